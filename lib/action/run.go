@@ -18,7 +18,14 @@ import (
 	"github.com/mithrandie/go-file/v2"
 )
 
-func Run(ctx context.Context, proc *query.Processor, input string, sourceFile string, outfile string) error {
+// VerticalTerminator is the statement terminator in the interactive shell
+// to display the results in the VERTICAL format.
+const VerticalTerminator = "\\G"
+
+// Run executes the statements in input. If outfile is specified, the result sets
+// are written to the file. If appendOut is true, the result sets are appended to
+// the file when it already exists.
+func Run(ctx context.Context, proc *query.Processor, input string, sourceFile string, outfile string, appendOut bool) error {
 	start := time.Now()
 
 	defer func() {
@@ -34,25 +41,44 @@ func Run(ctx context.Context, proc *query.Processor, input string, sourceFile st
 		if abs, err := filepath.Abs(outfile); err == nil {
 			outfile = abs
 		}
-		if csvqfile.Exists(outfile) {
+		exists := csvqfile.Exists(outfile)
+		if exists && !appendOut {
 			return query.NewFileAlreadyExistError(parser.Identifier{Literal: outfile})
 		}
 
-		fp, err := file.Create(outfile)
+		compression := proc.Tx.Flags.ExportOptions.Compression
+		if compression == cmd.NoCompression {
+			compression = cmd.CompressionFromExt(outfile)
+		}
+
+		var fp *os.File
+		proc.Tx.Session.SetAppendedOutFile(nil)
+		if exists {
+			fp, err = openOutFileToAppend(proc, outfile, compression)
+		} else {
+			fp, err = file.Create(outfile)
+		}
+		if err != nil {
+			return query.NewIOError(nil, err.Error())
+		}
+		w, err := query.NewCompressWriter(fp, compression, proc.Tx.Flags.ExportOptions.CompressionLevel)
 		if err != nil {
+			_ = fp.Close()
 			return query.NewIOError(nil, err.Error())
 		}
 		defer func() {
-			if info, err := fp.Stat(); err == nil && info.Size() < 1 {
+			if info, err := fp.Stat(); err == nil && info.Size() < 1 && !exists {
 				if err = os.Remove(outfile); err != nil {
 					proc.LogError(err.Error())
 				}
+			} else if err = w.Close(); err != nil {
+				proc.LogError(err.Error())
 			}
 			if err = fp.Close(); err != nil {
 				proc.LogError(err.Error())
 			}
 		}()
-		proc.Tx.Session.SetOutFile(fp)
+		proc.Tx.Session.SetOutFile(w)
 	}
 
 	proc.Tx.AutoCommit = true
@@ -60,6 +86,40 @@ func Run(ctx context.Context, proc *query.Processor, input string, sourceFile st
 	return err
 }
 
+// openOutFileToAppend opens the existing file to append result sets.
+// If the file is not empty, the header and the BOM are not written, and a line
+// break is written first if the file does not end with a line break.
+// Fields of result sets written in CSV or TSV are arranged in the order of the
+// header of the file.
+func openOutFileToAppend(proc *query.Processor, outfile string, compression cmd.Compression) (*os.File, error) {
+	fp, err := file.TryOpenToUpdate(outfile)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := fp.Stat()
+	if err == nil && 0 < info.Size() {
+		proc.Tx.Session.SetAppendedOutFile(query.NewAppendedOutFile(outfile, proc.Tx.Flags.ExportOptions.WithoutHeader))
+		proc.Tx.Flags.ExportOptions.WithoutHeader = true
+		proc.Tx.Flags.ExportOptions.WriteBOM = false
+
+		if compression == cmd.NoCompression {
+			last := make([]byte, 1)
+			if _, err = fp.ReadAt(last, info.Size()-1); err == nil && last[0] != '\n' {
+				_, err = fp.WriteAt([]byte(proc.Tx.Flags.ExportOptions.LineBreak.Value()), info.Size())
+			}
+		}
+	}
+	if err == nil {
+		_, err = fp.Seek(0, io.SeekEnd)
+	}
+	if err != nil {
+		_ = fp.Close()
+		return nil, err
+	}
+	return fp, nil
+}
+
 func LaunchInteractiveShell(ctx context.Context, proc *query.Processor) error {
 	if proc.Tx.Session.CanReadStdin {
 		return query.NewIncorrectCommandUsageError("input from pipe or redirection cannot be used in interactive shell")
@@ -136,6 +196,9 @@ func LaunchInteractiveShell(ctx context.Context, proc *query.Processor) error {
 			proc.LogError(e.Error())
 		}
 
+		var vertical bool
+		lines[len(lines)-1], vertical = trimVerticalTerminator(lines[len(lines)-1])
+
 		statements, _, e := parser.Parse(strings.Join(lines, "\n"), "", proc.Tx.Flags.DatetimeFormat, false, proc.Tx.Flags.AnsiQuotes)
 		if e != nil {
 			if e = query.NewSyntaxError(e.(*parser.SyntaxError)); e != nil {
@@ -146,7 +209,12 @@ func LaunchInteractiveShell(ctx context.Context, proc *query.Processor) error {
 			continue
 		}
 
-		flow, e := proc.Execute(ctx, statements)
+		var flow query.StatementFlow
+		if vertical {
+			flow, e = executeVertically(ctx, proc, statements)
+		} else {
+			flow, e = proc.Execute(ctx, statements)
+		}
 		if e != nil {
 			if ex, ok := e.(*query.ForcedExit); ok {
 				err = ex
@@ -170,6 +238,29 @@ func LaunchInteractiveShell(ctx context.Context, proc *query.Processor) error {
 	return err
 }
 
+// trimVerticalTerminator removes the terminator "\\G" from the end of the line,
+// and reports whether the terminator was found.
+func trimVerticalTerminator(line string) (string, bool) {
+	if strings.HasSuffix(line, VerticalTerminator) {
+		return line[:len(line)-len(VerticalTerminator)], true
+	}
+	return line, false
+}
+
+// executeVertically executes the statements with the VERTICAL format,
+// and restores the format unless it is changed by the statements.
+func executeVertically(ctx context.Context, proc *query.Processor, statements []parser.Statement) (query.StatementFlow, error) {
+	format := proc.Tx.Flags.ExportOptions.Format
+	proc.Tx.Flags.ExportOptions.Format = cmd.VERTICAL
+	defer func() {
+		if proc.Tx.Flags.ExportOptions.Format == cmd.VERTICAL {
+			proc.Tx.Flags.ExportOptions.Format = format
+		}
+	}()
+
+	return proc.Execute(ctx, statements)
+}
+
 func showStats(ctx context.Context, proc *query.Processor, start time.Time) {
 	if ctx.Err() != nil {
 		return
@@ -13,13 +13,15 @@ import (
 )
 
 var executeTests = []struct {
-	Name    string
-	Input   string
-	OutFile string
-	Output  string
-	Stats   bool
-	Content string
-	Error   string
+	Name     string
+	Input    string
+	OutFile  string
+	Append   bool
+	Existing string
+	Output   string
+	Stats    bool
+	Content  string
+	Error    string
 }{
 	{
 		Name:    "Select Query Output To File",
@@ -42,6 +44,60 @@ var executeTests = []struct {
 		Input: "select from",
 		Error: "[L:1 C:8] syntax error: unexpected token \"from\"",
 	},
+	{
+		Name:    "Select Query Output To Existing File Error",
+		Input:   "select 1 from dual",
+		OutFile: GetTestFilePath("select_query_output_file.csv"),
+		Error:   "file " + GetTestFilePath("select_query_output_file.csv") + " already exists",
+	},
+	{
+		Name:     "Select Query Append To Empty File",
+		Input:    "set @@format = csv; select 1 as c1 from dual",
+		OutFile:  GetTestFilePath("select_query_append_empty.csv"),
+		Append:   true,
+		Existing: "",
+		Content: "" +
+			"c1\n" +
+			"1\n",
+	},
+	{
+		Name:     "Select Query Append To File",
+		Input:    "set @@format = csv; select 2 as c1 from dual",
+		OutFile:  GetTestFilePath("select_query_append_file.csv"),
+		Append:   true,
+		Existing: "c1\n1",
+		Content: "" +
+			"c1\n" +
+			"1\n" +
+			"2\n",
+	},
+	{
+		Name:     "Select Query Append To File In Order Of Header",
+		Input:    "set @@format = csv; select 2 as c2, 'a' as c1 from dual",
+		OutFile:  GetTestFilePath("select_query_append_order.csv"),
+		Append:   true,
+		Existing: "c1,c2\nb,1\n",
+		Content: "" +
+			"c1,c2\n" +
+			"b,1\n" +
+			"a,2\n",
+	},
+	{
+		Name:     "Select Query Append To File Header Mismatch Error",
+		Input:    "set @@format = csv; select 2 as c3 from dual",
+		OutFile:  GetTestFilePath("select_query_append_mismatch.csv"),
+		Append:   true,
+		Existing: "c1\n1\n",
+		Error:    "columns of the result set do not match the header of file " + GetTestFilePath("select_query_append_mismatch.csv"),
+	},
+	{
+		Name:     "Select Query Append To File Format Error",
+		Input:    "set @@format = json; select 2 as c1 from dual",
+		OutFile:  GetTestFilePath("select_query_append_json.json"),
+		Append:   true,
+		Existing: "[{\"c1\":1}]",
+		Error:    "incorrect usage: --append option cannot be used with JSON format",
+	},
 	{
 		Name:  "Show Statistics",
 		Input: "select 1",
@@ -60,12 +116,16 @@ func TestRun(t *testing.T) {
 		}
 
 		tx.Session.SetOutFile(nil)
+		tx.Flags.ExportOptions.WithoutHeader = false
+		if v.Append {
+			_ = ioutil.WriteFile(v.OutFile, []byte(v.Existing), 0600)
+		}
 
 		out := query.NewOutput()
 		tx.Session.SetStdout(out)
 
 		proc := query.NewProcessor(tx)
-		err := Run(ctx, proc, v.Input, "", v.OutFile)
+		err := Run(ctx, proc, v.Input, "", v.OutFile, v.Append)
 
 		stdout := out.String()
 
@@ -101,3 +161,29 @@ func TestRun(t *testing.T) {
 		}
 	}
 }
+
+var trimVerticalTerminatorTests = []struct {
+	Line     string
+	Expect   string
+	Vertical bool
+}{
+	{
+		Line:     "select 1\\G",
+		Expect:   "select 1",
+		Vertical: true,
+	},
+	{
+		Line:     "select 1;",
+		Expect:   "select 1;",
+		Vertical: false,
+	},
+}
+
+func TestTrimVerticalTerminator(t *testing.T) {
+	for _, v := range trimVerticalTerminatorTests {
+		line, vertical := trimVerticalTerminator(v.Line)
+		if line != v.Expect || vertical != v.Vertical {
+			t.Errorf("result = %q, %t, want %q, %t for %q", line, vertical, v.Expect, v.Vertical, v.Line)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package action
+
+import (
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/query"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+// DeclareVariables declares variables passed by the --var option in the
+// form of "name=value" or "type:name=value" in the processor's scope.
+func DeclareVariables(proc *query.Processor, defs []string) error {
+	for _, def := range defs {
+		variable, val, err := ParseVariableDefinition(def)
+		if err != nil {
+			return err
+		}
+		if err = proc.ReferenceScope.DeclareVariableDirectly(variable, val); err != nil {
+			return query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
+	return nil
+}
+
+func ParseVariableDefinition(def string) (parser.Variable, value.Primary, error) {
+	eqIdx := strings.Index(def, "=")
+	if eqIdx < 0 {
+		return parser.Variable{}, nil, query.NewIncorrectCommandUsageError("variable definition " + def + " must be in the form of name=value")
+	}
+
+	name := def[:eqIdx]
+	s := def[eqIdx+1:]
+
+	typeName := "string"
+	if i := strings.Index(name, ":"); -1 < i {
+		typeName = strings.ToLower(name[:i])
+		name = name[i+1:]
+	}
+	name = strings.TrimPrefix(name, string(parser.VariableSign))
+
+	if len(name) < 1 {
+		return parser.Variable{}, nil, query.NewIncorrectCommandUsageError("variable definition " + def + " has no name")
+	}
+
+	var val value.Primary
+	switch typeName {
+	case "string":
+		val = value.NewString(s)
+	case "int", "integer":
+		val = value.ToInteger(value.NewString(s))
+	case "float":
+		val = value.ToFloat(value.NewString(strings.TrimSpace(s)))
+	default:
+		return parser.Variable{}, nil, query.NewIncorrectCommandUsageError("type of variable " + name + " must be one of string, int or float")
+	}
+	if value.IsNull(val) {
+		return parser.Variable{}, nil, query.NewIncorrectCommandUsageError("value of variable " + name + " cannot be converted to " + typeName)
+	}
+
+	return parser.Variable{Name: name}, val, nil
+}
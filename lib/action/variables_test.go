@@ -0,0 +1,109 @@
+package action
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/query"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var parseVariableDefinitionTests = []struct {
+	Input    string
+	Variable parser.Variable
+	Value    value.Primary
+	Error    string
+}{
+	{
+		Input:    "dir=/tmp/out",
+		Variable: parser.Variable{Name: "dir"},
+		Value:    value.NewString("/tmp/out"),
+	},
+	{
+		Input:    "@dir=",
+		Variable: parser.Variable{Name: "dir"},
+		Value:    value.NewString(""),
+	},
+	{
+		Input:    "n=3",
+		Variable: parser.Variable{Name: "n"},
+		Value:    value.NewString("3"),
+	},
+	{
+		Input:    "int:n=3",
+		Variable: parser.Variable{Name: "n"},
+		Value:    value.NewInteger(3),
+	},
+	{
+		Input:    "FLOAT:rate=1.5",
+		Variable: parser.Variable{Name: "rate"},
+		Value:    value.NewFloat(1.5),
+	},
+	{
+		Input:    "string:s=a=b",
+		Variable: parser.Variable{Name: "s"},
+		Value:    value.NewString("a=b"),
+	},
+	{
+		Input: "n",
+		Error: "incorrect usage: variable definition n must be in the form of name=value",
+	},
+	{
+		Input: "int:=3",
+		Error: "incorrect usage: variable definition int:=3 has no name",
+	},
+	{
+		Input: "bool:b=true",
+		Error: "incorrect usage: type of variable b must be one of string, int or float",
+	},
+	{
+		Input: "int:n=abc",
+		Error: "incorrect usage: value of variable n cannot be converted to int",
+	},
+}
+
+func TestParseVariableDefinition(t *testing.T) {
+	for _, v := range parseVariableDefinitionTests {
+		variable, val, err := ParseVariableDefinition(v.Input)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for %q", err, v.Input)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for %q", err.Error(), v.Error, v.Input)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for %q", v.Error, v.Input)
+			continue
+		}
+		if !reflect.DeepEqual(variable, v.Variable) {
+			t.Errorf("variable = %#v, want %#v for %q", variable, v.Variable, v.Input)
+		}
+		if !reflect.DeepEqual(val, v.Value) {
+			t.Errorf("value = %s, want %s for %q", val, v.Value, v.Input)
+		}
+	}
+}
+
+func TestDeclareVariables(t *testing.T) {
+	tx, _ := query.NewTransaction(context.Background(), file.DefaultWaitTimeout, file.DefaultRetryDelay, query.NewSession())
+	proc := query.NewProcessor(tx)
+
+	if err := DeclareVariables(proc, []string{"a=foo", "int:b=2"}); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if v, err := proc.ReferenceScope.GetVariable(parser.Variable{Name: "b"}); err != nil || !reflect.DeepEqual(v, value.NewInteger(2)) {
+		t.Errorf("variable @b = %s, %v, want %s", v, err, value.NewInteger(2))
+	}
+
+	expectErr := "incorrect usage: variable @a is redeclared"
+	if err := DeclareVariables(proc, []string{"a=bar"}); err == nil {
+		t.Errorf("no error, want error %q", expectErr)
+	} else if err.Error() != expectErr {
+		t.Errorf("error %q, want error %q", err.Error(), expectErr)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -27,24 +28,71 @@ const (
 	TimezoneFlag                 = "TIMEZONE"
 	DatetimeFormatFlag           = "DATETIME_FORMAT"
 	AnsiQuotesFlag               = "ANSI_QUOTES"
+	NullsOrderFlag               = "NULLS_ORDER"
+	StableSortFlag               = "STABLE_SORT"
+	ConcatNullAsEmptyFlag        = "CONCAT_NULL_AS_EMPTY"
+	AppendCommitFlag             = "APPEND_COMMIT"
+	AtomicWriteFlag              = "ATOMIC_WRITE"
+	DryRunFlag                   = "DRY_RUN"
+	IgnoreConstraintsFlag        = "IGNORE_CONSTRAINTS"
 	WaitTimeoutFlag              = "WAIT_TIMEOUT"
+	HttpTimeoutFlag              = "HTTP_TIMEOUT"
+	HttpMaxSizeFlag              = "HTTP_MAX_SIZE"
+	HttpHeadersFlag              = "HTTP_HEADERS"
 	ImportFormatFlag             = "IMPORT_FORMAT"
 	DelimiterFlag                = "DELIMITER"
+	DelimiterRegexFlag           = "DELIMITER_REGEX"
 	DelimiterPositionsFlag       = "DELIMITER_POSITIONS"
+	QuoteCharFlag                = "QUOTE_CHAR"
+	EscapeStyleFlag              = "ESCAPE_STYLE"
 	JsonQueryFlag                = "JSON_QUERY"
 	EncodingFlag                 = "ENCODING"
 	NoHeaderFlag                 = "NO_HEADER"
 	WithoutNullFlag              = "WITHOUT_NULL"
+	ColumnTypesFlag              = "COLUMN_TYPES"
+	StrictColumnTypesFlag        = "STRICT_COLUMN_TYPES"
+	StrictGlobHeaderFlag         = "STRICT_GLOB_HEADER"
+	NoInferFlag                  = "NO_INFER"
+	SkipLinesFlag                = "SKIP_LINES"
+	CommentPrefixFlag            = "COMMENT_PREFIX"
+	SkipAllCommentsFlag          = "SKIP_ALL_COMMENTS"
+	TrimFlag                     = "TRIM"
+	DetectFlag                   = "DETECT"
+	RaggedFlag                   = "RAGGED"
+	TeeFlag                      = "TEE"
 	StripEndingLineBreakFlag     = "STRIP_ENDING_LINE_BREAK"
 	FormatFlag                   = "FORMAT"
 	ExportEncodingFlag           = "WRITE_ENCODING"
+	WriteBOMFlag                 = "WRITE_BOM"
+	ReplaceUnencodableFlag       = "REPLACE_UNENCODABLE"
 	ExportDelimiterFlag          = "WRITE_DELIMITER"
 	ExportDelimiterPositionsFlag = "WRITE_DELIMITER_POSITIONS"
 	WithoutHeaderFlag            = "WITHOUT_HEADER"
+	TypedHeaderFlag              = "TYPED_HEADER"
 	LineBreakFlag                = "LINE_BREAK"
 	EncloseAllFlag               = "ENCLOSE_ALL"
+	QuoteModeFlag                = "QUOTE_MODE"
+	ExcelSafeFlag                = "EXCEL_SAFE"
+	ExcelSafePrefixFlag          = "EXCEL_SAFE_PREFIX"
 	JsonEscapeFlag               = "JSON_ESCAPE"
 	PrettyPrintFlag              = "PRETTY_PRINT"
+	JsonKeyFlag                  = "JSON_KEY"
+	JsonKeyLastFlag              = "JSON_KEY_LAST"
+	JsonFlatFlag                 = "JSON_FLAT"
+	NullTextFlag                 = "NULL_TEXT"
+	HtmlClassFlag                = "HTML_CLASS"
+	XmlAttributesFlag            = "XML_ATTRIBUTES"
+	MaxColumnWidthFlag           = "MAX_COLUMN_WIDTH"
+	WrapTextFlag                 = "WRAP_TEXT"
+	BoxDrawingFlag               = "BOX_DRAWING"
+	TableNameFlag                = "TABLE_NAME"
+	InsertBatchSizeFlag          = "INSERT_BATCH_SIZE"
+	IdentifierQuoteFlag          = "IDENTIFIER_QUOTE"
+	TemplateFlag                 = "TEMPLATE"
+	ParquetCompressionFlag       = "PARQUET_COMPRESSION"
+	ParquetAllStringsFlag        = "PARQUET_ALL_STRINGS"
+	CompressionFlag              = "COMPRESSION"
+	CompressionLevelFlag         = "COMPRESSION_LEVEL"
 	EastAsianEncodingFlag        = "EAST_ASIAN_ENCODING"
 	CountDiacriticalSignFlag     = "COUNT_DIACRITICAL_SIGN"
 	CountFormatCodeFlag          = "COUNT_FORMAT_CODE"
@@ -53,6 +101,7 @@ const (
 	LimitRecursion               = "LIMIT_RECURSION"
 	CPUFlag                      = "CPU"
 	StatsFlag                    = "STATS"
+	NowFlag                      = "NOW"
 )
 
 var FlagList = []string{
@@ -60,24 +109,71 @@ var FlagList = []string{
 	TimezoneFlag,
 	DatetimeFormatFlag,
 	AnsiQuotesFlag,
+	NullsOrderFlag,
+	StableSortFlag,
+	ConcatNullAsEmptyFlag,
+	AppendCommitFlag,
+	AtomicWriteFlag,
+	DryRunFlag,
+	IgnoreConstraintsFlag,
 	WaitTimeoutFlag,
+	HttpTimeoutFlag,
+	HttpMaxSizeFlag,
+	HttpHeadersFlag,
 	ImportFormatFlag,
 	DelimiterFlag,
+	DelimiterRegexFlag,
 	DelimiterPositionsFlag,
+	QuoteCharFlag,
+	EscapeStyleFlag,
 	JsonQueryFlag,
 	EncodingFlag,
 	NoHeaderFlag,
 	WithoutNullFlag,
+	ColumnTypesFlag,
+	StrictColumnTypesFlag,
+	StrictGlobHeaderFlag,
+	NoInferFlag,
+	SkipLinesFlag,
+	CommentPrefixFlag,
+	SkipAllCommentsFlag,
+	TrimFlag,
+	DetectFlag,
+	RaggedFlag,
+	TeeFlag,
 	StripEndingLineBreakFlag,
 	FormatFlag,
 	ExportEncodingFlag,
+	WriteBOMFlag,
+	ReplaceUnencodableFlag,
 	ExportDelimiterFlag,
 	ExportDelimiterPositionsFlag,
 	WithoutHeaderFlag,
+	TypedHeaderFlag,
 	LineBreakFlag,
 	EncloseAllFlag,
+	QuoteModeFlag,
+	ExcelSafeFlag,
+	ExcelSafePrefixFlag,
 	JsonEscapeFlag,
 	PrettyPrintFlag,
+	JsonKeyFlag,
+	JsonKeyLastFlag,
+	JsonFlatFlag,
+	NullTextFlag,
+	HtmlClassFlag,
+	XmlAttributesFlag,
+	MaxColumnWidthFlag,
+	WrapTextFlag,
+	BoxDrawingFlag,
+	TableNameFlag,
+	InsertBatchSizeFlag,
+	IdentifierQuoteFlag,
+	TemplateFlag,
+	ParquetCompressionFlag,
+	ParquetAllStringsFlag,
+	CompressionFlag,
+	CompressionLevelFlag,
 	EastAsianEncodingFlag,
 	CountDiacriticalSignFlag,
 	CountFormatCodeFlag,
@@ -86,6 +182,7 @@ var FlagList = []string{
 	LimitRecursion,
 	CPUFlag,
 	StatsFlag,
+	NowFlag,
 }
 
 type Format int
@@ -96,21 +193,35 @@ const (
 	TSV
 	FIXED
 	JSON
+	NDJSON
 	LTSV
 	GFM
 	ORG
 	TEXT
+	HTML
+	XML
+	VERTICAL
+	SQL_INSERT
+	TEMPLATE
+	PARQUET
 )
 
 var FormatLiteral = map[Format]string{
-	CSV:   "CSV",
-	TSV:   "TSV",
-	FIXED: "FIXED",
-	JSON:  "JSON",
-	LTSV:  "LTSV",
-	GFM:   "GFM",
-	ORG:   "ORG",
-	TEXT:  "TEXT",
+	CSV:        "CSV",
+	TSV:        "TSV",
+	FIXED:      "FIXED",
+	JSON:       "JSON",
+	NDJSON:     "NDJSON",
+	LTSV:       "LTSV",
+	GFM:        "GFM",
+	ORG:        "ORG",
+	TEXT:       "TEXT",
+	HTML:       "HTML",
+	XML:        "XML",
+	VERTICAL:   "VERTICAL",
+	SQL_INSERT: "SQL_INSERT",
+	TEMPLATE:   "TEMPLATE",
+	PARQUET:    "PARQUET",
 }
 
 func (f Format) String() string {
@@ -122,9 +233,171 @@ var ImportFormats = []Format{
 	TSV,
 	FIXED,
 	JSON,
+	NDJSON,
 	LTSV,
 }
 
+// Compression is the compression algorithm of query results and files written at commit.
+// Only the algorithms that can also be decompressed on loading are available, so that
+// compressed tables can be read and updated again. zstd is not available for this reason:
+// the zstd encoder for the data pages in PARQUET has no decoder counterpart.
+type Compression int
+
+const (
+	NoCompression Compression = iota
+	GZIP
+)
+
+var CompressionLiteral = map[Compression]string{
+	NoCompression: "NONE",
+	GZIP:          "GZIP",
+}
+
+func (c Compression) String() string {
+	return CompressionLiteral[c]
+}
+
+type EscapeStyle int
+
+const (
+	DoubleEscape EscapeStyle = iota
+	BackslashEscape
+	NoEscape
+)
+
+var EscapeStyleLiteral = map[EscapeStyle]string{
+	DoubleEscape:    "DOUBLE",
+	BackslashEscape: "BACKSLASH",
+	NoEscape:        "NONE",
+}
+
+func (e EscapeStyle) String() string {
+	return EscapeStyleLiteral[e]
+}
+
+type RaggedMode int
+
+const (
+	RaggedError RaggedMode = iota
+	RaggedPad
+	RaggedTruncate
+	RaggedSkip
+)
+
+var RaggedModeLiteral = map[RaggedMode]string{
+	RaggedError:    "ERROR",
+	RaggedPad:      "PAD",
+	RaggedTruncate: "TRUNCATE",
+	RaggedSkip:     "SKIP",
+}
+
+func (m RaggedMode) String() string {
+	return RaggedModeLiteral[m]
+}
+
+type QuoteMode int
+
+const (
+	MinimalQuote QuoteMode = iota
+	AllQuote
+	NonNumericQuote
+	NoQuote
+)
+
+var QuoteModeLiteral = map[QuoteMode]string{
+	MinimalQuote:    "MINIMAL",
+	AllQuote:        "ALL",
+	NonNumericQuote: "NON_NUMERIC",
+	NoQuote:         "NONE",
+}
+
+func (m QuoteMode) String() string {
+	return QuoteModeLiteral[m]
+}
+
+type ExcelSafePrefix int
+
+const (
+	QuotePrefix ExcelSafePrefix = iota
+	TabPrefix
+)
+
+var ExcelSafePrefixLiteral = map[ExcelSafePrefix]string{
+	QuotePrefix: "QUOTE",
+	TabPrefix:   "TAB",
+}
+
+var excelSafePrefixValue = map[ExcelSafePrefix]string{
+	QuotePrefix: "'",
+	TabPrefix:   "\t",
+}
+
+func (p ExcelSafePrefix) Value() string {
+	return excelSafePrefixValue[p]
+}
+
+func (p ExcelSafePrefix) String() string {
+	return ExcelSafePrefixLiteral[p]
+}
+
+type IdentifierQuote int
+
+const (
+	DoubleQuoteIdentifier IdentifierQuote = iota
+	BacktickIdentifier
+)
+
+var IdentifierQuoteLiteral = map[IdentifierQuote]string{
+	DoubleQuoteIdentifier: "DOUBLE",
+	BacktickIdentifier:    "BACKTICK",
+}
+
+func (q IdentifierQuote) String() string {
+	return IdentifierQuoteLiteral[q]
+}
+
+type ParquetCompression int
+
+const (
+	SnappyParquetCompression ParquetCompression = iota
+	ZstdParquetCompression
+	NoParquetCompression
+)
+
+var ParquetCompressionLiteral = map[ParquetCompression]string{
+	SnappyParquetCompression: "SNAPPY",
+	ZstdParquetCompression:   "ZSTD",
+	NoParquetCompression:     "NONE",
+}
+
+func (c ParquetCompression) String() string {
+	return ParquetCompressionLiteral[c]
+}
+
+type NullsOrder int
+
+const (
+	DefaultNullsOrder NullsOrder = iota
+	NullsFirst
+	NullsLast
+)
+
+var NullsOrderLiteral = map[NullsOrder]string{
+	DefaultNullsOrder: "DEFAULT",
+	NullsFirst:        "FIRST",
+	NullsLast:         "LAST",
+}
+
+func (o NullsOrder) String() string {
+	return NullsOrderLiteral[o]
+}
+
+const (
+	MinCompressionLevel     = 1
+	MaxCompressionLevel     = 9
+	DefaultCompressionLevel = 6
+)
+
 var JsonEscapeTypeLiteral = map[txjson.EscapeType]string{
 	txjson.Backslash:        "BACKSLASH",
 	txjson.HexDigits:        "HEX",
@@ -139,23 +412,68 @@ const (
 	CsvExt      = ".csv"
 	TsvExt      = ".tsv"
 	JsonExt     = ".json"
+	NdjsonExt   = ".ndjson"
+	JsonlExt    = ".jsonl"
 	LtsvExt     = ".ltsv"
 	GfmExt      = ".md"
 	OrgExt      = ".org"
+	HtmlExt     = ".html"
+	HtmExt      = ".htm"
+	XmlExt      = ".xml"
 	SqlExt      = ".sql"
+	ParquetExt  = ".parquet"
 	CsvqProcExt = ".cql"
 	TextExt     = ".txt"
+	GzExt       = ".gz"
 )
 
+type ColumnType struct {
+	Column string
+	Type   string
+	Format string
+}
+
+func (ct ColumnType) TypeString() string {
+	if 0 < len(ct.Format) {
+		return ct.Type + "(" + ct.Format + ")"
+	}
+	return ct.Type
+}
+
+type ColumnTypes []ColumnType
+
+func (list ColumnTypes) String() string {
+	s := make([]string, 0, len(list))
+	for _, ct := range list {
+		s = append(s, ct.Column+":"+ct.TypeString())
+	}
+	return strings.Join(s, ",")
+}
+
 type ImportOptions struct {
 	Format             Format
 	Delimiter          rune
+	MultiCharDelimiter string
+	DelimiterRegex     string
 	DelimiterPositions []int
+	FieldNames         []string
 	SingleLine         bool
+	QuoteChar          rune
+	EscapeStyle        EscapeStyle
 	JsonQuery          string
 	Encoding           text.Encoding
 	NoHeader           bool
 	WithoutNull        bool
+	ColumnTypes        ColumnTypes
+	StrictColumnTypes  bool
+	StrictGlobHeader   bool
+	NoInfer            bool
+	SkipLines          int
+	CommentPrefix      string
+	SkipAllComments    bool
+	Trim               bool
+	Detect             bool
+	Ragged             RaggedMode
 }
 
 func (ops ImportOptions) Copy() ImportOptions {
@@ -165,8 +483,22 @@ func (ops ImportOptions) Copy() ImportOptions {
 		copy(dp, ops.DelimiterPositions)
 	}
 
+	var fn []string
+	if ops.FieldNames != nil {
+		fn = make([]string, len(ops.FieldNames))
+		copy(fn, ops.FieldNames)
+	}
+
+	var ct ColumnTypes
+	if ops.ColumnTypes != nil {
+		ct = make(ColumnTypes, len(ops.ColumnTypes))
+		copy(ct, ops.ColumnTypes)
+	}
+
 	ret := ops
 	ret.DelimiterPositions = dp
+	ret.FieldNames = fn
+	ret.ColumnTypes = ct
 	return ret
 }
 
@@ -174,12 +506,27 @@ func NewImportOptions() ImportOptions {
 	return ImportOptions{
 		Format:             CSV,
 		Delimiter:          ',',
+		MultiCharDelimiter: "",
+		DelimiterRegex:     "",
 		DelimiterPositions: nil,
+		FieldNames:         nil,
 		SingleLine:         false,
+		QuoteChar:          '"',
+		EscapeStyle:        DoubleEscape,
 		JsonQuery:          "",
 		Encoding:           text.AUTO,
 		NoHeader:           false,
 		WithoutNull:        false,
+		ColumnTypes:        nil,
+		StrictColumnTypes:  false,
+		StrictGlobHeader:   false,
+		NoInfer:            false,
+		SkipLines:          0,
+		CommentPrefix:      "",
+		SkipAllComments:    false,
+		Trim:               false,
+		Detect:             false,
+		Ragged:             RaggedError,
 	}
 }
 
@@ -187,14 +534,37 @@ type ExportOptions struct {
 	StripEndingLineBreak bool
 	Format               Format
 	Encoding             text.Encoding
+	WriteBOM             bool
+	ReplaceUnencodable   bool
 	Delimiter            rune
 	DelimiterPositions   []int
 	SingleLine           bool
 	WithoutHeader        bool
+	TypedHeader          bool
 	LineBreak            text.LineBreak
 	EncloseAll           bool
+	QuoteMode            QuoteMode
+	ExcelSafe            bool
+	ExcelSafePrefix      ExcelSafePrefix
 	JsonEscape           txjson.EscapeType
 	PrettyPrint          bool
+	JsonKey              string
+	JsonKeyLast          bool
+	JsonFlat             bool
+	NullText             string
+	HtmlClass            string
+	XmlAttributes        bool
+	MaxColumnWidth       int
+	WrapText             bool
+	BoxDrawing           bool
+	TableName            string
+	InsertBatchSize      int
+	IdentifierQuote      IdentifierQuote
+	Template             string
+	ParquetCompression   ParquetCompression
+	ParquetAllStrings    bool
+	Compression          Compression
+	CompressionLevel     int
 
 	// For Calculation of String Width
 	EastAsianEncoding    bool
@@ -202,6 +572,9 @@ type ExportOptions struct {
 	CountFormatCode      bool
 
 	Color bool
+
+	// Width to wrap values in VERTICAL format. 0 means that values are not wrapped.
+	MaxWidth int
 }
 
 func (ops ExportOptions) Copy() ExportOptions {
@@ -221,14 +594,37 @@ func NewExportOptions() ExportOptions {
 		StripEndingLineBreak: false,
 		Format:               TEXT,
 		Encoding:             text.UTF8,
+		WriteBOM:             false,
+		ReplaceUnencodable:   false,
 		Delimiter:            ',',
 		DelimiterPositions:   nil,
 		SingleLine:           false,
 		WithoutHeader:        false,
+		TypedHeader:          false,
 		LineBreak:            text.LF,
 		EncloseAll:           false,
+		QuoteMode:            MinimalQuote,
+		ExcelSafe:            false,
+		ExcelSafePrefix:      QuotePrefix,
 		JsonEscape:           txjson.Backslash,
 		PrettyPrint:          false,
+		JsonKey:              "",
+		JsonKeyLast:          false,
+		JsonFlat:             false,
+		NullText:             "",
+		HtmlClass:            "",
+		XmlAttributes:        false,
+		MaxColumnWidth:       0,
+		WrapText:             false,
+		BoxDrawing:           false,
+		TableName:            "",
+		InsertBatchSize:      1,
+		IdentifierQuote:      DoubleQuoteIdentifier,
+		Template:             "",
+		ParquetCompression:   SnappyParquetCompression,
+		ParquetAllStrings:    false,
+		Compression:          NoCompression,
+		CompressionLevel:     DefaultCompressionLevel,
 		EastAsianEncoding:    false,
 		CountDiacriticalSign: false,
 		CountFormatCode:      false,
@@ -242,20 +638,35 @@ type Flags struct {
 	Location       string
 	DatetimeFormat []string
 	AnsiQuotes     bool
+	NullsOrder     NullsOrder
+	StableSort     bool
+
+	ConcatNullAsEmpty bool
+	AppendCommit      bool
+	AtomicWrite       bool
+	DryRun            bool
+	IgnoreConstraints bool
 
 	WaitTimeout float64
 
+	// For HTTP
+	HttpTimeout float64
+	HttpMaxSize int64
+	HttpHeaders string
+
 	// For Import
 	ImportOptions ImportOptions
 
 	// For Export
 	ExportOptions ExportOptions
+	Tee           string
 
 	// System Use
 	Quiet          bool
 	LimitRecursion int64
 	CPU            int
 	Stats          bool
+	Now            time.Time
 }
 
 func GetDefaultNumberOfCPU() int {
@@ -278,17 +689,28 @@ func NewFlags(env *Environment) *Flags {
 	}
 
 	return &Flags{
-		Repository:     "",
-		Location:       "Local",
-		DatetimeFormat: datetimeFormat,
-		AnsiQuotes:     false,
-		WaitTimeout:    10,
-		ImportOptions:  NewImportOptions(),
-		ExportOptions:  NewExportOptions(),
-		Quiet:          false,
-		LimitRecursion: 1000,
-		CPU:            GetDefaultNumberOfCPU(),
-		Stats:          false,
+		Repository:        "",
+		Location:          "Local",
+		DatetimeFormat:    datetimeFormat,
+		AnsiQuotes:        false,
+		NullsOrder:        DefaultNullsOrder,
+		StableSort:        true,
+		ConcatNullAsEmpty: false,
+		AppendCommit:      false,
+		AtomicWrite:       true,
+		DryRun:            false,
+		IgnoreConstraints: false,
+		WaitTimeout:       10,
+		HttpTimeout:       30,
+		HttpMaxSize:       0,
+		HttpHeaders:       "",
+		ImportOptions:     NewImportOptions(),
+		ExportOptions:     NewExportOptions(),
+		Tee:               "",
+		Quiet:             false,
+		LimitRecursion:    1000,
+		CPU:               GetDefaultNumberOfCPU(),
+		Stats:             false,
 	}
 }
 
@@ -328,7 +750,7 @@ func (f *Flags) SetLocation(s string) error {
 	}
 
 	f.Location = s
-	location = loc
+	setLocation(loc)
 	return nil
 }
 
@@ -351,6 +773,40 @@ func (f *Flags) SetAnsiQuotes(b bool) {
 	f.AnsiQuotes = b
 }
 
+func (f *Flags) SetNullsOrder(s string) error {
+	o, err := ParseNullsOrder(s)
+	if err != nil {
+		return err
+	}
+
+	f.NullsOrder = o
+	return nil
+}
+
+func (f *Flags) SetStableSort(b bool) {
+	f.StableSort = b
+}
+
+func (f *Flags) SetConcatNullAsEmpty(b bool) {
+	f.ConcatNullAsEmpty = b
+}
+
+func (f *Flags) SetAppendCommit(b bool) {
+	f.AppendCommit = b
+}
+
+func (f *Flags) SetAtomicWrite(b bool) {
+	f.AtomicWrite = b
+}
+
+func (f *Flags) SetDryRun(b bool) {
+	f.DryRun = b
+}
+
+func (f *Flags) SetIgnoreConstraints(b bool) {
+	f.IgnoreConstraints = b
+}
+
 func (f *Flags) SetWaitTimeout(t float64) {
 	if t < 0 {
 		t = 0
@@ -360,19 +816,44 @@ func (f *Flags) SetWaitTimeout(t float64) {
 	return
 }
 
+func (f *Flags) SetHttpTimeout(t float64) {
+	if t < 0 {
+		t = 0
+	}
+
+	f.HttpTimeout = t
+}
+
+func (f *Flags) SetHttpMaxSize(i int64) {
+	if i < 0 {
+		i = 0
+	}
+
+	f.HttpMaxSize = i
+}
+
+func (f *Flags) SetHttpHeaders(s string) error {
+	if _, err := ParseHttpHeaders(s); err != nil {
+		return err
+	}
+
+	f.HttpHeaders = s
+	return nil
+}
+
 func (f *Flags) SetImportFormat(s string) error {
 	fm, _, err := ParseFormat(s, f.ExportOptions.JsonEscape)
 	if err != nil {
-		return errors.New("import format must be one of CSV|TSV|FIXED|JSON|LTSV")
+		return errors.New("import format must be one of CSV|TSV|FIXED|JSON|NDJSON|LTSV")
 	}
 
 	switch fm {
-	case CSV, TSV, FIXED, JSON, LTSV:
+	case CSV, TSV, FIXED, JSON, NDJSON, LTSV:
 		f.ImportOptions.Format = fm
 		return nil
 	}
 
-	return errors.New("import format must be one of CSV|TSV|FIXED|JSON|LTSV")
+	return errors.New("import format must be one of CSV|TSV|FIXED|JSON|NDJSON|LTSV")
 }
 
 func (f *Flags) SetDelimiter(s string) error {
@@ -380,12 +861,26 @@ func (f *Flags) SetDelimiter(s string) error {
 		return nil
 	}
 
-	delimiter, err := ParseDelimiter(s)
+	delimiter, multiCharDelimiter, err := ParseImportDelimiter(s)
 	if err != nil {
 		return err
 	}
 
-	f.ImportOptions.Delimiter = delimiter
+	if 0 < len(multiCharDelimiter) {
+		f.ImportOptions.MultiCharDelimiter = multiCharDelimiter
+	} else {
+		f.ImportOptions.Delimiter = delimiter
+		f.ImportOptions.MultiCharDelimiter = ""
+	}
+	return nil
+}
+
+func (f *Flags) SetDelimiterRegex(s string) error {
+	if err := ValidateDelimiterRegex(s); err != nil {
+		return err
+	}
+
+	f.ImportOptions.DelimiterRegex = s
 	return nil
 }
 
@@ -393,16 +888,37 @@ func (f *Flags) SetDelimiterPositions(s string) error {
 	if len(s) < 1 {
 		return nil
 	}
-	delimiterPositions, singleLine, err := ParseDelimiterPositions(s)
+	delimiterPositions, fieldNames, singleLine, err := ParseDelimiterPositionsWithNames(s)
 	if err != nil {
 		return err
 	}
 
 	f.ImportOptions.DelimiterPositions = delimiterPositions
+	f.ImportOptions.FieldNames = fieldNames
 	f.ImportOptions.SingleLine = singleLine
 	return nil
 }
 
+func (f *Flags) SetQuoteChar(s string) error {
+	r, err := ParseQuoteChar(s)
+	if err != nil {
+		return err
+	}
+
+	f.ImportOptions.QuoteChar = r
+	return nil
+}
+
+func (f *Flags) SetEscapeStyle(s string) error {
+	e, err := ParseEscapeStyle(s)
+	if err != nil {
+		return err
+	}
+
+	f.ImportOptions.EscapeStyle = e
+	return nil
+}
+
 func (f *Flags) SetJsonQuery(s string) {
 	f.ImportOptions.JsonQuery = TrimSpace(s)
 }
@@ -429,6 +945,89 @@ func (f *Flags) SetWithoutNull(b bool) {
 	f.ImportOptions.WithoutNull = b
 }
 
+func (f *Flags) SetColumnTypes(s string) error {
+	columnTypes, err := ParseColumnTypes(s)
+	if err != nil {
+		return err
+	}
+
+	f.ImportOptions.ColumnTypes = columnTypes
+	return nil
+}
+
+func (f *Flags) SetSchema(path string) error {
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to load %q: %s", path, err.Error()))
+	}
+
+	columnTypes, err := ParseSchema(buf)
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to load %q: %s", path, err.Error()))
+	}
+
+	f.ImportOptions.ColumnTypes = columnTypes
+	return nil
+}
+
+func (f *Flags) SetStrictColumnTypes(b bool) {
+	f.ImportOptions.StrictColumnTypes = b
+}
+
+func (f *Flags) SetStrictGlobHeader(b bool) {
+	f.ImportOptions.StrictGlobHeader = b
+}
+
+func (f *Flags) SetNoInfer(b bool) {
+	f.ImportOptions.NoInfer = b
+}
+
+func (f *Flags) SetSkipLines(i int64) {
+	if i < 0 {
+		i = 0
+	}
+	f.ImportOptions.SkipLines = int(i)
+}
+
+func (f *Flags) SetCommentPrefix(s string) {
+	f.ImportOptions.CommentPrefix = s
+}
+
+func (f *Flags) SetSkipAllComments(b bool) {
+	f.ImportOptions.SkipAllComments = b
+}
+
+func (f *Flags) SetTrim(b bool) {
+	f.ImportOptions.Trim = b
+}
+
+func (f *Flags) SetDetect(b bool) {
+	f.ImportOptions.Detect = b
+}
+
+func (f *Flags) SetRagged(s string) error {
+	m, err := ParseRaggedMode(s)
+	if err != nil {
+		return err
+	}
+
+	f.ImportOptions.Ragged = m
+	return nil
+}
+
+func (f *Flags) SetTee(s string) {
+	if len(s) < 1 {
+		f.Tee = ""
+		return
+	}
+
+	path, err := filepath.Abs(s)
+	if err != nil {
+		path = s
+	}
+	f.Tee = path
+}
+
 func (f *Flags) SetFormat(s string, outfile string) error {
 	var fm Format
 	var escape txjson.EscapeType
@@ -436,20 +1035,8 @@ func (f *Flags) SetFormat(s string, outfile string) error {
 
 	switch s {
 	case "":
-		switch strings.ToLower(filepath.Ext(outfile)) {
-		case CsvExt:
-			fm = CSV
-		case TsvExt:
-			fm = TSV
-		case JsonExt:
-			fm = JSON
-		case LtsvExt:
-			fm = LTSV
-		case GfmExt:
-			fm = GFM
-		case OrgExt:
-			fm = ORG
-		default:
+		var ok bool
+		if fm, ok = FormatFromExt(outfile); !ok {
 			return nil
 		}
 	default:
@@ -470,7 +1057,7 @@ func (f *Flags) SetWriteEncoding(s string) error {
 
 	encoding, err := ParseEncoding(s)
 	if err != nil || encoding == text.AUTO {
-		return errors.New("write-encoding must be one of UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS")
+		return errors.New("write-encoding must be one of UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS|EUCJP")
 	}
 
 	f.ExportOptions.Encoding = encoding
@@ -509,6 +1096,18 @@ func (f *Flags) SetWithoutHeader(b bool) {
 	f.ExportOptions.WithoutHeader = b
 }
 
+func (f *Flags) SetWriteBOM(b bool) {
+	f.ExportOptions.WriteBOM = b
+}
+
+func (f *Flags) SetReplaceUnencodable(b bool) {
+	f.ExportOptions.ReplaceUnencodable = b
+}
+
+func (f *Flags) SetTypedHeader(b bool) {
+	f.ExportOptions.TypedHeader = b
+}
+
 func (f *Flags) SetLineBreak(s string) error {
 	if len(s) < 1 {
 		return nil
@@ -539,6 +1138,112 @@ func (f *Flags) SetPrettyPrint(b bool) {
 	f.ExportOptions.PrettyPrint = b
 }
 
+func (f *Flags) SetJsonKey(s string) {
+	f.ExportOptions.JsonKey = s
+}
+
+func (f *Flags) SetJsonKeyLast(b bool) {
+	f.ExportOptions.JsonKeyLast = b
+}
+
+func (f *Flags) SetJsonFlat(b bool) {
+	f.ExportOptions.JsonFlat = b
+}
+
+func (f *Flags) SetNullText(s string) {
+	f.ExportOptions.NullText = s
+}
+
+func (f *Flags) SetHtmlClass(s string) {
+	f.ExportOptions.HtmlClass = s
+}
+
+func (f *Flags) SetXmlAttributes(b bool) {
+	f.ExportOptions.XmlAttributes = b
+}
+
+func (f *Flags) SetMaxColumnWidth(i int64) {
+	if i < 0 {
+		i = 0
+	}
+	f.ExportOptions.MaxColumnWidth = int(i)
+}
+
+func (f *Flags) SetWrapText(b bool) {
+	f.ExportOptions.WrapText = b
+}
+
+func (f *Flags) SetBoxDrawing(b bool) {
+	f.ExportOptions.BoxDrawing = b
+}
+
+func (f *Flags) SetTableName(s string) {
+	f.ExportOptions.TableName = s
+}
+
+func (f *Flags) SetInsertBatchSize(i int64) {
+	if i < 1 {
+		i = 1
+	}
+	f.ExportOptions.InsertBatchSize = int(i)
+}
+
+func (f *Flags) SetTemplate(s string) {
+	if len(s) < 1 {
+		f.ExportOptions.Template = ""
+		return
+	}
+
+	path, err := filepath.Abs(s)
+	if err != nil {
+		path = s
+	}
+	f.ExportOptions.Template = path
+}
+
+func (f *Flags) SetIdentifierQuote(s string) error {
+	q, err := ParseIdentifierQuote(s)
+	if err != nil {
+		return err
+	}
+
+	f.ExportOptions.IdentifierQuote = q
+	return nil
+}
+
+func (f *Flags) SetParquetCompression(s string) error {
+	c, err := ParseParquetCompression(s)
+	if err != nil {
+		return err
+	}
+
+	f.ExportOptions.ParquetCompression = c
+	return nil
+}
+
+func (f *Flags) SetParquetAllStrings(b bool) {
+	f.ExportOptions.ParquetAllStrings = b
+}
+
+func (f *Flags) SetCompression(s string) error {
+	c, err := ParseCompression(s)
+	if err != nil {
+		return err
+	}
+
+	f.ExportOptions.Compression = c
+	return nil
+}
+
+func (f *Flags) SetCompressionLevel(i int64) {
+	if i < MinCompressionLevel {
+		i = MinCompressionLevel
+	} else if MaxCompressionLevel < i {
+		i = MaxCompressionLevel
+	}
+	f.ExportOptions.CompressionLevel = int(i)
+}
+
 func (f *Flags) SetStripEndingLineBreak(b bool) {
 	f.ExportOptions.StripEndingLineBreak = b
 }
@@ -547,6 +1252,30 @@ func (f *Flags) SetEncloseAll(b bool) {
 	f.ExportOptions.EncloseAll = b
 }
 
+func (f *Flags) SetQuoteMode(s string) error {
+	m, err := ParseQuoteMode(s)
+	if err != nil {
+		return err
+	}
+
+	f.ExportOptions.QuoteMode = m
+	return nil
+}
+
+func (f *Flags) SetExcelSafe(b bool) {
+	f.ExportOptions.ExcelSafe = b
+}
+
+func (f *Flags) SetExcelSafePrefix(s string) error {
+	p, err := ParseExcelSafePrefix(s)
+	if err != nil {
+		return err
+	}
+
+	f.ExportOptions.ExcelSafePrefix = p
+	return nil
+}
+
 func (f *Flags) SetColor(b bool) {
 	f.ExportOptions.Color = b
 }
@@ -589,3 +1318,8 @@ func (f *Flags) SetCPU(i int) {
 func (f *Flags) SetStats(b bool) {
 	f.Stats = b
 }
+
+func (f *Flags) SetNow(t time.Time) {
+	f.Now = t
+	setFixedTime(t)
+}
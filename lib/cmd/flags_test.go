@@ -1,10 +1,12 @@
 package cmd
 
 import (
+	"fmt"
 	"path/filepath"
 	"reflect"
 	"runtime"
 	"testing"
+	"time"
 
 	"github.com/mithrandie/go-text"
 	"github.com/mithrandie/go-text/json"
@@ -88,6 +90,18 @@ func TestFlags_SetLocation(t *testing.T) {
 	if flags.Location != "UTC" {
 		t.Errorf("location = %s, expect to set %s for %q", flags.Location, "UTC", s)
 	}
+	if GetLocation() != time.UTC {
+		t.Errorf("GetLocation() = %s, expect to return %s for %q", GetLocation(), time.UTC, s)
+	}
+
+	s = "Asia/Tokyo"
+	_ = flags.SetLocation(s)
+	if GetLocation().String() != "Asia/Tokyo" {
+		t.Errorf("GetLocation() = %s, expect to return %s for %q", GetLocation(), "Asia/Tokyo", s)
+	}
+	if _, offset := Now().Zone(); TestTime.IsZero() && offset != 9*60*60 {
+		t.Errorf("Now() offset = %d, expect %d for %q", offset, 9*60*60, s)
+	}
 
 	s = "America/NotExist"
 	expectErr := "timezone \"America/NotExist\" does not exist"
@@ -97,6 +111,11 @@ func TestFlags_SetLocation(t *testing.T) {
 	} else if err.Error() != expectErr {
 		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, s)
 	}
+	if GetLocation().String() != "Asia/Tokyo" {
+		t.Errorf("GetLocation() = %s, expect not to be changed by an invalid timezone", GetLocation())
+	}
+
+	_ = flags.SetLocation("Local")
 }
 
 func TestFlags_SetDatetimeFormat(t *testing.T) {
@@ -140,6 +159,83 @@ func TestFlags_SetAnsiQuotes(t *testing.T) {
 	}
 }
 
+func TestFlags_SetNullsOrder(t *testing.T) {
+	flags := NewFlags(nil)
+
+	_ = flags.SetNullsOrder("last")
+	if flags.NullsOrder != NullsLast {
+		t.Errorf("nulls order = %s, expect to set %s", flags.NullsOrder, NullsLast)
+	}
+
+	_ = flags.SetNullsOrder("default")
+	if flags.NullsOrder != DefaultNullsOrder {
+		t.Errorf("nulls order = %s, expect to set %s", flags.NullsOrder, DefaultNullsOrder)
+	}
+
+	s := "middle"
+	expectErr := "nulls order must be one of DEFAULT|FIRST|LAST"
+	err := flags.SetNullsOrder(s)
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, s)
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, s)
+	}
+}
+
+func TestFlags_SetStableSort(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetStableSort(false)
+	if flags.StableSort {
+		t.Errorf("stable_sort = %t, expect to set %t", flags.StableSort, false)
+	}
+}
+
+func TestFlags_SetConcatNullAsEmpty(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetConcatNullAsEmpty(true)
+	if !flags.ConcatNullAsEmpty {
+		t.Errorf("concat_null_as_empty = %t, expect to set %t", flags.ConcatNullAsEmpty, true)
+	}
+}
+
+func TestFlags_SetAppendCommit(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetAppendCommit(true)
+	if !flags.AppendCommit {
+		t.Errorf("append_commit = %t, expect to set %t", flags.AppendCommit, true)
+	}
+}
+
+func TestFlags_SetAtomicWrite(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetAtomicWrite(false)
+	if flags.AtomicWrite {
+		t.Errorf("atomic_write = %t, expect to set %t", flags.AtomicWrite, false)
+	}
+}
+
+func TestFlags_SetDryRun(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetDryRun(true)
+	if !flags.DryRun {
+		t.Errorf("dry_run = %t, expect to set %t", flags.DryRun, true)
+	}
+}
+
+func TestFlags_SetIgnoreConstraints(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetIgnoreConstraints(true)
+	if !flags.IgnoreConstraints {
+		t.Errorf("ignore_constraints = %t, expect to set %t", flags.IgnoreConstraints, true)
+	}
+}
+
 func TestFlags_SetWaitTimeout(t *testing.T) {
 	flags := NewFlags(nil)
 
@@ -156,6 +252,57 @@ func TestFlags_SetWaitTimeout(t *testing.T) {
 	}
 }
 
+func TestFlags_SetHttpTimeout(t *testing.T) {
+	flags := NewFlags(nil)
+
+	var f float64 = -1
+	flags.SetHttpTimeout(f)
+	if flags.HttpTimeout != 0 {
+		t.Errorf("http timeout = %f, expect to set %f for %f", flags.HttpTimeout, 0.0, f)
+	}
+
+	f = 5
+	flags.SetHttpTimeout(f)
+	if flags.HttpTimeout != 5 {
+		t.Errorf("http timeout = %f, expect to set %f for %f", flags.HttpTimeout, 5.0, f)
+	}
+}
+
+func TestFlags_SetHttpMaxSize(t *testing.T) {
+	flags := NewFlags(nil)
+
+	var i int64 = -1
+	flags.SetHttpMaxSize(i)
+	if flags.HttpMaxSize != 0 {
+		t.Errorf("http max size = %d, expect to set %d for %d", flags.HttpMaxSize, 0, i)
+	}
+
+	i = 1024
+	flags.SetHttpMaxSize(i)
+	if flags.HttpMaxSize != 1024 {
+		t.Errorf("http max size = %d, expect to set %d for %d", flags.HttpMaxSize, 1024, i)
+	}
+}
+
+func TestFlags_SetHttpHeaders(t *testing.T) {
+	flags := NewFlags(nil)
+
+	s := "{\"Authorization\": \"Bearer token\"}"
+	_ = flags.SetHttpHeaders(s)
+	if flags.HttpHeaders != s {
+		t.Errorf("http headers = %q, expect to set %q", flags.HttpHeaders, s)
+	}
+
+	s = "Authorization: Bearer token"
+	expectErr := "http headers must be a JSON object of strings"
+	err := flags.SetHttpHeaders(s)
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, s)
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, s)
+	}
+}
+
 func TestFlags_SetImportFormat(t *testing.T) {
 	flags := NewFlags(nil)
 
@@ -169,7 +316,7 @@ func TestFlags_SetImportFormat(t *testing.T) {
 		t.Errorf("importFormat = %s, expect to set %s for empty string", flags.ImportOptions.Format, JSON)
 	}
 
-	expectErr := "import format must be one of CSV|TSV|FIXED|JSON|LTSV"
+	expectErr := "import format must be one of CSV|TSV|FIXED|JSON|NDJSON|LTSV"
 	err := flags.SetImportFormat("error")
 	if err == nil {
 		t.Errorf("no error, want error %q for %s", expectErr, "error")
@@ -198,20 +345,42 @@ func TestFlags_SetDelimiter(t *testing.T) {
 		t.Errorf("delimiter = %q, expect to set %q for %q", flags.ImportOptions.Delimiter, "\t", "\t")
 	}
 
-	expectErr := "delimiter must be one character"
-	err := flags.SetDelimiter("[a]")
-	if err == nil {
-		t.Errorf("no error, want error %q for %s", expectErr, "//")
-	} else if err.Error() != expectErr {
-		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "//")
+	_ = flags.SetDelimiter("||")
+	if flags.ImportOptions.MultiCharDelimiter != "||" {
+		t.Errorf("multi-char delimiter = %q, expect to set %q for %q", flags.ImportOptions.MultiCharDelimiter, "||", "||")
+	}
+	if flags.ImportOptions.Delimiter != '\t' {
+		t.Errorf("delimiter = %q, expect to keep %q for %q", flags.ImportOptions.Delimiter, '\t', "||")
 	}
 
-	expectErr = "delimiter must be one character"
-	err = flags.SetDelimiter("//")
+	_ = flags.SetDelimiter(";")
+	if flags.ImportOptions.Delimiter != ';' {
+		t.Errorf("delimiter = %q, expect to set %q for %q", flags.ImportOptions.Delimiter, ';', ";")
+	}
+	if flags.ImportOptions.MultiCharDelimiter != "" {
+		t.Errorf("multi-char delimiter = %q, expect to set %q for %q", flags.ImportOptions.MultiCharDelimiter, "", ";")
+	}
+}
+
+func TestFlags_SetDelimiterRegex(t *testing.T) {
+	flags := NewFlags(nil)
+
+	_ = flags.SetDelimiterRegex("\\s*;\\s*")
+	if flags.ImportOptions.DelimiterRegex != "\\s*;\\s*" {
+		t.Errorf("delimiter-regex = %q, expect to set %q", flags.ImportOptions.DelimiterRegex, "\\s*;\\s*")
+	}
+
+	_ = flags.SetDelimiterRegex("")
+	if flags.ImportOptions.DelimiterRegex != "" {
+		t.Errorf("delimiter-regex = %q, expect to set %q for %q", flags.ImportOptions.DelimiterRegex, "", "")
+	}
+
+	expectErr := "delimiter-regex must be a valid regular expression"
+	err := flags.SetDelimiterRegex("[a")
 	if err == nil {
-		t.Errorf("no error, want error %q for %s", expectErr, "//")
+		t.Errorf("no error, want error %q for %s", expectErr, "[a")
 	} else if err.Error() != expectErr {
-		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "//")
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "[a")
 	}
 }
 
@@ -239,6 +408,14 @@ func TestFlags_SetDelimiterPositions(t *testing.T) {
 		t.Errorf("delimitPositions = %v, expect to set %v for %q", flags.ImportOptions.DelimiterPositions, []int{1, 2, 3}, "[1, 2, 3]")
 	}
 
+	_ = flags.SetDelimiterPositions("id 1 2, name 3 4")
+	if !reflect.DeepEqual(flags.ImportOptions.DelimiterPositions, []int{2, 6}) {
+		t.Errorf("delimitPositions = %v, expect to set %v for %q", flags.ImportOptions.DelimiterPositions, []int{2, 6}, "id 1 2, name 3 4")
+	}
+	if !reflect.DeepEqual(flags.ImportOptions.FieldNames, []string{"id", "name"}) {
+		t.Errorf("fieldNames = %v, expect to set %v for %q", flags.ImportOptions.FieldNames, []string{"id", "name"}, "id 1 2, name 3 4")
+	}
+
 	_ = flags.SetDelimiterPositions("spaces")
 	if flags.ImportOptions.SingleLine != false {
 		t.Errorf("singleLine = %t, expect to set %t for %q", flags.ImportOptions.SingleLine, false, "spaces")
@@ -247,7 +424,7 @@ func TestFlags_SetDelimiterPositions(t *testing.T) {
 		t.Errorf("delimitPositions = %v, expect to set %v for %q", flags.ImportOptions.DelimiterPositions, nil, "spaces")
 	}
 
-	expectErr := "delimiter positions must be \"SPACES\" or a JSON array of integers"
+	expectErr := "delimiter positions must be \"SPACES\", a JSON array of integers or field positions"
 	err := flags.SetDelimiterPositions("[a]")
 	if err == nil {
 		t.Errorf("no error, want error %q for %s", expectErr, "//")
@@ -263,6 +440,61 @@ func TestFlags_SetDelimiterPositions(t *testing.T) {
 	}
 }
 
+func TestFlags_SetQuoteChar(t *testing.T) {
+	flags := NewFlags(nil)
+
+	_ = flags.SetQuoteChar("'")
+	if flags.ImportOptions.QuoteChar != '\'' {
+		t.Errorf("quote char = %q, expect to set %q", flags.ImportOptions.QuoteChar, '\'')
+	}
+
+	_ = flags.SetQuoteChar("")
+	if flags.ImportOptions.QuoteChar != 0 {
+		t.Errorf("quote char = %q, expect to set %q", flags.ImportOptions.QuoteChar, 0)
+	}
+
+	s := "\\n"
+	expectErr := "quote character must not be a line break"
+	err := flags.SetQuoteChar(s)
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, s)
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, s)
+	}
+
+	s = "ab"
+	expectErr = "quote character must be one character or empty"
+	err = flags.SetQuoteChar(s)
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, s)
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, s)
+	}
+}
+
+func TestFlags_SetEscapeStyle(t *testing.T) {
+	flags := NewFlags(nil)
+
+	_ = flags.SetEscapeStyle("backslash")
+	if flags.ImportOptions.EscapeStyle != BackslashEscape {
+		t.Errorf("escape style = %s, expect to set %s", flags.ImportOptions.EscapeStyle, BackslashEscape)
+	}
+
+	_ = flags.SetEscapeStyle("none")
+	if flags.ImportOptions.EscapeStyle != NoEscape {
+		t.Errorf("escape style = %s, expect to set %s", flags.ImportOptions.EscapeStyle, NoEscape)
+	}
+
+	s := "single"
+	expectErr := "escape style must be one of DOUBLE|BACKSLASH|NONE"
+	err := flags.SetEscapeStyle(s)
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, s)
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, s)
+	}
+}
+
 func TestFlags_SetJsonQuery(t *testing.T) {
 	flags := NewFlags(nil)
 
@@ -280,7 +512,7 @@ func TestFlags_SetEncoding(t *testing.T) {
 		t.Errorf("encoding = %s, expect to set %s for %s", flags.ImportOptions.Encoding, text.SJIS, "sjis")
 	}
 
-	expectErr := "encoding must be one of AUTO|UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS"
+	expectErr := "encoding must be one of AUTO|UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS|EUCJP"
 	err := flags.SetEncoding("error")
 	if err == nil {
 		t.Errorf("no error, want error %q for %s", expectErr, "error")
@@ -307,6 +539,200 @@ func TestFlags_SetWithoutNull(t *testing.T) {
 	}
 }
 
+func TestFlags_SetColumnTypes(t *testing.T) {
+	flags := NewFlags(nil)
+
+	_ = flags.SetColumnTypes("zip:string, amount : Float")
+	expect := ColumnTypes{
+		{Column: "zip", Type: "STRING"},
+		{Column: "amount", Type: "FLOAT"},
+	}
+	if !reflect.DeepEqual(flags.ImportOptions.ColumnTypes, expect) {
+		t.Errorf("column-types = %v, expect to set %v for %s", flags.ImportOptions.ColumnTypes, expect, "zip:string, amount : Float")
+	}
+
+	_ = flags.SetColumnTypes("")
+	if flags.ImportOptions.ColumnTypes != nil {
+		t.Errorf("column-types = %v, expect to set %v for empty string", flags.ImportOptions.ColumnTypes, nil)
+	}
+
+	expectErr := "column type must be one of STRING|INTEGER|FLOAT|BOOLEAN|TERNARY|DATETIME"
+	err := flags.SetColumnTypes("zip:error")
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, "zip:error")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "zip:error")
+	}
+
+	expectErr = "column-types must be a comma-separated list of column:type"
+	err = flags.SetColumnTypes("zip")
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, "zip")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "zip")
+	}
+
+	_ = flags.SetColumnTypes("zip:string,order_date:datetime(%H:%M %d/%m/%Y)")
+	expect = ColumnTypes{
+		{Column: "zip", Type: "STRING"},
+		{Column: "order_date", Type: "DATETIME", Format: "%H:%M %d/%m/%Y"},
+	}
+	if !reflect.DeepEqual(flags.ImportOptions.ColumnTypes, expect) {
+		t.Errorf("column-types = %v, expect to set %v for %s", flags.ImportOptions.ColumnTypes, expect, "zip:string,order_date:datetime(%H:%M %d/%m/%Y)")
+	}
+	if flags.ImportOptions.ColumnTypes.String() != "zip:STRING,order_date:DATETIME(%H:%M %d/%m/%Y)" {
+		t.Errorf("column-types string = %q, expect %q", flags.ImportOptions.ColumnTypes.String(), "zip:STRING,order_date:DATETIME(%H:%M %d/%m/%Y)")
+	}
+
+	expectErr = "format cannot be specified for column type INTEGER"
+	err = flags.SetColumnTypes("qty:integer(%d)")
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, "qty:integer(%d)")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "qty:integer(%d)")
+	}
+}
+
+func TestFlags_SetSchema(t *testing.T) {
+	flags := NewFlags(nil)
+
+	path := filepath.Join(TestDir, "schema.json")
+	_ = flags.SetSchema(path)
+	expect := ColumnTypes{
+		{Column: "zip", Type: "STRING"},
+		{Column: "order_date", Type: "DATETIME", Format: "%m/%d/%Y"},
+		{Column: "qty", Type: "INTEGER"},
+	}
+	if !reflect.DeepEqual(flags.ImportOptions.ColumnTypes, expect) {
+		t.Errorf("column-types = %v, expect to set %v for %s", flags.ImportOptions.ColumnTypes, expect, path)
+	}
+
+	path = filepath.Join(TestDir, "table1.csv")
+	expectErr := fmt.Sprintf("failed to load %q: schema must be a JSON array of objects with column, type and format", path)
+	err := flags.SetSchema(path)
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, path)
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, path)
+	}
+}
+
+func TestFlags_SetStrictColumnTypes(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetStrictColumnTypes(true)
+	if !flags.ImportOptions.StrictColumnTypes {
+		t.Errorf("strict-column-types = %t, expect to set %t", flags.ImportOptions.StrictColumnTypes, true)
+	}
+}
+
+func TestFlags_SetStrictGlobHeader(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetStrictGlobHeader(true)
+	if !flags.ImportOptions.StrictGlobHeader {
+		t.Errorf("strict-glob-header = %t, expect to set %t", flags.ImportOptions.StrictGlobHeader, true)
+	}
+}
+
+func TestFlags_SetNoInfer(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetNoInfer(true)
+	if !flags.ImportOptions.NoInfer {
+		t.Errorf("no-infer = %t, expect to set %t", flags.ImportOptions.NoInfer, true)
+	}
+}
+
+func TestFlags_SetSkipLines(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetSkipLines(2)
+	if flags.ImportOptions.SkipLines != 2 {
+		t.Errorf("skip-lines = %d, expect to set %d for %d", flags.ImportOptions.SkipLines, 2, 2)
+	}
+
+	flags.SetSkipLines(-1)
+	if flags.ImportOptions.SkipLines != 0 {
+		t.Errorf("skip-lines = %d, expect to set %d for %d", flags.ImportOptions.SkipLines, 0, -1)
+	}
+}
+
+func TestFlags_SetCommentPrefix(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetCommentPrefix("#")
+	if flags.ImportOptions.CommentPrefix != "#" {
+		t.Errorf("comment-prefix = %q, expect to set %q", flags.ImportOptions.CommentPrefix, "#")
+	}
+}
+
+func TestFlags_SetSkipAllComments(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetSkipAllComments(true)
+	if !flags.ImportOptions.SkipAllComments {
+		t.Errorf("skip-all-comments = %t, expect to set %t", flags.ImportOptions.SkipAllComments, true)
+	}
+}
+
+func TestFlags_SetTrim(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetTrim(true)
+	if !flags.ImportOptions.Trim {
+		t.Errorf("trim = %t, expect to set %t", flags.ImportOptions.Trim, true)
+	}
+}
+
+func TestFlags_SetDetect(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetDetect(true)
+	if !flags.ImportOptions.Detect {
+		t.Errorf("detect = %t, expect to set %t", flags.ImportOptions.Detect, true)
+	}
+}
+
+func TestFlags_SetRagged(t *testing.T) {
+	flags := NewFlags(nil)
+
+	_ = flags.SetRagged("pad")
+	if flags.ImportOptions.Ragged != RaggedPad {
+		t.Errorf("ragged = %s, expect to set %s", flags.ImportOptions.Ragged, RaggedPad)
+	}
+
+	_ = flags.SetRagged("SKIP")
+	if flags.ImportOptions.Ragged != RaggedSkip {
+		t.Errorf("ragged = %s, expect to set %s", flags.ImportOptions.Ragged, RaggedSkip)
+	}
+
+	s := "fill"
+	expectErr := "ragged must be one of ERROR|PAD|TRUNCATE|SKIP"
+	err := flags.SetRagged(s)
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, s)
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, s)
+	}
+}
+
+func TestFlags_SetTee(t *testing.T) {
+	flags := NewFlags(nil)
+
+	path := filepath.Join("out", "tee.csv")
+	abspath, _ := filepath.Abs(path)
+	flags.SetTee(path)
+	if flags.Tee != abspath {
+		t.Errorf("tee = %s, expect to set %s for %s", flags.Tee, abspath, path)
+	}
+
+	flags.SetTee("")
+	if flags.Tee != "" {
+		t.Errorf("tee = %s, expect to set %q for %q", flags.Tee, "", "")
+	}
+}
+
 func TestFlags_SetFormat(t *testing.T) {
 	flags := NewFlags(nil)
 
@@ -325,6 +751,11 @@ func TestFlags_SetFormat(t *testing.T) {
 		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.ExportOptions.Format, TSV, "foo.tsv")
 	}
 
+	_ = flags.SetFormat("", "foo.csv.gz")
+	if flags.ExportOptions.Format != CSV {
+		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.ExportOptions.Format, CSV, "foo.csv.gz")
+	}
+
 	_ = flags.SetFormat("", "foo.json")
 	if flags.ExportOptions.Format != JSON {
 		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.ExportOptions.Format, JSON, "foo.json")
@@ -365,6 +796,16 @@ func TestFlags_SetFormat(t *testing.T) {
 		t.Errorf("format = %s, expect to set %s for %s", flags.ExportOptions.Format, JSON, "json")
 	}
 
+	_ = flags.SetFormat("ndjson", "")
+	if flags.ExportOptions.Format != NDJSON {
+		t.Errorf("format = %s, expect to set %s for %s", flags.ExportOptions.Format, NDJSON, "ndjson")
+	}
+
+	_ = flags.SetFormat("jsonl", "")
+	if flags.ExportOptions.Format != NDJSON {
+		t.Errorf("format = %s, expect to set %s for %s", flags.ExportOptions.Format, NDJSON, "jsonl")
+	}
+
 	_ = flags.SetFormat("ltsv", "")
 	if flags.ExportOptions.Format != LTSV {
 		t.Errorf("format = %s, expect to set %s for %s", flags.ExportOptions.Format, LTSV, "ltsv")
@@ -401,7 +842,7 @@ func TestFlags_SetFormat(t *testing.T) {
 		t.Errorf("format = %s, expect to set %s for %s", flags.ExportOptions.Format, TEXT, "text")
 	}
 
-	expectErr := "format must be one of CSV|TSV|FIXED|JSON|LTSV|GFM|ORG|TEXT"
+	expectErr := "format must be one of CSV|TSV|FIXED|JSON|NDJSON|LTSV|GFM|ORG|TEXT|HTML|XML|VERTICAL|SQL_INSERT|TEMPLATE|PARQUET"
 	err := flags.SetFormat("error", "")
 	if err == nil {
 		t.Errorf("no error, want error %q for %s", expectErr, "error")
@@ -418,7 +859,7 @@ func TestFlags_SetWriteEncoding(t *testing.T) {
 		t.Errorf("encoding = %s, expect to set %s for %s", flags.ExportOptions.Encoding, text.SJIS, "sjis")
 	}
 
-	expectErr := "write-encoding must be one of UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS"
+	expectErr := "write-encoding must be one of UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS|EUCJP"
 	err := flags.SetWriteEncoding("error")
 	if err == nil {
 		t.Errorf("no error, want error %q for %s", expectErr, "error")
@@ -486,6 +927,15 @@ func TestFlags_SetWithoutHeader(t *testing.T) {
 	}
 }
 
+func TestFlags_SetTypedHeader(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetTypedHeader(true)
+	if !flags.ExportOptions.TypedHeader {
+		t.Errorf("typed-header = %t, expect to set %t", flags.ExportOptions.TypedHeader, true)
+	}
+}
+
 func TestFlags_SetLineBreak(t *testing.T) {
 	flags := NewFlags(nil)
 
@@ -527,6 +977,69 @@ func TestFlags_SetEncloseAll(t *testing.T) {
 	}
 }
 
+func TestFlags_SetWriteBOM(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetWriteBOM(true)
+	if !flags.ExportOptions.WriteBOM {
+		t.Errorf("write-bom = %t, expect to set %t", flags.ExportOptions.WriteBOM, true)
+	}
+}
+
+func TestFlags_SetReplaceUnencodable(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetReplaceUnencodable(true)
+	if !flags.ExportOptions.ReplaceUnencodable {
+		t.Errorf("replace-unencodable = %t, expect to set %t", flags.ExportOptions.ReplaceUnencodable, true)
+	}
+}
+
+func TestFlags_SetQuoteMode(t *testing.T) {
+	flags := NewFlags(nil)
+
+	_ = flags.SetQuoteMode("non_numeric")
+	if flags.ExportOptions.QuoteMode != NonNumericQuote {
+		t.Errorf("quote mode = %s, expect to set %s", flags.ExportOptions.QuoteMode, NonNumericQuote)
+	}
+
+	s := "some"
+	expectErr := "quote mode must be one of MINIMAL|ALL|NON_NUMERIC|NONE"
+	err := flags.SetQuoteMode(s)
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, s)
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, s)
+	}
+}
+
+func TestFlags_SetExcelSafe(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetExcelSafe(true)
+	if !flags.ExportOptions.ExcelSafe {
+		t.Errorf("excel-safe = %t, expect to set %t", flags.ExportOptions.ExcelSafe, true)
+	}
+}
+
+func TestFlags_SetExcelSafePrefix(t *testing.T) {
+	flags := NewFlags(nil)
+
+	_ = flags.SetExcelSafePrefix("tab")
+	if flags.ExportOptions.ExcelSafePrefix != TabPrefix {
+		t.Errorf("excel-safe-prefix = %s, expect to set %s", flags.ExportOptions.ExcelSafePrefix, TabPrefix)
+	}
+
+	s := "some"
+	expectErr := "excel safe prefix must be one of QUOTE|TAB"
+	err := flags.SetExcelSafePrefix(s)
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, s)
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, s)
+	}
+}
+
 func TestFlags_SetJsonEscape(t *testing.T) {
 	flags := NewFlags(nil)
 
@@ -567,6 +1080,174 @@ func TestFlags_SetPrettyPrint(t *testing.T) {
 	}
 }
 
+func TestFlags_SetJsonKey(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetJsonKey("id")
+	if flags.ExportOptions.JsonKey != "id" {
+		t.Errorf("json-key = %q, expect to set %q", flags.ExportOptions.JsonKey, "id")
+	}
+}
+
+func TestFlags_SetJsonKeyLast(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetJsonKeyLast(true)
+	if !flags.ExportOptions.JsonKeyLast {
+		t.Errorf("json-key-last = %t, expect to set %t", flags.ExportOptions.JsonKeyLast, true)
+	}
+}
+
+func TestFlags_SetJsonFlat(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetJsonFlat(true)
+	if !flags.ExportOptions.JsonFlat {
+		t.Errorf("json-flat = %t, expect to set %t", flags.ExportOptions.JsonFlat, true)
+	}
+}
+
+func TestFlags_SetNullText(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetNullText("NULL")
+	if flags.ExportOptions.NullText != "NULL" {
+		t.Errorf("null-text = %q, expect to set %q", flags.ExportOptions.NullText, "NULL")
+	}
+}
+
+func TestFlags_SetHtmlClass(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetHtmlClass("report")
+	if flags.ExportOptions.HtmlClass != "report" {
+		t.Errorf("html-class = %q, expect to set %q", flags.ExportOptions.HtmlClass, "report")
+	}
+}
+
+func TestFlags_SetXmlAttributes(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetXmlAttributes(true)
+	if !flags.ExportOptions.XmlAttributes {
+		t.Errorf("xml-attributes = %t, expect to set %t", flags.ExportOptions.XmlAttributes, true)
+	}
+}
+
+func TestFlags_SetMaxColumnWidth(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetMaxColumnWidth(20)
+	if flags.ExportOptions.MaxColumnWidth != 20 {
+		t.Errorf("max-column-width = %d, expect to set %d", flags.ExportOptions.MaxColumnWidth, 20)
+	}
+
+	flags.SetMaxColumnWidth(-1)
+	if flags.ExportOptions.MaxColumnWidth != 0 {
+		t.Errorf("max-column-width = %d, expect to set %d", flags.ExportOptions.MaxColumnWidth, 0)
+	}
+}
+
+func TestFlags_SetWrapText(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetWrapText(true)
+	if !flags.ExportOptions.WrapText {
+		t.Errorf("wrap-text = %t, expect to set %t", flags.ExportOptions.WrapText, true)
+	}
+}
+
+func TestFlags_SetBoxDrawing(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetBoxDrawing(true)
+	if !flags.ExportOptions.BoxDrawing {
+		t.Errorf("box-drawing = %t, expect to set %t", flags.ExportOptions.BoxDrawing, true)
+	}
+}
+
+func TestFlags_SetTableName(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetTableName("target")
+	if flags.ExportOptions.TableName != "target" {
+		t.Errorf("table-name = %q, expect to set %q", flags.ExportOptions.TableName, "target")
+	}
+}
+
+func TestFlags_SetInsertBatchSize(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetInsertBatchSize(100)
+	if flags.ExportOptions.InsertBatchSize != 100 {
+		t.Errorf("insert-batch-size = %d, expect to set %d", flags.ExportOptions.InsertBatchSize, 100)
+	}
+
+	flags.SetInsertBatchSize(0)
+	if flags.ExportOptions.InsertBatchSize != 1 {
+		t.Errorf("insert-batch-size = %d, expect to set %d", flags.ExportOptions.InsertBatchSize, 1)
+	}
+}
+
+func TestFlags_SetTemplate(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetTemplate("")
+	if flags.ExportOptions.Template != "" {
+		t.Errorf("template = %q, expect to set %q", flags.ExportOptions.Template, "")
+	}
+
+	flags.SetTemplate("/path/to/report.tmpl")
+	if flags.ExportOptions.Template != "/path/to/report.tmpl" {
+		t.Errorf("template = %q, expect to set %q", flags.ExportOptions.Template, "/path/to/report.tmpl")
+	}
+}
+
+func TestFlags_SetIdentifierQuote(t *testing.T) {
+	flags := NewFlags(nil)
+
+	_ = flags.SetIdentifierQuote("backtick")
+	if flags.ExportOptions.IdentifierQuote != BacktickIdentifier {
+		t.Errorf("identifier-quote = %s, expect to set %s", flags.ExportOptions.IdentifierQuote, BacktickIdentifier)
+	}
+
+	s := "some"
+	expectErr := "identifier quote must be one of DOUBLE|BACKTICK"
+	err := flags.SetIdentifierQuote(s)
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, s)
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, s)
+	}
+}
+
+func TestFlags_SetParquetCompression(t *testing.T) {
+	flags := NewFlags(nil)
+
+	_ = flags.SetParquetCompression("zstd")
+	if flags.ExportOptions.ParquetCompression != ZstdParquetCompression {
+		t.Errorf("parquet-compression = %s, expect to set %s", flags.ExportOptions.ParquetCompression, ZstdParquetCompression)
+	}
+
+	s := "gzip"
+	expectErr := "parquet compression must be one of SNAPPY|ZSTD|NONE"
+	err := flags.SetParquetCompression(s)
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, s)
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, s)
+	}
+}
+
+func TestFlags_SetParquetAllStrings(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetParquetAllStrings(true)
+	if !flags.ExportOptions.ParquetAllStrings {
+		t.Errorf("parquet-all-strings = %t, expect to set %t", flags.ExportOptions.ParquetAllStrings, true)
+	}
+}
+
 func TestFlags_SetStripEndingLineBreak(t *testing.T) {
 	flags := NewFlags(nil)
 
@@ -637,6 +1318,48 @@ func TestFlags_SetLimitRecursion(t *testing.T) {
 	}
 }
 
+func TestFlags_SetCompression(t *testing.T) {
+	flags := NewFlags(nil)
+
+	_ = flags.SetCompression("gzip")
+	if flags.ExportOptions.Compression != GZIP {
+		t.Errorf("compression = %s, expect to set %s", flags.ExportOptions.Compression, GZIP)
+	}
+
+	_ = flags.SetCompression("none")
+	if flags.ExportOptions.Compression != NoCompression {
+		t.Errorf("compression = %s, expect to set %s", flags.ExportOptions.Compression, NoCompression)
+	}
+
+	s := "zip"
+	expectErr := "compression must be one of NONE|GZIP"
+	err := flags.SetCompression(s)
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, s)
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, s)
+	}
+}
+
+func TestFlags_SetCompressionLevel(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetCompressionLevel(9)
+	if flags.ExportOptions.CompressionLevel != 9 {
+		t.Errorf("compression-level = %d, expect to set %d", flags.ExportOptions.CompressionLevel, 9)
+	}
+
+	flags.SetCompressionLevel(0)
+	if flags.ExportOptions.CompressionLevel != MinCompressionLevel {
+		t.Errorf("compression-level = %d, expect to set %d", flags.ExportOptions.CompressionLevel, MinCompressionLevel)
+	}
+
+	flags.SetCompressionLevel(10)
+	if flags.ExportOptions.CompressionLevel != MaxCompressionLevel {
+		t.Errorf("compression-level = %d, expect to set %d", flags.ExportOptions.CompressionLevel, MaxCompressionLevel)
+	}
+}
+
 func TestFlags_SetCPU(t *testing.T) {
 	flags := NewFlags(nil)
 
@@ -660,3 +1383,24 @@ func TestFlags_SetStats(t *testing.T) {
 		t.Errorf("stats = %t, expect to set %t", flags.Stats, true)
 	}
 }
+
+func TestFlags_SetNow(t *testing.T) {
+	flags := NewFlags(nil)
+
+	fixed := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	flags.SetNow(fixed)
+	if !flags.Now.Equal(fixed) {
+		t.Errorf("now = %s, expect to set %s", flags.Now, fixed)
+	}
+	if !Now().Equal(fixed) {
+		t.Errorf("function Now() returns %s, expect to return %s", Now(), fixed)
+	}
+
+	flags.SetNow(time.Time{})
+	if !flags.Now.IsZero() {
+		t.Errorf("now = %s, expect to be reset", flags.Now)
+	}
+	if Now().Equal(fixed) {
+		t.Errorf("function Now() returns fixed time")
+	}
+}
@@ -34,6 +34,7 @@ func setup() {
 	}
 
 	_ = copyfile(filepath.Join(TestDir, "table1.csv"), filepath.Join(TestDataDir, "table1.csv"))
+	_ = copyfile(filepath.Join(TestDir, "schema.json"), filepath.Join(TestDataDir, "schema.json"))
 }
 
 func teardown() {
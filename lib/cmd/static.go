@@ -8,7 +8,12 @@ import (
 
 var (
 	TestTime time.Time // For Tests
-	location = time.Local
+
+	location      = time.Local
+	locationMutex = &sync.RWMutex{}
+
+	fixedTime      time.Time
+	fixedTimeMutex = &sync.RWMutex{}
 
 	random  *rand.Rand
 	getRand sync.Once
@@ -22,12 +27,37 @@ func GetRand() *rand.Rand {
 }
 
 func GetLocation() *time.Location {
+	locationMutex.RLock()
+	defer locationMutex.RUnlock()
+
 	return location
 }
 
+func setLocation(loc *time.Location) {
+	locationMutex.Lock()
+	location = loc
+	locationMutex.Unlock()
+}
+
+func setFixedTime(t time.Time) {
+	fixedTimeMutex.Lock()
+	fixedTime = t
+	fixedTimeMutex.Unlock()
+}
+
+func getFixedTime() time.Time {
+	fixedTimeMutex.RLock()
+	defer fixedTimeMutex.RUnlock()
+
+	return fixedTime
+}
+
 func Now() time.Time {
+	if t := getFixedTime(); !t.IsZero() {
+		return t
+	}
 	if !TestTime.IsZero() {
 		return TestTime
 	}
-	return time.Now().In(location)
+	return time.Now().In(GetLocation())
 }
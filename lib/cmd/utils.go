@@ -5,14 +5,26 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"path/filepath"
+	"regexp"
 	"strconv"
 	"strings"
 	"unicode"
 
 	"github.com/mithrandie/go-text"
 	txjson "github.com/mithrandie/go-text/json"
+	"golang.org/x/text/encoding/japanese"
 )
 
+// EUCJP is the character encoding EUC-JP.
+// The text library does not provide it, so texts in EUC-JP are transformed
+// from and to UTF-8 before they are passed to the library.
+const EUCJP = text.SJIS + 1
+
+func init() {
+	text.EncodingLiteral[EUCJP] = "EUCJP"
+}
+
 func EscapeString(s string) string {
 	runes := []rune(s)
 	var buf bytes.Buffer
@@ -288,13 +300,49 @@ func FormatNumber(f float64, precision int, decimalPoint string, thousandsSepara
 }
 
 func ParseEncoding(s string) (text.Encoding, error) {
+	if strings.EqualFold(s, EUCJP.String()) {
+		return EUCJP, nil
+	}
+
 	encoding, err := text.ParseEncoding(s)
 	if err != nil {
-		err = errors.New("encoding must be one of AUTO|UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS")
+		err = errors.New("encoding must be one of AUTO|UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS|EUCJP")
 	}
 	return encoding, err
 }
 
+// RuneByteSize returns the byte size of a character in the encoding.
+func RuneByteSize(r rune, enc text.Encoding) int {
+	if enc != EUCJP {
+		return text.RuneByteSize(r, enc)
+	}
+
+	switch {
+	case r < 0x80:
+		return 1
+	case 0xff61 <= r && r <= 0xff9f:
+		return 2
+	}
+	b, err := japanese.EUCJP.NewEncoder().String(string(r))
+	if err != nil {
+		return 1
+	}
+	return len(b)
+}
+
+// ByteSize returns the byte size of a string in the encoding.
+func ByteSize(s string, enc text.Encoding) int {
+	if enc != EUCJP {
+		return text.ByteSize(s, enc)
+	}
+
+	size := 0
+	for _, r := range s {
+		size = size + RuneByteSize(r, enc)
+	}
+	return size
+}
+
 func ParseLineBreak(s string) (text.LineBreak, error) {
 	lb, err := text.ParseLineBreak(s)
 	if err != nil {
@@ -310,7 +358,38 @@ func ParseDelimiter(s string) (rune, error) {
 	return r[0], nil
 }
 
+func ParseImportDelimiter(s string) (rune, string, error) {
+	s = UnescapeString(s, '\'')
+	r := []rune(s)
+	switch len(r) {
+	case 0:
+		return 0, "", errors.New("delimiter must not be empty")
+	case 1:
+		return r[0], "", nil
+	}
+	return 0, s, nil
+}
+
+func ValidateDelimiterRegex(s string) error {
+	if len(s) < 1 {
+		return nil
+	}
+	if _, err := regexp.Compile(s); err != nil {
+		return errors.New("delimiter-regex must be a valid regular expression")
+	}
+	return nil
+}
+
 func ParseDelimiterPositions(s string) ([]int, bool, error) {
+	delimiterPositions, _, singleLine, err := ParseDelimiterPositionsWithNames(s)
+	return delimiterPositions, singleLine, err
+}
+
+// ParseDelimiterPositionsWithNames parses delimiter positions in the same way
+// as ParseDelimiterPositions, and also accepts field positions in the form of
+// "name start length [, name start length ...]".
+// Field names are returned only when field positions are specified.
+func ParseDelimiterPositionsWithNames(s string) ([]int, []string, bool, error) {
 	s = UnescapeString(s, '\'')
 	var delimiterPositions []int = nil
 	singleLine := false
@@ -319,13 +398,126 @@ func ParseDelimiterPositions(s string) ([]int, bool, error) {
 		if strings.HasPrefix(s, "s[") || strings.HasPrefix(s, "S[") {
 			singleLine = true
 			s = s[1:]
+		} else if !strings.HasPrefix(TrimSpace(s), "[") && -1 < strings.IndexFunc(TrimSpace(s), unicode.IsSpace) {
+			delimiterPositions, names, err := parseFieldPositions(s)
+			return delimiterPositions, names, singleLine, err
 		}
 		err := json.Unmarshal([]byte(s), &delimiterPositions)
 		if err != nil {
-			return delimiterPositions, singleLine, errors.New(fmt.Sprintf("delimiter positions must be %q or a JSON array of integers", DelimitAutomatically))
+			return delimiterPositions, nil, singleLine, errors.New(fmt.Sprintf("delimiter positions must be %q, a JSON array of integers or field positions", DelimitAutomatically))
+		}
+	}
+	return delimiterPositions, nil, singleLine, nil
+}
+
+func parseFieldPositions(s string) ([]int, []string, error) {
+	items := strings.Split(s, ",")
+	delimiterPositions := make([]int, 0, len(items))
+	names := make([]string, 0, len(items))
+
+	end := 0
+	for _, item := range items {
+		words := strings.Fields(item)
+		if len(words) != 3 {
+			return nil, nil, errors.New(fmt.Sprintf("field position %q must be in the form of \"name start length\"", TrimSpace(item)))
+		}
+
+		start, err := strconv.Atoi(words[1])
+		if err != nil || start < 1 {
+			return nil, nil, errors.New(fmt.Sprintf("start of field %s must be a positive integer", words[0]))
+		}
+		length, err := strconv.Atoi(words[2])
+		if err != nil || length < 1 {
+			return nil, nil, errors.New(fmt.Sprintf("length of field %s must be a positive integer", words[0]))
+		}
+		if start != end+1 {
+			return nil, nil, errors.New(fmt.Sprintf("field %s must start at %d", words[0], end+1))
+		}
+
+		end = start + length - 1
+		delimiterPositions = append(delimiterPositions, end)
+		names = append(names, words[0])
+	}
+	return delimiterPositions, names, nil
+}
+
+func ParseColumnTypes(s string) (ColumnTypes, error) {
+	s = TrimSpace(s)
+	if len(s) < 1 {
+		return nil, nil
+	}
+
+	items := strings.Split(s, ",")
+	columnTypes := make(ColumnTypes, 0, len(items))
+	for _, item := range items {
+		format := ""
+		if p := strings.Index(item, "("); -1 < p && strings.HasSuffix(TrimSpace(item), ")") {
+			format = TrimSpace(item)
+			format = format[strings.Index(format, "(")+1 : len(format)-1]
+			item = item[:p]
+		}
+
+		idx := strings.LastIndex(item, ":")
+		if idx < 0 {
+			return nil, errors.New("column-types must be a comma-separated list of column:type")
+		}
+
+		column := TrimSpace(item[:idx])
+		if len(column) < 1 {
+			return nil, errors.New("column-types must be a comma-separated list of column:type")
+		}
+
+		ct, err := newColumnType(column, item[idx+1:], format)
+		if err != nil {
+			return nil, err
+		}
+		columnTypes = append(columnTypes, ct)
+	}
+	return columnTypes, nil
+}
+
+// ParseSchema parses a JSON array of objects that have the keys "column", "type" and "format".
+func ParseSchema(b []byte) (ColumnTypes, error) {
+	var items []struct {
+		Column string `json:"column"`
+		Type   string `json:"type"`
+		Format string `json:"format"`
+	}
+	if err := json.Unmarshal(b, &items); err != nil {
+		return nil, errors.New("schema must be a JSON array of objects with column, type and format")
+	}
+	if len(items) < 1 {
+		return nil, nil
+	}
+
+	columnTypes := make(ColumnTypes, 0, len(items))
+	for _, item := range items {
+		column := TrimSpace(item.Column)
+		if len(column) < 1 {
+			return nil, errors.New("column name must be specified in each object of schema")
+		}
+
+		ct, err := newColumnType(column, item.Type, item.Format)
+		if err != nil {
+			return nil, err
 		}
+		columnTypes = append(columnTypes, ct)
 	}
-	return delimiterPositions, singleLine, nil
+	return columnTypes, nil
+}
+
+func newColumnType(column string, typeName string, format string) (ColumnType, error) {
+	typeName = strings.ToUpper(TrimSpace(typeName))
+	switch typeName {
+	case "STRING", "INTEGER", "FLOAT", "BOOLEAN", "TERNARY", "DATETIME":
+	default:
+		return ColumnType{}, errors.New("column type must be one of STRING|INTEGER|FLOAT|BOOLEAN|TERNARY|DATETIME")
+	}
+	if 0 < len(format) && typeName != "DATETIME" {
+		return ColumnType{}, errors.New(fmt.Sprintf("format cannot be specified for column type %s", typeName))
+	}
+
+	return ColumnType{Column: column, Type: typeName, Format: format}, nil
 }
 
 func ParseFormat(s string, et txjson.EscapeType) (Format, txjson.EscapeType, error) {
@@ -339,6 +531,8 @@ func ParseFormat(s string, et txjson.EscapeType) (Format, txjson.EscapeType, err
 		fm = FIXED
 	case "JSON":
 		fm = JSON
+	case "NDJSON", "JSONL":
+		fm = NDJSON
 	case "LTSV":
 		fm = LTSV
 	case "GFM":
@@ -347,6 +541,18 @@ func ParseFormat(s string, et txjson.EscapeType) (Format, txjson.EscapeType, err
 		fm = ORG
 	case "TEXT":
 		fm = TEXT
+	case "HTML":
+		fm = HTML
+	case "XML":
+		fm = XML
+	case "VERTICAL":
+		fm = VERTICAL
+	case "SQL_INSERT":
+		fm = SQL_INSERT
+	case "TEMPLATE":
+		fm = TEMPLATE
+	case "PARQUET":
+		fm = PARQUET
 	case "JSONH":
 		fm = JSON
 		et = txjson.HexDigits
@@ -354,7 +560,7 @@ func ParseFormat(s string, et txjson.EscapeType) (Format, txjson.EscapeType, err
 		fm = JSON
 		et = txjson.AllWithHexDigits
 	default:
-		return fm, et, errors.New("format must be one of CSV|TSV|FIXED|JSON|LTSV|GFM|ORG|TEXT")
+		return fm, et, errors.New("format must be one of CSV|TSV|FIXED|JSON|NDJSON|LTSV|GFM|ORG|TEXT|HTML|XML|VERTICAL|SQL_INSERT|TEMPLATE|PARQUET")
 	}
 	return fm, et, nil
 }
@@ -374,6 +580,206 @@ func ParseJsonEscapeType(s string) (txjson.EscapeType, error) {
 	return escape, nil
 }
 
+func ParseCompression(s string) (Compression, error) {
+	var c Compression
+	switch strings.ToUpper(s) {
+	case "NONE":
+		c = NoCompression
+	case "GZIP":
+		c = GZIP
+	default:
+		return c, errors.New("compression must be one of NONE|GZIP")
+	}
+	return c, nil
+}
+
+// ParseQuoteChar parses the character used to enclose fields in CSV.
+// An empty string means that fields are not enclosed.
+func ParseQuoteChar(s string) (rune, error) {
+	r := []rune(s)
+	if 1 < len(r) {
+		r = []rune(UnescapeString(s, '\''))
+	}
+	switch len(r) {
+	case 0:
+		return 0, nil
+	case 1:
+		if r[0] == '\n' || r[0] == '\r' {
+			return 0, errors.New("quote character must not be a line break")
+		}
+		return r[0], nil
+	default:
+		return 0, errors.New("quote character must be one character or empty")
+	}
+}
+
+func ParseEscapeStyle(s string) (EscapeStyle, error) {
+	var e EscapeStyle
+	switch strings.ToUpper(s) {
+	case "DOUBLE":
+		e = DoubleEscape
+	case "BACKSLASH":
+		e = BackslashEscape
+	case "NONE":
+		e = NoEscape
+	default:
+		return e, errors.New("escape style must be one of DOUBLE|BACKSLASH|NONE")
+	}
+	return e, nil
+}
+
+func ParseRaggedMode(s string) (RaggedMode, error) {
+	var m RaggedMode
+	switch strings.ToUpper(s) {
+	case "ERROR":
+		m = RaggedError
+	case "PAD":
+		m = RaggedPad
+	case "TRUNCATE":
+		m = RaggedTruncate
+	case "SKIP":
+		m = RaggedSkip
+	default:
+		return m, errors.New("ragged must be one of ERROR|PAD|TRUNCATE|SKIP")
+	}
+	return m, nil
+}
+
+func ParseQuoteMode(s string) (QuoteMode, error) {
+	var m QuoteMode
+	switch strings.ToUpper(s) {
+	case "MINIMAL":
+		m = MinimalQuote
+	case "ALL":
+		m = AllQuote
+	case "NON_NUMERIC":
+		m = NonNumericQuote
+	case "NONE":
+		m = NoQuote
+	default:
+		return m, errors.New("quote mode must be one of MINIMAL|ALL|NON_NUMERIC|NONE")
+	}
+	return m, nil
+}
+
+func ParseExcelSafePrefix(s string) (ExcelSafePrefix, error) {
+	var p ExcelSafePrefix
+	switch strings.ToUpper(s) {
+	case "QUOTE":
+		p = QuotePrefix
+	case "TAB":
+		p = TabPrefix
+	default:
+		return p, errors.New("excel safe prefix must be one of QUOTE|TAB")
+	}
+	return p, nil
+}
+
+func ParseIdentifierQuote(s string) (IdentifierQuote, error) {
+	var q IdentifierQuote
+	switch strings.ToUpper(s) {
+	case "DOUBLE":
+		q = DoubleQuoteIdentifier
+	case "BACKTICK":
+		q = BacktickIdentifier
+	default:
+		return q, errors.New("identifier quote must be one of DOUBLE|BACKTICK")
+	}
+	return q, nil
+}
+
+func ParseParquetCompression(s string) (ParquetCompression, error) {
+	var c ParquetCompression
+	switch strings.ToUpper(s) {
+	case "SNAPPY":
+		c = SnappyParquetCompression
+	case "ZSTD":
+		c = ZstdParquetCompression
+	case "NONE":
+		c = NoParquetCompression
+	default:
+		return c, errors.New("parquet compression must be one of SNAPPY|ZSTD|NONE")
+	}
+	return c, nil
+}
+
+func ParseNullsOrder(s string) (NullsOrder, error) {
+	var o NullsOrder
+	switch strings.ToUpper(s) {
+	case "DEFAULT":
+		o = DefaultNullsOrder
+	case "FIRST":
+		o = NullsFirst
+	case "LAST":
+		o = NullsLast
+	default:
+		return o, errors.New("nulls order must be one of DEFAULT|FIRST|LAST")
+	}
+	return o, nil
+}
+
+// ParseHttpHeaders parses a JSON object of header names and values used
+// when fetching tables from HTTP(S) URLs.
+func ParseHttpHeaders(s string) (map[string]string, error) {
+	headers := make(map[string]string)
+	if len(s) < 1 {
+		return headers, nil
+	}
+	if err := json.Unmarshal([]byte(s), &headers); err != nil {
+		return nil, errors.New("http headers must be a JSON object of strings")
+	}
+	return headers, nil
+}
+
+// CompressionFromExt returns the compression algorithm indicated by the
+// extension of the file path.
+func CompressionFromExt(fpath string) Compression {
+	if strings.EqualFold(filepath.Ext(fpath), GzExt) {
+		return GZIP
+	}
+	return NoCompression
+}
+
+// FormatFromExt returns the format indicated by the extension of the file path.
+// The extension of the compression algorithm is ignored.
+func FormatFromExt(fpath string) (Format, bool) {
+	switch strings.ToLower(filepath.Ext(TrimCompressionExt(fpath))) {
+	case CsvExt:
+		return CSV, true
+	case TsvExt:
+		return TSV, true
+	case JsonExt:
+		return JSON, true
+	case NdjsonExt, JsonlExt:
+		return NDJSON, true
+	case LtsvExt:
+		return LTSV, true
+	case GfmExt:
+		return GFM, true
+	case OrgExt:
+		return ORG, true
+	case HtmlExt, HtmExt:
+		return HTML, true
+	case XmlExt:
+		return XML, true
+	case SqlExt:
+		return SQL_INSERT, true
+	case ParquetExt:
+		return PARQUET, true
+	}
+	return AutoSelect, false
+}
+
+// TrimCompressionExt removes the extension of the compression algorithm
+// from the file path so that the format can be detected by the remaining
+// extension.
+func TrimCompressionExt(fpath string) string {
+	if CompressionFromExt(fpath) != NoCompression {
+		return fpath[:len(fpath)-len(filepath.Ext(fpath))]
+	}
+	return fpath
+}
+
 func AppendStrIfNotExist(list []string, elem string) []string {
 	if len(elem) < 1 {
 		return list
@@ -215,7 +215,15 @@ func TestParseEncoding(t *testing.T) {
 		t.Errorf("encoding = %s, expect to set %s for %s", e, text.SJIS, "sjis")
 	}
 
-	expectErr := "encoding must be one of AUTO|UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS"
+	e, err = ParseEncoding("eucjp")
+	if err != nil {
+		t.Errorf("unexpected error: %q", err.Error())
+	}
+	if e != EUCJP {
+		t.Errorf("encoding = %s, expect to set %s for %s", e, EUCJP, "eucjp")
+	}
+
+	expectErr := "encoding must be one of AUTO|UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS|EUCJP"
 	_, err = ParseEncoding("error")
 	if err == nil {
 		t.Errorf("no error, want error %q for %s", expectErr, "error")
@@ -224,6 +232,37 @@ func TestParseEncoding(t *testing.T) {
 	}
 }
 
+var byteSizeTests = []struct {
+	Str      string
+	Encoding text.Encoding
+	Expect   int
+}{
+	{
+		Str:      "abc日本語",
+		Encoding: text.UTF8,
+		Expect:   12,
+	},
+	{
+		Str:      "abc日本語ｱ",
+		Encoding: EUCJP,
+		Expect:   11,
+	},
+	{
+		Str:      "丂",
+		Encoding: EUCJP,
+		Expect:   3,
+	},
+}
+
+func TestByteSize(t *testing.T) {
+	for _, v := range byteSizeTests {
+		result := ByteSize(v.Str, v.Encoding)
+		if result != v.Expect {
+			t.Errorf("result = %d, expect to return %d for %q in %s", result, v.Expect, v.Str, v.Encoding)
+		}
+	}
+}
+
 func TestParseDelimiter(t *testing.T) {
 	var s string
 
@@ -292,9 +331,19 @@ func TestParseDelimiterPositions(t *testing.T) {
 		t.Errorf("result = %v, %t, expect to set  %v, %t", p, sl, expectP, expectSL)
 	}
 
+	s = "id 1 8, name 9 30, amount 39 12"
+	expectP = []int{8, 38, 50}
+	expectSL = false
+	p, sl, err = ParseDelimiterPositions(s)
+	if err != nil {
+		t.Errorf("unexpected error: %q", err.Error())
+	} else if !reflect.DeepEqual(expectP, p) || expectSL != sl {
+		t.Errorf("result = %v, %t, expect to set  %v, %t", p, sl, expectP, expectSL)
+	}
+
 	s = ""
 	expectP = []int(nil)
-	expectErr := "delimiter positions must be \"SPACES\" or a JSON array of integers"
+	expectErr := "delimiter positions must be \"SPACES\", a JSON array of integers or field positions"
 	expectSL = false
 	p, sl, err = ParseDelimiterPositions(s)
 	if err == nil {
@@ -312,6 +361,61 @@ func TestParseDelimiterPositions(t *testing.T) {
 	}
 }
 
+var parseDelimiterPositionsWithNamesTests = []struct {
+	Input      string
+	Positions  []int
+	FieldNames []string
+	SingleLine bool
+	Error      string
+}{
+	{
+		Input:     "[1, 4, 6]",
+		Positions: []int{1, 4, 6},
+	},
+	{
+		Input:      "id 1 8, name 9 30, amount 39 12",
+		Positions:  []int{8, 38, 50},
+		FieldNames: []string{"id", "name", "amount"},
+	},
+	{
+		Input: "id 1 8, name 9",
+		Error: "field position \"name 9\" must be in the form of \"name start length\"",
+	},
+	{
+		Input: "id 0 8",
+		Error: "start of field id must be a positive integer",
+	},
+	{
+		Input: "id 1 a",
+		Error: "length of field id must be a positive integer",
+	},
+	{
+		Input: "id 1 8, name 10 30",
+		Error: "field name must start at 9",
+	},
+}
+
+func TestParseDelimiterPositionsWithNames(t *testing.T) {
+	for _, v := range parseDelimiterPositionsWithNamesTests {
+		p, names, sl, err := ParseDelimiterPositionsWithNames(v.Input)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Input, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Input, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Input, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(p, v.Positions) || !reflect.DeepEqual(names, v.FieldNames) || sl != v.SingleLine {
+			t.Errorf("%s: result = %v, %v, %t, want %v, %v, %t", v.Input, p, names, sl, v.Positions, v.FieldNames, v.SingleLine)
+		}
+	}
+}
+
 var unescapeStringBenchString = "fo\\o\\a\\b\\f\\n\\r\\t\\v\\\\\\\\'\\\"bar\\"
 var unescapeStringBenchString2 = "abcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyz"
 
@@ -326,3 +430,49 @@ func BenchmarkUnescapeString2(b *testing.B) {
 		_ = UnescapeString(unescapeStringBenchString2, '\'')
 	}
 }
+
+func TestTrimCompressionExt(t *testing.T) {
+	fpath := "/path/to/result.csv.GZ"
+	if c := CompressionFromExt(fpath); c != GZIP {
+		t.Errorf("compression = %s, want %s", c, GZIP)
+	}
+	expect := "/path/to/result.csv"
+	if s := TrimCompressionExt(fpath); s != expect {
+		t.Errorf("trimmed path = %q, want %q", s, expect)
+	}
+
+	fpath = "/path/to/result.csv"
+	if c := CompressionFromExt(fpath); c != NoCompression {
+		t.Errorf("compression = %s, want %s", c, NoCompression)
+	}
+	if s := TrimCompressionExt(fpath); s != fpath {
+		t.Errorf("trimmed path = %q, want %q", s, fpath)
+	}
+}
+
+func TestFormatFromExt(t *testing.T) {
+	fpath := "/path/to/result.jsonl.gz"
+	if f, ok := FormatFromExt(fpath); !ok || f != NDJSON {
+		t.Errorf("format = %s, %t, want %s, %t", f, ok, NDJSON, true)
+	}
+
+	fpath = "/path/to/result.htm"
+	if f, ok := FormatFromExt(fpath); !ok || f != HTML {
+		t.Errorf("format = %s, %t, want %s, %t", f, ok, HTML, true)
+	}
+
+	fpath = "/path/to/result.sql"
+	if f, ok := FormatFromExt(fpath); !ok || f != SQL_INSERT {
+		t.Errorf("format = %s, %t, want %s, %t", f, ok, SQL_INSERT, true)
+	}
+
+	fpath = "/path/to/result.parquet"
+	if f, ok := FormatFromExt(fpath); !ok || f != PARQUET {
+		t.Errorf("format = %s, %t, want %s, %t", f, ok, PARQUET, true)
+	}
+
+	fpath = "/path/to/result.txt"
+	if f, ok := FormatFromExt(fpath); ok || f != AutoSelect {
+		t.Errorf("format = %s, %t, want %s, %t", f, ok, AutoSelect, false)
+	}
+}
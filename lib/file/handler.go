@@ -3,6 +3,7 @@ package file
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"time"
 
@@ -92,7 +93,10 @@ type Handler struct {
 	lockFile  *mngFile
 	tempFile  *mngFile
 
-	closed bool
+	appending bool
+	inPlace   bool
+	removing  bool
+	closed    bool
 }
 
 func NewHandlerWithoutLock(ctx context.Context, container *Container, path string, defaultWaitTimeout time.Duration, retryDelay time.Duration) (*Handler, error) {
@@ -230,6 +234,29 @@ func (h *Handler) FileForUpdate() (*os.File, error) {
 	return nil, fmt.Errorf("file %s cannot be updated", h.path)
 }
 
+// FileForAppend returns the original file opened for update to append data to it.
+// The temporary file is discarded on commit, and the original file is kept.
+func (h *Handler) FileForAppend() (*os.File, error) {
+	if h.openType != ForUpdate {
+		return nil, fmt.Errorf("file %s cannot be appended", h.path)
+	}
+	h.appending = true
+	return h.fp, nil
+}
+
+// SetInPlace sets whether the contents of the temporary file are written over
+// the original file on commit instead of replacing the original file with the
+// temporary file by renaming it.
+func (h *Handler) SetInPlace(b bool) {
+	h.inPlace = b
+}
+
+// SetRemoval sets whether the original file opened for update is removed on
+// commit instead of being replaced with the temporary file.
+func (h *Handler) SetRemoval(b bool) {
+	h.removing = b
+}
+
 func (h *Handler) close() error {
 	if h.closed {
 		return nil
@@ -272,31 +299,40 @@ func (h *Handler) commit() error {
 		return nil
 	}
 
-	if h.fp != nil {
+	if h.openType == ForUpdate && h.removing {
 		if err := file.Close(h.fp); err != nil {
 			return err
 		}
 		h.fp = nil
-	}
 
-	if h.openType == ForUpdate {
-		if h.tempFile.fp != nil {
-			if err := file.Close(h.tempFile.fp); err != nil {
-				return err
-			}
-			h.tempFile.fp = nil
+		if err := h.tempFile.close(); err != nil {
+			return err
 		}
+		h.tempFile = nil
 
-		if Exists(h.path) {
-			if err := os.Remove(h.path); err != nil {
+		if err := os.Remove(h.path); err != nil {
+			return err
+		}
+	} else if h.openType == ForUpdate && !h.appending {
+		if h.inPlace {
+			if err := h.copyTempFile(); err != nil {
 				return err
 			}
-		}
-
-		if err := os.Rename(h.tempFile.path, h.path); err != nil {
+			if err := h.tempFile.close(); err != nil {
+				return err
+			}
+			h.tempFile = nil
+		} else if err := h.replaceWithTempFile(); err != nil {
 			return err
 		}
 	} else {
+		if h.fp != nil {
+			if err := file.Close(h.fp); err != nil {
+				return err
+			}
+			h.fp = nil
+		}
+
 		if err := h.tempFile.close(); err != nil {
 			return err
 		}
@@ -317,6 +353,59 @@ func (h *Handler) commit() error {
 	return nil
 }
 
+func (h *Handler) replaceWithTempFile() error {
+	if err := h.tempFile.fp.Sync(); err != nil {
+		return err
+	}
+
+	if h.fp != nil {
+		if fi, err := h.fp.Stat(); err == nil {
+			if err := h.tempFile.fp.Chmod(fi.Mode().Perm()); err != nil {
+				return err
+			}
+		}
+		if err := file.Close(h.fp); err != nil {
+			return err
+		}
+		h.fp = nil
+	}
+
+	if err := file.Close(h.tempFile.fp); err != nil {
+		return err
+	}
+	h.tempFile.fp = nil
+
+	if err := os.Rename(h.tempFile.path, h.path); err != nil {
+		return err
+	}
+	h.tempFile = nil
+	return nil
+}
+
+func (h *Handler) copyTempFile() error {
+	if _, err := h.tempFile.fp.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	if _, err := h.fp.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	if err := h.fp.Truncate(0); err != nil {
+		return err
+	}
+	if _, err := io.Copy(h.fp, h.tempFile.fp); err != nil {
+		return err
+	}
+	if err := h.fp.Sync(); err != nil {
+		return err
+	}
+
+	if err := file.Close(h.fp); err != nil {
+		return err
+	}
+	h.fp = nil
+	return nil
+}
+
 func (h *Handler) closeWithErrors() error {
 	if h.closed {
 		return nil
@@ -2,6 +2,9 @@ package file
 
 import (
 	"context"
+	"io"
+	"io/ioutil"
+	"os"
 	"testing"
 )
 
@@ -165,3 +168,141 @@ func TestHandler(t *testing.T) {
 		t.Fatalf("error = %#v, expect no error", err)
 	}
 }
+
+func TestHandler_FileForAppend(t *testing.T) {
+	fileForAppend := GetTestFilePath("append.txt")
+	fileForCreate := GetTestFilePath("create_to_append.txt")
+
+	ctx := context.Background()
+	container := NewContainer()
+	defer func() {
+		if err := container.CloseAllWithErrors(); err != nil {
+			t.Log(err)
+		}
+	}()
+
+	ch, err := NewHandlerForCreate(container, fileForCreate)
+	if err != nil {
+		t.Fatalf("error = %#v, expect no error", err)
+	}
+	if _, err = ch.FileForAppend(); err == nil {
+		t.Fatalf("no error, want error")
+	}
+	_ = container.Close(ch)
+
+	uh, err := NewHandlerForUpdate(ctx, container, fileForAppend, waitTimeoutForTests, retryDelayForTests)
+	if err != nil {
+		t.Fatalf("error = %#v, expect no error", err)
+	}
+
+	fp, err := uh.FileForAppend()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if fp.Name() != fileForAppend {
+		t.Fatalf("filename to append = %q, expect %q", fp.Name(), fileForAppend)
+	}
+
+	if _, err = fp.Seek(0, io.SeekEnd); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err = fp.WriteString("line2\n"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err = container.Commit(uh); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if Exists(TempFilePath(fileForAppend)) {
+		t.Fatalf("temporary file %q is not removed", TempFilePath(fileForAppend))
+	}
+
+	b, _ := ioutil.ReadFile(fileForAppend)
+	if string(b) != "line1\nline2\n" {
+		t.Fatalf("content = %q, expect %q", string(b), "line1\nline2\n")
+	}
+}
+
+func TestHandler_Commit(t *testing.T) {
+	ctx := context.Background()
+	container := NewContainer()
+	defer func() {
+		if err := container.CloseAllWithErrors(); err != nil {
+			t.Log(err)
+		}
+	}()
+
+	for _, inPlace := range []bool{false, true} {
+		path := GetTestFilePath("replace.txt")
+		if inPlace {
+			path = GetTestFilePath("in_place.txt")
+		}
+
+		uh, err := NewHandlerForUpdate(ctx, container, path, waitTimeoutForTests, retryDelayForTests)
+		if err != nil {
+			t.Fatalf("error = %#v, expect no error", err)
+		}
+		uh.SetInPlace(inPlace)
+
+		fp, err := uh.FileForUpdate()
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if _, err = fp.WriteString("updated\n"); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if err = container.Commit(uh); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if Exists(TempFilePath(path)) {
+			t.Fatalf("temporary file %q is not removed", TempFilePath(path))
+		}
+
+		b, _ := ioutil.ReadFile(path)
+		if string(b) != "updated\n" {
+			t.Fatalf("content = %q, expect %q", string(b), "updated\n")
+		}
+
+		if !inPlace {
+			fi, _ := os.Stat(path)
+			if fi.Mode().Perm() != 0644 {
+				t.Fatalf("file mode = %s, expect %s", fi.Mode().Perm(), os.FileMode(0644))
+			}
+		}
+	}
+}
+
+func TestHandler_SetRemoval(t *testing.T) {
+	path := GetTestFilePath("remove.txt")
+
+	ctx := context.Background()
+	container := NewContainer()
+	defer func() {
+		if err := container.CloseAllWithErrors(); err != nil {
+			t.Log(err)
+		}
+	}()
+
+	uh, err := NewHandlerForUpdate(ctx, container, path, waitTimeoutForTests, retryDelayForTests)
+	if err != nil {
+		t.Fatalf("error = %#v, expect no error", err)
+	}
+	uh.SetRemoval(true)
+
+	if err = container.Commit(uh); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if Exists(path) {
+		t.Fatalf("file %q is not removed", path)
+	}
+	if Exists(TempFilePath(path)) {
+		t.Fatalf("temporary file %q is not removed", TempFilePath(path))
+	}
+	if LockExists(path) {
+		t.Fatalf("lock file of %q is not removed", path)
+	}
+}
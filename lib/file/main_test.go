@@ -41,6 +41,23 @@ func setup() {
 
 	fp, _ = os.Create(GetTestFilePath("update.txt"))
 	_ = fp.Close()
+
+	fp, _ = os.Create(GetTestFilePath("append.txt"))
+	_, _ = fp.WriteString("line1\n")
+	_ = fp.Close()
+
+	fp, _ = os.Create(GetTestFilePath("replace.txt"))
+	_, _ = fp.WriteString("line1\nline2\n")
+	_ = fp.Close()
+	_ = os.Chmod(GetTestFilePath("replace.txt"), 0644)
+
+	fp, _ = os.Create(GetTestFilePath("in_place.txt"))
+	_, _ = fp.WriteString("line1\nline2\n")
+	_ = fp.Close()
+
+	fp, _ = os.Create(GetTestFilePath("remove.txt"))
+	_, _ = fp.WriteString("line1\n")
+	_ = fp.Close()
 }
 
 func teardown() {
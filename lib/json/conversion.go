@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/mithrandie/go-text/json"
@@ -90,12 +91,29 @@ func ConvertToTableValue(array json.Array) ([]string, [][]value.Primary, error)
 	return header, rows, nil
 }
 
-func ConvertTableValueToJsonStructure(ctx context.Context, fields []string, rows [][]value.Primary) (json.Structure, error) {
-	pathes, err := ParsePathes(fields)
-	if err != nil {
-		return nil, err
+// FlattenObject converts the members of a json object to a list of keys and
+// values. Nested objects are expanded to keys joined with dots up to maxDepth
+// levels, and objects deeper than that are returned as encoded json strings.
+func FlattenObject(obj json.Object, maxDepth int) ([]string, []value.Primary) {
+	keys := make([]string, 0, obj.Len())
+	values := make([]value.Primary, 0, obj.Len())
+	return flattenObject(obj, "", 1, maxDepth, keys, values)
+}
+
+func flattenObject(obj json.Object, prefix string, depth int, maxDepth int, keys []string, values []value.Primary) ([]string, []value.Primary) {
+	for _, m := range obj.Members {
+		key := prefix + m.Key
+		if child, ok := m.Value.(json.Object); ok && depth < maxDepth && 0 < child.Len() {
+			keys, values = flattenObject(child, key+".", depth+1, maxDepth, keys, values)
+			continue
+		}
+		keys = append(keys, key)
+		values = append(values, ConvertToValue(m.Value))
 	}
+	return keys, values
+}
 
+func ConvertTableValueToJsonStructure(ctx context.Context, pathes []PathExpression, rows [][]value.Primary) (json.Structure, error) {
 	structure := make(json.Array, len(rows))
 	for i := range rows {
 		if i&15 == 0 && ctx.Err() != nil {
@@ -112,6 +130,36 @@ func ConvertTableValueToJsonStructure(ctx context.Context, fields []string, rows
 	return structure, nil
 }
 
+// ConvertTableValueToJsonObject converts rows to an object that has the keys of
+// the rows as member names. Duplicate keys cause an error unless takeLast is true,
+// in which case the last row with the key is used.
+func ConvertTableValueToJsonObject(ctx context.Context, keys []string, pathes []PathExpression, rows [][]value.Primary, takeLast bool) (json.Structure, error) {
+	structure := json.NewObject(len(rows))
+	index := make(map[string]int, len(rows))
+	for i := range rows {
+		if i&15 == 0 && ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		rowStructure, err := ConvertRecordValueToJsonStructure(pathes, rows[i])
+		if err != nil {
+			return nil, err
+		}
+
+		if idx, ok := index[keys[i]]; ok {
+			if !takeLast {
+				return nil, errors.New(fmt.Sprintf("duplicate key %q in row %d", keys[i], i+1))
+			}
+			structure.Members[idx].Value = rowStructure
+			continue
+		}
+		index[keys[i]] = structure.Len()
+		structure.Add(keys[i], rowStructure)
+	}
+
+	return structure, nil
+}
+
 func ParsePathes(fields []string) ([]PathExpression, error) {
 	var err error
 	pathes := make([]PathExpression, len(fields))
@@ -127,6 +175,16 @@ func ParsePathes(fields []string) ([]PathExpression, error) {
 	return pathes, nil
 }
 
+// ParseFlatPathes returns pathes that use the fields as member names without
+// interpreting dots as nesting.
+func ParseFlatPathes(fields []string) []PathExpression {
+	pathes := make([]PathExpression, len(fields))
+	for i, field := range fields {
+		pathes[i] = ObjectPath{Name: field}
+	}
+	return pathes
+}
+
 func ConvertRecordValueToJsonStructure(pathes []PathExpression, row []value.Primary) (json.Structure, error) {
 	var structure json.Structure
 
@@ -137,13 +195,17 @@ func ConvertRecordValueToJsonStructure(pathes []PathExpression, row []value.Prim
 	}
 
 	for i, path := range pathes {
-		structure = addPathValueToRowStructure(structure, path.(ObjectPath), row[i], fieldLen)
+		s, ok := addPathValueToRowStructure(structure, path.(ObjectPath), row[i], fieldLen)
+		if !ok {
+			return nil, errors.New(fmt.Sprintf("field %q is used as both a value and an object", pathField(path.(ObjectPath))))
+		}
+		structure = s
 	}
 
 	return structure, nil
 }
 
-func addPathValueToRowStructure(parent json.Structure, path ObjectPath, val value.Primary, fieldLen int) json.Structure {
+func addPathValueToRowStructure(parent json.Structure, path ObjectPath, val value.Primary, fieldLen int) (json.Structure, bool) {
 	var obj json.Object
 	if parent == nil {
 		obj = json.NewObject(fieldLen)
@@ -152,17 +214,37 @@ func addPathValueToRowStructure(parent json.Structure, path ObjectPath, val valu
 	}
 
 	if path.Child == nil {
+		if _, isObj := obj.Value(path.Name).(json.Object); isObj {
+			return nil, false
+		}
 		obj.Add(path.Name, ParseValueToStructure(val))
 	} else {
-		valueStructure := addPathValueToRowStructure(obj.Value(path.Name), path.Child.(ObjectPath), val, fieldLen)
-		if obj.Exists(path.Name) {
+		member := obj.Value(path.Name)
+		if _, isObj := member.(json.Object); member != nil && !isObj {
+			return nil, false
+		}
+
+		valueStructure, ok := addPathValueToRowStructure(member, path.Child.(ObjectPath), val, fieldLen)
+		if !ok {
+			return nil, false
+		}
+		if member != nil {
 			obj.Update(path.Name, valueStructure)
 		} else {
 			obj.Add(path.Name, valueStructure)
 		}
 	}
 
-	return obj
+	return obj, true
+}
+
+func pathField(path ObjectPath) string {
+	name := strings.Replace(path.Name, string(PathEscape), string([]rune{PathEscape, PathEscape}), -1)
+	name = strings.Replace(name, string(PathSeparator), string([]rune{PathEscape, PathSeparator}), -1)
+	if path.Child != nil {
+		name = name + string(PathSeparator) + pathField(path.Child.(ObjectPath))
+	}
+	return name
 }
 
 func ParseValueToStructure(val value.Primary) json.Structure {
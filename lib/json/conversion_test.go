@@ -199,8 +199,91 @@ func TestConvertToTableValue(t *testing.T) {
 	}
 }
 
+var flattenObjectTests = []struct {
+	Input        json.Object
+	MaxDepth     int
+	ExpectKeys   []string
+	ExpectValues []value.Primary
+}{
+	{
+		Input: json.Object{
+			Members: []json.ObjectMember{
+				{Key: "id", Value: json.Integer(1)},
+				{
+					Key: "user",
+					Value: json.Object{
+						Members: []json.ObjectMember{
+							{Key: "name", Value: json.String("abc")},
+							{
+								Key: "address",
+								Value: json.Object{
+									Members: []json.ObjectMember{
+										{Key: "city", Value: json.String("def")},
+									},
+								},
+							},
+						},
+					},
+				},
+				{Key: "tags", Value: json.Array{json.String("a"), json.String("b")}},
+				{Key: "empty", Value: json.Object{}},
+			},
+		},
+		MaxDepth:   3,
+		ExpectKeys: []string{"id", "user.name", "user.address.city", "tags", "empty"},
+		ExpectValues: []value.Primary{
+			value.NewInteger(1),
+			value.NewString("abc"),
+			value.NewString("def"),
+			value.NewString("[\"a\",\"b\"]"),
+			value.NewString("{}"),
+		},
+	},
+	{
+		Input: json.Object{
+			Members: []json.ObjectMember{
+				{
+					Key: "user",
+					Value: json.Object{
+						Members: []json.ObjectMember{
+							{Key: "name", Value: json.String("abc")},
+							{
+								Key: "address",
+								Value: json.Object{
+									Members: []json.ObjectMember{
+										{Key: "city", Value: json.Null{}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		MaxDepth:   2,
+		ExpectKeys: []string{"user.name", "user.address"},
+		ExpectValues: []value.Primary{
+			value.NewString("abc"),
+			value.NewString("{\"city\":null}"),
+		},
+	},
+}
+
+func TestFlattenObject(t *testing.T) {
+	for _, v := range flattenObjectTests {
+		keys, values := FlattenObject(v.Input, v.MaxDepth)
+		if !reflect.DeepEqual(keys, v.ExpectKeys) {
+			t.Errorf("keys = %#v, want %#v for %q", keys, v.ExpectKeys, v.Input.Encode())
+		}
+		if !reflect.DeepEqual(values, v.ExpectValues) {
+			t.Errorf("values = %#v, want %#v for %q", values, v.ExpectValues, v.Input.Encode())
+		}
+	}
+}
+
 var convertTableValueToJsonStructureTests = []struct {
 	Fields []string
+	Flat   bool
 	Rows   [][]value.Primary
 	Expect json.Structure
 	Error  string
@@ -437,12 +520,78 @@ var convertTableValueToJsonStructureTests = []struct {
 		},
 		Error: "unexpected token \".\" at column 9 in \"column2..\"",
 	},
+	{
+		Fields: []string{
+			"column1.key1",
+			"column1..",
+		},
+		Flat: true,
+		Rows: [][]value.Primary{
+			{
+				value.NewString("a"),
+				value.NewInteger(1),
+			},
+		},
+		Expect: json.Array{
+			json.Object{
+				Members: []json.ObjectMember{
+					{
+						Key:   "column1.key1",
+						Value: json.String("a"),
+					},
+					{
+						Key:   "column1..",
+						Value: json.Integer(1),
+					},
+				},
+			},
+		},
+	},
+	{
+		Fields: []string{
+			"column1",
+			"column1.key1",
+		},
+		Rows: [][]value.Primary{
+			{
+				value.NewString("a"),
+				value.NewInteger(1),
+			},
+		},
+		Error: "field \"column1.key1\" is used as both a value and an object",
+	},
+	{
+		Fields: []string{
+			"column1.key1.key2",
+			"column1\\.key1",
+			"column1.key1",
+		},
+		Rows: [][]value.Primary{
+			{
+				value.NewString("a"),
+				value.NewString("b"),
+				value.NewInteger(1),
+			},
+		},
+		Error: "field \"column1.key1\" is used as both a value and an object",
+	},
 }
 
 func TestConvertTableValueToJsonStructure(t *testing.T) {
 	ctx := context.Background()
 	for _, v := range convertTableValueToJsonStructureTests {
-		result, err := ConvertTableValueToJsonStructure(ctx, v.Fields, v.Rows)
+		var pathes []PathExpression
+		var err error
+		if v.Flat {
+			pathes = ParseFlatPathes(v.Fields)
+		} else {
+			pathes, err = ParsePathes(v.Fields)
+		}
+
+		var result json.Structure
+		if err == nil {
+			result, err = ConvertTableValueToJsonStructure(ctx, pathes, v.Rows)
+		}
 		if err != nil {
 			if len(v.Error) < 1 {
 				t.Errorf("unexpected error %q for %s, %s", err.Error(), v.Fields, v.Rows)
@@ -460,3 +609,161 @@ func TestConvertTableValueToJsonStructure(t *testing.T) {
 		}
 	}
 }
+
+var convertTableValueToJsonObjectTests = []struct {
+	Keys     []string
+	Fields   []string
+	Rows     [][]value.Primary
+	TakeLast bool
+	Expect   json.Structure
+	Error    string
+}{
+	{
+		Keys:   []string{"k1", "k2"},
+		Fields: []string{"column1"},
+		Rows: [][]value.Primary{
+			{value.NewString("a")},
+			{value.NewInteger(1)},
+		},
+		Expect: json.Object{
+			Members: []json.ObjectMember{
+				{
+					Key: "k1",
+					Value: json.Object{
+						Members: []json.ObjectMember{
+							{Key: "column1", Value: json.String("a")},
+						},
+					},
+				},
+				{
+					Key: "k2",
+					Value: json.Object{
+						Members: []json.ObjectMember{
+							{Key: "column1", Value: json.Integer(1)},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Keys:   []string{"k1", "k2", "k1"},
+		Fields: []string{"column1"},
+		Rows: [][]value.Primary{
+			{value.NewString("a")},
+			{value.NewString("b")},
+			{value.NewString("c")},
+		},
+		TakeLast: true,
+		Expect: json.Object{
+			Members: []json.ObjectMember{
+				{
+					Key: "k1",
+					Value: json.Object{
+						Members: []json.ObjectMember{
+							{Key: "column1", Value: json.String("c")},
+						},
+					},
+				},
+				{
+					Key: "k2",
+					Value: json.Object{
+						Members: []json.ObjectMember{
+							{Key: "column1", Value: json.String("b")},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Keys:   []string{"k1", "k1"},
+		Fields: []string{"column1"},
+		Rows: [][]value.Primary{
+			{value.NewString("a")},
+			{value.NewString("b")},
+		},
+		Error: "duplicate key \"k1\" in row 2",
+	},
+	{
+		Keys:   []string{"k1"},
+		Fields: []string{"column1.."},
+		Rows: [][]value.Primary{
+			{value.NewString("a")},
+		},
+		Error: "unexpected token \".\" at column 9 in \"column1..\"",
+	},
+}
+
+func TestConvertTableValueToJsonObject(t *testing.T) {
+	ctx := context.Background()
+	for _, v := range convertTableValueToJsonObjectTests {
+		var result json.Structure
+		pathes, err := ParsePathes(v.Fields)
+		if err == nil {
+			result, err = ConvertTableValueToJsonObject(ctx, v.Keys, pathes, v.Rows, v.TakeLast)
+		}
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for %s, %s", err.Error(), v.Fields, v.Rows)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for %s, %s", err.Error(), v.Error, v.Fields, v.Rows)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for %s, %s", v.Error, v.Fields, v.Rows)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Expect) {
+			t.Errorf("result = %#v, want %#v for %s, %s", result, v.Expect, v.Fields, v.Rows)
+		}
+	}
+}
+
+var parseValueToStructureTests = []struct {
+	Input  value.Primary
+	Expect json.Structure
+}{
+	{
+		Input:  value.NewString("5"),
+		Expect: json.String("5"),
+	},
+	{
+		Input:  value.NewInteger(5),
+		Expect: json.Integer(5),
+	},
+	{
+		Input:  value.NewFloat(1.5),
+		Expect: json.Float(1.5),
+	},
+	{
+		Input:  value.NewBoolean(true),
+		Expect: json.Boolean(true),
+	},
+	{
+		Input:  value.NewTernary(ternary.FALSE),
+		Expect: json.Boolean(false),
+	},
+	{
+		Input:  value.NewTernary(ternary.UNKNOWN),
+		Expect: json.Null{},
+	},
+	{
+		Input:  value.NewDatetimeFromString("2012-02-03T09:18:15-08:00", nil),
+		Expect: json.String("2012-02-03T09:18:15-08:00"),
+	},
+	{
+		Input:  value.NewNull(),
+		Expect: json.Null{},
+	},
+}
+
+func TestParseValueToStructure(t *testing.T) {
+	for _, v := range parseValueToStructureTests {
+		result := ParseValueToStructure(v.Input)
+		if !reflect.DeepEqual(result, v.Expect) {
+			t.Errorf("result = %#v, want %#v for %s", result, v.Expect, v.Input)
+		}
+	}
+}
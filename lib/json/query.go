@@ -32,6 +32,28 @@ func LoadArray(queryString string, jsontext string) ([]value.Primary, error) {
 	return ConvertToArray(array), nil
 }
 
+func LoadObject(jsontext string) ([]string, []value.Primary, error) {
+	d := json.NewDecoder()
+	d.UseInteger = true
+	data, _, err := d.Decode(jsontext)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	obj, ok := data.(json.Object)
+	if !ok {
+		return nil, nil, errors.New("json value must be an object")
+	}
+
+	keys := make([]string, 0, obj.Len())
+	values := make([]value.Primary, 0, obj.Len())
+	for _, m := range obj.Members {
+		keys = append(keys, m.Key)
+		values = append(values, ConvertToValue(m.Value))
+	}
+	return keys, values, nil
+}
+
 func LoadTable(queryString string, jsontext string) ([]string, [][]value.Primary, json.EscapeType, error) {
 	structure, et, err := load(queryString, jsontext)
 	if err != nil {
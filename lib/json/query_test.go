@@ -101,6 +101,51 @@ func TestLoadRowValue(t *testing.T) {
 	}
 }
 
+var loadObjectTests = []struct {
+	Json         string
+	ExpectKeys   []string
+	ExpectValues []value.Primary
+	Error        string
+}{
+	{
+		Json:       "{\"a\":1, \"b\":\"str\", \"c\":null}",
+		ExpectKeys: []string{"a", "b", "c"},
+		ExpectValues: []value.Primary{
+			value.NewInteger(1),
+			value.NewString("str"),
+			value.NewNull(),
+		},
+	},
+	{
+		Json:  "[1, 2]",
+		Error: "json value must be an object",
+	},
+}
+
+func TestLoadObject(t *testing.T) {
+	for _, v := range loadObjectTests {
+		keys, values, err := LoadObject(v.Json)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for %q", err.Error(), v.Json)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for %q", err, v.Error, v.Json)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for %q", v.Error, v.Json)
+			continue
+		}
+		if !reflect.DeepEqual(keys, v.ExpectKeys) {
+			t.Errorf("keys = %#v, want %#v for %q", keys, v.ExpectKeys, v.Json)
+		}
+		if !reflect.DeepEqual(values, v.ExpectValues) {
+			t.Errorf("values = %#v, want %#v for %q", values, v.ExpectValues, v.Json)
+		}
+	}
+}
+
 var loadTableTests = []struct {
 	Query        string
 	Json         string
@@ -0,0 +1,387 @@
+// Package parquet writes tables in the Apache Parquet format.
+//
+// A file is written in a single row group, and each column is written in
+// data pages of PLAIN encoding. All columns are optional, so null values
+// are recorded in the definition levels.
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"time"
+)
+
+const magic = "PAR1"
+
+const createdBy = "csvq"
+
+// PageSize is the size of the uncompressed values at which a data page is
+// split.
+const PageSize = 1024 * 1024
+
+type Type int
+
+const (
+	String Type = iota
+	Int64
+	Double
+	TimestampMillis
+)
+
+// Codec is a compression codec of data pages. The values are the same as
+// the CompressionCodec in the Parquet format.
+type Codec int
+
+const (
+	Uncompressed Codec = 0
+	Snappy       Codec = 1
+	Zstd         Codec = 6
+)
+
+// Physical types, converted types and encodings in the Parquet format.
+const (
+	physicalInt64     = 2
+	physicalDouble    = 5
+	physicalByteArray = 6
+
+	convertedUTF8            = 0
+	convertedTimestampMillis = 9
+
+	repetitionOptional = 1
+
+	encodingPlain = 0
+	encodingRLE   = 3
+
+	pageTypeData = 0
+)
+
+// Column is a column written to a file.
+//
+// Values must be the type corresponding to Type, that is string, int64,
+// float64 or time.Time. Nil represents a null value.
+type Column struct {
+	Name   string
+	Type   Type
+	Values []interface{}
+}
+
+type columnChunk struct {
+	offset           int64
+	uncompressedSize int64
+	compressedSize   int64
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.n += int64(n)
+	return n, err
+}
+
+// Write writes columns to w as a Parquet file. All columns must have the
+// same number of values.
+func Write(w io.Writer, columns []Column, codec Codec) error {
+	switch codec {
+	case Uncompressed, Snappy, Zstd:
+	default:
+		return fmt.Errorf("unsupported codec %d", codec)
+	}
+
+	numRows := 0
+	if 0 < len(columns) {
+		numRows = len(columns[0].Values)
+	}
+	for _, c := range columns {
+		if len(c.Values) != numRows {
+			return errors.New("columns have different numbers of values")
+		}
+	}
+
+	cw := &countingWriter{w: w}
+	if _, err := io.WriteString(cw, magic); err != nil {
+		return err
+	}
+
+	chunks := make([]columnChunk, 0, len(columns))
+	if 0 < numRows {
+		for _, c := range columns {
+			chunk, err := writeColumnChunk(cw, c, codec)
+			if err != nil {
+				return err
+			}
+			chunks = append(chunks, chunk)
+		}
+	}
+
+	meta := fileMetaData(columns, chunks, numRows, codec)
+	if _, err := cw.Write(meta); err != nil {
+		return err
+	}
+	size := make([]byte, 4)
+	binary.LittleEndian.PutUint32(size, uint32(len(meta)))
+	if _, err := cw.Write(size); err != nil {
+		return err
+	}
+	_, err := io.WriteString(cw, magic)
+	return err
+}
+
+func writeColumnChunk(w *countingWriter, c Column, codec Codec) (columnChunk, error) {
+	chunk := columnChunk{offset: w.n}
+
+	values := new(bytes.Buffer)
+	defined := make([]bool, 0, len(c.Values))
+	for i, v := range c.Values {
+		defined = append(defined, v != nil)
+		if v != nil {
+			if err := writePlainValue(values, c.Type, v); err != nil {
+				return chunk, fmt.Errorf("column %s: %s", c.Name, err.Error())
+			}
+		}
+
+		if PageSize <= values.Len() || i == len(c.Values)-1 {
+			uncompressed, compressed, err := writeDataPage(w, defined, values.Bytes(), codec)
+			if err != nil {
+				return chunk, err
+			}
+			chunk.uncompressedSize += uncompressed
+			chunk.compressedSize += compressed
+			values.Reset()
+			defined = defined[:0]
+		}
+	}
+	return chunk, nil
+}
+
+func writePlainValue(buf *bytes.Buffer, t Type, v interface{}) error {
+	b := make([]byte, 8)
+	switch t {
+	case Int64:
+		i, ok := v.(int64)
+		if !ok {
+			return fmt.Errorf("%v is not an int64 value", v)
+		}
+		binary.LittleEndian.PutUint64(b, uint64(i))
+	case Double:
+		f, ok := v.(float64)
+		if !ok {
+			return fmt.Errorf("%v is not a float64 value", v)
+		}
+		binary.LittleEndian.PutUint64(b, math.Float64bits(f))
+	case TimestampMillis:
+		tm, ok := v.(time.Time)
+		if !ok {
+			return fmt.Errorf("%v is not a time.Time value", v)
+		}
+		binary.LittleEndian.PutUint64(b, uint64(tm.Unix()*1000+int64(tm.Nanosecond()/1000000)))
+	default:
+		s, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("%v is not a string value", v)
+		}
+		binary.LittleEndian.PutUint32(b, uint32(len(s)))
+		buf.Write(b[:4])
+		buf.WriteString(s)
+		return nil
+	}
+	buf.Write(b)
+	return nil
+}
+
+// writeDataPage writes a data page and returns the uncompressed and the
+// compressed sizes of the page including the page header.
+func writeDataPage(w io.Writer, defined []bool, values []byte, codec Codec) (int64, int64, error) {
+	levels := encodeDefinitionLevels(defined)
+
+	page := make([]byte, 4, 4+len(levels)+len(values))
+	binary.LittleEndian.PutUint32(page, uint32(len(levels)))
+	page = append(page, levels...)
+	page = append(page, values...)
+
+	compressed := page
+	switch codec {
+	case Snappy:
+		compressed = snappyEncode(page)
+	case Zstd:
+		compressed = zstdEncode(page)
+	}
+
+	tw := new(thriftWriter)
+	tw.BeginStruct()
+	tw.I32Field(1, pageTypeData)
+	tw.I32Field(2, int32(len(page)))
+	tw.I32Field(3, int32(len(compressed)))
+	tw.StructField(5)
+	tw.BeginStruct()
+	tw.I32Field(1, int32(len(defined)))
+	tw.I32Field(2, encodingPlain)
+	tw.I32Field(3, encodingRLE)
+	tw.I32Field(4, encodingRLE)
+	tw.EndStruct()
+	tw.EndStruct()
+	header := tw.Bytes()
+
+	if _, err := w.Write(header); err != nil {
+		return 0, 0, err
+	}
+	if _, err := w.Write(compressed); err != nil {
+		return 0, 0, err
+	}
+	return int64(len(header) + len(page)), int64(len(header) + len(compressed)), nil
+}
+
+// encodeDefinitionLevels encodes the definition levels in the RLE/bit-packing
+// hybrid encoding with the bit width of 1.
+func encodeDefinitionLevels(defined []bool) []byte {
+	runLength := func(i int) int {
+		n := 1
+		for i+n < len(defined) && defined[i+n] == defined[i] {
+			n++
+		}
+		return n
+	}
+
+	var buf []byte
+	b := make([]byte, binary.MaxVarintLen64)
+	for i := 0; i < len(defined); {
+		if n := runLength(i); 8 <= n {
+			buf = append(buf, b[:binary.PutUvarint(b, uint64(n)<<1)]...)
+			if defined[i] {
+				buf = append(buf, 1)
+			} else {
+				buf = append(buf, 0)
+			}
+			i += n
+			continue
+		}
+
+		start := i
+		for i < len(defined) && (i == start || runLength(i) < 8) {
+			i += 8
+		}
+		if len(defined) < i {
+			i = len(defined)
+		}
+
+		groups := (i - start + 7) / 8
+		buf = append(buf, b[:binary.PutUvarint(b, uint64(groups)<<1|1)]...)
+		packed := make([]byte, groups)
+		for j := start; j < i; j++ {
+			if defined[j] {
+				packed[(j-start)/8] |= 1 << uint((j-start)%8)
+			}
+		}
+		buf = append(buf, packed...)
+	}
+	return buf
+}
+
+func fileMetaData(columns []Column, chunks []columnChunk, numRows int, codec Codec) []byte {
+	tw := new(thriftWriter)
+	tw.BeginStruct()
+	tw.I32Field(1, 1)
+
+	tw.ListField(2, thriftStruct, len(columns)+1)
+	tw.BeginStruct()
+	tw.StringField(4, "schema")
+	tw.I32Field(5, int32(len(columns)))
+	tw.EndStruct()
+	for _, c := range columns {
+		writeSchemaElement(tw, c)
+	}
+
+	tw.I64Field(3, int64(numRows))
+
+	if len(chunks) < 1 {
+		tw.ListField(4, thriftStruct, 0)
+	} else {
+		tw.ListField(4, thriftStruct, 1)
+
+		var totalByteSize int64
+		for _, chunk := range chunks {
+			totalByteSize += chunk.uncompressedSize
+		}
+
+		tw.BeginStruct()
+		tw.ListField(1, thriftStruct, len(chunks))
+		for i, chunk := range chunks {
+			tw.BeginStruct()
+			tw.I64Field(2, chunk.offset)
+			tw.StructField(3)
+			tw.BeginStruct()
+			tw.I32Field(1, physicalType(columns[i].Type))
+			tw.ListField(2, thriftI32, 2)
+			tw.I32Elem(encodingPlain)
+			tw.I32Elem(encodingRLE)
+			tw.ListField(3, thriftBinary, 1)
+			tw.StringElem(columns[i].Name)
+			tw.I32Field(4, int32(codec))
+			tw.I64Field(5, int64(numRows))
+			tw.I64Field(6, chunk.uncompressedSize)
+			tw.I64Field(7, chunk.compressedSize)
+			tw.I64Field(9, chunk.offset)
+			tw.EndStruct()
+			tw.EndStruct()
+		}
+		tw.I64Field(2, totalByteSize)
+		tw.I64Field(3, int64(numRows))
+		tw.EndStruct()
+	}
+
+	tw.StringField(6, createdBy)
+	tw.EndStruct()
+	return tw.Bytes()
+}
+
+func writeSchemaElement(tw *thriftWriter, c Column) {
+	tw.BeginStruct()
+	tw.I32Field(1, physicalType(c.Type))
+	tw.I32Field(3, repetitionOptional)
+	tw.StringField(4, c.Name)
+
+	switch c.Type {
+	case String:
+		tw.I32Field(6, convertedUTF8)
+		tw.StructField(10)
+		tw.BeginStruct()
+		tw.StructField(1)
+		tw.BeginStruct()
+		tw.EndStruct()
+		tw.EndStruct()
+	case TimestampMillis:
+		tw.I32Field(6, convertedTimestampMillis)
+		tw.StructField(10)
+		tw.BeginStruct()
+		tw.StructField(8)
+		tw.BeginStruct()
+		tw.BoolField(1, true)
+		tw.StructField(2)
+		tw.BeginStruct()
+		tw.StructField(1)
+		tw.BeginStruct()
+		tw.EndStruct()
+		tw.EndStruct()
+		tw.EndStruct()
+		tw.EndStruct()
+	}
+	tw.EndStruct()
+}
+
+func physicalType(t Type) int32 {
+	switch t {
+	case Int64, TimestampMillis:
+		return physicalInt64
+	case Double:
+		return physicalDouble
+	default:
+		return physicalByteArray
+	}
+}
@@ -0,0 +1,228 @@
+package parquet
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+)
+
+var writeTests = []struct {
+	Name    string
+	Columns []Column
+	Codec   Codec
+	Result  []byte
+	Error   string
+}{
+	{
+		Name: "Write",
+		Columns: []Column{
+			{Name: "i", Type: Int64, Values: []interface{}{int64(1), nil}},
+			{Name: "d", Type: Double, Values: []interface{}{nil, 1.5}},
+			{Name: "s", Type: String, Values: []interface{}{"ab", nil}},
+			{Name: "t", Type: TimestampMillis, Values: []interface{}{time.Date(2012, 2, 3, 9, 18, 15, 123000000, time.UTC), nil}},
+		},
+		Codec: Uncompressed,
+		Result: []byte{
+			0x50, 0x41, 0x52, 0x31, 0x15, 0x00, 0x15, 0x1c, 0x15, 0x1c, 0x2c, 0x15, 0x04, 0x15, 0x00, 0x15,
+			0x06, 0x15, 0x06, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0x03, 0x01, 0x01, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x15, 0x00, 0x15, 0x1c, 0x15, 0x1c, 0x2c, 0x15, 0x04, 0x15, 0x00, 0x15, 0x06,
+			0x15, 0x06, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0x03, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0xf8, 0x3f, 0x15, 0x00, 0x15, 0x18, 0x15, 0x18, 0x2c, 0x15, 0x04, 0x15, 0x00, 0x15, 0x06, 0x15,
+			0x06, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0x03, 0x01, 0x02, 0x00, 0x00, 0x00, 0x61, 0x62, 0x15,
+			0x00, 0x15, 0x1c, 0x15, 0x1c, 0x2c, 0x15, 0x04, 0x15, 0x00, 0x15, 0x06, 0x15, 0x06, 0x00, 0x00,
+			0x02, 0x00, 0x00, 0x00, 0x03, 0x01, 0x53, 0xc4, 0x81, 0x42, 0x35, 0x01, 0x00, 0x00, 0x15, 0x02,
+			0x19, 0x5c, 0x48, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x15, 0x08, 0x00, 0x15, 0x04, 0x25,
+			0x02, 0x18, 0x01, 0x69, 0x00, 0x15, 0x0a, 0x25, 0x02, 0x18, 0x01, 0x64, 0x00, 0x15, 0x0c, 0x25,
+			0x02, 0x18, 0x01, 0x73, 0x25, 0x00, 0x4c, 0x1c, 0x00, 0x00, 0x00, 0x15, 0x04, 0x25, 0x02, 0x18,
+			0x01, 0x74, 0x25, 0x12, 0x4c, 0x8c, 0x11, 0x1c, 0x1c, 0x00, 0x00, 0x00, 0x00, 0x00, 0x16, 0x04,
+			0x19, 0x1c, 0x19, 0x4c, 0x26, 0x08, 0x1c, 0x15, 0x04, 0x19, 0x25, 0x00, 0x06, 0x19, 0x18, 0x01,
+			0x69, 0x15, 0x00, 0x16, 0x04, 0x16, 0x3e, 0x16, 0x3e, 0x26, 0x08, 0x00, 0x00, 0x26, 0x46, 0x1c,
+			0x15, 0x0a, 0x19, 0x25, 0x00, 0x06, 0x19, 0x18, 0x01, 0x64, 0x15, 0x00, 0x16, 0x04, 0x16, 0x3e,
+			0x16, 0x3e, 0x26, 0x46, 0x00, 0x00, 0x26, 0x84, 0x01, 0x1c, 0x15, 0x0c, 0x19, 0x25, 0x00, 0x06,
+			0x19, 0x18, 0x01, 0x73, 0x15, 0x00, 0x16, 0x04, 0x16, 0x3a, 0x16, 0x3a, 0x26, 0x84, 0x01, 0x00,
+			0x00, 0x26, 0xbe, 0x01, 0x1c, 0x15, 0x04, 0x19, 0x25, 0x00, 0x06, 0x19, 0x18, 0x01, 0x74, 0x15,
+			0x00, 0x16, 0x04, 0x16, 0x3e, 0x16, 0x3e, 0x26, 0xbe, 0x01, 0x00, 0x00, 0x16, 0xf4, 0x01, 0x16,
+			0x04, 0x00, 0x28, 0x04, 0x63, 0x73, 0x76, 0x71, 0x00, 0xbb, 0x00, 0x00, 0x00, 0x50, 0x41, 0x52,
+			0x31,
+		},
+	},
+	{
+		Name: "Write Empty",
+		Columns: []Column{
+			{Name: "c", Type: String, Values: []interface{}{}},
+		},
+		Codec: Snappy,
+		Result: []byte{
+			0x50, 0x41, 0x52, 0x31, 0x15, 0x02, 0x19, 0x2c, 0x48, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+			0x15, 0x02, 0x00, 0x15, 0x0c, 0x25, 0x02, 0x18, 0x01, 0x63, 0x25, 0x00, 0x4c, 0x1c, 0x00, 0x00,
+			0x00, 0x16, 0x00, 0x19, 0x0c, 0x28, 0x04, 0x63, 0x73, 0x76, 0x71, 0x00, 0x28, 0x00, 0x00, 0x00,
+			0x50, 0x41, 0x52, 0x31,
+		},
+	},
+	{
+		Name: "Different Numbers of Values",
+		Columns: []Column{
+			{Name: "c1", Type: Int64, Values: []interface{}{int64(1)}},
+			{Name: "c2", Type: Int64, Values: []interface{}{}},
+		},
+		Codec: Uncompressed,
+		Error: "columns have different numbers of values",
+	},
+	{
+		Name: "Invalid Value",
+		Columns: []Column{
+			{Name: "c1", Type: Int64, Values: []interface{}{"a"}},
+		},
+		Codec: Uncompressed,
+		Error: "column c1: a is not an int64 value",
+	},
+	{
+		Name: "Unsupported Codec",
+		Columns: []Column{
+			{Name: "c1", Type: Int64, Values: []interface{}{int64(1)}},
+		},
+		Codec: 2,
+		Error: "unsupported codec 2",
+	},
+}
+
+func TestWrite(t *testing.T) {
+	for _, v := range writeTests {
+		buf := new(bytes.Buffer)
+		err := Write(buf, v.Columns, v.Codec)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !bytes.Equal(buf.Bytes(), v.Result) {
+			t.Errorf("%s: result = %#v, want %#v", v.Name, buf.Bytes(), v.Result)
+		}
+	}
+}
+
+func TestWrite_Codec(t *testing.T) {
+	values := make([]interface{}, 1000)
+	for i := range values {
+		if i%3 != 0 {
+			values[i] = "value"
+		}
+	}
+	columns := []Column{{Name: "c", Type: String, Values: values}}
+
+	decoders := map[Codec]func([]byte) ([]byte, error){
+		Snappy: snappyDecode,
+		Zstd:   zstdDecode,
+	}
+
+	for codec, decode := range decoders {
+		uncompressed := new(bytes.Buffer)
+		_ = Write(uncompressed, columns, Uncompressed)
+		compressed := new(bytes.Buffer)
+		_ = Write(compressed, columns, codec)
+
+		page, err := extractPage(uncompressed.Bytes())
+		if err != nil {
+			t.Fatalf("codec %d: %s", codec, err)
+		}
+		compressedPage, err := extractPage(compressed.Bytes())
+		if err != nil {
+			t.Fatalf("codec %d: %s", codec, err)
+		}
+		decoded, err := decode(compressedPage)
+		if err != nil {
+			t.Errorf("codec %d: unexpected error %q", codec, err)
+		} else if !bytes.Equal(decoded, page) {
+			t.Errorf("codec %d: decoded page does not match the uncompressed page", codec)
+		}
+		if len(uncompressed.Bytes()) <= len(compressed.Bytes()) {
+			t.Errorf("codec %d: file size = %d, want to be less than %d", codec, len(compressed.Bytes()), len(uncompressed.Bytes()))
+		}
+	}
+}
+
+// extractPage returns the data of the first page in the file. The page
+// header is assumed to have the same fields as those written by Write.
+func extractPage(file []byte) ([]byte, error) {
+	p := 4
+	readVarint := func() int64 {
+		var u uint64
+		for shift := uint(0); ; shift += 7 {
+			b := file[p]
+			p++
+			u |= uint64(b&0x7f) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		return int64(u>>1) ^ -int64(u&1)
+	}
+
+	if file[p] != 0x15 {
+		return nil, errors.New("invalid page header")
+	}
+	p++
+	readVarint()
+	if file[p] != 0x15 {
+		return nil, errors.New("invalid page header")
+	}
+	p++
+	readVarint()
+	if file[p] != 0x15 {
+		return nil, errors.New("invalid page header")
+	}
+	p++
+	size := int(readVarint())
+
+	// DataPageHeader of 4 i32 fields and the stop fields.
+	p++
+	for i := 0; i < 4; i++ {
+		p++
+		readVarint()
+	}
+	p += 2
+	return file[p : p+size], nil
+}
+
+var encodeDefinitionLevelsTests = []struct {
+	Name    string
+	Defined []bool
+	Result  []byte
+}{
+	{
+		Name:    "RLE Run",
+		Defined: []bool{true, true, true, true, true, true, true, true, true, true},
+		Result:  []byte{0x14, 0x01},
+	},
+	{
+		Name:    "Bit-Packed Run",
+		Defined: []bool{true, false, true, true, false, false, true, true, true, false, true},
+		Result:  []byte{0x05, 0xcd, 0x05},
+	},
+	{
+		Name: "Mixed Runs",
+		Defined: []bool{
+			false, false, false, false, false, false, false, false, false,
+			true, false, true, true, false, false, true, false,
+			true, true, true, true, true, true, true, true, true, true,
+		},
+		Result: []byte{0x12, 0x00, 0x03, 0x4d, 0x14, 0x01},
+	},
+}
+
+func TestEncodeDefinitionLevels(t *testing.T) {
+	for _, v := range encodeDefinitionLevelsTests {
+		result := encodeDefinitionLevels(v.Defined)
+		if !bytes.Equal(result, v.Result) {
+			t.Errorf("%s: result = %#v, want %#v", v.Name, result, v.Result)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func snappyDecode(src []byte) ([]byte, error) {
+	n, l := binary.Uvarint(src)
+	if l <= 0 {
+		return nil, errors.New("invalid length")
+	}
+	src = src[l:]
+
+	dst := make([]byte, 0, n)
+	for 0 < len(src) {
+		tag := src[0]
+		src = src[1:]
+
+		switch tag & 3 {
+		case 0:
+			length := int(tag >> 2)
+			if 60 <= length {
+				nb := length - 59
+				length = 0
+				for i := 0; i < nb; i++ {
+					length |= int(src[i]) << uint(8*i)
+				}
+				src = src[nb:]
+			}
+			length++
+			dst = append(dst, src[:length]...)
+			src = src[length:]
+		default:
+			var length, offset int
+			switch tag & 3 {
+			case 1:
+				length = int(tag>>2&7) + 4
+				offset = int(tag>>5)<<8 | int(src[0])
+				src = src[1:]
+			case 2:
+				length = int(tag>>2) + 1
+				offset = int(binary.LittleEndian.Uint16(src))
+				src = src[2:]
+			default:
+				length = int(tag>>2) + 1
+				offset = int(binary.LittleEndian.Uint32(src))
+				src = src[4:]
+			}
+			if offset < 1 || len(dst) < offset {
+				return nil, errors.New("invalid offset")
+			}
+			for i := 0; i < length; i++ {
+				dst = append(dst, dst[len(dst)-offset])
+			}
+		}
+	}
+
+	if uint64(len(dst)) != n {
+		return nil, errors.New("length does not match")
+	}
+	return dst, nil
+}
+
+var snappyEncodeTests = []struct {
+	Name   string
+	Src    []byte
+	Result []byte
+}{
+	{
+		Name:   "Empty",
+		Src:    []byte{},
+		Result: []byte{0x00},
+	},
+	{
+		Name:   "Literal",
+		Src:    []byte("abc"),
+		Result: []byte{0x03, 0x08, 'a', 'b', 'c'},
+	},
+	{
+		Name:   "Copy",
+		Src:    []byte("abcdabcdabcd"),
+		Result: []byte{0x0c, 0x0c, 'a', 'b', 'c', 'd', 0x11, 0x04},
+	},
+}
+
+func TestSnappyEncode(t *testing.T) {
+	for _, v := range snappyEncodeTests {
+		result := snappyEncode(v.Src)
+		if !bytes.Equal(result, v.Result) {
+			t.Errorf("%s: result = %#v, want %#v", v.Name, result, v.Result)
+		}
+	}
+}
+
+func TestSnappyEncode_RoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	random := make([]byte, 200000)
+	for i := range random {
+		random[i] = byte('a' + r.Intn(4))
+	}
+
+	inputs := [][]byte{
+		[]byte(strings.Repeat("long repetition ", 20000)),
+		random,
+		bytes.Repeat([]byte{0}, snappyBlockSize+1),
+	}
+
+	for i, src := range inputs {
+		encoded := snappyEncode(src)
+		decoded, err := snappyDecode(encoded)
+		if err != nil {
+			t.Errorf("input %d: unexpected error %q", i, err)
+			continue
+		}
+		if !reflect.DeepEqual(decoded, src) {
+			t.Errorf("input %d: decoded data does not match the source", i)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// Type IDs of the Thrift compact protocol.
+const (
+	thriftBoolTrue  = 1
+	thriftBoolFalse = 2
+	thriftI32       = 5
+	thriftI64       = 6
+	thriftBinary    = 8
+	thriftList      = 9
+	thriftStruct    = 12
+)
+
+// thriftWriter encodes the Parquet metadata structures in the Thrift
+// compact protocol.
+type thriftWriter struct {
+	buf     bytes.Buffer
+	lastIDs []int16
+	lastID  int16
+}
+
+func (w *thriftWriter) Bytes() []byte {
+	return w.buf.Bytes()
+}
+
+func (w *thriftWriter) writeUvarint(n uint64) {
+	var b [binary.MaxVarintLen64]byte
+	w.buf.Write(b[:binary.PutUvarint(b[:], n)])
+}
+
+func (w *thriftWriter) writeVarint(n int64) {
+	w.writeUvarint(uint64((n << 1) ^ (n >> 63)))
+}
+
+func (w *thriftWriter) fieldHeader(id int16, typ byte) {
+	if delta := id - w.lastID; 0 < delta && delta <= 15 {
+		w.buf.WriteByte(byte(delta)<<4 | typ)
+	} else {
+		w.buf.WriteByte(typ)
+		w.writeVarint(int64(id))
+	}
+	w.lastID = id
+}
+
+// BeginStruct starts a struct. Fields written until the corresponding
+// EndStruct belong to the struct.
+func (w *thriftWriter) BeginStruct() {
+	w.lastIDs = append(w.lastIDs, w.lastID)
+	w.lastID = 0
+}
+
+// EndStruct writes the stop field of the current struct.
+func (w *thriftWriter) EndStruct() {
+	w.buf.WriteByte(0)
+	w.lastID = w.lastIDs[len(w.lastIDs)-1]
+	w.lastIDs = w.lastIDs[:len(w.lastIDs)-1]
+}
+
+// StructField writes the header of a struct field. The field must be
+// followed by BeginStruct and EndStruct.
+func (w *thriftWriter) StructField(id int16) {
+	w.fieldHeader(id, thriftStruct)
+}
+
+func (w *thriftWriter) BoolField(id int16, b bool) {
+	if b {
+		w.fieldHeader(id, thriftBoolTrue)
+	} else {
+		w.fieldHeader(id, thriftBoolFalse)
+	}
+}
+
+func (w *thriftWriter) I32Field(id int16, n int32) {
+	w.fieldHeader(id, thriftI32)
+	w.writeVarint(int64(n))
+}
+
+func (w *thriftWriter) I64Field(id int16, n int64) {
+	w.fieldHeader(id, thriftI64)
+	w.writeVarint(n)
+}
+
+func (w *thriftWriter) StringField(id int16, s string) {
+	w.fieldHeader(id, thriftBinary)
+	w.writeUvarint(uint64(len(s)))
+	w.buf.WriteString(s)
+}
+
+// ListField writes the header of a list field that has size elements of
+// elemType. The elements must be written after the header.
+func (w *thriftWriter) ListField(id int16, elemType byte, size int) {
+	w.fieldHeader(id, thriftList)
+	if size < 15 {
+		w.buf.WriteByte(byte(size)<<4 | elemType)
+	} else {
+		w.buf.WriteByte(0xf0 | elemType)
+		w.writeUvarint(uint64(size))
+	}
+}
+
+func (w *thriftWriter) I32Elem(n int32) {
+	w.writeVarint(int64(n))
+}
+
+func (w *thriftWriter) StringElem(s string) {
+	w.writeUvarint(uint64(len(s)))
+	w.buf.WriteString(s)
+}
@@ -0,0 +1,59 @@
+package parquet
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestThriftWriter(t *testing.T) {
+	tw := new(thriftWriter)
+	tw.BeginStruct()
+	tw.I32Field(1, -1)
+	tw.BoolField(2, true)
+	tw.StructField(4)
+	tw.BeginStruct()
+	tw.StringField(1, "a")
+	tw.EndStruct()
+	tw.I64Field(20, 300)
+	tw.ListField(21, thriftI32, 2)
+	tw.I32Elem(1)
+	tw.I32Elem(2)
+	tw.EndStruct()
+
+	expect := []byte{
+		0x15, 0x01, // field 1 i32 -1
+		0x11,                  // field 2 true
+		0x2c,                  // field 4 struct
+		0x18, 0x01, 'a', 0x00, // field 1 "a", stop
+		0x06, 0x28, 0xd8, 0x04, // field 20 i64 300
+		0x19, 0x25, 0x02, 0x04, // field 21 list<i32> [1, 2]
+		0x00,
+	}
+	if !bytes.Equal(tw.Bytes(), expect) {
+		t.Errorf("result = %#v, want %#v", tw.Bytes(), expect)
+	}
+}
+
+func TestThriftWriter_LongList(t *testing.T) {
+	tw := new(thriftWriter)
+	tw.BeginStruct()
+	tw.ListField(1, thriftBinary, 16)
+	for i := 0; i < 16; i++ {
+		tw.StringElem("")
+	}
+	tw.EndStruct()
+
+	expect := append([]byte{0x19, 0xf8, 0x10}, append(bytes.Repeat([]byte{0x00}, 16), 0x00)...)
+	if !bytes.Equal(tw.Bytes(), expect) {
+		t.Errorf("result = %#v, want %#v", tw.Bytes(), expect)
+	}
+
+	tw = new(thriftWriter)
+	tw.BeginStruct()
+	tw.StringField(1, strings.Repeat("a", 200))
+	tw.EndStruct()
+	if !bytes.Equal(tw.Bytes()[:3], []byte{0x18, 0xc8, 0x01}) {
+		t.Errorf("header = %#v, want %#v", tw.Bytes()[:3], []byte{0x18, 0xc8, 0x01})
+	}
+}
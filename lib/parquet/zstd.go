@@ -0,0 +1,380 @@
+package parquet
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// The zstd encoder compresses data with LZ77 sequences whose symbols are
+// encoded with the predefined FSE tables. Literals are stored raw.
+
+const (
+	zstdMagic        = 0xfd2fb528
+	zstdBlockSize    = 1 << 17
+	zstdTableBits    = 15
+	zstdMinMatchLen  = 4
+	zstdMaxSequences = 0x7f00 + 0xffff
+
+	zstdBlockRaw        = 0
+	zstdBlockCompressed = 2
+)
+
+var zstdLLDefaultNorm = []int16{
+	4, 3, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 1, 1, 1,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 3, 2, 1, 1, 1, 1, 1,
+	-1, -1, -1, -1,
+}
+
+var zstdMLDefaultNorm = []int16{
+	1, 4, 3, 2, 2, 2, 2, 2, 2, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, -1, -1,
+	-1, -1, -1, -1, -1,
+}
+
+var zstdOFDefaultNorm = []int16{
+	1, 1, 1, 1, 1, 1, 2, 2, 2, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, -1, -1, -1, -1, -1,
+}
+
+var zstdLLBase = []uint32{
+	0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15,
+	16, 18, 20, 22, 24, 28, 32, 40, 48, 64, 128, 256, 512, 1024, 2048, 4096,
+	8192, 16384, 32768, 65536,
+}
+
+var zstdLLBits = []uint8{
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1, 1, 1, 1, 2, 2, 3, 3, 4, 6, 7, 8, 9, 10, 11, 12,
+	13, 14, 15, 16,
+}
+
+var zstdMLBase = []uint32{
+	3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18,
+	19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34,
+	35, 37, 39, 41, 43, 47, 51, 59, 67, 83, 99, 131, 259, 515, 1027, 2051,
+	4099, 8195, 16387, 32771, 65539,
+}
+
+var zstdMLBits = []uint8{
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1, 1, 1, 1, 2, 2, 3, 3, 4, 4, 5, 7, 8, 9, 10, 11,
+	12, 13, 14, 15, 16,
+}
+
+var (
+	zstdLLTable = newFSETable(zstdLLDefaultNorm, 6)
+	zstdMLTable = newFSETable(zstdMLDefaultNorm, 6)
+	zstdOFTable = newFSETable(zstdOFDefaultNorm, 5)
+)
+
+type fseSymbolTransform struct {
+	deltaNbBits    uint32
+	deltaFindState int32
+}
+
+// fseTable is an FSE compression table built in the same way as the
+// decoding tables defined in RFC 8878.
+type fseTable struct {
+	tableLog   uint
+	stateTable []uint16
+	symbolTT   []fseSymbolTransform
+}
+
+func newFSETable(norm []int16, tableLog uint) *fseTable {
+	tableSize := 1 << tableLog
+	tableMask := tableSize - 1
+	step := (tableSize >> 1) + (tableSize >> 3) + 3
+	highThreshold := tableSize - 1
+
+	symbols := make([]int, tableSize)
+	cumul := make([]int, len(norm)+1)
+	for s, n := range norm {
+		if n == -1 {
+			cumul[s+1] = cumul[s] + 1
+			symbols[highThreshold] = s
+			highThreshold--
+		} else {
+			cumul[s+1] = cumul[s] + int(n)
+		}
+	}
+
+	pos := 0
+	for s, n := range norm {
+		for i := 0; i < int(n); i++ {
+			symbols[pos] = s
+			pos = (pos + step) & tableMask
+			for highThreshold < pos {
+				pos = (pos + step) & tableMask
+			}
+		}
+	}
+
+	t := &fseTable{
+		tableLog:   tableLog,
+		stateTable: make([]uint16, tableSize),
+		symbolTT:   make([]fseSymbolTransform, len(norm)),
+	}
+	for u := 0; u < tableSize; u++ {
+		s := symbols[u]
+		t.stateTable[cumul[s]] = uint16(tableSize + u)
+		cumul[s]++
+	}
+
+	total := 0
+	for s, n := range norm {
+		switch n {
+		case 0:
+		case -1, 1:
+			t.symbolTT[s].deltaNbBits = uint32(tableLog<<16) - uint32(tableSize)
+			t.symbolTT[s].deltaFindState = int32(total - 1)
+			total++
+		default:
+			maxBitsOut := tableLog - uint(bits.Len32(uint32(n-1))-1)
+			minStatePlus := uint32(n) << maxBitsOut
+			t.symbolTT[s].deltaNbBits = uint32(maxBitsOut<<16) - minStatePlus
+			t.symbolTT[s].deltaFindState = int32(total - int(n))
+			total += int(n)
+		}
+	}
+	return t
+}
+
+type fseState struct {
+	table *fseTable
+	value uint32
+}
+
+func (st *fseState) init(t *fseTable, symbol uint8) {
+	st.table = t
+	tt := t.symbolTT[symbol]
+	nbBitsOut := (tt.deltaNbBits + (1 << 15)) >> 16
+	value := (nbBitsOut << 16) - tt.deltaNbBits
+	st.value = uint32(t.stateTable[int32(value>>nbBitsOut)+tt.deltaFindState])
+}
+
+func (st *fseState) encode(w *bitWriter, symbol uint8) {
+	tt := st.table.symbolTT[symbol]
+	nbBitsOut := (st.value + tt.deltaNbBits) >> 16
+	w.addBits(st.value, nbBitsOut)
+	st.value = uint32(st.table.stateTable[int32(st.value>>nbBitsOut)+tt.deltaFindState])
+}
+
+func (st *fseState) flush(w *bitWriter) {
+	w.addBits(st.value, uint32(st.table.tableLog))
+}
+
+// bitWriter writes bits from the least significant bit of each byte.
+type bitWriter struct {
+	buf   []byte
+	bits  uint64
+	nbits uint32
+}
+
+func (w *bitWriter) addBits(value uint32, n uint32) {
+	w.bits |= uint64(value&(1<<n-1)) << w.nbits
+	w.nbits += n
+	for 8 <= w.nbits {
+		w.buf = append(w.buf, byte(w.bits))
+		w.bits >>= 8
+		w.nbits -= 8
+	}
+}
+
+// close writes the end mark and pads the last byte.
+func (w *bitWriter) close() []byte {
+	w.addBits(1, 1)
+	if 0 < w.nbits {
+		w.buf = append(w.buf, byte(w.bits))
+	}
+	return w.buf
+}
+
+type zstdSequence struct {
+	litLen   uint32
+	matchLen uint32
+	offset   uint32
+}
+
+func zstdLLCode(litLen uint32) uint8 {
+	if litLen < 16 {
+		return uint8(litLen)
+	}
+	code := uint8(len(zstdLLBase) - 1)
+	for litLen < zstdLLBase[code] {
+		code--
+	}
+	return code
+}
+
+func zstdMLCode(matchLen uint32) uint8 {
+	if matchLen < 35 {
+		return uint8(matchLen - 3)
+	}
+	code := uint8(len(zstdMLBase) - 1)
+	for matchLen < zstdMLBase[code] {
+		code--
+	}
+	return code
+}
+
+// zstdEncode compresses src in a zstd frame.
+func zstdEncode(src []byte) []byte {
+	dst := make([]byte, 4, 13+len(src)+len(src)/zstdBlockSize*3+3)
+	binary.LittleEndian.PutUint32(dst, zstdMagic)
+	// Single segment with an 8-byte frame content size.
+	dst = append(dst, 0xe0)
+	dst = append(dst, make([]byte, 8)...)
+	binary.LittleEndian.PutUint64(dst[5:], uint64(len(src)))
+
+	if len(src) < 1 {
+		return zstdAppendBlockHeader(dst, zstdBlockRaw, 0, true)
+	}
+
+	for 0 < len(src) {
+		block := src
+		if zstdBlockSize < len(block) {
+			block = block[:zstdBlockSize]
+		}
+		src = src[len(block):]
+		last := len(src) < 1
+
+		if c := zstdCompressBlock(block); c != nil && len(c) < len(block) {
+			dst = zstdAppendBlockHeader(dst, zstdBlockCompressed, len(c), last)
+			dst = append(dst, c...)
+		} else {
+			dst = zstdAppendBlockHeader(dst, zstdBlockRaw, len(block), last)
+			dst = append(dst, block...)
+		}
+	}
+	return dst
+}
+
+func zstdAppendBlockHeader(dst []byte, blockType int, size int, last bool) []byte {
+	h := uint32(size)<<3 | uint32(blockType)<<1
+	if last {
+		h |= 1
+	}
+	return append(dst, byte(h), byte(h>>8), byte(h>>16))
+}
+
+func zstdHash(u uint32) uint32 {
+	return (u * 2654435761) >> (32 - zstdTableBits)
+}
+
+// zstdCompressBlock returns the content of a compressed block, or nil if
+// no match is found in src.
+func zstdCompressBlock(src []byte) []byte {
+	if len(src) < zstdMinMatchLen+4 {
+		return nil
+	}
+
+	table := make([]int32, 1<<zstdTableBits)
+	for i := range table {
+		table[i] = -1
+	}
+
+	literals := make([]byte, 0, len(src))
+	sequences := make([]zstdSequence, 0, 64)
+
+	lit := 0
+	limit := len(src) - zstdMinMatchLen
+	for i := 0; i <= limit && len(sequences) < zstdMaxSequences; {
+		u := binary.LittleEndian.Uint32(src[i:])
+		h := zstdHash(u)
+		cand := int(table[h])
+		table[h] = int32(i)
+
+		if cand < 0 || binary.LittleEndian.Uint32(src[cand:]) != u {
+			i++
+			continue
+		}
+
+		length := zstdMinMatchLen
+		for i+length < len(src) && src[cand+length] == src[i+length] {
+			length++
+		}
+
+		literals = append(literals, src[lit:i]...)
+		sequences = append(sequences, zstdSequence{
+			litLen:   uint32(i - lit),
+			matchLen: uint32(length),
+			offset:   uint32(i - cand),
+		})
+		i += length
+		lit = i
+	}
+	if len(sequences) < 1 {
+		return nil
+	}
+	literals = append(literals, src[lit:]...)
+
+	dst := zstdAppendLiteralsHeader(make([]byte, 0, len(src)), len(literals))
+	dst = append(dst, literals...)
+
+	n := len(sequences)
+	switch {
+	case n < 128:
+		dst = append(dst, byte(n))
+	case n < 0x7f00:
+		dst = append(dst, byte(n>>8)+128, byte(n))
+	default:
+		dst = append(dst, 255, byte(n-0x7f00), byte((n-0x7f00)>>8))
+	}
+	// Predefined tables for literal lengths, offsets and match lengths.
+	dst = append(dst, 0)
+
+	return append(dst, zstdEncodeSequences(sequences)...)
+}
+
+func zstdAppendLiteralsHeader(dst []byte, size int) []byte {
+	switch {
+	case size < 32:
+		return append(dst, byte(size)<<3)
+	case size < 4096:
+		return append(dst, byte(size)<<4|1<<2, byte(size>>4))
+	default:
+		return append(dst, byte(size)<<4|3<<2, byte(size>>4), byte(size>>12))
+	}
+}
+
+func zstdEncodeSequences(sequences []zstdSequence) []byte {
+	n := len(sequences)
+	llCodes := make([]uint8, n)
+	mlCodes := make([]uint8, n)
+	ofCodes := make([]uint8, n)
+	offBases := make([]uint32, n)
+	for i, seq := range sequences {
+		llCodes[i] = zstdLLCode(seq.litLen)
+		mlCodes[i] = zstdMLCode(seq.matchLen)
+		// Offsets are always encoded as new offsets, not repeat offsets.
+		offBases[i] = seq.offset + 3
+		ofCodes[i] = uint8(bits.Len32(offBases[i]) - 1)
+	}
+
+	w := &bitWriter{buf: make([]byte, 0, n*4)}
+	addExtraBits := func(i int) {
+		w.addBits(sequences[i].litLen-zstdLLBase[llCodes[i]], uint32(zstdLLBits[llCodes[i]]))
+		w.addBits(sequences[i].matchLen-zstdMLBase[mlCodes[i]], uint32(zstdMLBits[mlCodes[i]]))
+		w.addBits(offBases[i]-1<<ofCodes[i], uint32(ofCodes[i]))
+	}
+
+	var llState, mlState, ofState fseState
+	mlState.init(zstdMLTable, mlCodes[n-1])
+	ofState.init(zstdOFTable, ofCodes[n-1])
+	llState.init(zstdLLTable, llCodes[n-1])
+	addExtraBits(n - 1)
+
+	for i := n - 2; 0 <= i; i-- {
+		ofState.encode(w, ofCodes[i])
+		mlState.encode(w, mlCodes[i])
+		llState.encode(w, llCodes[i])
+		addExtraBits(i)
+	}
+
+	mlState.flush(w)
+	ofState.flush(w)
+	llState.flush(w)
+	return w.close()
+}
@@ -0,0 +1,288 @@
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io/ioutil"
+	"math/bits"
+	"math/rand"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// zstdDecode decodes the frames written by zstdEncode. Decoding tables are
+// built in the way described in RFC 8878.
+func zstdDecode(src []byte) ([]byte, error) {
+	if len(src) < 13 || binary.LittleEndian.Uint32(src) != zstdMagic || src[4] != 0xe0 {
+		return nil, errors.New("invalid frame header")
+	}
+	size := binary.LittleEndian.Uint64(src[5:])
+	src = src[13:]
+
+	var dst []byte
+	for {
+		if len(src) < 3 {
+			return nil, errors.New("unexpected end of frame")
+		}
+		h := uint32(src[0]) | uint32(src[1])<<8 | uint32(src[2])<<16
+		src = src[3:]
+		blockSize := int(h >> 3)
+		if len(src) < blockSize {
+			return nil, errors.New("unexpected end of block")
+		}
+
+		switch h >> 1 & 3 {
+		case zstdBlockRaw:
+			dst = append(dst, src[:blockSize]...)
+		case zstdBlockCompressed:
+			var err error
+			if dst, err = zstdDecodeBlock(dst, src[:blockSize]); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, errors.New("unsupported block type")
+		}
+		src = src[blockSize:]
+
+		if h&1 == 1 {
+			break
+		}
+	}
+
+	if uint64(len(dst)) != size {
+		return nil, errors.New("frame content size does not match")
+	}
+	return dst, nil
+}
+
+type fseDecodingEntry struct {
+	symbol   uint8
+	nbBits   uint
+	baseline int
+}
+
+func newFSEDecodingTable(norm []int16, accuracyLog uint) []fseDecodingEntry {
+	tableSize := 1 << accuracyLog
+	table := make([]fseDecodingEntry, tableSize)
+	next := make([]int, len(norm))
+
+	high := tableSize - 1
+	for s, n := range norm {
+		if n == -1 {
+			table[high].symbol = uint8(s)
+			high--
+			next[s] = 1
+		} else {
+			next[s] = int(n)
+		}
+	}
+
+	pos := 0
+	step := (tableSize >> 1) + (tableSize >> 3) + 3
+	for s, n := range norm {
+		for i := 0; i < int(n); i++ {
+			table[pos].symbol = uint8(s)
+			pos = (pos + step) & (tableSize - 1)
+			for high < pos {
+				pos = (pos + step) & (tableSize - 1)
+			}
+		}
+	}
+
+	for u := range table {
+		state := next[table[u].symbol]
+		next[table[u].symbol]++
+		table[u].nbBits = accuracyLog - uint(bits.Len(uint(state))-1)
+		table[u].baseline = state<<table[u].nbBits - tableSize
+	}
+	return table
+}
+
+type backwardBitReader struct {
+	src []byte
+	pos int
+}
+
+func newBackwardBitReader(src []byte) (*backwardBitReader, error) {
+	if len(src) < 1 || src[len(src)-1] == 0 {
+		return nil, errors.New("invalid bitstream")
+	}
+	return &backwardBitReader{
+		src: src,
+		pos: (len(src)-1)*8 + bits.Len8(src[len(src)-1]) - 1,
+	}, nil
+}
+
+func (r *backwardBitReader) read(n uint) int {
+	r.pos -= int(n)
+	v := 0
+	for i := int(n) - 1; 0 <= i; i-- {
+		p := r.pos + i
+		v = v<<1 | int(r.src[p/8]>>uint(p%8)&1)
+	}
+	return v
+}
+
+func zstdDecodeBlock(dst []byte, src []byte) ([]byte, error) {
+	if src[0]&3 != 0 {
+		return nil, errors.New("unsupported literals block type")
+	}
+	var litSize, hl int
+	switch src[0] >> 2 & 3 {
+	case 1:
+		litSize, hl = int(src[0]>>4)|int(src[1])<<4, 2
+	case 3:
+		litSize, hl = int(src[0]>>4)|int(src[1])<<4|int(src[2])<<12, 3
+	default:
+		litSize, hl = int(src[0]>>3), 1
+	}
+	literals := src[hl : hl+litSize]
+	src = src[hl+litSize:]
+
+	var nbSeq int
+	switch {
+	case src[0] == 0:
+		return append(dst, literals...), nil
+	case src[0] < 128:
+		nbSeq, src = int(src[0]), src[1:]
+	case src[0] < 255:
+		nbSeq, src = int(src[0]-128)<<8|int(src[1]), src[2:]
+	default:
+		nbSeq, src = int(src[1])|int(src[2])<<8+0x7f00, src[3:]
+	}
+	if src[0] != 0 {
+		return nil, errors.New("unsupported symbol compression modes")
+	}
+
+	r, err := newBackwardBitReader(src[1:])
+	if err != nil {
+		return nil, err
+	}
+
+	llTable := newFSEDecodingTable(zstdLLDefaultNorm, 6)
+	ofTable := newFSEDecodingTable(zstdOFDefaultNorm, 5)
+	mlTable := newFSEDecodingTable(zstdMLDefaultNorm, 6)
+	llState := r.read(6)
+	ofState := r.read(5)
+	mlState := r.read(6)
+
+	for i := 0; i < nbSeq; i++ {
+		ofCode := ofTable[ofState].symbol
+		llCode := llTable[llState].symbol
+		mlCode := mlTable[mlState].symbol
+
+		offBase := 1<<ofCode + r.read(uint(ofCode))
+		matchLen := int(zstdMLBase[mlCode]) + r.read(uint(zstdMLBits[mlCode]))
+		litLen := int(zstdLLBase[llCode]) + r.read(uint(zstdLLBits[llCode]))
+
+		if i < nbSeq-1 {
+			e := llTable[llState]
+			llState = e.baseline + r.read(e.nbBits)
+			e = mlTable[mlState]
+			mlState = e.baseline + r.read(e.nbBits)
+			e = ofTable[ofState]
+			ofState = e.baseline + r.read(e.nbBits)
+		}
+
+		if offBase <= 3 {
+			return nil, errors.New("unsupported repeat offset")
+		}
+		offset := offBase - 3
+		if len(literals) < litLen {
+			return nil, errors.New("literal length exceeds literals")
+		}
+		dst = append(dst, literals[:litLen]...)
+		literals = literals[litLen:]
+		if len(dst) < offset {
+			return nil, errors.New("offset exceeds decoded data")
+		}
+		for j := 0; j < matchLen; j++ {
+			dst = append(dst, dst[len(dst)-offset])
+		}
+	}
+	if r.pos != 0 {
+		return nil, errors.New("bitstream is not fully consumed")
+	}
+	return append(dst, literals...), nil
+}
+
+func TestZstdEncode(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	random := make([]byte, 300000)
+	for i := range random {
+		random[i] = byte('a' + r.Intn(4))
+	}
+
+	var records strings.Builder
+	for i := 0; i < 20000; i++ {
+		records.WriteString(strconv.Itoa(i) + ",name" + strconv.Itoa(r.Intn(1000)) + "," + strconv.FormatFloat(r.Float64(), 'f', -1, 64) + "\n")
+	}
+
+	inputs := [][]byte{
+		{},
+		[]byte("a"),
+		[]byte(strings.Repeat("abcdefgh", 3)),
+		[]byte(strings.Repeat("long repetition ", 20000)),
+		random,
+		[]byte(records.String()),
+	}
+
+	for i, src := range inputs {
+		encoded := zstdEncode(src)
+		decoded, err := zstdDecode(encoded)
+		if err != nil {
+			t.Errorf("input %d: unexpected error %q", i, err)
+			continue
+		}
+		if !bytes.Equal(decoded, src) {
+			t.Errorf("input %d: decoded data does not match the source", i)
+		}
+	}
+}
+
+func TestZstdEncode_Block(t *testing.T) {
+	src := []byte(strings.Repeat("abcd", 100))
+	encoded := zstdEncode(src)
+
+	h := uint32(encoded[13]) | uint32(encoded[14])<<8 | uint32(encoded[15])<<16
+	if h>>1&3 != zstdBlockCompressed {
+		t.Fatalf("block type = %d, want %d", h>>1&3, zstdBlockCompressed)
+	}
+	if len(encoded) != 16+int(h>>3) {
+		t.Errorf("frame size = %d, want %d", len(encoded), 16+int(h>>3))
+	}
+	if 30 < len(encoded) {
+		t.Errorf("frame size = %d, want to be compressed in %d bytes or less", len(encoded), 30)
+	}
+}
+
+var zstdFixtures = []string{
+	"small.txt",
+	"records.csv",
+	"repetition.txt",
+	"random.bin",
+}
+
+// The frames in testdata/zstd were confirmed to be decompressed into the source
+// files by the reference implementation of zstd (zstd 1.5.6).
+func TestZstdEncode_Fixtures(t *testing.T) {
+	dir := filepath.Join("..", "..", "testdata", "zstd")
+
+	for _, name := range zstdFixtures {
+		src, err := ioutil.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatalf("%s: unexpected error %q", name, err)
+		}
+		expect, err := ioutil.ReadFile(filepath.Join(dir, name+".zst"))
+		if err != nil {
+			t.Fatalf("%s: unexpected error %q", name, err)
+		}
+
+		if encoded := zstdEncode(src); !bytes.Equal(encoded, expect) {
+			t.Errorf("%s: encoded frame does not match the fixture", name)
+		}
+	}
+}
@@ -497,6 +497,8 @@ type InlineTable struct {
 	Name      Identifier
 	Fields    []QueryExpression
 	Query     SelectQuery
+	Search    QueryExpression
+	Cycle     QueryExpression
 }
 
 func (e InlineTable) String() string {
@@ -509,6 +511,12 @@ func (e InlineTable) String() string {
 		s = append(s, putParentheses(listQueryExpressions(e.Fields)))
 	}
 	s = append(s, keyword(AS), putParentheses(e.Query.String()))
+	if e.Search != nil {
+		s = append(s, e.Search.String())
+	}
+	if e.Cycle != nil {
+		s = append(s, e.Cycle.String())
+	}
 	return joinWithSpace(s)
 }
 
@@ -516,6 +524,35 @@ func (e InlineTable) IsRecursive() bool {
 	return !e.Recursive.IsEmpty()
 }
 
+type SearchClause struct {
+	*BaseExpr
+	Type      Identifier
+	Columns   []QueryExpression
+	SetColumn Identifier
+}
+
+func (e SearchClause) String() string {
+	return joinWithSpace([]string{keyword(SEARCH), strings.ToUpper(e.Type.Literal), keyword(FIRST), keyword(BY), listQueryExpressions(e.Columns), keyword(SET), e.SetColumn.String()})
+}
+
+func (e SearchClause) IsDepthFirst() bool {
+	return strings.EqualFold(e.Type.Literal, "DEPTH")
+}
+
+func (e SearchClause) IsBreadthFirst() bool {
+	return strings.EqualFold(e.Type.Literal, "BREADTH")
+}
+
+type CycleClause struct {
+	*BaseExpr
+	Columns   []QueryExpression
+	SetColumn Identifier
+}
+
+func (e CycleClause) String() string {
+	return joinWithSpace([]string{keyword(CYCLE), listQueryExpressions(e.Columns), keyword(SET), e.SetColumn.String()})
+}
+
 type Subquery struct {
 	*BaseExpr
 	Query SelectQuery
@@ -564,7 +601,11 @@ type Comparison struct {
 }
 
 func (c Comparison) String() string {
-	s := []string{c.LHS.String(), c.Operator.String(), c.RHS.String()}
+	s := make([]string, 0, 3)
+	if c.LHS != nil {
+		s = append(s, c.LHS.String())
+	}
+	s = append(s, c.Operator.String(), c.RHS.String())
 	return joinWithSpace(s)
 }
 
@@ -601,7 +642,10 @@ func (b Between) IsNegated() bool {
 }
 
 func (b Between) String() string {
-	s := []string{b.LHS.String()}
+	s := make([]string, 0, 6)
+	if b.LHS != nil {
+		s = append(s, b.LHS.String())
+	}
 	if b.IsNegated() {
 		s = append(s, b.Negation.String())
 	}
@@ -753,6 +797,10 @@ type Function struct {
 }
 
 func (e Function) String() string {
+	if len(e.Args) < 1 && isNiladicFunction(e.Name) {
+		return strings.ToUpper(e.Name)
+	}
+
 	var args string
 	if strings.EqualFold(e.Name, keyword(SUBSTRING)) && !e.From.IsEmpty() {
 		elems := make([]string, 0, 5)
@@ -767,6 +815,12 @@ func (e Function) String() string {
 	return strings.ToUpper(e.Name) + "(" + args + ")"
 }
 
+func isNiladicFunction(name string) bool {
+	return strings.EqualFold(name, keyword(CURRENT_DATE)) ||
+		strings.EqualFold(name, keyword(CURRENT_TIME)) ||
+		strings.EqualFold(name, keyword(CURRENT_TIMESTAMP))
+}
+
 type AggregateFunction struct {
 	*BaseExpr
 	Name     string
@@ -822,6 +876,16 @@ func tableName(expr QueryExpression) Identifier {
 	case TableObject:
 		obj, _ := expr.(TableObject)
 		return tableName(obj.Path)
+	case Url:
+		return Identifier{
+			BaseExpr: expr.GetBaseExpr(),
+			Literal:  FormatUrlTableName(expr.(Url).Raw),
+		}
+	case FileGlob:
+		return Identifier{
+			BaseExpr: expr.GetBaseExpr(),
+			Literal:  FormatTableName(expr.(FileGlob).Pattern),
+		}
 	case JsonQuery, Subquery:
 		return Identifier{
 			BaseExpr: expr.GetBaseExpr(),
@@ -942,15 +1006,37 @@ func (si Stdin) String() string {
 	return keyword(STDIN)
 }
 
+type Url struct {
+	*BaseExpr
+	Raw string
+}
+
+func (e Url) String() string {
+	return cmd.QuoteString(e.Raw)
+}
+
+type FileGlob struct {
+	*BaseExpr
+	Pattern string
+}
+
+func (e FileGlob) String() string {
+	return cmd.QuoteString(e.Pattern)
+}
+
 type OrderItem struct {
 	*BaseExpr
 	Value         QueryExpression
+	Collation     Token
 	Direction     Token
 	NullsPosition Token
 }
 
 func (e OrderItem) String() string {
 	s := []string{e.Value.String()}
+	if !e.Collation.IsEmpty() {
+		s = append(s, keyword(COLLATE), cmd.QuoteString(e.Collation.Literal))
+	}
 	if !e.Direction.IsEmpty() {
 		s = append(s, e.Direction.String())
 	}
@@ -1220,11 +1306,13 @@ type UnsetEnvVar struct {
 
 type InsertQuery struct {
 	*BaseExpr
-	WithClause QueryExpression
-	Table      Table
-	Fields     []QueryExpression
-	ValuesList []QueryExpression
-	Query      QueryExpression
+	WithClause    QueryExpression
+	Table         Table
+	Fields        []QueryExpression
+	ValuesList    []QueryExpression
+	Query         QueryExpression
+	DefaultValues bool
+	Returning     []QueryExpression
 }
 
 type UpdateQuery struct {
@@ -1234,6 +1322,7 @@ type UpdateQuery struct {
 	SetList     []UpdateSet
 	FromClause  QueryExpression
 	WhereClause QueryExpression
+	Returning   []QueryExpression
 }
 
 type UpdateSet struct {
@@ -1258,13 +1347,16 @@ type DeleteQuery struct {
 	Tables      []QueryExpression
 	FromClause  FromClause
 	WhereClause QueryExpression
+	Returning   []QueryExpression
 }
 
 type CreateTable struct {
 	*BaseExpr
-	Table  Identifier
-	Fields []QueryExpression
-	Query  QueryExpression
+	Table   Identifier
+	Fields  []QueryExpression
+	Columns []ColumnDefault
+	Checks  []QueryExpression
+	Query   QueryExpression
 }
 
 type AddColumns struct {
@@ -1276,8 +1368,11 @@ type AddColumns struct {
 
 type ColumnDefault struct {
 	*BaseExpr
-	Column Identifier
-	Value  QueryExpression
+	Column  Identifier
+	Value   QueryExpression
+	NotNull bool
+	Unique  bool
+	Checks  []QueryExpression
 }
 
 type ColumnPosition struct {
@@ -1411,6 +1506,18 @@ type ShowFields struct {
 	Table QueryExpression
 }
 
+type Describe struct {
+	*BaseExpr
+	Table  QueryExpression
+	Sample QueryExpression
+}
+
+type ExplainAnalyze struct {
+	*BaseExpr
+	Query Statement
+	Apply bool
+}
+
 type If struct {
 	*BaseExpr
 	Condition  QueryExpression
@@ -620,6 +620,53 @@ func TestInlineTable_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	e.Cycle = CycleClause{
+		Columns: []QueryExpression{
+			Identifier{Literal: "column1"},
+		},
+		SetColumn: Identifier{Literal: "is_cycle"},
+	}
+	expect = "RECURSIVE it (column1) AS (SELECT 1) CYCLE column1 SET is_cycle"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+
+	e.Search = SearchClause{
+		Type: Identifier{Literal: "depth"},
+		Columns: []QueryExpression{
+			Identifier{Literal: "column1"},
+		},
+		SetColumn: Identifier{Literal: "seq"},
+	}
+	expect = "RECURSIVE it (column1) AS (SELECT 1) SEARCH DEPTH FIRST BY column1 SET seq CYCLE column1 SET is_cycle"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestSearchClause_IsDepthFirst(t *testing.T) {
+	e := SearchClause{Type: Identifier{Literal: "depth"}}
+	if e.IsDepthFirst() != true {
+		t.Errorf("result = %t, want %t for %#v", e.IsDepthFirst(), true, e)
+	}
+
+	e = SearchClause{Type: Identifier{Literal: "breadth"}}
+	if e.IsDepthFirst() != false {
+		t.Errorf("result = %t, want %t for %#v", e.IsDepthFirst(), false, e)
+	}
+}
+
+func TestSearchClause_IsBreadthFirst(t *testing.T) {
+	e := SearchClause{Type: Identifier{Literal: "Breadth"}}
+	if e.IsBreadthFirst() != true {
+		t.Errorf("result = %t, want %t for %#v", e.IsBreadthFirst(), true, e)
+	}
+
+	e = SearchClause{Type: Identifier{Literal: "depth"}}
+	if e.IsBreadthFirst() != false {
+		t.Errorf("result = %t, want %t for %#v", e.IsBreadthFirst(), false, e)
+	}
 }
 
 func TestInlineTable_IsRecursive(t *testing.T) {
@@ -734,6 +781,15 @@ func TestComparison_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	e = Comparison{
+		Operator: Token{Token: '>', Literal: ">"},
+		RHS:      NewIntegerValueFromString("1"),
+	}
+	expect = "> 1"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
 }
 
 func TestIs_IsNegated(t *testing.T) {
@@ -783,6 +839,15 @@ func TestBetween_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	e = Between{
+		Low:  NewIntegerValueFromString("-10"),
+		High: NewIntegerValueFromString("10"),
+	}
+	expect = "BETWEEN -10 AND 10"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
 }
 
 func TestIn_IsNegated(t *testing.T) {
@@ -1047,6 +1112,25 @@ func TestFunction_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	e = Function{
+		Name: "current_date",
+	}
+	expect = "CURRENT_DATE"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+
+	e = Function{
+		Name: "current_timestamp",
+		Args: []QueryExpression{
+			NewIntegerValue(3),
+		},
+	}
+	expect = "CURRENT_TIMESTAMP(3)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
 }
 
 func TestAggregateFunction_String(t *testing.T) {
@@ -1164,6 +1248,22 @@ func TestTable_Name(t *testing.T) {
 	if !reflect.DeepEqual(e.Name(), expect) {
 		t.Errorf("name = %q, want %q for %#v", e.Name(), expect, e)
 	}
+
+	e = Table{
+		Object: Url{Raw: "https://example.com/data.csv"},
+	}
+	expect = Identifier{Literal: "data"}
+	if !reflect.DeepEqual(e.Name(), expect) {
+		t.Errorf("name = %q, want %q for %#v", e.Name(), expect, e)
+	}
+
+	e = Table{
+		Object: FileGlob{Pattern: "/path/to/sales_*.csv"},
+	}
+	expect = Identifier{Literal: "sales_*"}
+	if !reflect.DeepEqual(e.Name(), expect) {
+		t.Errorf("name = %q, want %q for %#v", e.Name(), expect, e)
+	}
 }
 
 func TestJoin_String(t *testing.T) {
@@ -1309,6 +1409,22 @@ func TestStdin_String(t *testing.T) {
 	}
 }
 
+func TestUrl_String(t *testing.T) {
+	s := "'https://example.com/data.csv'"
+	e := Url{Raw: "https://example.com/data.csv"}
+	if e.String() != s {
+		t.Errorf("string = %q, want %q for %#v", e.String(), s, e)
+	}
+}
+
+func TestFileGlob_String(t *testing.T) {
+	s := "'sales_*.csv'"
+	e := FileGlob{Pattern: "sales_*.csv"}
+	if e.String() != s {
+		t.Errorf("string = %q, want %q for %#v", e.String(), s, e)
+	}
+}
+
 func TestOrderItem_String(t *testing.T) {
 	e := OrderItem{
 		Value:     Identifier{Literal: "column"},
@@ -1335,6 +1451,16 @@ func TestOrderItem_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	e = OrderItem{
+		Value:     Identifier{Literal: "column"},
+		Collation: Token{Token: STRING, Literal: "de-DE"},
+		Direction: Token{Token: DESC, Literal: "desc"},
+	}
+	expect = "column COLLATE 'de-DE' DESC"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
 }
 
 func TestCase_String(t *testing.T) {
@@ -1,6 +1,8 @@
 package parser
 
 import (
+	"net/url"
+	"path"
 	"path/filepath"
 	"strings"
 	"time"
@@ -15,6 +17,19 @@ func FormatTableName(s string) string {
 	return strings.TrimSuffix(filepath.Base(s), filepath.Ext(s))
 }
 
+func FormatUrlTableName(s string) string {
+	u, err := url.Parse(s)
+	if err != nil {
+		return ""
+	}
+	name := strings.TrimSuffix(path.Base(u.Path), path.Ext(u.Path))
+	switch name {
+	case "", ".", "/":
+		return u.Hostname()
+	}
+	return name
+}
+
 func FormatFieldIdentifier(e QueryExpression) string {
 	if pt, ok := e.(PrimitiveType); ok {
 		if s, ok := pt.Value.(*value.String); ok {
@@ -36,6 +36,22 @@ func TestFormatTableName(t *testing.T) {
 	}
 }
 
+func TestFormatUrlTableName(t *testing.T) {
+	s := "https://example.com/path/data.csv?key=value"
+	expect := "data"
+	result := FormatUrlTableName(s)
+	if result != expect {
+		t.Errorf("table name = %q, want %q for %q", result, expect, s)
+	}
+
+	s = "https://example.com/"
+	expect = "example.com"
+	result = FormatUrlTableName(s)
+	if result != expect {
+		t.Errorf("table name = %q, want %q for %q", result, expect, s)
+	}
+}
+
 func TestFormatFieldIdentifier(t *testing.T) {
 	var e QueryExpression = NewStringValue("str")
 	expect := "str"
@@ -2,7 +2,9 @@
 package parser
 
 import (
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/mithrandie/csvq/lib/value"
 )
@@ -61,7 +63,9 @@ import (
 %type<statement>   transaction_statement
 %type<statement>   table_operation_statement
 %type<columndef>   column_default
+%type<columndef>   column_constraints
 %type<columndefs>  column_defaults
+%type<queryexprs>  table_checks
 %type<expression>  column_position
 %type<statement>   cursor_statement
 %type<statement>   temporary_table_statement
@@ -98,6 +102,8 @@ import (
 %type<queryexpr>   offset_clause
 %type<queryexpr>   with_clause
 %type<queryexpr>   inline_table
+%type<queryexpr>   search_clause
+%type<queryexpr>   cycle_clause
 %type<queryexprs>  inline_tables
 %type<queryexpr>   primitive_type
 %type<queryexpr>   ternary
@@ -111,6 +117,7 @@ import (
 %type<queryexprs>  order_items
 %type<queryexpr>   order_item
 %type<queryexpr>   order_value
+%type<token>       order_collation
 %type<token>       order_direction
 %type<token>       order_null_position
 %type<queryexpr>   subquery
@@ -149,6 +156,7 @@ import (
 %type<queryexpr>   case_expr
 %type<queryexpr>   case_value
 %type<queryexprs>  case_expr_when
+%type<queryexpr>   case_expr_when_condition
 %type<queryexpr>   case_expr_else
 %type<queryexprs>  field_references
 %type<queryexprs>  values
@@ -156,6 +164,7 @@ import (
 %type<queryexprs>  tables
 %type<queryexprs>  identifiers
 %type<queryexprs>  fields
+%type<queryexprs>  returning_clause
 %type<expression>  insert_query
 %type<expression>  update_query
 %type<updateset>   update_set
@@ -200,8 +209,8 @@ import (
 %token<token> IDENTIFIER STRING INTEGER FLOAT BOOLEAN TERNARY DATETIME
 %token<token> VARIABLE FLAG ENVIRONMENT_VARIABLE RUNTIME_INFORMATION EXTERNAL_COMMAND PLACEHOLDER
 %token<token> SELECT FROM UPDATE SET UNSET DELETE WHERE INSERT INTO VALUES REPLACE AS DUAL STDIN
-%token<token> RECURSIVE
-%token<token> CREATE ADD DROP ALTER TABLE FIRST LAST AFTER BEFORE DEFAULT RENAME TO VIEW
+%token<token> RECURSIVE RETURNING CYCLE SEARCH COLLATE
+%token<token> CREATE ADD DROP ALTER TABLE FIRST LAST AFTER BEFORE DEFAULT UNIQUE CHECK RENAME TO VIEW
 %token<token> ORDER GROUP HAVING BY ASC DESC LIMIT OFFSET PERCENT
 %token<token> JOIN INNER OUTER LEFT RIGHT FULL CROSS ON USING NATURAL LATERAL
 %token<token> UNION INTERSECT EXCEPT
@@ -218,16 +227,17 @@ import (
 %token<token> ECHO PRINT PRINTF SOURCE EXECUTE CHDIR PWD RELOAD REMOVE SYNTAX TRIGGER
 %token<token> FUNCTION AGGREGATE BEGIN RETURN
 %token<token> IGNORE WITHIN
-%token<token> VAR SHOW
+%token<token> VAR SHOW DESCRIBE EXPLAIN ANALYZE
 %token<token> TIES NULLS ROWS ONLY
 %token<token> CSV JSON FIXED LTSV
 %token<token> JSON_ROW JSON_TABLE
-%token<token> SUBSTRING COUNT JSON_OBJECT
+%token<token> SUBSTRING COUNT CURRENT_DATE CURRENT_TIME CURRENT_TIMESTAMP JSON_OBJECT
 %token<token> AGGREGATE_FUNCTION LIST_FUNCTION ANALYTIC_FUNCTION FUNCTION_NTH FUNCTION_WITH_INS
-%token<token> COMPARISON_OP STRING_OP SUBSTITUTION_OP
-%token<token> UMINUS UPLUS
-%token<token> ';' '=' '-' '+' '*' '/' '%' '!' '(' ')'
+%token<token> COMPARISON_OP STRING_OP SHIFT_OP SUBSTITUTION_OP
+%token<token> UMINUS UPLUS COLUMN_DEFAULT
+%token<token> ';' '=' '-' '+' '*' '/' '%' '!' '&' '|' '^' '~' '(' ')'
 
+%nonassoc COLUMN_DEFAULT
 %right SUBSTITUTION_OP
 %left UNION EXCEPT
 %left INTERSECT
@@ -237,9 +247,13 @@ import (
 %right NOT
 %nonassoc '=' COMPARISON_OP IS BETWEEN IN LIKE
 %left STRING_OP
+%left '|'
+%left '^'
+%left '&'
+%left SHIFT_OP
 %left '+' '-'
 %left '*' '/' '%'
-%right UMINUS UPLUS '!'
+%right UMINUS UPLUS '!' '~'
 
 %%
 
@@ -635,13 +649,25 @@ transaction_statement
     }
 
 table_operation_statement
-    : CREATE TABLE identifier '(' identifiers ')'
+    : CREATE TABLE identifier '(' column_defaults ')'
     {
-        $$ = CreateTable{Table: $3, Fields: $5}
+        fields, columns := splitColumnDefaults($5)
+        $$ = CreateTable{Table: $3, Fields: fields, Columns: columns}
     }
-    | CREATE TABLE identifier '(' identifiers ')' as select_query
+    | CREATE TABLE identifier '(' column_defaults ',' table_checks ')'
     {
-        $$ = CreateTable{Table: $3, Fields: $5, Query: $8}
+        fields, columns := splitColumnDefaults($5)
+        $$ = CreateTable{Table: $3, Fields: fields, Columns: columns, Checks: $7}
+    }
+    | CREATE TABLE identifier '(' column_defaults ')' as select_query
+    {
+        fields, columns := splitColumnDefaults($5)
+        $$ = CreateTable{Table: $3, Fields: fields, Columns: columns, Query: $8}
+    }
+    | CREATE TABLE identifier '(' column_defaults ',' table_checks ')' as select_query
+    {
+        fields, columns := splitColumnDefaults($5)
+        $$ = CreateTable{Table: $3, Fields: fields, Columns: columns, Checks: $7, Query: $10}
     }
     | CREATE TABLE identifier as select_query
     {
@@ -677,13 +703,42 @@ table_operation_statement
     }
 
 column_default
-    : identifier
+    : identifier column_constraints
+    {
+        $2.Column = $1
+        $$ = $2
+    }
+
+column_constraints
+    :
+    {
+        $$ = ColumnDefault{}
+    }
+    | column_constraints NOT NULL
+    {
+        $1.NotNull = true
+        $$ = $1
+    }
+    | column_constraints UNIQUE
     {
-        $$ = ColumnDefault{Column: $1}
+        $1.Unique = true
+        $$ = $1
     }
-    | identifier DEFAULT value
+    | column_constraints CHECK '(' value ')'
     {
-        $$ = ColumnDefault{Column: $1, Value: $3}
+        $1.Checks = append($1.Checks, $4)
+        $$ = $1
+    }
+    | column_constraints DEFAULT value %prec COLUMN_DEFAULT
+    {
+        $1.Value = $3
+        $$ = $1
+    }
+    | column_constraints DEFAULT value NOT NULL
+    {
+        $1.Value = $3
+        $1.NotNull = true
+        $$ = $1
     }
 
 column_defaults
@@ -691,9 +746,19 @@ column_defaults
     {
         $$ = []ColumnDefault{$1}
     }
-    | column_default ',' column_defaults
+    | column_defaults ',' column_default
     {
-        $$ = append([]ColumnDefault{$1}, $3...)
+        $$ = append($1, $3)
+    }
+
+table_checks
+    : CHECK '(' value ')'
+    {
+        $$ = []QueryExpression{$3}
+    }
+    | table_checks ',' CHECK '(' value ')'
+    {
+        $$ = append($1, $5)
     }
 
 column_position
@@ -995,7 +1060,48 @@ command_statement
     }
     | SHOW identifier FROM updatable_table_identifier
     {
-        $$ = ShowFields{BaseExpr: NewBaseExpr($1), Type: $2, Table: $4}
+        if strings.EqualFold($2.Literal, "COLUMNS") {
+            $$ = Describe{BaseExpr: NewBaseExpr($1), Table: $4}
+        } else {
+            $$ = ShowFields{BaseExpr: NewBaseExpr($1), Type: $2, Table: $4}
+        }
+    }
+    | DESCRIBE virtual_table_object
+    {
+        $$ = Describe{BaseExpr: NewBaseExpr($1), Table: $2}
+    }
+    | DESCRIBE virtual_table_object IDENTIFIER substantial_value
+    {
+        if !strings.EqualFold($3.Literal, "SAMPLE") {
+            yylex.(*Lexer).err = NewSyntaxError(fmt.Sprintf("syntax error: unexpected token %q", $3.Literal), $3)
+        }
+        $$ = Describe{BaseExpr: NewBaseExpr($1), Table: $2, Sample: $4}
+    }
+    | EXPLAIN ANALYZE select_query
+    {
+        $$ = ExplainAnalyze{BaseExpr: NewBaseExpr($1), Query: $3}
+    }
+    | EXPLAIN ANALYZE update_query
+    {
+        $$ = ExplainAnalyze{BaseExpr: NewBaseExpr($1), Query: $3}
+    }
+    | EXPLAIN ANALYZE delete_query
+    {
+        $$ = ExplainAnalyze{BaseExpr: NewBaseExpr($1), Query: $3}
+    }
+    | EXPLAIN ANALYZE IDENTIFIER update_query
+    {
+        if !strings.EqualFold($3.Literal, "APPLY") {
+            yylex.(*Lexer).err = NewSyntaxError(fmt.Sprintf("syntax error: unexpected token %q", $3.Literal), $3)
+        }
+        $$ = ExplainAnalyze{BaseExpr: NewBaseExpr($1), Query: $4, Apply: true}
+    }
+    | EXPLAIN ANALYZE IDENTIFIER delete_query
+    {
+        if !strings.EqualFold($3.Literal, "APPLY") {
+            yylex.(*Lexer).err = NewSyntaxError(fmt.Sprintf("syntax error: unexpected token %q", $3.Literal), $3)
+        }
+        $$ = ExplainAnalyze{BaseExpr: NewBaseExpr($1), Query: $4, Apply: true}
     }
     | CHDIR identifier
     {
@@ -1095,6 +1201,16 @@ select_entity
             HavingClause:  $5,
         }
     }
+    | TABLE virtual_table_object
+    {
+        $$ = SelectEntity{
+            SelectClause: SelectClause{
+                BaseExpr: NewBaseExpr($1),
+                Fields:   []QueryExpression{Field{Object: AllColumns{BaseExpr: NewBaseExpr($1)}}},
+            },
+            FromClause: FromClause{Tables: []QueryExpression{Table{Object: $2}}},
+        }
+    }
     | select_set_entity UNION all select_set_entity
     {
         $$ = SelectSet{
@@ -1302,13 +1418,33 @@ with_clause
     }
 
 inline_table
-    : recursive identifier AS '(' select_query ')'
+    : recursive identifier AS '(' select_query ')' search_clause cycle_clause
+    {
+        $$ = InlineTable{Recursive: $1, Name: $2, Query: $5.(SelectQuery), Search: $7, Cycle: $8}
+    }
+    | recursive identifier '(' identifiers ')' AS '(' select_query ')' search_clause cycle_clause
+    {
+        $$ = InlineTable{Recursive: $1, Name: $2, Fields: $4, Query: $8.(SelectQuery), Search: $10, Cycle: $11}
+    }
+
+search_clause
+    :
     {
-        $$ = InlineTable{Recursive: $1, Name: $2, Query: $5.(SelectQuery)}
+        $$ = nil
     }
-    | recursive identifier '(' identifiers ')' AS '(' select_query ')'
+    | SEARCH identifier FIRST BY identifiers SET identifier
     {
-        $$ = InlineTable{Recursive: $1, Name: $2, Fields: $4, Query: $8.(SelectQuery)}
+        $$ = SearchClause{BaseExpr: NewBaseExpr($1), Type: $2, Columns: $5, SetColumn: $7}
+    }
+
+cycle_clause
+    :
+    {
+        $$ = nil
+    }
+    | CYCLE identifiers SET identifier
+    {
+        $$ = CycleClause{BaseExpr: NewBaseExpr($1), Columns: $2, SetColumn: $4}
     }
 
 inline_tables
@@ -1510,13 +1646,13 @@ order_items
     }
 
 order_item
-    : order_value order_direction
+    : order_value order_collation order_direction
     {
-        $$ = OrderItem{Value: $1, Direction: $2}
+        $$ = OrderItem{Value: $1, Collation: $2, Direction: $3}
     }
-    | order_value order_direction NULLS order_null_position
+    | order_value order_collation order_direction NULLS order_null_position
     {
-        $$ = OrderItem{Value: $1, Direction: $2, NullsPosition: $4}
+        $$ = OrderItem{Value: $1, Collation: $2, Direction: $3, NullsPosition: $5}
     }
 
 order_value
@@ -1529,6 +1665,16 @@ order_value
         $$ = $1
     }
 
+order_collation
+    :
+    {
+        $$ = Token{}
+    }
+    | COLLATE STRING
+    {
+        $$ = $2
+    }
+
 order_direction
     :
     {
@@ -1703,6 +1849,26 @@ arithmetic
     {
         $$ = UnaryArithmetic{Operand: $2, Operator: $1}
     }
+    | value '&' value
+    {
+        $$ = Arithmetic{LHS: $1, Operator: $2, RHS: $3}
+    }
+    | value '|' value
+    {
+        $$ = Arithmetic{LHS: $1, Operator: $2, RHS: $3}
+    }
+    | value '^' value
+    {
+        $$ = Arithmetic{LHS: $1, Operator: $2, RHS: $3}
+    }
+    | value SHIFT_OP value
+    {
+        $$ = Arithmetic{LHS: $1, Operator: $2, RHS: $3}
+    }
+    | '~' value
+    {
+        $$ = UnaryArithmetic{Operand: $2, Operator: $1}
+    }
 
 logic
     : value OR value
@@ -1765,6 +1931,26 @@ function
     {
         $$ = Function{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3}
     }
+    | CURRENT_DATE
+    {
+        $$ = Function{BaseExpr: NewBaseExpr($1), Name: $1.Literal}
+    }
+    | CURRENT_TIME
+    {
+        $$ = Function{BaseExpr: NewBaseExpr($1), Name: $1.Literal}
+    }
+    | CURRENT_TIME '(' arguments ')'
+    {
+        $$ = Function{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3}
+    }
+    | CURRENT_TIMESTAMP
+    {
+        $$ = Function{BaseExpr: NewBaseExpr($1), Name: $1.Literal}
+    }
+    | CURRENT_TIMESTAMP '(' arguments ')'
+    {
+        $$ = Function{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3}
+    }
 
 
 aggregate_function
@@ -1802,6 +1988,18 @@ list_function
     {
         $$ = ListFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, OrderBy: $9}
     }
+    | LIST_FUNCTION '(' distinct arguments ORDER BY order_items ')'
+    {
+        $$ = ListFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, OrderBy: OrderByClause{Items: $7}}
+    }
+    | FIRST '(' arguments ORDER BY order_items ')'
+    {
+        $$ = ListFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3, OrderBy: OrderByClause{Items: $6}}
+    }
+    | LAST '(' arguments ORDER BY order_items ')'
+    {
+        $$ = ListFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3, OrderBy: OrderByClause{Items: $6}}
+    }
 
 analytic_function
     : identifier '(' arguments ')' OVER '(' analytic_clause_with_windowing ')'
@@ -1832,6 +2030,18 @@ analytic_function
     {
         $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, AnalyticClause: $8.(AnalyticClause)}
     }
+    | LIST_FUNCTION '(' distinct arguments ')' WITHIN GROUP '(' order_by_clause ')' OVER '(' partition_clause ')'
+    {
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, AnalyticClause: AnalyticClause{PartitionClause: $13, OrderByClause: $9}}
+    }
+    | FIRST '(' arguments ORDER BY order_items ')' OVER '(' partition_clause ')'
+    {
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3, AnalyticClause: AnalyticClause{PartitionClause: $10, OrderByClause: OrderByClause{Items: $6}}}
+    }
+    | LAST '(' arguments ORDER BY order_items ')' OVER '(' partition_clause ')'
+    {
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3, AnalyticClause: AnalyticClause{PartitionClause: $10, OrderByClause: OrderByClause{Items: $6}}}
+    }
     | ANALYTIC_FUNCTION '(' arguments ')' OVER '(' analytic_clause ')'
     {
         $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3, AnalyticClause: $7.(AnalyticClause)}
@@ -2001,6 +2211,10 @@ virtual_table_object
     {
         $$ = $1
     }
+    | STRING
+    {
+        $$ = newStringTable($1)
+    }
     | JSON_TABLE '(' substantial_value ',' identifier ')'
     {
         $$ = JsonQuery{BaseExpr: NewBaseExpr($1), JsonQuery: $1, Query: $3, JsonText: $5}
@@ -2179,15 +2393,33 @@ case_value
     }
 
 case_expr_when
-    : WHEN value THEN value
+    : WHEN case_expr_when_condition THEN value
     {
         $$ = []QueryExpression{CaseExprWhen{Condition: $2, Result: $4}}
     }
-    | WHEN value THEN value case_expr_when
+    | WHEN case_expr_when_condition THEN value case_expr_when
     {
         $$ = append([]QueryExpression{CaseExprWhen{Condition: $2, Result: $4}}, $5...)
     }
 
+case_expr_when_condition
+    : value
+    {
+        $$ = $1
+    }
+    | comparison_operator value
+    {
+        $$ = Comparison{Operator: $1, RHS: $2}
+    }
+    | BETWEEN value AND value
+    {
+        $$ = Between{Low: $2, High: $4}
+    }
+    | NOT BETWEEN value AND value
+    {
+        $$ = Between{Low: $3, High: $5, Negation: $1}
+    }
+
 case_expr_else
     :
     {
@@ -2279,27 +2511,41 @@ fields
     }
 
 insert_query
-    : with_clause INSERT INTO updatable_table_identifier VALUES row_values
+    : with_clause INSERT INTO updatable_table_identifier VALUES row_values returning_clause
+    {
+        $$ = InsertQuery{WithClause: $1, Table: Table{Object: $4}, ValuesList: $6, Returning: $7}
+    }
+    | with_clause INSERT INTO updatable_table_identifier '(' field_references ')' VALUES row_values returning_clause
     {
-        $$ = InsertQuery{WithClause: $1, Table: Table{Object: $4}, ValuesList: $6}
+        $$ = InsertQuery{WithClause: $1, Table: Table{Object: $4}, Fields: $6, ValuesList: $9, Returning: $10}
     }
-    | with_clause INSERT INTO updatable_table_identifier '(' field_references ')' VALUES row_values
+    | with_clause INSERT INTO updatable_table_identifier select_query returning_clause
     {
-        $$ = InsertQuery{WithClause: $1, Table: Table{Object: $4}, Fields: $6, ValuesList: $9}
+        $$ = InsertQuery{WithClause: $1, Table: Table{Object: $4}, Query: $5.(SelectQuery), Returning: $6}
     }
-    | with_clause INSERT INTO updatable_table_identifier select_query
+    | with_clause INSERT INTO updatable_table_identifier '(' field_references ')' select_query returning_clause
     {
-        $$ = InsertQuery{WithClause: $1, Table: Table{Object: $4}, Query: $5.(SelectQuery)}
+        $$ = InsertQuery{WithClause: $1, Table: Table{Object: $4}, Fields: $6, Query: $8.(SelectQuery), Returning: $9}
     }
-    | with_clause INSERT INTO updatable_table_identifier '(' field_references ')' select_query
+    | with_clause INSERT INTO updatable_table_identifier DEFAULT VALUES returning_clause
     {
-        $$ = InsertQuery{WithClause: $1, Table: Table{Object: $4}, Fields: $6, Query: $8.(SelectQuery)}
+        $$ = InsertQuery{WithClause: $1, Table: Table{Object: $4}, DefaultValues: true, Returning: $7}
     }
 
 update_query
-    : with_clause UPDATE updatable_tables SET update_set_list from_clause where_clause
+    : with_clause UPDATE updatable_tables SET update_set_list from_clause where_clause returning_clause
     {
-        $$ = UpdateQuery{WithClause: $1, Tables: $3, SetList: $5, FromClause: $6, WhereClause: $7}
+        $$ = UpdateQuery{WithClause: $1, Tables: $3, SetList: $5, FromClause: $6, WhereClause: $7, Returning: $8}
+    }
+
+returning_clause
+    :
+    {
+        $$ = nil
+    }
+    | RETURNING fields
+    {
+        $$ = $2
     }
 
 update_set
@@ -2353,13 +2599,13 @@ replace_query
     }
 
 delete_query
-    : with_clause DELETE FROM tables where_clause
+    : with_clause DELETE FROM tables where_clause returning_clause
     {
-        $$ = DeleteQuery{BaseExpr: NewBaseExpr($2), WithClause: $1, FromClause: FromClause{Tables: $4}, WhereClause: $5}
+        $$ = DeleteQuery{BaseExpr: NewBaseExpr($2), WithClause: $1, FromClause: FromClause{Tables: $4}, WhereClause: $5, Returning: $6}
     }
-    | with_clause DELETE identified_tables FROM tables where_clause
+    | with_clause DELETE identified_tables FROM tables where_clause returning_clause
     {
-        $$ = DeleteQuery{BaseExpr: NewBaseExpr($2), WithClause: $1, Tables: $3, FromClause: FromClause{Tables: $5}, WhereClause: $6}
+        $$ = DeleteQuery{BaseExpr: NewBaseExpr($2), WithClause: $1, Tables: $3, FromClause: FromClause{Tables: $5}, WhereClause: $6, Returning: $7}
     }
 
 elseif
@@ -2555,6 +2801,38 @@ identifier
     {
         $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
     }
+    | UNIQUE
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | CHECK
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | CYCLE
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | SEARCH
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | COLLATE
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | DESCRIBE
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | EXPLAIN
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | ANALYZE
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
 
 variable
     : VARIABLE
@@ -2718,6 +2996,34 @@ func SetDebugLevel(level int, verbose bool) {
 	yyErrorVerbose = verbose
 }
 
+// splitColumnDefaults returns the column names and the column definitions.
+// The column definitions are nil if no column has a default value or
+// a constraint.
+func splitColumnDefaults(columns []ColumnDefault) ([]QueryExpression, []ColumnDefault) {
+    fields := make([]QueryExpression, len(columns))
+    hasDefinition := false
+    for i, c := range columns {
+        fields[i] = c.Column
+        if c.Value != nil || c.NotNull || c.Unique || c.Checks != nil {
+            hasDefinition = true
+        }
+    }
+    if !hasDefinition {
+        return fields, nil
+    }
+    return fields, columns
+}
+
+// newStringTable returns a url if the string begins with http:// or https://,
+// otherwise returns a file glob pattern.
+func newStringTable(token Token) QueryExpression {
+    lit := strings.ToLower(token.Literal)
+    if strings.HasPrefix(lit, "http://") || strings.HasPrefix(lit, "https://") {
+        return Url{BaseExpr: NewBaseExpr(token), Raw: token.Literal}
+    }
+    return FileGlob{BaseExpr: NewBaseExpr(token), Pattern: token.Literal}
+}
+
 func Parse(s string, sourceFile string, datetimeFormats []string, forPrepared bool, ansiQuotes bool) ([]Statement, int, error) {
     l := new(Lexer)
     l.Init(s, sourceFile, datetimeFormats, forPrepared, ansiQuotes)
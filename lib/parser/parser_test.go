@@ -93,6 +93,39 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select c1 from t order by c1 collate 'de-DE' desc nulls last, c2",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{
+								Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "c1"}},
+							},
+						},
+					},
+					FromClause: FromClause{Tables: []QueryExpression{
+						Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "t"}},
+					}},
+				},
+				OrderByClause: OrderByClause{
+					Items: []QueryExpression{
+						OrderItem{
+							Value:         FieldReference{BaseExpr: &BaseExpr{line: 1, char: 27}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 27}, Literal: "c1"}},
+							Collation:     Token{Token: STRING, Literal: "de-DE", Line: 1, Char: 38},
+							Direction:     Token{Token: DESC, Literal: "desc", Line: 1, Char: 46},
+							NullsPosition: Token{Token: LAST, Literal: "last", Line: 1, Char: 57},
+						},
+						OrderItem{
+							Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 63}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 63}, Literal: "c2"}},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select 1 as a from dual",
 		Output: []Statement{
@@ -115,6 +148,34 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "table t1 union table t2",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectSet{
+					LHS: SelectEntity{
+						SelectClause: SelectClause{
+							BaseExpr: &BaseExpr{line: 1, char: 1},
+							Fields:   []QueryExpression{Field{Object: AllColumns{BaseExpr: &BaseExpr{line: 1, char: 1}}}},
+						},
+						FromClause: FromClause{Tables: []QueryExpression{
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 7}, Literal: "t1"}},
+						}},
+					},
+					Operator: Token{Token: UNION, Literal: "union", Line: 1, Char: 10},
+					RHS: SelectEntity{
+						SelectClause: SelectClause{
+							BaseExpr: &BaseExpr{line: 1, char: 16},
+							Fields:   []QueryExpression{Field{Object: AllColumns{BaseExpr: &BaseExpr{line: 1, char: 16}}}},
+						},
+						FromClause: FromClause{Tables: []QueryExpression{
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 22}, Literal: "t2"}},
+						}},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select c1 from stdin",
 		Output: []Statement{
@@ -358,6 +419,52 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select c1 from 'https://example.com/data.csv' t",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{
+								Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "c1"}},
+							},
+						},
+					},
+					FromClause: FromClause{Tables: []QueryExpression{
+						Table{
+							Object: Url{BaseExpr: &BaseExpr{line: 1, char: 16}, Raw: "https://example.com/data.csv"},
+							Alias:  Identifier{BaseExpr: &BaseExpr{line: 1, char: 47}, Literal: "t"},
+						},
+					}},
+				},
+			},
+		},
+	},
+	{
+		Input: "select c1 from 'sales_*.csv' s",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{
+								Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "c1"}},
+							},
+						},
+					},
+					FromClause: FromClause{Tables: []QueryExpression{
+						Table{
+							Object: FileGlob{BaseExpr: &BaseExpr{line: 1, char: 16}, Pattern: "sales_*.csv"},
+							Alias:  Identifier{BaseExpr: &BaseExpr{line: 1, char: 30}, Literal: "s"},
+						},
+					}},
+				},
+			},
+		},
+	},
 	{
 		Input: "select c1 from json_table('key', `table.json`)",
 		Output: []Statement{
@@ -1048,6 +1155,90 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "with recursive ct as (select 1) cycle c1, c2 set is_cycle select * from ct",
+		Output: []Statement{
+			SelectQuery{
+				WithClause: WithClause{
+					InlineTables: []QueryExpression{
+						InlineTable{
+							Name:      Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "ct"},
+							Recursive: Token{Token: RECURSIVE, Literal: "recursive", Line: 1, Char: 6},
+							Query: SelectQuery{
+								SelectEntity: SelectEntity{
+									SelectClause: SelectClause{
+										BaseExpr: &BaseExpr{line: 1, char: 23},
+										Fields: []QueryExpression{
+											Field{Object: NewIntegerValueFromString("1")},
+										},
+									},
+								},
+							},
+							Cycle: CycleClause{
+								BaseExpr: &BaseExpr{line: 1, char: 33},
+								Columns: []QueryExpression{
+									Identifier{BaseExpr: &BaseExpr{line: 1, char: 39}, Literal: "c1"},
+									Identifier{BaseExpr: &BaseExpr{line: 1, char: 43}, Literal: "c2"},
+								},
+								SetColumn: Identifier{BaseExpr: &BaseExpr{line: 1, char: 50}, Literal: "is_cycle"},
+							},
+						},
+					},
+				},
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 59},
+						Fields:   []QueryExpression{Field{Object: AllColumns{BaseExpr: &BaseExpr{line: 1, char: 66}}}},
+					},
+					FromClause: FromClause{
+						Tables: []QueryExpression{Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 73}, Literal: "ct"}}},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "with recursive ct as (select 1) search depth first by c1 set seq select * from ct",
+		Output: []Statement{
+			SelectQuery{
+				WithClause: WithClause{
+					InlineTables: []QueryExpression{
+						InlineTable{
+							Name:      Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "ct"},
+							Recursive: Token{Token: RECURSIVE, Literal: "recursive", Line: 1, Char: 6},
+							Query: SelectQuery{
+								SelectEntity: SelectEntity{
+									SelectClause: SelectClause{
+										BaseExpr: &BaseExpr{line: 1, char: 23},
+										Fields: []QueryExpression{
+											Field{Object: NewIntegerValueFromString("1")},
+										},
+									},
+								},
+							},
+							Search: SearchClause{
+								BaseExpr: &BaseExpr{line: 1, char: 33},
+								Type:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 40}, Literal: "depth"},
+								Columns: []QueryExpression{
+									Identifier{BaseExpr: &BaseExpr{line: 1, char: 55}, Literal: "c1"},
+								},
+								SetColumn: Identifier{BaseExpr: &BaseExpr{line: 1, char: 62}, Literal: "seq"},
+							},
+						},
+					},
+				},
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 66},
+						Fields:   []QueryExpression{Field{Object: AllColumns{BaseExpr: &BaseExpr{line: 1, char: 73}}}},
+					},
+					FromClause: FromClause{
+						Tables: []QueryExpression{Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 80}, Literal: "ct"}}},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select ident, tbl.3, (ident), 'foo', 1, 1.234, true, '2010-01-01 12:00:00', null, ('bar') from dual",
 		Output: []Statement{
@@ -1873,6 +2064,74 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select column1 & 1 | 2",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{Object: Arithmetic{
+								LHS: Arithmetic{
+									LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
+									Operator: Token{Token: '&', Literal: "&", Line: 1, Char: 16},
+									RHS:      NewIntegerValueFromString("1"),
+								},
+								Operator: Token{Token: '|', Literal: "|", Line: 1, Char: 20},
+								RHS:      NewIntegerValueFromString("2"),
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select 1 + column1 << 2 ^ 3",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{Object: Arithmetic{
+								LHS: Arithmetic{
+									LHS: Arithmetic{
+										LHS:      NewIntegerValueFromString("1"),
+										Operator: Token{Token: '+', Literal: "+", Line: 1, Char: 10},
+										RHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 12}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 12}, Literal: "column1"}},
+									},
+									Operator: Token{Token: SHIFT_OP, Literal: "<<", Line: 1, Char: 20},
+									RHS:      NewIntegerValueFromString("2"),
+								},
+								Operator: Token{Token: '^', Literal: "^", Line: 1, Char: 25},
+								RHS:      NewIntegerValueFromString("3"),
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select ~column1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{Object: UnaryArithmetic{
+								Operand:  FieldReference{BaseExpr: &BaseExpr{line: 1, char: 9}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "column1"}},
+								Operator: Token{Token: '~', Literal: "~", Line: 1, Char: 8},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select column1 - 1",
 		Output: []Statement{
@@ -2152,6 +2411,54 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select case column1 when < 10 then 'A' when = 10 then 'B' when between 11 and 20 then 'C' when not between 21 and 30 then 'D' end",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{Object: CaseExpr{
+								Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 13}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "column1"}},
+								When: []QueryExpression{
+									CaseExprWhen{
+										Condition: Comparison{
+											Operator: Token{Token: COMPARISON_OP, Literal: "<", Line: 1, Char: 26},
+											RHS:      NewIntegerValueFromString("10"),
+										},
+										Result: NewStringValue("A"),
+									},
+									CaseExprWhen{
+										Condition: Comparison{
+											Operator: Token{Token: COMPARISON_OP, Literal: "=", Line: 1, Char: 45},
+											RHS:      NewIntegerValueFromString("10"),
+										},
+										Result: NewStringValue("B"),
+									},
+									CaseExprWhen{
+										Condition: Between{
+											Low:  NewIntegerValueFromString("11"),
+											High: NewIntegerValueFromString("20"),
+										},
+										Result: NewStringValue("C"),
+									},
+									CaseExprWhen{
+										Condition: Between{
+											Low:      NewIntegerValueFromString("21"),
+											High:     NewIntegerValueFromString("30"),
+											Negation: Token{Token: NOT, Literal: "not", Line: 1, Char: 96},
+										},
+										Result: NewStringValue("D"),
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select now()",
 		Output: []Statement{
@@ -2213,6 +2520,35 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select current_date, current_time(3), current_timestamp",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{Object: Function{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "current_date",
+							}},
+							Field{Object: Function{
+								BaseExpr: &BaseExpr{line: 1, char: 22},
+								Name:     "current_time",
+								Args: []QueryExpression{
+									NewIntegerValueFromString("3"),
+								},
+							}},
+							Field{Object: Function{
+								BaseExpr: &BaseExpr{line: 1, char: 39},
+								Name:     "current_timestamp",
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select substring(column1, 2, 5)",
 		Output: []Statement{
@@ -2603,6 +2939,111 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select string_agg(column1, ',' order by column1)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{Object: ListFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "string_agg",
+								Args: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 19}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 19}, Literal: "column1"}},
+									NewStringValue(","),
+								},
+								OrderBy: OrderByClause{
+									Items: []QueryExpression{
+										OrderItem{Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 41}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 41}, Literal: "column1"}}},
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select mode() within group (order by column1)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{Object: ListFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "mode",
+								OrderBy: OrderByClause{
+									Items: []QueryExpression{
+										OrderItem{Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 38}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 38}, Literal: "column1"}}},
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select first(column1 order by column2 desc)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{Object: ListFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "first",
+								Args: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 14}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "column1"}},
+								},
+								OrderBy: OrderByClause{
+									Items: []QueryExpression{
+										OrderItem{
+											Value:     FieldReference{BaseExpr: &BaseExpr{line: 1, char: 31}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 31}, Literal: "column2"}},
+											Direction: Token{Token: DESC, Literal: "desc", Line: 1, Char: 39},
+										},
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select last(column1 order by column2)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{Object: ListFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "last",
+								Args: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 13}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "column1"}},
+								},
+								OrderBy: OrderByClause{
+									Items: []QueryExpression{
+										OrderItem{Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 30}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 30}, Literal: "column2"}}},
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select cursor cur is not open",
 		Output: []Statement{
@@ -2971,7 +3412,127 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select min(column1) over (partition by column1 order by column2 rows current row)",
+		Input: "select min(column1) over (partition by column1 order by column2 rows current row)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{Object: AnalyticFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "min",
+								Args: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 12}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 12}, Literal: "column1"}},
+								},
+								AnalyticClause: AnalyticClause{
+									PartitionClause: PartitionClause{
+										Values: []QueryExpression{
+											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 40}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 40}, Literal: "column1"}},
+										},
+									},
+									OrderByClause: OrderByClause{
+										Items: []QueryExpression{
+											OrderItem{
+												Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 57}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 57}, Literal: "column2"}},
+											},
+										},
+									},
+									WindowingClause: WindowingClause{
+										FrameLow: WindowFramePosition{
+											Direction: Token{Token: CURRENT, Literal: "current", Line: 1, Char: 70},
+										},
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select var(column1) over (partition by column1 order by column2 rows current row)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{Object: AnalyticFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "var",
+								Args: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 12}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 12}, Literal: "column1"}},
+								},
+								AnalyticClause: AnalyticClause{
+									PartitionClause: PartitionClause{
+										Values: []QueryExpression{
+											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 40}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 40}, Literal: "column1"}},
+										},
+									},
+									OrderByClause: OrderByClause{
+										Items: []QueryExpression{
+											OrderItem{
+												Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 57}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 57}, Literal: "column2"}},
+											},
+										},
+									},
+									WindowingClause: WindowingClause{
+										FrameLow: WindowFramePosition{
+											Direction: Token{Token: CURRENT, Literal: "current", Line: 1, Char: 70},
+										},
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select count(column1) over (partition by column1 order by column2 rows current row)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{Object: AnalyticFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "count",
+								Args: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 14}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "column1"}},
+								},
+								AnalyticClause: AnalyticClause{
+									PartitionClause: PartitionClause{
+										Values: []QueryExpression{
+											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 42}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 42}, Literal: "column1"}},
+										},
+									},
+									OrderByClause: OrderByClause{
+										Items: []QueryExpression{
+											OrderItem{
+												Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 59}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 59}, Literal: "column2"}},
+											},
+										},
+									},
+									WindowingClause: WindowingClause{
+										FrameLow: WindowFramePosition{
+											Direction: Token{Token: CURRENT, Literal: "current", Line: 1, Char: 72},
+										},
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select count(*) over (partition by column1 order by column2 rows current row)",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -2980,26 +3541,26 @@ var parseTests = []struct {
 						Fields: []QueryExpression{
 							Field{Object: AnalyticFunction{
 								BaseExpr: &BaseExpr{line: 1, char: 8},
-								Name:     "min",
+								Name:     "count",
 								Args: []QueryExpression{
-									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 12}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 12}, Literal: "column1"}},
+									AllColumns{BaseExpr: &BaseExpr{line: 1, char: 14}},
 								},
 								AnalyticClause: AnalyticClause{
 									PartitionClause: PartitionClause{
 										Values: []QueryExpression{
-											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 40}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 40}, Literal: "column1"}},
+											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 36}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 36}, Literal: "column1"}},
 										},
 									},
 									OrderByClause: OrderByClause{
 										Items: []QueryExpression{
 											OrderItem{
-												Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 57}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 57}, Literal: "column2"}},
+												Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 53}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 53}, Literal: "column2"}},
 											},
 										},
 									},
 									WindowingClause: WindowingClause{
 										FrameLow: WindowFramePosition{
-											Direction: Token{Token: CURRENT, Literal: "current", Line: 1, Char: 70},
+											Direction: Token{Token: CURRENT, Literal: "current", Line: 1, Char: 66},
 										},
 									},
 								},
@@ -3011,7 +3572,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select var(column1) over (partition by column1 order by column2 rows current row)",
+		Input: "select listagg(column1) over (partition by column1 order by column2)",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -3020,28 +3581,23 @@ var parseTests = []struct {
 						Fields: []QueryExpression{
 							Field{Object: AnalyticFunction{
 								BaseExpr: &BaseExpr{line: 1, char: 8},
-								Name:     "var",
+								Name:     "listagg",
 								Args: []QueryExpression{
-									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 12}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 12}, Literal: "column1"}},
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 16}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "column1"}},
 								},
 								AnalyticClause: AnalyticClause{
 									PartitionClause: PartitionClause{
 										Values: []QueryExpression{
-											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 40}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 40}, Literal: "column1"}},
+											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 44}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 44}, Literal: "column1"}},
 										},
 									},
 									OrderByClause: OrderByClause{
 										Items: []QueryExpression{
 											OrderItem{
-												Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 57}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 57}, Literal: "column2"}},
+												Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 61}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 61}, Literal: "column2"}},
 											},
 										},
 									},
-									WindowingClause: WindowingClause{
-										FrameLow: WindowFramePosition{
-											Direction: Token{Token: CURRENT, Literal: "current", Line: 1, Char: 70},
-										},
-									},
 								},
 							}},
 						},
@@ -3051,7 +3607,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select count(column1) over (partition by column1 order by column2 rows current row)",
+		Input: "select mode() within group (order by column2) over (partition by column1)",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -3060,28 +3616,20 @@ var parseTests = []struct {
 						Fields: []QueryExpression{
 							Field{Object: AnalyticFunction{
 								BaseExpr: &BaseExpr{line: 1, char: 8},
-								Name:     "count",
-								Args: []QueryExpression{
-									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 14}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "column1"}},
-								},
+								Name:     "mode",
 								AnalyticClause: AnalyticClause{
 									PartitionClause: PartitionClause{
 										Values: []QueryExpression{
-											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 42}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 42}, Literal: "column1"}},
+											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 66}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 66}, Literal: "column1"}},
 										},
 									},
 									OrderByClause: OrderByClause{
 										Items: []QueryExpression{
 											OrderItem{
-												Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 59}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 59}, Literal: "column2"}},
+												Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 38}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 38}, Literal: "column2"}},
 											},
 										},
 									},
-									WindowingClause: WindowingClause{
-										FrameLow: WindowFramePosition{
-											Direction: Token{Token: CURRENT, Literal: "current", Line: 1, Char: 72},
-										},
-									},
 								},
 							}},
 						},
@@ -3091,7 +3639,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select count(*) over (partition by column1 order by column2 rows current row)",
+		Input: "select first(column1 order by column2) over (partition by column1)",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -3100,28 +3648,23 @@ var parseTests = []struct {
 						Fields: []QueryExpression{
 							Field{Object: AnalyticFunction{
 								BaseExpr: &BaseExpr{line: 1, char: 8},
-								Name:     "count",
+								Name:     "first",
 								Args: []QueryExpression{
-									AllColumns{BaseExpr: &BaseExpr{line: 1, char: 14}},
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 14}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "column1"}},
 								},
 								AnalyticClause: AnalyticClause{
 									PartitionClause: PartitionClause{
 										Values: []QueryExpression{
-											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 36}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 36}, Literal: "column1"}},
+											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 59}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 59}, Literal: "column1"}},
 										},
 									},
 									OrderByClause: OrderByClause{
 										Items: []QueryExpression{
 											OrderItem{
-												Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 53}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 53}, Literal: "column2"}},
+												Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 31}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 31}, Literal: "column2"}},
 											},
 										},
 									},
-									WindowingClause: WindowingClause{
-										FrameLow: WindowFramePosition{
-											Direction: Token{Token: CURRENT, Literal: "current", Line: 1, Char: 66},
-										},
-									},
 								},
 							}},
 						},
@@ -3131,7 +3674,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select listagg(column1) over (partition by column1 order by column2)",
+		Input: "select last(column1 order by column2) over ()",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -3140,20 +3683,15 @@ var parseTests = []struct {
 						Fields: []QueryExpression{
 							Field{Object: AnalyticFunction{
 								BaseExpr: &BaseExpr{line: 1, char: 8},
-								Name:     "listagg",
+								Name:     "last",
 								Args: []QueryExpression{
-									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 16}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "column1"}},
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 13}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "column1"}},
 								},
 								AnalyticClause: AnalyticClause{
-									PartitionClause: PartitionClause{
-										Values: []QueryExpression{
-											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 44}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 44}, Literal: "column1"}},
-										},
-									},
 									OrderByClause: OrderByClause{
 										Items: []QueryExpression{
 											OrderItem{
-												Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 61}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 61}, Literal: "column2"}},
+												Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 30}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 30}, Literal: "column2"}},
 											},
 										},
 									},
@@ -4099,6 +4637,28 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "insert into table1 default values",
+		Output: []Statement{
+			InsertQuery{
+				Table:         Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "table1"}},
+				DefaultValues: true,
+			},
+		},
+	},
+	{
+		Input: "insert into table1 default values returning column1, column2 as c2",
+		Output: []Statement{
+			InsertQuery{
+				Table:         Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "table1"}},
+				DefaultValues: true,
+				Returning: []QueryExpression{
+					Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 45}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 45}, Literal: "column1"}}},
+					Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 54}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 54}, Literal: "column2"}}, As: Token{Token: AS, Literal: "as", Line: 1, Char: 62}, Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 65}, Literal: "c2"}},
+				},
+			},
+		},
+	},
 	{
 		Input: "insert into table1 (column1, column2) select 1, 2",
 		Output: []Statement{
@@ -4185,6 +4745,22 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "update table1 set column1 = 1 returning *",
+		Output: []Statement{
+			UpdateQuery{
+				Tables: []QueryExpression{
+					Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "table1"}},
+				},
+				SetList: []UpdateSet{
+					{Field: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 19}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 19}, Literal: "column1"}}, Value: NewIntegerValueFromString("1")},
+				},
+				Returning: []QueryExpression{
+					Field{Object: AllColumns{BaseExpr: &BaseExpr{line: 1, char: 41}}},
+				},
+			},
+		},
+	},
 	{
 		Input: "with ct as (select 1) replace into table1 using(col1) values (1, 'str1'), (2, 'str2')",
 		Output: []Statement{
@@ -4515,6 +5091,25 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "delete from table1 where true returning column1",
+		Output: []Statement{
+			DeleteQuery{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				FromClause: FromClause{
+					Tables: []QueryExpression{
+						Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "table1"}},
+					},
+				},
+				WhereClause: WhereClause{
+					Filter: NewTernaryValueFromString("true"),
+				},
+				Returning: []QueryExpression{
+					Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 41}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 41}, Literal: "column1"}}},
+				},
+			},
+		},
+	},
 	{
 		Input: "delete table1 from table1 where true",
 		Output: []Statement{
@@ -4546,6 +5141,121 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "create table newtable (column1 default 0, column2, column3 default current_timestamp)",
+		Output: []Statement{
+			CreateTable{
+				Table: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "newtable"},
+				Fields: []QueryExpression{
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "column1"},
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 43}, Literal: "column2"},
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 52}, Literal: "column3"},
+				},
+				Columns: []ColumnDefault{
+					{
+						Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "column1"},
+						Value:  NewIntegerValueFromString("0"),
+					},
+					{
+						Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 43}, Literal: "column2"},
+					},
+					{
+						Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 52}, Literal: "column3"},
+						Value: Function{
+							BaseExpr: &BaseExpr{line: 1, char: 68},
+							Name:     "current_timestamp",
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "create table newtable (column1 not null unique, column2 unique default 'a')",
+		Output: []Statement{
+			CreateTable{
+				Table: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "newtable"},
+				Fields: []QueryExpression{
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "column1"},
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 49}, Literal: "column2"},
+				},
+				Columns: []ColumnDefault{
+					{
+						Column:  Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "column1"},
+						NotNull: true,
+						Unique:  true,
+					},
+					{
+						Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 49}, Literal: "column2"},
+						Value:  NewStringValue("a"),
+						Unique: true,
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "create table newtable (column1 default 1 not null, column2 default column1 not like 'a%' unique)",
+		Output: []Statement{
+			CreateTable{
+				Table: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "newtable"},
+				Fields: []QueryExpression{
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "column1"},
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 52}, Literal: "column2"},
+				},
+				Columns: []ColumnDefault{
+					{
+						Column:  Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "column1"},
+						Value:   NewIntegerValueFromString("1"),
+						NotNull: true,
+					},
+					{
+						Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 52}, Literal: "column2"},
+						Value: Like{
+							LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 68}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 68}, Literal: "column1"}},
+							Pattern:  NewStringValue("a%"),
+							Negation: Token{Token: NOT, Literal: "not", Line: 1, Char: 76},
+						},
+						Unique: true,
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "create table newtable (column1 check (column1 > 0), column2, check (column2 = 1))",
+		Output: []Statement{
+			CreateTable{
+				Table: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "newtable"},
+				Fields: []QueryExpression{
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "column1"},
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 53}, Literal: "column2"},
+				},
+				Columns: []ColumnDefault{
+					{
+						Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "column1"},
+						Checks: []QueryExpression{
+							Comparison{
+								LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 39}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 39}, Literal: "column1"}},
+								Operator: Token{Token: COMPARISON_OP, Literal: ">", Line: 1, Char: 47},
+								RHS:      NewIntegerValueFromString("0"),
+							},
+						},
+					},
+					{
+						Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 53}, Literal: "column2"},
+					},
+				},
+				Checks: []QueryExpression{
+					Comparison{
+						LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 69}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 69}, Literal: "column2"}},
+						Operator: Token{Token: '=', Literal: "=", Line: 1, Char: 77},
+						RHS:      NewIntegerValueFromString("1"),
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "create table newtable (column1, column2) select 1, 2",
 		Output: []Statement{
@@ -5074,6 +5784,102 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "show columns from table1",
+		Output: []Statement{
+			Describe{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 19}, Literal: "table1"},
+			},
+		},
+	},
+	{
+		Input: "describe table1",
+		Output: []Statement{
+			Describe{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 10}, Literal: "table1"},
+			},
+		},
+	},
+	{
+		Input: "describe table1 sample 10",
+		Output: []Statement{
+			Describe{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 10}, Literal: "table1"},
+				Sample:   NewIntegerValueFromString("10"),
+			},
+		},
+	},
+	{
+		Input:     "describe table1 sampl 10",
+		Error:     "syntax error: unexpected token \"sampl\"",
+		ErrorLine: 1,
+		ErrorChar: 17,
+	},
+	{
+		Input: "explain analyze select c1 from stdin",
+		Output: []Statement{
+			ExplainAnalyze{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Query: SelectQuery{
+					SelectEntity: SelectEntity{
+						SelectClause: SelectClause{
+							BaseExpr: &BaseExpr{line: 1, char: 17},
+							Fields: []QueryExpression{
+								Field{
+									Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 24}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "c1"}},
+								},
+							},
+						},
+						FromClause: FromClause{Tables: []QueryExpression{
+							Table{Object: Stdin{BaseExpr: &BaseExpr{line: 1, char: 32}}},
+						}},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "explain analyze delete from table1",
+		Output: []Statement{
+			ExplainAnalyze{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Query: DeleteQuery{
+					BaseExpr: &BaseExpr{line: 1, char: 17},
+					FromClause: FromClause{
+						Tables: []QueryExpression{
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 29}, Literal: "table1"}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "explain analyze apply delete from table1",
+		Output: []Statement{
+			ExplainAnalyze{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Query: DeleteQuery{
+					BaseExpr: &BaseExpr{line: 1, char: 23},
+					FromClause: FromClause{
+						Tables: []QueryExpression{
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 35}, Literal: "table1"}},
+						},
+					},
+				},
+				Apply: true,
+			},
+		},
+	},
+	{
+		Input:     "explain analyze applied delete from table1",
+		Error:     "syntax error: unexpected token \"applied\"",
+		ErrorLine: 1,
+		ErrorChar: 17,
+	},
 	{
 		Input: "trigger error",
 		Output: []Statement{
@@ -6315,6 +7121,30 @@ var parseTests = []struct {
 			}},
 		},
 	},
+	{
+		Input: "select check, unique, explain, mode",
+		Output: []Statement{
+			SelectQuery{SelectEntity: SelectEntity{
+				SelectClause: SelectClause{
+					BaseExpr: &BaseExpr{line: 1, char: 1},
+					Fields: []QueryExpression{
+						Field{
+							Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "check"}},
+						},
+						Field{
+							Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 15}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "unique"}},
+						},
+						Field{
+							Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 23}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 23}, Literal: "explain"}},
+						},
+						Field{
+							Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 32}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 32}, Literal: "mode"}},
+						},
+					},
+				},
+			}},
+		},
+	},
 	{
 		Input: "select fields",
 		Output: []Statement{
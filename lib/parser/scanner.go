@@ -53,6 +53,11 @@ var stringOperators = []string{
 	"||",
 }
 
+var shiftOperators = []string{
+	"<<",
+	">>",
+}
+
 var aggregateFunctions = []string{
 	"MIN",
 	"MAX",
@@ -60,13 +65,32 @@ var aggregateFunctions = []string{
 	"AVG",
 	"STDEV",
 	"STDEVP",
+	"STDDEV_SAMP",
+	"STDDEV_POP",
 	"VARP",
+	"VAR_SAMP",
+	"VAR_POP",
 	"MEDIAN",
+	"BIT_AND",
+	"BIT_OR",
+	"CORR",
+	"COVAR_SAMP",
+	"COVAR_POP",
+	"COUNT_IF",
+	"SUM_IF",
+	"AVG_IF",
+	"BOOL_AND",
+	"BOOL_OR",
+	"ANY_VALUE",
 }
 
 var listFunctions = []string{
 	"LISTAGG",
+	"STRING_AGG",
 	"JSON_AGG",
+	"MODE",
+	"TOP_K",
+	"HISTOGRAM",
 }
 
 var analyticFunctions = []string{
@@ -89,18 +113,42 @@ var functionsWithIgnoreNulls = []string{
 	"LEAD",
 }
 
+// nonReservedFunctions are scanned as function tokens only when followed by
+// a left parenthesis, so that they can be used as identifiers.
+var nonReservedFunctions = []string{
+	"STDDEV_SAMP",
+	"STDDEV_POP",
+	"VAR_SAMP",
+	"VAR_POP",
+	"BIT_AND",
+	"BIT_OR",
+	"CORR",
+	"COVAR_SAMP",
+	"COVAR_POP",
+	"COUNT_IF",
+	"SUM_IF",
+	"AVG_IF",
+	"BOOL_AND",
+	"BOOL_OR",
+	"ANY_VALUE",
+	"STRING_AGG",
+	"MODE",
+	"TOP_K",
+	"HISTOGRAM",
+}
+
 func TokenLiteral(token int) string {
 	if TokenFrom <= token && token <= TokenTo {
 		return yyToknames[token-TokenFrom+3]
 	}
-	return string(token)
+	return string(rune(token))
 }
 
 func KeywordLiteral(token int) (string, error) {
 	if KeywordFrom <= token && token <= KeywordTo {
 		return yyToknames[token-TokenFrom+3], nil
 	}
-	return string(token), errTokenIsNotKeyword
+	return string(rune(token)), errTokenIsNotKeyword
 }
 
 type Scanner struct {
@@ -234,6 +282,8 @@ func (s *Scanner) Scan() (Token, error) {
 			token = TERNARY
 		} else if t, e := s.searchKeyword(literal); e == nil {
 			token = rune(t)
+		} else if s.isNonReservedFunctions(literal) && !s.isFollowedByLeftParenthesis() {
+			token = IDENTIFIER
 		} else if s.isAggregateFunctions(literal) {
 			token = AGGREGATE_FUNCTION
 		} else if s.isListaggFunctions(literal) {
@@ -255,6 +305,8 @@ func (s *Scanner) Scan() (Token, error) {
 			token = COMPARISON_OP
 		} else if s.isStringOperators(literal) {
 			token = STRING_OP
+		} else if s.isShiftOperators(literal) {
+			token = SHIFT_OP
 		} else if literal == SubstitutionOperator {
 			token = SUBSTITUTION_OP
 		} else if 1 < len(literal) {
@@ -459,6 +511,24 @@ func (s *Scanner) isFunctionsWithIgnoreNulls(str string) bool {
 	return false
 }
 
+func (s *Scanner) isNonReservedFunctions(str string) bool {
+	for _, v := range nonReservedFunctions {
+		if strings.EqualFold(v, str) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Scanner) isFollowedByLeftParenthesis() bool {
+	for i := s.srcPos; i < len(s.src); i++ {
+		if !unicode.IsSpace(s.src[i]) {
+			return s.src[i] == '('
+		}
+	}
+	return false
+}
+
 func (s *Scanner) isComparisonOperators(str string) bool {
 	for _, v := range comparisonOperators {
 		if v == str {
@@ -477,6 +547,15 @@ func (s *Scanner) isStringOperators(str string) bool {
 	return false
 }
 
+func (s *Scanner) isShiftOperators(str string) bool {
+	for _, v := range shiftOperators {
+		if v == str {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *Scanner) isCommentRune(ch rune) bool {
 	if ch == '/' && s.peek() == '*' {
 		s.next()
@@ -257,6 +257,41 @@ var scanTests = []struct {
 			},
 		},
 	},
+	{
+		Name:  "ShiftOperator",
+		Input: "<<",
+		Output: []scanResult{
+			{
+				Token:   SHIFT_OP,
+				Literal: "<<",
+			},
+		},
+	},
+	{
+		Name:  "BitwiseOperators",
+		Input: "& | ^ ~",
+		Output: []scanResult{
+			{
+				Token:   '&',
+				Literal: "&",
+			},
+			{
+				Token:   '|',
+				Literal: "|",
+				Char:    3,
+			},
+			{
+				Token:   '^',
+				Literal: "^",
+				Char:    5,
+			},
+			{
+				Token:   '~',
+				Literal: "~",
+				Char:    7,
+			},
+		},
+	},
 	{
 		Name:  "SubstitutionOperator",
 		Input: ":=",
@@ -327,6 +362,31 @@ var scanTests = []struct {
 			},
 		},
 	},
+	{
+		Name:  "NonReservedFunction",
+		Input: "mode (",
+		Output: []scanResult{
+			{
+				Token:   LIST_FUNCTION,
+				Literal: "mode",
+			},
+			{
+				Token:   int('('),
+				Literal: "(",
+				Char:    6,
+			},
+		},
+	},
+	{
+		Name:  "NonReservedFunction as Identifier",
+		Input: "mode",
+		Output: []scanResult{
+			{
+				Token:   IDENTIFIER,
+				Literal: "mode",
+			},
+		},
+	},
 	{
 		Name:  "PassThrough",
 		Input: ",",
@@ -17,16 +17,63 @@ import (
 type AggregateFunction func([]value.Primary, *cmd.Flags) value.Primary
 
 var AggregateFunctions = map[string]AggregateFunction{
-	"COUNT":  Count,
-	"MAX":    Max,
-	"MIN":    Min,
-	"SUM":    Sum,
-	"AVG":    Avg,
-	"STDEV":  StdEV,
-	"STDEVP": StdEVP,
-	"VAR":    Var,
-	"VARP":   VarP,
-	"MEDIAN": Median,
+	"COUNT":       Count,
+	"MAX":         Max,
+	"MIN":         Min,
+	"SUM":         Sum,
+	"AVG":         Avg,
+	"STDEV":       StdEV,
+	"STDEVP":      StdEVP,
+	"STDDEV_SAMP": StdEV,
+	"STDDEV_POP":  StdEVP,
+	"VAR":         Var,
+	"VARP":        VarP,
+	"VAR_SAMP":    Var,
+	"VAR_POP":     VarP,
+	"MEDIAN":      Median,
+	"BIT_AND":     BitAnd,
+	"BIT_OR":      BitOr,
+	"BOOL_AND":    BoolAnd,
+	"BOOL_OR":     BoolOr,
+	"ANY_VALUE":   AnyValue,
+}
+
+// numericAggregateFunctions are the aggregate functions that convert their
+// arguments to numbers. Their arguments must not be strings when the flag
+// NO_INFER is enabled.
+var numericAggregateFunctions = map[string]bool{
+	"SUM":         true,
+	"AVG":         true,
+	"STDEV":       true,
+	"STDEVP":      true,
+	"STDDEV_SAMP": true,
+	"STDDEV_POP":  true,
+	"VAR":         true,
+	"VARP":        true,
+	"VAR_SAMP":    true,
+	"VAR_POP":     true,
+	"MEDIAN":      true,
+	"BIT_AND":     true,
+	"BIT_OR":      true,
+	"SUM_IF":      true,
+	"AVG_IF":      true,
+	"CORR":        true,
+	"COVAR_SAMP":  true,
+	"COVAR_POP":   true,
+}
+
+type BivariateAggregateFunction func([]value.Primary, []value.Primary, *cmd.Flags) value.Primary
+
+var BivariateAggregateFunctions = map[string]BivariateAggregateFunction{
+	"CORR":       Corr,
+	"COVAR_SAMP": CovarSamp,
+	"COVAR_POP":  CovarPop,
+}
+
+var ConditionalAggregateFunctions = map[string]AggregateFunction{
+	"COUNT_IF": Count,
+	"SUM_IF":   Sum,
+	"AVG_IF":   Avg,
 }
 
 func Count(list []value.Primary, _ *cmd.Flags) value.Primary {
@@ -105,7 +152,7 @@ func StdEV(list []value.Primary, _ *cmd.Flags) value.Primary {
 	if len(values) < 2 {
 		return value.NewNull()
 	}
-	return value.ParseFloat64(standardDeviation(values, false))
+	return value.NewFloat(standardDeviation(values, false))
 }
 
 func StdEVP(list []value.Primary, _ *cmd.Flags) value.Primary {
@@ -113,7 +160,7 @@ func StdEVP(list []value.Primary, _ *cmd.Flags) value.Primary {
 	if len(values) < 1 {
 		return value.NewNull()
 	}
-	return value.ParseFloat64(standardDeviation(values, true))
+	return value.NewFloat(standardDeviation(values, true))
 }
 
 func Var(list []value.Primary, _ *cmd.Flags) value.Primary {
@@ -121,7 +168,7 @@ func Var(list []value.Primary, _ *cmd.Flags) value.Primary {
 	if len(values) < 2 {
 		return value.NewNull()
 	}
-	return value.ParseFloat64(variance(values, false))
+	return value.NewFloat(variance(values, false))
 }
 
 func VarP(list []value.Primary, _ *cmd.Flags) value.Primary {
@@ -129,7 +176,104 @@ func VarP(list []value.Primary, _ *cmd.Flags) value.Primary {
 	if len(values) < 1 {
 		return value.NewNull()
 	}
-	return value.ParseFloat64(variance(values, true))
+	return value.NewFloat(variance(values, true))
+}
+
+func Corr(xList []value.Primary, yList []value.Primary, _ *cmd.Flags) value.Primary {
+	xValues, yValues := floatPairList(xList, yList)
+	if len(xValues) < 2 {
+		return value.NewNull()
+	}
+
+	m2x, m2y, c := comoments(xValues, yValues)
+	if m2x == 0 || m2y == 0 {
+		return value.NewNull()
+	}
+	return value.ParseFloat64(c / math.Sqrt(m2x*m2y))
+}
+
+func CovarSamp(xList []value.Primary, yList []value.Primary, _ *cmd.Flags) value.Primary {
+	xValues, yValues := floatPairList(xList, yList)
+	if len(xValues) < 2 {
+		return value.NewNull()
+	}
+
+	_, _, c := comoments(xValues, yValues)
+	return value.ParseFloat64(c / float64(len(xValues)-1))
+}
+
+func CovarPop(xList []value.Primary, yList []value.Primary, _ *cmd.Flags) value.Primary {
+	xValues, yValues := floatPairList(xList, yList)
+	if len(xValues) < 1 {
+		return value.NewNull()
+	}
+
+	_, _, c := comoments(xValues, yValues)
+	return value.ParseFloat64(c / float64(len(xValues)))
+}
+
+func BitAnd(list []value.Primary, _ *cmd.Flags) value.Primary {
+	values := integerList(list)
+	if len(values) < 1 {
+		return value.NewNull()
+	}
+
+	result := values[0]
+	for _, v := range values[1:] {
+		result &= v
+	}
+	return value.NewInteger(result)
+}
+
+func BitOr(list []value.Primary, _ *cmd.Flags) value.Primary {
+	values := integerList(list)
+	if len(values) < 1 {
+		return value.NewNull()
+	}
+
+	var result int64
+	for _, v := range values {
+		result |= v
+	}
+	return value.NewInteger(result)
+}
+
+func integerList(list []value.Primary) []int64 {
+	values := make([]int64, 0, len(list))
+	for _, v := range list {
+		if _, ok := v.(*value.Float); ok {
+			continue
+		}
+		if i := value.ToInteger(v); !value.IsNull(i) {
+			values = append(values, i.(*value.Integer).Raw())
+		}
+	}
+	return values
+}
+
+func BoolAnd(list []value.Primary, _ *cmd.Flags) value.Primary {
+	values := ternaryList(list)
+	if len(values) < 1 {
+		return value.NewNull()
+	}
+	return value.NewTernary(ternary.All(values))
+}
+
+func BoolOr(list []value.Primary, _ *cmd.Flags) value.Primary {
+	values := ternaryList(list)
+	if len(values) < 1 {
+		return value.NewNull()
+	}
+	return value.NewTernary(ternary.Any(values))
+}
+
+func AnyValue(list []value.Primary, _ *cmd.Flags) value.Primary {
+	for _, v := range list {
+		if !value.IsNull(v) {
+			return v
+		}
+	}
+	return value.NewNull()
 }
 
 func floatList(list []value.Primary) []float64 {
@@ -142,6 +286,37 @@ func floatList(list []value.Primary) []float64 {
 	return values
 }
 
+func ternaryList(list []value.Primary) []ternary.Value {
+	values := make([]ternary.Value, 0, len(list))
+	for _, v := range list {
+		if t := v.Ternary(); t != ternary.UNKNOWN {
+			values = append(values, t)
+		}
+	}
+	return values
+}
+
+func floatPairList(xList []value.Primary, yList []value.Primary) ([]float64, []float64) {
+	xValues := make([]float64, 0, len(xList))
+	yValues := make([]float64, 0, len(yList))
+	for i := 0; i < len(xList) && i < len(yList); i++ {
+		x := value.ToFloat(xList[i])
+		if value.IsNull(x) {
+			continue
+		}
+		y := value.ToFloat(yList[i])
+		if value.IsNull(y) {
+			value.Discard(x)
+			continue
+		}
+		xValues = append(xValues, x.(*value.Float).Raw())
+		yValues = append(yValues, y.(*value.Float).Raw())
+		value.Discard(x)
+		value.Discard(y)
+	}
+	return xValues, yValues
+}
+
 func sum(list []float64) float64 {
 	var sum float64
 	for _, v := range list {
@@ -162,22 +337,41 @@ func average(list []float64) float64 {
 }
 
 func variance(list []float64, isP bool) float64 {
-	avg := average(list)
-	denom := float64(len(list))
-	if !isP {
-		denom = denom - 1
+	var n, mean, m2 float64
+	for _, v := range list {
+		n++
+		delta := v - mean
+		mean += delta / n
+		m2 += delta * (v - mean)
 	}
 
-	var sum float64
-	for _, v := range list {
-		sum += math.Pow(v-avg, 2)
+	denom := n
+	if !isP {
+		denom = denom - 1
 	}
 
-	if denom == 0 || sum == 0 {
+	if denom <= 0 || m2 == 0 {
 		return 0
 	}
 
-	return sum / denom
+	return m2 / denom
+}
+
+// comoments returns the sums of squared deviations of each list and the sum of
+// products of their deviations, calculated in a single pass by Welford's method.
+func comoments(xList []float64, yList []float64) (float64, float64, float64) {
+	var n, meanX, meanY, m2x, m2y, c float64
+	for i := range xList {
+		n++
+		dx := xList[i] - meanX
+		dy := yList[i] - meanY
+		meanX += dx / n
+		meanY += dy / n
+		m2x += dx * (xList[i] - meanX)
+		m2y += dy * (yList[i] - meanY)
+		c += dx * (yList[i] - meanY)
+	}
+	return m2x, m2y, c
 }
 
 func standardDeviation(list []float64, isP bool) float64 {
@@ -232,6 +426,64 @@ func ListAgg(list []value.Primary, separator string) value.Primary {
 	return value.NewString(strings.Join(strlist, separator))
 }
 
+func Mode(list []value.Primary, flags *cmd.Flags) value.Primary {
+	counts := make(map[string]int, 40)
+	keys := make([]string, len(list))
+	maxCount := 0
+
+	buf := GetComparisonKeysBuf()
+	for i, v := range list {
+		if value.IsNull(v) {
+			continue
+		}
+
+		buf.Reset()
+		SerializeComparisonKeys(buf, []value.Primary{v}, flags)
+		keys[i] = buf.String()
+		counts[keys[i]]++
+		if maxCount < counts[keys[i]] {
+			maxCount = counts[keys[i]]
+		}
+	}
+	PutComparisonkeysBuf(buf)
+
+	for i, v := range list {
+		if !value.IsNull(v) && counts[keys[i]] == maxCount {
+			return v
+		}
+	}
+	return value.NewNull()
+}
+
+// First returns the value of the first record in which no sort values are null.
+func First(list []value.Primary, sortValues [][]value.Primary) value.Primary {
+	for i := range list {
+		if !hasNullSortValue(sortValues, i) {
+			return list[i]
+		}
+	}
+	return value.NewNull()
+}
+
+// Last returns the value of the last record in which no sort values are null.
+func Last(list []value.Primary, sortValues [][]value.Primary) value.Primary {
+	for i := len(list) - 1; 0 <= i; i-- {
+		if !hasNullSortValue(sortValues, i) {
+			return list[i]
+		}
+	}
+	return value.NewNull()
+}
+
+func hasNullSortValue(sortValues [][]value.Primary, idx int) bool {
+	for _, values := range sortValues {
+		if value.IsNull(values[idx]) {
+			return true
+		}
+	}
+	return false
+}
+
 func JsonAgg(list []value.Primary) value.Primary {
 	if len(list) < 1 {
 		return value.NewNull()
@@ -245,3 +497,81 @@ func JsonAgg(list []value.Primary) value.Primary {
 
 	return value.NewString(array.Encode())
 }
+
+// Histogram returns the string formatted in JSON object that has the counts of values in n buckets of equal width
+// between low and high, the counts of values out of the range and the count of nulls and non-numeric values.
+func Histogram(list []value.Primary, low float64, high float64, n int64) value.Primary {
+	if len(list) < 1 {
+		return value.NewNull()
+	}
+
+	counts := make([]int64, n+2)
+	var invalid int64
+	for _, v := range list {
+		f := value.ToFloat(v)
+		if value.IsNull(f) {
+			invalid++
+			continue
+		}
+		counts[widthBucket(f.(*value.Float).Raw(), low, high, n)]++
+		value.Discard(f)
+	}
+
+	buckets := make(txjson.Array, 0, n)
+	for _, c := range counts[1 : n+1] {
+		buckets = append(buckets, txjson.Integer(c))
+	}
+
+	obj := txjson.NewObject(4)
+	obj.Add("buckets", buckets)
+	obj.Add("underflow", txjson.Integer(counts[0]))
+	obj.Add("overflow", txjson.Integer(counts[n+1]))
+	obj.Add("invalid", txjson.Integer(invalid))
+	return value.NewString(obj.Encode())
+}
+
+// TopK returns the string formatted in JSON array of the k most frequent values with their counts.
+// Ties are broken by the order of first occurrence, and nulls are counted as one value.
+func TopK(list []value.Primary, k int, flags *cmd.Flags) value.Primary {
+	if len(list) < 1 {
+		return value.NewNull()
+	}
+
+	counts := make(map[string]int, 40)
+	values := make([]value.Primary, 0, 40)
+	keys := make([]string, 0, 40)
+
+	buf := GetComparisonKeysBuf()
+	for _, v := range list {
+		buf.Reset()
+		SerializeComparisonKeys(buf, []value.Primary{v}, flags)
+		key := buf.String()
+		if _, ok := counts[key]; !ok {
+			values = append(values, v)
+			keys = append(keys, key)
+		}
+		counts[key]++
+	}
+	PutComparisonkeysBuf(buf)
+
+	indices := make([]int, len(values))
+	for i := range indices {
+		indices[i] = i
+	}
+	sort.SliceStable(indices, func(i, j int) bool {
+		return counts[keys[indices[i]]] > counts[keys[indices[j]]]
+	})
+	if k < len(indices) {
+		indices = indices[:k]
+	}
+
+	array := make(txjson.Array, 0, len(indices))
+	for _, idx := range indices {
+		obj := txjson.NewObject(2)
+		obj.Add("value", json.ParseValueToStructure(values[idx]))
+		obj.Add("count", txjson.Integer(int64(counts[keys[idx]])))
+		array = append(array, obj)
+	}
+
+	return value.NewString(array.Encode())
+}
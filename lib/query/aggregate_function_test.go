@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
 )
 
 type aggregateTests struct {
@@ -175,7 +177,7 @@ var stdEVTests = []aggregateTests{
 			value.NewInteger(0),
 			value.NewInteger(0),
 		},
-		Result: value.NewInteger(0),
+		Result: value.NewFloat(0),
 	},
 	{
 		List: []value.Primary{
@@ -211,7 +213,7 @@ var stdEVPTests = []aggregateTests{
 			value.NewInteger(0),
 			value.NewInteger(0),
 		},
-		Result: value.NewInteger(0),
+		Result: value.NewFloat(0),
 	},
 	{
 		List: []value.Primary{
@@ -247,7 +249,7 @@ var varTests = []aggregateTests{
 			value.NewInteger(0),
 			value.NewInteger(0),
 		},
-		Result: value.NewInteger(0),
+		Result: value.NewFloat(0),
 	},
 	{
 		List: []value.Primary{
@@ -276,14 +278,14 @@ var varPTests = []aggregateTests{
 			value.NewInteger(4),
 			value.NewInteger(5),
 		},
-		Result: value.NewInteger(2),
+		Result: value.NewFloat(2),
 	},
 	{
 		List: []value.Primary{
 			value.NewInteger(0),
 			value.NewInteger(0),
 		},
-		Result: value.NewInteger(0),
+		Result: value.NewFloat(0),
 	},
 	{
 		List: []value.Primary{
@@ -352,6 +354,476 @@ func TestMedian(t *testing.T) {
 	}
 }
 
+type bivariateAggregateTests struct {
+	XList  []value.Primary
+	YList  []value.Primary
+	Result value.Primary
+}
+
+var corrTests = []bivariateAggregateTests{
+	{
+		XList: []value.Primary{
+			value.NewInteger(1),
+			value.NewInteger(2),
+			value.NewInteger(3),
+			value.NewNull(),
+			value.NewInteger(4),
+		},
+		YList: []value.Primary{
+			value.NewInteger(2),
+			value.NewInteger(4),
+			value.NewInteger(6),
+			value.NewInteger(8),
+			value.NewNull(),
+		},
+		Result: value.NewInteger(1),
+	},
+	{
+		XList: []value.Primary{
+			value.NewInteger(1),
+			value.NewInteger(2),
+			value.NewInteger(3),
+		},
+		YList: []value.Primary{
+			value.NewInteger(3),
+			value.NewInteger(2),
+			value.NewInteger(1),
+		},
+		Result: value.NewInteger(-1),
+	},
+	{
+		XList: []value.Primary{
+			value.NewInteger(1),
+			value.NewInteger(1),
+			value.NewInteger(1),
+		},
+		YList: []value.Primary{
+			value.NewInteger(3),
+			value.NewInteger(2),
+			value.NewInteger(1),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		XList: []value.Primary{
+			value.NewInteger(1),
+			value.NewNull(),
+		},
+		YList: []value.Primary{
+			value.NewInteger(2),
+			value.NewInteger(3),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestCorr(t *testing.T) {
+	for _, v := range corrTests {
+		r := Corr(v.XList, v.YList, TestTx.Flags)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("corr lists = %s, %s: result = %s, want %s", v.XList, v.YList, r, v.Result)
+		}
+	}
+}
+
+var covarSampTests = []bivariateAggregateTests{
+	{
+		XList: []value.Primary{
+			value.NewInteger(1),
+			value.NewInteger(2),
+			value.NewInteger(3),
+			value.NewNull(),
+			value.NewInteger(4),
+		},
+		YList: []value.Primary{
+			value.NewInteger(2),
+			value.NewInteger(4),
+			value.NewInteger(6),
+			value.NewInteger(8),
+			value.NewNull(),
+		},
+		Result: value.NewInteger(2),
+	},
+	{
+		XList: []value.Primary{
+			value.NewInteger(1),
+			value.NewNull(),
+		},
+		YList: []value.Primary{
+			value.NewInteger(2),
+			value.NewInteger(3),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestCovarSamp(t *testing.T) {
+	for _, v := range covarSampTests {
+		r := CovarSamp(v.XList, v.YList, TestTx.Flags)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("covar_samp lists = %s, %s: result = %s, want %s", v.XList, v.YList, r, v.Result)
+		}
+	}
+}
+
+var covarPopTests = []bivariateAggregateTests{
+	{
+		XList: []value.Primary{
+			value.NewInteger(1),
+			value.NewInteger(3),
+			value.NewNull(),
+			value.NewInteger(4),
+		},
+		YList: []value.Primary{
+			value.NewInteger(2),
+			value.NewInteger(6),
+			value.NewInteger(8),
+			value.NewNull(),
+		},
+		Result: value.NewInteger(2),
+	},
+	{
+		XList: []value.Primary{
+			value.NewInteger(1),
+		},
+		YList: []value.Primary{
+			value.NewInteger(2),
+		},
+		Result: value.NewInteger(0),
+	},
+	{
+		XList: []value.Primary{
+			value.NewInteger(1),
+			value.NewNull(),
+		},
+		YList: []value.Primary{
+			value.NewNull(),
+			value.NewInteger(3),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestCovarPop(t *testing.T) {
+	for _, v := range covarPopTests {
+		r := CovarPop(v.XList, v.YList, TestTx.Flags)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("covar_pop lists = %s, %s: result = %s, want %s", v.XList, v.YList, r, v.Result)
+		}
+	}
+}
+
+var boolAndTests = []aggregateTests{
+	{
+		List: []value.Primary{
+			value.NewTernary(ternary.TRUE),
+			value.NewInteger(1),
+			value.NewTernary(ternary.TRUE),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		List: []value.Primary{
+			value.NewTernary(ternary.TRUE),
+			value.NewNull(),
+			value.NewTernary(ternary.TRUE),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		List: []value.Primary{
+			value.NewTernary(ternary.TRUE),
+			value.NewString("abc"),
+			value.NewTernary(ternary.UNKNOWN),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		List: []value.Primary{
+			value.NewString("abc"),
+			value.NewTernary(ternary.UNKNOWN),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		List: []value.Primary{
+			value.NewTernary(ternary.TRUE),
+			value.NewNull(),
+			value.NewTernary(ternary.FALSE),
+		},
+		Result: value.NewTernary(ternary.FALSE),
+	},
+	{
+		List: []value.Primary{
+			value.NewNull(),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		List:   []value.Primary{},
+		Result: value.NewNull(),
+	},
+}
+
+func TestBoolAnd(t *testing.T) {
+	for _, v := range boolAndTests {
+		r := BoolAnd(v.List, TestTx.Flags)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("bool_and list = %s: result = %s, want %s", v.List, r, v.Result)
+		}
+	}
+}
+
+var boolOrTests = []aggregateTests{
+	{
+		List: []value.Primary{
+			value.NewTernary(ternary.FALSE),
+			value.NewInteger(0),
+			value.NewTernary(ternary.FALSE),
+		},
+		Result: value.NewTernary(ternary.FALSE),
+	},
+	{
+		List: []value.Primary{
+			value.NewTernary(ternary.FALSE),
+			value.NewNull(),
+			value.NewTernary(ternary.FALSE),
+		},
+		Result: value.NewTernary(ternary.FALSE),
+	},
+	{
+		List: []value.Primary{
+			value.NewTernary(ternary.FALSE),
+			value.NewString("abc"),
+			value.NewTernary(ternary.UNKNOWN),
+		},
+		Result: value.NewTernary(ternary.FALSE),
+	},
+	{
+		List: []value.Primary{
+			value.NewString("abc"),
+			value.NewTernary(ternary.UNKNOWN),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		List: []value.Primary{
+			value.NewTernary(ternary.FALSE),
+			value.NewNull(),
+			value.NewTernary(ternary.TRUE),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		List: []value.Primary{
+			value.NewNull(),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		List:   []value.Primary{},
+		Result: value.NewNull(),
+	},
+}
+
+func TestBoolOr(t *testing.T) {
+	for _, v := range boolOrTests {
+		r := BoolOr(v.List, TestTx.Flags)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("bool_or list = %s: result = %s, want %s", v.List, r, v.Result)
+		}
+	}
+}
+
+var anyValueTests = []aggregateTests{
+	{
+		List: []value.Primary{
+			value.NewNull(),
+			value.NewString("b"),
+			value.NewString("a"),
+		},
+		Result: value.NewString("b"),
+	},
+	{
+		List: []value.Primary{
+			value.NewNull(),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestAnyValue(t *testing.T) {
+	for _, v := range anyValueTests {
+		r := AnyValue(v.List, TestTx.Flags)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("any_value list = %s: result = %s, want %s", v.List, r, v.Result)
+		}
+	}
+}
+
+var modeTests = []aggregateTests{
+	{
+		List: []value.Primary{
+			value.NewInteger(1),
+			value.NewNull(),
+			value.NewInteger(2),
+			value.NewInteger(2),
+			value.NewInteger(3),
+		},
+		Result: value.NewInteger(2),
+	},
+	{
+		List: []value.Primary{
+			value.NewString("b"),
+			value.NewString("a"),
+			value.NewString("a"),
+			value.NewString("b"),
+		},
+		Result: value.NewString("b"),
+	},
+	{
+		List: []value.Primary{
+			value.NewNull(),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		List:   []value.Primary{},
+		Result: value.NewNull(),
+	},
+}
+
+func TestMode(t *testing.T) {
+	for _, v := range modeTests {
+		r := Mode(v.List, TestTx.Flags)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("mode list = %s: result = %s, want %s", v.List, r, v.Result)
+		}
+	}
+}
+
+var firstLastTests = []struct {
+	List       []value.Primary
+	SortValues [][]value.Primary
+	First      value.Primary
+	Last       value.Primary
+}{
+	{
+		List: []value.Primary{
+			value.NewInteger(1),
+			value.NewInteger(2),
+			value.NewInteger(3),
+			value.NewInteger(4),
+		},
+		SortValues: [][]value.Primary{
+			{
+				value.NewNull(),
+				value.NewInteger(10),
+				value.NewInteger(20),
+				value.NewInteger(30),
+			},
+			{
+				value.NewString("a"),
+				value.NewString("b"),
+				value.NewString("c"),
+				value.NewNull(),
+			},
+		},
+		First: value.NewInteger(2),
+		Last:  value.NewInteger(3),
+	},
+	{
+		List: []value.Primary{
+			value.NewInteger(1),
+		},
+		SortValues: [][]value.Primary{
+			{
+				value.NewNull(),
+			},
+		},
+		First: value.NewNull(),
+		Last:  value.NewNull(),
+	},
+}
+
+func TestFirst(t *testing.T) {
+	for _, v := range firstLastTests {
+		r := First(v.List, v.SortValues)
+		if !reflect.DeepEqual(r, v.First) {
+			t.Errorf("first list = %s: result = %s, want %s", v.List, r, v.First)
+		}
+	}
+}
+
+func TestLast(t *testing.T) {
+	for _, v := range firstLastTests {
+		r := Last(v.List, v.SortValues)
+		if !reflect.DeepEqual(r, v.Last) {
+			t.Errorf("last list = %s: result = %s, want %s", v.List, r, v.Last)
+		}
+	}
+}
+
+var bitAndTests = []aggregateTests{
+	{
+		List: []value.Primary{
+			value.NewInteger(7),
+			value.NewNull(),
+			value.NewString("14"),
+			value.NewFloat(1.5),
+			value.NewInteger(6),
+		},
+		Result: value.NewInteger(6),
+	},
+	{
+		List: []value.Primary{
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestBitAnd(t *testing.T) {
+	for _, v := range bitAndTests {
+		r := BitAnd(v.List, TestTx.Flags)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("bit_and list = %s: result = %s, want %s", v.List, r, v.Result)
+		}
+	}
+}
+
+var bitOrTests = []aggregateTests{
+	{
+		List: []value.Primary{
+			value.NewInteger(1),
+			value.NewNull(),
+			value.NewString("4"),
+			value.NewFloat(1.5),
+			value.NewInteger(8),
+		},
+		Result: value.NewInteger(13),
+	},
+	{
+		List: []value.Primary{
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestBitOr(t *testing.T) {
+	for _, v := range bitOrTests {
+		r := BitOr(v.List, TestTx.Flags)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("bit_or list = %s: result = %s, want %s", v.List, r, v.Result)
+		}
+	}
+}
+
 var listAggTests = []struct {
 	List      []value.Primary
 	Separator string
@@ -413,3 +885,87 @@ func TestJsonAgg(t *testing.T) {
 		}
 	}
 }
+
+var histogramTests = []struct {
+	List   []value.Primary
+	Low    float64
+	High   float64
+	N      int64
+	Result value.Primary
+}{
+	{
+		List:   []value.Primary{},
+		Low:    0,
+		High:   10,
+		N:      2,
+		Result: value.NewNull(),
+	},
+	{
+		List: []value.Primary{
+			value.NewInteger(-1),
+			value.NewInteger(0),
+			value.NewString("4.9"),
+			value.NewFloat(5),
+			value.NewInteger(9),
+			value.NewInteger(10),
+			value.NewNull(),
+			value.NewString("abc"),
+			value.NewBoolean(true),
+		},
+		Low:    0,
+		High:   10,
+		N:      2,
+		Result: value.NewString("{\"buckets\":[2,2],\"underflow\":1,\"overflow\":1,\"invalid\":3}"),
+	},
+}
+
+func TestHistogram(t *testing.T) {
+	for _, v := range histogramTests {
+		r := Histogram(v.List, v.Low, v.High, v.N)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("Histogram list = %s, low = %f, high = %f, n = %d, result = %s, want %s", v.List, v.Low, v.High, v.N, r, v.Result)
+		}
+	}
+}
+
+var topKTests = []struct {
+	List   []value.Primary
+	K      int
+	Result value.Primary
+}{
+	{
+		List:   []value.Primary{},
+		K:      2,
+		Result: value.NewNull(),
+	},
+	{
+		List: []value.Primary{
+			value.NewString("str3"),
+			value.NewNull(),
+			value.NewString("str2"),
+			value.NewInteger(2),
+			value.NewNull(),
+			value.NewString("2"),
+			value.NewString("str2"),
+		},
+		K:      3,
+		Result: value.NewString("[{\"value\":null,\"count\":2},{\"value\":\"str2\",\"count\":2},{\"value\":2,\"count\":2}]"),
+	},
+	{
+		List: []value.Primary{
+			value.NewString("str3"),
+			value.NewString("str2"),
+		},
+		K:      5,
+		Result: value.NewString("[{\"value\":\"str3\",\"count\":1},{\"value\":\"str2\",\"count\":1}]"),
+	},
+}
+
+func TestTopK(t *testing.T) {
+	for _, v := range topKTests {
+		r := TopK(v.List, v.K, TestTx.Flags)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("TopK list = %s, k = %d, result = %s, want %s", v.List, v.K, r, v.Result)
+		}
+	}
+}
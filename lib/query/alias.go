@@ -13,7 +13,7 @@ func (m AliasMap) Add(alias parser.Identifier, path string) error {
 	if _, ok := m[uname]; ok {
 		return NewDuplicateTableNameError(alias)
 	}
-	m[uname] = strings.ToUpper(path)
+	m[uname] = viewKey(path)
 	return nil
 }
 
@@ -22,6 +22,15 @@ var aliasMapAddTests = []struct {
 			"TBL": "/PATH/TO/TBL1.CSV",
 		},
 	},
+	{
+		Name:  "AliasMap Add Url",
+		Alias: parser.Identifier{Literal: "url"},
+		Path:  "https://example.com/Table.csv",
+		Result: AliasMap{
+			"TBL": "/PATH/TO/TBL1.CSV",
+			"URL": "https://example.com/Table.csv",
+		},
+	},
 	{
 		Name:  "AliasMap Add Table Name Duplicate Error",
 		Alias: parser.Identifier{Literal: "tbl"},
@@ -23,7 +23,18 @@ var AnalyticFunctions = map[string]AnalyticFunction{
 	"LAG":          Lag{},
 	"LEAD":         Lead{},
 	"LISTAGG":      AnalyticListAgg{},
+	"STRING_AGG":   AnalyticStringAgg{},
 	"JSON_AGG":     AnalyticJsonAgg{},
+	"TOP_K":        AnalyticTopK{},
+	"HISTOGRAM":    AnalyticHistogram{},
+	"MODE":         AnalyticMode{},
+	"FIRST":        AnalyticFirst{},
+	"LAST":         AnalyticLast{},
+	"CORR":         AnalyticBivariateAggregate{Fn: Corr},
+	"COVAR_SAMP":   AnalyticBivariateAggregate{Fn: CovarSamp},
+	"COVAR_POP":    AnalyticBivariateAggregate{Fn: CovarPop},
+
+	"RATIO_TO_REPORT": RatioToReport{},
 }
 
 type AnalyticFunction interface {
@@ -50,6 +61,13 @@ func Analyze(ctx context.Context, scope *ReferenceScope, view *View, fn parser.A
 		anfn = f
 	} else if f, ok := AggregateFunctions[uname]; ok {
 		aggfn = f
+	} else if f, ok := ConditionalAggregateFunctions[uname]; ok {
+		arg, e := conditionalAggregateArgument(fn, fn.Name, fn.Args, fn.IsDistinct())
+		if e != nil {
+			return e
+		}
+		aggfn = f
+		fn.Args = []parser.QueryExpression{arg}
 	} else {
 		if udfn, err = scope.GetFunction(fn, uname); err != nil || !udfn.IsAggregate {
 			return NewFunctionNotExistError(fn, fn.Name)
@@ -748,6 +766,206 @@ func (fn AnalyticListAgg) Execute(ctx context.Context, scope *ReferenceScope, pa
 	return list, nil
 }
 
+type AnalyticStringAgg struct{}
+
+func (fn AnalyticStringAgg) CheckArgsLen(expr parser.AnalyticFunction) error {
+	return CheckArgsLen(expr, []int{2})
+}
+
+func (fn AnalyticStringAgg) Execute(ctx context.Context, scope *ReferenceScope, partition Partition, expr parser.AnalyticFunction) (map[int]value.Primary, error) {
+	return AnalyticListAgg{}.Execute(ctx, scope, partition, expr)
+}
+
+type RatioToReport struct{}
+
+func (fn RatioToReport) CheckArgsLen(expr parser.AnalyticFunction) error {
+	return CheckArgsLen(expr, []int{1})
+}
+
+func (fn RatioToReport) Execute(ctx context.Context, scope *ReferenceScope, partition Partition, expr parser.AnalyticFunction) (map[int]value.Primary, error) {
+	if expr.IsDistinct() {
+		return nil, NewFunctionInvalidArgumentError(expr, expr.Name, "DISTINCT cannot be specified")
+	}
+
+	list := make(map[int]value.Primary, len(partition))
+	valueCache := make(map[int]value.Primary, len(partition))
+	anScope := scope.CreateScopeForAnalytics()
+
+	for _, frame := range WindowFrameSet(partition, expr.AnalyticClause) {
+		values, err := windowValues(ctx, scope, frame, partition, expr, valueCache)
+		if err != nil {
+			return nil, err
+		}
+
+		sum := Sum(values, scope.Tx.Flags)
+		total := value.ToFloat(sum)
+		value.Discard(sum)
+
+		for _, idx := range frame.Records {
+			v, ok := valueCache[idx]
+			if !ok {
+				anScope.Records[0].recordIndex = idx
+				if v, err = Evaluate(ctx, anScope, expr.Args[0]); err != nil {
+					return nil, err
+				}
+				valueCache[idx] = v
+			}
+			list[idx] = ratioToReport(v, total)
+		}
+		value.Discard(total)
+	}
+
+	return list, nil
+}
+
+func ratioToReport(v value.Primary, total value.Primary) value.Primary {
+	if value.IsNull(total) || total.(*value.Float).Raw() == 0 {
+		return value.NewNull()
+	}
+
+	f := value.ToFloat(v)
+	if value.IsNull(f) {
+		return value.NewNull()
+	}
+	defer value.Discard(f)
+
+	return value.NewFloat(f.(*value.Float).Raw() / total.(*value.Float).Raw())
+}
+
+type AnalyticBivariateAggregate struct {
+	Fn BivariateAggregateFunction
+}
+
+func (fn AnalyticBivariateAggregate) CheckArgsLen(expr parser.AnalyticFunction) error {
+	return CheckArgsLen(expr, []int{2})
+}
+
+func (fn AnalyticBivariateAggregate) Execute(ctx context.Context, scope *ReferenceScope, partition Partition, expr parser.AnalyticFunction) (map[int]value.Primary, error) {
+	if expr.IsDistinct() {
+		return nil, NewFunctionInvalidArgumentError(expr, expr.Name, "DISTINCT cannot be specified")
+	}
+
+	anScope := scope.CreateScopeForAnalytics()
+	xList := make([]value.Primary, len(partition))
+	yList := make([]value.Primary, len(partition))
+	for i, idx := range partition {
+		anScope.Records[0].recordIndex = idx
+		x, e := Evaluate(ctx, anScope, expr.Args[0])
+		if e != nil {
+			return nil, e
+		}
+		y, e := Evaluate(ctx, anScope, expr.Args[1])
+		if e != nil {
+			return nil, e
+		}
+		xList[i] = x
+		yList[i] = y
+	}
+
+	val := fn.Fn(xList, yList, scope.Tx.Flags)
+
+	list := make(map[int]value.Primary, len(partition))
+	for _, idx := range partition {
+		list[idx] = val
+	}
+
+	return list, nil
+}
+
+type AnalyticMode struct{}
+
+func (fn AnalyticMode) CheckArgsLen(expr parser.AnalyticFunction) error {
+	_, err := modeValueExpr(expr, expr.Name, expr.IsDistinct(), expr.Args, expr.AnalyticClause.OrderByClause)
+	return err
+}
+
+func (fn AnalyticMode) Execute(ctx context.Context, scope *ReferenceScope, partition Partition, expr parser.AnalyticFunction) (map[int]value.Primary, error) {
+	valueExpr, err := modeValueExpr(expr, expr.Name, expr.IsDistinct(), expr.Args, expr.AnalyticClause.OrderByClause)
+	if err != nil {
+		return nil, err
+	}
+
+	anScope := scope.CreateScopeForAnalytics()
+	values := make([]value.Primary, len(partition))
+	for i, idx := range partition {
+		anScope.Records[0].recordIndex = idx
+		val, e := Evaluate(ctx, anScope, valueExpr)
+		if e != nil {
+			return nil, e
+		}
+		values[i] = val
+	}
+
+	val := Mode(values, scope.Tx.Flags)
+
+	list := make(map[int]value.Primary, len(partition))
+	for _, idx := range partition {
+		list[idx] = val
+	}
+
+	return list, nil
+}
+
+type AnalyticFirst struct{}
+
+func (fn AnalyticFirst) CheckArgsLen(expr parser.AnalyticFunction) error {
+	return CheckArgsLen(expr, []int{1})
+}
+
+func (fn AnalyticFirst) Execute(ctx context.Context, scope *ReferenceScope, partition Partition, expr parser.AnalyticFunction) (map[int]value.Primary, error) {
+	return executeFirstOrLast(ctx, scope, partition, expr, First)
+}
+
+type AnalyticLast struct{}
+
+func (fn AnalyticLast) CheckArgsLen(expr parser.AnalyticFunction) error {
+	return CheckArgsLen(expr, []int{1})
+}
+
+func (fn AnalyticLast) Execute(ctx context.Context, scope *ReferenceScope, partition Partition, expr parser.AnalyticFunction) (map[int]value.Primary, error) {
+	return executeFirstOrLast(ctx, scope, partition, expr, Last)
+}
+
+func executeFirstOrLast(ctx context.Context, scope *ReferenceScope, partition Partition, expr parser.AnalyticFunction, fn func([]value.Primary, [][]value.Primary) value.Primary) (map[int]value.Primary, error) {
+	if expr.AnalyticClause.OrderByClause == nil {
+		return nil, NewFunctionInvalidArgumentError(expr, expr.Name, "ORDER BY clause must be specified")
+	}
+	items := expr.AnalyticClause.OrderByClause.(parser.OrderByClause).Items
+
+	anScope := scope.CreateScopeForAnalytics()
+	values := make([]value.Primary, len(partition))
+	sortValues := make([][]value.Primary, len(items))
+	for i := range items {
+		sortValues[i] = make([]value.Primary, len(partition))
+	}
+
+	for i, idx := range partition {
+		anScope.Records[0].recordIndex = idx
+		val, e := Evaluate(ctx, anScope, expr.Args[0])
+		if e != nil {
+			return nil, e
+		}
+		values[i] = val
+
+		for j, item := range items {
+			sv, e := Evaluate(ctx, anScope, item.(parser.OrderItem).Value)
+			if e != nil {
+				return nil, e
+			}
+			sortValues[j][i] = sv
+		}
+	}
+
+	val := fn(values, sortValues)
+
+	list := make(map[int]value.Primary, len(partition))
+	for _, idx := range partition {
+		list[idx] = val
+	}
+
+	return list, nil
+}
+
 type AnalyticJsonAgg struct{}
 
 func (fn AnalyticJsonAgg) CheckArgsLen(expr parser.AnalyticFunction) error {
@@ -778,3 +996,69 @@ func (fn AnalyticJsonAgg) Execute(ctx context.Context, scope *ReferenceScope, pa
 
 	return list, nil
 }
+
+type AnalyticTopK struct{}
+
+func (fn AnalyticTopK) CheckArgsLen(expr parser.AnalyticFunction) error {
+	return CheckArgsLen(expr, []int{2})
+}
+
+func (fn AnalyticTopK) Execute(ctx context.Context, scope *ReferenceScope, partition Partition, expr parser.AnalyticFunction) (map[int]value.Primary, error) {
+	k, err := checkArgsForTopK(ctx, scope, expr, expr.Name, expr.IsDistinct(), expr.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	anScope := scope.CreateScopeForAnalytics()
+	values := make([]value.Primary, len(partition))
+	for i, idx := range partition {
+		anScope.Records[0].recordIndex = idx
+		val, e := Evaluate(ctx, anScope, expr.Args[0])
+		if e != nil {
+			return nil, e
+		}
+		values[i] = val
+	}
+
+	val := TopK(values, k, scope.Tx.Flags)
+
+	list := make(map[int]value.Primary, len(partition))
+	for _, idx := range partition {
+		list[idx] = val
+	}
+
+	return list, nil
+}
+
+type AnalyticHistogram struct{}
+
+func (fn AnalyticHistogram) CheckArgsLen(expr parser.AnalyticFunction) error {
+	return CheckArgsLen(expr, []int{4})
+}
+
+func (fn AnalyticHistogram) Execute(ctx context.Context, scope *ReferenceScope, partition Partition, expr parser.AnalyticFunction) (map[int]value.Primary, error) {
+	low, high, n, err := checkArgsForHistogram(ctx, scope, expr, expr.Name, expr.IsDistinct(), expr.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	anScope := scope.CreateScopeForAnalytics()
+	values := make([]value.Primary, len(partition))
+	for i, idx := range partition {
+		anScope.Records[0].recordIndex = idx
+		val, e := Evaluate(ctx, anScope, expr.Args[0])
+		if e != nil {
+			return nil, e
+		}
+		values[i] = val
+	}
+
+	val := Histogram(values, low, high, n)
+
+	list := make(map[int]value.Primary, len(partition))
+	for _, idx := range partition {
+		list[idx] = val
+	}
+
+	return list, nil
+}
@@ -548,6 +548,122 @@ var analyzeTests = []struct {
 		},
 		Error: "field notexist does not exist",
 	},
+	{
+		Name: "Analyze ConditionalAggregateFunction",
+		View: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewNull(),
+				}),
+			},
+		},
+		Function: parser.AnalyticFunction{
+			Name: "sum_if",
+			Args: []parser.QueryExpression{
+				parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					RHS:      parser.NewIntegerValue(1),
+					Operator: parser.Token{Token: '>', Literal: ">"},
+				},
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+			AnalyticClause: parser.AnalyticClause{
+				PartitionClause: parser.PartitionClause{
+					Values: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
+				},
+			},
+		},
+		PartitionIndices: []int{0},
+		Result: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(2),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewNull(),
+					value.NewNull(),
+				}),
+			},
+			sortValuesInEachCell: [][]*SortValue{
+				{NewSortValue(value.NewString("a"), TestTx.Flags), nil},
+				{NewSortValue(value.NewString("a"), TestTx.Flags), nil},
+				{NewSortValue(value.NewString("b"), TestTx.Flags), nil},
+				{NewSortValue(value.NewString("b"), TestTx.Flags), nil},
+			},
+		},
+	},
+	{
+		Name: "Analyze ConditionalAggregateFunction Argument Length Error",
+		View: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewNull(),
+				}),
+			},
+		},
+		Function: parser.AnalyticFunction{
+			Name: "sum_if",
+			Args: []parser.QueryExpression{
+				parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					RHS:      parser.NewIntegerValue(1),
+					Operator: parser.Token{Token: '>', Literal: ">"},
+				},
+			},
+			AnalyticClause: parser.AnalyticClause{
+				PartitionClause: parser.PartitionClause{
+					Values: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
+				},
+			},
+		},
+		Error: "function sum_if takes exactly 2 arguments",
+	},
 	{
 		Name: "Analyze UserDefinedFunction",
 		View: &View{
@@ -2200,6 +2316,346 @@ func TestAnalyticListAgg_Execute(t *testing.T) {
 	testAnalyticFunctionExecute(t, AnalyticListAgg{}, analyticListAggExecuteTests)
 }
 
+var analyticBivariateAggregateCheckArgsLenTests = []analyticFunctionCheckArgsLenTests{
+	{
+		Name: "BivariateAggregate CheckArgsLen Error",
+		Function: parser.AnalyticFunction{
+			Name: "corr",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+		},
+		Error: "function corr takes exactly 2 arguments",
+	},
+}
+
+func TestAnalyticBivariateAggregate_CheckArgsLen(t *testing.T) {
+	testAnalyticFunctionCheckArgsLenTests(t, AnalyticBivariateAggregate{Fn: Corr}, analyticBivariateAggregateCheckArgsLenTests)
+}
+
+var analyticCorrExecuteTests = []analyticFunctionExecuteTests{
+	{
+		Name:  "AnalyticBivariateAggregate Execute Corr",
+		Items: Partition{3, 4, 5, 6},
+		Function: parser.AnalyticFunction{
+			Name: "corr",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+		},
+		Result: map[int]value.Primary{
+			3: value.NewInteger(1),
+			4: value.NewInteger(1),
+			5: value.NewInteger(1),
+			6: value.NewInteger(1),
+		},
+	},
+	{
+		Name:  "AnalyticBivariateAggregate Execute Distinct Error",
+		Items: Partition{3, 4, 5, 6},
+		Function: parser.AnalyticFunction{
+			Name:     "corr",
+			Distinct: parser.Token{Token: parser.DISTINCT, Literal: "distinct"},
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+		},
+		Error: "DISTINCT cannot be specified for function corr",
+	},
+	{
+		Name:  "AnalyticBivariateAggregate Execute Argument Evaluation Error",
+		Items: Partition{3, 4, 5, 6},
+		Function: parser.AnalyticFunction{
+			Name: "corr",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+			},
+		},
+		Error: "field notexist does not exist",
+	},
+}
+
+func TestAnalyticBivariateAggregate_Execute(t *testing.T) {
+	testAnalyticFunctionExecute(t, AnalyticBivariateAggregate{Fn: Corr}, analyticCorrExecuteTests)
+}
+
+var analyticCovarSampExecuteTests = []analyticFunctionExecuteTests{
+	{
+		Name:  "AnalyticBivariateAggregate Execute CovarSamp",
+		Items: Partition{3, 4, 5, 6},
+		Function: parser.AnalyticFunction{
+			Name: "covar_samp",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+		},
+		Result: map[int]value.Primary{
+			3: value.NewInteger(70000),
+			4: value.NewInteger(70000),
+			5: value.NewInteger(70000),
+			6: value.NewInteger(70000),
+		},
+	},
+}
+
+func TestAnalyticBivariateAggregate_Execute_CovarSamp(t *testing.T) {
+	testAnalyticFunctionExecute(t, AnalyticBivariateAggregate{Fn: CovarSamp}, analyticCovarSampExecuteTests)
+}
+
+var analyticCovarPopExecuteTests = []analyticFunctionExecuteTests{
+	{
+		Name:  "AnalyticBivariateAggregate Execute CovarPop",
+		Items: Partition{3, 4, 5, 6},
+		Function: parser.AnalyticFunction{
+			Name: "covar_pop",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+		},
+		Result: map[int]value.Primary{
+			3: value.NewInteger(52500),
+			4: value.NewInteger(52500),
+			5: value.NewInteger(52500),
+			6: value.NewInteger(52500),
+		},
+	},
+}
+
+func TestAnalyticBivariateAggregate_Execute_CovarPop(t *testing.T) {
+	testAnalyticFunctionExecute(t, AnalyticBivariateAggregate{Fn: CovarPop}, analyticCovarPopExecuteTests)
+}
+
+var analyticModeCheckArgsLenTests = []analyticFunctionCheckArgsLenTests{
+	{
+		Name: "Mode CheckArgsLen Error",
+		Function: parser.AnalyticFunction{
+			Name: "mode",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+			AnalyticClause: parser.AnalyticClause{
+				OrderByClause: parser.OrderByClause{
+					Items: []parser.QueryExpression{
+						parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+					},
+				},
+			},
+		},
+		Error: "function mode takes no argument",
+	},
+	{
+		Name: "Mode CheckArgsLen Within Group Error",
+		Function: parser.AnalyticFunction{
+			Name: "mode",
+		},
+		Error: "the WITHIN GROUP clause must have exactly one order item for function mode",
+	},
+}
+
+func TestAnalyticMode_CheckArgsLen(t *testing.T) {
+	testAnalyticFunctionCheckArgsLenTests(t, AnalyticMode{}, analyticModeCheckArgsLenTests)
+}
+
+var analyticModeExecuteTests = []analyticFunctionExecuteTests{
+	{
+		Name:  "AnalyticMode Execute",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "mode",
+			AnalyticClause: parser.AnalyticClause{
+				OrderByClause: parser.OrderByClause{
+					Items: []parser.QueryExpression{
+						parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+					},
+				},
+			},
+		},
+		Result: map[int]value.Primary{
+			0: value.NewInteger(200),
+			1: value.NewInteger(200),
+			2: value.NewInteger(200),
+			3: value.NewInteger(200),
+			4: value.NewInteger(200),
+		},
+	},
+	{
+		Name:  "AnalyticMode Execute Value Evaluation Error",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "mode",
+			AnalyticClause: parser.AnalyticClause{
+				OrderByClause: parser.OrderByClause{
+					Items: []parser.QueryExpression{
+						parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}}},
+					},
+				},
+			},
+		},
+		Error: "field notexist does not exist",
+	},
+}
+
+func TestAnalyticMode_Execute(t *testing.T) {
+	testAnalyticFunctionExecute(t, AnalyticMode{}, analyticModeExecuteTests)
+}
+
+var analyticFirstCheckArgsLenTests = []analyticFunctionCheckArgsLenTests{
+	{
+		Name: "First CheckArgsLen Error",
+		Function: parser.AnalyticFunction{
+			Name: "first",
+			AnalyticClause: parser.AnalyticClause{
+				OrderByClause: parser.OrderByClause{
+					Items: []parser.QueryExpression{
+						parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+					},
+				},
+			},
+		},
+		Error: "function first takes exactly 1 argument",
+	},
+}
+
+func TestAnalyticFirst_CheckArgsLen(t *testing.T) {
+	testAnalyticFunctionCheckArgsLenTests(t, AnalyticFirst{}, analyticFirstCheckArgsLenTests)
+}
+
+var analyticFirstExecuteTests = []analyticFunctionExecuteTests{
+	{
+		Name:  "AnalyticFirst Execute",
+		Items: Partition{2, 3, 4, 7},
+		Function: parser.AnalyticFunction{
+			Name: "first",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+			AnalyticClause: parser.AnalyticClause{
+				OrderByClause: parser.OrderByClause{
+					Items: []parser.QueryExpression{
+						parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+					},
+				},
+			},
+		},
+		Result: map[int]value.Primary{
+			2: value.NewInteger(200),
+			3: value.NewInteger(200),
+			4: value.NewInteger(200),
+			7: value.NewInteger(200),
+		},
+	},
+	{
+		Name:  "AnalyticFirst Execute Sort Value Evaluation Error",
+		Items: Partition{2, 3, 4, 7},
+		Function: parser.AnalyticFunction{
+			Name: "first",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+			AnalyticClause: parser.AnalyticClause{
+				OrderByClause: parser.OrderByClause{
+					Items: []parser.QueryExpression{
+						parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}}},
+					},
+				},
+			},
+		},
+		Error: "field notexist does not exist",
+	},
+	{
+		Name:  "AnalyticFirst Execute Order By Error",
+		Items: Partition{2, 3, 4, 7},
+		Function: parser.AnalyticFunction{
+			Name: "first",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+		},
+		Error: "ORDER BY clause must be specified for function first",
+	},
+}
+
+func TestAnalyticFirst_Execute(t *testing.T) {
+	testAnalyticFunctionExecute(t, AnalyticFirst{}, analyticFirstExecuteTests)
+}
+
+var analyticLastExecuteTests = []analyticFunctionExecuteTests{
+	{
+		Name:  "AnalyticLast Execute",
+		Items: Partition{2, 3, 4, 7},
+		Function: parser.AnalyticFunction{
+			Name: "last",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+			AnalyticClause: parser.AnalyticClause{
+				OrderByClause: parser.OrderByClause{
+					Items: []parser.QueryExpression{
+						parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+					},
+				},
+			},
+		},
+		Result: map[int]value.Primary{
+			2: value.NewInteger(300),
+			3: value.NewInteger(300),
+			4: value.NewInteger(300),
+			7: value.NewInteger(300),
+		},
+	},
+}
+
+func TestAnalyticLast_Execute(t *testing.T) {
+	testAnalyticFunctionExecute(t, AnalyticLast{}, analyticLastExecuteTests)
+}
+
+var analyticStringAggCheckArgsLenTests = []analyticFunctionCheckArgsLenTests{
+	{
+		Name: "StringAgg CheckArgsLen Too Little Error",
+		Function: parser.AnalyticFunction{
+			Name: "string_agg",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+		},
+		Error: "function string_agg takes exactly 2 arguments",
+	},
+}
+
+func TestAnalyticStringAgg_CheckArgsLen(t *testing.T) {
+	testAnalyticFunctionCheckArgsLenTests(t, AnalyticStringAgg{}, analyticStringAggCheckArgsLenTests)
+}
+
+var analyticStringAggExecuteTests = []analyticFunctionExecuteTests{
+	{
+		Name:  "AnalyticStringAgg Execute",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "string_agg",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.NewStringValue(", "),
+			},
+		},
+		Result: map[int]value.Primary{
+			0: value.NewString("100, 200, 200, 300"),
+			1: value.NewString("100, 200, 200, 300"),
+			2: value.NewString("100, 200, 200, 300"),
+			3: value.NewString("100, 200, 200, 300"),
+			4: value.NewString("100, 200, 200, 300"),
+		},
+	},
+}
+
+func TestAnalyticStringAgg_Execute(t *testing.T) {
+	testAnalyticFunctionExecute(t, AnalyticStringAgg{}, analyticStringAggExecuteTests)
+}
+
 var analyticJsonAggCheckArgsLenTests = []analyticFunctionCheckArgsLenTests{
 	{
 		Name: "JsonAgg CheckArgsLen Too Little Error",
@@ -2266,3 +2722,251 @@ var analyticJsonAggExecuteTests = []analyticFunctionExecuteTests{
 func TestAnalyticJsonAgg_Execute(t *testing.T) {
 	testAnalyticFunctionExecute(t, AnalyticJsonAgg{}, analyticJsonAggExecuteTests)
 }
+
+var analyticTopKCheckArgsLenTests = []analyticFunctionCheckArgsLenTests{
+	{
+		Name: "TopK CheckArgsLen Too Little Error",
+		Function: parser.AnalyticFunction{
+			Name: "top_k",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+		},
+		Error: "function top_k takes exactly 2 arguments",
+	},
+}
+
+func TestAnalyticTopK_CheckArgsLen(t *testing.T) {
+	testAnalyticFunctionCheckArgsLenTests(t, AnalyticTopK{}, analyticTopKCheckArgsLenTests)
+}
+
+var analyticTopKExecuteTests = []analyticFunctionExecuteTests{
+	{
+		Name:  "AnalyticTopK Execute",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "top_k",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.NewIntegerValue(2),
+			},
+		},
+		Result: map[int]value.Primary{
+			0: value.NewString("[{\"value\":200,\"count\":2},{\"value\":100,\"count\":1}]"),
+			1: value.NewString("[{\"value\":200,\"count\":2},{\"value\":100,\"count\":1}]"),
+			2: value.NewString("[{\"value\":200,\"count\":2},{\"value\":100,\"count\":1}]"),
+			3: value.NewString("[{\"value\":200,\"count\":2},{\"value\":100,\"count\":1}]"),
+			4: value.NewString("[{\"value\":200,\"count\":2},{\"value\":100,\"count\":1}]"),
+		},
+	},
+	{
+		Name:  "AnalyticTopK Execute Second Argument Error",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "top_k",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.NewIntegerValue(0),
+			},
+		},
+		Error: "the second argument must be a positive integer for function top_k",
+	},
+	{
+		Name:  "AnalyticTopK Execute First Argument Evaluation Error",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "top_k",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+				parser.NewIntegerValue(2),
+			},
+		},
+		Error: "field notexist does not exist",
+	},
+}
+
+func TestAnalyticTopK_Execute(t *testing.T) {
+	testAnalyticFunctionExecute(t, AnalyticTopK{}, analyticTopKExecuteTests)
+}
+
+var analyticHistogramCheckArgsLenTests = []analyticFunctionCheckArgsLenTests{
+	{
+		Name: "Histogram CheckArgsLen Too Little Error",
+		Function: parser.AnalyticFunction{
+			Name: "histogram",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+		},
+		Error: "function histogram takes exactly 4 arguments",
+	},
+}
+
+func TestAnalyticHistogram_CheckArgsLen(t *testing.T) {
+	testAnalyticFunctionCheckArgsLenTests(t, AnalyticHistogram{}, analyticHistogramCheckArgsLenTests)
+}
+
+var analyticHistogramExecuteTests = []analyticFunctionExecuteTests{
+	{
+		Name:  "AnalyticHistogram Execute",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "histogram",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.NewIntegerValue(150),
+				parser.NewIntegerValue(250),
+				parser.NewIntegerValue(2),
+			},
+		},
+		Result: map[int]value.Primary{
+			0: value.NewString("{\"buckets\":[0,2],\"underflow\":1,\"overflow\":1,\"invalid\":1}"),
+			1: value.NewString("{\"buckets\":[0,2],\"underflow\":1,\"overflow\":1,\"invalid\":1}"),
+			2: value.NewString("{\"buckets\":[0,2],\"underflow\":1,\"overflow\":1,\"invalid\":1}"),
+			3: value.NewString("{\"buckets\":[0,2],\"underflow\":1,\"overflow\":1,\"invalid\":1}"),
+			4: value.NewString("{\"buckets\":[0,2],\"underflow\":1,\"overflow\":1,\"invalid\":1}"),
+		},
+	},
+	{
+		Name:  "AnalyticHistogram Execute Range Error",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "histogram",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.NewIntegerValue(250),
+				parser.NewIntegerValue(150),
+				parser.NewIntegerValue(2),
+			},
+		},
+		Error: "the third argument must be greater than the second argument for function histogram",
+	},
+	{
+		Name:  "AnalyticHistogram Execute First Argument Evaluation Error",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "histogram",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+				parser.NewIntegerValue(150),
+				parser.NewIntegerValue(250),
+				parser.NewIntegerValue(2),
+			},
+		},
+		Error: "field notexist does not exist",
+	},
+}
+
+func TestAnalyticHistogram_Execute(t *testing.T) {
+	testAnalyticFunctionExecute(t, AnalyticHistogram{}, analyticHistogramExecuteTests)
+}
+
+var ratioToReportCheckArgsLenTests = []analyticFunctionCheckArgsLenTests{
+	{
+		Name: "RatioToReport CheckArgsLen Error",
+		Function: parser.AnalyticFunction{
+			Name: "ratio_to_report",
+		},
+		Error: "function ratio_to_report takes exactly 1 argument",
+	},
+}
+
+func TestRatioToReport_CheckArgsLen(t *testing.T) {
+	testAnalyticFunctionCheckArgsLenTests(t, RatioToReport{}, ratioToReportCheckArgsLenTests)
+}
+
+var ratioToReportExecuteTests = []analyticFunctionExecuteTests{
+	{
+		Name:  "RatioToReport Execute",
+		Items: Partition{3, 4, 5, 7},
+		Function: parser.AnalyticFunction{
+			Name: "ratio_to_report",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+		},
+		Result: map[int]value.Primary{
+			3: value.NewFloat(0.2),
+			4: value.NewFloat(0.3),
+			5: value.NewFloat(0.5),
+			7: value.NewNull(),
+		},
+	},
+	{
+		Name:  "RatioToReport Execute With Window Frame",
+		Items: Partition{3, 4, 5},
+		Function: parser.AnalyticFunction{
+			Name: "ratio_to_report",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+			AnalyticClause: parser.AnalyticClause{
+				OrderByClause: parser.OrderByClause{
+					Items: []parser.QueryExpression{
+						parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+					},
+				},
+			},
+		},
+		Result: map[int]value.Primary{
+			3: value.NewFloat(1),
+			4: value.NewFloat(0.6),
+			5: value.NewFloat(0.5),
+		},
+	},
+	{
+		Name:  "RatioToReport Execute Null Total",
+		Items: Partition{2, 7},
+		Function: parser.AnalyticFunction{
+			Name: "ratio_to_report",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+		},
+		Result: map[int]value.Primary{
+			2: value.NewNull(),
+			7: value.NewNull(),
+		},
+	},
+	{
+		Name:  "RatioToReport Execute Zero Total",
+		Items: Partition{3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "ratio_to_report",
+			Args: []parser.QueryExpression{
+				parser.NewIntegerValue(0),
+			},
+		},
+		Result: map[int]value.Primary{
+			3: value.NewNull(),
+			4: value.NewNull(),
+		},
+	},
+	{
+		Name:  "RatioToReport Execute Distinct Error",
+		Items: Partition{3, 4},
+		Function: parser.AnalyticFunction{
+			Name:     "ratio_to_report",
+			Distinct: parser.Token{Token: parser.DISTINCT, Literal: "distinct"},
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+		},
+		Error: "DISTINCT cannot be specified for function ratio_to_report",
+	},
+	{
+		Name:  "RatioToReport Execute Argument Value Error",
+		Items: Partition{3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "ratio_to_report",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+			},
+		},
+		Error: "field notexist does not exist",
+	},
+}
+
+func TestRatioToReport_Execute(t *testing.T) {
+	testAnalyticFunctionExecute(t, RatioToReport{}, ratioToReportExecuteTests)
+}
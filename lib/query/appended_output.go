@@ -0,0 +1,148 @@
+package query
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+
+	"github.com/mithrandie/go-text/csv"
+)
+
+// IsAppendableFormat returns true if result sets in the format can be appended
+// to an existing file by writing them after the end of the file.
+func IsAppendableFormat(format cmd.Format) bool {
+	switch format {
+	case cmd.CSV, cmd.TSV, cmd.FIXED, cmd.NDJSON, cmd.LTSV, cmd.SQL_INSERT:
+		return true
+	}
+	return false
+}
+
+// AppendedOutFile is the existing file to which result sets are appended.
+// Fields of result sets are arranged in the order of the header of the file
+// when result sets are written in CSV or TSV.
+type AppendedOutFile struct {
+	Path     string
+	NoHeader bool
+
+	header     []string
+	headerRead bool
+}
+
+func NewAppendedOutFile(fpath string, noHeader bool) *AppendedOutFile {
+	return &AppendedOutFile{
+		Path:     fpath,
+		NoHeader: noHeader,
+	}
+}
+
+// readHeader reads the field names in the first line of the file.
+// If the file is empty, then returns nil.
+func (f *AppendedOutFile) readHeader(options cmd.ExportOptions) ([]string, error) {
+	fp, err := os.Open(f.Path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = fp.Close()
+	}()
+
+	r, _, err := decompressFile(fp)
+	if err != nil {
+		return nil, err
+	}
+	enc, err := detectEncoding(r, options.Encoding)
+	if err != nil {
+		return nil, err
+	}
+	reader, err := csv.NewReader(readerForTextLibrary(r, enc))
+	if err != nil {
+		return nil, err
+	}
+	reader.Delimiter = options.Delimiter
+	if options.Format == cmd.TSV {
+		reader.Delimiter = '\t'
+	}
+
+	header, err := reader.ReadHeader()
+	if err == io.EOF {
+		return nil, nil
+	}
+	return header, err
+}
+
+// Align returns the view whose fields are arranged in the order of the header
+// of the file. If the field names of the view do not match the header, or the
+// format cannot be appended to the file, then returns an error.
+func (f *AppendedOutFile) Align(view *View, options cmd.ExportOptions) (*View, error) {
+	if !IsAppendableFormat(options.Format) {
+		return nil, NewIncorrectCommandUsageError(fmt.Sprintf("--append option cannot be used with %s format", options.Format))
+	}
+	if f.NoHeader || (options.Format != cmd.CSV && options.Format != cmd.TSV) {
+		return view, nil
+	}
+
+	if !f.headerRead {
+		header, err := f.readHeader(options)
+		if err != nil {
+			return nil, NewIOError(nil, err.Error())
+		}
+		f.header = header
+		f.headerRead = true
+	}
+	if f.header == nil {
+		return view, nil
+	}
+
+	if len(view.Header) != len(f.header) {
+		return nil, NewOutFileHeaderMismatchError(f.Path)
+	}
+
+	indices := make([]int, len(f.header))
+	used := make([]bool, len(view.Header))
+	for i, name := range f.header {
+		indices[i] = -1
+		for j := range view.Header {
+			if !used[j] && strings.EqualFold(view.Header[j].Column, name) {
+				indices[i] = j
+				used[j] = true
+				break
+			}
+		}
+		if indices[i] < 0 {
+			return nil, NewOutFileHeaderMismatchError(f.Path)
+		}
+	}
+
+	aligned := true
+	for i, idx := range indices {
+		if i != idx {
+			aligned = false
+			break
+		}
+	}
+	if aligned {
+		return view, nil
+	}
+
+	header := make(Header, len(indices))
+	for i, idx := range indices {
+		header[i] = view.Header[idx]
+	}
+	records := make(RecordSet, view.RecordLen())
+	for i, record := range view.RecordSet {
+		records[i] = make(Record, len(indices))
+		for j, idx := range indices {
+			records[i][j] = record[idx]
+		}
+	}
+
+	return &View{
+		Header:    header,
+		RecordSet: records,
+		FileInfo:  view.FileInfo,
+	}, nil
+}
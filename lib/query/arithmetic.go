@@ -65,3 +65,25 @@ func calculateInteger(i1 int64, i2 int64, operator int) value.Primary {
 
 	return value.NewInteger(result)
 }
+
+func CalculateBitwise(i1 int64, i2 int64, operator string) value.Primary {
+	var result int64 = 0
+	switch operator {
+	case "&":
+		result = i1 & i2
+	case "|":
+		result = i1 | i2
+	case "^":
+		result = i1 ^ i2
+	case "<<":
+		if 0 <= i2 && i2 <= 63 {
+			result = i1 << uint64(i2)
+		}
+	case ">>":
+		if 0 <= i2 && i2 <= 63 {
+			result = i1 >> uint64(i2)
+		}
+	}
+
+	return value.NewInteger(result)
+}
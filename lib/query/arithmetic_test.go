@@ -85,7 +85,72 @@ func TestCalculate(t *testing.T) {
 	for _, v := range calculateTests {
 		r := Calculate(v.LHS, v.RHS, v.Operator)
 		if !reflect.DeepEqual(r, v.Result) {
-			t.Errorf("result = %s, want %s for (%s %s %s)", r, v.Result, v.LHS, string(v.Operator), v.RHS)
+			t.Errorf("result = %s, want %s for (%s %s %s)", r, v.Result, v.LHS, string(rune(v.Operator)), v.RHS)
+		}
+	}
+}
+
+var calculateBitwiseTests = []struct {
+	LHS      int64
+	RHS      int64
+	Operator string
+	Result   value.Primary
+}{
+	{
+		LHS:      6,
+		RHS:      3,
+		Operator: "&",
+		Result:   value.NewInteger(2),
+	},
+	{
+		LHS:      6,
+		RHS:      3,
+		Operator: "|",
+		Result:   value.NewInteger(7),
+	},
+	{
+		LHS:      6,
+		RHS:      3,
+		Operator: "^",
+		Result:   value.NewInteger(5),
+	},
+	{
+		LHS:      1,
+		RHS:      4,
+		Operator: "<<",
+		Result:   value.NewInteger(16),
+	},
+	{
+		LHS:      256,
+		RHS:      2,
+		Operator: ">>",
+		Result:   value.NewInteger(64),
+	},
+	{
+		LHS:      1,
+		RHS:      64,
+		Operator: "<<",
+		Result:   value.NewInteger(0),
+	},
+	{
+		LHS:      -1,
+		RHS:      64,
+		Operator: ">>",
+		Result:   value.NewInteger(0),
+	},
+	{
+		LHS:      1,
+		RHS:      -1,
+		Operator: "<<",
+		Result:   value.NewInteger(0),
+	},
+}
+
+func TestCalculateBitwise(t *testing.T) {
+	for _, v := range calculateBitwiseTests {
+		r := CalculateBitwise(v.LHS, v.RHS, v.Operator)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("result = %s, want %s for (%d %s %d)", r, v.Result, v.LHS, v.Operator, v.RHS)
 		}
 	}
 }
@@ -2,12 +2,15 @@ package query
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
+	"math"
 	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/file"
@@ -43,6 +46,8 @@ const (
 	ShowFlags      = "FLAGS"
 	ShowEnv        = "ENV"
 	ShowRuninfo    = "RUNINFO"
+	ShowSettings   = "SETTINGS"
+	ShowVariables  = "VARIABLES"
 )
 
 var ShowObjectList = []string{
@@ -50,10 +55,12 @@ var ShowObjectList = []string{
 	ShowViews,
 	ShowCursors,
 	ShowFunctions,
+	ShowVariables,
 	ShowStatements,
 	ShowFlags,
 	ShowEnv,
 	ShowRuninfo,
+	ShowSettings,
 }
 
 func Echo(ctx context.Context, scope *ReferenceScope, expr parser.Echo) (string, error) {
@@ -215,16 +222,19 @@ func SetFlag(ctx context.Context, scope *ReferenceScope, expr parser.SetFlag) er
 
 	switch strings.ToUpper(expr.Flag.Name) {
 	case cmd.RepositoryFlag, cmd.TimezoneFlag, cmd.DatetimeFormatFlag,
-		cmd.ImportFormatFlag, cmd.DelimiterFlag, cmd.DelimiterPositionsFlag, cmd.JsonQueryFlag, cmd.EncodingFlag,
+		cmd.ImportFormatFlag, cmd.DelimiterFlag, cmd.DelimiterRegexFlag, cmd.DelimiterPositionsFlag, cmd.JsonQueryFlag, cmd.EncodingFlag,
+		cmd.ColumnTypesFlag, cmd.CommentPrefixFlag,
 		cmd.ExportEncodingFlag, cmd.FormatFlag, cmd.ExportDelimiterFlag, cmd.ExportDelimiterPositionsFlag,
-		cmd.LineBreakFlag, cmd.JsonEscapeFlag:
+		cmd.LineBreakFlag, cmd.JsonEscapeFlag, cmd.CompressionFlag, cmd.HttpHeadersFlag, cmd.NullsOrderFlag, cmd.RaggedFlag, cmd.TeeFlag, cmd.NullTextFlag,
+		cmd.HtmlClassFlag, cmd.TableNameFlag, cmd.IdentifierQuoteFlag, cmd.TemplateFlag, cmd.ParquetCompressionFlag, cmd.JsonKeyFlag, cmd.QuoteCharFlag, cmd.EscapeStyleFlag, cmd.QuoteModeFlag, cmd.ExcelSafePrefixFlag:
 		p = value.ToString(v)
 		if value.IsNull(p) {
 			return NewFlagValueNotAllowedFormatError(expr)
 		}
 		val = p.(*value.String).Raw()
-	case cmd.AnsiQuotesFlag, cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.WithoutHeaderFlag, cmd.EncloseAllFlag,
-		cmd.PrettyPrintFlag, cmd.StripEndingLineBreakFlag,
+	case cmd.AnsiQuotesFlag, cmd.StableSortFlag, cmd.ConcatNullAsEmptyFlag, cmd.AppendCommitFlag, cmd.AtomicWriteFlag, cmd.DryRunFlag, cmd.IgnoreConstraintsFlag, cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.StrictColumnTypesFlag, cmd.StrictGlobHeaderFlag, cmd.NoInferFlag,
+		cmd.SkipAllCommentsFlag, cmd.TrimFlag, cmd.DetectFlag,
+		cmd.WriteBOMFlag, cmd.ReplaceUnencodableFlag, cmd.WithoutHeaderFlag, cmd.TypedHeaderFlag, cmd.EncloseAllFlag, cmd.ExcelSafeFlag, cmd.PrettyPrintFlag, cmd.JsonKeyLastFlag, cmd.JsonFlatFlag, cmd.XmlAttributesFlag, cmd.WrapTextFlag, cmd.BoxDrawingFlag, cmd.ParquetAllStringsFlag, cmd.StripEndingLineBreakFlag,
 		cmd.EastAsianEncodingFlag, cmd.CountDiacriticalSignFlag, cmd.CountFormatCodeFlag, cmd.ColorFlag,
 		cmd.QuietFlag, cmd.StatsFlag:
 		p = value.ToBoolean(v)
@@ -232,30 +242,71 @@ func SetFlag(ctx context.Context, scope *ReferenceScope, expr parser.SetFlag) er
 			return NewFlagValueNotAllowedFormatError(expr)
 		}
 		val = p.(*value.Boolean).Raw()
-	case cmd.WaitTimeoutFlag:
+	case cmd.WaitTimeoutFlag, cmd.HttpTimeoutFlag:
 		p = value.ToFloat(v)
 		if value.IsNull(p) {
 			return NewFlagValueNotAllowedFormatError(expr)
 		}
 		val = p.(*value.Float).Raw()
-	case cmd.LimitRecursion, cmd.CPUFlag:
+	case cmd.SkipLinesFlag, cmd.LimitRecursion, cmd.CPUFlag, cmd.CompressionLevelFlag, cmd.MaxColumnWidthFlag, cmd.InsertBatchSizeFlag, cmd.HttpMaxSizeFlag:
 		p = value.ToInteger(v)
 		if value.IsNull(p) {
 			return NewFlagValueNotAllowedFormatError(expr)
 		}
 		val = p.(*value.Integer).Raw()
+	case cmd.NowFlag:
+		if value.IsNull(v) {
+			val = time.Time{}
+			break
+		}
+		p = value.ToDatetime(v, scope.Tx.Flags.DatetimeFormat)
+		if value.IsNull(p) {
+			return NewFlagValueNotAllowedFormatError(expr)
+		}
+		val = p.(*value.Datetime).Raw()
 	default:
 		return NewInvalidFlagNameError(expr.Flag)
 	}
 
 	value.Discard(p)
 
+	if err = validateFlagValue(expr.Flag.Name, val); err != nil {
+		return NewInvalidFlagValueError(expr, err.Error())
+	}
 	if err = scope.Tx.SetFlag(expr.Flag.Name, val); err != nil {
 		return NewInvalidFlagValueError(expr, err.Error())
 	}
 	return nil
 }
 
+// validateFlagValue rejects values that the flag setters would silently
+// ignore or adjust, so that set flag statements do not end up as no-ops.
+func validateFlagValue(name string, val interface{}) error {
+	optionName := strings.Replace(strings.ToLower(name), "_", "-", -1)
+
+	switch strings.ToUpper(name) {
+	case cmd.DelimiterFlag, cmd.DelimiterPositionsFlag, cmd.EncodingFlag,
+		cmd.ExportEncodingFlag, cmd.FormatFlag, cmd.ExportDelimiterFlag, cmd.ExportDelimiterPositionsFlag,
+		cmd.LineBreakFlag:
+		if len(val.(string)) < 1 {
+			return errors.New(fmt.Sprintf("%s must not be empty", optionName))
+		}
+	case cmd.WaitTimeoutFlag, cmd.HttpTimeoutFlag:
+		if val.(float64) < 0 {
+			return errors.New(fmt.Sprintf("%s must be 0 or greater", optionName))
+		}
+	case cmd.SkipLinesFlag, cmd.HttpMaxSizeFlag:
+		if val.(int64) < 0 {
+			return errors.New(fmt.Sprintf("%s must be 0 or greater", optionName))
+		}
+	case cmd.CPUFlag:
+		if val.(int64) < 1 {
+			return errors.New(fmt.Sprintf("%s must be 1 or greater", optionName))
+		}
+	}
+	return nil
+}
+
 func AddFlagElement(ctx context.Context, scope *ReferenceScope, expr parser.AddFlagElement) error {
 	switch strings.ToUpper(expr.Flag.Name) {
 	case cmd.DatetimeFormatFlag:
@@ -265,14 +316,19 @@ func AddFlagElement(ctx context.Context, scope *ReferenceScope, expr parser.AddF
 			Value:    expr.Value,
 		}
 		return SetFlag(ctx, scope, e)
-	case cmd.RepositoryFlag, cmd.TimezoneFlag, cmd.AnsiQuotesFlag,
-		cmd.ImportFormatFlag, cmd.DelimiterFlag, cmd.DelimiterPositionsFlag, cmd.JsonQueryFlag, cmd.EncodingFlag,
-		cmd.ExportEncodingFlag, cmd.FormatFlag, cmd.ExportDelimiterFlag, cmd.ExportDelimiterPositionsFlag,
-		cmd.LineBreakFlag, cmd.JsonEscapeFlag, cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.WithoutHeaderFlag,
-		cmd.EncloseAllFlag, cmd.PrettyPrintFlag, cmd.StripEndingLineBreakFlag,
-		cmd.EastAsianEncodingFlag, cmd.CountDiacriticalSignFlag, cmd.CountFormatCodeFlag, cmd.ColorFlag,
-		cmd.QuietFlag, cmd.StatsFlag,
-		cmd.WaitTimeoutFlag,
+	case cmd.RepositoryFlag, cmd.TimezoneFlag, cmd.AnsiQuotesFlag, cmd.NullsOrderFlag, cmd.StableSortFlag, cmd.ConcatNullAsEmptyFlag, cmd.AppendCommitFlag, cmd.AtomicWriteFlag, cmd.DryRunFlag, cmd.IgnoreConstraintsFlag,
+		cmd.ImportFormatFlag, cmd.DelimiterFlag, cmd.DelimiterRegexFlag, cmd.DelimiterPositionsFlag, cmd.QuoteCharFlag, cmd.EscapeStyleFlag,
+		cmd.JsonQueryFlag, cmd.EncodingFlag, cmd.ExportEncodingFlag, cmd.WriteBOMFlag, cmd.ReplaceUnencodableFlag, cmd.FormatFlag, cmd.ExportDelimiterFlag, cmd.ExportDelimiterPositionsFlag,
+		cmd.LineBreakFlag, cmd.JsonEscapeFlag, cmd.NoHeaderFlag, cmd.WithoutNullFlag,
+		cmd.ColumnTypesFlag, cmd.StrictColumnTypesFlag, cmd.StrictGlobHeaderFlag, cmd.NoInferFlag,
+		cmd.SkipLinesFlag, cmd.CommentPrefixFlag, cmd.SkipAllCommentsFlag, cmd.TrimFlag, cmd.DetectFlag, cmd.RaggedFlag, cmd.TeeFlag, cmd.WithoutHeaderFlag,
+		cmd.TypedHeaderFlag, cmd.EncloseAllFlag, cmd.QuoteModeFlag, cmd.ExcelSafeFlag, cmd.ExcelSafePrefixFlag, cmd.PrettyPrintFlag, cmd.JsonKeyFlag, cmd.JsonKeyLastFlag, cmd.JsonFlatFlag, cmd.NullTextFlag, cmd.HtmlClassFlag, cmd.XmlAttributesFlag,
+		cmd.MaxColumnWidthFlag, cmd.WrapTextFlag, cmd.BoxDrawingFlag, cmd.TableNameFlag, cmd.InsertBatchSizeFlag, cmd.IdentifierQuoteFlag, cmd.TemplateFlag,
+		cmd.ParquetCompressionFlag, cmd.ParquetAllStringsFlag,
+		cmd.CompressionFlag, cmd.CompressionLevelFlag,
+		cmd.StripEndingLineBreakFlag, cmd.EastAsianEncodingFlag, cmd.CountDiacriticalSignFlag, cmd.CountFormatCodeFlag, cmd.ColorFlag,
+		cmd.QuietFlag, cmd.StatsFlag, cmd.NowFlag,
+		cmd.WaitTimeoutFlag, cmd.HttpTimeoutFlag, cmd.HttpMaxSizeFlag, cmd.HttpHeadersFlag,
 		cmd.LimitRecursion, cmd.CPUFlag:
 
 		return NewAddFlagNotSupportedNameError(expr)
@@ -313,14 +369,19 @@ func RemoveFlagElement(ctx context.Context, scope *ReferenceScope, expr parser.R
 		} else {
 			return NewInvalidFlagValueToBeRemovedError(expr)
 		}
-	case cmd.RepositoryFlag, cmd.TimezoneFlag, cmd.AnsiQuotesFlag,
-		cmd.ImportFormatFlag, cmd.DelimiterFlag, cmd.DelimiterPositionsFlag, cmd.JsonQueryFlag, cmd.EncodingFlag,
-		cmd.ExportEncodingFlag, cmd.FormatFlag, cmd.ExportDelimiterFlag, cmd.ExportDelimiterPositionsFlag,
-		cmd.LineBreakFlag, cmd.JsonEscapeFlag, cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.WithoutHeaderFlag,
-		cmd.EncloseAllFlag, cmd.PrettyPrintFlag, cmd.StripEndingLineBreakFlag,
-		cmd.EastAsianEncodingFlag, cmd.CountDiacriticalSignFlag, cmd.CountFormatCodeFlag, cmd.ColorFlag,
-		cmd.QuietFlag, cmd.StatsFlag,
-		cmd.WaitTimeoutFlag,
+	case cmd.RepositoryFlag, cmd.TimezoneFlag, cmd.AnsiQuotesFlag, cmd.NullsOrderFlag, cmd.StableSortFlag, cmd.ConcatNullAsEmptyFlag, cmd.AppendCommitFlag, cmd.AtomicWriteFlag, cmd.DryRunFlag, cmd.IgnoreConstraintsFlag,
+		cmd.ImportFormatFlag, cmd.DelimiterFlag, cmd.DelimiterRegexFlag, cmd.DelimiterPositionsFlag, cmd.QuoteCharFlag, cmd.EscapeStyleFlag,
+		cmd.JsonQueryFlag, cmd.EncodingFlag, cmd.ExportEncodingFlag, cmd.WriteBOMFlag, cmd.ReplaceUnencodableFlag, cmd.FormatFlag, cmd.ExportDelimiterFlag, cmd.ExportDelimiterPositionsFlag,
+		cmd.LineBreakFlag, cmd.JsonEscapeFlag, cmd.NoHeaderFlag, cmd.WithoutNullFlag,
+		cmd.ColumnTypesFlag, cmd.StrictColumnTypesFlag, cmd.StrictGlobHeaderFlag, cmd.NoInferFlag,
+		cmd.SkipLinesFlag, cmd.CommentPrefixFlag, cmd.SkipAllCommentsFlag, cmd.TrimFlag, cmd.DetectFlag, cmd.RaggedFlag, cmd.TeeFlag, cmd.WithoutHeaderFlag,
+		cmd.TypedHeaderFlag, cmd.EncloseAllFlag, cmd.QuoteModeFlag, cmd.ExcelSafeFlag, cmd.ExcelSafePrefixFlag, cmd.PrettyPrintFlag, cmd.JsonKeyFlag, cmd.JsonKeyLastFlag, cmd.JsonFlatFlag, cmd.NullTextFlag, cmd.HtmlClassFlag, cmd.XmlAttributesFlag,
+		cmd.MaxColumnWidthFlag, cmd.WrapTextFlag, cmd.BoxDrawingFlag, cmd.TableNameFlag, cmd.InsertBatchSizeFlag, cmd.IdentifierQuoteFlag, cmd.TemplateFlag,
+		cmd.ParquetCompressionFlag, cmd.ParquetAllStringsFlag,
+		cmd.CompressionFlag, cmd.CompressionLevelFlag,
+		cmd.StripEndingLineBreakFlag, cmd.EastAsianEncodingFlag, cmd.CountDiacriticalSignFlag, cmd.CountFormatCodeFlag, cmd.ColorFlag,
+		cmd.QuietFlag, cmd.StatsFlag, cmd.NowFlag,
+		cmd.WaitTimeoutFlag, cmd.HttpTimeoutFlag, cmd.HttpMaxSizeFlag, cmd.HttpHeadersFlag,
 		cmd.LimitRecursion, cmd.CPUFlag:
 
 		return NewRemoveFlagNotSupportedNameError(expr)
@@ -331,6 +392,274 @@ func RemoveFlagElement(ctx context.Context, scope *ReferenceScope, expr parser.R
 	return nil
 }
 
+func ShowSettingList(tx *Transaction) *View {
+	recordSet := make(RecordSet, 0, len(cmd.FlagList))
+	for _, flag := range cmd.FlagList {
+		val, _ := tx.GetFlag(flag)
+		recordSet = append(recordSet, NewRecord([]value.Primary{
+			value.NewString(cmd.FlagSymbol(flag)),
+			val,
+		}))
+	}
+
+	return &View{
+		Header:    NewHeader("", []string{"name", "value"}),
+		RecordSet: recordSet,
+	}
+}
+
+// IsShowObjectTable returns true if the object type of the SHOW statement is
+// printed as a result set.
+func IsShowObjectTable(expr parser.ShowObjects) bool {
+	switch strings.ToUpper(expr.Type.Literal) {
+	case ShowTables, ShowViews, ShowCursors, ShowFunctions, ShowVariables, ShowSettings:
+		return true
+	}
+	return false
+}
+
+func ShowObjectTable(scope *ReferenceScope, expr parser.ShowObjects) (*View, error) {
+	switch strings.ToUpper(expr.Type.Literal) {
+	case ShowTables:
+		return showTableList(scope, expr)
+	case ShowViews:
+		return showViewList(scope), nil
+	case ShowCursors:
+		return showCursorList(scope), nil
+	case ShowFunctions:
+		return showFunctionList(scope), nil
+	case ShowVariables:
+		return showVariableList(scope), nil
+	case ShowSettings:
+		return ShowSettingList(scope.Tx), nil
+	}
+	return nil, NewShowInvalidObjectTypeError(expr, expr.Type.String())
+}
+
+func showTableList(scope *ReferenceScope, expr parser.ShowObjects) (*View, error) {
+	files, err := ListTableFiles(scope.Tx.Flags.Repository)
+	if err != nil {
+		return nil, NewIOError(expr.Type, err.Error())
+	}
+
+	keys := scope.Tx.cachedViews.SortedKeys()
+	createdFiles, updatedFiles := scope.Tx.uncommittedViews.UncommittedFiles()
+	loaded := make(map[string]bool, len(keys))
+
+	recordSet := make(RecordSet, 0, len(keys)+len(files))
+	for _, key := range keys {
+		if view, ok := scope.Tx.cachedViews.Load(key); ok {
+			info := view.FileInfo
+			ufpath := strings.ToUpper(info.Path)
+			loaded[ufpath] = true
+
+			status := "LOADED"
+			if _, ok := createdFiles[ufpath]; ok {
+				status = "CREATED"
+			} else if _, ok := updatedFiles[ufpath]; ok {
+				status = "UPDATED"
+			} else if info.ForUpdate {
+				status = "LOCKED"
+			}
+
+			var header value.Primary = value.NewBoolean(!info.NoHeader)
+			var lineBreak value.Primary = value.NewString(info.LineBreak.String())
+			switch info.Format {
+			case cmd.JSON, cmd.NDJSON, cmd.LTSV:
+				header = value.NewNull()
+			case cmd.FIXED:
+				if info.SingleLine {
+					header = value.NewNull()
+					lineBreak = value.NewNull()
+				}
+			}
+
+			recordSet = append(recordSet, NewRecord([]value.Primary{
+				value.NewString(info.Path),
+				value.NewString(info.Format.String()),
+				value.NewString(info.Encoding.String()),
+				lineBreak,
+				header,
+				value.NewString(joinFieldNames(view.Header.TableColumnNames())),
+				value.NewInteger(int64(view.RecordLen())),
+				value.NewString(status),
+			}))
+		}
+	}
+
+	dir := scope.Tx.Flags.Repository
+	if len(dir) < 1 {
+		dir, _ = os.Getwd()
+	}
+	for _, f := range files {
+		fpath := filepath.Join(dir, f)
+		if loaded[strings.ToUpper(fpath)] {
+			continue
+		}
+
+		recordSet = append(recordSet, NewRecord([]value.Primary{
+			value.NewString(fpath),
+			value.NewString(detectFormat(f, scope.Tx.Flags.ImportOptions.Format).String()),
+			value.NewNull(),
+			value.NewNull(),
+			value.NewNull(),
+			value.NewNull(),
+			value.NewNull(),
+			value.NewString("NOT LOADED"),
+		}))
+	}
+
+	return &View{
+		Header:    NewHeader("", []string{"path", "format", "encoding", "line_break", "header", "fields", "rows", "status"}),
+		RecordSet: recordSet,
+	}, nil
+}
+
+func showViewList(scope *ReferenceScope) *View {
+	views := scope.AllTemporaryTables()
+	keys := views.SortedKeys()
+	updatedViews := scope.Tx.uncommittedViews.UncommittedTempViews()
+
+	recordSet := make(RecordSet, 0, len(keys))
+	for _, key := range keys {
+		if view, ok := views.Load(key); ok {
+			var status value.Primary = value.NewNull()
+			if _, ok := updatedViews[strings.ToUpper(view.FileInfo.Path)]; ok {
+				status = value.NewString("UPDATED")
+			}
+
+			recordSet = append(recordSet, NewRecord([]value.Primary{
+				value.NewString(view.FileInfo.Path),
+				value.NewString(joinFieldNames(view.Header.TableColumnNames())),
+				value.NewInteger(int64(view.RecordLen())),
+				status,
+			}))
+		}
+	}
+
+	return &View{
+		Header:    NewHeader("", []string{"name", "fields", "rows", "status"}),
+		RecordSet: recordSet,
+	}
+}
+
+func showCursorList(scope *ReferenceScope) *View {
+	cursors := scope.AllCursors()
+	keys := cursors.SortedKeys()
+
+	recordSet := make(RecordSet, 0, len(keys))
+	for _, key := range keys {
+		if cur, ok := cursors.Load(key); ok {
+			status := "CLOSED"
+			var rows value.Primary = value.NewNull()
+			var position value.Primary = value.NewNull()
+			var inRange value.Primary = value.NewNull()
+
+			if cur.IsOpen() == ternary.TRUE {
+				status = "OPEN"
+
+				nor, _ := cur.Count()
+				rows = value.NewInteger(int64(nor))
+
+				r, _ := cur.IsInRange()
+				inRange = value.NewTernary(r)
+				if r == ternary.TRUE {
+					p, _ := cur.Pointer()
+					position = value.NewInteger(int64(p))
+				}
+			}
+
+			var query value.Primary
+			if cur.query.SelectEntity != nil {
+				query = value.NewString(cur.query.String())
+			} else {
+				query = value.NewString(cur.statement.String())
+			}
+
+			recordSet = append(recordSet, NewRecord([]value.Primary{
+				value.NewString(cur.name),
+				value.NewString(status),
+				rows,
+				position,
+				inRange,
+				query,
+			}))
+		}
+	}
+
+	return &View{
+		Header:    NewHeader("", []string{"name", "status", "rows", "position", "in_range", "query"}),
+		RecordSet: recordSet,
+	}
+}
+
+func showFunctionList(scope *ReferenceScope) *View {
+	scalars, aggs := scope.AllFunctions()
+
+	recordSet := make(RecordSet, 0, scalars.Len()+aggs.Len())
+	for _, funcs := range []UserDefinedFunctionMap{scalars, aggs} {
+		for _, key := range funcs.SortedKeys() {
+			if fn, ok := funcs.Load(key); ok {
+				funcType := "SCALAR"
+				var cursor value.Primary = value.NewNull()
+				if fn.IsAggregate {
+					funcType = "AGGREGATE"
+					cursor = value.NewString(fn.Cursor.String())
+				}
+
+				params := make([]string, 0, len(fn.Parameters))
+				for _, p := range fn.Parameters {
+					if def, ok := fn.Defaults[p.Name]; ok {
+						params = append(params, p.String()+" = "+def.String())
+					} else {
+						params = append(params, p.String())
+					}
+				}
+
+				recordSet = append(recordSet, NewRecord([]value.Primary{
+					value.NewString(fn.Name.String()),
+					value.NewString(funcType),
+					cursor,
+					value.NewString(strings.Join(params, ", ")),
+				}))
+			}
+		}
+	}
+
+	return &View{
+		Header:    NewHeader("", []string{"name", "type", "cursor", "parameters"}),
+		RecordSet: recordSet,
+	}
+}
+
+func showVariableList(scope *ReferenceScope) *View {
+	vars := scope.AllVariables()
+	keys := vars.SortedKeys()
+
+	recordSet := make(RecordSet, 0, len(keys))
+	for _, key := range keys {
+		if val, ok := vars.Load(key); ok {
+			recordSet = append(recordSet, NewRecord([]value.Primary{
+				value.NewString(parser.Variable{Name: key}.String()),
+				val,
+			}))
+		}
+	}
+
+	return &View{
+		Header:    NewHeader("", []string{"name", "value"}),
+		RecordSet: recordSet,
+	}
+}
+
+func joinFieldNames(fields []string) string {
+	escaped := make([]string, 0, len(fields))
+	for _, f := range fields {
+		escaped = append(escaped, cmd.EscapeIdentifier(f))
+	}
+	return strings.Join(escaped, ", ")
+}
+
 func ShowFlag(tx *Transaction, expr parser.ShowFlag) (string, error) {
 	s, ok := showFlag(tx, expr.Flag.Name)
 	if !ok {
@@ -364,20 +693,66 @@ func showFlag(tx *Transaction, flagName string) (string, bool) {
 		} else {
 			s = tx.Palette.Render(cmd.StringEffect, p.Raw())
 		}
-	case cmd.JsonQueryFlag:
+	case cmd.JsonQueryFlag, cmd.HttpHeadersFlag:
 		p := val.(*value.String)
 		if len(p.Raw()) < 1 {
 			s = tx.Palette.Render(cmd.NullEffect, "(empty)")
 		} else {
 			s = tx.Palette.Render(cmd.StringEffect, p.Raw())
 		}
+	case cmd.DelimiterRegexFlag:
+		p := val.(*value.String)
+		if len(p.Raw()) < 1 {
+			s = tx.Palette.Render(cmd.NullEffect, "(not set)")
+		} else {
+			s = tx.Palette.Render(cmd.StringEffect, p.Raw())
+		}
+	case cmd.CommentPrefixFlag:
+		p := val.(*value.String)
+		if len(p.Raw()) < 1 {
+			s = tx.Palette.Render(cmd.NullEffect, "(not set)")
+		} else {
+			s = tx.Palette.Render(cmd.StringEffect, p.Raw())
+		}
+	case cmd.TeeFlag:
+		p := val.(*value.String)
+		if len(p.Raw()) < 1 {
+			s = tx.Palette.Render(cmd.NullEffect, "(not set)")
+		} else {
+			s = tx.Palette.Render(cmd.StringEffect, p.Raw())
+		}
+	case cmd.ColumnTypesFlag:
+		p := val.(*value.String)
+		if len(p.Raw()) < 1 {
+			s = tx.Palette.Render(cmd.NullEffect, "(not set)")
+		} else {
+			s = tx.Palette.Render(cmd.StringEffect, p.Raw())
+		}
 	case cmd.ExportEncodingFlag:
 		switch tx.Flags.ExportOptions.Format {
-		case cmd.JSON:
+		case cmd.JSON, cmd.NDJSON:
 			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.String).Raw())
 		default:
 			s = tx.Palette.Render(cmd.StringEffect, val.(*value.String).Raw())
 		}
+	case cmd.WriteBOMFlag:
+		switch {
+		case tx.Flags.ExportOptions.Format == cmd.JSON || tx.Flags.ExportOptions.Format == cmd.NDJSON:
+			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.Boolean).String())
+		case encodingWithBOM(tx.Flags.ExportOptions.Encoding) == tx.Flags.ExportOptions.Encoding:
+			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.Boolean).String())
+		default:
+			s = tx.Palette.Render(cmd.BooleanEffect, val.(*value.Boolean).String())
+		}
+	case cmd.ReplaceUnencodableFlag:
+		switch {
+		case tx.Flags.ExportOptions.Format == cmd.JSON || tx.Flags.ExportOptions.Format == cmd.NDJSON:
+			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.Boolean).String())
+		case tx.Flags.ExportOptions.Encoding != text.SJIS && tx.Flags.ExportOptions.Encoding != cmd.EUCJP:
+			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.Boolean).String())
+		default:
+			s = tx.Palette.Render(cmd.BooleanEffect, val.(*value.Boolean).String())
+		}
 	case cmd.ExportDelimiterFlag:
 		switch tx.Flags.ExportOptions.Format {
 		case cmd.CSV:
@@ -394,7 +769,7 @@ func showFlag(tx *Transaction, flagName string) (string, bool) {
 		}
 	case cmd.WithoutHeaderFlag:
 		switch tx.Flags.ExportOptions.Format {
-		case cmd.CSV, cmd.TSV, cmd.FIXED, cmd.GFM, cmd.ORG:
+		case cmd.CSV, cmd.TSV, cmd.FIXED, cmd.GFM, cmd.ORG, cmd.HTML, cmd.SQL_INSERT:
 			if tx.Flags.ExportOptions.Format == cmd.FIXED && tx.Flags.ExportOptions.SingleLine {
 				s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.Boolean).String())
 			} else {
@@ -403,6 +778,17 @@ func showFlag(tx *Transaction, flagName string) (string, bool) {
 		default:
 			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.Boolean).String())
 		}
+	case cmd.TypedHeaderFlag:
+		switch tx.Flags.ExportOptions.Format {
+		case cmd.CSV, cmd.TSV, cmd.FIXED, cmd.GFM, cmd.ORG, cmd.TEXT, cmd.HTML:
+			if tx.Flags.ExportOptions.WithoutHeader || (tx.Flags.ExportOptions.Format == cmd.FIXED && tx.Flags.ExportOptions.SingleLine) {
+				s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.Boolean).String())
+			} else {
+				s = tx.Palette.Render(cmd.BooleanEffect, val.(*value.Boolean).String())
+			}
+		default:
+			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.Boolean).String())
+		}
 	case cmd.LineBreakFlag:
 		if tx.Flags.ExportOptions.Format == cmd.FIXED && tx.Flags.ExportOptions.SingleLine {
 			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.String).Raw())
@@ -416,9 +802,43 @@ func showFlag(tx *Transaction, flagName string) (string, bool) {
 		default:
 			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.Boolean).String())
 		}
+	case cmd.QuoteModeFlag:
+		switch tx.Flags.ExportOptions.Format {
+		case cmd.CSV, cmd.TSV:
+			s = tx.Palette.Render(cmd.StringEffect, val.(*value.String).Raw())
+		default:
+			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.String).Raw())
+		}
+	case cmd.ExcelSafeFlag:
+		switch tx.Flags.ExportOptions.Format {
+		case cmd.CSV, cmd.TSV:
+			s = tx.Palette.Render(cmd.BooleanEffect, val.(*value.Boolean).String())
+		default:
+			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.Boolean).String())
+		}
+	case cmd.ExcelSafePrefixFlag:
+		switch tx.Flags.ExportOptions.Format {
+		case cmd.CSV, cmd.TSV:
+			if tx.Flags.ExportOptions.ExcelSafe {
+				s = tx.Palette.Render(cmd.StringEffect, val.(*value.String).Raw())
+			} else {
+				s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.String).Raw())
+			}
+		default:
+			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.String).Raw())
+		}
+	case cmd.QuoteCharFlag:
+		p := val.(*value.String)
+		if len(p.Raw()) < 1 {
+			s = tx.Palette.Render(cmd.NullEffect, "(none)")
+		} else {
+			s = tx.Palette.Render(cmd.StringEffect, p.String())
+		}
+	case cmd.EscapeStyleFlag:
+		s = tx.Palette.Render(cmd.StringEffect, val.(*value.String).Raw())
 	case cmd.JsonEscapeFlag:
 		switch tx.Flags.ExportOptions.Format {
-		case cmd.JSON:
+		case cmd.JSON, cmd.NDJSON:
 			s = tx.Palette.Render(cmd.StringEffect, val.(*value.String).Raw())
 		default:
 			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.String).Raw())
@@ -430,9 +850,157 @@ func showFlag(tx *Transaction, flagName string) (string, bool) {
 		default:
 			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.Boolean).String())
 		}
+	case cmd.JsonFlatFlag:
+		switch tx.Flags.ExportOptions.Format {
+		case cmd.JSON, cmd.NDJSON:
+			s = tx.Palette.Render(cmd.BooleanEffect, val.(*value.Boolean).String())
+		default:
+			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.Boolean).String())
+		}
+	case cmd.JsonKeyFlag:
+		p := val.(*value.String)
+		switch tx.Flags.ExportOptions.Format {
+		case cmd.JSON:
+			if len(p.Raw()) < 1 {
+				s = tx.Palette.Render(cmd.NullEffect, "(not set)")
+			} else {
+				s = tx.Palette.Render(cmd.StringEffect, p.Raw())
+			}
+		default:
+			if len(p.Raw()) < 1 {
+				s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+"(not set)")
+			} else {
+				s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+p.Raw())
+			}
+		}
+	case cmd.JsonKeyLastFlag:
+		if tx.Flags.ExportOptions.Format == cmd.JSON && 0 < len(tx.Flags.ExportOptions.JsonKey) {
+			s = tx.Palette.Render(cmd.BooleanEffect, val.(*value.Boolean).String())
+		} else {
+			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.Boolean).String())
+		}
+	case cmd.NullTextFlag:
+		p := val.(*value.String)
+		switch tx.Flags.ExportOptions.Format {
+		case cmd.GFM, cmd.ORG:
+			if len(p.Raw()) < 1 {
+				s = tx.Palette.Render(cmd.NullEffect, "(empty)")
+			} else {
+				s = tx.Palette.Render(cmd.StringEffect, p.Raw())
+			}
+		default:
+			if len(p.Raw()) < 1 {
+				s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+"(empty)")
+			} else {
+				s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+p.Raw())
+			}
+		}
+	case cmd.HtmlClassFlag:
+		p := val.(*value.String)
+		switch tx.Flags.ExportOptions.Format {
+		case cmd.HTML:
+			if len(p.Raw()) < 1 {
+				s = tx.Palette.Render(cmd.NullEffect, "(not set)")
+			} else {
+				s = tx.Palette.Render(cmd.StringEffect, p.Raw())
+			}
+		default:
+			if len(p.Raw()) < 1 {
+				s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+"(not set)")
+			} else {
+				s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+p.Raw())
+			}
+		}
+	case cmd.XmlAttributesFlag:
+		switch tx.Flags.ExportOptions.Format {
+		case cmd.XML:
+			s = tx.Palette.Render(cmd.BooleanEffect, val.(*value.Boolean).String())
+		default:
+			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.Boolean).String())
+		}
+	case cmd.MaxColumnWidthFlag:
+		p := val.(*value.Integer)
+		switch {
+		case tx.Flags.ExportOptions.Format != cmd.TEXT && p.Raw() < 1:
+			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+"(no limit)")
+		case tx.Flags.ExportOptions.Format != cmd.TEXT:
+			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+p.String())
+		case p.Raw() < 1:
+			s = tx.Palette.Render(cmd.NullEffect, "(no limit)")
+		default:
+			s = tx.Palette.Render(cmd.NumberEffect, p.String())
+		}
+	case cmd.WrapTextFlag:
+		if tx.Flags.ExportOptions.Format == cmd.TEXT && 0 < tx.Flags.ExportOptions.MaxColumnWidth {
+			s = tx.Palette.Render(cmd.BooleanEffect, val.(*value.Boolean).String())
+		} else {
+			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.Boolean).String())
+		}
+	case cmd.BoxDrawingFlag:
+		if tx.Flags.ExportOptions.Format == cmd.TEXT {
+			s = tx.Palette.Render(cmd.BooleanEffect, val.(*value.Boolean).String())
+		} else {
+			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.Boolean).String())
+		}
+	case cmd.TableNameFlag:
+		p := val.(*value.String)
+		switch tx.Flags.ExportOptions.Format {
+		case cmd.SQL_INSERT:
+			if len(p.Raw()) < 1 {
+				s = tx.Palette.Render(cmd.NullEffect, "(not set)")
+			} else {
+				s = tx.Palette.Render(cmd.StringEffect, p.Raw())
+			}
+		default:
+			if len(p.Raw()) < 1 {
+				s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+"(not set)")
+			} else {
+				s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+p.Raw())
+			}
+		}
+	case cmd.InsertBatchSizeFlag:
+		if tx.Flags.ExportOptions.Format == cmd.SQL_INSERT {
+			s = tx.Palette.Render(cmd.NumberEffect, val.(*value.Integer).String())
+		} else {
+			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.Integer).String())
+		}
+	case cmd.TemplateFlag:
+		p := val.(*value.String)
+		switch tx.Flags.ExportOptions.Format {
+		case cmd.TEMPLATE:
+			if len(p.Raw()) < 1 {
+				s = tx.Palette.Render(cmd.NullEffect, "(not set)")
+			} else {
+				s = tx.Palette.Render(cmd.StringEffect, p.Raw())
+			}
+		default:
+			if len(p.Raw()) < 1 {
+				s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+"(not set)")
+			} else {
+				s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+p.Raw())
+			}
+		}
+	case cmd.IdentifierQuoteFlag:
+		if tx.Flags.ExportOptions.Format == cmd.SQL_INSERT {
+			s = tx.Palette.Render(cmd.StringEffect, val.(*value.String).Raw())
+		} else {
+			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.String).Raw())
+		}
+	case cmd.ParquetCompressionFlag:
+		if tx.Flags.ExportOptions.Format == cmd.PARQUET {
+			s = tx.Palette.Render(cmd.StringEffect, val.(*value.String).Raw())
+		} else {
+			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.String).Raw())
+		}
+	case cmd.ParquetAllStringsFlag:
+		if tx.Flags.ExportOptions.Format == cmd.PARQUET {
+			s = tx.Palette.Render(cmd.BooleanEffect, val.(*value.Boolean).String())
+		} else {
+			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.Boolean).String())
+		}
 	case cmd.EastAsianEncodingFlag, cmd.CountDiacriticalSignFlag, cmd.CountFormatCodeFlag:
 		switch tx.Flags.ExportOptions.Format {
-		case cmd.GFM, cmd.ORG, cmd.TEXT:
+		case cmd.GFM, cmd.ORG, cmd.TEXT, cmd.VERTICAL:
 			s = tx.Palette.Render(cmd.BooleanEffect, val.(*value.Boolean).String())
 		default:
 			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.Boolean).String())
@@ -441,6 +1009,14 @@ func showFlag(tx *Transaction, flagName string) (string, bool) {
 		s = tx.Palette.Render(cmd.StringEffect, val.(*value.String).String())
 	case cmd.TimezoneFlag, cmd.ImportFormatFlag, cmd.DelimiterPositionsFlag, cmd.EncodingFlag, cmd.FormatFlag:
 		s = tx.Palette.Render(cmd.StringEffect, val.(*value.String).Raw())
+	case cmd.CompressionFlag, cmd.NullsOrderFlag, cmd.RaggedFlag:
+		s = tx.Palette.Render(cmd.StringEffect, val.(*value.String).Raw())
+	case cmd.CompressionLevelFlag:
+		if tx.Flags.ExportOptions.Compression == cmd.NoCompression {
+			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.Integer).String())
+		} else {
+			s = tx.Palette.Render(cmd.NumberEffect, val.(*value.Integer).String())
+		}
 	case cmd.LimitRecursion:
 		p := val.(*value.Integer)
 		if p.Raw() < 0 {
@@ -448,12 +1024,25 @@ func showFlag(tx *Transaction, flagName string) (string, bool) {
 		} else {
 			s = tx.Palette.Render(cmd.NumberEffect, p.String())
 		}
-	case cmd.CPUFlag:
+	case cmd.NowFlag:
+		if value.IsNull(val) {
+			s = tx.Palette.Render(cmd.NullEffect, "(current time)")
+		} else {
+			s = tx.Palette.Render(cmd.DatetimeEffect, val.(*value.Datetime).Format(time.RFC3339Nano))
+		}
+	case cmd.HttpMaxSizeFlag:
+		p := val.(*value.Integer)
+		if p.Raw() < 1 {
+			s = tx.Palette.Render(cmd.NullEffect, "(no limit)")
+		} else {
+			s = tx.Palette.Render(cmd.NumberEffect, p.String())
+		}
+	case cmd.SkipLinesFlag, cmd.CPUFlag:
 		s = tx.Palette.Render(cmd.NumberEffect, val.(*value.Integer).String())
-	case cmd.WaitTimeoutFlag:
+	case cmd.WaitTimeoutFlag, cmd.HttpTimeoutFlag:
 		s = tx.Palette.Render(cmd.NumberEffect, val.(*value.Float).String())
-	case cmd.AnsiQuotesFlag, cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.StripEndingLineBreakFlag,
-		cmd.ColorFlag, cmd.QuietFlag, cmd.StatsFlag:
+	case cmd.AnsiQuotesFlag, cmd.StableSortFlag, cmd.ConcatNullAsEmptyFlag, cmd.AppendCommitFlag, cmd.AtomicWriteFlag, cmd.DryRunFlag, cmd.IgnoreConstraintsFlag, cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.StrictColumnTypesFlag, cmd.StrictGlobHeaderFlag, cmd.NoInferFlag,
+		cmd.SkipAllCommentsFlag, cmd.TrimFlag, cmd.DetectFlag, cmd.StripEndingLineBreakFlag, cmd.ColorFlag, cmd.QuietFlag, cmd.StatsFlag:
 		s = tx.Palette.Render(cmd.BooleanEffect, val.(*value.Boolean).String())
 	}
 
@@ -466,155 +1055,6 @@ func ShowObjects(scope *ReferenceScope, expr parser.ShowObjects) (string, error)
 	w := NewObjectWriter(scope.Tx)
 
 	switch strings.ToUpper(expr.Type.Literal) {
-	case ShowTables:
-		keys := scope.Tx.cachedViews.SortedKeys()
-
-		if len(keys) < 1 {
-			s = scope.Tx.Warn("No table is loaded")
-		} else {
-			createdFiles, updatedFiles := scope.Tx.uncommittedViews.UncommittedFiles()
-
-			for _, key := range keys {
-				if view, ok := scope.Tx.cachedViews.Load(key); ok {
-					fields := view.Header.TableColumnNames()
-					info := view.FileInfo
-					ufpath := strings.ToUpper(info.Path)
-
-					if _, ok := createdFiles[ufpath]; ok {
-						w.WriteColor("*Created* ", cmd.EmphasisEffect)
-					} else if _, ok := updatedFiles[ufpath]; ok {
-						w.WriteColor("*Updated* ", cmd.EmphasisEffect)
-					}
-					w.WriteColorWithoutLineBreak(info.Path, cmd.ObjectEffect)
-					writeFields(w, fields)
-
-					w.NewLine()
-					writeTableAttribute(w, scope.Tx.Flags, info)
-					w.ClearBlock()
-					w.NewLine()
-				}
-			}
-
-			uncommitted := len(createdFiles) + len(updatedFiles)
-
-			w.Title1 = "Loaded Tables"
-			if 0 < uncommitted {
-				w.Title2 = fmt.Sprintf("(Uncommitted: %s)", FormatCount(uncommitted, "Table"))
-				w.Title2Effect = cmd.EmphasisEffect
-			}
-			s = "\n" + w.String() + "\n"
-		}
-	case ShowViews:
-		views := scope.AllTemporaryTables()
-
-		if views.Len() < 1 {
-			s = scope.Tx.Warn("No view is declared")
-		} else {
-			keys := views.SortedKeys()
-
-			updatedViews := scope.Tx.uncommittedViews.UncommittedTempViews()
-
-			for _, key := range keys {
-				if view, ok := views.Load(key); ok {
-					fields := view.Header.TableColumnNames()
-					info := view.FileInfo
-					ufpath := strings.ToUpper(info.Path)
-
-					if _, ok := updatedViews[ufpath]; ok {
-						w.WriteColor("*Updated* ", cmd.EmphasisEffect)
-					}
-					w.WriteColorWithoutLineBreak(info.Path, cmd.ObjectEffect)
-					writeFields(w, fields)
-					w.ClearBlock()
-					w.NewLine()
-				}
-			}
-
-			uncommitted := len(updatedViews)
-
-			w.Title1 = "Views"
-			if 0 < uncommitted {
-				w.Title2 = fmt.Sprintf("(Uncommitted: %s)", FormatCount(uncommitted, "View"))
-				w.Title2Effect = cmd.EmphasisEffect
-			}
-			s = "\n" + w.String() + "\n"
-		}
-	case ShowCursors:
-		cursors := scope.AllCursors()
-		if cursors.Len() < 1 {
-			s = scope.Tx.Warn("No cursor is declared")
-		} else {
-			keys := cursors.SortedKeys()
-
-			for _, key := range keys {
-				if cur, ok := cursors.Load(key); ok {
-					isOpen := cur.IsOpen()
-
-					w.WriteColor(cur.name, cmd.ObjectEffect)
-					w.BeginBlock()
-
-					w.NewLine()
-					w.WriteColorWithoutLineBreak("Status: ", cmd.LableEffect)
-					if isOpen == ternary.TRUE {
-						nor, _ := cur.Count()
-						inRange, _ := cur.IsInRange()
-						position, _ := cur.Pointer()
-
-						norStr := cmd.FormatInt(nor, ",")
-
-						w.WriteColorWithoutLineBreak("Open", cmd.TernaryEffect)
-						w.WriteColorWithoutLineBreak("    Number of Rows: ", cmd.LableEffect)
-						w.WriteColorWithoutLineBreak(norStr, cmd.NumberEffect)
-						w.WriteSpaces(10 - len(norStr))
-						w.WriteColorWithoutLineBreak("Pointer: ", cmd.LableEffect)
-						switch inRange {
-						case ternary.FALSE:
-							w.WriteColorWithoutLineBreak("Out of Range", cmd.TernaryEffect)
-						case ternary.UNKNOWN:
-							w.WriteColorWithoutLineBreak(inRange.String(), cmd.TernaryEffect)
-						default:
-							w.WriteColorWithoutLineBreak(cmd.FormatInt(position, ","), cmd.NumberEffect)
-						}
-					} else {
-						w.WriteColorWithoutLineBreak("Closed", cmd.TernaryEffect)
-					}
-
-					w.NewLine()
-					if cur.query.SelectEntity != nil {
-						w.WriteColor("Query:", cmd.LableEffect)
-						writeQuery(w, cur.query.String())
-					} else {
-						w.WriteColorWithoutLineBreak("Statement: ", cmd.LableEffect)
-						w.WriteColorWithoutLineBreak(cur.statement.String(), cmd.IdentifierEffect)
-					}
-
-					w.ClearBlock()
-					w.NewLine()
-				}
-			}
-			w.Title1 = "Cursors"
-			s = "\n" + w.String() + "\n"
-		}
-	case ShowFunctions:
-		scalars, aggs := scope.AllFunctions()
-		if scalars.Len() < 1 && aggs.Len() < 1 {
-			s = scope.Tx.Warn("No function is declared")
-		} else {
-			if 0 < scalars.Len() {
-				w.Clear()
-				writeFunctions(w, scalars)
-				w.Title1 = "Scalar Functions"
-				s += "\n" + w.String()
-			}
-			if 0 < aggs.Len() {
-				w.Clear()
-				writeFunctions(w, aggs)
-				w.Title1 = "Aggregate Functions"
-				s += "\n" + w.String() + "\n"
-			} else {
-				s += "\n"
-			}
-		}
 	case ShowStatements:
 		if scope.Tx.PreparedStatements.Len() < 1 {
 			s = scope.Tx.Warn("No statement is prepared")
@@ -690,13 +1130,19 @@ func ShowObjects(scope *ReferenceScope, expr parser.ShowObjects) (string, error)
 			label := string(parser.VariableSign) + string(parser.RuntimeInformationSign) + ri
 			p, _ := GetRuntimeInformation(scope.Tx, parser.RuntimeInformation{Name: ri})
 
-			w.WriteSpaces(19 - len(label))
+			w.WriteSpaces(20 - len(label))
 			w.WriteColorWithoutLineBreak(label, cmd.LableEffect)
 			w.WriteColorWithoutLineBreak(":", cmd.LableEffect)
 			w.WriteSpaces(1)
 			switch ri {
 			case WorkingDirectory, VersionInformation:
 				w.WriteColorWithoutLineBreak(p.(*value.String).Raw(), cmd.StringEffect)
+			case LastLoadWarnings:
+				if value.IsNull(p) {
+					w.WriteColorWithoutLineBreak(p.String(), cmd.NullEffect)
+				} else {
+					w.WriteColorWithoutLineBreak(p.(*value.String).Raw(), cmd.StringEffect)
+				}
 			case UncommittedInformation:
 				w.WriteColorWithoutLineBreak(p.(*value.Boolean).String(), cmd.BooleanEffect)
 			default:
@@ -752,6 +1198,9 @@ func writeTableAttribute(w *ObjectWriter, flags *cmd.Flags, info *FileInfo) {
 		} else {
 			w.WriteColorWithoutLineBreak(info.JsonQuery, cmd.NullEffect)
 		}
+	case cmd.NDJSON:
+		w.WriteColorWithoutLineBreak("Escape: ", cmd.LableEffect)
+		w.WriteWithoutLineBreak(cmd.JsonEscapeTypeToString(info.JsonEscape))
 	}
 
 	switch info.Format {
@@ -765,7 +1214,7 @@ func writeTableAttribute(w *ObjectWriter, flags *cmd.Flags, info *FileInfo) {
 
 	w.WriteColor("Encoding: ", cmd.LableEffect)
 	switch info.Format {
-	case cmd.JSON:
+	case cmd.JSON, cmd.NDJSON:
 		w.WriteColorWithoutLineBreak(text.UTF8.String(), cmd.NullEffect)
 	default:
 		w.WriteWithoutLineBreak(info.Encoding.String())
@@ -782,7 +1231,7 @@ func writeTableAttribute(w *ObjectWriter, flags *cmd.Flags, info *FileInfo) {
 		w.WriteSpaces(6 - (cmd.TextWidth(info.LineBreak.String(), flags)))
 		w.WriteColorWithoutLineBreak("Pretty Print: ", cmd.LableEffect)
 		w.WriteWithoutLineBreak(strconv.FormatBool(info.PrettyPrint))
-	case cmd.CSV, cmd.TSV, cmd.FIXED, cmd.GFM, cmd.ORG:
+	case cmd.CSV, cmd.TSV, cmd.FIXED, cmd.GFM, cmd.ORG, cmd.HTML:
 		if !(info.Format == cmd.FIXED && info.SingleLine) {
 			w.WriteSpaces(6 - (cmd.TextWidth(info.LineBreak.String(), flags)))
 			w.WriteColorWithoutLineBreak("Header: ", cmd.LableEffect)
@@ -791,60 +1240,6 @@ func writeTableAttribute(w *ObjectWriter, flags *cmd.Flags, info *FileInfo) {
 	}
 }
 
-func writeFields(w *ObjectWriter, fields []string) {
-	w.BeginBlock()
-	w.NewLine()
-	w.WriteColor("Fields: ", cmd.LableEffect)
-	w.BeginSubBlock()
-	lastIdx := len(fields) - 1
-	for i, f := range fields {
-		escaped := cmd.EscapeIdentifier(f)
-		if i < lastIdx && !w.FitInLine(escaped+", ") {
-			w.NewLine()
-		}
-		w.WriteColor(escaped, cmd.AttributeEffect)
-		if i < lastIdx {
-			w.WriteWithoutLineBreak(", ")
-		}
-	}
-	w.EndSubBlock()
-}
-
-func writeFunctions(w *ObjectWriter, funcs UserDefinedFunctionMap) {
-	keys := funcs.SortedKeys()
-
-	for _, key := range keys {
-		if fn, ok := funcs.Load(key); ok {
-			w.WriteColor(fn.Name.String(), cmd.ObjectEffect)
-			w.WriteWithoutLineBreak(" (")
-
-			if fn.IsAggregate {
-				w.WriteColorWithoutLineBreak(fn.Cursor.String(), cmd.IdentifierEffect)
-				if 0 < len(fn.Parameters) {
-					w.WriteWithoutLineBreak(", ")
-				}
-			}
-
-			for i, p := range fn.Parameters {
-				if 0 < i {
-					w.WriteWithoutLineBreak(", ")
-				}
-				if def, ok := fn.Defaults[p.Name]; ok {
-					w.WriteColorWithoutLineBreak(p.String(), cmd.AttributeEffect)
-					w.WriteWithoutLineBreak(" = ")
-					w.WriteColorWithoutLineBreak(def.String(), cmd.ValueEffect)
-				} else {
-					w.WriteColorWithoutLineBreak(p.String(), cmd.AttributeEffect)
-				}
-			}
-
-			w.WriteWithoutLineBreak(")")
-			w.ClearBlock()
-			w.NewLine()
-		}
-	}
-}
-
 func ShowFields(ctx context.Context, scope *ReferenceScope, expr parser.ShowFields) (string, error) {
 	var tableName = func(expr parser.QueryExpression) (s string) {
 		if e, ok := expr.(parser.Identifier); ok {
@@ -924,6 +1319,103 @@ func ShowFields(ctx context.Context, scope *ReferenceScope, expr parser.ShowFiel
 	return "\n" + w.String() + "\n", nil
 }
 
+func Describe(ctx context.Context, scope *ReferenceScope, expr parser.Describe) (*View, error) {
+	queryScope := scope.CreateNode()
+	defer queryScope.CloseCurrentNode()
+
+	sampleLen := 0
+	if expr.Sample != nil {
+		p, err := Evaluate(ctx, queryScope, expr.Sample)
+		if err != nil {
+			return nil, err
+		}
+		i := value.ToInteger(p)
+		if value.IsNull(i) || i.(*value.Integer).Raw() < 1 {
+			return nil, NewInvalidSampleNumberError(expr)
+		}
+		if n := i.(*value.Integer).Raw(); n < math.MaxInt32 {
+			sampleLen = int(n)
+		}
+	}
+
+	view, err := LoadViewSample(ctx, queryScope, expr.Table, sampleLen)
+	if err != nil {
+		return nil, err
+	}
+	if sampleLen < 1 || view.RecordLen() < sampleLen {
+		sampleLen = view.RecordLen()
+	}
+
+	stats := make([]*columnStats, view.FieldLen())
+	for i := range view.Header {
+		if view.Header[i].IsFromTable {
+			stats[i] = newColumnStats(scope.Tx.Flags.DatetimeFormat)
+		}
+	}
+
+	for i := 0; i < sampleLen; i++ {
+		if i&0xff == 0 && ctx.Err() != nil {
+			return nil, ConvertContextError(ctx.Err())
+		}
+
+		for j := range stats {
+			if stats[j] != nil {
+				stats[j].add(view.RecordSet[i][j][0])
+			}
+		}
+	}
+
+	recordSet := make(RecordSet, 0, view.FieldLen())
+	for i := range stats {
+		if stats[i] != nil {
+			recordSet = append(recordSet, stats[i].Record(view.Header[i].Column, view.Header[i].Number))
+		}
+	}
+
+	return &View{
+		Header: NewHeader("", []string{
+			"column_name", "position", "type",
+			"integer", "float", "datetime", "boolean", "string", "empty", "null",
+			"min", "max", "distinct", "samples",
+		}),
+		RecordSet: recordSet,
+	}, nil
+}
+
+func inferValueType(p value.Primary, datetimeFormat []string) string {
+	switch p.(type) {
+	case *value.Null:
+		return ""
+	case *value.Integer:
+		return "integer"
+	case *value.Float:
+		return "float"
+	case *value.Boolean:
+		return "boolean"
+	case *value.Ternary:
+		return "ternary"
+	case *value.Datetime:
+		return "datetime"
+	}
+
+	s := cmd.TrimSpace(p.(*value.String).Raw())
+	switch {
+	case len(s) < 1:
+		return ""
+	case value.MaybeInteger(s):
+		return "integer"
+	case value.MaybeNumber(s):
+		return "float"
+	}
+	if _, ok := value.StrToTime(s, datetimeFormat); ok {
+		return "datetime"
+	}
+	if p.Ternary() != ternary.UNKNOWN {
+		return "boolean"
+	}
+	return "string"
+}
+
 func writeFieldList(w *ObjectWriter, fields []string) {
 	l := len(fields)
 	digits := len(strconv.Itoa(l))
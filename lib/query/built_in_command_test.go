@@ -20,7 +20,7 @@ import (
 
 	"github.com/mithrandie/go-text"
 	"github.com/mithrandie/go-text/fixedlen"
-	"github.com/mithrandie/go-text/json"
+	"github.com/mithrandie/ternary"
 )
 
 var echoTests = []struct {
@@ -409,6 +409,63 @@ var setFlagTests = []struct {
 			Value: parser.NewTernaryValueFromString("true"),
 		},
 	},
+	{
+		Name: "Set NullsOrder",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "nulls_order"},
+			Value: parser.NewStringValue("last"),
+		},
+	},
+	{
+		Name: "Set NullsOrder Value Error",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "nulls_order"},
+			Value: parser.NewStringValue("middle"),
+		},
+		Error: "nulls order must be one of DEFAULT|FIRST|LAST",
+	},
+	{
+		Name: "Set ConcatNullAsEmpty",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "concat_null_as_empty"},
+			Value: parser.NewTernaryValueFromString("true"),
+		},
+	},
+	{
+		Name: "Set AppendCommit",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "append_commit"},
+			Value: parser.NewTernaryValueFromString("true"),
+		},
+	},
+	{
+		Name: "Set AtomicWrite",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "atomic_write"},
+			Value: parser.NewTernaryValueFromString("false"),
+		},
+	},
+	{
+		Name: "Set DryRun",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "dry_run"},
+			Value: parser.NewTernaryValueFromString("true"),
+		},
+	},
+	{
+		Name: "Set IgnoreConstraints",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "ignore_constraints"},
+			Value: parser.NewTernaryValueFromString("true"),
+		},
+	},
+	{
+		Name: "Set StableSort",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "stable_sort"},
+			Value: parser.NewTernaryValueFromString("false"),
+		},
+	},
 	{
 		Name: "Set WaitTimeout",
 		Expr: parser.SetFlag{
@@ -416,6 +473,80 @@ var setFlagTests = []struct {
 			Value: parser.NewFloatValue(15),
 		},
 	},
+	{
+		Name: "Set HttpHeaders",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "http_headers"},
+			Value: parser.NewStringValue("{\"Authorization\": \"Bearer token\"}"),
+		},
+	},
+	{
+		Name: "Set HttpHeaders Value Error",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "http_headers"},
+			Value: parser.NewStringValue("Authorization: Bearer token"),
+		},
+		Error: "http headers must be a JSON object of strings",
+	},
+	{
+		Name: "Set QuoteChar",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "quote_char"},
+			Value: parser.NewStringValue("'"),
+		},
+	},
+	{
+		Name: "Set QuoteChar Value Error",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "quote_char"},
+			Value: parser.NewStringValue("ab"),
+		},
+		Error: "quote character must be one character or empty",
+	},
+	{
+		Name: "Set EscapeStyle",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "escape_style"},
+			Value: parser.NewStringValue("backslash"),
+		},
+	},
+	{
+		Name: "Set QuoteMode",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "quote_mode"},
+			Value: parser.NewStringValue("non_numeric"),
+		},
+	},
+	{
+		Name: "Set QuoteMode Value Error",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "quote_mode"},
+			Value: parser.NewStringValue("some"),
+		},
+		Error: "quote mode must be one of MINIMAL|ALL|NON_NUMERIC|NONE",
+	},
+	{
+		Name: "Set ExcelSafe",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "excel_safe"},
+			Value: parser.NewTernaryValueFromString("true"),
+		},
+	},
+	{
+		Name: "Set ExcelSafePrefix",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "excel_safe_prefix"},
+			Value: parser.NewStringValue("tab"),
+		},
+	},
+	{
+		Name: "Set ExcelSafePrefix Value Error",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "excel_safe_prefix"},
+			Value: parser.NewStringValue("some"),
+		},
+		Error: "excel safe prefix must be one of QUOTE|TAB",
+	},
 	{
 		Name: "Set Delimiter",
 		Expr: parser.SetFlag{
@@ -423,6 +554,34 @@ var setFlagTests = []struct {
 			Value: parser.NewStringValue("\\t"),
 		},
 	},
+	{
+		Name: "Set DelimiterRegex",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "delimiter_regex"},
+			Value: parser.NewStringValue("\\s*;\\s*"),
+		},
+	},
+	{
+		Name: "Set SkipLines",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "skip_lines"},
+			Value: parser.NewIntegerValue(2),
+		},
+	},
+	{
+		Name: "Set CommentPrefix",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "comment_prefix"},
+			Value: parser.NewStringValue("#"),
+		},
+	},
+	{
+		Name: "Set ColumnTypes",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "column_types"},
+			Value: parser.NewStringValue("zip:string"),
+		},
+	},
 	{
 		Name: "Set JsonQuery",
 		Expr: parser.SetFlag{
@@ -430,6 +589,36 @@ var setFlagTests = []struct {
 			Value: parser.NewStringValue("{}"),
 		},
 	},
+	{
+		Name: "Set Ragged",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "ragged"},
+			Value: parser.NewStringValue("pad"),
+		},
+	},
+	{
+		Name: "Set Ragged Value Error",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "ragged"},
+			Value: parser.NewStringValue("fill"),
+		},
+		Error: "ragged must be one of ERROR|PAD|TRUNCATE|SKIP",
+	},
+	{
+		Name: "Set Tee",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "tee"},
+			Value: parser.NewStringValue(GetTestFilePath("tee_set.txt")),
+		},
+	},
+	{
+		Name: "Set Tee Open Error",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "tee"},
+			Value: parser.NewStringValue(GetTestFilePath(filepath.Join("notexist", "tee.txt"))),
+		},
+		Error: fmt.Sprintf("open %s: no such file or directory", GetTestFilePath(filepath.Join("notexist", "tee.txt"))),
+	},
 	{
 		Name: "Set Encoding",
 		Expr: parser.SetFlag{
@@ -479,6 +668,13 @@ var setFlagTests = []struct {
 			Value: parser.NewTernaryValueFromString("true"),
 		},
 	},
+	{
+		Name: "Set TypedHeader",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "typed_header"},
+			Value: parser.NewTernaryValueFromString("true"),
+		},
+	},
 	{
 		Name: "Set lineBreak",
 		Expr: parser.SetFlag{
@@ -486,6 +682,20 @@ var setFlagTests = []struct {
 			Value: parser.NewStringValue("CRLF"),
 		},
 	},
+	{
+		Name: "Set WriteBOM",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "write_bom"},
+			Value: parser.NewTernaryValueFromString("true"),
+		},
+	},
+	{
+		Name: "Set ReplaceUnencodable",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "replace_unencodable"},
+			Value: parser.NewTernaryValueFromString("true"),
+		},
+	},
 	{
 		Name: "Set EncloseAll",
 		Expr: parser.SetFlag{
@@ -507,6 +717,127 @@ var setFlagTests = []struct {
 			Value: parser.NewTernaryValueFromString("true"),
 		},
 	},
+	{
+		Name: "Set JsonKey",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "json_key"},
+			Value: parser.NewStringValue("id"),
+		},
+	},
+	{
+		Name: "Set JsonKeyLast",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "json_key_last"},
+			Value: parser.NewTernaryValueFromString("true"),
+		},
+	},
+	{
+		Name: "Set JsonFlat",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "json_flat"},
+			Value: parser.NewTernaryValueFromString("true"),
+		},
+	},
+	{
+		Name: "Set NullText",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "null_text"},
+			Value: parser.NewStringValue("NULL"),
+		},
+	},
+	{
+		Name: "Set HtmlClass",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "html_class"},
+			Value: parser.NewStringValue("report"),
+		},
+	},
+	{
+		Name: "Set XmlAttributes",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "xml_attributes"},
+			Value: parser.NewTernaryValueFromString("true"),
+		},
+	},
+	{
+		Name: "Set MaxColumnWidth",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "max_column_width"},
+			Value: parser.NewIntegerValueFromString("20"),
+		},
+	},
+	{
+		Name: "Set WrapText",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "wrap_text"},
+			Value: parser.NewTernaryValueFromString("true"),
+		},
+	},
+	{
+		Name: "Set BoxDrawing",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "box_drawing"},
+			Value: parser.NewTernaryValueFromString("true"),
+		},
+	},
+	{
+		Name: "Set TableName",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "table_name"},
+			Value: parser.NewStringValue("target"),
+		},
+	},
+	{
+		Name: "Set InsertBatchSize",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "insert_batch_size"},
+			Value: parser.NewIntegerValueFromString("100"),
+		},
+	},
+	{
+		Name: "Set Template",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "template"},
+			Value: parser.NewStringValue("report.tmpl"),
+		},
+	},
+	{
+		Name: "Set IdentifierQuote",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "identifier_quote"},
+			Value: parser.NewStringValue("backtick"),
+		},
+	},
+	{
+		Name: "Set IdentifierQuote Error",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "identifier_quote"},
+			Value: parser.NewStringValue("invalid"),
+		},
+		Error: "identifier quote must be one of DOUBLE|BACKTICK",
+	},
+	{
+		Name: "Set ParquetCompression",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "parquet_compression"},
+			Value: parser.NewStringValue("zstd"),
+		},
+	},
+	{
+		Name: "Set ParquetCompression Error",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "parquet_compression"},
+			Value: parser.NewStringValue("invalid"),
+		},
+		Error: "parquet compression must be one of SNAPPY|ZSTD|NONE",
+	},
+	{
+		Name: "Set ParquetAllStrings",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "parquet_all_strings"},
+			Value: parser.NewTernaryValueFromString("true"),
+		},
+	},
 	{
 		Name: "Set Strip Ending Line Break",
 		Expr: parser.SetFlag{
@@ -570,6 +901,28 @@ var setFlagTests = []struct {
 			Value: parser.NewTernaryValueFromString("true"),
 		},
 	},
+	{
+		Name: "Set Now",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "now"},
+			Value: parser.NewStringValue("2024-01-01 00:00:00"),
+		},
+	},
+	{
+		Name: "Set Now to Null",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "now"},
+			Value: parser.NewNullValue(),
+		},
+	},
+	{
+		Name: "Set Now Value Error",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "now"},
+			Value: parser.NewStringValue("invalid"),
+		},
+		Error: "'invalid' for @@NOW is not allowed",
+	},
 	{
 		Name: "Set Encoding with Identifier",
 		Expr: parser.SetFlag{
@@ -633,10 +986,69 @@ var setFlagTests = []struct {
 		},
 		Error: "line-break must be one of CRLF|CR|LF",
 	},
+	{
+		Name: "Invalid Timezone Value Error",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "timezone"},
+			Value: parser.NewStringValue("Mars/Olympus_Mons"),
+		},
+		Error: "timezone \"Mars/Olympus_Mons\" does not exist",
+	},
+	{
+		Name: "Empty Delimiter Value Error",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "delimiter"},
+			Value: parser.NewStringValue(""),
+		},
+		Error: "delimiter must not be empty",
+	},
+	{
+		Name: "Empty WriteEncoding Value Error",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "write_encoding"},
+			Value: parser.NewStringValue(""),
+		},
+		Error: "write-encoding must not be empty",
+	},
+	{
+		Name: "Negative WaitTimeout Value Error",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "wait_timeout"},
+			Value: parser.NewFloatValue(-1),
+		},
+		Error: "wait-timeout must be 0 or greater",
+	},
+	{
+		Name: "Negative SkipLines Value Error",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "skip_lines"},
+			Value: parser.NewIntegerValue(-1),
+		},
+		Error: "skip-lines must be 0 or greater",
+	},
+	{
+		Name: "Zero CPU Value Error",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "cpu"},
+			Value: parser.NewIntegerValue(0),
+		},
+		Error: "cpu must be 1 or greater",
+	},
+	{
+		Name: "Invalid ColumnTypes Value Error",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "column_types"},
+			Value: parser.NewStringValue("zip:invalid"),
+		},
+		Error: "column type must be one of STRING|INTEGER|FLOAT|BOOLEAN|TERNARY|DATETIME",
+	},
 }
 
 func TestSetFlag(t *testing.T) {
-	defer initFlag(TestTx.Flags)
+	defer func() {
+		_ = TestTx.CloseTee()
+		initFlag(TestTx.Flags)
+	}()
 
 	ctx := context.Background()
 	scope := NewReferenceScope(TestTx)
@@ -925,17 +1337,108 @@ var showFlagTests = []struct {
 		Result: "\033[34;1m@@ANSI_QUOTES:\033[0m \033[33;1mtrue\033[0m",
 	},
 	{
-		Name: "Show WaitTimeout",
+		Name: "Show NullsOrder",
 		Expr: parser.ShowFlag{
-			Flag: parser.Flag{Name: "wait_timeout"},
+			Flag: parser.Flag{Name: "nulls_order"},
 		},
 		SetExprs: []parser.SetFlag{
 			{
-				Flag:  parser.Flag{Name: "wait_timeout"},
-				Value: parser.NewFloatValue(15),
+				Flag:  parser.Flag{Name: "nulls_order"},
+				Value: parser.NewStringValue("first"),
 			},
 		},
-		Result: "\033[34;1m@@WAIT_TIMEOUT:\033[0m \033[35m15\033[0m",
+		Result: "\033[34;1m@@NULLS_ORDER:\033[0m \033[32mFIRST\033[0m",
+	},
+	{
+		Name: "Show StableSort",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "stable_sort"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "stable_sort"},
+				Value: parser.NewTernaryValueFromString("false"),
+			},
+		},
+		Result: "\033[34;1m@@STABLE_SORT:\033[0m \033[33;1mfalse\033[0m",
+	},
+	{
+		Name: "Show ConcatNullAsEmpty",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "concat_null_as_empty"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "concat_null_as_empty"},
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+		},
+		Result: "\033[34;1m@@CONCAT_NULL_AS_EMPTY:\033[0m \033[33;1mtrue\033[0m",
+	},
+	{
+		Name: "Show AppendCommit",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "append_commit"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "append_commit"},
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+		},
+		Result: "\033[34;1m@@APPEND_COMMIT:\033[0m \033[33;1mtrue\033[0m",
+	},
+	{
+		Name: "Show AtomicWrite",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "atomic_write"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "atomic_write"},
+				Value: parser.NewTernaryValueFromString("false"),
+			},
+		},
+		Result: "\033[34;1m@@ATOMIC_WRITE:\033[0m \033[33;1mfalse\033[0m",
+	},
+	{
+		Name: "Show DryRun",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "dry_run"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "dry_run"},
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+		},
+		Result: "\033[34;1m@@DRY_RUN:\033[0m \033[33;1mtrue\033[0m",
+	},
+	{
+		Name: "Show WaitTimeout",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "wait_timeout"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "wait_timeout"},
+				Value: parser.NewFloatValue(15),
+			},
+		},
+		Result: "\033[34;1m@@WAIT_TIMEOUT:\033[0m \033[35m15\033[0m",
+	},
+	{
+		Name: "Show HttpMaxSize",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "http_max_size"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "http_max_size"},
+				Value: parser.NewIntegerValue(1024),
+			},
+		},
+		Result: "\033[34;1m@@HTTP_MAX_SIZE:\033[0m \033[35m1024\033[0m",
 	},
 	{
 		Name: "Show Import Format",
@@ -963,6 +1466,79 @@ var showFlagTests = []struct {
 		},
 		Result: "\033[34;1m@@DELIMITER:\033[0m \033[32m'\\t'\033[0m",
 	},
+	{
+		Name: "Show QuoteChar",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "quote_char"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "quote_char"},
+				Value: parser.NewStringValue("'"),
+			},
+		},
+		Result: "\033[34;1m@@QUOTE_CHAR:\033[0m \033[32m'\\''\033[0m",
+	},
+	{
+		Name: "Show QuoteChar None",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "quote_char"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "quote_char"},
+				Value: parser.NewStringValue(""),
+			},
+		},
+		Result: "\033[34;1m@@QUOTE_CHAR:\033[0m \033[90m(none)\033[0m",
+	},
+	{
+		Name: "Show EscapeStyle",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "escape_style"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "escape_style"},
+				Value: parser.NewStringValue("backslash"),
+			},
+		},
+		Result: "\033[34;1m@@ESCAPE_STYLE:\033[0m \033[32mBACKSLASH\033[0m",
+	},
+	{
+		Name: "Show Multi-Character Delimiter",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "delimiter"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "delimiter"},
+				Value: parser.NewStringValue("||"),
+			},
+		},
+		Result: "\033[34;1m@@DELIMITER:\033[0m \033[32m'||'\033[0m",
+	},
+	{
+		Name: "Show DelimiterRegex",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "delimiter_regex"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "delimiter_regex"},
+				Value: parser.NewStringValue("\\s*;\\s*"),
+			},
+		},
+		Result: "\033[34;1m@@DELIMITER_REGEX:\033[0m \033[32m\\s*;\\s*\033[0m",
+	},
+	{
+		Name: "Show DelimiterRegex Not Set",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "delimiter_regex"},
+		},
+		SetExprs: []parser.SetFlag{},
+		Result:   "\033[34;1m@@DELIMITER_REGEX:\033[0m \033[90m(not set)\033[0m",
+	},
 	{
 		Name: "Show Delimiter Positions",
 		Expr: parser.ShowFlag{
@@ -1049,6 +1625,160 @@ var showFlagTests = []struct {
 		},
 		Result: "\033[34;1m@@WITHOUT_NULL:\033[0m \033[33;1mtrue\033[0m",
 	},
+	{
+		Name: "Show ColumnTypes",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "column_types"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "column_types"},
+				Value: parser.NewStringValue("zip:string,amount:float"),
+			},
+		},
+		Result: "\033[34;1m@@COLUMN_TYPES:\033[0m \033[32mzip:STRING,amount:FLOAT\033[0m",
+	},
+	{
+		Name: "Show ColumnTypes Not Set",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "column_types"},
+		},
+		SetExprs: []parser.SetFlag{},
+		Result:   "\033[34;1m@@COLUMN_TYPES:\033[0m \033[90m(not set)\033[0m",
+	},
+	{
+		Name: "Show StrictColumnTypes",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "strict_column_types"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "strict_column_types"},
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+		},
+		Result: "\033[34;1m@@STRICT_COLUMN_TYPES:\033[0m \033[33;1mtrue\033[0m",
+	},
+	{
+		Name: "Show NoInfer",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "no_infer"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "no_infer"},
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+		},
+		Result: "\033[34;1m@@NO_INFER:\033[0m \033[33;1mtrue\033[0m",
+	},
+	{
+		Name: "Show SkipLines",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "skip_lines"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "skip_lines"},
+				Value: parser.NewIntegerValue(2),
+			},
+		},
+		Result: "\033[34;1m@@SKIP_LINES:\033[0m \033[35m2\033[0m",
+	},
+	{
+		Name: "Show CommentPrefix",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "comment_prefix"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "comment_prefix"},
+				Value: parser.NewStringValue("#"),
+			},
+		},
+		Result: "\033[34;1m@@COMMENT_PREFIX:\033[0m \033[32m#\033[0m",
+	},
+	{
+		Name: "Show CommentPrefix Not Set",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "comment_prefix"},
+		},
+		SetExprs: []parser.SetFlag{},
+		Result:   "\033[34;1m@@COMMENT_PREFIX:\033[0m \033[90m(not set)\033[0m",
+	},
+	{
+		Name: "Show SkipAllComments",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "skip_all_comments"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "skip_all_comments"},
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+		},
+		Result: "\033[34;1m@@SKIP_ALL_COMMENTS:\033[0m \033[33;1mtrue\033[0m",
+	},
+	{
+		Name: "Show Trim",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "trim"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "trim"},
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+		},
+		Result: "\033[34;1m@@TRIM:\033[0m \033[33;1mtrue\033[0m",
+	},
+	{
+		Name: "Show Detect",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "detect"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "detect"},
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+		},
+		Result: "\033[34;1m@@DETECT:\033[0m \033[33;1mtrue\033[0m",
+	},
+	{
+		Name: "Show Ragged",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "ragged"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "ragged"},
+				Value: parser.NewStringValue("skip"),
+			},
+		},
+		Result: "\033[34;1m@@RAGGED:\033[0m \033[32mSKIP\033[0m",
+	},
+	{
+		Name: "Show Tee",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "tee"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "tee"},
+				Value: parser.NewStringValue(GetTestFilePath("tee_show.txt")),
+			},
+		},
+		Result: "\033[34;1m@@TEE:\033[0m \033[32m" + GetTestFilePath("tee_show.txt") + "\033[0m",
+	},
+	{
+		Name: "Show Tee Not Set",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "tee"},
+		},
+		Result: "\033[34;1m@@TEE:\033[0m \033[90m(not set)\033[0m",
+	},
+
 	{
 		Name: "Show Format",
 		Expr: parser.ShowFlag{
@@ -1093,289 +1823,989 @@ var showFlagTests = []struct {
 		Result: "\033[34;1m@@WRITE_ENCODING:\033[0m \033[90m(ignored) SJIS\033[0m",
 	},
 	{
-		Name: "Show WriteDelimiter",
+		Name: "Show WriteDelimiter",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "write_delimiter"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "write_delimiter"},
+				Value: parser.NewStringValue("\t"),
+			},
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("CSV"),
+			},
+		},
+		Result: "\033[34;1m@@WRITE_DELIMITER:\033[0m \033[32m'\\t'\033[0m",
+	},
+	{
+		Name: "Show WriteDelimiter Ignored",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "write_delimiter"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "write_delimiter"},
+				Value: parser.NewStringValue("\t"),
+			},
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("JSON"),
+			},
+		},
+		Result: "\033[34;1m@@WRITE_DELIMITER:\033[0m \033[90m(ignored) '\\t'\033[0m",
+	},
+	{
+		Name: "Show WriteDelimiterPositions for Single-Line FIXED",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "write_delimiter_positions"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "write_delimiter_positions"},
+				Value: parser.NewStringValue("s[2, 5, 10]"),
+			},
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("FIXED"),
+			},
+		},
+		Result: "\033[34;1m@@WRITE_DELIMITER_POSITIONS:\033[0m \033[32mS[2, 5, 10]\033[0m",
+	},
+	{
+		Name: "Show WriteDelimiterPositions for FIXED",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "write_delimiter_positions"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "write_delimiter_positions"},
+				Value: parser.NewStringValue("spaces"),
+			},
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("FIXED"),
+			},
+		},
+		Result: "\033[34;1m@@WRITE_DELIMITER_POSITIONS:\033[0m \033[32mSPACES\033[0m",
+	},
+	{
+		Name: "Show WriteDelimiterPositions Ignored",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "write_delimiter_positions"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "write_delimiter_positions"},
+				Value: parser.NewStringValue("spaces"),
+			},
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("JSON"),
+			},
+		},
+		Result: "\033[34;1m@@WRITE_DELIMITER_POSITIONS:\033[0m \033[90m(ignored) SPACES\033[0m",
+	},
+	{
+		Name: "Show WithoutHeader",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "without_header"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "without_header"},
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("CSV"),
+			},
+		},
+		Result: "\033[34;1m@@WITHOUT_HEADER:\033[0m \033[33;1mtrue\033[0m",
+	},
+	{
+		Name: "Show WithoutHeader Ignored",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "without_header"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "without_header"},
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("JSON"),
+			},
+		},
+		Result: "\033[34;1m@@WITHOUT_HEADER:\033[0m \033[90m(ignored) true\033[0m",
+	},
+	{
+		Name: "Show WithoutHeader with Single-Line Fixed-Length",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "without_header"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("fixed"),
+			},
+			{
+				Flag:  parser.Flag{Name: "write_delimiter_positions"},
+				Value: parser.NewStringValue("s[2, 5, 10]"),
+			},
+			{
+				Flag:  parser.Flag{Name: "without_header"},
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+		},
+		Result: "\033[34;1m@@WITHOUT_HEADER:\033[0m \033[90m(ignored) true\033[0m",
+	},
+	{
+		Name: "Show TypedHeader",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "typed_header"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "typed_header"},
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("TEXT"),
+			},
+		},
+		Result: "\033[34;1m@@TYPED_HEADER:\033[0m \033[33;1mtrue\033[0m",
+	},
+	{
+		Name: "Show TypedHeader Ignored",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "typed_header"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "typed_header"},
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("JSON"),
+			},
+		},
+		Result: "\033[34;1m@@TYPED_HEADER:\033[0m \033[90m(ignored) true\033[0m",
+	},
+	{
+		Name: "Show lineBreak",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "line_break"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "line_break"},
+				Value: parser.NewStringValue("CRLF"),
+			},
+		},
+		Result: "\033[34;1m@@LINE_BREAK:\033[0m \033[32mCRLF\033[0m",
+	},
+	{
+		Name: "Show lineBreak with Single-Line Fixed-Length",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "line_break"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("fixed"),
+			},
+			{
+				Flag:  parser.Flag{Name: "write_delimiter_positions"},
+				Value: parser.NewStringValue("s[2, 5, 10]"),
+			},
+			{
+				Flag:  parser.Flag{Name: "line_break"},
+				Value: parser.NewStringValue("CRLF"),
+			},
+		},
+		Result: "\033[34;1m@@LINE_BREAK:\033[0m \033[90m(ignored) CRLF\033[0m",
+	},
+	{
+		Name: "Show WriteBOM",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "write_bom"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "write_bom"},
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("CSV"),
+			},
+			{
+				Flag:  parser.Flag{Name: "write_encoding"},
+				Value: parser.NewStringValue("UTF16LE"),
+			},
+		},
+		Result: "\033[34;1m@@WRITE_BOM:\033[0m \033[33;1mtrue\033[0m",
+	},
+	{
+		Name: "Show WriteBOM Ignored",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "write_bom"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "write_bom"},
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("CSV"),
+			},
+			{
+				Flag:  parser.Flag{Name: "write_encoding"},
+				Value: parser.NewStringValue("SJIS"),
+			},
+		},
+		Result: "\033[34;1m@@WRITE_BOM:\033[0m \033[90m(ignored) true\033[0m",
+	},
+	{
+		Name: "Show ReplaceUnencodable",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "replace_unencodable"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "replace_unencodable"},
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("CSV"),
+			},
+			{
+				Flag:  parser.Flag{Name: "write_encoding"},
+				Value: parser.NewStringValue("SJIS"),
+			},
+		},
+		Result: "\033[34;1m@@REPLACE_UNENCODABLE:\033[0m \033[33;1mtrue\033[0m",
+	},
+	{
+		Name: "Show ReplaceUnencodable Ignored",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "replace_unencodable"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "replace_unencodable"},
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("CSV"),
+			},
+			{
+				Flag:  parser.Flag{Name: "write_encoding"},
+				Value: parser.NewStringValue("UTF8"),
+			},
+		},
+		Result: "\033[34;1m@@REPLACE_UNENCODABLE:\033[0m \033[90m(ignored) true\033[0m",
+	},
+	{
+		Name: "Show EncloseAll",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "enclose_all"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "enclose_all"},
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("CSV"),
+			},
+		},
+		Result: "\033[34;1m@@ENCLOSE_ALL:\033[0m \033[33;1mtrue\033[0m",
+	},
+	{
+		Name: "Show EncloseAll Ignored",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "enclose_all"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "enclose_all"},
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("JSON"),
+			},
+		},
+		Result: "\033[34;1m@@ENCLOSE_ALL:\033[0m \033[90m(ignored) true\033[0m",
+	},
+	{
+		Name: "Show QuoteMode",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "quote_mode"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "quote_mode"},
+				Value: parser.NewStringValue("all"),
+			},
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("CSV"),
+			},
+		},
+		Result: "\033[34;1m@@QUOTE_MODE:\033[0m \033[32mALL\033[0m",
+	},
+	{
+		Name: "Show QuoteMode Ignored",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "quote_mode"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "quote_mode"},
+				Value: parser.NewStringValue("all"),
+			},
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("JSON"),
+			},
+		},
+		Result: "\033[34;1m@@QUOTE_MODE:\033[0m \033[90m(ignored) ALL\033[0m",
+	},
+	{
+		Name: "Show ExcelSafe",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "excel_safe"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "excel_safe"},
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("CSV"),
+			},
+		},
+		Result: "\033[34;1m@@EXCEL_SAFE:\033[0m \033[33;1mtrue\033[0m",
+	},
+	{
+		Name: "Show ExcelSafe Ignored",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "excel_safe"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("JSON"),
+			},
+		},
+		Result: "\033[34;1m@@EXCEL_SAFE:\033[0m \033[90m(ignored) false\033[0m",
+	},
+	{
+		Name: "Show ExcelSafePrefix",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "excel_safe_prefix"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "excel_safe"},
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+			{
+				Flag:  parser.Flag{Name: "excel_safe_prefix"},
+				Value: parser.NewStringValue("tab"),
+			},
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("TSV"),
+			},
+		},
+		Result: "\033[34;1m@@EXCEL_SAFE_PREFIX:\033[0m \033[32mTAB\033[0m",
+	},
+	{
+		Name: "Show ExcelSafePrefix Ignored",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "excel_safe_prefix"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("CSV"),
+			},
+		},
+		Result: "\033[34;1m@@EXCEL_SAFE_PREFIX:\033[0m \033[90m(ignored) QUOTE\033[0m",
+	},
+	{
+		Name: "Show JsonEscape",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "json_escape"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "json_escape"},
+				Value: parser.NewStringValue("HEXALL"),
+			},
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("JSON"),
+			},
+		},
+		Result: "\033[34;1m@@JSON_ESCAPE:\033[0m \033[32mHEXALL\033[0m",
+	},
+	{
+		Name: "Show JsonEscape Ignored",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "json_escape"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "json_escape"},
+				Value: parser.NewStringValue("BACKSLASH"),
+			},
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("CSV"),
+			},
+		},
+		Result: "\033[34;1m@@JSON_ESCAPE:\033[0m \033[90m(ignored) BACKSLASH\033[0m",
+	},
+	{
+		Name: "Show PrettyPrint",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "pretty_print"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "pretty_print"},
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("JSON"),
+			},
+		},
+		Result: "\033[34;1m@@PRETTY_PRINT:\033[0m \033[33;1mtrue\033[0m",
+	},
+	{
+		Name: "Show StripEndingLineBreak",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "strip_ending_line_break"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "strip_ending_line_break"},
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+		},
+		Result: "\033[34;1m@@STRIP_ENDING_LINE_BREAK:\033[0m \033[33;1mtrue\033[0m",
+	},
+	{
+		Name: "Show PrettyPrint Ignored",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "pretty_print"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "pretty_print"},
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+		},
+		Result: "\033[34;1m@@PRETTY_PRINT:\033[0m \033[90m(ignored) true\033[0m",
+	},
+	{
+		Name: "Show JsonKey",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "json_key"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "json_key"},
+				Value: parser.NewStringValue("id"),
+			},
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("JSON"),
+			},
+		},
+		Result: "\033[34;1m@@JSON_KEY:\033[0m \033[32mid\033[0m",
+	},
+	{
+		Name: "Show JsonKey Not Set",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "json_key"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("JSON"),
+			},
+		},
+		Result: "\033[34;1m@@JSON_KEY:\033[0m \033[90m(not set)\033[0m",
+	},
+	{
+		Name: "Show JsonKey Ignored",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "json_key"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "json_key"},
+				Value: parser.NewStringValue("id"),
+			},
+		},
+		Result: "\033[34;1m@@JSON_KEY:\033[0m \033[90m(ignored) id\033[0m",
+	},
+	{
+		Name: "Show JsonKeyLast",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "json_key_last"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "json_key_last"},
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+			{
+				Flag:  parser.Flag{Name: "json_key"},
+				Value: parser.NewStringValue("id"),
+			},
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("JSON"),
+			},
+		},
+		Result: "\033[34;1m@@JSON_KEY_LAST:\033[0m \033[33;1mtrue\033[0m",
+	},
+	{
+		Name: "Show JsonKeyLast Ignored",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "json_key_last"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "json_key_last"},
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("JSON"),
+			},
+		},
+		Result: "\033[34;1m@@JSON_KEY_LAST:\033[0m \033[90m(ignored) true\033[0m",
+	},
+	{
+		Name: "Show JsonFlat",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "json_flat"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "json_flat"},
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("NDJSON"),
+			},
+		},
+		Result: "\033[34;1m@@JSON_FLAT:\033[0m \033[33;1mtrue\033[0m",
+	},
+	{
+		Name: "Show JsonFlat Ignored",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "json_flat"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "json_flat"},
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+		},
+		Result: "\033[34;1m@@JSON_FLAT:\033[0m \033[90m(ignored) true\033[0m",
+	},
+	{
+		Name: "Show NullText",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "null_text"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "null_text"},
+				Value: parser.NewStringValue("NULL"),
+			},
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("GFM"),
+			},
+		},
+		Result: "\033[34;1m@@NULL_TEXT:\033[0m \033[32mNULL\033[0m",
+	},
+	{
+		Name: "Show NullText Empty",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "null_text"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("ORG"),
+			},
+		},
+		Result: "\033[34;1m@@NULL_TEXT:\033[0m \033[90m(empty)\033[0m",
+	},
+	{
+		Name: "Show NullText Ignored",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "null_text"},
+		},
+		Result: "\033[34;1m@@NULL_TEXT:\033[0m \033[90m(ignored) (empty)\033[0m",
+	},
+	{
+		Name: "Show HtmlClass",
 		Expr: parser.ShowFlag{
-			Flag: parser.Flag{Name: "write_delimiter"},
+			Flag: parser.Flag{Name: "html_class"},
 		},
 		SetExprs: []parser.SetFlag{
 			{
-				Flag:  parser.Flag{Name: "write_delimiter"},
-				Value: parser.NewStringValue("\t"),
+				Flag:  parser.Flag{Name: "html_class"},
+				Value: parser.NewStringValue("report"),
 			},
 			{
 				Flag:  parser.Flag{Name: "format"},
-				Value: parser.NewStringValue("CSV"),
+				Value: parser.NewStringValue("HTML"),
 			},
 		},
-		Result: "\033[34;1m@@WRITE_DELIMITER:\033[0m \033[32m'\\t'\033[0m",
+		Result: "\033[34;1m@@HTML_CLASS:\033[0m \033[32mreport\033[0m",
 	},
 	{
-		Name: "Show WriteDelimiter Ignored",
+		Name: "Show HtmlClass Ignored",
 		Expr: parser.ShowFlag{
-			Flag: parser.Flag{Name: "write_delimiter"},
+			Flag: parser.Flag{Name: "html_class"},
+		},
+		Result: "\033[34;1m@@HTML_CLASS:\033[0m \033[90m(ignored) (not set)\033[0m",
+	},
+	{
+		Name: "Show XmlAttributes",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "xml_attributes"},
 		},
 		SetExprs: []parser.SetFlag{
 			{
-				Flag:  parser.Flag{Name: "write_delimiter"},
-				Value: parser.NewStringValue("\t"),
+				Flag:  parser.Flag{Name: "xml_attributes"},
+				Value: parser.NewTernaryValueFromString("true"),
 			},
 			{
 				Flag:  parser.Flag{Name: "format"},
-				Value: parser.NewStringValue("JSON"),
+				Value: parser.NewStringValue("XML"),
 			},
 		},
-		Result: "\033[34;1m@@WRITE_DELIMITER:\033[0m \033[90m(ignored) '\\t'\033[0m",
+		Result: "\033[34;1m@@XML_ATTRIBUTES:\033[0m \033[33;1mtrue\033[0m",
 	},
 	{
-		Name: "Show WriteDelimiterPositions for Single-Line FIXED",
+		Name: "Show XmlAttributes Ignored",
 		Expr: parser.ShowFlag{
-			Flag: parser.Flag{Name: "write_delimiter_positions"},
+			Flag: parser.Flag{Name: "xml_attributes"},
+		},
+		Result: "\033[34;1m@@XML_ATTRIBUTES:\033[0m \033[90m(ignored) false\033[0m",
+	},
+	{
+		Name: "Show MaxColumnWidth",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "max_column_width"},
 		},
 		SetExprs: []parser.SetFlag{
 			{
-				Flag:  parser.Flag{Name: "write_delimiter_positions"},
-				Value: parser.NewStringValue("s[2, 5, 10]"),
+				Flag:  parser.Flag{Name: "max_column_width"},
+				Value: parser.NewIntegerValueFromString("20"),
 			},
 			{
 				Flag:  parser.Flag{Name: "format"},
-				Value: parser.NewStringValue("FIXED"),
+				Value: parser.NewStringValue("TEXT"),
 			},
 		},
-		Result: "\033[34;1m@@WRITE_DELIMITER_POSITIONS:\033[0m \033[32mS[2, 5, 10]\033[0m",
+		Result: "\033[34;1m@@MAX_COLUMN_WIDTH:\033[0m \033[35m20\033[0m",
 	},
 	{
-		Name: "Show WriteDelimiterPositions for FIXED",
+		Name: "Show MaxColumnWidth No Limit",
 		Expr: parser.ShowFlag{
-			Flag: parser.Flag{Name: "write_delimiter_positions"},
+			Flag: parser.Flag{Name: "max_column_width"},
 		},
 		SetExprs: []parser.SetFlag{
-			{
-				Flag:  parser.Flag{Name: "write_delimiter_positions"},
-				Value: parser.NewStringValue("spaces"),
-			},
 			{
 				Flag:  parser.Flag{Name: "format"},
-				Value: parser.NewStringValue("FIXED"),
+				Value: parser.NewStringValue("TEXT"),
 			},
 		},
-		Result: "\033[34;1m@@WRITE_DELIMITER_POSITIONS:\033[0m \033[32mSPACES\033[0m",
+		Result: "\033[34;1m@@MAX_COLUMN_WIDTH:\033[0m \033[90m(no limit)\033[0m",
 	},
 	{
-		Name: "Show WriteDelimiterPositions Ignored",
+		Name: "Show WrapText",
 		Expr: parser.ShowFlag{
-			Flag: parser.Flag{Name: "write_delimiter_positions"},
+			Flag: parser.Flag{Name: "wrap_text"},
 		},
 		SetExprs: []parser.SetFlag{
 			{
-				Flag:  parser.Flag{Name: "write_delimiter_positions"},
-				Value: parser.NewStringValue("spaces"),
+				Flag:  parser.Flag{Name: "wrap_text"},
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+			{
+				Flag:  parser.Flag{Name: "max_column_width"},
+				Value: parser.NewIntegerValueFromString("20"),
 			},
 			{
 				Flag:  parser.Flag{Name: "format"},
-				Value: parser.NewStringValue("JSON"),
+				Value: parser.NewStringValue("TEXT"),
 			},
 		},
-		Result: "\033[34;1m@@WRITE_DELIMITER_POSITIONS:\033[0m \033[90m(ignored) SPACES\033[0m",
+		Result: "\033[34;1m@@WRAP_TEXT:\033[0m \033[33;1mtrue\033[0m",
 	},
 	{
-		Name: "Show WithoutHeader",
+		Name: "Show WrapText Ignored",
 		Expr: parser.ShowFlag{
-			Flag: parser.Flag{Name: "without_header"},
+			Flag: parser.Flag{Name: "wrap_text"},
 		},
 		SetExprs: []parser.SetFlag{
-			{
-				Flag:  parser.Flag{Name: "without_header"},
-				Value: parser.NewTernaryValueFromString("true"),
-			},
 			{
 				Flag:  parser.Flag{Name: "format"},
-				Value: parser.NewStringValue("CSV"),
+				Value: parser.NewStringValue("TEXT"),
 			},
 		},
-		Result: "\033[34;1m@@WITHOUT_HEADER:\033[0m \033[33;1mtrue\033[0m",
+		Result: "\033[34;1m@@WRAP_TEXT:\033[0m \033[90m(ignored) false\033[0m",
 	},
 	{
-		Name: "Show WithoutHeader Ignored",
+		Name: "Show BoxDrawing",
 		Expr: parser.ShowFlag{
-			Flag: parser.Flag{Name: "without_header"},
+			Flag: parser.Flag{Name: "box_drawing"},
 		},
 		SetExprs: []parser.SetFlag{
 			{
-				Flag:  parser.Flag{Name: "without_header"},
+				Flag:  parser.Flag{Name: "box_drawing"},
 				Value: parser.NewTernaryValueFromString("true"),
 			},
 			{
 				Flag:  parser.Flag{Name: "format"},
-				Value: parser.NewStringValue("JSON"),
+				Value: parser.NewStringValue("TEXT"),
 			},
 		},
-		Result: "\033[34;1m@@WITHOUT_HEADER:\033[0m \033[90m(ignored) true\033[0m",
+		Result: "\033[34;1m@@BOX_DRAWING:\033[0m \033[33;1mtrue\033[0m",
 	},
 	{
-		Name: "Show WithoutHeader with Single-Line Fixed-Length",
+		Name: "Show BoxDrawing Ignored",
 		Expr: parser.ShowFlag{
-			Flag: parser.Flag{Name: "without_header"},
+			Flag: parser.Flag{Name: "box_drawing"},
 		},
 		SetExprs: []parser.SetFlag{
 			{
 				Flag:  parser.Flag{Name: "format"},
-				Value: parser.NewStringValue("fixed"),
-			},
-			{
-				Flag:  parser.Flag{Name: "write_delimiter_positions"},
-				Value: parser.NewStringValue("s[2, 5, 10]"),
-			},
-			{
-				Flag:  parser.Flag{Name: "without_header"},
-				Value: parser.NewTernaryValueFromString("true"),
+				Value: parser.NewStringValue("CSV"),
 			},
 		},
-		Result: "\033[34;1m@@WITHOUT_HEADER:\033[0m \033[90m(ignored) true\033[0m",
+		Result: "\033[34;1m@@BOX_DRAWING:\033[0m \033[90m(ignored) false\033[0m",
 	},
 	{
-		Name: "Show lineBreak",
+		Name: "Show TableName",
 		Expr: parser.ShowFlag{
-			Flag: parser.Flag{Name: "line_break"},
+			Flag: parser.Flag{Name: "table_name"},
 		},
 		SetExprs: []parser.SetFlag{
 			{
-				Flag:  parser.Flag{Name: "line_break"},
-				Value: parser.NewStringValue("CRLF"),
+				Flag:  parser.Flag{Name: "table_name"},
+				Value: parser.NewStringValue("target"),
+			},
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("SQL_INSERT"),
 			},
 		},
-		Result: "\033[34;1m@@LINE_BREAK:\033[0m \033[32mCRLF\033[0m",
+		Result: "\033[34;1m@@TABLE_NAME:\033[0m \033[32mtarget\033[0m",
 	},
 	{
-		Name: "Show lineBreak with Single-Line Fixed-Length",
+		Name: "Show TableName Not Set",
 		Expr: parser.ShowFlag{
-			Flag: parser.Flag{Name: "line_break"},
+			Flag: parser.Flag{Name: "table_name"},
 		},
 		SetExprs: []parser.SetFlag{
 			{
 				Flag:  parser.Flag{Name: "format"},
-				Value: parser.NewStringValue("fixed"),
-			},
-			{
-				Flag:  parser.Flag{Name: "write_delimiter_positions"},
-				Value: parser.NewStringValue("s[2, 5, 10]"),
-			},
-			{
-				Flag:  parser.Flag{Name: "line_break"},
-				Value: parser.NewStringValue("CRLF"),
+				Value: parser.NewStringValue("SQL_INSERT"),
 			},
 		},
-		Result: "\033[34;1m@@LINE_BREAK:\033[0m \033[90m(ignored) CRLF\033[0m",
+		Result: "\033[34;1m@@TABLE_NAME:\033[0m \033[90m(not set)\033[0m",
 	},
 	{
-		Name: "Show EncloseAll",
+		Name: "Show TableName Ignored",
 		Expr: parser.ShowFlag{
-			Flag: parser.Flag{Name: "enclose_all"},
+			Flag: parser.Flag{Name: "table_name"},
+		},
+		Result: "\033[34;1m@@TABLE_NAME:\033[0m \033[90m(ignored) (not set)\033[0m",
+	},
+	{
+		Name: "Show InsertBatchSize",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "insert_batch_size"},
 		},
 		SetExprs: []parser.SetFlag{
 			{
-				Flag:  parser.Flag{Name: "enclose_all"},
-				Value: parser.NewTernaryValueFromString("true"),
+				Flag:  parser.Flag{Name: "insert_batch_size"},
+				Value: parser.NewIntegerValueFromString("100"),
 			},
 			{
 				Flag:  parser.Flag{Name: "format"},
-				Value: parser.NewStringValue("CSV"),
+				Value: parser.NewStringValue("SQL_INSERT"),
 			},
 		},
-		Result: "\033[34;1m@@ENCLOSE_ALL:\033[0m \033[33;1mtrue\033[0m",
+		Result: "\033[34;1m@@INSERT_BATCH_SIZE:\033[0m \033[35m100\033[0m",
 	},
 	{
-		Name: "Show EncloseAll Ignored",
+		Name: "Show InsertBatchSize Ignored",
 		Expr: parser.ShowFlag{
-			Flag: parser.Flag{Name: "enclose_all"},
+			Flag: parser.Flag{Name: "insert_batch_size"},
+		},
+		Result: "\033[34;1m@@INSERT_BATCH_SIZE:\033[0m \033[90m(ignored) 1\033[0m",
+	},
+	{
+		Name: "Show Template",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "template"},
 		},
 		SetExprs: []parser.SetFlag{
 			{
-				Flag:  parser.Flag{Name: "enclose_all"},
-				Value: parser.NewTernaryValueFromString("true"),
+				Flag:  parser.Flag{Name: "template"},
+				Value: parser.NewStringValue("/path/to/report.tmpl"),
 			},
 			{
 				Flag:  parser.Flag{Name: "format"},
-				Value: parser.NewStringValue("JSON"),
+				Value: parser.NewStringValue("TEMPLATE"),
 			},
 		},
-		Result: "\033[34;1m@@ENCLOSE_ALL:\033[0m \033[90m(ignored) true\033[0m",
+		Result: "\033[34;1m@@TEMPLATE:\033[0m \033[32m/path/to/report.tmpl\033[0m",
 	},
 	{
-		Name: "Show JsonEscape",
+		Name: "Show Template Ignored",
 		Expr: parser.ShowFlag{
-			Flag: parser.Flag{Name: "json_escape"},
+			Flag: parser.Flag{Name: "template"},
+		},
+		Result: "\033[34;1m@@TEMPLATE:\033[0m \033[90m(ignored) (not set)\033[0m",
+	},
+	{
+		Name: "Show IdentifierQuote",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "identifier_quote"},
 		},
 		SetExprs: []parser.SetFlag{
 			{
-				Flag:  parser.Flag{Name: "json_escape"},
-				Value: parser.NewStringValue("HEXALL"),
+				Flag:  parser.Flag{Name: "identifier_quote"},
+				Value: parser.NewStringValue("backtick"),
 			},
 			{
 				Flag:  parser.Flag{Name: "format"},
-				Value: parser.NewStringValue("JSON"),
+				Value: parser.NewStringValue("SQL_INSERT"),
 			},
 		},
-		Result: "\033[34;1m@@JSON_ESCAPE:\033[0m \033[32mHEXALL\033[0m",
+		Result: "\033[34;1m@@IDENTIFIER_QUOTE:\033[0m \033[32mBACKTICK\033[0m",
 	},
 	{
-		Name: "Show JsonEscape Ignored",
+		Name: "Show IdentifierQuote Ignored",
 		Expr: parser.ShowFlag{
-			Flag: parser.Flag{Name: "json_escape"},
+			Flag: parser.Flag{Name: "identifier_quote"},
+		},
+		Result: "\033[34;1m@@IDENTIFIER_QUOTE:\033[0m \033[90m(ignored) DOUBLE\033[0m",
+	},
+	{
+		Name: "Show ParquetCompression",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "parquet_compression"},
 		},
 		SetExprs: []parser.SetFlag{
 			{
-				Flag:  parser.Flag{Name: "json_escape"},
-				Value: parser.NewStringValue("BACKSLASH"),
+				Flag:  parser.Flag{Name: "parquet_compression"},
+				Value: parser.NewStringValue("zstd"),
 			},
 			{
 				Flag:  parser.Flag{Name: "format"},
-				Value: parser.NewStringValue("CSV"),
+				Value: parser.NewStringValue("PARQUET"),
 			},
 		},
-		Result: "\033[34;1m@@JSON_ESCAPE:\033[0m \033[90m(ignored) BACKSLASH\033[0m",
+		Result: "\033[34;1m@@PARQUET_COMPRESSION:\033[0m \033[32mZSTD\033[0m",
 	},
 	{
-		Name: "Show PrettyPrint",
+		Name: "Show ParquetCompression Ignored",
 		Expr: parser.ShowFlag{
-			Flag: parser.Flag{Name: "pretty_print"},
+			Flag: parser.Flag{Name: "parquet_compression"},
+		},
+		Result: "\033[34;1m@@PARQUET_COMPRESSION:\033[0m \033[90m(ignored) SNAPPY\033[0m",
+	},
+	{
+		Name: "Show ParquetAllStrings",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "parquet_all_strings"},
 		},
 		SetExprs: []parser.SetFlag{
 			{
-				Flag:  parser.Flag{Name: "pretty_print"},
+				Flag:  parser.Flag{Name: "parquet_all_strings"},
 				Value: parser.NewTernaryValueFromString("true"),
 			},
 			{
 				Flag:  parser.Flag{Name: "format"},
-				Value: parser.NewStringValue("JSON"),
+				Value: parser.NewStringValue("PARQUET"),
 			},
 		},
-		Result: "\033[34;1m@@PRETTY_PRINT:\033[0m \033[33;1mtrue\033[0m",
+		Result: "\033[34;1m@@PARQUET_ALL_STRINGS:\033[0m \033[33;1mtrue\033[0m",
 	},
 	{
-		Name: "Show StripEndingLineBreak",
+		Name: "Show ParquetAllStrings Ignored",
 		Expr: parser.ShowFlag{
-			Flag: parser.Flag{Name: "strip_ending_line_break"},
+			Flag: parser.Flag{Name: "parquet_all_strings"},
+		},
+		Result: "\033[34;1m@@PARQUET_ALL_STRINGS:\033[0m \033[90m(ignored) false\033[0m",
+	},
+	{
+		Name: "Show Compression",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "compression"},
 		},
 		SetExprs: []parser.SetFlag{
 			{
-				Flag:  parser.Flag{Name: "strip_ending_line_break"},
-				Value: parser.NewTernaryValueFromString("true"),
+				Flag:  parser.Flag{Name: "compression"},
+				Value: parser.NewStringValue("gzip"),
 			},
 		},
-		Result: "\033[34;1m@@STRIP_ENDING_LINE_BREAK:\033[0m \033[33;1mtrue\033[0m",
+		Result: "\033[34;1m@@COMPRESSION:\033[0m \033[32mGZIP\033[0m",
 	},
 	{
-		Name: "Show PrettyPrint Ignored",
+		Name: "Show CompressionLevel",
 		Expr: parser.ShowFlag{
-			Flag: parser.Flag{Name: "pretty_print"},
+			Flag: parser.Flag{Name: "compression_level"},
 		},
 		SetExprs: []parser.SetFlag{
 			{
-				Flag:  parser.Flag{Name: "pretty_print"},
-				Value: parser.NewTernaryValueFromString("true"),
+				Flag:  parser.Flag{Name: "compression_level"},
+				Value: parser.NewIntegerValue(9),
+			},
+			{
+				Flag:  parser.Flag{Name: "compression"},
+				Value: parser.NewStringValue("gzip"),
 			},
 		},
-		Result: "\033[34;1m@@PRETTY_PRINT:\033[0m \033[90m(ignored) true\033[0m",
+		Result: "\033[34;1m@@COMPRESSION_LEVEL:\033[0m \033[35m9\033[0m",
+	},
+	{
+		Name: "Show CompressionLevel Ignored",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "compression_level"},
+		},
+		Result: "\033[34;1m@@COMPRESSION_LEVEL:\033[0m \033[90m(ignored) 6\033[0m",
 	},
 	{
 		Name: "Show EastAsianEncoding",
@@ -1557,6 +2987,26 @@ var showFlagTests = []struct {
 		},
 		Result: "\033[34;1m@@STATS:\033[0m \033[33;1mtrue\033[0m",
 	},
+	{
+		Name: "Show Now",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "now"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "now"},
+				Value: parser.NewStringValue("2024-01-01 00:00:00"),
+			},
+		},
+		Result: "\033[34;1m@@NOW:\033[0m \033[36m2024-01-01T00:00:00Z\033[0m",
+	},
+	{
+		Name: "Show Now Not Set",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "now"},
+		},
+		Result: "\033[34;1m@@NOW:\033[0m \033[90m(current time)\033[0m",
+	},
 	{
 		Name: "Invalid Flag Name Error",
 		Expr: parser.ShowFlag{
@@ -1568,6 +3018,7 @@ var showFlagTests = []struct {
 
 func TestShowFlag(t *testing.T) {
 	defer func() {
+		_ = TestTx.CloseTee()
 		TestTx.UseColor(false)
 		initFlag(TestTx.Flags)
 	}()
@@ -1601,134 +3052,99 @@ func TestShowFlag(t *testing.T) {
 	}
 }
 
-var showObjectsTests = []struct {
-	Name                    string
-	Expr                    parser.ShowObjects
-	Scope                   *ReferenceScope
-	PreparedStatements      PreparedStatementMap
-	ImportFormat            cmd.Format
-	Delimiter               rune
-	DelimiterPositions      fixedlen.DelimiterPositions
-	SingleLine              bool
-	JsonQuery               string
-	Repository              string
-	Format                  cmd.Format
-	WriteDelimiter          rune
-	WriteDelimiterPositions fixedlen.DelimiterPositions
-	WriteAsSingleLine       bool
-	ViewCache               ViewMap
-	UncommittedViews        UncommittedViews
-	Expect                  string
-	Error                   string
+var showSettingListTests = []struct {
+	Name     string
+	SetExprs []parser.SetFlag
+	Flag     string
+	Value    value.Primary
 }{
 	{
-		Name: "ShowObjects Tables",
-		Expr: parser.ShowObjects{Type: parser.Identifier{Literal: "tables"}},
-		ViewCache: GenerateViewMap([]*View{
-			{
-				Header: NewHeader("table1", []string{"col1", "col2"}),
-				FileInfo: &FileInfo{
-					Path:      "table1.csv",
-					Delimiter: '\t',
-					Format:    cmd.CSV,
-					Encoding:  text.SJIS,
-					LineBreak: text.CRLF,
-					NoHeader:  true,
-				},
-			},
-			{
-				Header: NewHeader("table1", []string{"col1", "col2"}),
-				FileInfo: &FileInfo{
-					Path:      "table1.tsv",
-					Delimiter: '\t',
-					Format:    cmd.TSV,
-					Encoding:  text.UTF8,
-					LineBreak: text.LF,
-					NoHeader:  false,
-				},
-			},
-			{
-				Header: NewHeader("table1", []string{"col1", "col2"}),
-				FileInfo: &FileInfo{
-					Path:        "table1.json",
-					JsonQuery:   "{}",
-					Format:      cmd.JSON,
-					Encoding:    text.UTF8,
-					LineBreak:   text.LF,
-					PrettyPrint: false,
-				},
-			},
-			{
-				Header: NewHeader("table2", []string{"col1", "col2"}),
-				FileInfo: &FileInfo{
-					Path:        "table2.json",
-					JsonQuery:   "",
-					Format:      cmd.JSON,
-					Encoding:    text.UTF8,
-					LineBreak:   text.LF,
-					JsonEscape:  json.HexDigits,
-					PrettyPrint: false,
-				},
-			},
+		Name:  "ShowSettingList Default Value",
+		Flag:  "@@DELIMITER",
+		Value: value.NewString(","),
+	},
+	{
+		Name: "ShowSettingList Changed Value",
+		SetExprs: []parser.SetFlag{
 			{
-				Header: NewHeader("table1", []string{"col1", "col2"}),
-				FileInfo: &FileInfo{
-					Path:               "table1.txt",
-					DelimiterPositions: []int{3, 12},
-					Format:             cmd.FIXED,
-					Encoding:           text.UTF8,
-					LineBreak:          text.LF,
-					NoHeader:           false,
-				},
+				Flag:  parser.Flag{Name: "timezone"},
+				Value: parser.NewStringValue("UTC"),
 			},
-			{
-				Header: NewHeader("table2", []string{"col1", "col2"}),
-				FileInfo: &FileInfo{
-					Path:               "table2.txt",
-					DelimiterPositions: []int{3, 12},
-					SingleLine:         true,
-					Format:             cmd.FIXED,
-					Encoding:           text.UTF8,
-					LineBreak:          text.LF,
-					NoHeader:           false,
-				},
+		},
+		Flag:  "@@TIMEZONE",
+		Value: value.NewString("UTC"),
+	},
+	{
+		Name: "ShowSettingList Integer Value",
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "wait_timeout"},
+				Value: parser.NewFloatValue(15),
 			},
-		}),
-		Expect: "\n" +
-			"                      Loaded Tables\n" +
-			"----------------------------------------------------------\n" +
-			" table1.csv\n" +
-			"     Fields: col1, col2\n" +
-			"     Format: CSV     Delimiter: '\\t'  Enclose All: false\n" +
-			"     Encoding: SJIS  LineBreak: CRLF  Header: false\n" +
-			" table1.json\n" +
-			"     Fields: col1, col2\n" +
-			"     Format: JSON    Escape: BACKSLASH  Query: {}\n" +
-			"     Encoding: UTF8  LineBreak: LF    Pretty Print: false\n" +
-			" table1.tsv\n" +
-			"     Fields: col1, col2\n" +
-			"     Format: TSV     Delimiter: '\\t'  Enclose All: false\n" +
-			"     Encoding: UTF8  LineBreak: LF    Header: true\n" +
-			" table1.txt\n" +
-			"     Fields: col1, col2\n" +
-			"     Format: FIXED   Delimiter Positions: [3, 12]\n" +
-			"     Encoding: UTF8  LineBreak: LF    Header: true\n" +
-			" table2.json\n" +
-			"     Fields: col1, col2\n" +
-			"     Format: JSON    Escape: HEX      Query: (empty)\n" +
-			"     Encoding: UTF8  LineBreak: LF    Pretty Print: false\n" +
-			" table2.txt\n" +
-			"     Fields: col1, col2\n" +
-			"     Format: FIXED   Delimiter Positions: S[3, 12]\n" +
-			"     Encoding: UTF8\n" +
-			"\n",
+		},
+		Flag:  "@@WAIT_TIMEOUT",
+		Value: value.NewFloat(15),
 	},
+}
+
+func TestShowSettingList(t *testing.T) {
+	defer initFlag(TestTx.Flags)
+
+	ctx := context.Background()
+	scope := NewReferenceScope(TestTx)
+
+	for _, v := range showSettingListTests {
+		initFlag(TestTx.Flags)
+		for _, expr := range v.SetExprs {
+			if err := SetFlag(ctx, scope, expr); err != nil {
+				t.Fatalf("%s: unexpected error %q", v.Name, err)
+			}
+		}
+
+		view := ShowSettingList(TestTx)
+		if !reflect.DeepEqual(view.Header, NewHeader("", []string{"name", "value"})) {
+			t.Errorf("%s: header = %v, want name and value", v.Name, view.Header)
+		}
+		if view.RecordLen() != len(cmd.FlagList) {
+			t.Errorf("%s: %d records, want %d records", v.Name, view.RecordLen(), len(cmd.FlagList))
+		}
+
+		var found bool
+		for _, record := range view.RecordSet {
+			if record[0][0].(*value.String).Raw() != v.Flag {
+				continue
+			}
+			found = true
+			if !reflect.DeepEqual(record[1][0], v.Value) {
+				t.Errorf("%s: value = %s, want %s", v.Name, record[1][0], v.Value)
+			}
+		}
+		if !found {
+			t.Errorf("%s: flag %s is not listed", v.Name, v.Flag)
+		}
+	}
+}
+
+var showObjectTableTests = []struct {
+	Name             string
+	Expr             parser.ShowObjects
+	Scope            *ReferenceScope
+	Repository       string
+	ViewCache        ViewMap
+	UncommittedViews UncommittedViews
+	Result           *View
+	Error            string
+}{
 	{
-		Name: "ShowObjects Tables Uncommitted",
-		Expr: parser.ShowObjects{Type: parser.Identifier{Literal: "tables"}},
+		Name:       "ShowObjectTable Tables",
+		Expr:       parser.ShowObjects{Type: parser.Identifier{Literal: "tables"}},
+		Repository: filepath.Join(TestDir, "test_show_objects_empty"),
 		ViewCache: GenerateViewMap([]*View{
 			{
 				Header: NewHeader("table1", []string{"col1", "col2"}),
+				RecordSet: RecordSet{
+					NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a")}),
+				},
 				FileInfo: &FileInfo{
 					Path:      "table1.csv",
 					Delimiter: '\t',
@@ -1746,40 +3162,27 @@ var showObjectsTests = []struct {
 					Format:    cmd.TSV,
 					Encoding:  text.UTF8,
 					LineBreak: text.LF,
-					NoHeader:  false,
 				},
 			},
 			{
 				Header: NewHeader("table1", []string{"col1", "col2"}),
 				FileInfo: &FileInfo{
-					Path:        "table1.json",
-					JsonQuery:   "{}",
-					Format:      cmd.JSON,
-					Encoding:    text.UTF8,
-					LineBreak:   text.LF,
-					PrettyPrint: false,
-				},
-			},
-			{
-				Header: NewHeader("table2", []string{"col1", "col2"}),
-				FileInfo: &FileInfo{
-					Path:        "table2.json",
-					JsonQuery:   "",
-					Format:      cmd.JSON,
-					Encoding:    text.UTF8,
-					LineBreak:   text.LF,
-					PrettyPrint: false,
+					Path:      "table1.json",
+					JsonQuery: "{}",
+					Format:    cmd.JSON,
+					Encoding:  text.UTF8,
+					LineBreak: text.LF,
 				},
 			},
 			{
-				Header: NewHeader("table1", []string{"col1", "col2"}),
+				Header: NewHeader("table2", []string{"col 1", "col2"}),
 				FileInfo: &FileInfo{
-					Path:               "table1.txt",
-					DelimiterPositions: []int{3, 12},
-					Format:             cmd.FIXED,
-					Encoding:           text.UTF8,
-					LineBreak:          text.LF,
-					NoHeader:           false,
+					Path:      "table2.csv",
+					Delimiter: ',',
+					Format:    cmd.CSV,
+					Encoding:  text.UTF8,
+					LineBreak: text.LF,
+					ForUpdate: true,
 				},
 			},
 			{
@@ -1787,11 +3190,10 @@ var showObjectsTests = []struct {
 				FileInfo: &FileInfo{
 					Path:               "table2.txt",
 					DelimiterPositions: []int{3, 12},
+					SingleLine:         true,
 					Format:             cmd.FIXED,
 					Encoding:           text.UTF8,
 					LineBreak:          text.LF,
-					NoHeader:           false,
-					SingleLine:         true,
 				},
 			},
 		}),
@@ -1801,72 +3203,93 @@ var showObjectsTests = []struct {
 				"TABLE1.TSV": {Path: "table1.tsv"},
 			},
 			Updated: map[string]*FileInfo{
-				"TABLE2.JSON": {Path: "table2.json"},
+				"TABLE1.JSON": {Path: "table1.json"},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("", []string{"path", "format", "encoding", "line_break", "header", "fields", "rows", "status"}),
+			RecordSet: RecordSet{
+				NewRecord([]value.Primary{
+					value.NewString("table1.csv"),
+					value.NewString("CSV"),
+					value.NewString("SJIS"),
+					value.NewString("CRLF"),
+					value.NewBoolean(false),
+					value.NewString("col1, col2"),
+					value.NewInteger(1),
+					value.NewString("LOADED"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("table1.json"),
+					value.NewString("JSON"),
+					value.NewString("UTF8"),
+					value.NewString("LF"),
+					value.NewNull(),
+					value.NewString("col1, col2"),
+					value.NewInteger(0),
+					value.NewString("UPDATED"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("table1.tsv"),
+					value.NewString("TSV"),
+					value.NewString("UTF8"),
+					value.NewString("LF"),
+					value.NewBoolean(true),
+					value.NewString("col1, col2"),
+					value.NewInteger(0),
+					value.NewString("CREATED"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("table2.csv"),
+					value.NewString("CSV"),
+					value.NewString("UTF8"),
+					value.NewString("LF"),
+					value.NewBoolean(true),
+					value.NewString("col 1, col2"),
+					value.NewInteger(0),
+					value.NewString("LOCKED"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("table2.txt"),
+					value.NewString("FIXED"),
+					value.NewString("UTF8"),
+					value.NewNull(),
+					value.NewNull(),
+					value.NewString("col1, col2"),
+					value.NewInteger(0),
+					value.NewString("LOADED"),
+				}),
 			},
 		},
-		Expect: "\n" +
-			"          Loaded Tables (Uncommitted: 2 Tables)\n" +
-			"----------------------------------------------------------\n" +
-			" table1.csv\n" +
-			"     Fields: col1, col2\n" +
-			"     Format: CSV     Delimiter: '\\t'  Enclose All: false\n" +
-			"     Encoding: SJIS  LineBreak: CRLF  Header: false\n" +
-			" table1.json\n" +
-			"     Fields: col1, col2\n" +
-			"     Format: JSON    Escape: BACKSLASH  Query: {}\n" +
-			"     Encoding: UTF8  LineBreak: LF    Pretty Print: false\n" +
-			" *Created* table1.tsv\n" +
-			"     Fields: col1, col2\n" +
-			"     Format: TSV     Delimiter: '\\t'  Enclose All: false\n" +
-			"     Encoding: UTF8  LineBreak: LF    Header: true\n" +
-			" table1.txt\n" +
-			"     Fields: col1, col2\n" +
-			"     Format: FIXED   Delimiter Positions: [3, 12]\n" +
-			"     Encoding: UTF8  LineBreak: LF    Header: true\n" +
-			" *Updated* table2.json\n" +
-			"     Fields: col1, col2\n" +
-			"     Format: JSON    Escape: BACKSLASH  Query: (empty)\n" +
-			"     Encoding: UTF8  LineBreak: LF    Pretty Print: false\n" +
-			" table2.txt\n" +
-			"     Fields: col1, col2\n" +
-			"     Format: FIXED   Delimiter Positions: S[3, 12]\n" +
-			"     Encoding: UTF8\n" +
-			"\n",
 	},
 	{
-		Name: "ShowObjects Tables Long Fields",
-		Expr: parser.ShowObjects{Type: parser.Identifier{Literal: "tables"}},
-		ViewCache: GenerateViewMap([]*View{
-			{
-				Header: NewHeader("table1", []string{"colabcdef1", "colabcdef2", "colabcdef3", "colabcdef4", "colabcdef5", "colabcdef6", "colabcdef7"}),
-				FileInfo: &FileInfo{
-					Path:      "table1.csv",
-					Delimiter: '\t',
-					Format:    cmd.CSV,
-					Encoding:  text.SJIS,
-					LineBreak: text.CRLF,
-					NoHeader:  true,
-				},
+		Name:       "ShowObjectTable Table Files",
+		Expr:       parser.ShowObjects{Type: parser.Identifier{Literal: "tables"}},
+		Repository: CompletionTestDir,
+		Result: &View{
+			Header: NewHeader("", []string{"path", "format", "encoding", "line_break", "header", "fields", "rows", "status"}),
+			RecordSet: RecordSet{
+				NewRecord([]value.Primary{
+					value.NewString(filepath.Join(CompletionTestDir, "table1.csv")),
+					value.NewString("CSV"),
+					value.NewNull(),
+					value.NewNull(),
+					value.NewNull(),
+					value.NewNull(),
+					value.NewNull(),
+					value.NewString("NOT LOADED"),
+				}),
 			},
-		}),
-		Expect: "\n" +
-			"                        Loaded Tables\n" +
-			"--------------------------------------------------------------\n" +
-			" table1.csv\n" +
-			"     Fields: colabcdef1, colabcdef2, colabcdef3, colabcdef4, \n" +
-			"             colabcdef5, colabcdef6, colabcdef7\n" +
-			"     Format: CSV     Delimiter: '\\t'  Enclose All: false\n" +
-			"     Encoding: SJIS  LineBreak: CRLF  Header: false\n" +
-			"\n",
+		},
 	},
 	{
-		Name:       "ShowObjects No Table is Loaded",
+		Name:       "ShowObjectTable Tables Repository Error",
 		Expr:       parser.ShowObjects{Type: parser.Identifier{Literal: "tables"}},
-		Repository: filepath.Join(TestDir, "test_show_objects_empty"),
-		Expect:     "No table is loaded",
+		Repository: filepath.Join(TestDir, "notexist"),
+		Error:      fmt.Sprintf("open %s: no such file or directory", filepath.Join(TestDir, "notexist")),
 	},
 	{
-		Name: "ShowObjects Views",
+		Name: "ShowObjectTable Views",
 		Expr: parser.ShowObjects{Type: parser.Identifier{Literal: "views"}},
 		Scope: GenerateReferenceScope([]map[string]map[string]interface{}{
 			{
@@ -1877,6 +3300,10 @@ var showObjectsTests = []struct {
 							ViewType: ViewTypeTemporaryTable,
 						},
 						Header: NewHeader("view1", []string{"column1", "column2"}),
+						RecordSet: RecordSet{
+							NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a")}),
+							NewRecord([]value.Primary{value.NewInteger(2), value.NewString("b")}),
+						},
 					},
 				},
 			},
@@ -1909,22 +3336,34 @@ var showObjectsTests = []struct {
 				},
 			},
 		},
-		Expect: "\n" +
-			" Views (Uncommitted: 1 View)\n" +
-			"------------------------------\n" +
-			" view1\n" +
-			"     Fields: column1, column2\n" +
-			" *Updated* view2\n" +
-			"     Fields: column1, column2\n" +
-			"\n",
+		Result: &View{
+			Header: NewHeader("", []string{"name", "fields", "rows", "status"}),
+			RecordSet: RecordSet{
+				NewRecord([]value.Primary{
+					value.NewString("view1"),
+					value.NewString("column1, column2"),
+					value.NewInteger(2),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("view2"),
+					value.NewString("column1, column2"),
+					value.NewInteger(0),
+					value.NewString("UPDATED"),
+				}),
+			},
+		},
 	},
 	{
-		Name:   "ShowObjects Views Empty",
-		Expr:   parser.ShowObjects{Type: parser.Identifier{Literal: "views"}},
-		Expect: "No view is declared",
+		Name: "ShowObjectTable Views Empty",
+		Expr: parser.ShowObjects{Type: parser.Identifier{Literal: "views"}},
+		Result: &View{
+			Header:    NewHeader("", []string{"name", "fields", "rows", "status"}),
+			RecordSet: RecordSet{},
+		},
 	},
 	{
-		Name: "ShowObjects Cursors",
+		Name: "ShowObjectTable Cursors",
 		Expr: parser.ShowObjects{Type: parser.Identifier{Literal: "cursors"}},
 		Scope: GenerateReferenceScope([]map[string]map[string]interface{}{
 			{
@@ -1938,14 +3377,8 @@ var showObjectsTests = []struct {
 						query: selectQueryForCursorTest,
 						view: &View{
 							RecordSet: RecordSet{
-								NewRecord([]value.Primary{
-									value.NewInteger(1),
-									value.NewString("a"),
-								}),
-								NewRecord([]value.Primary{
-									value.NewInteger(2),
-									value.NewString("b"),
-								}),
+								NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a")}),
+								NewRecord([]value.Primary{value.NewInteger(2), value.NewString("b")}),
 							},
 						},
 						fetched: false,
@@ -1956,14 +3389,8 @@ var showObjectsTests = []struct {
 						query: selectQueryForCursorTest,
 						view: &View{
 							RecordSet: RecordSet{
-								NewRecord([]value.Primary{
-									value.NewInteger(1),
-									value.NewString("a"),
-								}),
-								NewRecord([]value.Primary{
-									value.NewInteger(2),
-									value.NewString("b"),
-								}),
+								NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a")}),
+								NewRecord([]value.Primary{value.NewInteger(2), value.NewString("b")}),
 							},
 						},
 						fetched: true,
@@ -1974,14 +3401,8 @@ var showObjectsTests = []struct {
 						query: selectQueryForCursorTest,
 						view: &View{
 							RecordSet: RecordSet{
-								NewRecord([]value.Primary{
-									value.NewInteger(1),
-									value.NewString("a"),
-								}),
-								NewRecord([]value.Primary{
-									value.NewInteger(2),
-									value.NewString("b"),
-								}),
+								NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a")}),
+								NewRecord([]value.Primary{value.NewInteger(2), value.NewString("b")}),
 							},
 						},
 						fetched: true,
@@ -1994,37 +3415,54 @@ var showObjectsTests = []struct {
 				},
 			},
 		}, nil, time.Time{}, nil),
-		Expect: "\n" +
-			"                               Cursors\n" +
-			"---------------------------------------------------------------------\n" +
-			" cur\n" +
-			"     Status: Closed\n" +
-			"     Query:\n" +
-			"       SELECT column1, column2 FROM table1\n" +
-			" cur2\n" +
-			"     Status: Open    Number of Rows: 2         Pointer: UNKNOWN\n" +
-			"     Query:\n" +
-			"       SELECT column1, column2 FROM table1\n" +
-			" cur3\n" +
-			"     Status: Open    Number of Rows: 2         Pointer: 1\n" +
-			"     Query:\n" +
-			"       SELECT column1, column2 FROM table1\n" +
-			" cur4\n" +
-			"     Status: Open    Number of Rows: 2         Pointer: Out of Range\n" +
-			"     Query:\n" +
-			"       SELECT column1, column2 FROM table1\n" +
-			" stmtcur\n" +
-			"     Status: Closed\n" +
-			"     Statement: stmt\n" +
-			"\n",
-	},
-	{
-		Name:   "ShowObjects Cursors Empty",
-		Expr:   parser.ShowObjects{Type: parser.Identifier{Literal: "cursors"}},
-		Expect: "No cursor is declared",
+		Result: &View{
+			Header: NewHeader("", []string{"name", "status", "rows", "position", "in_range", "query"}),
+			RecordSet: RecordSet{
+				NewRecord([]value.Primary{
+					value.NewString("cur"),
+					value.NewString("CLOSED"),
+					value.NewNull(),
+					value.NewNull(),
+					value.NewNull(),
+					value.NewString("SELECT column1, column2 FROM table1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("cur2"),
+					value.NewString("OPEN"),
+					value.NewInteger(2),
+					value.NewNull(),
+					value.NewTernary(ternary.UNKNOWN),
+					value.NewString("SELECT column1, column2 FROM table1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("cur3"),
+					value.NewString("OPEN"),
+					value.NewInteger(2),
+					value.NewInteger(1),
+					value.NewTernary(ternary.TRUE),
+					value.NewString("SELECT column1, column2 FROM table1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("cur4"),
+					value.NewString("OPEN"),
+					value.NewInteger(2),
+					value.NewNull(),
+					value.NewTernary(ternary.FALSE),
+					value.NewString("SELECT column1, column2 FROM table1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("stmtcur"),
+					value.NewString("CLOSED"),
+					value.NewNull(),
+					value.NewNull(),
+					value.NewNull(),
+					value.NewString("stmt"),
+				}),
+			},
+		},
 	},
 	{
-		Name: "ShowObjects Functions",
+		Name: "ShowObjectTable Functions",
 		Expr: parser.ShowObjects{Type: parser.Identifier{Literal: "functions"}},
 		Scope: GenerateReferenceScope([]map[string]map[string]interface{}{
 			{
@@ -2061,21 +3499,124 @@ var showObjectsTests = []struct {
 				},
 			},
 		}, nil, time.Time{}, nil),
-		Expect: "\n" +
-			" Scalar Functions\n" +
-			"-------------------\n" +
-			" userfunc1 (@arg1)\n" +
-			"\n" +
-			"           Aggregate Functions\n" +
-			"-----------------------------------------\n" +
-			" useraggfunc (column1, @arg1, @arg2 = 1)\n" +
-			"\n",
+		Result: &View{
+			Header: NewHeader("", []string{"name", "type", "cursor", "parameters"}),
+			RecordSet: RecordSet{
+				NewRecord([]value.Primary{
+					value.NewString("userfunc1"),
+					value.NewString("SCALAR"),
+					value.NewNull(),
+					value.NewString("@arg1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("useraggfunc"),
+					value.NewString("AGGREGATE"),
+					value.NewString("column1"),
+					value.NewString("@arg1, @arg2 = 1"),
+				}),
+			},
+		},
 	},
 	{
-		Name:   "ShowObjects Functions Empty",
-		Expr:   parser.ShowObjects{Type: parser.Identifier{Literal: "functions"}},
-		Expect: "No function is declared",
+		Name: "ShowObjectTable Variables",
+		Expr: parser.ShowObjects{Type: parser.Identifier{Literal: "variables"}},
+		Scope: GenerateReferenceScope([]map[string]map[string]interface{}{
+			{
+				scopeNameVariables: {
+					"var2": value.NewString("a"),
+				},
+			},
+			{
+				scopeNameVariables: {
+					"var1": value.NewInteger(1),
+					"var2": value.NewString("b"),
+				},
+			},
+		}, nil, time.Time{}, nil),
+		Result: &View{
+			Header: NewHeader("", []string{"name", "value"}),
+			RecordSet: RecordSet{
+				NewRecord([]value.Primary{
+					value.NewString("@var1"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("@var2"),
+					value.NewString("a"),
+				}),
+			},
+		},
+	},
+	{
+		Name:  "ShowObjectTable Invalid Object Type",
+		Expr:  parser.ShowObjects{Type: parser.Identifier{Literal: "flags"}},
+		Error: "object type flags is invalid",
 	},
+}
+
+func TestShowObjectTable(t *testing.T) {
+	defer func() {
+		_ = TestTx.ReleaseResources()
+		TestTx.uncommittedViews.Clean()
+		initFlag(TestTx.Flags)
+	}()
+
+	for _, v := range showObjectTableTests {
+		initFlag(TestTx.Flags)
+		TestTx.Flags.Repository = v.Repository
+
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		if v.ViewCache.SyncMap != nil {
+			TestTx.cachedViews = v.ViewCache
+		}
+		if v.UncommittedViews.mtx == nil {
+			TestTx.uncommittedViews = NewUncommittedViews()
+		} else {
+			TestTx.uncommittedViews = v.UncommittedViews
+		}
+
+		if v.Scope == nil {
+			v.Scope = NewReferenceScope(TestTx)
+		}
+		result, err := ShowObjectTable(v.Scope, v.Expr)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result, v.Result)
+		}
+	}
+}
+
+var showObjectsTests = []struct {
+	Name                    string
+	Expr                    parser.ShowObjects
+	Scope                   *ReferenceScope
+	PreparedStatements      PreparedStatementMap
+	ImportFormat            cmd.Format
+	Delimiter               rune
+	DelimiterPositions      fixedlen.DelimiterPositions
+	SingleLine              bool
+	JsonQuery               string
+	Repository              string
+	Format                  cmd.Format
+	WriteDelimiter          rune
+	WriteDelimiterPositions fixedlen.DelimiterPositions
+	WriteAsSingleLine       bool
+	ViewCache               ViewMap
+	UncommittedViews        UncommittedViews
+	Expect                  string
+	Error                   string
+}{
 	{
 		Name: "ShowObjects Statements",
 		Expr: parser.ShowObjects{Type: parser.Identifier{Literal: "statements"}},
@@ -2156,30 +3697,77 @@ var showObjectsTests = []struct {
 		Expr:       parser.ShowObjects{Type: parser.Identifier{Literal: "flags"}},
 		Repository: ".",
 		Expect: "\n" +
-			"                      Flags\n" +
-			"--------------------------------------------------\n" +
+			"                       Flags\n" +
+			"---------------------------------------------------\n" +
 			"                @@REPOSITORY: .\n" +
 			"                  @@TIMEZONE: UTC\n" +
 			"           @@DATETIME_FORMAT: (not set)\n" +
 			"               @@ANSI_QUOTES: false\n" +
+			"               @@NULLS_ORDER: DEFAULT\n" +
+			"               @@STABLE_SORT: true\n" +
+			"      @@CONCAT_NULL_AS_EMPTY: false\n" +
+			"             @@APPEND_COMMIT: false\n" +
+			"              @@ATOMIC_WRITE: true\n" +
+			"                   @@DRY_RUN: false\n" +
+			"        @@IGNORE_CONSTRAINTS: false\n" +
 			"              @@WAIT_TIMEOUT: 15\n" +
+			"              @@HTTP_TIMEOUT: 30\n" +
+			"             @@HTTP_MAX_SIZE: (no limit)\n" +
+			"              @@HTTP_HEADERS: (empty)\n" +
 			"             @@IMPORT_FORMAT: CSV\n" +
 			"                 @@DELIMITER: ','\n" +
+			"           @@DELIMITER_REGEX: (not set)\n" +
 			"       @@DELIMITER_POSITIONS: SPACES\n" +
+			"                @@QUOTE_CHAR: '\"'\n" +
+			"              @@ESCAPE_STYLE: DOUBLE\n" +
 			"                @@JSON_QUERY: (empty)\n" +
 			"                  @@ENCODING: AUTO\n" +
 			"                 @@NO_HEADER: false\n" +
 			"              @@WITHOUT_NULL: false\n" +
+			"              @@COLUMN_TYPES: (not set)\n" +
+			"       @@STRICT_COLUMN_TYPES: false\n" +
+			"        @@STRICT_GLOB_HEADER: false\n" +
+			"                  @@NO_INFER: false\n" +
+			"                @@SKIP_LINES: 0\n" +
+			"            @@COMMENT_PREFIX: (not set)\n" +
+			"         @@SKIP_ALL_COMMENTS: false\n" +
+			"                      @@TRIM: false\n" +
+			"                    @@DETECT: false\n" +
+			"                    @@RAGGED: ERROR\n" +
+			"                       @@TEE: (not set)\n" +
 			"   @@STRIP_ENDING_LINE_BREAK: false\n" +
 			"                    @@FORMAT: CSV\n" +
 			"            @@WRITE_ENCODING: UTF8\n" +
+			"                 @@WRITE_BOM: false\n" +
+			"       @@REPLACE_UNENCODABLE: (ignored) false\n" +
 			"           @@WRITE_DELIMITER: ','\n" +
 			" @@WRITE_DELIMITER_POSITIONS: (ignored) SPACES\n" +
 			"            @@WITHOUT_HEADER: false\n" +
+			"              @@TYPED_HEADER: false\n" +
 			"                @@LINE_BREAK: LF\n" +
 			"               @@ENCLOSE_ALL: false\n" +
+			"                @@QUOTE_MODE: MINIMAL\n" +
+			"                @@EXCEL_SAFE: false\n" +
+			"         @@EXCEL_SAFE_PREFIX: (ignored) QUOTE\n" +
 			"               @@JSON_ESCAPE: (ignored) BACKSLASH\n" +
 			"              @@PRETTY_PRINT: (ignored) false\n" +
+			"                  @@JSON_KEY: (ignored) (not set)\n" +
+			"             @@JSON_KEY_LAST: (ignored) false\n" +
+			"                 @@JSON_FLAT: (ignored) false\n" +
+			"                 @@NULL_TEXT: (ignored) (empty)\n" +
+			"                @@HTML_CLASS: (ignored) (not set)\n" +
+			"            @@XML_ATTRIBUTES: (ignored) false\n" +
+			"          @@MAX_COLUMN_WIDTH: (ignored) (no limit)\n" +
+			"                 @@WRAP_TEXT: (ignored) false\n" +
+			"               @@BOX_DRAWING: (ignored) false\n" +
+			"                @@TABLE_NAME: (ignored) (not set)\n" +
+			"         @@INSERT_BATCH_SIZE: (ignored) 1\n" +
+			"          @@IDENTIFIER_QUOTE: (ignored) DOUBLE\n" +
+			"                  @@TEMPLATE: (ignored) (not set)\n" +
+			"       @@PARQUET_COMPRESSION: (ignored) SNAPPY\n" +
+			"       @@PARQUET_ALL_STRINGS: (ignored) false\n" +
+			"               @@COMPRESSION: NONE\n" +
+			"         @@COMPRESSION_LEVEL: (ignored) 6\n" +
 			"       @@EAST_ASIAN_ENCODING: (ignored) false\n" +
 			"    @@COUNT_DIACRITICAL_SIGN: (ignored) false\n" +
 			"         @@COUNT_FORMAT_CODE: (ignored) false\n" +
@@ -2188,6 +3776,7 @@ var showObjectsTests = []struct {
 			"           @@LIMIT_RECURSION: 5\n" +
 			"                       @@CPU: " + strconv.Itoa(TestTx.Flags.CPU) + "\n" +
 			"                     @@STATS: false\n" +
+			"                       @@NOW: (current time)\n" +
 			"\n",
 	},
 	{
@@ -2197,13 +3786,14 @@ var showObjectsTests = []struct {
 		Expect: "\n" +
 			strings.Repeat(" ", (calcShowRuninfoWidth(GetWD())-19)/2) + "Runtime Information\n" +
 			strings.Repeat("-", calcShowRuninfoWidth(GetWD())) + "\n" +
-			"       @#UNCOMMITTED: false\n" +
-			"           @#CREATED: 0\n" +
-			"           @#UPDATED: 0\n" +
-			"     @#UPDATED_VIEWS: 0\n" +
-			"     @#LOADED_TABLES: 0\n" +
-			" @#WORKING_DIRECTORY: " + GetWD() + "\n" +
-			"           @#VERSION: v1.0.0\n" +
+			"        @#UNCOMMITTED: false\n" +
+			"            @#CREATED: 0\n" +
+			"            @#UPDATED: 0\n" +
+			"      @#UPDATED_VIEWS: 0\n" +
+			"      @#LOADED_TABLES: 0\n" +
+			"  @#WORKING_DIRECTORY: " + GetWD() + "\n" +
+			"            @#VERSION: v1.0.0\n" +
+			" @#LAST_LOAD_WARNINGS: NULL\n" +
 			"\n",
 	},
 	{
@@ -2539,8 +4129,8 @@ func calcShowFieldsWidth(fileName string, fileNameInTitle string, prefixLen int)
 }
 
 func calcShowRuninfoWidth(wd string) int {
-	w := 28
-	pathLen := 22 + len(wd)
+	w := 39
+	pathLen := 23 + len(wd)
 	if w < pathLen {
 		w = pathLen
 	}
@@ -2596,6 +4186,242 @@ func TestShowFields(t *testing.T) {
 	}
 }
 
+var describeHeader = NewHeader("", []string{
+	"column_name", "position", "type",
+	"integer", "float", "datetime", "boolean", "string", "empty", "null",
+	"min", "max", "distinct", "samples",
+})
+
+var describeTests = []struct {
+	Name   string
+	Expr   parser.Describe
+	Scope  *ReferenceScope
+	Result *View
+	Error  string
+}{
+	{
+		Name: "Describe CSV File",
+		Expr: parser.Describe{
+			Table: parser.Identifier{Literal: "table1"},
+		},
+		Result: &View{
+			Header: describeHeader,
+			RecordSet: RecordSet{
+				NewRecord([]value.Primary{
+					value.NewString("column1"), value.NewInteger(1), value.NewString("integer"),
+					value.NewInteger(3), value.NewInteger(0), value.NewInteger(0), value.NewInteger(0), value.NewInteger(0), value.NewInteger(0), value.NewInteger(0),
+					value.NewInteger(1), value.NewInteger(3), value.NewInteger(3), value.NewString("1, 2, 3"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("column2"), value.NewInteger(2), value.NewString("string"),
+					value.NewInteger(0), value.NewInteger(0), value.NewInteger(0), value.NewInteger(0), value.NewInteger(3), value.NewInteger(0), value.NewInteger(0),
+					value.NewString("str1"), value.NewString("str3"), value.NewInteger(3), value.NewString("str1, str2, str3"),
+				}),
+			},
+		},
+	},
+	{
+		Name: "Describe CSV File with Sample",
+		Expr: parser.Describe{
+			Table:  parser.Identifier{Literal: "table1"},
+			Sample: parser.NewIntegerValue(2),
+		},
+		Result: &View{
+			Header: describeHeader,
+			RecordSet: RecordSet{
+				NewRecord([]value.Primary{
+					value.NewString("column1"), value.NewInteger(1), value.NewString("integer"),
+					value.NewInteger(2), value.NewInteger(0), value.NewInteger(0), value.NewInteger(0), value.NewInteger(0), value.NewInteger(0), value.NewInteger(0),
+					value.NewInteger(1), value.NewInteger(2), value.NewInteger(2), value.NewString("1, 2"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("column2"), value.NewInteger(2), value.NewString("string"),
+					value.NewInteger(0), value.NewInteger(0), value.NewInteger(0), value.NewInteger(0), value.NewInteger(2), value.NewInteger(0), value.NewInteger(0),
+					value.NewString("str1"), value.NewString("str2"), value.NewInteger(2), value.NewString("str1, str2"),
+				}),
+			},
+		},
+	},
+	{
+		Name: "Describe CSV File with Sample Not Reading Following Records",
+		Expr: parser.Describe{
+			Table:  parser.Identifier{Literal: "table_broken"},
+			Sample: parser.NewIntegerValue(1),
+		},
+		Result: &View{
+			Header: describeHeader,
+			RecordSet: RecordSet{
+				NewRecord([]value.Primary{
+					value.NewString("column1"), value.NewInteger(1), value.NewString("integer"),
+					value.NewInteger(1), value.NewInteger(0), value.NewInteger(0), value.NewInteger(0), value.NewInteger(0), value.NewInteger(0), value.NewInteger(0),
+					value.NewInteger(1), value.NewInteger(1), value.NewInteger(1), value.NewString("1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("column2"), value.NewInteger(2), value.NewString("string"),
+					value.NewInteger(0), value.NewInteger(0), value.NewInteger(0), value.NewInteger(0), value.NewInteger(1), value.NewInteger(0), value.NewInteger(0),
+					value.NewString("str1"), value.NewString("str1"), value.NewInteger(1), value.NewString("str1"),
+				}),
+			},
+		},
+	},
+	{
+		Name: "Describe CSV File without Sample Error",
+		Expr: parser.Describe{
+			Table: parser.Identifier{Literal: "table_broken"},
+		},
+		Error: fmt.Sprintf("data parse error in file %s: line 3: wrong number of fields, 2 expected but 3 found", GetTestFilePath("table_broken.csv")),
+	},
+	{
+		Name: "Describe JSON File",
+		Expr: parser.Describe{
+			Table: parser.TableObject{
+				Type:          parser.Token{Token: parser.JSON, Literal: "json"},
+				FormatElement: parser.NewStringValue(""),
+				Path:          parser.Identifier{Literal: "table"},
+			},
+		},
+		Result: &View{
+			Header: describeHeader,
+			RecordSet: RecordSet{
+				NewRecord([]value.Primary{
+					value.NewString("item1"), value.NewInteger(1), value.NewString("string"),
+					value.NewInteger(0), value.NewInteger(0), value.NewInteger(0), value.NewInteger(0), value.NewInteger(2), value.NewInteger(0), value.NewInteger(0),
+					value.NewString("value1"), value.NewString("value2"), value.NewInteger(2), value.NewString("value1, value2"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("item2"), value.NewInteger(2), value.NewString("integer"),
+					value.NewInteger(2), value.NewInteger(0), value.NewInteger(0), value.NewInteger(0), value.NewInteger(0), value.NewInteger(0), value.NewInteger(0),
+					value.NewInteger(1), value.NewInteger(2), value.NewInteger(2), value.NewString("1, 2"),
+				}),
+			},
+		},
+	},
+	{
+		Name: "Describe Temporary Table",
+		Expr: parser.Describe{
+			Table: parser.Identifier{Literal: "view1"},
+		},
+		Scope: GenerateReferenceScope([]map[string]map[string]interface{}{
+			{
+				scopeNameTempTables: {
+					"VIEW1": &View{
+						Header: NewHeader("view1", []string{"column1", "column2", "column3", "column4", "column5", "column6"}),
+						RecordSet: RecordSet{
+							NewRecord([]value.Primary{
+								value.NewString("1"),
+								value.NewString("1.5"),
+								value.NewNull(),
+								value.NewString("2012-02-03 09:18:15"),
+								value.NewString("true"),
+								value.NewInteger(1),
+							}),
+							NewRecord([]value.Primary{
+								value.NewString("2.5"),
+								value.NewString("1"),
+								value.NewString(""),
+								value.NewString("2012-02-04"),
+								value.NewString("false"),
+								value.NewString("a"),
+							}),
+						},
+						FileInfo: &FileInfo{
+							Path:     "view1",
+							ViewType: ViewTypeTemporaryTable,
+						},
+					},
+				},
+			},
+		}, nil, time.Time{}, nil),
+		Result: &View{
+			Header: describeHeader,
+			RecordSet: RecordSet{
+				NewRecord([]value.Primary{
+					value.NewString("column1"), value.NewInteger(1), value.NewString("float"),
+					value.NewInteger(1), value.NewInteger(1), value.NewInteger(0), value.NewInteger(0), value.NewInteger(0), value.NewInteger(0), value.NewInteger(0),
+					value.NewFloat(1), value.NewFloat(2.5), value.NewInteger(2), value.NewString("1, 2.5"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("column2"), value.NewInteger(2), value.NewString("float"),
+					value.NewInteger(1), value.NewInteger(1), value.NewInteger(0), value.NewInteger(0), value.NewInteger(0), value.NewInteger(0), value.NewInteger(0),
+					value.NewFloat(1), value.NewFloat(1.5), value.NewInteger(2), value.NewString("1.5, 1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("column3"), value.NewInteger(3), value.NewString("null"),
+					value.NewInteger(0), value.NewInteger(0), value.NewInteger(0), value.NewInteger(0), value.NewInteger(0), value.NewInteger(1), value.NewInteger(1),
+					value.NewNull(), value.NewNull(), value.NewInteger(0), value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("column4"), value.NewInteger(4), value.NewString("datetime"),
+					value.NewInteger(0), value.NewInteger(0), value.NewInteger(2), value.NewInteger(0), value.NewInteger(0), value.NewInteger(0), value.NewInteger(0),
+					value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())), value.NewDatetime(time.Date(2012, 2, 4, 0, 0, 0, 0, GetTestLocation())), value.NewInteger(2), value.NewString("2012-02-03 09:18:15, 2012-02-04"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("column5"), value.NewInteger(5), value.NewString("boolean"),
+					value.NewInteger(0), value.NewInteger(0), value.NewInteger(0), value.NewInteger(2), value.NewInteger(0), value.NewInteger(0), value.NewInteger(0),
+					value.NewNull(), value.NewNull(), value.NewInteger(2), value.NewString("true, false"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("column6"), value.NewInteger(6), value.NewString("string"),
+					value.NewInteger(1), value.NewInteger(0), value.NewInteger(0), value.NewInteger(0), value.NewInteger(1), value.NewInteger(0), value.NewInteger(0),
+					value.NewString("1"), value.NewString("a"), value.NewInteger(2), value.NewString("1, a"),
+				}),
+			},
+		},
+	},
+	{
+		Name: "Describe Invalid Sample Number Error",
+		Expr: parser.Describe{
+			Table:  parser.Identifier{Literal: "table1"},
+			Sample: parser.NewIntegerValue(0),
+		},
+		Error: "sample number of records 0 is not a positive integer value",
+	},
+	{
+		Name: "Describe Table Not Loaded Error",
+		Expr: parser.Describe{
+			Table: parser.Identifier{Literal: "notexist"},
+		},
+		Error: "file notexist does not exist",
+	},
+}
+
+func TestDescribe(t *testing.T) {
+	defer func() {
+		_ = TestTx.ReleaseResources()
+		initFlag(TestTx.Flags)
+	}()
+
+	initFlag(TestTx.Flags)
+	TestTx.Flags.Repository = TestDir
+	_ = TestTx.Flags.SetLocation(TestLocation)
+	ctx := context.Background()
+
+	for _, v := range describeTests {
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+
+		if v.Scope == nil {
+			v.Scope = NewReferenceScope(TestTx)
+		}
+
+		result, err := Describe(ctx, v.Scope, v.Expr)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result, v.Result)
+		}
+	}
+}
+
 var setEnvVarTests = []struct {
 	Name   string
 	Expr   parser.SetEnvVar
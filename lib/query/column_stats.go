@@ -0,0 +1,272 @@
+package query
+
+import (
+	"hash/fnv"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+const (
+	distinctCountThreshold = 10000
+	distinctSketchSize     = 1024
+	columnSampleValues     = 3
+	columnSampleJoinSep    = ", "
+)
+
+type columnStats struct {
+	datetimeFormat []string
+
+	inferred string
+	mixed    bool
+
+	integerCount  int
+	floatCount    int
+	datetimeCount int
+	booleanCount  int
+	stringCount   int
+	emptyCount    int
+	nullCount     int
+
+	hasNumber  bool
+	minNumber  float64
+	maxNumber  float64
+	hasInteger bool
+	minInteger int64
+	maxInteger int64
+
+	hasDatetime bool
+	minDatetime time.Time
+	maxDatetime time.Time
+
+	hasString bool
+	minString string
+	maxString string
+
+	distinct *distinctCounter
+	samples  []string
+}
+
+func newColumnStats(datetimeFormat []string) *columnStats {
+	return &columnStats{
+		datetimeFormat: datetimeFormat,
+		distinct:       newDistinctCounter(),
+		samples:        make([]string, 0, columnSampleValues),
+	}
+}
+
+func (s *columnStats) add(p value.Primary) {
+	t := inferValueType(p, s.datetimeFormat)
+
+	switch t {
+	case "":
+		if value.IsNull(p) {
+			s.nullCount++
+		} else {
+			s.emptyCount++
+		}
+		return
+	case "integer":
+		s.integerCount++
+	case "float":
+		s.floatCount++
+	case "datetime":
+		s.datetimeCount++
+	case "boolean", "ternary":
+		s.booleanCount++
+	default:
+		s.stringCount++
+	}
+
+	switch {
+	case s.mixed || t == s.inferred:
+	case len(s.inferred) < 1:
+		s.inferred = t
+	case (s.inferred == "integer" && t == "float") || (s.inferred == "float" && t == "integer"):
+		s.inferred = "float"
+	default:
+		s.mixed = true
+	}
+
+	switch t {
+	case "integer":
+		if i := value.ToInteger(p); !value.IsNull(i) {
+			n := i.(*value.Integer).Raw()
+			if !s.hasInteger || n < s.minInteger {
+				s.minInteger = n
+			}
+			if !s.hasInteger || s.maxInteger < n {
+				s.maxInteger = n
+			}
+			s.hasInteger = true
+		}
+		fallthrough
+	case "float":
+		if f := value.ToFloat(p); !value.IsNull(f) {
+			n := f.(*value.Float).Raw()
+			if !s.hasNumber || n < s.minNumber {
+				s.minNumber = n
+			}
+			if !s.hasNumber || s.maxNumber < n {
+				s.maxNumber = n
+			}
+			s.hasNumber = true
+		}
+	case "datetime":
+		if dt := value.ToDatetime(p, s.datetimeFormat); !value.IsNull(dt) {
+			d := dt.(*value.Datetime).Raw()
+			if !s.hasDatetime || d.Before(s.minDatetime) {
+				s.minDatetime = d
+			}
+			if !s.hasDatetime || s.maxDatetime.Before(d) {
+				s.maxDatetime = d
+			}
+			s.hasDatetime = true
+		}
+	}
+
+	text := columnValueText(p)
+	if !s.hasString || text < s.minString {
+		s.minString = text
+	}
+	if !s.hasString || s.maxString < text {
+		s.maxString = text
+	}
+	s.hasString = true
+
+	if s.distinct.add(text) && len(s.samples) < columnSampleValues {
+		s.samples = append(s.samples, text)
+	}
+}
+
+func (s *columnStats) Type() string {
+	switch {
+	case s.mixed:
+		return "string"
+	case len(s.inferred) < 1:
+		return "null"
+	}
+	return s.inferred
+}
+
+func (s *columnStats) MinMax() (value.Primary, value.Primary) {
+	switch s.Type() {
+	case "integer":
+		return value.NewInteger(s.minInteger), value.NewInteger(s.maxInteger)
+	case "float":
+		return value.NewFloat(s.minNumber), value.NewFloat(s.maxNumber)
+	case "datetime":
+		return value.NewDatetime(s.minDatetime), value.NewDatetime(s.maxDatetime)
+	case "string":
+		return value.NewString(s.minString), value.NewString(s.maxString)
+	}
+	return value.NewNull(), value.NewNull()
+}
+
+func (s *columnStats) Record(name string, position int) Record {
+	minValue, maxValue := s.MinMax()
+
+	var samples value.Primary = value.NewNull()
+	if 0 < len(s.samples) {
+		samples = value.NewString(strings.Join(s.samples, columnSampleJoinSep))
+	}
+
+	return NewRecord([]value.Primary{
+		value.NewString(name),
+		value.NewInteger(int64(position)),
+		value.NewString(s.Type()),
+		value.NewInteger(int64(s.integerCount)),
+		value.NewInteger(int64(s.floatCount)),
+		value.NewInteger(int64(s.datetimeCount)),
+		value.NewInteger(int64(s.booleanCount)),
+		value.NewInteger(int64(s.stringCount)),
+		value.NewInteger(int64(s.emptyCount)),
+		value.NewInteger(int64(s.nullCount)),
+		minValue,
+		maxValue,
+		value.NewInteger(int64(s.distinct.Count())),
+		samples,
+	})
+}
+
+func columnValueText(p value.Primary) string {
+	switch p.(type) {
+	case *value.String:
+		return p.(*value.String).Raw()
+	case *value.Integer, *value.Float:
+		return value.ToString(p).(*value.String).Raw()
+	}
+	return p.String()
+}
+
+// distinctCounter counts distinct values exactly up to distinctCountThreshold,
+// and then estimates the number from the distinctSketchSize smallest hash values.
+type distinctCounter struct {
+	values map[string]struct{}
+	hashes []uint64
+}
+
+func newDistinctCounter() *distinctCounter {
+	return &distinctCounter{
+		values: make(map[string]struct{}),
+	}
+}
+
+// add adds s to the counter and returns true if s is certainly a new value.
+func (c *distinctCounter) add(s string) bool {
+	if c.values != nil {
+		if _, ok := c.values[s]; ok {
+			return false
+		}
+		c.values[s] = struct{}{}
+
+		if distinctCountThreshold < len(c.values) {
+			c.hashes = make([]uint64, 0, distinctSketchSize+1)
+			for v := range c.values {
+				c.addHash(v)
+			}
+			c.values = nil
+		}
+		return true
+	}
+
+	c.addHash(s)
+	return false
+}
+
+func (c *distinctCounter) addHash(s string) {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(s))
+	hv := h.Sum64()
+
+	if len(c.hashes) == distinctSketchSize && c.hashes[distinctSketchSize-1] <= hv {
+		return
+	}
+
+	i := sort.Search(len(c.hashes), func(i int) bool { return hv <= c.hashes[i] })
+	if i < len(c.hashes) && c.hashes[i] == hv {
+		return
+	}
+
+	c.hashes = append(c.hashes, 0)
+	copy(c.hashes[i+1:], c.hashes[i:])
+	c.hashes[i] = hv
+	if distinctSketchSize < len(c.hashes) {
+		c.hashes = c.hashes[:distinctSketchSize]
+	}
+}
+
+func (c *distinctCounter) Count() int {
+	if c.values != nil {
+		return len(c.values)
+	}
+	if len(c.hashes) < distinctSketchSize {
+		return len(c.hashes)
+	}
+
+	kth := float64(c.hashes[distinctSketchSize-1]) / math.MaxUint64
+	return int(math.Round(float64(distinctSketchSize-1) / kth))
+}
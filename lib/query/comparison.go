@@ -1,7 +1,10 @@
 package query
 
 import (
+	"errors"
+	"fmt"
 	"strings"
+	"unicode"
 	"unicode/utf8"
 
 	"github.com/mithrandie/csvq/lib/parser"
@@ -125,6 +128,174 @@ func matchCondition(pattern []rune) (anyRunesMinLen int, anyRunesMaxLen int, sea
 	return anyRunesMinLen, anyRunesMaxLen, searchWord, pattern[patternPos:]
 }
 
+type globTokenType int
+
+const (
+	globLiteral globTokenType = iota
+	globAnyRune
+	globAnyRunes
+	globClass
+)
+
+type globRange struct {
+	From rune
+	To   rune
+}
+
+type globToken struct {
+	Type    globTokenType
+	Rune    rune
+	Ranges  []globRange
+	Negated bool
+}
+
+func (t globToken) matchClass(r rune, caseInsensitive bool) bool {
+	match := false
+	for _, rg := range t.Ranges {
+		if rg.From <= r && r <= rg.To {
+			match = true
+			break
+		}
+		if caseInsensitive {
+			if l := unicode.ToLower(r); rg.From <= l && l <= rg.To {
+				match = true
+				break
+			}
+			if u := unicode.ToUpper(r); rg.From <= u && u <= rg.To {
+				match = true
+				break
+			}
+		}
+	}
+	return match != t.Negated
+}
+
+func (t globToken) match(r rune, caseInsensitive bool) bool {
+	switch t.Type {
+	case globAnyRune:
+		return true
+	case globClass:
+		return t.matchClass(r, caseInsensitive)
+	default:
+		if caseInsensitive {
+			return unicode.ToLower(t.Rune) == unicode.ToLower(r)
+		}
+		return t.Rune == r
+	}
+}
+
+func parseGlobPattern(pattern []rune) ([]globToken, error) {
+	tokens := make([]globToken, 0, len(pattern))
+
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '*':
+			if 0 < len(tokens) && tokens[len(tokens)-1].Type == globAnyRunes {
+				continue
+			}
+			tokens = append(tokens, globToken{Type: globAnyRunes})
+		case '?':
+			tokens = append(tokens, globToken{Type: globAnyRune})
+		case '\\':
+			if i+1 < len(pattern) {
+				i++
+			}
+			tokens = append(tokens, globToken{Type: globLiteral, Rune: pattern[i]})
+		case '[':
+			token, pos, err := parseGlobClass(pattern, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token)
+			i = pos
+		default:
+			tokens = append(tokens, globToken{Type: globLiteral, Rune: pattern[i]})
+		}
+	}
+	return tokens, nil
+}
+
+func parseGlobClass(pattern []rune, start int) (globToken, int, error) {
+	token := globToken{Type: globClass}
+
+	i := start + 1
+	if i < len(pattern) && (pattern[i] == '!' || pattern[i] == '^') {
+		token.Negated = true
+		i++
+	}
+
+	first := true
+	for ; i < len(pattern); i++ {
+		r := pattern[i]
+		if r == ']' && !first {
+			return token, i, nil
+		}
+		first = false
+
+		if r == '\\' && i+1 < len(pattern) {
+			i++
+			r = pattern[i]
+		}
+
+		if i+2 < len(pattern) && pattern[i+1] == '-' && pattern[i+2] != ']' {
+			to := pattern[i+2]
+			if to == '\\' && i+3 < len(pattern) {
+				to = pattern[i+3]
+				i++
+			}
+			if to < r {
+				return token, i, errors.New(fmt.Sprintf("invalid character range %c-%c in the pattern", r, to))
+			}
+			token.Ranges = append(token.Ranges, globRange{From: r, To: to})
+			i += 2
+			continue
+		}
+		token.Ranges = append(token.Ranges, globRange{From: r, To: r})
+	}
+
+	return token, i, errors.New("unterminated character class in the pattern")
+}
+
+func matchGlob(text []rune, tokens []globToken, caseInsensitive bool) bool {
+	ti, pi := 0, 0
+	starTokenIdx, starTextIdx := -1, 0
+
+	for ti < len(text) {
+		if pi < len(tokens) && tokens[pi].Type == globAnyRunes {
+			starTokenIdx = pi
+			starTextIdx = ti
+			pi++
+			continue
+		}
+		if pi < len(tokens) && tokens[pi].match(text[ti], caseInsensitive) {
+			ti++
+			pi++
+			continue
+		}
+		if -1 < starTokenIdx {
+			starTextIdx++
+			ti = starTextIdx
+			pi = starTokenIdx + 1
+			continue
+		}
+		return false
+	}
+
+	for pi < len(tokens) && tokens[pi].Type == globAnyRunes {
+		pi++
+	}
+	return pi == len(tokens)
+}
+
+// Glob reports whether str matches the shell-style wildcard pattern.
+func Glob(str string, pattern string, caseInsensitive bool) (ternary.Value, error) {
+	tokens, err := parseGlobPattern([]rune(pattern))
+	if err != nil {
+		return ternary.UNKNOWN, err
+	}
+	return ternary.ConvertFromBool(matchGlob([]rune(str), tokens, caseInsensitive)), nil
+}
+
 func InRowValueList(rowValue value.RowValue, list []value.RowValue, matchType int, operator string, datetimeFormats []string) (ternary.Value, error) {
 	results := make([]ternary.Value, len(list))
 
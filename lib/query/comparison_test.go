@@ -156,6 +156,138 @@ func TestLike(t *testing.T) {
 	}
 }
 
+var globTests = []struct {
+	Str             string
+	Pattern         string
+	CaseInsensitive bool
+	Result          ternary.Value
+	Error           string
+}{
+	{
+		Str:     "report_2020.csv",
+		Pattern: "report_*.csv",
+		Result:  ternary.TRUE,
+	},
+	{
+		Str:     "report_2020.tsv",
+		Pattern: "report_*.csv",
+		Result:  ternary.FALSE,
+	},
+	{
+		Str:     "a.CSV",
+		Pattern: "*.csv",
+		Result:  ternary.FALSE,
+	},
+	{
+		Str:             "a.CSV",
+		Pattern:         "*.csv",
+		CaseInsensitive: true,
+		Result:          ternary.TRUE,
+	},
+	{
+		Str:     "",
+		Pattern: "*",
+		Result:  ternary.TRUE,
+	},
+	{
+		Str:     "abc",
+		Pattern: "a**c",
+		Result:  ternary.TRUE,
+	},
+	{
+		Str:     "abc",
+		Pattern: "a?c",
+		Result:  ternary.TRUE,
+	},
+	{
+		Str:     "ac",
+		Pattern: "a?c",
+		Result:  ternary.FALSE,
+	},
+	{
+		Str:     "b1",
+		Pattern: "[a-c][0-9]",
+		Result:  ternary.TRUE,
+	},
+	{
+		Str:             "B1",
+		Pattern:         "[a-c][0-9]",
+		CaseInsensitive: true,
+		Result:          ternary.TRUE,
+	},
+	{
+		Str:     "x1",
+		Pattern: "[!a-c]?",
+		Result:  ternary.TRUE,
+	},
+	{
+		Str:     "b1",
+		Pattern: "[^a-c]?",
+		Result:  ternary.FALSE,
+	},
+	{
+		Str:     "]",
+		Pattern: "[]]",
+		Result:  ternary.TRUE,
+	},
+	{
+		Str:     "-",
+		Pattern: "[a-]",
+		Result:  ternary.TRUE,
+	},
+	{
+		Str:     "a*",
+		Pattern: "a\\*",
+		Result:  ternary.TRUE,
+	},
+	{
+		Str:     "ab",
+		Pattern: "a\\*",
+		Result:  ternary.FALSE,
+	},
+	{
+		Str:     "日本語.txt",
+		Pattern: "日?語.*",
+		Result:  ternary.TRUE,
+	},
+	{
+		Str:     "abcabd",
+		Pattern: "*ab?",
+		Result:  ternary.TRUE,
+	},
+	{
+		Str:     "a",
+		Pattern: "[a-",
+		Error:   "unterminated character class in the pattern",
+	},
+	{
+		Str:     "a",
+		Pattern: "[z-a]",
+		Error:   "invalid character range z-a in the pattern",
+	},
+}
+
+func TestGlob(t *testing.T) {
+	for _, v := range globTests {
+		r, err := Glob(v.Str, v.Pattern, v.CaseInsensitive)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for (%q glob %q)", err, v.Str, v.Pattern)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for (%q glob %q)", err.Error(), v.Error, v.Str, v.Pattern)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for (%q glob %q)", v.Error, v.Str, v.Pattern)
+			continue
+		}
+		if r != v.Result {
+			t.Errorf("result = %s, want %s for (%q glob %q)", r, v.Result, v.Str, v.Pattern)
+		}
+	}
+}
+
 var inRowValueListTests = []struct {
 	LHS      value.RowValue
 	List     []value.RowValue
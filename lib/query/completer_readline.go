@@ -3,10 +3,13 @@
 package query
 
 import (
+	"bytes"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 	"unicode"
 
@@ -14,6 +17,7 @@ import (
 	"github.com/mithrandie/csvq/lib/parser"
 
 	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/go-text/csv"
 	"github.com/mithrandie/readline-csvq"
 	"github.com/mithrandie/ternary"
 )
@@ -24,6 +28,10 @@ const (
 	dummyTable       = "____table____"
 )
 
+// headerReadSize is the maximum size in bytes to be read from a file to get
+// its field names for completion.
+const headerReadSize = 64 * 1024
+
 var statementPrefix = []string{
 	"WITH",
 	"SELECT",
@@ -46,6 +54,8 @@ var statementPrefix = []string{
 	"CHDIR",
 	"EXECUTE",
 	"SHOW",
+	"DESCRIBE",
+	"EXPLAIN",
 	"SOURCE",
 	"SYNTAX",
 	"RELOAD",
@@ -87,6 +97,7 @@ var tableObjectCandidates = []string{
 }
 
 var exportEncodingsCandidates = []string{
+	"EUCJP",
 	"SJIS",
 	"UTF16",
 	"UTF16BE",
@@ -136,6 +147,11 @@ func (l ReadlineListener) OnChange(line []rune, pos int, key rune) ([]rune, int,
 	return line, pos, false
 }
 
+type completionTable struct {
+	Name  string
+	Alias string
+}
+
 type Completer struct {
 	completer *readline.PrefixCompleter
 	scope     *ReferenceScope
@@ -185,22 +201,36 @@ func NewCompleter(scope *ReferenceScope) *Completer {
 	sort.Strings(completer.flagList)
 	sort.Strings(completer.runinfoList)
 
-	completer.funcs = make([]string, 0, len(Functions)+3)
+	completer.funcs = make([]string, 0, len(Functions)+6)
 	for k := range Functions {
 		completer.funcs = append(completer.funcs, k)
 	}
 	completer.funcs = append(completer.funcs, "CALL")
 	completer.funcs = append(completer.funcs, "NOW")
+	completer.funcs = append(completer.funcs, "FILENAME")
+	completer.funcs = append(completer.funcs, "FILELINE")
+	completer.funcs = append(completer.funcs, "TRANSACTION_TIMESTAMP")
 	completer.funcs = append(completer.funcs, "JSON_OBJECT")
 
-	completer.aggFuncs = make([]string, 0, len(AggregateFunctions)+2)
-	completer.analyticFuncs = make([]string, 0, len(AnalyticFunctions)+len(AggregateFunctions))
+	completer.aggFuncs = make([]string, 0, len(AggregateFunctions)+len(BivariateAggregateFunctions)+len(ConditionalAggregateFunctions)+4)
+	completer.analyticFuncs = make([]string, 0, len(AnalyticFunctions)+len(AggregateFunctions)+len(ConditionalAggregateFunctions))
 	for k := range AggregateFunctions {
 		completer.aggFuncs = append(completer.aggFuncs, k)
 		completer.analyticFuncs = append(completer.analyticFuncs, k)
 	}
+	for k := range BivariateAggregateFunctions {
+		completer.aggFuncs = append(completer.aggFuncs, k)
+	}
+	for k := range ConditionalAggregateFunctions {
+		completer.aggFuncs = append(completer.aggFuncs, k)
+		completer.analyticFuncs = append(completer.analyticFuncs, k)
+	}
 	completer.aggFuncs = append(completer.aggFuncs, "LISTAGG")
+	completer.aggFuncs = append(completer.aggFuncs, "STRING_AGG")
 	completer.aggFuncs = append(completer.aggFuncs, "JSON_AGG")
+	completer.aggFuncs = append(completer.aggFuncs, "MODE")
+	completer.aggFuncs = append(completer.aggFuncs, "TOP_K")
+	completer.aggFuncs = append(completer.aggFuncs, "HISTOGRAM")
 	for k := range AnalyticFunctions {
 		completer.analyticFuncs = append(completer.analyticFuncs, k)
 	}
@@ -222,6 +252,7 @@ func (c *Completer) Update() {
 	c.updateVariables()
 	c.updateEnvironmentVariables()
 	c.updateAllColumns()
+	c.tableColumns = make(map[string][]string)
 
 	completer := readline.NewPrefixCompleter()
 	statements := readline.PcItemDynamic(c.Statements)
@@ -370,6 +401,10 @@ func (c *Completer) Statements(line string, origLine string, index int) readline
 		token = c.tokens[0].Token
 	}
 
+	if _, ok := c.columnQualifier(line, origLine, index); ok && token != parser.EOF {
+		return c.SearchValues(line, origLine, index)
+	}
+
 	switch token {
 	case parser.WITH:
 		return c.WithArgs(line, origLine, index)
@@ -411,6 +446,31 @@ func (c *Completer) Statements(line string, origLine string, index int) readline
 		return c.UsingArgs(line, origLine, index)
 	case parser.SHOW:
 		return c.ShowArgs(line, origLine, index)
+	case parser.DESCRIBE:
+		if 0 < len(line) && len(c.tokens) == 2 || len(line) < 1 && len(c.tokens) == 1 {
+			return c.allTableCandidatesForUpdate(line, origLine, index)
+		} else {
+			return nil
+		}
+	case parser.EXPLAIN:
+		if 0 < len(line) && len(c.tokens) == 2 || len(line) < 1 && len(c.tokens) == 1 {
+			return readline.CandidateList{c.candidate("ANALYZE", true)}
+		} else if 0 < len(line) && len(c.tokens) == 3 || len(line) < 1 && len(c.tokens) == 2 {
+			return readline.CandidateList{
+				c.candidate("APPLY", true),
+				c.candidate("DELETE", true),
+				c.candidate("SELECT", true),
+				c.candidate("UPDATE", true),
+				c.candidate("WITH", true),
+			}
+		} else if 0 < len(line) && len(c.tokens) == 4 || len(line) < 1 && len(c.tokens) == 3 {
+			if strings.EqualFold(c.tokens[2].Literal, "APPLY") {
+				return readline.CandidateList{c.candidate("DELETE", true), c.candidate("UPDATE", true), c.candidate("WITH", true)}
+			}
+			return nil
+		} else {
+			return nil
+		}
 	case parser.SOURCE:
 		return c.SearchExecutableFiles(line, origLine, index)
 	case parser.RELOAD:
@@ -656,7 +716,8 @@ func (c *Completer) functionArgs(line string, origLine string, index int) readli
 							if funcName == "FIRST_VALUE" ||
 								funcName == "LAST_VALUE" ||
 								funcName == "NTH_VALUE" ||
-								(funcName != "LISTAGG" && funcName != "JSON_AGG" && InStrSliceWithCaseInsensitive(funcName, c.aggFuncs)) ||
+								funcName == "RATIO_TO_REPORT" ||
+								(funcName != "LISTAGG" && funcName != "STRING_AGG" && funcName != "JSON_AGG" && funcName != "TOP_K" && funcName != "HISTOGRAM" && InStrSliceWithCaseInsensitive(funcName, c.aggFuncs)) ||
 								InStrSliceWithCaseInsensitive(funcName, c.userAggFuncs) {
 
 								customList = append(customList, c.candidate("ROWS", true))
@@ -1882,7 +1943,7 @@ func (c *Completer) SetArgs(line string, origLine string, index int) readline.Ca
 			case parser.TO:
 				if i == c.lastIdx && c.tokens[c.lastIdx-1].Token == parser.FLAG {
 					switch strings.ToUpper(c.tokens[c.lastIdx-1].Literal) {
-					case cmd.RepositoryFlag:
+					case cmd.RepositoryFlag, cmd.TeeFlag, cmd.TemplateFlag:
 						return nil, c.SearchDirs(line, origLine, index), true
 					case cmd.TimezoneFlag:
 						return nil, c.candidateList([]string{"Local", "UTC"}, false), true
@@ -1896,9 +1957,10 @@ func (c *Completer) SetArgs(line string, origLine string, index int) readline.Ca
 						return nil, c.candidateList(c.encodingList(), false), true
 					case cmd.ExportEncodingFlag:
 						return nil, c.candidateList(exportEncodingsCandidates, false), true
-					case cmd.AnsiQuotesFlag, cmd.NoHeaderFlag, cmd.WithoutNullFlag,
-						cmd.WithoutHeaderFlag, cmd.EncloseAllFlag, cmd.PrettyPrintFlag,
-						cmd.StripEndingLineBreakFlag, cmd.EastAsianEncodingFlag,
+					case cmd.AnsiQuotesFlag, cmd.StableSortFlag, cmd.ConcatNullAsEmptyFlag, cmd.AppendCommitFlag, cmd.AtomicWriteFlag, cmd.DryRunFlag, cmd.IgnoreConstraintsFlag, cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.StrictColumnTypesFlag, cmd.StrictGlobHeaderFlag, cmd.NoInferFlag,
+						cmd.SkipAllCommentsFlag, cmd.TrimFlag, cmd.DetectFlag,
+						cmd.WriteBOMFlag, cmd.ReplaceUnencodableFlag, cmd.WithoutHeaderFlag, cmd.TypedHeaderFlag, cmd.EncloseAllFlag, cmd.ExcelSafeFlag, cmd.PrettyPrintFlag, cmd.JsonKeyLastFlag, cmd.JsonFlatFlag, cmd.XmlAttributesFlag,
+						cmd.WrapTextFlag, cmd.BoxDrawingFlag, cmd.ParquetAllStringsFlag, cmd.StripEndingLineBreakFlag, cmd.EastAsianEncodingFlag,
 						cmd.CountDiacriticalSignFlag, cmd.CountFormatCodeFlag,
 						cmd.ColorFlag, cmd.QuietFlag, cmd.StatsFlag:
 						return nil, c.candidateList([]string{ternary.TRUE.String(), ternary.FALSE.String()}, false), true
@@ -1908,6 +1970,22 @@ func (c *Completer) SetArgs(line string, origLine string, index int) readline.Ca
 						return nil, c.candidateList(c.lineBreakList(), false), true
 					case cmd.JsonEscapeFlag:
 						return nil, c.candidateList(c.jsonEscapeTypeList(), false), true
+					case cmd.CompressionFlag:
+						return nil, c.candidateList([]string{cmd.NoCompression.String(), cmd.GZIP.String()}, false), true
+					case cmd.EscapeStyleFlag:
+						return nil, c.candidateList([]string{cmd.DoubleEscape.String(), cmd.BackslashEscape.String(), cmd.NoEscape.String()}, false), true
+					case cmd.QuoteModeFlag:
+						return nil, c.candidateList([]string{cmd.MinimalQuote.String(), cmd.AllQuote.String(), cmd.NonNumericQuote.String(), cmd.NoQuote.String()}, false), true
+					case cmd.ExcelSafePrefixFlag:
+						return nil, c.candidateList([]string{cmd.QuotePrefix.String(), cmd.TabPrefix.String()}, false), true
+					case cmd.IdentifierQuoteFlag:
+						return nil, c.candidateList([]string{cmd.DoubleQuoteIdentifier.String(), cmd.BacktickIdentifier.String()}, false), true
+					case cmd.ParquetCompressionFlag:
+						return nil, c.candidateList([]string{cmd.SnappyParquetCompression.String(), cmd.ZstdParquetCompression.String(), cmd.NoParquetCompression.String()}, false), true
+					case cmd.NullsOrderFlag:
+						return nil, c.candidateList([]string{cmd.DefaultNullsOrder.String(), cmd.NullsFirst.String(), cmd.NullsLast.String()}, false), true
+					case cmd.RaggedFlag:
+						return nil, c.candidateList([]string{cmd.RaggedError.String(), cmd.RaggedPad.String(), cmd.RaggedTruncate.String(), cmd.RaggedSkip.String()}, false), true
 					}
 				}
 				return nil, c.SearchValues(line, origLine, index), true
@@ -2055,6 +2133,7 @@ func (c *Completer) ShowArgs(line string, origLine string, index int) readline.C
 	var showChild = func() readline.CandidateList {
 		cands := c.candidateList(ShowObjectList, false)
 		cands = append(cands, c.candidate("FIELDS", true))
+		cands = append(cands, c.candidate("COLUMNS", true))
 		cands.Sort()
 		cands = append(cands, c.candidateList(c.flagList, false)...)
 		return cands
@@ -2076,7 +2155,7 @@ func (c *Completer) ShowArgs(line string, origLine string, index int) readline.C
 				case c.lastIdx:
 					return nil, showChild(), true
 				case c.lastIdx - 1:
-					if c.tokens[c.lastIdx].Token == parser.IDENTIFIER && strings.ToUpper(c.tokens[c.lastIdx].Literal) == "FIELDS" {
+					if c.tokens[c.lastIdx].Token == parser.IDENTIFIER && (strings.EqualFold(c.tokens[c.lastIdx].Literal, "FIELDS") || strings.EqualFold(c.tokens[c.lastIdx].Literal, "COLUMNS")) {
 						return []string{"FROM"}, nil, true
 					}
 				}
@@ -2163,6 +2242,16 @@ func (c *Completer) SearchValuesWithSpace(line string, origLine string, index in
 }
 
 func (c *Completer) SearchValues(line string, origLine string, index int) readline.CandidateList {
+	tables := c.ReferencedTables(origLine, index)
+	if qualifier, ok := c.columnQualifier(line, origLine, index); ok {
+		return c.columnCandidates(line, c.qualifiedTables(tables, qualifier), false)
+	}
+	if 0 < len(line) && line[0] == '`' {
+		if cands := c.columnCandidates(line, tables, true); 0 < len(cands) {
+			return cands
+		}
+	}
+
 	if cands := c.EncloseQuotation(line, origLine, index); cands != nil {
 		return cands
 	}
@@ -2199,6 +2288,7 @@ func (c *Completer) SearchValues(line string, origLine string, index int) readli
 			cands = append(cands, readline.Candidate{Name: []rune(s), FormatAsIdentifier: false, AppendSpace: false})
 		}
 	}
+	cands = append(cands, c.columnCandidates(line, tables, true)...)
 
 	list = list[:0]
 	list = append(list,
@@ -2472,9 +2562,236 @@ func (c *Completer) ColumnList(tableName string, repository string) []string {
 		}
 	}
 
+	options := c.scope.Tx.Flags.ImportOptions
+	if fileInfo, err := NewFileInfo(parser.Identifier{Literal: tableName}, repository, options, options.Format); err == nil {
+		fileInfo.NoHeader = options.NoHeader
+		if list := c.readHeader(fileInfo); list != nil {
+			c.tableColumns[tableName] = list
+			return list
+		}
+	}
+
 	return nil
 }
 
+// ReferencedTables returns the tables referred to in FROM, JOIN, UPDATE and
+// INTO clauses of the statement at the cursor position.
+func (c *Completer) ReferencedTables(origLine string, index int) []completionTable {
+	runes := []rune(origLine)
+	if len(runes) < index {
+		index = len(runes)
+	}
+
+	tokens := make([]parser.Token, 0, 20)
+	s := new(parser.Scanner)
+	s.Init(string(runes[:index]), "", c.scope.Tx.Flags.DatetimeFormat, false, c.scope.Tx.Flags.AnsiQuotes)
+	for {
+		t, _ := s.Scan()
+		if t.Token == parser.EOF {
+			break
+		}
+		if t.Token == ';' {
+			tokens = tokens[:0]
+			continue
+		}
+		tokens = append(tokens, t)
+	}
+	s.Init(string(runes[index:]), "", c.scope.Tx.Flags.DatetimeFormat, false, c.scope.Tx.Flags.AnsiQuotes)
+	for {
+		t, _ := s.Scan()
+		if t.Token == parser.EOF || t.Token == ';' {
+			break
+		}
+		tokens = append(tokens, t)
+	}
+
+	var closingIndex = func(i int) int {
+		blockLevel := 0
+		for ; i < len(tokens); i++ {
+			switch tokens[i].Token {
+			case '(':
+				blockLevel++
+			case ')':
+				blockLevel--
+				if blockLevel == 0 {
+					return i
+				}
+			}
+		}
+		return len(tokens) - 1
+	}
+
+	var tables []completionTable
+	for i := 0; i < len(tokens); i++ {
+		switch tokens[i].Token {
+		case parser.FROM, parser.JOIN, parser.UPDATE, parser.INTO:
+		default:
+			continue
+		}
+		enumerable := tokens[i].Token == parser.FROM || tokens[i].Token == parser.UPDATE
+
+	CompleterReferencedTablesLoop:
+		for j := i + 1; j < len(tokens); j++ {
+			name := ""
+			switch {
+			case tokens[j].Token == parser.LATERAL:
+				continue
+			case tokens[j].Token == parser.IDENTIFIER:
+				name = tokens[j].Literal
+			case tokens[j].Token == '(':
+				j = closingIndex(j)
+			case c.isTableObject(tokens[j]) && j+1 < len(tokens) && tokens[j+1].Token == '(':
+				j = closingIndex(j + 1)
+			default:
+				break CompleterReferencedTablesLoop
+			}
+
+			alias := ""
+			if j+1 < len(tokens) && tokens[j+1].Token == parser.AS {
+				j++
+			}
+			if j+1 < len(tokens) && tokens[j+1].Token == parser.IDENTIFIER {
+				j++
+				alias = tokens[j].Literal
+			}
+			if 0 < len(name) {
+				tables = append(tables, completionTable{Name: name, Alias: alias})
+			}
+
+			if !enumerable || len(tokens) <= j+1 || tokens[j+1].Token != ',' {
+				break
+			}
+			j++
+		}
+	}
+	return tables
+}
+
+// columnQualifier returns the table name or the alias followed by a dot
+// just before the word being completed.
+func (c *Completer) columnQualifier(line string, origLine string, index int) (string, bool) {
+	runes := []rune(origLine)
+	end := index - len([]rune(line))
+	if end < 2 || len(runes) < end || runes[end-1] != '.' || unicode.IsSpace(runes[end-2]) {
+		return "", false
+	}
+
+	var last parser.Token
+	s := new(parser.Scanner)
+	s.Init(string(runes[:end-1]), "", c.scope.Tx.Flags.DatetimeFormat, false, c.scope.Tx.Flags.AnsiQuotes)
+	for {
+		t, _ := s.Scan()
+		if t.Token == parser.EOF {
+			break
+		}
+		last = t
+	}
+	if last.Token != parser.IDENTIFIER {
+		return "", false
+	}
+	return last.Literal, true
+}
+
+func (c *Completer) qualifiedTables(tables []completionTable, qualifier string) []completionTable {
+	var list []completionTable
+	for _, t := range tables {
+		if strings.EqualFold(t.Alias, qualifier) ||
+			(len(t.Alias) < 1 && (strings.EqualFold(t.Name, qualifier) || strings.EqualFold(parser.FormatTableName(t.Name), qualifier))) {
+			list = append(list, t)
+		}
+	}
+	return list
+}
+
+// columnCandidates returns the field names of the tables that start with the
+// line. The names of the tables are also returned if withTableNames is true.
+func (c *Completer) columnCandidates(line string, tables []completionTable, withTableNames bool) readline.CandidateList {
+	searchWord := strings.ToUpper(strings.TrimPrefix(line, "`"))
+
+	var cands readline.CandidateList
+	names := make(map[string]bool)
+	var appendCandidate = func(s string) {
+		if _, ok := names[s]; ok || !strings.HasPrefix(strings.ToUpper(s), searchWord) {
+			return
+		}
+		names[s] = true
+		cands = append(cands, readline.Candidate{Name: []rune(s), FormatAsIdentifier: true, AppendSpace: false})
+	}
+
+	for _, t := range tables {
+		for _, s := range c.ColumnList(t.Name, c.scope.Tx.Flags.Repository) {
+			appendCandidate(s)
+		}
+	}
+	if withTableNames {
+		for _, t := range tables {
+			if 0 < len(t.Alias) {
+				appendCandidate(t.Alias)
+			} else {
+				appendCandidate(parser.FormatTableName(t.Name))
+			}
+		}
+	}
+	return cands
+}
+
+// readHeader reads the field names from the beginning of a CSV or TSV file
+// without loading and locking the file.
+func (c *Completer) readHeader(fileInfo *FileInfo) []string {
+	if fileInfo.Format != cmd.CSV && fileInfo.Format != cmd.TSV {
+		return nil
+	}
+	if fileInfo.SplitsFields() || fileInfo.CustomizesQuotes() || skipsLines(c.scope.Tx.Flags.ImportOptions, fileInfo.Format) {
+		return nil
+	}
+	if cmd.CompressionFromExt(fileInfo.Path) != cmd.NoCompression {
+		return nil
+	}
+
+	fp, err := os.Open(fileInfo.Path)
+	if err != nil {
+		return nil
+	}
+	defer func() {
+		_ = fp.Close()
+	}()
+
+	buf := make([]byte, headerReadSize)
+	n, err := io.ReadFull(fp, buf)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return nil
+	}
+	r := bytes.NewReader(buf[:n])
+
+	enc, err := detectEncoding(r, fileInfo.Encoding)
+	if err != nil {
+		return nil
+	}
+	reader, err := csv.NewReader(readerForTextLibrary(r, enc))
+	if err != nil {
+		return nil
+	}
+	reader.Delimiter = fileInfo.Delimiter
+
+	if !fileInfo.NoHeader {
+		header, err := reader.ReadHeader()
+		if err != nil {
+			return nil
+		}
+		return header
+	}
+
+	record, err := reader.Read()
+	if err != nil {
+		return nil
+	}
+	header := make([]string, len(record))
+	for i := range record {
+		header[i] = "c" + strconv.Itoa(i+1)
+	}
+	return header
+}
+
 func (*Completer) columnList(view *View) []string {
 	var list []string
 	for _, h := range view.Header {
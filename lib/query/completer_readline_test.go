@@ -135,13 +135,13 @@ func TestCompleter_Update(t *testing.T) {
 	if len(c.runinfoList) != len(RuntimeInformatinList) || !strings.HasPrefix(c.runinfoList[0], cmd.RuntimeInformationSign) {
 		t.Error("runtime information are not set correctly")
 	}
-	if len(c.funcs) != len(Functions)+3 {
+	if len(c.funcs) != len(Functions)+6 {
 		t.Error("functions are not set correctly")
 	}
-	if len(c.aggFuncs) != len(AggregateFunctions)+2 {
+	if len(c.aggFuncs) != len(AggregateFunctions)+len(BivariateAggregateFunctions)+len(ConditionalAggregateFunctions)+6 {
 		t.Error("aggregate functions are not set correctly")
 	}
-	if len(c.analyticFuncs) != len(AnalyticFunctions)+len(AggregateFunctions) {
+	if len(c.analyticFuncs) != len(AnalyticFunctions)+len(AggregateFunctions)+len(ConditionalAggregateFunctions) {
 		t.Error("analytic functions are not set correctly")
 	}
 
@@ -158,13 +158,13 @@ func TestCompleter_Update(t *testing.T) {
 	if len(c.statementList) != 1 {
 		t.Error("statement list is not set correctly")
 	}
-	if len(c.funcList) != len(Functions)+3+1 || !strings.HasSuffix(c.funcList[0], "()") {
+	if len(c.funcList) != len(Functions)+6+1 || !strings.HasSuffix(c.funcList[0], "()") {
 		t.Error("function list is not set correctly")
 	}
-	if len(c.aggFuncList) != len(AggregateFunctions)+2+1 || !strings.HasSuffix(c.aggFuncList[0], "()") {
+	if len(c.aggFuncList) != len(AggregateFunctions)+len(BivariateAggregateFunctions)+len(ConditionalAggregateFunctions)+6+1 || !strings.HasSuffix(c.aggFuncList[0], "()") {
 		t.Error("aggregate function list is not set correctly")
 	}
-	if len(c.analyticFuncList) != len(AnalyticFunctions)+len(AggregateFunctions)+1 || !strings.HasSuffix(c.analyticFuncList[0], "() OVER ()") {
+	if len(c.analyticFuncList) != len(AnalyticFunctions)+len(AggregateFunctions)+len(ConditionalAggregateFunctions)+1 || !strings.HasSuffix(c.analyticFuncList[0], "() OVER ()") {
 		t.Error("analytic function list is not set correctly")
 	}
 	if !reflect.DeepEqual(c.varList, []string{"@var"}) {
@@ -261,10 +261,12 @@ var completerStatementsTests = []completerTest{
 			{Name: []rune("CREATE"), AppendSpace: true},
 			{Name: []rune("DECLARE"), AppendSpace: true},
 			{Name: []rune("DELETE"), AppendSpace: true},
+			{Name: []rune("DESCRIBE"), AppendSpace: true},
 			{Name: []rune("DISPOSE"), AppendSpace: true},
 			{Name: []rune("ECHO"), AppendSpace: true},
 			{Name: []rune("EXECUTE"), AppendSpace: true},
 			{Name: []rune("EXIT")},
+			{Name: []rune("EXPLAIN"), AppendSpace: true},
 			{Name: []rune("FETCH"), AppendSpace: true},
 			{Name: []rune("INSERT"), AppendSpace: true},
 			{Name: []rune("OPEN"), AppendSpace: true},
@@ -483,14 +485,17 @@ var completerStatementsTests = []completerTest{
 		OrigLine: "show c",
 		Index:    6,
 		Expect: append(readline.CandidateList{
+			{Name: []rune("COLUMNS"), AppendSpace: true},
 			{Name: []rune("CURSORS")},
 			{Name: []rune("ENV")},
 			{Name: []rune("FIELDS"), AppendSpace: true},
 			{Name: []rune("FLAGS")},
 			{Name: []rune("FUNCTIONS")},
 			{Name: []rune("RUNINFO")},
+			{Name: []rune("SETTINGS")},
 			{Name: []rune("STATEMENTS")},
 			{Name: []rune("TABLES")},
+			{Name: []rune("VARIABLES")},
 			{Name: []rune("VIEWS")},
 		}, completer.candidateList(completer.flagList, false)...),
 	},
@@ -636,6 +641,16 @@ var completerStatementsTests = []completerTest{
 			{Name: []rune("DISTINCT"), AppendSpace: true},
 		},
 	},
+	{
+		Name:     "Statements Qualified Column",
+		Line:     "",
+		OrigLine: "select 1 from table1 t where t.",
+		Index:    31,
+		Expect: readline.CandidateList{
+			{Name: []rune("column1"), FormatAsIdentifier: true},
+			{Name: []rune("column2"), FormatAsIdentifier: true},
+		},
+	},
 }
 
 func TestCompleter_Statements(t *testing.T) {
@@ -686,6 +701,7 @@ var completerTableObjectArgsTests = []completerTest{
 		Index:    19,
 		Expect: readline.CandidateList{
 			{Name: []rune("AUTO")},
+			{Name: []rune("EUCJP")},
 			{Name: []rune("SJIS")},
 			{Name: []rune("UTF16")},
 			{Name: []rune("UTF16BE")},
@@ -750,6 +766,7 @@ var completerTableObjectArgsTests = []completerTest{
 		Index:    15,
 		Expect: readline.CandidateList{
 			{Name: []rune("AUTO")},
+			{Name: []rune("EUCJP")},
 			{Name: []rune("SJIS")},
 			{Name: []rune("UTF16")},
 			{Name: []rune("UTF16BE")},
@@ -2719,11 +2736,18 @@ var completerAlterArgsTests = []completerTest{
 			{Name: []rune("CSV")},
 			{Name: []rune("FIXED")},
 			{Name: []rune("GFM")},
+			{Name: []rune("HTML")},
 			{Name: []rune("JSON")},
 			{Name: []rune("LTSV")},
+			{Name: []rune("NDJSON")},
 			{Name: []rune("ORG")},
+			{Name: []rune("PARQUET")},
+			{Name: []rune("SQL_INSERT")},
+			{Name: []rune("TEMPLATE")},
 			{Name: []rune("TEXT")},
 			{Name: []rune("TSV")},
+			{Name: []rune("VERTICAL")},
+			{Name: []rune("XML")},
 		},
 	},
 	{
@@ -2732,6 +2756,7 @@ var completerAlterArgsTests = []completerTest{
 		OrigLine: "alter table `newtable.csv` set encoding to ",
 		Index:    42,
 		Expect: readline.CandidateList{
+			{Name: []rune("EUCJP")},
 			{Name: []rune("SJIS")},
 			{Name: []rune("UTF16")},
 			{Name: []rune("UTF16BE")},
@@ -3079,6 +3104,7 @@ var completerSetArgsTests = []completerTest{
 			{Name: []rune("FIXED")},
 			{Name: []rune("JSON")},
 			{Name: []rune("LTSV")},
+			{Name: []rune("NDJSON")},
 			{Name: []rune("TSV")},
 		},
 	},
@@ -3110,6 +3136,7 @@ var completerSetArgsTests = []completerTest{
 		Index:    18,
 		Expect: readline.CandidateList{
 			{Name: []rune("AUTO")},
+			{Name: []rune("EUCJP")},
 			{Name: []rune("SJIS")},
 			{Name: []rune("UTF16")},
 			{Name: []rune("UTF16BE")},
@@ -3126,6 +3153,7 @@ var completerSetArgsTests = []completerTest{
 		OrigLine: "set @@write_encoding to ",
 		Index:    24,
 		Expect: readline.CandidateList{
+			{Name: []rune("EUCJP")},
 			{Name: []rune("SJIS")},
 			{Name: []rune("UTF16")},
 			{Name: []rune("UTF16BE")},
@@ -3155,11 +3183,18 @@ var completerSetArgsTests = []completerTest{
 			{Name: []rune("CSV")},
 			{Name: []rune("FIXED")},
 			{Name: []rune("GFM")},
+			{Name: []rune("HTML")},
 			{Name: []rune("JSON")},
 			{Name: []rune("LTSV")},
+			{Name: []rune("NDJSON")},
 			{Name: []rune("ORG")},
+			{Name: []rune("PARQUET")},
+			{Name: []rune("SQL_INSERT")},
+			{Name: []rune("TEMPLATE")},
 			{Name: []rune("TEXT")},
 			{Name: []rune("TSV")},
+			{Name: []rune("VERTICAL")},
+			{Name: []rune("XML")},
 		},
 	},
 	{
@@ -3427,14 +3462,17 @@ var completerShowArgsTests = []completerTest{
 		OrigLine: "show ",
 		Index:    5,
 		Expect: append(readline.CandidateList{
+			{Name: []rune("COLUMNS"), AppendSpace: true},
 			{Name: []rune("CURSORS")},
 			{Name: []rune("ENV")},
 			{Name: []rune("FIELDS"), AppendSpace: true},
 			{Name: []rune("FLAGS")},
 			{Name: []rune("FUNCTIONS")},
 			{Name: []rune("RUNINFO")},
+			{Name: []rune("SETTINGS")},
 			{Name: []rune("STATEMENTS")},
 			{Name: []rune("TABLES")},
+			{Name: []rune("VARIABLES")},
 			{Name: []rune("VIEWS")},
 		}, completer.candidateList(completer.flagList, false)...),
 	},
@@ -3444,14 +3482,17 @@ var completerShowArgsTests = []completerTest{
 		OrigLine: "show cu",
 		Index:    7,
 		Expect: append(readline.CandidateList{
+			{Name: []rune("COLUMNS"), AppendSpace: true},
 			{Name: []rune("CURSORS")},
 			{Name: []rune("ENV")},
 			{Name: []rune("FIELDS"), AppendSpace: true},
 			{Name: []rune("FLAGS")},
 			{Name: []rune("FUNCTIONS")},
 			{Name: []rune("RUNINFO")},
+			{Name: []rune("SETTINGS")},
 			{Name: []rune("STATEMENTS")},
 			{Name: []rune("TABLES")},
+			{Name: []rune("VARIABLES")},
 			{Name: []rune("VIEWS")},
 		}, completer.candidateList(completer.flagList, false)...),
 	},
@@ -3461,14 +3502,17 @@ var completerShowArgsTests = []completerTest{
 		OrigLine: "show cu",
 		Index:    7,
 		Expect: append(readline.CandidateList{
+			{Name: []rune("COLUMNS"), AppendSpace: true},
 			{Name: []rune("CURSORS")},
 			{Name: []rune("ENV")},
 			{Name: []rune("FIELDS"), AppendSpace: true},
 			{Name: []rune("FLAGS")},
 			{Name: []rune("FUNCTIONS")},
 			{Name: []rune("RUNINFO")},
+			{Name: []rune("SETTINGS")},
 			{Name: []rune("STATEMENTS")},
 			{Name: []rune("TABLES")},
+			{Name: []rune("VARIABLES")},
 			{Name: []rune("VIEWS")},
 		}, completer.candidateList(completer.flagList, false)...),
 	},
@@ -3481,6 +3525,15 @@ var completerShowArgsTests = []completerTest{
 			{Name: []rune("FROM"), AppendSpace: true},
 		},
 	},
+	{
+		Name:     "ShowArgs After COLUMNS",
+		Line:     "",
+		OrigLine: "show columns ",
+		Index:    13,
+		Expect: readline.CandidateList{
+			{Name: []rune("FROM"), AppendSpace: true},
+		},
+	},
 	{
 		Name:     "ShowArgs After FROM",
 		Line:     "",
@@ -3686,6 +3739,81 @@ var completerSearchValuesTests = []completerTest{
 			{Name: []rune("SELECT"), AppendSpace: true},
 		},
 	},
+	{
+		Name:     "SearchValues Columns",
+		Line:     "c",
+		OrigLine: "select c from table1",
+		Index:    8,
+		Expect: readline.CandidateList{
+			{Name: []rune("column1"), FormatAsIdentifier: true},
+			{Name: []rune("column2"), FormatAsIdentifier: true},
+			{Name: []rune("CASE"), AppendSpace: true},
+			{Name: []rune("CURSOR"), AppendSpace: true},
+		},
+	},
+	{
+		Name:     "SearchValues Columns and Aliases",
+		Line:     "n",
+		OrigLine: "select n from `newtable.csv` n join table1 t; select column1 from table1",
+		Index:    8,
+		Expect: readline.CandidateList{
+			{Name: []rune("NOW()")},
+			{Name: []rune("NULL")},
+			{Name: []rune("ncol1"), FormatAsIdentifier: true},
+			{Name: []rune("ncol2"), FormatAsIdentifier: true},
+			{Name: []rune("ncol3"), FormatAsIdentifier: true},
+			{Name: []rune("n"), FormatAsIdentifier: true},
+			{Name: []rune("NOT"), AppendSpace: true},
+		},
+	},
+	{
+		Name:     "SearchValues Columns Qualified by Alias",
+		Line:     "",
+		OrigLine: "select n.ncol1, t. from `newtable.csv` n join table1 as t",
+		Index:    18,
+		Expect: readline.CandidateList{
+			{Name: []rune("column1"), FormatAsIdentifier: true},
+			{Name: []rune("column2"), FormatAsIdentifier: true},
+		},
+	},
+	{
+		Name:     "SearchValues Columns Qualified by Table Name",
+		Line:     "c",
+		OrigLine: "select table1.c from `table1.csv`",
+		Index:    15,
+		Expect: readline.CandidateList{
+			{Name: []rune("column1"), FormatAsIdentifier: true},
+			{Name: []rune("column2"), FormatAsIdentifier: true},
+		},
+	},
+	{
+		Name:     "SearchValues Columns Qualified by Quoted Alias",
+		Line:     "`col",
+		OrigLine: "select `my t`.`col from table1 as `my t`",
+		Index:    18,
+		Expect: readline.CandidateList{
+			{Name: []rune("column1"), FormatAsIdentifier: true},
+			{Name: []rune("column2"), FormatAsIdentifier: true},
+		},
+	},
+	{
+		Name:     "SearchValues Columns in Quoted Identifier",
+		Line:     "`nc",
+		OrigLine: "select `nc from `newtable.csv`",
+		Index:    10,
+		Expect: readline.CandidateList{
+			{Name: []rune("ncol1"), FormatAsIdentifier: true},
+			{Name: []rune("ncol2"), FormatAsIdentifier: true},
+			{Name: []rune("ncol3"), FormatAsIdentifier: true},
+		},
+	},
+	{
+		Name:     "SearchValues Columns of Unknown Qualifier",
+		Line:     "",
+		OrigLine: "select x. from table1",
+		Index:    9,
+		Expect:   readline.CandidateList(nil),
+	},
 }
 
 func TestCompleter_SearchValues(t *testing.T) {
@@ -4061,6 +4189,10 @@ var completerColumnListTests = []struct {
 		TableName: "table1",
 		Expect:    []string{"tcol1", "tcol2", "tcol3"},
 	},
+	{
+		TableName: filepath.Join("sub", "table2"),
+		Expect:    []string{"column3", "column4"},
+	},
 	{
 		TableName: "notexist",
 		Expect:    []string(nil),
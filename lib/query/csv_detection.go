@@ -0,0 +1,195 @@
+package query
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+
+	"github.com/mithrandie/go-text"
+)
+
+// detectionSampleSize is the maximum number of bytes read from the beginning
+// of a file to detect the delimiter and the header.
+const detectionSampleSize = 64 * 1024
+
+var detectionDelimiters = []rune{',', '\t', ';', '|'}
+
+// detectCSVSettings detects the delimiter and the existence of the header line
+// from the sample read from the beginning of the file.
+//
+// The delimiter is chosen from the candidates so that the largest number of
+// records in the sample have the same number of fields, and the number is
+// greater than one. Ties are broken by the number of fields and then by the
+// order of the candidates, so the result is always the same for the same input.
+// If no candidate splits the records, the delimiter passed is returned.
+//
+// The header line is regarded as missing if the values in the first record
+// have the same types or lengths as the values in the following records.
+// If the sample cannot tell, noHeader is returned as it is.
+//
+// The read position of fp is restored to the beginning of the file.
+func detectCSVSettings(fp io.ReadSeeker, enc text.Encoding, delimiter rune, noHeader bool, options cmd.ImportOptions, detectsDelimiter bool, detectsHeader bool) (rune, bool, error) {
+	sample, err := readDetectionSample(fp, enc, options)
+	if err != nil {
+		return delimiter, noHeader, err
+	}
+
+	candidates := []rune{delimiter}
+	if detectsDelimiter {
+		candidates = detectionDelimiters
+	}
+
+	var records [][]text.RawText
+	fieldLen := 0
+	for _, d := range candidates {
+		r := sampleRecords(sample, d, options)
+		if len(r) < 1 || len(r[0]) < 2 {
+			continue
+		}
+		if len(records) < len(r) || (len(records) == len(r) && fieldLen < len(r[0])) {
+			delimiter = d
+			records = r
+			fieldLen = len(r[0])
+		}
+	}
+
+	if detectsHeader && records != nil {
+		switch votes := headerVotes(records); {
+		case 0 < votes:
+			noHeader = false
+		case votes < 0:
+			noHeader = true
+		}
+	}
+
+	return delimiter, noHeader, nil
+}
+
+// readDetectionSample reads at most detectionSampleSize bytes from the beginning
+// of the file and returns them as UTF-8 text without the lines to be skipped.
+// If the sample is cut off in the middle of the file, the last incomplete line
+// is removed.
+func readDetectionSample(fp io.ReadSeeker, enc text.Encoding, options cmd.ImportOptions) (string, error) {
+	enc, err := detectEncoding(fp, enc)
+	if err != nil {
+		return "", err
+	}
+	if _, err = fp.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	limited := &io.LimitedReader{R: fp, N: detectionSampleSize}
+	decoder, err := getTransformDecoder(limited, enc)
+	if err != nil {
+		return "", err
+	}
+	b, err := ioutil.ReadAll(decoder)
+	if _, e := fp.Seek(0, io.SeekStart); e != nil {
+		return "", e
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if limited.N < 1 {
+		if i := bytes.LastIndexByte(b, '\n'); 0 <= i {
+			b = b[:i+1]
+		}
+	}
+
+	lines := strings.SplitAfter(string(b), "\n")
+	if options.SkipLines < len(lines) {
+		lines = lines[options.SkipLines:]
+	} else {
+		lines = nil
+	}
+
+	if 0 < len(options.CommentPrefix) {
+		body := make([]string, 0, len(lines))
+		inBody := false
+		for _, line := range lines {
+			if (!inBody || options.SkipAllComments) && strings.HasPrefix(line, options.CommentPrefix) {
+				continue
+			}
+			inBody = true
+			body = append(body, line)
+		}
+		lines = body
+	}
+
+	return strings.Join(lines, ""), nil
+}
+
+// sampleRecords returns the records read from the sample until a record has
+// a different number of fields from the first record.
+func sampleRecords(sample string, delimiter rune, options cmd.ImportOptions) [][]text.RawText {
+	reader, err := NewQuotedReader(strings.NewReader(sample), text.UTF8)
+	if err != nil {
+		return nil
+	}
+	reader.Delimiter = delimiter
+	reader.QuoteChar = options.QuoteChar
+	reader.EscapeStyle = options.EscapeStyle
+	reader.WithoutNull = true
+
+	records := make([][]text.RawText, 0, 40)
+	for {
+		record, err := reader.Read()
+		if err != nil {
+			break
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// headerVotes compares the first record with the following records column by column.
+// A column whose values in the following records are all numbers or have the same
+// length votes for the header if the first value differs in that respect, and votes
+// against the header otherwise.
+func headerVotes(records [][]text.RawText) int {
+	if len(records) < 2 {
+		return 0
+	}
+
+	votes := 0
+	for i := range records[0] {
+		isNumber := true
+		length := -1
+		for _, record := range records[1:] {
+			s := cmd.TrimSpace(string(record[i]))
+			if isNumber {
+				if _, err := strconv.ParseFloat(s, 64); err != nil {
+					isNumber = false
+				}
+			}
+			if length == -1 {
+				length = utf8.RuneCountInString(s)
+			} else if length != utf8.RuneCountInString(s) {
+				length = -2
+			}
+		}
+
+		s := cmd.TrimSpace(string(records[0][i]))
+		switch {
+		case isNumber:
+			if _, err := strconv.ParseFloat(s, 64); err != nil {
+				votes++
+			} else {
+				votes--
+			}
+		case 0 <= length:
+			if utf8.RuneCountInString(s) != length {
+				votes++
+			} else {
+				votes--
+			}
+		}
+	}
+	return votes
+}
@@ -0,0 +1,175 @@
+package query
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+
+	"github.com/mithrandie/go-text"
+)
+
+var detectCSVSettingsTests = []struct {
+	Name             string
+	Input            string
+	Encoding         text.Encoding
+	Delimiter        rune
+	NoHeader         bool
+	Options          cmd.ImportOptions
+	DetectsDelimiter bool
+	DetectsHeader    bool
+	ResultDelimiter  rune
+	ResultNoHeader   bool
+}{
+	{
+		Name:             "Detect Semicolon",
+		Input:            "id;name;score\n1;alice;3.5\n2;bob;4\n",
+		Delimiter:        ',',
+		DetectsDelimiter: true,
+		DetectsHeader:    true,
+		ResultDelimiter:  ';',
+		ResultNoHeader:   false,
+	},
+	{
+		Name:             "Detect Tab",
+		Input:            "id\tname\n1\ta,b\n2\tc,d\n",
+		Delimiter:        ',',
+		DetectsDelimiter: true,
+		DetectsHeader:    true,
+		ResultDelimiter:  '\t',
+		ResultNoHeader:   false,
+	},
+	{
+		Name:             "Detect Comma with Quoted Semicolons",
+		Input:            "a,b\n1,\"x;y\"\n2,\"z;w\"\n",
+		Delimiter:        ',',
+		DetectsDelimiter: true,
+		DetectsHeader:    true,
+		ResultDelimiter:  ',',
+		ResultNoHeader:   false,
+	},
+	{
+		Name:             "Detect Pipe without Header",
+		Input:            "1|x|2020-01-01\n2|y|2020-01-02\n3|z|2020-01-03\n",
+		Delimiter:        ',',
+		DetectsDelimiter: true,
+		DetectsHeader:    true,
+		ResultDelimiter:  '|',
+		ResultNoHeader:   true,
+	},
+	{
+		Name:             "Delimiter Not Detected",
+		Input:            "1|x|2020-01-01\n2|y|2020-01-02\n3|z|2020-01-03\n",
+		Delimiter:        ',',
+		DetectsDelimiter: false,
+		DetectsHeader:    true,
+		ResultDelimiter:  ',',
+		ResultNoHeader:   false,
+	},
+	{
+		Name:             "Header Not Detected",
+		Input:            "1;x\n2;y\n3;z\n",
+		Delimiter:        ',',
+		DetectsDelimiter: true,
+		DetectsHeader:    false,
+		ResultDelimiter:  ';',
+		ResultNoHeader:   false,
+	},
+	{
+		Name:             "Header Cannot Be Determined",
+		Input:            "a;bc\nxyz;de\n",
+		Delimiter:        ',',
+		NoHeader:         true,
+		DetectsDelimiter: true,
+		DetectsHeader:    true,
+		ResultDelimiter:  ';',
+		ResultNoHeader:   true,
+	},
+	{
+		Name:             "Skip Lines",
+		Input:            "# comment, with comma\nid;name\n1;alice\n2;bob\n",
+		Delimiter:        ',',
+		Options:          cmd.ImportOptions{CommentPrefix: "#"},
+		DetectsDelimiter: true,
+		DetectsHeader:    true,
+		ResultDelimiter:  ';',
+		ResultNoHeader:   false,
+	},
+	{
+		Name:             "Single Column",
+		Input:            "a\nb\n",
+		Delimiter:        ',',
+		DetectsDelimiter: true,
+		DetectsHeader:    true,
+		ResultDelimiter:  ',',
+		ResultNoHeader:   false,
+	},
+	{
+		Name:             "UTF16LE with BOM",
+		Input:            string([]byte{0xff, 0xfe, 'a', 0, ';', 0, 'b', 0, '\n', 0, '1', 0, ';', 0, '2', 0, '\n', 0}),
+		Encoding:         text.AUTO,
+		Delimiter:        ',',
+		DetectsDelimiter: true,
+		DetectsHeader:    true,
+		ResultDelimiter:  ';',
+		ResultNoHeader:   false,
+	},
+}
+
+func TestDetectCSVSettings(t *testing.T) {
+	for _, v := range detectCSVSettingsTests {
+		enc := v.Encoding
+		if enc == 0 {
+			enc = text.UTF8
+		}
+
+		fp := strings.NewReader(v.Input)
+		delimiter, noHeader, err := detectCSVSettings(fp, enc, v.Delimiter, v.NoHeader, v.Options, v.DetectsDelimiter, v.DetectsHeader)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		if delimiter != v.ResultDelimiter {
+			t.Errorf("%s: delimiter = %q, want %q", v.Name, delimiter, v.ResultDelimiter)
+		}
+		if noHeader != v.ResultNoHeader {
+			t.Errorf("%s: no-header = %t, want %t", v.Name, noHeader, v.ResultNoHeader)
+		}
+		if pos, _ := fp.Seek(0, 1); pos != 0 {
+			t.Errorf("%s: read position = %d, want %d", v.Name, pos, 0)
+		}
+	}
+}
+
+type readPositionRecorder struct {
+	*bytes.Reader
+	maxPosition int64
+}
+
+func (r *readPositionRecorder) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	if pos, _ := r.Reader.Seek(0, 1); r.maxPosition < pos {
+		r.maxPosition = pos
+	}
+	return n, err
+}
+
+func TestDetectCSVSettings_SampleSize(t *testing.T) {
+	buf := &bytes.Buffer{}
+	for buf.Len() < detectionSampleSize*2 {
+		buf.WriteString("1;2\n")
+	}
+
+	fp := &readPositionRecorder{Reader: bytes.NewReader(buf.Bytes())}
+	delimiter, _, err := detectCSVSettings(fp, text.UTF8, ',', false, cmd.ImportOptions{}, true, true)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if delimiter != ';' {
+		t.Errorf("delimiter = %q, want %q", delimiter, ';')
+	}
+	if detectionSampleSize < fp.maxPosition {
+		t.Errorf("read position = %d, want at most %d", fp.maxPosition, detectionSampleSize)
+	}
+}
@@ -3,11 +3,19 @@ package query
 import (
 	"bufio"
 	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/xml"
 	"errors"
+	"fmt"
+	"html"
 	"io"
+	"io/ioutil"
 	"strconv"
+	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/json"
@@ -21,21 +29,101 @@ import (
 	"github.com/mithrandie/go-text/ltsv"
 	"github.com/mithrandie/go-text/table"
 	"github.com/mithrandie/ternary"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/transform"
 )
 
 var EmptyResultSetError = errors.New("empty result set")
 var DataEmpty = errors.New("data empty")
 
+// MinVerticalValueWidth is the minimum width of values that are wrapped in
+// VERTICAL format.
+const MinVerticalValueWidth = 20
+
+// ExcelFormulaChars are the characters that make fields interpreted as formulas
+// when they appear at the beginning of the fields.
+const ExcelFormulaChars = "=+-@\t\r"
+
+// Ellipsis is appended to values truncated by @@MAX_COLUMN_WIDTH.
+const Ellipsis = "…"
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (w nopWriteCloser) Close() error {
+	return nil
+}
+
+// NewCompressWriter returns a writer that compresses the data written to w.
+// Closing the returned writer flushes the compressed data, but does not
+// close w.
+func NewCompressWriter(w io.Writer, compression cmd.Compression, level int) (io.WriteCloser, error) {
+	switch compression {
+	case cmd.GZIP:
+		return gzip.NewWriterLevel(w, level)
+	}
+	return nopWriteCloser{w}, nil
+}
+
+// decompressFile returns the decompressed contents of fp and the compression
+// algorithm if fp is compressed. Otherwise, fp is returned as it is.
+func decompressFile(fp io.ReadSeeker) (io.ReadSeeker, cmd.Compression, error) {
+	magic := make([]byte, 2)
+	n, _ := io.ReadFull(fp, magic)
+	if _, err := fp.Seek(0, io.SeekStart); err != nil {
+		return nil, cmd.NoCompression, err
+	}
+	if n < len(magic) || magic[0] != 0x1f || magic[1] != 0x8b {
+		return fp, cmd.NoCompression, nil
+	}
+
+	r, err := gzip.NewReader(fp)
+	if err != nil {
+		return nil, cmd.NoCompression, err
+	}
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, cmd.NoCompression, err
+	}
+	return bytes.NewReader(b), cmd.GZIP, r.Close()
+}
+
 func EncodeView(ctx context.Context, fp io.Writer, view *View, options cmd.ExportOptions, palette *color.Palette) (string, error) {
+	if options.WriteBOM {
+		options.Encoding = encodingWithBOM(options.Encoding)
+	}
+
+	if (options.Encoding == text.SJIS || options.Encoding == cmd.EUCJP) && options.Format != cmd.JSON && options.Format != cmd.NDJSON && options.Format != cmd.PARQUET {
+		var err error
+		if view, err = checkEncodable(view, options.Encoding, options.ReplaceUnencodable); err != nil {
+			return "", err
+		}
+	}
+
 	switch options.Format {
 	case cmd.FIXED:
 		return "", encodeFixedLengthFormat(ctx, fp, view, options)
 	case cmd.JSON:
 		return "", encodeJson(ctx, fp, view, options, palette)
+	case cmd.NDJSON:
+		return "", encodeNdjson(ctx, fp, view, options)
 	case cmd.LTSV:
 		return "", encodeLTSV(ctx, fp, view, options)
+	case cmd.HTML:
+		return "", encodeHTML(ctx, fp, view, options)
+	case cmd.XML:
+		return "", encodeXML(ctx, fp, view, options)
+	case cmd.SQL_INSERT:
+		return "", encodeSQLInsert(ctx, fp, view, options)
+	case cmd.TEMPLATE:
+		return "", encodeTemplate(ctx, fp, view, options)
+	case cmd.PARQUET:
+		return "", encodeParquet(ctx, fp, view, options)
 	case cmd.GFM, cmd.ORG, cmd.TEXT:
 		return encodeText(ctx, fp, view, options, palette)
+	case cmd.VERTICAL:
+		return encodeVertical(ctx, fp, view, options, palette)
 	case cmd.TSV:
 		options.Delimiter = '\t'
 		fallthrough
@@ -44,8 +132,213 @@ func EncodeView(ctx context.Context, fp io.Writer, view *View, options cmd.Expor
 	}
 }
 
+// encodingWithBOM returns the encoding that writes a byte order mark
+// corresponding to enc. Encodings that have no byte order mark are returned as is.
+func encodingWithBOM(enc text.Encoding) text.Encoding {
+	switch enc {
+	case text.UTF8:
+		return text.UTF8M
+	case text.UTF16, text.UTF16BE:
+		return text.UTF16BEM
+	case text.UTF16LE:
+		return text.UTF16LEM
+	}
+	return enc
+}
+
+// encodingWithoutBOM returns the encoding corresponding to enc that does not
+// write a byte order mark.
+func encodingWithoutBOM(enc text.Encoding) text.Encoding {
+	switch enc {
+	case text.UTF8M:
+		return text.UTF8
+	case text.UTF16BEM:
+		return text.UTF16BE
+	case text.UTF16LEM:
+		return text.UTF16LE
+	}
+	return enc
+}
+
+// getTransformDecoder returns a reader that transforms the character encoding
+// of r from enc to UTF-8.
+func getTransformDecoder(r io.Reader, enc text.Encoding) (io.Reader, error) {
+	if enc == cmd.EUCJP {
+		return transform.NewReader(r, japanese.EUCJP.NewDecoder()), nil
+	}
+	return text.GetTransformDecoder(r, enc)
+}
+
+// getTransformWriter returns a writer that transforms the character encoding
+// of the written texts from UTF-8 to enc.
+func getTransformWriter(w io.Writer, enc text.Encoding) (io.Writer, error) {
+	if enc == cmd.EUCJP {
+		return transform.NewWriter(w, japanese.EUCJP.NewEncoder()), nil
+	}
+	return text.GetTransformWriter(w, enc)
+}
+
+// encodeBytes transforms the character encoding of src from UTF-8 to enc.
+func encodeBytes(src []byte, enc text.Encoding) ([]byte, error) {
+	if enc == cmd.EUCJP {
+		return japanese.EUCJP.NewEncoder().Bytes(src)
+	}
+	return text.Encode(src, enc)
+}
+
+// readerForTextLibrary returns the reader and the encoding passed to the readers
+// of the text library. Texts in the encodings that the library does not provide
+// are transformed to UTF-8 in advance.
+func readerForTextLibrary(r io.Reader, enc text.Encoding) (io.Reader, text.Encoding) {
+	if enc == cmd.EUCJP {
+		return transform.NewReader(r, japanese.EUCJP.NewDecoder()), text.UTF8
+	}
+	return r, enc
+}
+
+// writerForTextLibrary returns the writer and the encoding passed to the writers
+// of the text library. Texts in the encodings that the library does not provide
+// are transformed from UTF-8 by the returned writer.
+func writerForTextLibrary(w io.Writer, enc text.Encoding) (io.Writer, text.Encoding) {
+	if enc == cmd.EUCJP {
+		return transform.NewWriter(w, japanese.EUCJP.NewEncoder()), text.UTF8
+	}
+	return w, enc
+}
+
+// checkEncodable verifies that every column name and string value in the view
+// can be encoded in Shift_JIS or EUC-JP.
+// If replace is true, characters that cannot be encoded are replaced with "?"
+// in a copy of the view, and the original view is left unchanged.
+func checkEncodable(view *View, enc text.Encoding, replace bool) (*View, error) {
+	encoder := japanese.ShiftJIS.NewEncoder()
+	if enc == cmd.EUCJP {
+		encoder = japanese.EUCJP.NewEncoder()
+	}
+
+	unencodable := func(s string) (rune, bool) {
+		for _, r := range s {
+			if r == utf8.RuneError {
+				continue
+			}
+			if _, err := encoder.String(string(r)); err != nil {
+				return r, true
+			}
+		}
+		return 0, false
+	}
+
+	replaceString := func(s string) string {
+		buf := make([]rune, 0, len(s))
+		for _, r := range s {
+			if _, ok := unencodable(string(r)); ok {
+				r = '?'
+			}
+			buf = append(buf, r)
+		}
+		return string(buf)
+	}
+
+	var header Header
+	for i := range view.Header {
+		r, ok := unencodable(view.Header[i].Column)
+		if !ok {
+			continue
+		}
+		if !replace {
+			return nil, NewDataEncodingError(fmt.Sprintf("character %q in column name %s cannot be encoded in %s", r, view.Header[i].Column, enc))
+		}
+		if header == nil {
+			header = view.Header.Copy()
+		}
+		header[i].Column = replaceString(header[i].Column)
+	}
+
+	var records RecordSet
+	for i := range view.RecordSet {
+		var record Record
+		for j := range view.RecordSet[i] {
+			s, ok := view.RecordSet[i][j][0].(*value.String)
+			if !ok {
+				continue
+			}
+			r, ok := unencodable(s.Raw())
+			if !ok {
+				continue
+			}
+			if !replace {
+				return nil, NewDataEncodingError(fmt.Sprintf("character %q in record %d, column %s cannot be encoded in %s", r, i+1, view.Header[j].Column, enc))
+			}
+			if records == nil {
+				records = make(RecordSet, len(view.RecordSet))
+				copy(records, view.RecordSet)
+			}
+			if record == nil {
+				record = make(Record, len(view.RecordSet[i]))
+				copy(record, view.RecordSet[i])
+			}
+			record[j] = NewCell(value.NewString(replaceString(s.Raw())))
+		}
+		if record != nil {
+			records[i] = record
+		}
+	}
+
+	if header == nil && records == nil {
+		return view, nil
+	}
+
+	replaced := *view
+	if header != nil {
+		replaced.Header = header
+	}
+	if records != nil {
+		replaced.RecordSet = records
+	}
+	return &replaced, nil
+}
+
+// headerLabels returns the column names written in the header line.
+// If TypedHeader is set, each name is followed by the type of the column
+// in the form of "name:type".
+func headerLabels(view *View, options cmd.ExportOptions) []string {
+	labels := make([]string, view.FieldLen())
+	for i := range view.Header {
+		labels[i] = view.Header[i].Column
+		if options.TypedHeader {
+			labels[i] = labels[i] + ":" + columnTypeName(view, i)
+		}
+	}
+	return labels
+}
+
+// columnTypeName returns the type name of the non-null values in a column.
+// Integers and floats are regarded as floats, and any other combination
+// of different types is regarded as strings.
+func columnTypeName(view *View, idx int) string {
+	t := typeName(value.NewNull())
+	for i := range view.RecordSet {
+		p := view.RecordSet[i][idx][0]
+		if value.IsNull(p) {
+			continue
+		}
+
+		s := typeName(p)
+		switch {
+		case t == "null" || t == s:
+			t = s
+		case (t == "integer" && s == "float") || (t == "float" && s == "integer"):
+			t = "float"
+		default:
+			return "string"
+		}
+	}
+	return t
+}
+
 func encodeCSV(ctx context.Context, fp io.Writer, view *View, options cmd.ExportOptions) error {
-	w, err := csv.NewWriter(fp, options.LineBreak, options.Encoding)
+	tw, enc := writerForTextLibrary(fp, options.Encoding)
+	w, err := csv.NewWriter(tw, options.LineBreak, enc)
 	if err != nil {
 		return NewDataEncodingError(err.Error())
 	}
@@ -54,8 +347,11 @@ func encodeCSV(ctx context.Context, fp io.Writer, view *View, options cmd.Export
 	fields := make([]csv.Field, view.FieldLen())
 
 	if !options.WithoutHeader {
-		for i := range view.Header {
-			fields[i] = csv.NewField(view.Header[i].Column, options.EncloseAll)
+		for i, label := range headerLabels(view, options) {
+			if options.QuoteMode == cmd.NoQuote && !isWritableWithoutQuotes(label, options.Delimiter) {
+				return NewDataEncodingError(fmt.Sprintf("column name %s cannot be written without quotation marks", label))
+			}
+			fields[i] = csv.NewField(excelSafeField(label, options), quotesCSVField(cmd.StringEffect, options))
 		}
 		if err := w.Write(fields); err != nil {
 			return NewSystemError(err.Error())
@@ -72,11 +368,13 @@ func encodeCSV(ctx context.Context, fp io.Writer, view *View, options cmd.Export
 
 		for j := range view.RecordSet[i] {
 			str, effect, _ := ConvertFieldContents(view.RecordSet[i][j][0], false)
-			quote := false
-			if options.EncloseAll && (effect == cmd.StringEffect || effect == cmd.DatetimeEffect) {
-				quote = true
+			if effect == cmd.StringEffect {
+				str = excelSafeField(str, options)
 			}
-			fields[j] = csv.NewField(str, quote)
+			if options.QuoteMode == cmd.NoQuote && !isWritableWithoutQuotes(str, options.Delimiter) {
+				return NewDataEncodingError(fmt.Sprintf("value %q in record %d, column %s cannot be written without quotation marks", str, i+1, view.Header[j].Column))
+			}
+			fields[j] = csv.NewField(str, quotesCSVField(effect, options))
 		}
 		if err := w.Write(fields); err != nil {
 			return NewSystemError(err.Error())
@@ -88,7 +386,55 @@ func encodeCSV(ctx context.Context, fp io.Writer, view *View, options cmd.Export
 	return nil
 }
 
+// quotesCSVField returns true if a field with the effect is enclosed in
+// quotation marks in the quote mode. Fields including the delimiter are
+// enclosed by the writer regardless of the mode.
+func quotesCSVField(effect string, options cmd.ExportOptions) bool {
+	switch options.QuoteMode {
+	case cmd.AllQuote:
+		return effect != cmd.NoEffect
+	case cmd.NonNumericQuote:
+		return effect != cmd.NoEffect && effect != cmd.NumberEffect
+	case cmd.NoQuote:
+		return false
+	default:
+		return options.EncloseAll && (effect == cmd.StringEffect || effect == cmd.DatetimeEffect)
+	}
+}
+
+// excelSafeField prefixes s if it begins with a character that makes
+// spreadsheet applications interpret the field as a formula. Leading quotation
+// marks do not prevent the interpretation, so they are skipped in the check.
+// Strings representing numbers are not changed.
+func excelSafeField(s string, options cmd.ExportOptions) string {
+	if !options.ExcelSafe {
+		return s
+	}
+
+	c := strings.TrimLeft(s, "'\"")
+	if 0 < len(c) && strings.IndexByte(ExcelFormulaChars, c[0]) != -1 && !isNumericString(c) {
+		return options.ExcelSafePrefix.Value() + s
+	}
+	return s
+}
+
+func isNumericString(s string) bool {
+	if !value.MaybeNumber(s) {
+		return false
+	}
+	_, err := strconv.ParseFloat(s, 64)
+	return err == nil
+}
+
+func isWritableWithoutQuotes(s string, delimiter rune) bool {
+	return !strings.ContainsRune(s, delimiter) && !strings.ContainsAny(s, "\r\n")
+}
+
 func encodeFixedLengthFormat(ctx context.Context, fp io.Writer, view *View, options cmd.ExportOptions) error {
+	if options.Encoding == cmd.EUCJP {
+		return NewDataEncodingError("fixed-length format does not support EUCJP")
+	}
+
 	if options.DelimiterPositions == nil {
 		m := fixedlen.NewMeasure()
 		m.Encoding = options.Encoding
@@ -107,8 +453,8 @@ func encodeFixedLengthFormat(ctx context.Context, fp io.Writer, view *View, opti
 			recordStartPos = 1
 
 			fields := make([]fixedlen.Field, fieldLen)
-			for i := range view.Header {
-				fields[i] = fixedlen.NewField(view.Header[i].Column, text.NotAligned)
+			for i, label := range headerLabels(view, options) {
+				fields[i] = fixedlen.NewField(label, text.NotAligned)
 			}
 			fieldList[0] = fields
 			m.Measure(fields)
@@ -161,8 +507,8 @@ func encodeFixedLengthFormat(ctx context.Context, fp io.Writer, view *View, opti
 				return DataEmpty
 			}
 		} else if !options.SingleLine {
-			for i := range view.Header {
-				fields[i] = fixedlen.NewField(view.Header[i].Column, text.NotAligned)
+			for i, label := range headerLabels(view, options) {
+				fields[i] = fixedlen.NewField(label, text.NotAligned)
 			}
 			if err := w.Write(fields); err != nil {
 				return NewDataEncodingError(err.Error())
@@ -191,20 +537,57 @@ func encodeFixedLengthFormat(ctx context.Context, fp io.Writer, view *View, opti
 
 func encodeJson(ctx context.Context, fp io.Writer, view *View, options cmd.ExportOptions, palette *color.Palette) error {
 	header := view.Header.TableColumnNames()
+
+	keyIdx := -1
+	if 0 < len(options.JsonKey) {
+		for i := range header {
+			if strings.EqualFold(header[i], options.JsonKey) {
+				keyIdx = i
+				break
+			}
+		}
+		if keyIdx < 0 {
+			return NewDataEncodingError(fmt.Sprintf("json key column %s does not exist", options.JsonKey))
+		}
+		header = append(header[:keyIdx:keyIdx], header[keyIdx+1:]...)
+	}
+
 	records := make([][]value.Primary, view.RecordLen())
+	var keys []string
+	if -1 < keyIdx {
+		keys = make([]string, view.RecordLen())
+	}
 	for i := range view.RecordSet {
 		if i&15 == 0 && ctx.Err() != nil {
 			return ConvertContextError(ctx.Err())
 		}
 
-		row := make([]value.Primary, view.FieldLen())
+		row := make([]value.Primary, 0, view.FieldLen())
 		for j := range view.RecordSet[i] {
-			row[j] = view.RecordSet[i][j][0]
+			if j == keyIdx {
+				key, effect, _ := ConvertFieldContents(view.RecordSet[i][j][0], false)
+				if effect == cmd.NoEffect {
+					return NewDataEncodingError(fmt.Sprintf("json key in record %d is null", i+1))
+				}
+				keys[i] = key
+				continue
+			}
+			row = append(row, view.RecordSet[i][j][0])
 		}
 		records[i] = row
 	}
 
-	data, err := json.ConvertTableValueToJsonStructure(ctx, header, records)
+	pathes, err := jsonPathes(header, options)
+	if err != nil {
+		return err
+	}
+
+	var data txjson.Structure
+	if -1 < keyIdx {
+		data, err = json.ConvertTableValueToJsonObject(ctx, keys, pathes, records, options.JsonKeyLast)
+	} else {
+		data, err = json.ConvertTableValueToJsonStructure(ctx, pathes, records)
+	}
 	if err != nil {
 		if ctx.Err() != nil {
 			return ConvertContextError(ctx.Err())
@@ -239,6 +622,64 @@ func encodeJson(ctx context.Context, fp io.Writer, view *View, options cmd.Expor
 	return nil
 }
 
+// jsonPathes returns the pathes of the fields in JSON output. Dots in the field
+// names are interpreted as nesting unless JsonFlat is specified.
+func jsonPathes(fields []string, options cmd.ExportOptions) ([]json.PathExpression, error) {
+	if options.JsonFlat {
+		return json.ParseFlatPathes(fields), nil
+	}
+
+	pathes, err := json.ParsePathes(fields)
+	if err != nil {
+		return nil, NewDataEncodingError(err.Error())
+	}
+	return pathes, nil
+}
+
+func encodeNdjson(ctx context.Context, fp io.Writer, view *View, options cmd.ExportOptions) error {
+	if view.RecordLen() < 1 {
+		return DataEmpty
+	}
+
+	pathes, err := jsonPathes(view.Header.TableColumnNames(), options)
+	if err != nil {
+		return err
+	}
+
+	e := txjson.NewEncoder()
+	e.EscapeType = options.JsonEscape
+	e.LineBreak = options.LineBreak
+
+	w := bufio.NewWriter(fp)
+	row := make([]value.Primary, view.FieldLen())
+	for i := range view.RecordSet {
+		if i&15 == 0 && ctx.Err() != nil {
+			return ConvertContextError(ctx.Err())
+		}
+
+		for j := range view.RecordSet[i] {
+			row[j] = view.RecordSet[i][j][0]
+		}
+		data, err := json.ConvertRecordValueToJsonStructure(pathes, row)
+		if err != nil {
+			return NewDataEncodingError(err.Error())
+		}
+
+		if 0 < i {
+			if _, err = w.WriteString(options.LineBreak.Value()); err != nil {
+				return NewSystemError(err.Error())
+			}
+		}
+		if _, err = w.WriteString(e.Encode(data)); err != nil {
+			return NewSystemError(err.Error())
+		}
+	}
+	if err = w.Flush(); err != nil {
+		return NewSystemError(err.Error())
+	}
+	return nil
+}
+
 func encodeText(ctx context.Context, fp io.Writer, view *View, options cmd.ExportOptions, palette *color.Palette) (string, error) {
 	isPlainTable := false
 
@@ -266,12 +707,28 @@ func encodeText(ctx context.Context, fp io.Writer, view *View, options cmd.Expor
 	e.WithoutHeader = options.WithoutHeader
 	e.Encoding = options.Encoding
 
+	boxDrawing := options.Format == cmd.TEXT && options.BoxDrawing
+	if boxDrawing || options.Encoding == cmd.EUCJP {
+		e.Encoding = text.UTF8
+	}
+
 	fieldLen := view.FieldLen()
 
+	var maxWidths []int
+	if options.Format == cmd.TEXT && 0 < options.MaxColumnWidth {
+		maxWidths = make([]int, fieldLen)
+		for i, label := range headerLabels(view, options) {
+			maxWidths[i] = options.MaxColumnWidth
+			if w := text.Width(label, options.EastAsianEncoding, options.CountDiacriticalSign, options.CountFormatCode); maxWidths[i] < w {
+				maxWidths[i] = w
+			}
+		}
+	}
+
 	if !options.WithoutHeader {
 		hfields := make([]table.Field, fieldLen)
-		for i := range view.Header {
-			hfields[i] = table.NewField(view.Header[i].Column, text.Centering)
+		for i, label := range headerLabels(view, options) {
+			hfields[i] = table.NewField(label, text.Centering)
 		}
 		e.SetHeader(hfields)
 	} else if view.RecordLen() < 1 {
@@ -279,6 +736,7 @@ func encodeText(ctx context.Context, fp io.Writer, view *View, options cmd.Expor
 	}
 
 	aligns := make([]text.FieldAlignment, fieldLen)
+	alignDetermined := make([]bool, fieldLen)
 
 	var textStrBuf bytes.Buffer
 	var textLineBuf bytes.Buffer
@@ -290,7 +748,15 @@ func encodeText(ctx context.Context, fp io.Writer, view *View, options cmd.Expor
 		rfields := make([]table.Field, fieldLen)
 		for j := range view.RecordSet[i] {
 			str, effect, align := ConvertFieldContents(view.RecordSet[i][j][0], isPlainTable)
+			isNull := value.IsNull(view.RecordSet[i][j][0])
+			if isNull && !isPlainTable {
+				str = options.NullText
+			}
 			if options.Format == cmd.TEXT {
+				if maxWidths != nil {
+					str = fitColumnWidth(str, maxWidths[j], options)
+				}
+
 				textStrBuf.Reset()
 				textLineBuf.Reset()
 
@@ -327,8 +793,9 @@ func encodeText(ctx context.Context, fp io.Writer, view *View, options cmd.Expor
 			}
 			rfields[j] = table.NewField(str, align)
 
-			if i == 0 {
+			if !alignDetermined[j] && !isNull {
 				aligns[j] = align
+				alignDetermined[j] = true
 			}
 		}
 		e.AppendRecord(rfields)
@@ -342,6 +809,16 @@ func encodeText(ctx context.Context, fp io.Writer, view *View, options cmd.Expor
 	if err != nil {
 		return "", NewDataEncodingError(err.Error())
 	}
+	if boxDrawing {
+		s = drawBoxBorders(s, options.LineBreak.Value())
+	}
+	if e.Encoding != options.Encoding {
+		b, err := encodeBytes([]byte(s), options.Encoding)
+		if err != nil {
+			return "", NewDataEncodingError(err.Error())
+		}
+		s = string(b)
+	}
 	w := bufio.NewWriter(fp)
 	if _, err = w.WriteString(s); err != nil {
 		return "", NewSystemError(err.Error())
@@ -352,6 +829,207 @@ func encodeText(ctx context.Context, fp io.Writer, view *View, options cmd.Expor
 	return "", nil
 }
 
+func encodeVertical(ctx context.Context, fp io.Writer, view *View, options cmd.ExportOptions, palette *color.Palette) (string, error) {
+	if view.FieldLen() < 1 {
+		return "Empty Fields", EmptyResultSetError
+	}
+	if view.RecordLen() < 1 {
+		return "Empty RecordSet", EmptyResultSetError
+	}
+
+	tw, err := getTransformWriter(fp, options.Encoding)
+	if err != nil {
+		return "", NewDataEncodingError(err.Error())
+	}
+	w := bufio.NewWriter(tw)
+	lb := options.LineBreak.Value()
+
+	labels := make([]string, view.FieldLen())
+	labelWidths := make([]int, view.FieldLen())
+	labelWidth := 0
+	for i := range view.Header {
+		labels[i] = view.Header[i].Column
+		labelWidths[i] = text.Width(labels[i], options.EastAsianEncoding, options.CountDiacriticalSign, options.CountFormatCode)
+		if labelWidth < labelWidths[i] {
+			labelWidth = labelWidths[i]
+		}
+	}
+	indent := strings.Repeat(" ", labelWidth+2)
+
+	valueWidth := 0
+	if 0 < options.MaxWidth {
+		valueWidth = options.MaxWidth - len(indent)
+		if valueWidth < MinVerticalValueWidth {
+			valueWidth = MinVerticalValueWidth
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	for i := range view.RecordSet {
+		if i&15 == 0 && ctx.Err() != nil {
+			return "", ConvertContextError(ctx.Err())
+		}
+
+		buf.Reset()
+		if 0 < i {
+			buf.WriteString(lb)
+		}
+		buf.WriteString(strings.Repeat("*", 27) + " " + strconv.Itoa(i+1) + ". row " + strings.Repeat("*", 27))
+
+		for j := range view.RecordSet[i] {
+			str, effect, _ := ConvertFieldContents(view.RecordSet[i][j][0], true)
+
+			buf.WriteString(lb)
+			buf.WriteString(strings.Repeat(" ", labelWidth-labelWidths[j]))
+			buf.WriteString(palette.Render(cmd.LableEffect, labels[j]))
+			buf.WriteString(": ")
+			for k, line := range wrapLines(str, valueWidth, options) {
+				if 0 < k {
+					buf.WriteString(lb + indent)
+				}
+				if 0 < len(line) {
+					buf.WriteString(palette.Render(effect, line))
+				}
+			}
+		}
+
+		if _, err = w.Write(buf.Bytes()); err != nil {
+			return "", NewSystemError(err.Error())
+		}
+	}
+
+	if err = w.Flush(); err != nil {
+		return "", NewSystemError(err.Error())
+	}
+	return "", nil
+}
+
+// wrapLines splits s into lines at line breaks, and wraps the lines
+// longer than width. If width is 0, lines are not wrapped.
+func wrapLines(s string, width int, options cmd.ExportOptions) []string {
+	s = strings.Replace(s, "\r\n", "\n", -1)
+	s = strings.Replace(s, "\r", "\n", -1)
+
+	lines := make([]string, 0, 1)
+	for _, line := range strings.Split(s, "\n") {
+		if width < 1 {
+			lines = append(lines, line)
+			continue
+		}
+
+		start := 0
+		w := 0
+		for pos, r := range line {
+			rw := text.RuneWidth(r, options.EastAsianEncoding, options.CountDiacriticalSign, options.CountFormatCode)
+			if width < w+rw && start < pos {
+				lines = append(lines, line[start:pos])
+				start = pos
+				w = 0
+			}
+			w = w + rw
+		}
+		lines = append(lines, line[start:])
+	}
+	return lines
+}
+
+// fitColumnWidth wraps or truncates the lines in s that are longer than width.
+// Truncated lines end with an ellipsis.
+func fitColumnWidth(s string, width int, options cmd.ExportOptions) string {
+	if options.WrapText {
+		return strings.Join(wrapLines(s, width, options), "\n")
+	}
+
+	lines := wrapLines(s, 0, options)
+	ellipsisWidth := text.Width(Ellipsis, options.EastAsianEncoding, options.CountDiacriticalSign, options.CountFormatCode)
+	for i, line := range lines {
+		if text.Width(line, options.EastAsianEncoding, options.CountDiacriticalSign, options.CountFormatCode) <= width {
+			continue
+		}
+
+		w := 0
+		for pos, r := range line {
+			rw := text.RuneWidth(r, options.EastAsianEncoding, options.CountDiacriticalSign, options.CountFormatCode)
+			if width < w+rw+ellipsisWidth {
+				lines[i] = line[:pos] + Ellipsis
+				break
+			}
+			w = w + rw
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// drawBoxBorders replaces the ASCII borders of a text table with box-drawing characters.
+func drawBoxBorders(s string, lineBreak string) string {
+	lines := strings.Split(s, lineBreak)
+	if len(lines) < 1 || !strings.HasPrefix(lines[0], "+") {
+		return s
+	}
+
+	borders := make(map[int]bool)
+	for i, c := range lines[0] {
+		if c == '+' {
+			borders[i] = true
+		}
+	}
+
+	replaceHR := func(line string, left string, cross string, right string) string {
+		buf := make([]string, 0, len(line))
+		for i, c := range line {
+			switch {
+			case c == '-':
+				buf = append(buf, "─")
+			case i == 0:
+				buf = append(buf, left)
+			case i == len(line)-1:
+				buf = append(buf, right)
+			default:
+				buf = append(buf, cross)
+			}
+		}
+		return strings.Join(buf, "")
+	}
+
+	for i, line := range lines {
+		if strings.HasPrefix(line, "+") {
+			switch i {
+			case 0:
+				lines[i] = replaceHR(line, "┌", "┬", "┐")
+			case len(lines) - 1:
+				lines[i] = replaceHR(line, "└", "┴", "┘")
+			default:
+				lines[i] = replaceHR(line, "├", "┼", "┤")
+			}
+			continue
+		}
+
+		var buf strings.Builder
+		col := 0
+		inEscSeq := false
+		for _, r := range line {
+			switch {
+			case inEscSeq:
+				if unicode.IsLetter(r) {
+					inEscSeq = false
+				}
+			case r == 27:
+				inEscSeq = true
+			default:
+				if r == '|' && borders[col] {
+					buf.WriteString("│")
+					col++
+					continue
+				}
+				col = col + text.RuneWidth(r, false, false, false)
+			}
+			buf.WriteRune(r)
+		}
+		lines[i] = buf.String()
+	}
+	return strings.Join(lines, lineBreak)
+}
+
 func encodeLTSV(ctx context.Context, fp io.Writer, view *View, options cmd.ExportOptions) error {
 	if view.RecordLen() < 1 {
 		return DataEmpty
@@ -362,7 +1040,8 @@ func encodeLTSV(ctx context.Context, fp io.Writer, view *View, options cmd.Expor
 		hfields[i] = view.Header[i].Column
 	}
 
-	w, err := ltsv.NewWriter(fp, hfields, options.LineBreak, options.Encoding)
+	tw, enc := writerForTextLibrary(fp, options.Encoding)
+	w, err := ltsv.NewWriter(tw, hfields, options.LineBreak, enc)
 	if err != nil {
 		return NewDataEncodingError(err.Error())
 	}
@@ -386,6 +1065,278 @@ func encodeLTSV(ctx context.Context, fp io.Writer, view *View, options cmd.Expor
 	return nil
 }
 
+func encodeHTML(ctx context.Context, fp io.Writer, view *View, options cmd.ExportOptions) error {
+	if options.WithoutHeader && view.RecordLen() < 1 {
+		return DataEmpty
+	}
+
+	tw, err := getTransformWriter(fp, options.Encoding)
+	if err != nil {
+		return NewDataEncodingError(err.Error())
+	}
+	w := bufio.NewWriter(tw)
+	lb := options.LineBreak.Value()
+
+	buf := new(bytes.Buffer)
+	buf.WriteString("<table")
+	if 0 < len(options.HtmlClass) {
+		buf.WriteString(" class=\"" + html.EscapeString(options.HtmlClass) + "\"")
+	}
+	buf.WriteString(">" + lb)
+	if !options.WithoutHeader {
+		buf.WriteString("<thead>" + lb + "<tr>")
+		for _, label := range headerLabels(view, options) {
+			buf.WriteString("<th>" + html.EscapeString(label) + "</th>")
+		}
+		buf.WriteString("</tr>" + lb + "</thead>" + lb)
+	}
+	buf.WriteString("<tbody>" + lb)
+	if _, err = w.Write(buf.Bytes()); err != nil {
+		return NewSystemError(err.Error())
+	}
+
+	for i := range view.RecordSet {
+		if i&15 == 0 && ctx.Err() != nil {
+			return ConvertContextError(ctx.Err())
+		}
+
+		buf.Reset()
+		buf.WriteString("<tr>")
+		for j := range view.RecordSet[i] {
+			val := view.RecordSet[i][j][0]
+			str, effect, _ := ConvertFieldContents(val, false)
+
+			switch {
+			case value.IsNull(val):
+				buf.WriteString("<td class=\"null\">")
+			case effect == cmd.NumberEffect:
+				buf.WriteString("<td class=\"number\">")
+			default:
+				buf.WriteString("<td>")
+			}
+			buf.WriteString(html.EscapeString(str) + "</td>")
+		}
+		buf.WriteString("</tr>" + lb)
+		if _, err = w.Write(buf.Bytes()); err != nil {
+			return NewSystemError(err.Error())
+		}
+	}
+
+	if _, err = w.WriteString("</tbody>" + lb + "</table>"); err != nil {
+		return NewSystemError(err.Error())
+	}
+	if err = w.Flush(); err != nil {
+		return NewSystemError(err.Error())
+	}
+	return nil
+}
+
+func encodeXML(ctx context.Context, fp io.Writer, view *View, options cmd.ExportOptions) error {
+	tw, err := getTransformWriter(fp, options.Encoding)
+	if err != nil {
+		return NewDataEncodingError(err.Error())
+	}
+	w := bufio.NewWriter(tw)
+	lb := options.LineBreak.Value()
+
+	names := make([]string, view.FieldLen())
+	for i := range view.Header {
+		if options.XmlAttributes {
+			names[i] = xmlName(view.Header[i].Column)
+		} else {
+			names[i] = escapeXML(view.Header[i].Column)
+		}
+	}
+
+	if _, err = w.WriteString("<?xml version=\"1.0\" encoding=\"" + xmlEncodingName(options.Encoding) + "\"?>" + lb + "<rows>" + lb); err != nil {
+		return NewSystemError(err.Error())
+	}
+
+	buf := new(bytes.Buffer)
+	for i := range view.RecordSet {
+		if i&15 == 0 && ctx.Err() != nil {
+			return ConvertContextError(ctx.Err())
+		}
+
+		buf.Reset()
+		if options.XmlAttributes {
+			buf.WriteString("  <row")
+			for j := range view.RecordSet[i] {
+				val := view.RecordSet[i][j][0]
+				if value.IsNull(val) {
+					continue
+				}
+				str, _, _ := ConvertFieldContents(val, false)
+				buf.WriteString(" " + names[j] + "=\"" + escapeXML(str) + "\"")
+			}
+			buf.WriteString("/>" + lb)
+		} else {
+			buf.WriteString("  <row>" + lb)
+			for j := range view.RecordSet[i] {
+				val := view.RecordSet[i][j][0]
+				if value.IsNull(val) {
+					buf.WriteString("    <field name=\"" + names[j] + "\" null=\"true\"/>" + lb)
+					continue
+				}
+				str, _, _ := ConvertFieldContents(val, false)
+				buf.WriteString("    <field name=\"" + names[j] + "\">" + escapeXML(str) + "</field>" + lb)
+			}
+			buf.WriteString("  </row>" + lb)
+		}
+		if _, err = w.Write(buf.Bytes()); err != nil {
+			return NewSystemError(err.Error())
+		}
+	}
+
+	if _, err = w.WriteString("</rows>"); err != nil {
+		return NewSystemError(err.Error())
+	}
+	if err = w.Flush(); err != nil {
+		return NewSystemError(err.Error())
+	}
+	return nil
+}
+
+func encodeSQLInsert(ctx context.Context, fp io.Writer, view *View, options cmd.ExportOptions) error {
+	if view.RecordLen() < 1 {
+		return DataEmpty
+	}
+
+	tableName := options.TableName
+	if len(tableName) < 1 && 0 < view.FieldLen() {
+		tableName = view.Header[0].View
+	}
+	if len(tableName) < 1 {
+		return NewDataEncodingError("table name is not specified for SQL_INSERT")
+	}
+
+	tw, err := getTransformWriter(fp, options.Encoding)
+	if err != nil {
+		return NewDataEncodingError(err.Error())
+	}
+	w := bufio.NewWriter(tw)
+	lb := options.LineBreak.Value()
+
+	prefix := "INSERT INTO " + quoteSQLIdentifier(tableName, options.IdentifierQuote)
+	if !options.WithoutHeader {
+		columns := make([]string, view.FieldLen())
+		for i := range view.Header {
+			columns[i] = quoteSQLIdentifier(view.Header[i].Column, options.IdentifierQuote)
+		}
+		prefix = prefix + " (" + strings.Join(columns, ", ") + ")"
+	}
+	prefix = prefix + " VALUES "
+
+	batchSize := options.InsertBatchSize
+	if batchSize < 1 {
+		batchSize = 1
+	}
+
+	buf := new(bytes.Buffer)
+	for i := range view.RecordSet {
+		if i&15 == 0 && ctx.Err() != nil {
+			return ConvertContextError(ctx.Err())
+		}
+
+		buf.Reset()
+		if i%batchSize == 0 {
+			if 0 < i {
+				buf.WriteString(";" + lb)
+			}
+			buf.WriteString(prefix)
+		} else {
+			buf.WriteString(", ")
+		}
+
+		buf.WriteByte('(')
+		for j := range view.RecordSet[i] {
+			if 0 < j {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(sqlLiteral(view.RecordSet[i][j][0]))
+		}
+		buf.WriteByte(')')
+		if _, err = w.Write(buf.Bytes()); err != nil {
+			return NewSystemError(err.Error())
+		}
+	}
+
+	if _, err = w.WriteString(";"); err != nil {
+		return NewSystemError(err.Error())
+	}
+	if err = w.Flush(); err != nil {
+		return NewSystemError(err.Error())
+	}
+	return nil
+}
+
+func quoteSQLIdentifier(s string, quote cmd.IdentifierQuote) string {
+	q := "\""
+	if quote == cmd.BacktickIdentifier {
+		q = "`"
+	}
+	return q + strings.Replace(s, q, q+q, -1) + q
+}
+
+// sqlLiteral returns the representation of val in SQL.
+// Strings and datetimes are enclosed in single quotes, and nulls and unknown are written as NULL.
+func sqlLiteral(val value.Primary) string {
+	str, effect, _ := ConvertFieldContents(val, false)
+	switch effect {
+	case cmd.NumberEffect:
+		return str
+	case cmd.BooleanEffect:
+		return strings.ToUpper(str)
+	case cmd.StringEffect, cmd.DatetimeEffect:
+		return "'" + strings.Replace(str, "'", "''", -1) + "'"
+	default:
+		return "NULL"
+	}
+}
+
+func escapeXML(s string) string {
+	buf := new(bytes.Buffer)
+	_ = xml.EscapeText(buf, []byte(s))
+	return buf.String()
+}
+
+// xmlName converts s to a name that can be used as an attribute name in XML.
+// Characters that are not allowed in names are replaced with underscores.
+func xmlName(s string) string {
+	buf := make([]rune, 0, len(s)+1)
+	for i, r := range s {
+		switch {
+		case r == '_' || unicode.IsLetter(r):
+		case 0 < i && (r == '-' || r == '.' || unicode.IsDigit(r)):
+		case i == 0 && unicode.IsDigit(r):
+			buf = append(buf, '_')
+		default:
+			r = '_'
+		}
+		buf = append(buf, r)
+	}
+	if len(buf) < 1 {
+		return "_"
+	}
+	return string(buf)
+}
+
+func xmlEncodingName(enc text.Encoding) string {
+	switch enc {
+	case text.UTF16, text.UTF16BE:
+		return "UTF-16BE"
+	case text.UTF16LE:
+		return "UTF-16LE"
+	case text.UTF16BEM, text.UTF16LEM:
+		return "UTF-16"
+	case text.SJIS:
+		return "Shift_JIS"
+	case cmd.EUCJP:
+		return "EUC-JP"
+	}
+	return "UTF-8"
+}
+
 func ConvertFieldContents(val value.Primary, forTextTable bool) (string, string, text.FieldAlignment) {
 	var s string
 	var effect = cmd.NoEffect
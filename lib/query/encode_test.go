@@ -2,7 +2,10 @@ package query
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
+	"io/ioutil"
+	"reflect"
 	"testing"
 
 	"github.com/mithrandie/csvq/lib/cmd"
@@ -24,9 +27,28 @@ var encodeViewTests = []struct {
 	WriteDelimiterPositions []int
 	WriteAsSingleLine       bool
 	WithoutHeader           bool
+	TypedHeader             bool
 	EncloseAll              bool
+	QuoteMode               cmd.QuoteMode
+	ExcelSafe               bool
+	ExcelSafePrefix         cmd.ExcelSafePrefix
 	JsonEscape              json.EscapeType
 	PrettyPrint             bool
+	JsonKey                 string
+	JsonKeyLast             bool
+	JsonFlat                bool
+	NullText                string
+	HtmlClass               string
+	XmlAttributes           bool
+	MaxWidth                int
+	MaxColumnWidth          int
+	WrapText                bool
+	BoxDrawing              bool
+	TableName               string
+	InsertBatchSize         int
+	IdentifierQuote         cmd.IdentifierQuote
+	WriteBOM                bool
+	ReplaceUnencodable      bool
 	UseColor                bool
 	Result                  string
 	Error                   string
@@ -93,6 +115,83 @@ var encodeViewTests = []struct {
 			"|        | \033[32mghijkl\033[0m |\n" +
 			"+--------+--------+",
 	},
+	{
+		Name: "Text with MaxColumnWidth",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "long_column_name"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("abcdefghijkl"), value.NewString("abcdefghijklmnopqrstuvwxyz")}),
+				NewRecord([]value.Primary{value.NewString("日本語のテキスト"), value.NewString("abc\ndefghijklmnopqrstuvwxyz")}),
+			},
+		},
+		Format:         cmd.TEXT,
+		MaxColumnWidth: 8,
+		Result: "" +
+			"+----------+------------------+\n" +
+			"|    c1    | long_column_name |\n" +
+			"+----------+------------------+\n" +
+			"| abcdefg… | abcdefghijklmno… |\n" +
+			"| 日本語…  | abc              |\n" +
+			"|          | defghijklmnopqr… |\n" +
+			"+----------+------------------+",
+	},
+	{
+		Name: "Text with MaxColumnWidth and WrapText",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("abcdefghijkl"), value.NewString("日本語のテキスト")}),
+			},
+		},
+		Format:         cmd.TEXT,
+		MaxColumnWidth: 8,
+		WrapText:       true,
+		Result: "" +
+			"+----------+----------+\n" +
+			"|    c1    |    c2    |\n" +
+			"+----------+----------+\n" +
+			"| abcdefgh | 日本語の |\n" +
+			"| ijkl     | テキスト |\n" +
+			"+----------+----------+",
+	},
+	{
+		Name: "Text with BoxDrawing",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a|b")}),
+				NewRecord([]value.Primary{value.NewInteger(2), value.NewString("abc")}),
+			},
+		},
+		Format:     cmd.TEXT,
+		BoxDrawing: true,
+		UseColor:   true,
+		Result: "" +
+			"┌────┬──────┐\n" +
+			"│ c1 │  c2  │\n" +
+			"├────┼──────┤\n" +
+			"│  \033[35m1\033[0m │ \033[32ma|b\033[0m  │\n" +
+			"│  \033[35m2\033[0m │ \033[32mabc\033[0m  │\n" +
+			"└────┴──────┘",
+	},
+	{
+		Name: "Text TypedHeader",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(-1), value.NewString("abc")}),
+				NewRecord([]value.Primary{value.NewInteger(2), value.NewNull()}),
+			},
+		},
+		Format:      cmd.TEXT,
+		TypedHeader: true,
+		Result: "+------------+-----------+\n" +
+			"| c1:integer | c2:string |\n" +
+			"+------------+-----------+\n" +
+			"|         -1 | abc       |\n" +
+			"|          2 |   NULL    |\n" +
+			"+------------+-----------+",
+	},
 	{
 		Name: "Fixed-Length Format",
 		View: &View{
@@ -180,6 +279,23 @@ var encodeViewTests = []struct {
 			"|   2.0123 | 2016-02-01T16:00:00.123456-07:00                                      | abcdef |\r\n" +
 			"| 34567890 |  ab\\|cdefghijklmnopqrstuvwxyzabcdefg<br />hi\"jk日本語あアｱＡ（<br />  |        |",
 	},
+	{
+		Name: "GFM With Null Text",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewNull(), value.NewString("a")}),
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewNull()}),
+			},
+		},
+		Format:   cmd.GFM,
+		NullText: "N/A",
+		Result: "" +
+			"|  c1  |  c2  |\n" +
+			"| ---: | ---- |\n" +
+			"| N/A  | a    |\n" +
+			"|    1 | N/A  |",
+	},
 	{
 		Name: "GFM Data Empty",
 		View: &View{
@@ -217,6 +333,206 @@ var encodeViewTests = []struct {
 		WithoutHeader: true,
 		Error:         "data empty",
 	},
+	{
+		Name: "HTML",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c<2>"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a & b")}),
+				NewRecord([]value.Primary{value.NewFloat(2.5), value.NewNull()}),
+			},
+		},
+		Format:    cmd.HTML,
+		HtmlClass: "report",
+		Result: "" +
+			"<table class=\"report\">\n" +
+			"<thead>\n" +
+			"<tr><th>c1</th><th>c&lt;2&gt;</th></tr>\n" +
+			"</thead>\n" +
+			"<tbody>\n" +
+			"<tr><td class=\"number\">1</td><td>a &amp; b</td></tr>\n" +
+			"<tr><td class=\"number\">2.5</td><td class=\"null\"></td></tr>\n" +
+			"</tbody>\n" +
+			"</table>",
+	},
+	{
+		Name: "HTML Without Header",
+		View: &View{
+			Header: NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("a")}),
+			},
+		},
+		Format:        cmd.HTML,
+		WithoutHeader: true,
+		Result: "" +
+			"<table>\n" +
+			"<tbody>\n" +
+			"<tr><td>a</td></tr>\n" +
+			"</tbody>\n" +
+			"</table>",
+	},
+	{
+		Name: "HTML Data Empty",
+		View: &View{
+			Header:    NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{},
+		},
+		Format:        cmd.HTML,
+		WithoutHeader: true,
+		Error:         "data empty",
+	},
+	{
+		Name: "Vertical",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("abc\ndef")}),
+				NewRecord([]value.Primary{value.NewNull(), value.NewTernary(ternary.UNKNOWN)}),
+			},
+		},
+		Format: cmd.VERTICAL,
+		Result: "" +
+			"*************************** 1. row ***************************\n" +
+			"     c1: 1\n" +
+			"column2: abc\n" +
+			"         def\n" +
+			"*************************** 2. row ***************************\n" +
+			"     c1: NULL\n" +
+			"column2: UNKNOWN",
+	},
+	{
+		Name: "Vertical Wrapped",
+		View: &View{
+			Header: NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("abcdefghijklmnopqrstuvwxyz日本語")}),
+			},
+		},
+		Format:   cmd.VERTICAL,
+		MaxWidth: 26,
+		Result: "" +
+			"*************************** 1. row ***************************\n" +
+			"c1: abcdefghijklmnopqrstuv\n" +
+			"    wxyz日本語",
+	},
+	{
+		Name: "Vertical Empty RecordSet",
+		View: &View{
+			Header:    NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{},
+		},
+		Format: cmd.VERTICAL,
+		Error:  "empty result set",
+	},
+	{
+		Name: "XML",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c\"2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a<b")}),
+				NewRecord([]value.Primary{value.NewInteger(2), value.NewNull()}),
+			},
+		},
+		Format: cmd.XML,
+		Result: "" +
+			"<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n" +
+			"<rows>\n" +
+			"  <row>\n" +
+			"    <field name=\"c1\">1</field>\n" +
+			"    <field name=\"c&#34;2\">a&lt;b</field>\n" +
+			"  </row>\n" +
+			"  <row>\n" +
+			"    <field name=\"c1\">2</field>\n" +
+			"    <field name=\"c&#34;2\" null=\"true\"/>\n" +
+			"  </row>\n" +
+			"</rows>",
+	},
+	{
+		Name: "XML Attributes",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "column 2", "3"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a\"b"), value.NewBoolean(true)}),
+				NewRecord([]value.Primary{value.NewInteger(2), value.NewNull(), value.NewBoolean(false)}),
+			},
+		},
+		Format:        cmd.XML,
+		XmlAttributes: true,
+		WriteEncoding: text.SJIS,
+		Result: "" +
+			"<?xml version=\"1.0\" encoding=\"Shift_JIS\"?>\n" +
+			"<rows>\n" +
+			"  <row c1=\"1\" column_2=\"a&#34;b\" _3=\"true\"/>\n" +
+			"  <row c1=\"2\" _3=\"false\"/>\n" +
+			"</rows>",
+	},
+	{
+		Name: "SQL Insert",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2", "c3", "c4"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("O'Brien"), value.NewBoolean(true), value.NewDatetimeFromString("2016-02-01T16:00:00.123456-07:00", nil)}),
+				NewRecord([]value.Primary{value.NewFloat(2.5), value.NewNull(), value.NewTernary(ternary.UNKNOWN), value.NewNull()}),
+			},
+		},
+		Format:          cmd.SQL_INSERT,
+		InsertBatchSize: 1,
+		Result: "" +
+			"INSERT INTO \"test\" (\"c1\", \"c2\", \"c3\", \"c4\") VALUES (1, 'O''Brien', TRUE, '2016-02-01T16:00:00.123456-07:00');\n" +
+			"INSERT INTO \"test\" (\"c1\", \"c2\", \"c3\", \"c4\") VALUES (2.5, NULL, NULL, NULL);",
+	},
+	{
+		Name: "SQL Insert in Batches",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c`2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a")}),
+				NewRecord([]value.Primary{value.NewInteger(2), value.NewString("b")}),
+				NewRecord([]value.Primary{value.NewInteger(3), value.NewString("c")}),
+			},
+		},
+		Format:          cmd.SQL_INSERT,
+		TableName:       "target",
+		InsertBatchSize: 2,
+		IdentifierQuote: cmd.BacktickIdentifier,
+		Result: "" +
+			"INSERT INTO `target` (`c1`, `c``2`) VALUES (1, 'a'), (2, 'b');\n" +
+			"INSERT INTO `target` (`c1`, `c``2`) VALUES (3, 'c');",
+	},
+	{
+		Name: "SQL Insert Without Header",
+		View: &View{
+			Header: NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1)}),
+			},
+		},
+		Format:          cmd.SQL_INSERT,
+		WithoutHeader:   true,
+		InsertBatchSize: 1,
+		Result:          "INSERT INTO \"test\" VALUES (1);",
+	},
+	{
+		Name: "SQL Insert Empty RecordSet",
+		View: &View{
+			Header:    NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{},
+		},
+		Format: cmd.SQL_INSERT,
+		Error:  "data empty",
+	},
+	{
+		Name: "SQL Insert Table Name Error",
+		View: &View{
+			Header: NewHeader("", []string{"c1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1)}),
+			},
+		},
+		Format: cmd.SQL_INSERT,
+		Error:  "data encode error: table name is not specified for SQL_INSERT",
+	},
 	{
 		Name: "TSV",
 		View: &View{
@@ -263,6 +579,165 @@ var encodeViewTests = []struct {
 			"2.0123,\"2016-02-01T16:00:00.123456-07:00\",\"abcdef\"\n" +
 			"34567890,\" abcdefghijklmnopqrstuvwxyzabcdefg\nhi\"\"jk\n\",",
 	},
+	{
+		Name: "CSV Quote Mode All",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2", "c3"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(-1), value.NewNull(), value.NewBoolean(true)}),
+				NewRecord([]value.Primary{value.NewFloat(2.5), value.NewString("a b"), value.NewDatetimeFromString("2016-02-01T16:00:00-07:00", nil)}),
+			},
+		},
+		Format:    cmd.CSV,
+		QuoteMode: cmd.AllQuote,
+		Result: "\"c1\",\"c2\",\"c3\"\n" +
+			"\"-1\",,\"true\"\n" +
+			"\"2.5\",\"a b\",\"2016-02-01T16:00:00-07:00\"",
+	},
+	{
+		Name: "CSV Excel Safe",
+		View: &View{
+			Header: NewHeader("test", []string{"=c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("=1+1"), value.NewInteger(-1)}),
+				NewRecord([]value.Primary{value.NewString("+1"), value.NewFloat(-2.5)}),
+				NewRecord([]value.Primary{value.NewString("-1"), value.NewNull()}),
+				NewRecord([]value.Primary{value.NewString("@SUM(A1)"), value.NewBoolean(true)}),
+				NewRecord([]value.Primary{value.NewString("\t=1"), value.NewString("a=b")}),
+				NewRecord([]value.Primary{value.NewString("\r=1"), value.NewString("")}),
+				NewRecord([]value.Primary{value.NewString("-3"), value.NewString("+2.5")}),
+				NewRecord([]value.Primary{value.NewString("-1+1"), value.NewString("-")}),
+			},
+		},
+		Format:    cmd.CSV,
+		ExcelSafe: true,
+		Result: "'=c1,c2\n" +
+			"'=1+1,-1\n" +
+			"+1,-2.5\n" +
+			"-1,\n" +
+			"'@SUM(A1),true\n" +
+			"'\t=1,a=b\n" +
+			"'\r=1,\n" +
+			"-3,+2.5\n" +
+			"'-1+1,'-",
+	},
+	{
+		Name: "CSV Excel Safe After Leading Quote",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("'=1+1"), value.NewString("\"+1+1")}),
+				NewRecord([]value.Primary{value.NewString("'-a"), value.NewString("''@A1")}),
+				NewRecord([]value.Primary{value.NewString("'-1"), value.NewString("'abc")}),
+			},
+		},
+		Format:    cmd.CSV,
+		ExcelSafe: true,
+		Result: "c1,c2\n" +
+			"''=1+1,'\"+1+1\n" +
+			"''-a,'''@A1\n" +
+			"'-1,'abc",
+	},
+	{
+		Name: "CSV Excel Safe Enclosed",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("=1+1"), value.NewInteger(-1)}),
+				NewRecord([]value.Primary{value.NewString("@A1"), value.NewString("-")}),
+			},
+		},
+		Format:    cmd.CSV,
+		QuoteMode: cmd.AllQuote,
+		ExcelSafe: true,
+		Result: "\"c1\",\"c2\"\n" +
+			"\"'=1+1\",\"-1\"\n" +
+			"\"'@A1\",\"'-\"",
+	},
+	{
+		Name: "TSV Excel Safe with Tab Prefix",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("+A1"), value.NewInteger(1)}),
+			},
+		},
+		Format:          cmd.TSV,
+		ExcelSafe:       true,
+		ExcelSafePrefix: cmd.TabPrefix,
+		Result: "c1\tc2\n" +
+			"\"\t+A1\"\t1",
+	},
+	{
+		Name: "CSV Quote Mode Non-Numeric",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2", "c3"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(-1), value.NewNull(), value.NewBoolean(true)}),
+				NewRecord([]value.Primary{value.NewFloat(2.5), value.NewString("a b"), value.NewDatetimeFromString("2016-02-01T16:00:00-07:00", nil)}),
+			},
+		},
+		Format:    cmd.CSV,
+		QuoteMode: cmd.NonNumericQuote,
+		Result: "\"c1\",\"c2\",\"c3\"\n" +
+			"-1,,\"true\"\n" +
+			"2.5,\"a b\",\"2016-02-01T16:00:00-07:00\"",
+	},
+	{
+		Name: "CSV Quote Mode None",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2", "c3"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(-1), value.NewNull(), value.NewBoolean(true)}),
+				NewRecord([]value.Primary{value.NewFloat(2.5), value.NewString("a b"), value.NewDatetimeFromString("2016-02-01T16:00:00-07:00", nil)}),
+			},
+		},
+		Format:     cmd.CSV,
+		EncloseAll: true,
+		QuoteMode:  cmd.NoQuote,
+		Result: "c1,c2,c3\n" +
+			"-1,,true\n" +
+			"2.5,a b,2016-02-01T16:00:00-07:00",
+	},
+	{
+		Name: "CSV Quote Mode None Delimiter Error",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a,b")}),
+			},
+		},
+		Format:    cmd.CSV,
+		QuoteMode: cmd.NoQuote,
+		Error:     "data encode error: value \"a,b\" in record 1, column c2 cannot be written without quotation marks",
+	},
+	{
+		Name: "CSV Quote Mode None Column Name Error",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2\nsecond line"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a")}),
+			},
+		},
+		Format:    cmd.CSV,
+		QuoteMode: cmd.NoQuote,
+		Error:     "data encode error: column name c2\nsecond line cannot be written without quotation marks",
+	},
+	{
+		Name: "CSV TypedHeader",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2", "c3", "c4"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(-1), value.NewNull(), value.NewBoolean(true), value.NewString("abc")}),
+				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewNull(), value.NewNull(), value.NewInteger(1)}),
+			},
+		},
+		Format:      cmd.CSV,
+		TypedHeader: true,
+		Result: "c1:float,c2:null,c3:boolean,c4:string\n" +
+			"-1,,true,abc\n" +
+			"2.0123,,,1",
+	},
 	{
 		Name: "CSV Line Break CRLF",
 		View: &View{
@@ -389,6 +864,117 @@ var encodeViewTests = []struct {
 			"  }\n" +
 			"]",
 	},
+	{
+		Name: "JSON Flat",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2.k1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a")}),
+			},
+		},
+		Format:   cmd.JSON,
+		JsonFlat: true,
+		Result:   "[{\"c1\":1,\"c2.k1\":\"a\"}]",
+	},
+	{
+		Name: "JSON Nesting Conflict Error",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c1.k1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a")}),
+			},
+		},
+		Format: cmd.JSON,
+		Error:  "data encode error: field \"c1.k1\" is used as both a value and an object",
+	},
+	{
+		Name: "JSON Keyed by Column",
+		View: &View{
+			Header: NewHeader("test", []string{"id", "c2.k1", "c3"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a"), value.NewBoolean(true)}),
+				NewRecord([]value.Primary{value.NewString("b"), value.NewString("c"), value.NewNull()}),
+			},
+		},
+		Format:  cmd.JSON,
+		JsonKey: "ID",
+		Result:  "{\"1\":{\"c2\":{\"k1\":\"a\"},\"c3\":true},\"b\":{\"c2\":{\"k1\":\"c\"},\"c3\":null}}",
+	},
+	{
+		Name: "JSON Keyed by Column Duplicate Key Last",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "id"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("a"), value.NewInteger(1)}),
+				NewRecord([]value.Primary{value.NewString("b"), value.NewInteger(2)}),
+				NewRecord([]value.Primary{value.NewString("c"), value.NewInteger(1)}),
+			},
+		},
+		Format:      cmd.JSON,
+		JsonKey:     "id",
+		JsonKeyLast: true,
+		Result:      "{\"1\":{\"c1\":\"c\"},\"2\":{\"c1\":\"b\"}}",
+	},
+	{
+		Name: "JSON Keyed by Column Duplicate Key Error",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "id"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("a"), value.NewInteger(1)}),
+				NewRecord([]value.Primary{value.NewString("b"), value.NewInteger(1)}),
+			},
+		},
+		Format:  cmd.JSON,
+		JsonKey: "id",
+		Error:   "data encode error: duplicate key \"1\" in row 2",
+	},
+	{
+		Name: "JSON Keyed by Column Null Key Error",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "id"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("a"), value.NewNull()}),
+			},
+		},
+		Format:  cmd.JSON,
+		JsonKey: "id",
+		Error:   "data encode error: json key in record 1 is null",
+	},
+	{
+		Name: "JSON Keyed by Column Not Exist Error",
+		View: &View{
+			Header: NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("a")}),
+			},
+		},
+		Format:  cmd.JSON,
+		JsonKey: "id",
+		Error:   "data encode error: json key column id does not exist",
+	},
+	{
+		Name: "NDJSON",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2.k1", "c2.k2", "c3"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(-1), value.NewTernary(ternary.UNKNOWN), value.NewString("a"), value.NewBoolean(true)}),
+				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewDatetimeFromString("2016-02-01T16:00:00.123456-07:00", nil), value.NewString("abc\\def"), value.NewNull()}),
+			},
+		},
+		Format:     cmd.NDJSON,
+		JsonEscape: json.HexDigits,
+		Result: "{\"c1\":-1,\"c2\":{\"k1\":null,\"k2\":\"a\"},\"c3\":true}\n" +
+			"{\"c1\":2.0123,\"c2\":{\"k1\":\"2016-02-01T16:00:00.123456-07:00\",\"k2\":\"abc\\u005cdef\"},\"c3\":null}",
+	},
+	{
+		Name: "NDJSON Data Empty",
+		View: &View{
+			Header:    NewHeader("test", []string{"c1", "c2", "c3"}),
+			RecordSet: []Record{},
+		},
+		Format: cmd.NDJSON,
+		Error:  "data empty",
+	},
 	{
 		Name: "LTSV",
 		View: &View{
@@ -482,6 +1068,114 @@ var encodeViewTests = []struct {
 			"2.0123,\"2016-02-01T16:00:00.123456-07:00\",\"abcdef\"\n" +
 			"34567890,\" " + string([]byte{0x93, 0xfa, 0x96, 0x7b, 0x8c, 0xea}) + "ghijklmnopqrstuvwxyzabcdefg\nhi\"\"jk\n\",",
 	},
+	{
+		Name: "CSV Write BOM",
+		View: &View{
+			Header: NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1)}),
+			},
+		},
+		Format:        cmd.CSV,
+		WriteEncoding: text.UTF16LE,
+		WriteBOM:      true,
+		Result:        string([]byte{0xff, 0xfe, 'c', 0x00, '1', 0x00, '\n', 0x00, '1', 0x00}),
+	},
+	{
+		Name: "CSV Encode Unencodable Character Error",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("abc")}),
+				NewRecord([]value.Primary{value.NewInteger(2), value.NewString("a\u00e9c")}),
+			},
+		},
+		Format:        cmd.CSV,
+		WriteEncoding: text.SJIS,
+		Error:         "data encode error: character 'é' in record 2, column c2 cannot be encoded in SJIS",
+	},
+	{
+		Name: "CSV Encode Unencodable Character in Column Name Error",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c\u00e92"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("abc")}),
+			},
+		},
+		Format:        cmd.CSV,
+		WriteEncoding: text.SJIS,
+		Error:         "data encode error: character 'é' in column name c\u00e92 cannot be encoded in SJIS",
+	},
+	{
+		Name: "CSV Encode Replace Unencodable Characters",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c\u00e92"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("abc")}),
+				NewRecord([]value.Primary{value.NewInteger(2), value.NewString("a\u00e9c\u00e9")}),
+			},
+		},
+		Format:             cmd.CSV,
+		WriteEncoding:      text.SJIS,
+		ReplaceUnencodable: true,
+		Result: "c1,c?2\n" +
+			"1,abc\n" +
+			"2,a?c?",
+	},
+	{
+		Name: "CSV Encode EUC-JP",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("日本語")}),
+			},
+		},
+		Format:        cmd.CSV,
+		WriteEncoding: cmd.EUCJP,
+		Result: "c1,c2\n" +
+			"1," + string([]byte{0xc6, 0xfc, 0xcb, 0xdc, 0xb8, 0xec}),
+	},
+	{
+		Name: "XML Encode EUC-JP",
+		View: &View{
+			Header: NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("日本")}),
+			},
+		},
+		Format:        cmd.XML,
+		WriteEncoding: cmd.EUCJP,
+		Result: "<?xml version=\"1.0\" encoding=\"EUC-JP\"?>\n" +
+			"<rows>\n" +
+			"  <row>\n" +
+			"    <field name=\"c1\">" + string([]byte{0xc6, 0xfc, 0xcb, 0xdc}) + "</field>\n" +
+			"  </row>\n" +
+			"</rows>",
+	},
+	{
+		Name: "CSV Encode Unencodable Character in EUC-JP Error",
+		View: &View{
+			Header: NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("a\u20acc")}),
+			},
+		},
+		Format:        cmd.CSV,
+		WriteEncoding: cmd.EUCJP,
+		Error:         "data encode error: character '€' in record 1, column c1 cannot be encoded in EUCJP",
+	},
+	{
+		Name: "Fixed-Length Format Encode EUC-JP Error",
+		View: &View{
+			Header: NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("abc")}),
+			},
+		},
+		Format:        cmd.FIXED,
+		WriteEncoding: cmd.EUCJP,
+		Error:         "data encode error: fixed-length format does not support EUCJP",
+	},
 }
 
 func TestEncodeView(t *testing.T) {
@@ -509,10 +1203,29 @@ func TestEncodeView(t *testing.T) {
 		options.Encoding = v.WriteEncoding
 		options.LineBreak = v.LineBreak
 		options.WithoutHeader = v.WithoutHeader
+		options.TypedHeader = v.TypedHeader
 		options.EncloseAll = v.EncloseAll
+		options.QuoteMode = v.QuoteMode
+		options.ExcelSafe = v.ExcelSafe
+		options.ExcelSafePrefix = v.ExcelSafePrefix
 		options.JsonEscape = v.JsonEscape
 		options.PrettyPrint = v.PrettyPrint
+		options.JsonKey = v.JsonKey
+		options.JsonKeyLast = v.JsonKeyLast
+		options.JsonFlat = v.JsonFlat
+		options.NullText = v.NullText
+		options.HtmlClass = v.HtmlClass
+		options.XmlAttributes = v.XmlAttributes
+		options.MaxColumnWidth = v.MaxColumnWidth
+		options.WrapText = v.WrapText
+		options.BoxDrawing = v.BoxDrawing
+		options.TableName = v.TableName
+		options.InsertBatchSize = v.InsertBatchSize
+		options.IdentifierQuote = v.IdentifierQuote
+		options.MaxWidth = v.MaxWidth
 		options.SingleLine = v.WriteAsSingleLine
+		options.WriteBOM = v.WriteBOM
+		options.ReplaceUnencodable = v.ReplaceUnencodable
 
 		buf.Reset()
 		_, err := EncodeView(ctx, buf, v.View, options, TestTx.Palette)
@@ -535,3 +1248,98 @@ func TestEncodeView(t *testing.T) {
 		}
 	}
 }
+
+func TestEncodeView_ExcelSafe(t *testing.T) {
+	view := &View{
+		Header: NewHeader("test", []string{"c1"}),
+		RecordSet: []Record{
+			NewRecord([]value.Primary{value.NewString("=1+1")}),
+		},
+	}
+	options := cmd.NewExportOptions()
+	options.Format = cmd.CSV
+	options.ExcelSafe = true
+
+	buf := new(bytes.Buffer)
+	if _, err := EncodeView(context.Background(), buf, view, options, TestTx.Palette); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	if s := view.RecordSet[0][0][0].(*value.String).Raw(); s != "=1+1" {
+		t.Errorf("value = %q, want %q", s, "=1+1")
+	}
+
+	fileOptions := (&FileInfo{Format: cmd.CSV}).ExportOptions(TestTx)
+	if fileOptions.ExcelSafe {
+		t.Errorf("excel-safe = %t, want %t for table files", fileOptions.ExcelSafe, false)
+	}
+}
+
+func TestDecompressFile(t *testing.T) {
+	content := "column1,column2\n1,str1\n"
+
+	buf := new(bytes.Buffer)
+	w := gzip.NewWriter(buf)
+	_, _ = w.Write([]byte(content))
+	_ = w.Close()
+
+	r, compression, err := decompressFile(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if compression != cmd.GZIP {
+		t.Errorf("compression = %s, want %s", compression, cmd.GZIP)
+	}
+	if b, _ := ioutil.ReadAll(r); string(b) != content {
+		t.Errorf("result = %q, want %q", string(b), content)
+	}
+
+	r, compression, err = decompressFile(bytes.NewReader([]byte(content)))
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if compression != cmd.NoCompression {
+		t.Errorf("compression = %s, want %s for plain text", compression, cmd.NoCompression)
+	}
+	if b, _ := ioutil.ReadAll(r); string(b) != content {
+		t.Errorf("result = %q, want %q for plain text", string(b), content)
+	}
+}
+
+func TestEncodeView_EUCJPRoundTrip(t *testing.T) {
+	view := &View{
+		Header: NewHeader("test", []string{"c1", "c2"}),
+		RecordSet: []Record{
+			NewRecord([]value.Primary{value.NewString("1"), value.NewString("日本語")}),
+			NewRecord([]value.Primary{value.NewString("2"), value.NewString("ｶﾀｶﾅ")}),
+		},
+	}
+
+	options := TestTx.Flags.ExportOptions.Copy()
+	options.Format = cmd.CSV
+	options.Delimiter = ','
+	options.LineBreak = text.LF
+	options.Encoding = cmd.EUCJP
+
+	buf := &bytes.Buffer{}
+	if _, err := EncodeView(context.Background(), buf, view, options, TestTx.Palette); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	fileInfo := &FileInfo{
+		Path:      "test.csv",
+		Format:    cmd.CSV,
+		Delimiter: ',',
+		Encoding:  cmd.EUCJP,
+	}
+	result, err := loadViewFromCSVFile(context.Background(), bytes.NewReader(buf.Bytes()), fileInfo, false, nil)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if !reflect.DeepEqual(result.RecordSet, view.RecordSet) {
+		t.Errorf("records = %s, want %s", result.RecordSet, view.RecordSet)
+	}
+	if fileInfo.Encoding != cmd.EUCJP {
+		t.Errorf("encoding = %s, want %s", fileInfo.Encoding, cmd.EUCJP)
+	}
+}
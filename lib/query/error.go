@@ -73,8 +73,18 @@ const (
 	ErrMsgFileAlreadyExist                     = "file %s already exists"
 	ErrMsgFileUnableToRead                     = "file %s is unable to be read"
 	ErrMsgFileLockTimeout                      = "file %s: lock wait timeout period exceeded"
+	ErrMsgHttpRequest                          = "failed to fetch %s: %s"
+	ErrMsgHttpStatus                           = "failed to fetch %s: status code %d"
+	ErrMsgHttpSizeExceeded                     = "size of %s exceeds the limit of %d bytes"
+	ErrMsgPartitionLimitExceeded               = "number of output files exceeds the limit of %d"
+	ErrMsgUrlNotUpdatable                      = "tables fetched from urls are read-only"
+	ErrMsgFileGlobNoMatch                      = "no file matches the pattern %s"
+	ErrMsgFileGlobHeaderMismatch               = "columns of file %s do not match columns of file %s for the pattern %s"
+	ErrMsgOutFileHeaderMismatch                = "columns of the result set do not match the header of file %s"
 	ErrMsgFileNameAmbiguous                    = "filename %s is ambiguous"
+	ErrMsgFileNotUpdatable                     = "file %s cannot be updated: %s"
 	ErrMsgDataParsing                          = "data parse error in file %s: %s"
+	ErrMsgColumnTypeFieldNotExist              = "column %s specified in the column types does not exist in any loaded table"
 	ErrMsgDataEncoding                         = "data encode error: %s"
 	ErrMsgTableFieldLength                     = "select query should return exactly %s for table %s"
 	ErrMsgTemporaryTableRedeclared             = "view %s is redeclared"
@@ -91,6 +101,9 @@ const (
 	ErrMsgCombinedSetFieldLength               = "result set to be combined should contain exactly %s"
 	ErrMsgRecursionExceededLimit               = "iteration of recursive query exceeded the limit %d"
 	ErrMsgNestedRecursion                      = "recursive queries are nested"
+	ErrMsgInvalidCycleClause                   = "cycle clause of inline table %s requires a recursive query combined with a set operator"
+	ErrMsgInvalidSearchClause                  = "search clause of inline table %s requires a recursive query combined with UNION"
+	ErrMsgInvalidSearchType                    = "search type %s is invalid"
 	ErrMsgInsertRowValueLength                 = "row value should contain exactly %s"
 	ErrMsgInsertSelectFieldLength              = "select query should return exactly %s"
 	ErrMsgUpdateFieldNotExist                  = "field %s does not exist in the tables to update"
@@ -127,6 +140,16 @@ const (
 	ErrMsgStatementReplaceValueNotSpecified    = "replace value for %s is not specified"
 	ErrMsgSelectIntoQueryFieldLengthNotMatch   = "select into query should return exactly %s"
 	ErrMsgSelectIntoQueryTooManyRecords        = "select into query returns too many records, should return only one record"
+	ErrMsgCaseValueNotSpecified                = "condition %s requires a value to be compared in case expression"
+	ErrMsgBitwiseOperandNotInteger             = "operand %s of operator %s must be an integer"
+	ErrMsgNotNullConstraintViolation           = "field %s in record %d violates not null constraint"
+	ErrMsgUniqueConstraintViolation            = "value %s of field %s in record %d violates unique constraint, it is a duplicate of record %d"
+	ErrMsgCheckConstraintViolation             = "record %d violates check constraint %s"
+	ErrMsgInvalidTableDefinition               = "invalid table definition file %s: %s"
+	ErrMsgInvalidCollation                     = "%q is an invalid collation"
+	ErrMsgInvalidSampleNumber                  = "sample number of records %s is not a positive integer value"
+	ErrMsgStringOperandNotCast                 = "operand %s of operator %s is a string and must be cast explicitly when @@NO_INFER is enabled"
+	ErrMsgStringArgumentNotCast                = "argument %s of function %s is a string and must be cast explicitly when @@NO_INFER is enabled"
 )
 
 type Error interface {
@@ -895,6 +918,76 @@ func NewFileLockTimeoutError(file parser.Identifier) error {
 	}
 }
 
+type HttpRequestError struct {
+	*BaseError
+}
+
+func NewHttpRequestError(url parser.Url, message string) error {
+	return &HttpRequestError{
+		NewBaseError(url, fmt.Sprintf(ErrMsgHttpRequest, url, message), ReturnCodeIOError, ErrorHttpRequest),
+	}
+}
+
+type HttpStatusError struct {
+	*BaseError
+}
+
+func NewHttpStatusError(url parser.Url, statusCode int) error {
+	return &HttpStatusError{
+		NewBaseError(url, fmt.Sprintf(ErrMsgHttpStatus, url, statusCode), ReturnCodeIOError, ErrorHttpStatus),
+	}
+}
+
+type HttpSizeExceededError struct {
+	*BaseError
+}
+
+func NewHttpSizeExceededError(url parser.Url, limit int64) error {
+	return &HttpSizeExceededError{
+		NewBaseError(url, fmt.Sprintf(ErrMsgHttpSizeExceeded, url, limit), ReturnCodeIOError, ErrorHttpSizeExceeded),
+	}
+}
+
+type PartitionLimitExceededError struct {
+	*BaseError
+}
+
+func NewPartitionLimitExceededError(limit int) error {
+	return &PartitionLimitExceededError{
+		NewBaseErrorWithPrefix("", fmt.Sprintf(ErrMsgPartitionLimitExceeded, limit), ReturnCodeIOError, ErrorPartitionLimitExceeded),
+	}
+}
+
+type FileGlobNoMatchError struct {
+	*BaseError
+}
+
+func NewFileGlobNoMatchError(glob parser.FileGlob) error {
+	return &FileGlobNoMatchError{
+		NewBaseError(glob, fmt.Sprintf(ErrMsgFileGlobNoMatch, glob), ReturnCodeApplicationError, ErrorFileGlobNoMatch),
+	}
+}
+
+type FileGlobHeaderMismatchError struct {
+	*BaseError
+}
+
+func NewFileGlobHeaderMismatchError(glob parser.FileGlob, fpath string, basePath string) error {
+	return &FileGlobHeaderMismatchError{
+		NewBaseError(glob, fmt.Sprintf(ErrMsgFileGlobHeaderMismatch, fpath, basePath, glob), ReturnCodeApplicationError, ErrorFileGlobHeaderMismatch),
+	}
+}
+
+type OutFileHeaderMismatchError struct {
+	*BaseError
+}
+
+func NewOutFileHeaderMismatchError(fpath string) error {
+	return &OutFileHeaderMismatchError{
+		NewBaseError(parser.NewNullValue(), fmt.Sprintf(ErrMsgOutFileHeaderMismatch, fpath), ReturnCodeApplicationError, ErrorOutFileHeaderMismatch),
+	}
+}
+
 type FileNameAmbiguousError struct {
 	*BaseError
 }
@@ -905,6 +998,16 @@ func NewFileNameAmbiguousError(file parser.Identifier) error {
 	}
 }
 
+type FileNotUpdatableError struct {
+	*BaseError
+}
+
+func NewFileNotUpdatableError(file parser.Identifier, filepath string, message string) error {
+	return &FileNotUpdatableError{
+		NewBaseError(file, fmt.Sprintf(ErrMsgFileNotUpdatable, filepath, message), ReturnCodeApplicationError, ErrorFileNotUpdatable),
+	}
+}
+
 type DataParsingError struct {
 	*BaseError
 }
@@ -915,6 +1018,16 @@ func NewDataParsingError(file parser.QueryExpression, filepath string, message s
 	}
 }
 
+type ColumnTypeFieldNotExistError struct {
+	*BaseError
+}
+
+func NewColumnTypeFieldNotExistError(expr parser.Expression, column string) error {
+	return &ColumnTypeFieldNotExistError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgColumnTypeFieldNotExist, column), ReturnCodeApplicationError, ErrorColumnTypeFieldNotExist),
+	}
+}
+
 type DataEncodingError struct {
 	*BaseError
 }
@@ -1083,6 +1196,36 @@ func NewNestedRecursionError(expr parser.QueryExpression) error {
 	}
 }
 
+type InvalidCycleClauseError struct {
+	*BaseError
+}
+
+func NewInvalidCycleClauseError(expr parser.QueryExpression, table parser.Identifier) error {
+	return &InvalidCycleClauseError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgInvalidCycleClause, table), ReturnCodeApplicationError, ErrorInvalidCycleClause),
+	}
+}
+
+type InvalidSearchClauseError struct {
+	*BaseError
+}
+
+func NewInvalidSearchClauseError(expr parser.QueryExpression, table parser.Identifier) error {
+	return &InvalidSearchClauseError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgInvalidSearchClause, table), ReturnCodeApplicationError, ErrorInvalidSearchClause),
+	}
+}
+
+type InvalidSearchTypeError struct {
+	*BaseError
+}
+
+func NewInvalidSearchTypeError(searchType parser.Identifier) error {
+	return &InvalidSearchTypeError{
+		NewBaseError(searchType, fmt.Sprintf(ErrMsgInvalidSearchType, searchType.Literal), ReturnCodeApplicationError, ErrorInvalidSearchType),
+	}
+}
+
 type InsertRowValueLengthError struct {
 	*BaseError
 }
@@ -1451,6 +1594,112 @@ func NewSelectIntoQueryTooManyRecordsError(query parser.SelectQuery) error {
 	}
 }
 
+type CaseValueNotSpecifiedError struct {
+	*BaseError
+}
+
+func NewCaseValueNotSpecifiedError(condition parser.QueryExpression) error {
+	return &CaseValueNotSpecifiedError{
+		NewBaseError(condition, fmt.Sprintf(ErrMsgCaseValueNotSpecified, condition), ReturnCodeApplicationError, ErrorCaseValueNotSpecified),
+	}
+}
+
+type BitwiseOperandNotIntegerError struct {
+	*BaseError
+}
+
+func NewBitwiseOperandNotIntegerError(operand parser.QueryExpression, operator parser.Token) error {
+	var expr parser.Expression = operand
+	if !operand.HasParseInfo() {
+		// Literals have no parse information, so the position of the operator is used.
+		expr = parser.NewBaseExpr(operator)
+	}
+
+	return &BitwiseOperandNotIntegerError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgBitwiseOperandNotInteger, operand, operator), ReturnCodeApplicationError, ErrorBitwiseOperandNotInteger),
+	}
+}
+
+type StringOperandNotCastError struct {
+	*BaseError
+}
+
+func NewStringOperandNotCastError(operand parser.QueryExpression, operator parser.Token) error {
+	return &StringOperandNotCastError{
+		NewBaseError(operand, fmt.Sprintf(ErrMsgStringOperandNotCast, operand, operator), ReturnCodeApplicationError, ErrorStringOperandNotCast),
+	}
+}
+
+type StringArgumentNotCastError struct {
+	*BaseError
+}
+
+func NewStringArgumentNotCastError(arg parser.QueryExpression, funcname string) error {
+	return &StringArgumentNotCastError{
+		NewBaseError(arg, fmt.Sprintf(ErrMsgStringArgumentNotCast, arg, funcname), ReturnCodeApplicationError, ErrorStringArgumentNotCast),
+	}
+}
+
+type NotNullConstraintViolationError struct {
+	*BaseError
+}
+
+func NewNotNullConstraintViolationError(expr parser.QueryExpression, field string, recordNumber int) error {
+	return &NotNullConstraintViolationError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgNotNullConstraintViolation, field, recordNumber), ReturnCodeApplicationError, ErrorNotNullConstraintViolation),
+	}
+}
+
+type UniqueConstraintViolationError struct {
+	*BaseError
+}
+
+func NewUniqueConstraintViolationError(expr parser.QueryExpression, field string, val value.Primary, recordNumber int, duplicateNumber int) error {
+	return &UniqueConstraintViolationError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgUniqueConstraintViolation, val, field, recordNumber, duplicateNumber), ReturnCodeApplicationError, ErrorUniqueConstraintViolation),
+	}
+}
+
+type CheckConstraintViolationError struct {
+	*BaseError
+}
+
+func NewCheckConstraintViolationError(expr parser.QueryExpression, check parser.QueryExpression, recordNumber int) error {
+	return &CheckConstraintViolationError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgCheckConstraintViolation, recordNumber, check), ReturnCodeApplicationError, ErrorCheckConstraintViolation),
+	}
+}
+
+type InvalidTableDefinitionError struct {
+	*BaseError
+}
+
+func NewInvalidTableDefinitionError(expr parser.QueryExpression, fpath string, message string) error {
+	return &InvalidTableDefinitionError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgInvalidTableDefinition, fpath, message), ReturnCodeApplicationError, ErrorInvalidTableDefinition),
+	}
+}
+
+type InvalidCollationError struct {
+	*BaseError
+}
+
+func NewInvalidCollationError(expr parser.QueryExpression, collation string) error {
+	return &InvalidCollationError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgInvalidCollation, collation), ReturnCodeApplicationError, ErrorInvalidCollation),
+	}
+}
+
+type InvalidSampleNumberError struct {
+	*BaseError
+}
+
+func NewInvalidSampleNumberError(expr parser.Describe) error {
+	return &InvalidSampleNumberError{
+		NewBaseError(expr.Sample, fmt.Sprintf(ErrMsgInvalidSampleNumber, expr.Sample), ReturnCodeApplicationError, ErrorInvalidSampleNumber),
+	}
+}
+
 func searchSelectClause(query parser.SelectQuery) parser.SelectClause {
 	return searchSelectClauseInSelectEntity(query.SelectEntity)
 }
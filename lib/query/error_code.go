@@ -55,7 +55,12 @@ const (
 	ErrorUndefinedInlineTable                 = 11102
 	ErrorInlineTableFieldLength               = 11103
 	ErrorFileNameAmbiguous                    = 11201
+	ErrorFileNotUpdatable                     = 11202
+	ErrorFileGlobNoMatch                      = 11203
+	ErrorFileGlobHeaderMismatch               = 11204
+	ErrorOutFileHeaderMismatch                = 11205
 	ErrorDataParsing                          = 11301
+	ErrorColumnTypeFieldNotExist              = 11302
 	ErrorDataEncoding                         = 11351
 	ErrorTableFieldLength                     = 11401
 	ErrorTemporaryTableRedeclared             = 11501
@@ -72,6 +77,9 @@ const (
 	ErrorCombinedSetFieldLength               = 12001
 	ErrorRecursionExceededLimit               = 12002
 	ErrorNestedRecursion                      = 12003
+	ErrorInvalidCycleClause                   = 12004
+	ErrorInvalidSearchClause                  = 12005
+	ErrorInvalidSearchType                    = 12006
 	ErrorInsertRowValueLength                 = 12101
 	ErrorInsertSelectFieldLength              = 12102
 	ErrorUpdateFieldNotExist                  = 12201
@@ -107,6 +115,16 @@ const (
 	ErrorReplaceKeyNotSet                     = 13901
 	ErrorSelectIntoQueryFieldLengthNotMatch   = 14001
 	ErrorSelectIntoQueryTooManyRecords        = 14002
+	ErrorCaseValueNotSpecified                = 14101
+	ErrorBitwiseOperandNotInteger             = 14201
+	ErrorNotNullConstraintViolation           = 14301
+	ErrorUniqueConstraintViolation            = 14302
+	ErrorCheckConstraintViolation             = 14303
+	ErrorInvalidTableDefinition               = 14304
+	ErrorInvalidCollation                     = 14401
+	ErrorInvalidSampleNumber                  = 14501
+	ErrorStringOperandNotCast                 = 14601
+	ErrorStringArgumentNotCast                = 14602
 
 	//Incorrect Command Usage
 	ErrorIncorrectCommandUsage = 90020
@@ -123,13 +141,17 @@ const (
 	ErrorFileLockTimeout = 90082
 
 	//IO Error
-	ErrorIO               = 90160
-	ErrorCommit           = 90171
-	ErrorRollback         = 90172
-	ErrorInvalidPath      = 90180
-	ErrorFileNotExist     = 90181
-	ErrorFileAlreadyExist = 90182
-	ErrorFileUnableToRead = 90183
+	ErrorIO                     = 90160
+	ErrorCommit                 = 90171
+	ErrorRollback               = 90172
+	ErrorInvalidPath            = 90180
+	ErrorFileNotExist           = 90181
+	ErrorFileAlreadyExist       = 90182
+	ErrorFileUnableToRead       = 90183
+	ErrorPartitionLimitExceeded = 90184
+	ErrorHttpRequest            = 90191
+	ErrorHttpStatus             = 90192
+	ErrorHttpSizeExceeded       = 90193
 
 	//System Error
 	ErrorSystemError     = 90320
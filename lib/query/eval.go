@@ -5,6 +5,8 @@ import (
 	"context"
 	"os"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/excmd"
@@ -25,7 +27,7 @@ func Evaluate(ctx context.Context, scope *ReferenceScope, expr parser.QueryExpre
 
 	switch expr.(type) {
 	case parser.PrimitiveType:
-		val = expr.(parser.PrimitiveType).Value
+		val = evalPrimitiveType(scope, expr.(parser.PrimitiveType))
 	case parser.FieldReference, parser.ColumnNumber:
 		val, err = evalFieldReference(expr, scope)
 	case parser.Parentheses:
@@ -95,13 +97,15 @@ func Evaluate(ctx context.Context, scope *ReferenceScope, expr parser.QueryExpre
 
 func evaluateSequentialRoutine(ctx context.Context, scope *ReferenceScope, view *View, fn func(*ReferenceScope, int) error, thIdx int, gm *GoroutineTaskManager) {
 	start, end := gm.RecordRange(thIdx)
-	seqScope := scope.CreateScopeForSequentialEvaluation(
-		&View{
-			Header:    view.Header,
-			RecordSet: view.RecordSet[start:end],
-			isGrouped: view.isGrouped,
-		},
-	)
+	seqView := &View{
+		Header:    view.Header,
+		RecordSet: view.RecordSet[start:end],
+		isGrouped: view.isGrouped,
+	}
+	if end <= len(view.recordOrigins) {
+		seqView.recordOrigins = view.recordOrigins[start:end]
+	}
+	seqScope := scope.CreateScopeForSequentialEvaluation(seqView)
 
 	i := 0
 	for seqScope.NextRecord() {
@@ -146,6 +150,44 @@ func EvaluateSequentially(ctx context.Context, scope *ReferenceScope, view *View
 	return nil
 }
 
+type datetimeLiteralKey struct {
+	literal  string
+	formats  string
+	location *time.Location
+}
+
+// datetimeLiterals caches datetime literals re-parsed in timezones other than
+// the one in which the statements were parsed.
+var datetimeLiterals = &sync.Map{}
+
+// evalPrimitiveType re-parses datetime literals if the timezone has been
+// changed since the statements were parsed.
+func evalPrimitiveType(scope *ReferenceScope, expr parser.PrimitiveType) value.Primary {
+	if dt, ok := expr.Value.(*value.Datetime); ok && 0 < len(expr.Literal) {
+		location := cmd.GetLocation()
+		if dt.Raw().Location() == location {
+			return expr.Value
+		}
+
+		key := datetimeLiteralKey{
+			literal:  expr.Literal,
+			formats:  strings.Join(scope.Tx.Flags.DatetimeFormat, "\n"),
+			location: location,
+		}
+		if p, ok := datetimeLiterals.Load(key); ok {
+			return p.(value.Primary)
+		}
+
+		var p value.Primary = expr.Value
+		if t, ok := value.StrToTime(expr.Literal, scope.Tx.Flags.DatetimeFormat); ok {
+			p = value.NewDatetime(t)
+		}
+		datetimeLiterals.Store(key, p)
+		return p
+	}
+	return expr.Value
+}
+
 func evalFieldReference(expr parser.QueryExpression, scope *ReferenceScope) (value.Primary, error) {
 	var p value.Primary
 	for i := range scope.Records {
@@ -194,15 +236,99 @@ func evalArithmetic(ctx context.Context, scope *ReferenceScope, expr parser.Arit
 		return nil, err
 	}
 
+	if scope.Tx.Flags.ImportOptions.NoInfer {
+		if err = checkStringOperand(expr.LHS, lhs, expr.Operator); err != nil {
+			return nil, err
+		}
+		if err = checkStringOperand(expr.RHS, rhs, expr.Operator); err != nil {
+			return nil, err
+		}
+	}
+
+	if isBitwiseOperator(expr.Operator) {
+		if value.IsNull(rhs) {
+			return value.NewNull(), nil
+		}
+
+		i1, err := bitwiseOperand(expr.LHS, lhs, expr.Operator)
+		if err != nil {
+			return nil, err
+		}
+		i2, err := bitwiseOperand(expr.RHS, rhs, expr.Operator)
+		if err != nil {
+			return nil, err
+		}
+		return CalculateBitwise(i1, i2, expr.Operator.Literal), nil
+	}
+
 	return Calculate(lhs, rhs, expr.Operator.Token), nil
 }
 
+func isBitwiseOperator(operator parser.Token) bool {
+	switch operator.Token {
+	case '&', '|', '^', '~', parser.SHIFT_OP:
+		return true
+	}
+	return false
+}
+
+func bitwiseOperand(expr parser.QueryExpression, p value.Primary, operator parser.Token) (int64, error) {
+	if _, ok := p.(*value.Float); !ok {
+		if pi := value.ToInteger(p); !value.IsNull(pi) {
+			i := pi.(*value.Integer).Raw()
+			value.Discard(pi)
+			return i, nil
+		}
+	}
+	return 0, NewBitwiseOperandNotIntegerError(expr, operator)
+}
+
+// checkStringOperand returns an error if an operand is a string. It is used when
+// the flag NO_INFER is enabled, and strings must be cast explicitly to be used
+// as operands of arithmetic operators.
+func checkStringOperand(expr parser.QueryExpression, p value.Primary, operator parser.Token) error {
+	if _, ok := p.(*value.String); ok {
+		return NewStringOperandNotCastError(expr, operator)
+	}
+	return nil
+}
+
+// checkStringArguments returns an error if any of the values aggregated by a
+// function is a string. It is used when the flag NO_INFER is enabled, and strings
+// must be cast explicitly to be aggregated as numbers.
+func checkStringArguments(expr parser.QueryExpression, list []value.Primary, name string) error {
+	for _, p := range list {
+		if _, ok := p.(*value.String); ok {
+			return NewStringArgumentNotCastError(expr, name)
+		}
+	}
+	return nil
+}
+
 func evalUnaryArithmetic(ctx context.Context, scope *ReferenceScope, expr parser.UnaryArithmetic) (value.Primary, error) {
 	ope, err := Evaluate(ctx, scope, expr.Operand)
 	if err != nil {
 		return nil, err
 	}
 
+	if scope.Tx.Flags.ImportOptions.NoInfer {
+		if err = checkStringOperand(expr.Operand, ope, expr.Operator); err != nil {
+			return nil, err
+		}
+	}
+
+	if isBitwiseOperator(expr.Operator) {
+		if value.IsNull(ope) {
+			return value.NewNull(), nil
+		}
+
+		i, err := bitwiseOperand(expr.Operand, ope, expr.Operator)
+		if err != nil {
+			return nil, err
+		}
+		return value.NewInteger(^i), nil
+	}
+
 	if pi := value.ToInteger(ope); !value.IsNull(pi) {
 		val := pi.(*value.Integer).Raw()
 		value.Discard(pi)
@@ -238,6 +364,9 @@ func evalConcat(ctx context.Context, scope *ReferenceScope, expr parser.Concat)
 		}
 		s := value.ToString(p)
 		if value.IsNull(s) {
+			if scope.Tx.Flags.ConcatNullAsEmpty {
+				continue
+			}
 			return value.NewNull(), nil
 		}
 		items[i] = s.(*value.String).Raw()
@@ -294,7 +423,9 @@ func evalComparison(ctx context.Context, scope *ReferenceScope, expr parser.Comp
 			return nil, err
 		}
 
-		t = value.Compare(sv, rhs, expr.Operator.Literal, scope.Tx.Flags.DatetimeFormat)
+		if t, err = compareValues(scope, expr.LHS, sv, expr.RHS, rhs, expr.Operator.Literal, expr.Operator); err != nil {
+			return nil, err
+		}
 	} else {
 		rhs, err := EvalRowValue(ctx, scope, expr.RHS.(parser.RowValue))
 		if err != nil {
@@ -310,6 +441,26 @@ func evalComparison(ctx context.Context, scope *ReferenceScope, expr parser.Comp
 	return value.NewTernary(t), nil
 }
 
+// compareValues compares two values with a comparison operator. The token is the
+// operator written in the query, and is used in error messages.
+// When the flag NO_INFER is enabled, strings are compared only with strings and
+// are not converted to other types.
+func compareValues(scope *ReferenceScope, lhsExpr parser.QueryExpression, lhs value.Primary, rhsExpr parser.QueryExpression, rhs value.Primary, operator string, token parser.Token) (ternary.Value, error) {
+	if scope.Tx.Flags.ImportOptions.NoInfer && !value.IsNull(lhs) && !value.IsNull(rhs) {
+		s1, ok1 := lhs.(*value.String)
+		s2, ok2 := rhs.(*value.String)
+		switch {
+		case ok1 && ok2:
+			return value.CompareStrings(s1, s2, operator), nil
+		case ok1:
+			return ternary.UNKNOWN, NewStringOperandNotCastError(lhsExpr, token)
+		case ok2:
+			return ternary.UNKNOWN, NewStringOperandNotCastError(rhsExpr, token)
+		}
+	}
+	return value.Compare(lhs, rhs, operator, scope.Tx.Flags.DatetimeFormat), nil
+}
+
 func evalIs(ctx context.Context, scope *ReferenceScope, expr parser.Is) (value.Primary, error) {
 	lhs, err := Evaluate(ctx, scope, expr.LHS)
 	if err != nil {
@@ -345,7 +496,12 @@ func evalBetween(ctx context.Context, scope *ReferenceScope, expr parser.Between
 			return nil, err
 		}
 
-		lowResult := value.GreaterOrEqual(sv, low, scope.Tx.Flags.DatetimeFormat)
+		operator := parser.Token{Token: parser.BETWEEN, Literal: "BETWEEN"}
+
+		lowResult, err := compareValues(scope, expr.LHS, sv, expr.Low, low, ">=", operator)
+		if err != nil {
+			return nil, err
+		}
 		if lowResult == ternary.FALSE {
 			t = ternary.FALSE
 		} else {
@@ -354,7 +510,10 @@ func evalBetween(ctx context.Context, scope *ReferenceScope, expr parser.Between
 				return nil, err
 			}
 
-			highResult := value.LessOrEqual(sv, high, scope.Tx.Flags.DatetimeFormat)
+			highResult, err := compareValues(scope, expr.LHS, sv, expr.High, high, "<=", operator)
+			if err != nil {
+				return nil, err
+			}
 			t = ternary.And(lowResult, highResult)
 		}
 	} else {
@@ -495,7 +654,7 @@ func evalLike(ctx context.Context, scope *ReferenceScope, expr parser.Like) (val
 }
 
 func evalExists(ctx context.Context, scope *ReferenceScope, expr parser.Exists) (value.Primary, error) {
-	view, err := Select(ctx, scope, expr.Query.Query)
+	view, err := Select(ctx, scope.withoutProfile(), expr.Query.Query)
 	if err != nil {
 		return nil, err
 	}
@@ -506,7 +665,7 @@ func evalExists(ctx context.Context, scope *ReferenceScope, expr parser.Exists)
 }
 
 func evalSubqueryForValue(ctx context.Context, scope *ReferenceScope, expr parser.Subquery) (value.Primary, error) {
-	view, err := Select(ctx, scope, expr.Query)
+	view, err := Select(ctx, scope.withoutProfile(), expr.Query)
 	if err != nil {
 		return nil, err
 	}
@@ -534,7 +693,7 @@ func evalFunction(ctx context.Context, scope *ReferenceScope, expr parser.Functi
 	var ok bool
 	var err error
 
-	if fn, ok = Functions[name]; !ok && name != "CALL" && name != "NOW" && name != "JSON_OBJECT" {
+	if fn, ok = Functions[name]; !ok && name != "CALL" && name != "NOW" && name != "CURRENT_TIMESTAMP" && name != "CURRENT_DATE" && name != "CURRENT_TIME" && name != "TRANSACTION_TIMESTAMP" && name != "JSON_OBJECT" && name != "FILENAME" && name != "FILELINE" {
 		udfn, err = scope.GetFunction(expr, name)
 		if err != nil {
 			return nil, NewFunctionNotExistError(expr, expr.Name)
@@ -568,8 +727,18 @@ func evalFunction(ctx context.Context, scope *ReferenceScope, expr parser.Functi
 
 	if name == "CALL" {
 		return Call(ctx, expr, args)
-	} else if name == "NOW" {
+	} else if name == "NOW" || name == "CURRENT_TIMESTAMP" {
 		return Now(scope, expr, args)
+	} else if name == "CURRENT_DATE" {
+		return CurrentDate(scope, expr, args)
+	} else if name == "CURRENT_TIME" {
+		return CurrentTime(scope, expr, args)
+	} else if name == "TRANSACTION_TIMESTAMP" {
+		return TransactionTimestamp(scope, expr, args)
+	} else if name == "FILENAME" {
+		return FileName(scope, expr, args)
+	} else if name == "FILELINE" {
+		return FileLine(scope, expr, args)
 	}
 
 	if fn != nil {
@@ -584,8 +753,21 @@ func evalAggregateFunction(ctx context.Context, scope *ReferenceScope, expr pars
 	var err error
 
 	uname := strings.ToUpper(expr.Name)
+	if fn, ok := BivariateAggregateFunctions[uname]; ok {
+		return evalBivariateAggregateFunction(ctx, scope, expr, fn)
+	}
+
+	var argExpr parser.QueryExpression
 	if fn, ok := AggregateFunctions[uname]; ok {
 		aggfn = fn
+	} else if fn, ok := ConditionalAggregateFunctions[uname]; ok {
+		arg, e := conditionalAggregateArgument(expr, expr.Name, expr.Args, expr.IsDistinct())
+		if e != nil {
+			return nil, e
+		}
+		aggfn = fn
+		argExpr = expr.Args[len(expr.Args)-1]
+		expr.Args = []parser.QueryExpression{arg}
 	} else {
 		if udfn, err = scope.GetFunction(expr, uname); err != nil || !udfn.IsAggregate {
 			return nil, NewFunctionNotExistError(expr, expr.Name)
@@ -636,6 +818,15 @@ func evalAggregateFunction(ctx context.Context, scope *ReferenceScope, expr pars
 		}
 	}
 
+	if scope.Tx.Flags.ImportOptions.NoInfer && numericAggregateFunctions[uname] {
+		if argExpr == nil {
+			argExpr = expr.Args[0]
+		}
+		if err = checkStringArguments(argExpr, list, expr.Name); err != nil {
+			return nil, err
+		}
+	}
+
 	if aggfn == nil {
 		argsExprs := expr.Args[1:]
 		args := make([]value.Primary, len(argsExprs))
@@ -652,13 +843,97 @@ func evalAggregateFunction(ctx context.Context, scope *ReferenceScope, expr pars
 	return aggfn(list, scope.Tx.Flags), nil
 }
 
+func evalBivariateAggregateFunction(ctx context.Context, scope *ReferenceScope, expr parser.AggregateFunction, fn BivariateAggregateFunction) (value.Primary, error) {
+	if len(expr.Args) != 2 {
+		return nil, NewFunctionArgumentLengthError(expr, expr.Name, []int{2})
+	}
+	if expr.IsDistinct() {
+		return nil, NewFunctionInvalidArgumentError(expr, expr.Name, "DISTINCT cannot be specified")
+	}
+
+	var xList []value.Primary
+	var yList []value.Primary
+	if 0 < len(scope.Records) {
+		if !scope.Records[0].view.isGrouped {
+			return nil, NewNotGroupingRecordsError(expr, expr.Name)
+		}
+
+		if scope.Records[0].IsInRange() {
+			view, err := NewViewFromGroupedRecord(ctx, scope.Tx.Flags, scope.Records[0])
+			if err != nil {
+				return nil, err
+			}
+			if xList, err = view.ListValuesForAggregateFunctions(ctx, scope, expr, expr.Args[0], false); err != nil {
+				return nil, err
+			}
+			if yList, err = view.ListValuesForAggregateFunctions(ctx, scope, expr, expr.Args[1], false); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if scope.Tx.Flags.ImportOptions.NoInfer && numericAggregateFunctions[strings.ToUpper(expr.Name)] {
+		if err := checkStringArguments(expr.Args[0], xList, expr.Name); err != nil {
+			return nil, err
+		}
+		if err := checkStringArguments(expr.Args[1], yList, expr.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	return fn(xList, yList, scope.Tx.Flags), nil
+}
+
+// conditionalAggregateArgument returns the expression to be aggregated by a conditional
+// aggregate function. The expression is evaluated only for records matching the condition.
+func conditionalAggregateArgument(expr parser.QueryExpression, name string, args []parser.QueryExpression, distinct bool) (parser.QueryExpression, error) {
+	var result parser.QueryExpression
+
+	if strings.EqualFold(name, "COUNT_IF") {
+		if len(args) != 1 {
+			return nil, NewFunctionArgumentLengthError(expr, name, []int{1})
+		}
+		if distinct {
+			return nil, NewFunctionInvalidArgumentError(expr, name, "DISTINCT cannot be specified")
+		}
+		result = parser.NewIntegerValue(1)
+	} else {
+		if len(args) != 2 {
+			return nil, NewFunctionArgumentLengthError(expr, name, []int{2})
+		}
+		result = args[1]
+	}
+
+	return parser.CaseExpr{
+		When: []parser.QueryExpression{
+			parser.CaseExprWhen{Condition: args[0], Result: result},
+		},
+	}, nil
+}
+
 func evalListFunction(ctx context.Context, scope *ReferenceScope, expr parser.ListFunction) (value.Primary, error) {
 	var separator string
+	var k int
+	var low, high float64
+	var n int64
 	var err error
 
-	switch strings.ToUpper(expr.Name) {
+	uname := strings.ToUpper(expr.Name)
+
+	var listExpr parser.QueryExpression
+	switch uname {
+	case "MODE":
+		listExpr, err = modeValueExpr(expr, expr.Name, expr.IsDistinct(), expr.Args, expr.OrderBy)
+	case "FIRST", "LAST":
+		err = checkArgsForFirstLast(expr)
 	case "JSON_AGG":
 		err = checkArgsForJsonAgg(expr)
+	case "TOP_K":
+		k, err = checkArgsForTopK(ctx, scope, expr, expr.Name, expr.IsDistinct(), expr.Args)
+	case "HISTOGRAM":
+		low, high, n, err = checkArgsForHistogram(ctx, scope, expr, expr.Name, expr.IsDistinct(), expr.Args)
+	case "STRING_AGG":
+		separator, err = checkArgsForStringAgg(ctx, scope, expr)
 	default: // LISTAGG
 		separator, err = checkArgsForListFunction(ctx, scope, expr)
 	}
@@ -666,8 +941,12 @@ func evalListFunction(ctx context.Context, scope *ReferenceScope, expr parser.Li
 	if err != nil {
 		return nil, err
 	}
+	if listExpr == nil {
+		listExpr = expr.Args[0]
+	}
 
 	var list []value.Primary
+	var sortValues [][]value.Primary
 	if 0 < len(scope.Records) {
 		if !scope.Records[0].view.isGrouped {
 			return nil, NewNotGroupingRecordsError(expr, expr.Name)
@@ -684,15 +963,36 @@ func evalListFunction(ctx context.Context, scope *ReferenceScope, expr parser.Li
 			}
 		}
 
-		list, err = view.ListValuesForAggregateFunctions(ctx, scope, expr, expr.Args[0], expr.IsDistinct())
+		list, err = view.ListValuesForAggregateFunctions(ctx, scope, expr, listExpr, expr.IsDistinct())
 		if err != nil {
 			return nil, err
 		}
+
+		if uname == "FIRST" || uname == "LAST" {
+			items := expr.OrderBy.(parser.OrderByClause).Items
+			sortValues = make([][]value.Primary, len(items))
+			for i, item := range items {
+				sortValues[i], err = view.ListValuesForAggregateFunctions(ctx, scope, expr, item.(parser.OrderItem).Value, false)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
 	}
 
-	switch strings.ToUpper(expr.Name) {
+	switch uname {
+	case "MODE":
+		return Mode(list, scope.Tx.Flags), nil
+	case "FIRST":
+		return First(list, sortValues), nil
+	case "LAST":
+		return Last(list, sortValues), nil
 	case "JSON_AGG":
 		return JsonAgg(list), nil
+	case "TOP_K":
+		return TopK(list, k, scope.Tx.Flags), nil
+	case "HISTOGRAM":
+		return Histogram(list, low, high, n), nil
 	}
 	return ListAgg(list, separator), nil
 }
@@ -719,6 +1019,37 @@ func checkArgsForListFunction(ctx context.Context, scope *ReferenceScope, expr p
 	return separator, nil
 }
 
+func checkArgsForStringAgg(ctx context.Context, scope *ReferenceScope, expr parser.ListFunction) (string, error) {
+	if 2 != len(expr.Args) {
+		return "", NewFunctionArgumentLengthError(expr, expr.Name, []int{2})
+	}
+	return checkArgsForListFunction(ctx, scope, expr)
+}
+
+// modeValueExpr returns the expression specified in the WITHIN GROUP clause of a MODE function.
+func modeValueExpr(expr parser.QueryExpression, name string, distinct bool, args []parser.QueryExpression, orderBy parser.QueryExpression) (parser.QueryExpression, error) {
+	if 0 < len(args) {
+		return nil, NewFunctionArgumentLengthError(expr, name, []int{0})
+	}
+	if distinct {
+		return nil, NewFunctionInvalidArgumentError(expr, name, "DISTINCT cannot be specified")
+	}
+	if orderBy == nil || len(orderBy.(parser.OrderByClause).Items) != 1 {
+		return nil, NewFunctionInvalidArgumentError(expr, name, "the WITHIN GROUP clause must have exactly one order item")
+	}
+	return orderBy.(parser.OrderByClause).Items[0].(parser.OrderItem).Value, nil
+}
+
+func checkArgsForFirstLast(expr parser.ListFunction) error {
+	if 1 != len(expr.Args) {
+		return NewFunctionArgumentLengthError(expr, expr.Name, []int{1})
+	}
+	if expr.OrderBy == nil {
+		return NewFunctionInvalidArgumentError(expr, expr.Name, "ORDER BY clause must be specified")
+	}
+	return nil
+}
+
 func checkArgsForJsonAgg(expr parser.ListFunction) error {
 	if 1 != len(expr.Args) {
 		return NewFunctionArgumentLengthError(expr, expr.Name, []int{1})
@@ -726,6 +1057,66 @@ func checkArgsForJsonAgg(expr parser.ListFunction) error {
 	return nil
 }
 
+func checkArgsForTopK(ctx context.Context, scope *ReferenceScope, expr parser.QueryExpression, name string, distinct bool, args []parser.QueryExpression) (int, error) {
+	if 2 != len(args) {
+		return 0, NewFunctionArgumentLengthError(expr, name, []int{2})
+	}
+	if distinct {
+		return 0, NewFunctionInvalidArgumentError(expr, name, "DISTINCT cannot be specified")
+	}
+
+	p, err := Evaluate(ctx, scope, args[1])
+	if err != nil {
+		return 0, NewFunctionInvalidArgumentError(expr, name, "the second argument must be a positive integer")
+	}
+	i := value.ToInteger(p)
+	if value.IsNull(i) || i.(*value.Integer).Raw() < 1 {
+		return 0, NewFunctionInvalidArgumentError(expr, name, "the second argument must be a positive integer")
+	}
+	k := int(i.(*value.Integer).Raw())
+	value.Discard(i)
+	return k, nil
+}
+
+func checkArgsForHistogram(ctx context.Context, scope *ReferenceScope, expr parser.QueryExpression, name string, distinct bool, args []parser.QueryExpression) (float64, float64, int64, error) {
+	if 4 != len(args) {
+		return 0, 0, 0, NewFunctionArgumentLengthError(expr, name, []int{4})
+	}
+	if distinct {
+		return 0, 0, 0, NewFunctionInvalidArgumentError(expr, name, "DISTINCT cannot be specified")
+	}
+
+	bounds := make([]float64, 2)
+	for i, msg := range []string{"the second argument must be a number", "the third argument must be a number"} {
+		p, err := Evaluate(ctx, scope, args[i+1])
+		if err != nil {
+			return 0, 0, 0, NewFunctionInvalidArgumentError(expr, name, msg)
+		}
+		f := value.ToFloat(p)
+		if value.IsNull(f) {
+			return 0, 0, 0, NewFunctionInvalidArgumentError(expr, name, msg)
+		}
+		bounds[i] = f.(*value.Float).Raw()
+		value.Discard(f)
+	}
+	if bounds[1] <= bounds[0] {
+		return 0, 0, 0, NewFunctionInvalidArgumentError(expr, name, "the third argument must be greater than the second argument")
+	}
+
+	p, err := Evaluate(ctx, scope, args[3])
+	if err != nil {
+		return 0, 0, 0, NewFunctionInvalidArgumentError(expr, name, "the fourth argument must be an integer greater than 0")
+	}
+	i := value.ToInteger(p)
+	if value.IsNull(i) || i.(*value.Integer).Raw() < 1 {
+		return 0, 0, 0, NewFunctionInvalidArgumentError(expr, name, "the fourth argument must be an integer greater than 0")
+	}
+	n := i.(*value.Integer).Raw()
+	value.Discard(i)
+
+	return bounds[0], bounds[1], n, nil
+}
+
 func evalCaseExpr(ctx context.Context, scope *ReferenceScope, expr parser.CaseExpr) (value.Primary, error) {
 	var val value.Primary
 	var err error
@@ -740,15 +1131,21 @@ func evalCaseExpr(ctx context.Context, scope *ReferenceScope, expr parser.CaseEx
 		when := v.(parser.CaseExprWhen)
 		var t ternary.Value
 
-		cond, err := Evaluate(ctx, scope, when.Condition)
-		if err != nil {
-			return nil, err
-		}
-
 		if val == nil {
+			if isCaseValueCondition(when.Condition) {
+				return nil, NewCaseValueNotSpecifiedError(when.Condition)
+			}
+
+			cond, err := Evaluate(ctx, scope, when.Condition)
+			if err != nil {
+				return nil, err
+			}
 			t = cond.Ternary()
 		} else {
-			t = value.Equal(val, cond, scope.Tx.Flags.DatetimeFormat)
+			t, err = evalCaseValueCondition(ctx, scope, val, when.Condition)
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		if t == ternary.TRUE {
@@ -770,6 +1167,46 @@ func evalCaseExpr(ctx context.Context, scope *ReferenceScope, expr parser.CaseEx
 	return result, nil
 }
 
+func isCaseValueCondition(condition parser.QueryExpression) bool {
+	switch c := condition.(type) {
+	case parser.Comparison:
+		return c.LHS == nil
+	case parser.Between:
+		return c.LHS == nil
+	}
+	return false
+}
+
+func evalCaseValueCondition(ctx context.Context, scope *ReferenceScope, val value.Primary, condition parser.QueryExpression) (ternary.Value, error) {
+	var p value.Primary
+	var err error
+
+	switch c := condition.(type) {
+	case parser.Comparison:
+		if c.LHS == nil {
+			c.LHS = parser.PrimitiveType{Value: val}
+			p, err = evalComparison(ctx, scope, c)
+		}
+	case parser.Between:
+		if c.LHS == nil {
+			c.LHS = parser.PrimitiveType{Value: val}
+			p, err = evalBetween(ctx, scope, c)
+		}
+	}
+	if err != nil {
+		return ternary.UNKNOWN, err
+	}
+	if p != nil {
+		return p.Ternary(), nil
+	}
+
+	cond, err := Evaluate(ctx, scope, condition)
+	if err != nil {
+		return ternary.UNKNOWN, err
+	}
+	return value.Equal(val, cond, scope.Tx.Flags.DatetimeFormat), nil
+}
+
 func evalLogic(ctx context.Context, scope *ReferenceScope, expr parser.Logic) (value.Primary, error) {
 	lhs, err := Evaluate(ctx, scope, expr.LHS)
 	if err != nil {
@@ -958,7 +1395,7 @@ func evalArray(ctx context.Context, scope *ReferenceScope, expr parser.QueryExpr
 }
 
 func evalSubqueryForRowValue(ctx context.Context, scope *ReferenceScope, expr parser.Subquery) (value.RowValue, error) {
-	view, err := Select(ctx, scope, expr.Query)
+	view, err := Select(ctx, scope.withoutProfile(), expr.Query)
 	if err != nil {
 		return nil, err
 	}
@@ -1023,7 +1460,7 @@ func evalValueList(ctx context.Context, scope *ReferenceScope, expr parser.Value
 }
 
 func evalSubqueryForRowValueList(ctx context.Context, scope *ReferenceScope, expr parser.Subquery) ([]value.RowValue, error) {
-	view, err := Select(ctx, scope, expr.Query)
+	view, err := Select(ctx, scope.withoutProfile(), expr.Query)
 	if err != nil {
 		return nil, err
 	}
@@ -1072,7 +1509,7 @@ func evalJsonQueryForRowValueList(ctx context.Context, scope *ReferenceScope, ex
 }
 
 func evalSubqueryForArray(ctx context.Context, scope *ReferenceScope, expr parser.Subquery) ([]value.RowValue, error) {
-	view, err := Select(ctx, scope, expr.Query)
+	view, err := Select(ctx, scope.withoutProfile(), expr.Query)
 	if err != nil {
 		return nil, err
 	}
@@ -14,12 +14,14 @@ import (
 )
 
 var evaluateTests = []struct {
-	Name          string
-	Scope         *ReferenceScope
-	Expr          parser.QueryExpression
-	ReplaceValues *ReplaceValues
-	Result        value.Primary
-	Error         string
+	Name              string
+	Scope             *ReferenceScope
+	Expr              parser.QueryExpression
+	ReplaceValues     *ReplaceValues
+	ConcatNullAsEmpty bool
+	NoInfer           bool
+	Result            value.Primary
+	Error             string
 }{
 	{
 		Name:   "nil",
@@ -281,6 +283,116 @@ var evaluateTests = []struct {
 		},
 		Error: "field notexist does not exist",
 	},
+	{
+		Name: "Arithmetic Bitwise",
+		Expr: parser.Arithmetic{
+			LHS:      parser.NewStringValue("6"),
+			RHS:      parser.NewIntegerValue(3),
+			Operator: parser.Token{Token: '&', Literal: "&"},
+		},
+		Result: value.NewInteger(2),
+	},
+	{
+		Name: "Arithmetic Bitwise Shift",
+		Expr: parser.Arithmetic{
+			LHS:      parser.NewIntegerValue(1),
+			RHS:      parser.NewIntegerValue(4),
+			Operator: parser.Token{Token: parser.SHIFT_OP, Literal: "<<"},
+		},
+		Result: value.NewInteger(16),
+	},
+	{
+		Name: "Arithmetic Bitwise RHS Is Null",
+		Expr: parser.Arithmetic{
+			LHS:      parser.NewIntegerValue(1),
+			RHS:      parser.NewNullValue(),
+			Operator: parser.Token{Token: '|', Literal: "|"},
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Arithmetic Bitwise LHS Not Integer Error",
+		Expr: parser.Arithmetic{
+			LHS:      parser.NewFloatValue(1.5),
+			RHS:      parser.NewIntegerValue(1),
+			Operator: parser.Token{Token: '^', Literal: "^"},
+		},
+		Error: "operand 1.5 of operator ^ must be an integer",
+	},
+	{
+		Name: "Arithmetic Bitwise RHS Not Integer Error",
+		Expr: parser.Arithmetic{
+			LHS:      parser.NewIntegerValue(1),
+			RHS:      parser.NewFloatValue(2),
+			Operator: parser.Token{Token: parser.SHIFT_OP, Literal: ">>"},
+		},
+		Error: "operand 2 of operator >> must be an integer",
+	},
+	{
+		Name: "Arithmetic Bitwise Literal Not Integer Error Position",
+		Expr: parser.Arithmetic{
+			LHS:      parser.NewFloatValue(1.5),
+			RHS:      parser.NewIntegerValue(1),
+			Operator: parser.Token{Token: '&', Literal: "&", Line: 1, Char: 12},
+		},
+		Error: "[L:1 C:12] operand 1.5 of operator & must be an integer",
+	},
+	{
+		Name: "Arithmetic Bitwise Field Not Integer Error Position",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeader("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						NewRecord([]value.Primary{
+							value.NewString("str"),
+							value.NewInteger(2),
+						}),
+					},
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.Arithmetic{
+			LHS:      parser.NewIntegerValue(1),
+			RHS:      parser.FieldReference{BaseExpr: parser.NewBaseExpr(parser.Token{Line: 1, Char: 12}), Column: parser.Identifier{Literal: "column1"}},
+			Operator: parser.Token{Token: '|', Literal: "|", Line: 1, Char: 10},
+		},
+		Error: "[L:1 C:12] operand column1 of operator | must be an integer",
+	},
+	{
+		Name: "Arithmetic String without Inference Error",
+		Expr: parser.Arithmetic{
+			LHS:      parser.NewStringValue("1234"),
+			RHS:      parser.NewIntegerValue(1),
+			Operator: parser.Token{Token: '+', Literal: "+"},
+		},
+		NoInfer: true,
+		Error:   "operand '1234' of operator + is a string and must be cast explicitly when @@NO_INFER is enabled",
+	},
+	{
+		Name: "Arithmetic Cast String without Inference",
+		Expr: parser.Arithmetic{
+			LHS: parser.Function{
+				Name: "integer",
+				Args: []parser.QueryExpression{parser.NewStringValue("1234")},
+			},
+			RHS:      parser.NewIntegerValue(1),
+			Operator: parser.Token{Token: '+', Literal: "+"},
+		},
+		NoInfer: true,
+		Result:  value.NewInteger(1235),
+	},
+	{
+		Name: "UnaryArithmetic String without Inference Error",
+		Expr: parser.UnaryArithmetic{
+			Operand:  parser.NewStringValue("1"),
+			Operator: parser.Token{Token: '-', Literal: "-"},
+		},
+		NoInfer: true,
+		Error:   "operand '1' of operator - is a string and must be cast explicitly when @@NO_INFER is enabled",
+	},
 	{
 		Name: "UnaryArithmetic Integer",
 		Expr: parser.UnaryArithmetic{
@@ -313,6 +425,30 @@ var evaluateTests = []struct {
 		},
 		Result: value.NewNull(),
 	},
+	{
+		Name: "UnaryArithmetic Bitwise Not",
+		Expr: parser.UnaryArithmetic{
+			Operand:  parser.NewIntegerValue(5),
+			Operator: parser.Token{Token: '~', Literal: "~"},
+		},
+		Result: value.NewInteger(-6),
+	},
+	{
+		Name: "UnaryArithmetic Bitwise Not Null",
+		Expr: parser.UnaryArithmetic{
+			Operand:  parser.NewNullValue(),
+			Operator: parser.Token{Token: '~', Literal: "~"},
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "UnaryArithmetic Bitwise Not Operand Error",
+		Expr: parser.UnaryArithmetic{
+			Operand:  parser.NewStringValue("str"),
+			Operator: parser.Token{Token: '~', Literal: "~"},
+		},
+		Error: "operand 'str' of operator ~ must be an integer",
+	},
 	{
 		Name: "Concat",
 		Expr: parser.Concat{
@@ -346,6 +482,18 @@ var evaluateTests = []struct {
 		},
 		Result: value.NewNull(),
 	},
+	{
+		Name: "Concat Including Null with ConcatNullAsEmpty",
+		Expr: parser.Concat{
+			Items: []parser.QueryExpression{
+				parser.NewStringValue("a"),
+				parser.NewNullValue(),
+				parser.NewStringValue("c"),
+			},
+		},
+		ConcatNullAsEmpty: true,
+		Result:            value.NewString("ac"),
+	},
 	{
 		Name: "Comparison",
 		Expr: parser.Comparison{
@@ -355,6 +503,59 @@ var evaluateTests = []struct {
 		},
 		Result: value.NewTernary(ternary.FALSE),
 	},
+	{
+		Name: "Comparison String and Integer without Inference Error",
+		Expr: parser.Comparison{
+			LHS:      parser.NewStringValue("1234"),
+			RHS:      parser.NewIntegerValue(1234),
+			Operator: parser.Token{Token: '=', Literal: "="},
+		},
+		NoInfer: true,
+		Error:   "operand '1234' of operator = is a string and must be cast explicitly when @@NO_INFER is enabled",
+	},
+	{
+		Name: "Comparison Integer and String without Inference Error",
+		Expr: parser.Comparison{
+			LHS:      parser.NewIntegerValue(1234),
+			RHS:      parser.NewStringValue("1234"),
+			Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: "<"},
+		},
+		NoInfer: true,
+		Error:   "operand '1234' of operator < is a string and must be cast explicitly when @@NO_INFER is enabled",
+	},
+	{
+		Name: "Comparison Strings without Inference",
+		Expr: parser.Comparison{
+			LHS:      parser.NewStringValue("01234"),
+			RHS:      parser.NewStringValue("1234"),
+			Operator: parser.Token{Token: '=', Literal: "="},
+		},
+		NoInfer: true,
+		Result:  value.NewTernary(ternary.FALSE),
+	},
+	{
+		Name: "Comparison Cast String without Inference",
+		Expr: parser.Comparison{
+			LHS: parser.Function{
+				Name: "integer",
+				Args: []parser.QueryExpression{parser.NewStringValue("01234")},
+			},
+			RHS:      parser.NewIntegerValue(1234),
+			Operator: parser.Token{Token: '=', Literal: "="},
+		},
+		NoInfer: true,
+		Result:  value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "Comparison String and Null without Inference",
+		Expr: parser.Comparison{
+			LHS:      parser.NewStringValue("1234"),
+			RHS:      parser.NewNullValue(),
+			Operator: parser.Token{Token: '=', Literal: "="},
+		},
+		NoInfer: true,
+		Result:  value.NewTernary(ternary.UNKNOWN),
+	},
 	{
 		Name: "Comparison With Single RowValue",
 		Expr: parser.Comparison{
@@ -819,6 +1020,26 @@ var evaluateTests = []struct {
 		},
 		Result: value.NewTernary(ternary.FALSE),
 	},
+	{
+		Name: "Between Strings without Inference",
+		Expr: parser.Between{
+			LHS:  parser.NewStringValue("10"),
+			Low:  parser.NewStringValue("1"),
+			High: parser.NewStringValue("2"),
+		},
+		NoInfer: true,
+		Result:  value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "Between String without Inference Error",
+		Expr: parser.Between{
+			LHS:  parser.NewStringValue("2"),
+			Low:  parser.NewIntegerValue(1),
+			High: parser.NewIntegerValue(3),
+		},
+		NoInfer: true,
+		Error:   "operand '2' of operator BETWEEN is a string and must be cast explicitly when @@NO_INFER is enabled",
+	},
 	{
 		Name: "Between LHS Error",
 		Expr: parser.Between{
@@ -2524,6 +2745,13 @@ var evaluateTests = []struct {
 		},
 		Result: value.NewDatetime(NowForTest),
 	},
+	{
+		Name: "Function CurrentTimestamp",
+		Expr: parser.Function{
+			Name: "current_timestamp",
+		},
+		Result: value.NewDatetime(NowForTest),
+	},
 	{
 		Name: "User Defined Function",
 		Scope: GenerateReferenceScope([]map[string]map[string]interface{}{
@@ -2637,13 +2865,18 @@ var evaluateTests = []struct {
 		Result: value.NewInteger(2),
 	},
 	{
-		Name: "Aggregate Function Argument Length Error",
+		Name: "Aggregate Function Bivariate",
 		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
 			{
 				view: &View{
-					Header: NewHeader("table1", []string{"column1", "column2"}),
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
 					RecordSet: []Record{
 						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+							}),
 							NewGroupCell([]value.Primary{
 								value.NewInteger(1),
 								value.NewNull(),
@@ -2663,45 +2896,67 @@ var evaluateTests = []struct {
 			},
 		}),
 		Expr: parser.AggregateFunction{
-			Name:     "avg",
-			Distinct: parser.Token{},
+			Name: "covar_samp",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
 		},
-		Error: "function avg takes exactly 1 argument",
+		Result: value.NewInteger(2),
 	},
 	{
-		Name: "Aggregate Function Not Grouped Error",
+		Name: "Aggregate Function without Inference",
 		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
 			{
 				view: &View{
-					Header: NewHeader("table1", []string{"column1", "column2"}),
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
 					RecordSet: []Record{
-						NewRecordWithId(1, []value.Primary{
-							value.NewInteger(1),
-							value.NewString("str2"),
-						}),
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewNull(),
+								value.NewInteger(3),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("str1"),
+								value.NewString("str2"),
+								value.NewString("str3"),
+							}),
+						},
 					},
+					isGrouped: true,
 				},
 				recordIndex: 0,
 				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
 			},
 		}),
 		Expr: parser.AggregateFunction{
-			Name:     "avg",
-			Distinct: parser.Token{},
+			Name: "sum",
 			Args: []parser.QueryExpression{
 				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
 			},
 		},
-		Error: "function avg cannot aggregate not grouping records",
+		NoInfer: true,
+		Result:  value.NewInteger(4),
 	},
 	{
-		Name: "Aggregate Function Nested Error",
+		Name: "Aggregate Function String Argument without Inference Error",
 		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
 			{
 				view: &View{
-					Header: NewHeader("table1", []string{"column1", "column2"}),
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
 					RecordSet: []Record{
 						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+							}),
 							NewGroupCell([]value.Primary{
 								value.NewInteger(1),
 								value.NewNull(),
@@ -2721,27 +2976,27 @@ var evaluateTests = []struct {
 			},
 		}),
 		Expr: parser.AggregateFunction{
-			Name:     "avg",
-			Distinct: parser.Token{},
+			Name: "sum",
 			Args: []parser.QueryExpression{
-				parser.AggregateFunction{
-					Name: "avg",
-					Args: []parser.QueryExpression{
-						parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
-					},
-				},
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
 			},
 		},
-		Error: "aggregate functions are nested at AVG(AVG(column1))",
+		NoInfer: true,
+		Error:   "argument column2 of function sum is a string and must be cast explicitly when @@NO_INFER is enabled",
 	},
 	{
-		Name: "Aggregate Function Count With AllColumns",
+		Name: "Aggregate Function Conditional String Argument without Inference Error",
 		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
 			{
 				view: &View{
-					Header: NewHeader("table1", []string{"column1", "column2"}),
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
 					RecordSet: []Record{
 						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+							}),
 							NewGroupCell([]value.Primary{
 								value.NewInteger(1),
 								value.NewNull(),
@@ -2761,22 +3016,28 @@ var evaluateTests = []struct {
 			},
 		}),
 		Expr: parser.AggregateFunction{
-			Name:     "count",
-			Distinct: parser.Token{},
+			Name: "avg_if",
 			Args: []parser.QueryExpression{
-				parser.AllColumns{},
+				parser.NewTernaryValueFromString("true"),
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
 			},
 		},
-		Result: value.NewInteger(3),
+		NoInfer: true,
+		Error:   "argument column2 of function avg_if is a string and must be cast explicitly when @@NO_INFER is enabled",
 	},
 	{
-		Name: "Aggregate Function Count With Null",
+		Name: "Aggregate Function Bivariate String Argument without Inference Error",
 		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
 			{
 				view: &View{
-					Header: NewHeader("table1", []string{"column1", "column2"}),
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
 					RecordSet: []Record{
 						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+							}),
 							NewGroupCell([]value.Primary{
 								value.NewInteger(1),
 								value.NewNull(),
@@ -2796,33 +3057,282 @@ var evaluateTests = []struct {
 			},
 		}),
 		Expr: parser.AggregateFunction{
-			Name:     "count",
-			Distinct: parser.Token{},
+			Name: "corr",
 			Args: []parser.QueryExpression{
-				parser.PrimitiveType{Value: value.NewNull()},
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
 			},
 		},
-		Result: value.NewInteger(0),
+		NoInfer: true,
+		Error:   "argument column2 of function corr is a string and must be cast explicitly when @@NO_INFER is enabled",
 	},
 	{
-		Name: "Aggregate Function As a Statement Error",
+		Name: "Aggregate Function Bivariate Argument Length Error",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewNull(),
+								value.NewInteger(3),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("str1"),
+								value.NewString("str2"),
+								value.NewString("str3"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
 		Expr: parser.AggregateFunction{
-			Name:     "avg",
-			Distinct: parser.Token{},
+			Name: "corr",
 			Args: []parser.QueryExpression{
 				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
 			},
 		},
-		Result: value.NewNull(),
+		Error: "function corr takes exactly 2 arguments",
 	},
 	{
-		Name: "Aggregate Function User Defined",
-		Scope: GenerateReferenceScope([]map[string]map[string]interface{}{
+		Name: "Aggregate Function Bivariate Distinct Error",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
 			{
-				scopeNameFunctions: {
-					"USERAGGFUNC": &UserDefinedFunction{
-						Name:        parser.Identifier{Literal: "useraggfunc"},
-						IsAggregate: true,
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewNull(),
+								value.NewInteger(3),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("str1"),
+								value.NewString("str2"),
+								value.NewString("str3"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.AggregateFunction{
+			Name:     "corr",
+			Distinct: parser.Token{Token: parser.DISTINCT, Literal: "distinct"},
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+		},
+		Error: "DISTINCT cannot be specified for function corr",
+	},
+	{
+		Name: "Aggregate Function Argument Length Error",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeader("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewNull(),
+								value.NewInteger(3),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("str1"),
+								value.NewString("str2"),
+								value.NewString("str3"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.AggregateFunction{
+			Name:     "avg",
+			Distinct: parser.Token{},
+		},
+		Error: "function avg takes exactly 1 argument",
+	},
+	{
+		Name: "Aggregate Function Not Grouped Error",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeader("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						NewRecordWithId(1, []value.Primary{
+							value.NewInteger(1),
+							value.NewString("str2"),
+						}),
+					},
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.AggregateFunction{
+			Name:     "avg",
+			Distinct: parser.Token{},
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+		},
+		Error: "function avg cannot aggregate not grouping records",
+	},
+	{
+		Name: "Aggregate Function Nested Error",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeader("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewNull(),
+								value.NewInteger(3),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("str1"),
+								value.NewString("str2"),
+								value.NewString("str3"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.AggregateFunction{
+			Name:     "avg",
+			Distinct: parser.Token{},
+			Args: []parser.QueryExpression{
+				parser.AggregateFunction{
+					Name: "avg",
+					Args: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
+				},
+			},
+		},
+		Error: "aggregate functions are nested at AVG(AVG(column1))",
+	},
+	{
+		Name: "Aggregate Function Count With AllColumns",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeader("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewNull(),
+								value.NewInteger(3),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("str1"),
+								value.NewString("str2"),
+								value.NewString("str3"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.AggregateFunction{
+			Name:     "count",
+			Distinct: parser.Token{},
+			Args: []parser.QueryExpression{
+				parser.AllColumns{},
+			},
+		},
+		Result: value.NewInteger(3),
+	},
+	{
+		Name: "Aggregate Function Count With Null",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeader("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewNull(),
+								value.NewInteger(3),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("str1"),
+								value.NewString("str2"),
+								value.NewString("str3"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.AggregateFunction{
+			Name:     "count",
+			Distinct: parser.Token{},
+			Args: []parser.QueryExpression{
+				parser.PrimitiveType{Value: value.NewNull()},
+			},
+		},
+		Result: value.NewInteger(0),
+	},
+	{
+		Name: "Aggregate Function As a Statement Error",
+		Expr: parser.AggregateFunction{
+			Name:     "avg",
+			Distinct: parser.Token{},
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Aggregate Function User Defined",
+		Scope: GenerateReferenceScope([]map[string]map[string]interface{}{
+			{
+				scopeNameFunctions: {
+					"USERAGGFUNC": &UserDefinedFunction{
+						Name:        parser.Identifier{Literal: "useraggfunc"},
+						IsAggregate: true,
 						Cursor:      parser.Identifier{Literal: "column1"},
 						Parameters: []parser.Variable{
 							{Name: "default"},
@@ -3237,10 +3747,879 @@ var evaluateTests = []struct {
 				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
 			},
 		},
-		Error: "function undefined does not exist",
+		Error: "function undefined does not exist",
+	},
+	{
+		Name: "ListAgg Function",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("str2"),
+								value.NewString("str1"),
+								value.NewNull(),
+								value.NewString("str2"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.ListFunction{
+			Name:     "listagg",
+			Distinct: parser.Token{Token: parser.DISTINCT, Literal: "distinct"},
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.NewStringValue(","),
+			},
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+				},
+			},
+		},
+		Result: value.NewString("str1,str2"),
+	},
+	{
+		Name: "ListAgg Function Null",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewNull(),
+								value.NewNull(),
+								value.NewNull(),
+								value.NewNull(),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.ListFunction{
+			Distinct: parser.Token{Token: parser.DISTINCT, Literal: "distinct"},
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.NewStringValue(","),
+			},
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "ListAgg Function Argument Length Error",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						NewRecordWithId(1, []value.Primary{
+							value.NewInteger(1),
+							value.NewString("str2"),
+						}),
+					},
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.ListFunction{
+			Name:     "listagg",
+			Distinct: parser.Token{Token: parser.DISTINCT, Literal: "distinct"},
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+				},
+			},
+		},
+		Error: "function listagg takes 1 or 2 arguments",
+	},
+	{
+		Name: "StringAgg Function",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("str2"),
+								value.NewString("str1"),
+								value.NewNull(),
+								value.NewString("str3"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.ListFunction{
+			Name: "string_agg",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.NewStringValue(", "),
+			},
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}, Direction: parser.Token{Token: parser.DESC, Literal: "desc"}},
+				},
+			},
+		},
+		Result: value.NewString("str3, str2, str1"),
+	},
+	{
+		Name: "StringAgg Function Argument Length Error",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("str2"),
+								value.NewString("str1"),
+								value.NewNull(),
+								value.NewString("str3"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.ListFunction{
+			Name: "string_agg",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+		},
+		Error: "function string_agg takes exactly 2 arguments",
+	},
+	{
+		Name: "ListAgg Function Not Grouped Error",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						NewRecordWithId(1, []value.Primary{
+							value.NewInteger(1),
+							value.NewString("str2"),
+						}),
+					},
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.ListFunction{
+			Name:     "listagg",
+			Distinct: parser.Token{Token: parser.DISTINCT, Literal: "distinct"},
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.NewStringValue(","),
+			},
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+				},
+			},
+		},
+		Error: "function listagg cannot aggregate not grouping records",
+	},
+	{
+		Name: "ListAgg Function Sort Error",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("str2"),
+								value.NewString("str1"),
+								value.NewNull(),
+								value.NewString("str2"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.ListFunction{
+			Name:     "listagg",
+			Distinct: parser.Token{Token: parser.DISTINCT, Literal: "distinct"},
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.NewStringValue(","),
+			},
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}}},
+				},
+			},
+		},
+		Error: "field notexist does not exist",
+	},
+	{
+		Name: "ListAgg Function Nested Error",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewNull(),
+								value.NewInteger(3),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("str1"),
+								value.NewString("str2"),
+								value.NewString("str3"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.ListFunction{
+			Name: "listagg",
+			Args: []parser.QueryExpression{
+				parser.AggregateFunction{
+					Name:     "avg",
+					Distinct: parser.Token{},
+					Args: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
+				},
+			},
+		},
+		Error: "aggregate functions are nested at LISTAGG(AVG(column1))",
+	},
+	{
+		Name: "ListAgg Function Second Argument Evaluation Error",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewNull(),
+								value.NewInteger(3),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("str1"),
+								value.NewString("str2"),
+								value.NewString("str3"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.ListFunction{
+			Name: "listagg",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+		},
+		Error: "the second argument must be a string for function listagg",
+	},
+	{
+		Name: "ListAgg Function Second Argument Not String Error",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewNull(),
+								value.NewInteger(3),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("str1"),
+								value.NewString("str2"),
+								value.NewString("str3"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.ListFunction{
+			Name: "listagg",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				parser.NewNullValue(),
+			},
+		},
+		Error: "the second argument must be a string for function listagg",
+	},
+	{
+		Name: "ListAgg Function As a Statement Error",
+		Expr: parser.ListFunction{
+			Name:     "listagg",
+			Distinct: parser.Token{Token: parser.DISTINCT, Literal: "distinct"},
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.NewStringValue(","),
+			},
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+				},
+			},
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Aggregate Function Conditional",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("str2"),
+								value.NewString("str1"),
+								value.NewNull(),
+								value.NewString("str2"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.AggregateFunction{
+			Name: "sum_if",
+			Args: []parser.QueryExpression{
+				parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					RHS:      parser.NewIntegerValue(1),
+					Operator: parser.Token{Token: '>', Literal: ">"},
+				},
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+		},
+		Result: value.NewInteger(9),
+	},
+	{
+		Name: "Aggregate Function Conditional Count",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("str2"),
+								value.NewString("str1"),
+								value.NewNull(),
+								value.NewString("str2"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.AggregateFunction{
+			Name: "count_if",
+			Args: []parser.QueryExpression{
+				parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					RHS:      parser.NewStringValue("str2"),
+					Operator: parser.Token{Token: '=', Literal: "="},
+				},
+			},
+		},
+		Result: value.NewInteger(2),
+	},
+	{
+		Name: "Aggregate Function Conditional Argument Length Error",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("str2"),
+								value.NewString("str1"),
+								value.NewNull(),
+								value.NewString("str2"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.AggregateFunction{
+			Name: "sum_if",
+			Args: []parser.QueryExpression{
+				parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					RHS:      parser.NewIntegerValue(1),
+					Operator: parser.Token{Token: '>', Literal: ">"},
+				},
+			},
+		},
+		Error: "function sum_if takes exactly 2 arguments",
+	},
+	{
+		Name: "Aggregate Function Conditional Distinct Error",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("str2"),
+								value.NewString("str1"),
+								value.NewNull(),
+								value.NewString("str2"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.AggregateFunction{
+			Name:     "count_if",
+			Distinct: parser.Token{Token: parser.DISTINCT, Literal: "distinct"},
+			Args: []parser.QueryExpression{
+				parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					RHS:      parser.NewStringValue("str2"),
+					Operator: parser.Token{Token: '=', Literal: "="},
+				},
+			},
+		},
+		Error: "DISTINCT cannot be specified for function count_if",
+	},
+	{
+		Name: "Mode Function",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("str2"),
+								value.NewString("str1"),
+								value.NewNull(),
+								value.NewString("str2"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.ListFunction{
+			Name: "mode",
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+				},
+			},
+		},
+		Result: value.NewString("str2"),
+	},
+	{
+		Name: "Mode Function Argument Length Error",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("str2"),
+								value.NewString("str1"),
+								value.NewNull(),
+								value.NewString("str2"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.ListFunction{
+			Name: "mode",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+				},
+			},
+		},
+		Error: "function mode takes no argument",
+	},
+	{
+		Name: "Mode Function Within Group Error",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("str2"),
+								value.NewString("str1"),
+								value.NewNull(),
+								value.NewString("str2"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.ListFunction{
+			Name: "mode",
+		},
+		Error: "the WITHIN GROUP clause must have exactly one order item for function mode",
+	},
+	{
+		Name: "First Function",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("str2"),
+								value.NewString("str1"),
+								value.NewNull(),
+								value.NewString("str2"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.ListFunction{
+			Name: "first",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+				},
+			},
+		},
+		Result: value.NewInteger(2),
+	},
+	{
+		Name: "Last Function",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("str2"),
+								value.NewString("str1"),
+								value.NewNull(),
+								value.NewString("str2"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.ListFunction{
+			Name: "last",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{
+						Value:     parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+						Direction: parser.Token{Token: parser.DESC, Literal: "desc"},
+					},
+				},
+			},
+		},
+		Result: value.NewString("str2"),
+	},
+	{
+		Name: "First Function Argument Length Error",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("str2"),
+								value.NewString("str1"),
+								value.NewNull(),
+								value.NewString("str2"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.ListFunction{
+			Name: "first",
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+				},
+			},
+		},
+		Error: "function first takes exactly 1 argument",
 	},
 	{
-		Name: "ListAgg Function",
+		Name: "First Function Sort Value Error",
 		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
 			{
 				view: &View{
@@ -3274,22 +4653,20 @@ var evaluateTests = []struct {
 			},
 		}),
 		Expr: parser.ListFunction{
-			Name:     "listagg",
-			Distinct: parser.Token{Token: parser.DISTINCT, Literal: "distinct"},
+			Name: "first",
 			Args: []parser.QueryExpression{
-				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
-				parser.NewStringValue(","),
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
 			},
 			OrderBy: parser.OrderByClause{
 				Items: []parser.QueryExpression{
-					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}}},
 				},
 			},
 		},
-		Result: value.NewString("str1,str2"),
+		Error: "field notexist does not exist",
 	},
 	{
-		Name: "ListAgg Function Null",
+		Name: "TopK Function",
 		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
 			{
 				view: &View{
@@ -3309,10 +4686,10 @@ var evaluateTests = []struct {
 								value.NewInteger(4),
 							}),
 							NewGroupCell([]value.Primary{
+								value.NewString("str2"),
+								value.NewString("str1"),
 								value.NewNull(),
-								value.NewNull(),
-								value.NewNull(),
-								value.NewNull(),
+								value.NewString("str2"),
 							}),
 						},
 					},
@@ -3323,82 +4700,115 @@ var evaluateTests = []struct {
 			},
 		}),
 		Expr: parser.ListFunction{
-			Distinct: parser.Token{Token: parser.DISTINCT, Literal: "distinct"},
+			Name: "top_k",
 			Args: []parser.QueryExpression{
 				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
-				parser.NewStringValue(","),
+				parser.NewIntegerValue(5),
 			},
 		},
-		Result: value.NewNull(),
+		Result: value.NewString("[{\"value\":\"str2\",\"count\":2},{\"value\":\"str1\",\"count\":1},{\"value\":null,\"count\":1}]"),
 	},
 	{
-		Name: "ListAgg Function Argument Length Error",
+		Name: "TopK Function Distinct Error",
 		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
 			{
 				view: &View{
 					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
 					RecordSet: []Record{
-						NewRecordWithId(1, []value.Primary{
-							value.NewInteger(1),
-							value.NewString("str2"),
-						}),
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("str2"),
+								value.NewString("str1"),
+								value.NewNull(),
+								value.NewString("str2"),
+							}),
+						},
 					},
+					isGrouped: true,
 				},
 				recordIndex: 0,
 				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
 			},
 		}),
 		Expr: parser.ListFunction{
-			Name:     "listagg",
+			Name:     "top_k",
 			Distinct: parser.Token{Token: parser.DISTINCT, Literal: "distinct"},
-			OrderBy: parser.OrderByClause{
-				Items: []parser.QueryExpression{
-					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
-				},
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.NewIntegerValue(5),
 			},
 		},
-		Error: "function listagg takes 1 or 2 arguments",
+		Error: "DISTINCT cannot be specified for function top_k",
 	},
 	{
-		Name: "ListAgg Function Not Grouped Error",
+		Name: "TopK Function Second Argument Error",
 		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
 			{
 				view: &View{
 					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
 					RecordSet: []Record{
-						NewRecordWithId(1, []value.Primary{
-							value.NewInteger(1),
-							value.NewString("str2"),
-						}),
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("str2"),
+								value.NewString("str1"),
+								value.NewNull(),
+								value.NewString("str2"),
+							}),
+						},
 					},
+					isGrouped: true,
 				},
 				recordIndex: 0,
 				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
 			},
 		}),
 		Expr: parser.ListFunction{
-			Name:     "listagg",
-			Distinct: parser.Token{Token: parser.DISTINCT, Literal: "distinct"},
+			Name: "top_k",
 			Args: []parser.QueryExpression{
 				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
-				parser.NewStringValue(","),
-			},
-			OrderBy: parser.OrderByClause{
-				Items: []parser.QueryExpression{
-					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
-				},
+				parser.NewStringValue("a"),
 			},
 		},
-		Error: "function listagg cannot aggregate not grouping records",
+		Error: "the second argument must be a positive integer for function top_k",
 	},
 	{
-		Name: "ListAgg Function Sort Error",
+		Name: "Histogram Function",
 		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
 			{
 				view: &View{
 					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
 					RecordSet: []Record{
 						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
 							NewGroupCell([]value.Primary{
 								value.NewInteger(1),
 								value.NewInteger(2),
@@ -3420,22 +4830,18 @@ var evaluateTests = []struct {
 			},
 		}),
 		Expr: parser.ListFunction{
-			Name:     "listagg",
-			Distinct: parser.Token{Token: parser.DISTINCT, Literal: "distinct"},
+			Name: "histogram",
 			Args: []parser.QueryExpression{
-				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
-				parser.NewStringValue(","),
-			},
-			OrderBy: parser.OrderByClause{
-				Items: []parser.QueryExpression{
-					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}}},
-				},
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				parser.NewIntegerValue(1),
+				parser.NewIntegerValue(4),
+				parser.NewIntegerValue(3),
 			},
 		},
-		Error: "field notexist does not exist",
+		Result: value.NewString("{\"buckets\":[1,1,1],\"underflow\":0,\"overflow\":1,\"invalid\":0}"),
 	},
 	{
-		Name: "ListAgg Function Nested Error",
+		Name: "Histogram Function Arguments Error",
 		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
 			{
 				view: &View{
@@ -3444,13 +4850,21 @@ var evaluateTests = []struct {
 						{
 							NewGroupCell([]value.Primary{
 								value.NewInteger(1),
-								value.NewNull(),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
 								value.NewInteger(3),
+								value.NewInteger(4),
 							}),
 							NewGroupCell([]value.Primary{
+								value.NewString("str2"),
 								value.NewString("str1"),
+								value.NewNull(),
 								value.NewString("str2"),
-								value.NewString("str3"),
 							}),
 						},
 					},
@@ -3461,21 +4875,16 @@ var evaluateTests = []struct {
 			},
 		}),
 		Expr: parser.ListFunction{
-			Name: "listagg",
+			Name: "histogram",
 			Args: []parser.QueryExpression{
-				parser.AggregateFunction{
-					Name:     "avg",
-					Distinct: parser.Token{},
-					Args: []parser.QueryExpression{
-						parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
-					},
-				},
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				parser.NewIntegerValue(1),
 			},
 		},
-		Error: "aggregate functions are nested at LISTAGG(AVG(column1))",
+		Error: "function histogram takes exactly 4 arguments",
 	},
 	{
-		Name: "ListAgg Function Second Argument Evaluation Error",
+		Name: "Histogram Function Second Argument Error",
 		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
 			{
 				view: &View{
@@ -3484,13 +4893,21 @@ var evaluateTests = []struct {
 						{
 							NewGroupCell([]value.Primary{
 								value.NewInteger(1),
-								value.NewNull(),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
 								value.NewInteger(3),
+								value.NewInteger(4),
 							}),
 							NewGroupCell([]value.Primary{
+								value.NewString("str2"),
 								value.NewString("str1"),
+								value.NewNull(),
 								value.NewString("str2"),
-								value.NewString("str3"),
 							}),
 						},
 					},
@@ -3501,16 +4918,18 @@ var evaluateTests = []struct {
 			},
 		}),
 		Expr: parser.ListFunction{
-			Name: "listagg",
+			Name: "histogram",
 			Args: []parser.QueryExpression{
 				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
-				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.NewStringValue("a"),
+				parser.NewIntegerValue(4),
+				parser.NewIntegerValue(3),
 			},
 		},
-		Error: "the second argument must be a string for function listagg",
+		Error: "the second argument must be a number for function histogram",
 	},
 	{
-		Name: "ListAgg Function Second Argument Not String Error",
+		Name: "Histogram Function Fourth Argument Error",
 		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
 			{
 				view: &View{
@@ -3519,13 +4938,21 @@ var evaluateTests = []struct {
 						{
 							NewGroupCell([]value.Primary{
 								value.NewInteger(1),
-								value.NewNull(),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
 								value.NewInteger(3),
+								value.NewInteger(4),
 							}),
 							NewGroupCell([]value.Primary{
+								value.NewString("str2"),
 								value.NewString("str1"),
+								value.NewNull(),
 								value.NewString("str2"),
-								value.NewString("str3"),
 							}),
 						},
 					},
@@ -3536,30 +4963,15 @@ var evaluateTests = []struct {
 			},
 		}),
 		Expr: parser.ListFunction{
-			Name: "listagg",
+			Name: "histogram",
 			Args: []parser.QueryExpression{
 				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
-				parser.NewNullValue(),
-			},
-		},
-		Error: "the second argument must be a string for function listagg",
-	},
-	{
-		Name: "ListAgg Function As a Statement Error",
-		Expr: parser.ListFunction{
-			Name:     "listagg",
-			Distinct: parser.Token{Token: parser.DISTINCT, Literal: "distinct"},
-			Args: []parser.QueryExpression{
-				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
-				parser.NewStringValue(","),
-			},
-			OrderBy: parser.OrderByClause{
-				Items: []parser.QueryExpression{
-					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
-				},
+				parser.NewIntegerValue(1),
+				parser.NewIntegerValue(4),
+				parser.NewIntegerValue(0),
 			},
 		},
-		Result: value.NewNull(),
+		Error: "the fourth argument must be an integer greater than 0 for function histogram",
 	},
 	{
 		Name: "JsonAgg Function",
@@ -3666,6 +5078,99 @@ var evaluateTests = []struct {
 		},
 		Result: value.NewString("B"),
 	},
+	{
+		Name: "CaseExpr Comparison with Operator",
+		Expr: parser.CaseExpr{
+			Value: parser.NewIntegerValue(15),
+			When: []parser.QueryExpression{
+				parser.CaseExprWhen{
+					Condition: parser.Comparison{
+						RHS:      parser.NewIntegerValue(10),
+						Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: "<"},
+					},
+					Result: parser.NewStringValue("low"),
+				},
+				parser.CaseExprWhen{
+					Condition: parser.Between{
+						Low:  parser.NewIntegerValue(10),
+						High: parser.NewIntegerValue(20),
+					},
+					Result: parser.NewStringValue("mid"),
+				},
+			},
+			Else: parser.CaseExprElse{
+				Result: parser.NewStringValue("high"),
+			},
+		},
+		Result: value.NewString("mid"),
+	},
+	{
+		Name: "CaseExpr Comparison with Negated Between",
+		Expr: parser.CaseExpr{
+			Value: parser.NewIntegerValue(15),
+			When: []parser.QueryExpression{
+				parser.CaseExprWhen{
+					Condition: parser.Between{
+						Low:      parser.NewIntegerValue(10),
+						High:     parser.NewIntegerValue(20),
+						Negation: parser.Token{Token: parser.NOT, Literal: "not"},
+					},
+					Result: parser.NewStringValue("outside"),
+				},
+			},
+			Else: parser.CaseExprElse{
+				Result: parser.NewStringValue("inside"),
+			},
+		},
+		Result: value.NewString("inside"),
+	},
+	{
+		Name: "CaseExpr Comparison with Operator and Null Value",
+		Expr: parser.CaseExpr{
+			Value: parser.NewNullValue(),
+			When: []parser.QueryExpression{
+				parser.CaseExprWhen{
+					Condition: parser.Comparison{
+						RHS:      parser.NewIntegerValue(10),
+						Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: "<"},
+					},
+					Result: parser.NewStringValue("low"),
+				},
+			},
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "CaseExpr Comparison with Operator Error",
+		Expr: parser.CaseExpr{
+			Value: parser.NewIntegerValue(15),
+			When: []parser.QueryExpression{
+				parser.CaseExprWhen{
+					Condition: parser.Comparison{
+						RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+						Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: "<"},
+					},
+					Result: parser.NewStringValue("low"),
+				},
+			},
+		},
+		Error: "field notexist does not exist",
+	},
+	{
+		Name: "CaseExpr Case Value Not Specified Error",
+		Expr: parser.CaseExpr{
+			When: []parser.QueryExpression{
+				parser.CaseExprWhen{
+					Condition: parser.Comparison{
+						RHS:      parser.NewIntegerValue(10),
+						Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: "<"},
+					},
+					Result: parser.NewStringValue("low"),
+				},
+			},
+		},
+		Error: "condition < 10 requires a value to be compared in case expression",
+	},
 	{
 		Name: "CaseExpr Filter",
 		Expr: parser.CaseExpr{
@@ -4072,6 +5577,8 @@ func TestEvaluate(t *testing.T) {
 
 	for _, v := range evaluateTests {
 		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		TestTx.Flags.ConcatNullAsEmpty = v.ConcatNullAsEmpty
+		TestTx.Flags.ImportOptions.NoInfer = v.NoInfer
 
 		if v.Scope == nil {
 			v.Scope = scope
@@ -4341,3 +5848,30 @@ func BenchmarkEvaluateFieldReference(b *testing.B) {
 		_, _ = Evaluate(ctx, scope, expr)
 	}
 }
+
+func TestEvaluateDatetimeLiteralAfterTimezoneChanged(t *testing.T) {
+	defer func() {
+		_ = TestTx.Flags.SetLocation(TestLocation)
+	}()
+
+	_ = TestTx.Flags.SetLocation("UTC")
+	expr := parser.NewDatetimeValueFromString("2012-02-03 09:18:15", nil)
+
+	_ = TestTx.Flags.SetLocation("Asia/Tokyo")
+	scope := NewReferenceScope(TestTx)
+	result, err := Evaluate(context.Background(), scope, expr)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	loc, _ := time.LoadLocation("Asia/Tokyo")
+	expect := time.Date(2012, 2, 3, 9, 18, 15, 0, loc)
+	if !result.(*value.Datetime).Raw().Equal(expect) {
+		t.Errorf("result = %s, want %s", result, value.NewDatetime(expect))
+	}
+
+	cached, _ := Evaluate(context.Background(), scope, expr)
+	if cached != result {
+		t.Errorf("datetime literal is re-parsed, want the cached value %s", result)
+	}
+}
@@ -0,0 +1,260 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+const ExplainStageIndent = "  "
+
+// ExplainStage is a measurement of a stage of a query executed by EXPLAIN ANALYZE.
+type ExplainStage struct {
+	Name    string
+	Depth   int
+	RowsIn  int
+	RowsOut int
+	Time    time.Duration
+	Memory  int64
+}
+
+type queryProfile struct {
+	Stages []ExplainStage
+	depth  int
+}
+
+// measureStage executes fn as a stage of a query, and records the number of rows
+// of the view before and after the stage, the elapsed time and the peak memory of
+// the materialized views if the scope is profiled by EXPLAIN ANALYZE.
+// Stages executed in fn are recorded as child stages.
+func (rs *ReferenceScope) measureStage(name string, view **View, fn func() error) error {
+	if rs.profile == nil {
+		return fn()
+	}
+
+	p := rs.profile
+	idx := len(p.Stages)
+	stage := ExplainStage{
+		Name:   name,
+		Depth:  p.depth,
+		RowsIn: -1,
+	}
+	if *view != nil {
+		stage.RowsIn = (*view).RecordLen()
+	}
+	p.Stages = append(p.Stages, stage)
+
+	p.depth++
+	start := time.Now()
+	err := fn()
+	p.Stages[idx].Time = time.Since(start)
+	p.depth--
+
+	p.Stages[idx].RowsOut = -1
+	if err == nil && *view != nil {
+		p.Stages[idx].RowsOut = (*view).RecordLen()
+		p.Stages[idx].Memory = viewMemory(*view)
+	}
+	for _, child := range p.Stages[idx+1:] {
+		if p.Stages[idx].Memory < child.Memory {
+			p.Stages[idx].Memory = child.Memory
+		}
+	}
+	return err
+}
+
+// withoutProfile returns a scope that does not record stages. Subqueries in
+// expressions are evaluated with this scope because they may be evaluated
+// concurrently for each record.
+func (rs *ReferenceScope) withoutProfile() *ReferenceScope {
+	if rs.profile == nil {
+		return rs
+	}
+	scope := *rs
+	scope.profile = nil
+	return &scope
+}
+
+// ExplainAnalyze executes the query and returns a view that shows the number of
+// rows, the elapsed time and the peak memory of each stage of the query.
+// Changes made by an update or delete query are discarded unless APPLY is specified.
+func ExplainAnalyze(ctx context.Context, scope *ReferenceScope, expr parser.ExplainAnalyze) (*View, error) {
+	queryScope := scope.CreateNode()
+	defer queryScope.CloseCurrentNode()
+
+	profile := &queryProfile{}
+	queryScope.profile = profile
+
+	start := time.Now()
+	rows, err := explainQuery(ctx, queryScope, expr)
+	if err != nil {
+		return nil, err
+	}
+	total := time.Since(start)
+
+	var peak int64
+	recordSet := make(RecordSet, 0, len(profile.Stages)+1)
+	for _, stage := range profile.Stages {
+		recordSet = append(recordSet, NewRecord([]value.Primary{
+			value.NewString(strings.Repeat(ExplainStageIndent, stage.Depth) + stage.Name),
+			explainRowCount(stage.RowsIn),
+			explainRowCount(stage.RowsOut),
+			explainTime(stage.Time),
+			value.NewInteger(stage.Memory),
+		}))
+		if peak < stage.Memory {
+			peak = stage.Memory
+		}
+	}
+	recordSet = append(recordSet, NewRecord([]value.Primary{
+		value.NewString("TOTAL"),
+		value.NewNull(),
+		value.NewInteger(int64(rows)),
+		explainTime(total),
+		value.NewInteger(peak),
+	}))
+
+	return &View{
+		Header:    NewHeader("", []string{"stage", "rows_in", "rows_out", "time_ms", "peak_memory"}),
+		RecordSet: recordSet,
+	}, nil
+}
+
+// explainQuery executes the query of EXPLAIN ANALYZE and returns the number of
+// rows of the result, or the number of affected records.
+func explainQuery(ctx context.Context, scope *ReferenceScope, expr parser.ExplainAnalyze) (int, error) {
+	if query, ok := expr.Query.(parser.SelectQuery); ok {
+		view, err := Select(ctx, scope, query)
+		if err != nil {
+			return 0, err
+		}
+		return view.RecordLen(), nil
+	}
+
+	var stage, name string
+	var exec func() ([]*FileInfo, []int, *View, error)
+	switch query := expr.Query.(type) {
+	case parser.UpdateQuery:
+		stage, name = "UPDATE", "updated"
+		exec = func() ([]*FileInfo, []int, *View, error) { return Update(ctx, scope, query) }
+	case parser.DeleteQuery:
+		stage, name = "DELETE", "deleted"
+		exec = func() ([]*FileInfo, []int, *View, error) { return Delete(ctx, scope, query) }
+	}
+
+	sp := createSavepoint(scope)
+
+	var fileInfos []*FileInfo
+	var counts []int
+	var view *View
+	idx := len(scope.profile.Stages)
+	err := scope.measureStage(stage, &view, func() (e error) {
+		fileInfos, counts, _, e = exec()
+		return e
+	})
+	if err != nil || !expr.Apply {
+		sp.rollback(scope)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	total := 0
+	for i, info := range fileInfos {
+		if expr.Apply {
+			if 0 < counts[i] {
+				scope.Tx.uncommittedViews.SetForUpdatedView(info)
+			}
+			scope.Tx.Log(fmt.Sprintf("%s %s on %q.", FormatCount(counts[i], "record"), name, info.Path), scope.Tx.Flags.Quiet)
+		}
+		total += counts[i]
+	}
+	scope.profile.Stages[idx].RowsOut = total
+	return total, nil
+}
+
+func explainRowCount(n int) value.Primary {
+	if n < 0 {
+		return value.NewNull()
+	}
+	return value.NewInteger(int64(n))
+}
+
+func explainTime(d time.Duration) value.Primary {
+	return value.NewFloat(float64(d.Nanoseconds()/1000) / 1000)
+}
+
+// savepoint holds the views cached in the transaction and the temporary tables
+// at a point of time, in order to discard the changes made after that.
+type savepoint struct {
+	cachedViews     map[string]*View
+	temporaryTables []map[string]*View
+}
+
+func createSavepoint(scope *ReferenceScope) *savepoint {
+	sp := &savepoint{
+		cachedViews:     viewMapEntries(scope.Tx.cachedViews),
+		temporaryTables: make([]map[string]*View, len(scope.blocks)),
+	}
+	for i := range scope.blocks {
+		sp.temporaryTables[i] = viewMapEntries(scope.blocks[i].temporaryTables)
+	}
+	return sp
+}
+
+// rollback restores the views held by the savepoint.
+// Views loaded after the savepoint are disposed.
+func (sp *savepoint) rollback(scope *ReferenceScope) {
+	for i := range scope.blocks {
+		for key, view := range sp.temporaryTables[i] {
+			scope.blocks[i].temporaryTables.store(key, view)
+		}
+	}
+	for _, key := range scope.Tx.cachedViews.Keys() {
+		if view, ok := sp.cachedViews[key]; ok {
+			scope.Tx.cachedViews.store(key, view)
+		} else {
+			_ = scope.Tx.cachedViews.Dispose(scope.Tx.FileContainer, key)
+		}
+	}
+}
+
+func viewMapEntries(m ViewMap) map[string]*View {
+	entries := make(map[string]*View)
+	m.Range(func(key, value interface{}) bool {
+		entries[key.(string)] = value.(*View)
+		return true
+	})
+	return entries
+}
+
+// viewMemory returns the approximate number of bytes used by the records of the view.
+func viewMemory(view *View) int64 {
+	const sliceSize = 24
+	const interfaceSize = 16
+
+	var size int64
+	for _, record := range view.RecordSet {
+		size += sliceSize + int64(len(record))*sliceSize
+		for _, cell := range record {
+			size += int64(len(cell)) * interfaceSize
+			for _, p := range cell {
+				switch v := p.(type) {
+				case *value.String:
+					size += 16 + int64(len(v.Raw()))
+				case *value.Integer, *value.Float, *value.Ternary:
+					size += 8
+				case *value.Boolean:
+					size += 1
+				case *value.Datetime:
+					size += 24
+				}
+			}
+		}
+	}
+	return size
+}
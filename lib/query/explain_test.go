@@ -0,0 +1,271 @@
+package query
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+type explainStageResult struct {
+	Name    string
+	RowsIn  interface{}
+	RowsOut interface{}
+}
+
+var explainAnalyzeTests = []struct {
+	Name           string
+	Expr           parser.ExplainAnalyze
+	Result         []explainStageResult
+	UpdatedRecords int
+	Error          string
+}{
+	{
+		Name: "Explain Analyze",
+		Expr: parser.ExplainAnalyze{
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+						},
+					},
+					FromClause: parser.FromClause{
+						Tables: []parser.QueryExpression{
+							parser.Table{Object: parser.Identifier{Literal: "table1"}},
+						},
+					},
+					WhereClause: parser.WhereClause{
+						Filter: parser.Comparison{
+							LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+							RHS:      parser.NewIntegerValueFromString("1"),
+							Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: ">"},
+						},
+					},
+				},
+				LimitClause: parser.LimitClause{
+					Type:  parser.Token{Token: parser.LIMIT},
+					Value: parser.NewIntegerValueFromString("1"),
+				},
+			},
+		},
+		Result: []explainStageResult{
+			{Name: "FROM", RowsIn: nil, RowsOut: int64(3)},
+			{Name: "WHERE", RowsIn: int64(3), RowsOut: int64(2)},
+			{Name: "SELECT", RowsIn: int64(2), RowsOut: int64(2)},
+			{Name: "LIMIT", RowsIn: int64(2), RowsOut: int64(1)},
+			{Name: "TOTAL", RowsIn: nil, RowsOut: int64(1)},
+		},
+	},
+	{
+		Name: "Explain Analyze Set Operation",
+		Expr: parser.ExplainAnalyze{
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectSet{
+					LHS: parser.SelectEntity{
+						SelectClause: parser.SelectClause{
+							Fields: []parser.QueryExpression{
+								parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+							},
+						},
+						FromClause: parser.FromClause{
+							Tables: []parser.QueryExpression{
+								parser.Table{Object: parser.Identifier{Literal: "table1"}},
+							},
+						},
+					},
+					Operator: parser.Token{Token: parser.UNION, Literal: "union"},
+					All:      parser.Token{Token: parser.ALL, Literal: "all"},
+					RHS: parser.SelectEntity{
+						SelectClause: parser.SelectClause{
+							Fields: []parser.QueryExpression{
+								parser.Field{Object: parser.NewIntegerValueFromString("1")},
+							},
+						},
+					},
+				},
+			},
+		},
+		Result: []explainStageResult{
+			{Name: "UNION ALL", RowsIn: nil, RowsOut: int64(4)},
+			{Name: "  FROM", RowsIn: nil, RowsOut: int64(3)},
+			{Name: "  SELECT", RowsIn: int64(3), RowsOut: int64(3)},
+			{Name: "  FROM", RowsIn: nil, RowsOut: int64(1)},
+			{Name: "  SELECT", RowsIn: int64(1), RowsOut: int64(1)},
+			{Name: "TOTAL", RowsIn: nil, RowsOut: int64(4)},
+		},
+	},
+	{
+		Name: "Explain Analyze Update",
+		Expr: parser.ExplainAnalyze{
+			Query: parser.UpdateQuery{
+				Tables: []parser.QueryExpression{
+					parser.Table{Object: parser.Identifier{Literal: "table1"}},
+				},
+				SetList: []parser.UpdateSet{
+					{
+						Field: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+						Value: parser.NewStringValue("updated"),
+					},
+				},
+				WhereClause: parser.WhereClause{
+					Filter: parser.Comparison{
+						LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+						RHS:      parser.NewIntegerValueFromString("1"),
+						Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: ">"},
+					},
+				},
+			},
+		},
+		Result: []explainStageResult{
+			{Name: "UPDATE", RowsIn: nil, RowsOut: int64(2)},
+			{Name: "  FROM", RowsIn: nil, RowsOut: int64(3)},
+			{Name: "  WHERE", RowsIn: int64(3), RowsOut: int64(2)},
+			{Name: "TOTAL", RowsIn: nil, RowsOut: int64(2)},
+		},
+		UpdatedRecords: 0,
+	},
+	{
+		Name: "Explain Analyze Apply Update",
+		Expr: parser.ExplainAnalyze{
+			Query: parser.UpdateQuery{
+				Tables: []parser.QueryExpression{
+					parser.Table{Object: parser.Identifier{Literal: "table1"}},
+				},
+				SetList: []parser.UpdateSet{
+					{
+						Field: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+						Value: parser.NewStringValue("updated"),
+					},
+				},
+				WhereClause: parser.WhereClause{
+					Filter: parser.Comparison{
+						LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+						RHS:      parser.NewIntegerValueFromString("1"),
+						Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: ">"},
+					},
+				},
+			},
+			Apply: true,
+		},
+		Result: []explainStageResult{
+			{Name: "UPDATE", RowsIn: nil, RowsOut: int64(2)},
+			{Name: "  FROM", RowsIn: nil, RowsOut: int64(3)},
+			{Name: "  WHERE", RowsIn: int64(3), RowsOut: int64(2)},
+			{Name: "TOTAL", RowsIn: nil, RowsOut: int64(2)},
+		},
+		UpdatedRecords: 2,
+	},
+	{
+		Name: "Explain Analyze Delete",
+		Expr: parser.ExplainAnalyze{
+			Query: parser.DeleteQuery{
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+			},
+		},
+		Result: []explainStageResult{
+			{Name: "DELETE", RowsIn: nil, RowsOut: int64(3)},
+			{Name: "  FROM", RowsIn: nil, RowsOut: int64(3)},
+			{Name: "TOTAL", RowsIn: nil, RowsOut: int64(3)},
+		},
+		UpdatedRecords: 0,
+	},
+	{
+		Name: "Explain Analyze Query Error",
+		Expr: parser.ExplainAnalyze{
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}}},
+						},
+					},
+					FromClause: parser.FromClause{
+						Tables: []parser.QueryExpression{
+							parser.Table{Object: parser.Identifier{Literal: "table1"}},
+						},
+					},
+				},
+			},
+		},
+		Error: "field notexist does not exist",
+	},
+}
+
+func explainRowCountValue(p value.Primary) interface{} {
+	if i, ok := p.(*value.Integer); ok {
+		return i.Raw()
+	}
+	return nil
+}
+
+func TestExplainAnalyze(t *testing.T) {
+	defer func() {
+		_ = TestTx.ReleaseResources()
+		initFlag(TestTx.Flags)
+	}()
+
+	initFlag(TestTx.Flags)
+	TestTx.Flags.Repository = TestDir
+	ctx := context.Background()
+
+	for _, v := range explainAnalyzeTests {
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		TestTx.uncommittedViews.Clean()
+
+		view, err := ExplainAnalyze(ctx, NewReferenceScope(TestTx), v.Expr)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		if !reflect.DeepEqual(view.Header.TableColumnNames(), []string{"stage", "rows_in", "rows_out", "time_ms", "peak_memory"}) {
+			t.Errorf("%s: header = %v", v.Name, view.Header.TableColumnNames())
+		}
+
+		result := make([]explainStageResult, 0, view.RecordLen())
+		for _, record := range view.RecordSet {
+			result = append(result, explainStageResult{
+				Name:    record[0][0].(*value.String).Raw(),
+				RowsIn:  explainRowCountValue(record[1][0]),
+				RowsOut: explainRowCountValue(record[2][0]),
+			})
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result, v.Result)
+		}
+		if peak := view.RecordSet[view.RecordLen()-1][4][0].(*value.Integer).Raw(); peak < 1 {
+			t.Errorf("%s: peak memory = %d, want a positive number", v.Name, peak)
+		}
+
+		updated := 0
+		if view, ok := TestTx.cachedViews.Load(GetTestFilePath("table1.csv")); ok {
+			for _, record := range view.RecordSet {
+				if s, ok := record[1][0].(*value.String); ok && s.Raw() == "updated" {
+					updated++
+				}
+			}
+		}
+		if updated != v.UpdatedRecords {
+			t.Errorf("%s: updated records = %d, want %d", v.Name, updated, v.UpdatedRecords)
+		}
+		if _, updatedFiles := TestTx.uncommittedViews.UncommittedFiles(); (0 < v.UpdatedRecords) != (0 < len(updatedFiles)) {
+			t.Errorf("%s: uncommitted files = %v", v.Name, updatedFiles)
+		}
+	}
+	TestTx.uncommittedViews.Clean()
+}
@@ -3,9 +3,11 @@ package query
 import (
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/mithrandie/csvq/lib/cmd"
@@ -35,6 +37,7 @@ const (
 	ViewTypeFile ViewType = iota
 	ViewTypeTemporaryTable
 	ViewTypeStdin
+	ViewTypeUrl
 )
 
 var FileAttributeList = []string{
@@ -70,7 +73,10 @@ type FileInfo struct {
 
 	Format             cmd.Format
 	Delimiter          rune
+	MultiCharDelimiter string
+	DelimiterRegex     string
 	DelimiterPositions fixedlen.DelimiterPositions
+	FieldNames         []string
 	JsonQuery          string
 	Encoding           text.Encoding
 	LineBreak          text.LineBreak
@@ -78,10 +84,29 @@ type FileInfo struct {
 	EncloseAll         bool
 	JsonEscape         json.EscapeType
 	PrettyPrint        bool
+	Compression        cmd.Compression
 
 	SingleLine bool
 
+	QuoteChar    rune
+	EscapeStyle  cmd.EscapeStyle
+	customQuotes bool
+
+	// raggedRows is the number of records handled by the ragged mode on loading.
+	raggedRows RaggedRows
+
+	// recordLimit is the maximum number of records to be read on loading.
+	// All the records are read if it is 0.
+	recordLimit int
+
+	// datetimeFormats holds the datetime formats declared by the column types
+	// with the upper-cased column names as keys.
+	datetimeFormats map[string]string
+
 	Handler *file.Handler
+	// DefinitionHandler is the handler of the table definition file, which is
+	// committed with the table.
+	DefinitionHandler *file.Handler
 
 	ForUpdate bool
 	ViewType  ViewType
@@ -102,20 +127,50 @@ func NewFileInfo(
 	}
 
 	delimiter := options.Delimiter
+	multiCharDelimiter := ""
+	delimiterRegex := ""
 	encoding := options.Encoding
 	switch format {
+	case cmd.CSV:
+		multiCharDelimiter = options.MultiCharDelimiter
+		delimiterRegex = options.DelimiterRegex
 	case cmd.TSV:
 		delimiter = '\t'
-	case cmd.JSON:
+	case cmd.JSON, cmd.NDJSON:
 		encoding = text.UTF8
 	}
 
-	return &FileInfo{
-		Path:      fpath,
-		Format:    format,
-		Delimiter: delimiter,
-		Encoding:  encoding,
-	}, nil
+	fileInfo := &FileInfo{
+		Path:               fpath,
+		Format:             format,
+		Delimiter:          delimiter,
+		MultiCharDelimiter: multiCharDelimiter,
+		DelimiterRegex:     delimiterRegex,
+		Encoding:           encoding,
+	}
+	fileInfo.SetQuoteStyle(options)
+	return fileInfo, nil
+}
+
+func (f *FileInfo) SplitsFields() bool {
+	return f.Format == cmd.CSV && (0 < len(f.MultiCharDelimiter) || 0 < len(f.DelimiterRegex))
+}
+
+// SetQuoteStyle sets the quotation character and the escape style of CSV
+// from the import options. Nothing is set for the standard style.
+func (f *FileInfo) SetQuoteStyle(options cmd.ImportOptions) {
+	if options.QuoteChar == '"' && options.EscapeStyle == cmd.DoubleEscape {
+		return
+	}
+	f.QuoteChar = options.QuoteChar
+	f.EscapeStyle = options.EscapeStyle
+	f.customQuotes = true
+}
+
+// CustomizesQuotes returns true if fields of CSV are enclosed or escaped in
+// a different style from the standard one set by SetQuoteStyle.
+func (f *FileInfo) CustomizesQuotes() bool {
+	return f.customQuotes && (f.Format == cmd.CSV || f.Format == cmd.TSV) && !f.SplitsFields()
 }
 
 func (f *FileInfo) SetDelimiter(s string) error {
@@ -178,7 +233,7 @@ func (f *FileInfo) SetFormat(s string) error {
 	switch format {
 	case cmd.TSV:
 		delimiter = '\t'
-	case cmd.JSON:
+	case cmd.JSON, cmd.NDJSON:
 		encoding = text.UTF8
 	}
 
@@ -192,11 +247,11 @@ func (f *FileInfo) SetFormat(s string) error {
 func (f *FileInfo) SetEncoding(s string) error {
 	encoding, err := cmd.ParseEncoding(s)
 	if err != nil || encoding == text.AUTO {
-		return errors.New("encoding must be one of UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS")
+		return errors.New("encoding must be one of UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS|EUCJP")
 	}
 
 	switch f.Format {
-	case cmd.JSON:
+	case cmd.JSON, cmd.NDJSON:
 		if encoding != text.UTF8 {
 			return errors.New("json format is supported only UTF8")
 		}
@@ -274,6 +329,10 @@ func (f *FileInfo) IsStdin() bool {
 	return f.ViewType == ViewTypeStdin
 }
 
+func (f *FileInfo) IsUrl() bool {
+	return f.ViewType == ViewTypeUrl
+}
+
 func (f *FileInfo) ExportOptions(tx *Transaction) cmd.ExportOptions {
 	ops := tx.Flags.ExportOptions.Copy()
 	ops.Format = f.Format
@@ -286,6 +345,9 @@ func (f *FileInfo) ExportOptions(tx *Transaction) cmd.ExportOptions {
 	ops.EncloseAll = f.EncloseAll
 	ops.JsonEscape = f.JsonEscape
 	ops.PrettyPrint = f.PrettyPrint
+	ops.Compression = f.Compression
+	ops.ExcelSafe = false
+	ops.JsonKey = ""
 	return ops
 }
 
@@ -300,30 +362,65 @@ func SearchFilePath(filename parser.Identifier, repository string, options cmd.I
 		fpath, err = SearchCSVFilePath(filename, repository)
 	case cmd.JSON:
 		fpath, err = SearchJsonFilePath(filename, repository)
+	case cmd.NDJSON:
+		fpath, err = SearchNdjsonFilePath(filename, repository)
 	case cmd.FIXED:
 		fpath, err = SearchFixedLengthFilePath(filename, repository)
 	case cmd.LTSV:
 		fpath, err = SearchLTSVFilePath(filename, repository)
 	default: // AutoSelect
 		if fpath, err = SearchFilePathFromAllTypes(filename, repository); err == nil {
-			switch strings.ToLower(filepath.Ext(fpath)) {
-			case cmd.CsvExt:
-				format = cmd.CSV
-			case cmd.TsvExt:
-				format = cmd.TSV
-			case cmd.JsonExt:
-				format = cmd.JSON
-			case cmd.LtsvExt:
-				format = cmd.LTSV
-			default:
-				format = defaultFormat
-			}
+			format = detectFormat(fpath, defaultFormat)
 		}
 	}
 
 	return fpath, format, err
 }
 
+func detectFormat(fpath string, defaultFormat cmd.Format) cmd.Format {
+	switch strings.ToLower(filepath.Ext(cmd.TrimCompressionExt(fpath))) {
+	case cmd.CsvExt:
+		return cmd.CSV
+	case cmd.TsvExt:
+		return cmd.TSV
+	case cmd.JsonExt:
+		return cmd.JSON
+	case cmd.NdjsonExt, cmd.JsonlExt:
+		return cmd.NDJSON
+	case cmd.LtsvExt:
+		return cmd.LTSV
+	}
+	return defaultFormat
+}
+
+func ListTableFiles(repository string) ([]string, error) {
+	dir := repository
+	if len(dir) < 1 {
+		wd, err := os.Getwd()
+		if err != nil {
+			return nil, err
+		}
+		dir = wd
+	}
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]string, 0, len(files))
+	for _, f := range files {
+		if f.IsDir() || f.Name()[0] == '.' {
+			continue
+		}
+		if !InStrSliceWithCaseInsensitive(filepath.Ext(f.Name()), []string{cmd.CsvExt, cmd.TsvExt, cmd.JsonExt, cmd.NdjsonExt, cmd.JsonlExt, cmd.LtsvExt, cmd.TextExt}) {
+			continue
+		}
+		list = append(list, f.Name())
+	}
+	return list, nil
+}
+
 func SearchCSVFilePath(filename parser.Identifier, repository string) (string, error) {
 	return SearchFilePathWithExtType(filename, repository, []string{cmd.CsvExt, cmd.TsvExt, cmd.TextExt})
 }
@@ -332,6 +429,10 @@ func SearchJsonFilePath(filename parser.Identifier, repository string) (string,
 	return SearchFilePathWithExtType(filename, repository, []string{cmd.JsonExt})
 }
 
+func SearchNdjsonFilePath(filename parser.Identifier, repository string) (string, error) {
+	return SearchFilePathWithExtType(filename, repository, []string{cmd.NdjsonExt, cmd.JsonlExt})
+}
+
 func SearchFixedLengthFilePath(filename parser.Identifier, repository string) (string, error) {
 	return SearchFilePathWithExtType(filename, repository, []string{cmd.TextExt})
 }
@@ -341,7 +442,7 @@ func SearchLTSVFilePath(filename parser.Identifier, repository string) (string,
 }
 
 func SearchFilePathFromAllTypes(filename parser.Identifier, repository string) (string, error) {
-	return SearchFilePathWithExtType(filename, repository, []string{cmd.CsvExt, cmd.TsvExt, cmd.JsonExt, cmd.LtsvExt, cmd.TextExt})
+	return SearchFilePathWithExtType(filename, repository, []string{cmd.CsvExt, cmd.TsvExt, cmd.JsonExt, cmd.NdjsonExt, cmd.JsonlExt, cmd.LtsvExt, cmd.TextExt})
 }
 
 func SearchFilePathWithExtType(filename parser.Identifier, repository string, extTypes []string) (string, error) {
@@ -394,13 +495,16 @@ func NewFileInfoForCreate(filename parser.Identifier, repository string, delimit
 	}
 
 	var format cmd.Format
-	switch strings.ToLower(filepath.Ext(fpath)) {
+	switch strings.ToLower(filepath.Ext(cmd.TrimCompressionExt(fpath))) {
 	case cmd.TsvExt:
 		delimiter = '\t'
 		format = cmd.TSV
 	case cmd.JsonExt:
 		encoding = text.UTF8
 		format = cmd.JSON
+	case cmd.NdjsonExt, cmd.JsonlExt:
+		encoding = text.UTF8
+		format = cmd.NDJSON
 	case cmd.LtsvExt:
 		format = cmd.LTSV
 	case cmd.GfmExt:
@@ -412,13 +516,43 @@ func NewFileInfoForCreate(filename parser.Identifier, repository string, delimit
 	}
 
 	return &FileInfo{
-		Path:      fpath,
-		Delimiter: delimiter,
-		Format:    format,
-		Encoding:  encoding,
+		Path:        fpath,
+		Delimiter:   delimiter,
+		Format:      format,
+		Encoding:    encoding,
+		Compression: cmd.CompressionFromExt(fpath),
 	}, nil
 }
 
+// SearchFilePathsByGlob returns the sorted paths of the files matched by the glob pattern.
+func SearchFilePathsByGlob(glob parser.FileGlob, repository string) ([]string, error) {
+	pattern := glob.Pattern
+	if !filepath.IsAbs(pattern) {
+		if len(repository) < 1 {
+			repository, _ = os.Getwd()
+		}
+		pattern = filepath.Join(repository, pattern)
+	}
+
+	matches, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, NewInvalidPathError(glob, glob.Pattern, err.Error())
+	}
+
+	pathes := make([]string, 0, len(matches))
+	for _, fpath := range matches {
+		if info, err := os.Stat(fpath); err == nil && !info.IsDir() {
+			pathes = append(pathes, fpath)
+		}
+	}
+	if len(pathes) < 1 {
+		return nil, NewFileGlobNoMatchError(glob)
+	}
+
+	sort.Strings(pathes)
+	return pathes, nil
+}
+
 func CreateFilePath(filename parser.Identifier, repository string) (string, error) {
 	fpath := filename.Literal
 	if !filepath.IsAbs(fpath) {
@@ -9,9 +9,15 @@ import (
 	"crypto/sha512"
 	"encoding/base64"
 	"encoding/hex"
+	"fmt"
 	"hash"
+	"html"
 	"math"
+	"net"
+	"net/url"
+	"os"
 	"os/exec"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -25,118 +31,166 @@ import (
 
 	"github.com/mithrandie/go-text"
 	"github.com/mithrandie/ternary"
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/width"
 )
 
 type BuiltInFunction func(parser.Function, []value.Primary, *cmd.Flags) (value.Primary, error)
 
 var Functions = map[string]BuiltInFunction{
-	"COALESCE":         Coalesce,
-	"IF":               If,
-	"IFNULL":           Ifnull,
-	"NULLIF":           Nullif,
-	"CEIL":             Ceil,
-	"FLOOR":            Floor,
-	"ROUND":            Round,
-	"ABS":              Abs,
-	"ACOS":             Acos,
-	"ASIN":             Asin,
-	"ATAN":             Atan,
-	"ATAN2":            Atan2,
-	"COS":              Cos,
-	"SIN":              Sin,
-	"TAN":              Tan,
-	"EXP":              Exp,
-	"EXP2":             Exp2,
-	"EXPM1":            Expm1,
-	"LOG":              MathLog,
-	"LOG10":            Log10,
-	"LOG2":             Log2,
-	"LOG1P":            Log1p,
-	"SQRT":             Sqrt,
-	"POW":              Pow,
-	"BIN_TO_DEC":       BinToDec,
-	"OCT_TO_DEC":       OctToDec,
-	"HEX_TO_DEC":       HexToDec,
-	"ENOTATION_TO_DEC": EnotationToDec,
-	"BIN":              Bin,
-	"OCT":              Oct,
-	"HEX":              Hex,
-	"ENOTATION":        Enotation,
-	"NUMBER_FORMAT":    NumberFormat,
-	"RAND":             Rand,
-	"TRIM":             Trim,
-	"LTRIM":            Ltrim,
-	"RTRIM":            Rtrim,
-	"UPPER":            Upper,
-	"LOWER":            Lower,
-	"BASE64_ENCODE":    Base64Encode,
-	"BASE64_DECODE":    Base64Decode,
-	"HEX_ENCODE":       HexEncode,
-	"HEX_DECODE":       HexDecode,
-	"LEN":              Len,
-	"BYTE_LEN":         ByteLen,
-	"WIDTH":            Width,
-	"LPAD":             Lpad,
-	"RPAD":             Rpad,
-	"SUBSTRING":        Substring,
-	"SUBSTR":           Substr,
-	"INSTR":            Instr,
-	"LIST_ELEM":        ListElem,
-	"REPLACE":          ReplaceFn,
-	"FORMAT":           Format,
-	"JSON_VALUE":       JsonValue,
-	"MD5":              Md5,
-	"SHA1":             Sha1,
-	"SHA256":           Sha256,
-	"SHA512":           Sha512,
-	"MD5_HMAC":         Md5Hmac,
-	"SHA1_HMAC":        Sha1Hmac,
-	"SHA256_HMAC":      Sha256Hmac,
-	"SHA512_HMAC":      Sha512Hmac,
-	"DATETIME_FORMAT":  DatetimeFormat,
-	"YEAR":             Year,
-	"MONTH":            Month,
-	"DAY":              Day,
-	"HOUR":             Hour,
-	"MINUTE":           Minute,
-	"SECOND":           Second,
-	"MILLISECOND":      Millisecond,
-	"MICROSECOND":      Microsecond,
-	"NANOSECOND":       Nanosecond,
-	"WEEKDAY":          Weekday,
-	"UNIX_TIME":        UnixTime,
-	"UNIX_NANO_TIME":   UnixNanoTime,
-	"DAY_OF_YEAR":      DayOfYear,
-	"WEEK_OF_YEAR":     WeekOfYear,
-	"ADD_YEAR":         AddYear,
-	"ADD_MONTH":        AddMonth,
-	"ADD_DAY":          AddDay,
-	"ADD_HOUR":         AddHour,
-	"ADD_MINUTE":       AddMinute,
-	"ADD_SECOND":       AddSecond,
-	"ADD_MILLI":        AddMilli,
-	"ADD_MICRO":        AddMicro,
-	"ADD_NANO":         AddNano,
-	"TRUNC_MONTH":      TruncMonth,
-	"TRUNC_DAY":        TruncDay,
-	"TRUNC_TIME":       TruncTime,
-	"TRUNC_HOUR":       TruncTime,
-	"TRUNC_MINUTE":     TruncMinute,
-	"TRUNC_SECOND":     TruncSecond,
-	"TRUNC_MILLI":      TruncMilli,
-	"TRUNC_MICRO":      TruncMicro,
-	"TRUNC_NANO":       TruncNano,
-	"DATE_DIFF":        DateDiff,
-	"TIME_DIFF":        TimeDiff,
-	"TIME_NANO_DIFF":   TimeNanoDiff,
-	"UTC":              UTC,
-	"NANO_TO_DATETIME": NanoToDatetime,
-	"STRING":           String,
-	"INTEGER":          Integer,
-	"FLOAT":            Float,
-	"BOOLEAN":          Boolean,
-	"TERNARY":          Ternary,
-	"DATETIME":         Datetime,
+	"COALESCE":              Coalesce,
+	"IF":                    If,
+	"IFNULL":                Ifnull,
+	"NVL":                   Ifnull,
+	"ISNULL":                Ifnull,
+	"NVL2":                  Nvl2,
+	"NULLIF":                Nullif,
+	"DECODE":                Decode,
+	"MAP":                   Map,
+	"CEIL":                  Ceil,
+	"FLOOR":                 Floor,
+	"ROUND":                 Round,
+	"TRUNC":                 Trunc,
+	"ABS":                   Abs,
+	"SIGN":                  Sign,
+	"ACOS":                  Acos,
+	"ASIN":                  Asin,
+	"ATAN":                  Atan,
+	"ATAN2":                 Atan2,
+	"COS":                   Cos,
+	"SIN":                   Sin,
+	"TAN":                   Tan,
+	"EXP":                   Exp,
+	"EXP2":                  Exp2,
+	"EXPM1":                 Expm1,
+	"LOG":                   MathLog,
+	"LOG10":                 Log10,
+	"LOG2":                  Log2,
+	"LOG1P":                 Log1p,
+	"SQRT":                  Sqrt,
+	"CBRT":                  Cbrt,
+	"POW":                   Pow,
+	"SAFE_DIVIDE":           SafeDivide,
+	"WIDTH_BUCKET":          WidthBucket,
+	"CLAMP":                 Clamp,
+	"BIN_TO_DEC":            BinToDec,
+	"OCT_TO_DEC":            OctToDec,
+	"HEX_TO_DEC":            HexToDec,
+	"ENOTATION_TO_DEC":      EnotationToDec,
+	"BIN":                   Bin,
+	"OCT":                   Oct,
+	"HEX":                   Hex,
+	"ENOTATION":             Enotation,
+	"NUMBER_FORMAT":         NumberFormat,
+	"TO_NUMBER":             ToNumber,
+	"FORMAT_NUMBER":         FormatNumber,
+	"PARSE_NUMBER":          ParseNumber,
+	"RAND":                  Rand,
+	"TRIM":                  Trim,
+	"LTRIM":                 Ltrim,
+	"RTRIM":                 Rtrim,
+	"UPPER":                 Upper,
+	"LOWER":                 Lower,
+	"BASE64_ENCODE":         Base64Encode,
+	"BASE64_DECODE":         Base64Decode,
+	"HEX_ENCODE":            HexEncode,
+	"HEX_DECODE":            HexDecode,
+	"URL_ENCODE":            UrlEncode,
+	"URL_DECODE":            UrlDecode,
+	"HTML_ESCAPE":           HtmlEscape,
+	"HTML_UNESCAPE":         HtmlUnescape,
+	"REVERSE":               Reverse,
+	"REPEAT":                Repeat,
+	"LEN":                   Len,
+	"BYTE_LEN":              ByteLen,
+	"WIDTH":                 Width,
+	"NORMALIZE":             Normalize,
+	"TO_HALFWIDTH":          ToHalfwidth,
+	"TO_FULLWIDTH":          ToFullwidth,
+	"LEVENSHTEIN":           Levenshtein,
+	"SIMILARITY":            Similarity,
+	"SOUNDEX":               Soundex,
+	"LPAD":                  Lpad,
+	"RPAD":                  Rpad,
+	"SUBSTRING":             Substring,
+	"SUBSTR":                Substr,
+	"INSTR":                 Instr,
+	"POSITION":              Instr,
+	"LIST_ELEM":             ListElem,
+	"CONCAT_WS":             ConcatWs,
+	"REPLACE":               ReplaceFn,
+	"GLOB":                  GlobFn,
+	"FORMAT":                Format,
+	"JSON_VALUE":            JsonValue,
+	"MD5":                   Md5,
+	"SHA1":                  Sha1,
+	"SHA256":                Sha256,
+	"SHA512":                Sha512,
+	"MD5_HMAC":              Md5Hmac,
+	"SHA1_HMAC":             Sha1Hmac,
+	"SHA256_HMAC":           Sha256Hmac,
+	"SHA512_HMAC":           Sha512Hmac,
+	"INET_ATON":             InetAton,
+	"INET_NTOA":             InetNtoa,
+	"IP_IN_CIDR":            IpInCidr,
+	"IP_NORMALIZE":          IpNormalize,
+	"CLOCK_TIMESTAMP":       ClockTimestamp,
+	"DATETIME_FORMAT":       DatetimeFormat,
+	"PARSE_DATETIME":        ParseDatetime,
+	"PARSE_DATETIME_STRICT": ParseDatetimeStrict,
+	"TO_CHAR":               ToChar,
+	"YEAR":                  Year,
+	"MONTH":                 Month,
+	"DAY":                   Day,
+	"HOUR":                  Hour,
+	"MINUTE":                Minute,
+	"SECOND":                Second,
+	"MILLISECOND":           Millisecond,
+	"MICROSECOND":           Microsecond,
+	"NANOSECOND":            Nanosecond,
+	"WEEKDAY":               Weekday,
+	"UNIX_TIME":             UnixTime,
+	"UNIX_NANO_TIME":        UnixNanoTime,
+	"UNIX_TIMESTAMP":        UnixTime,
+	"UNIX_TIMESTAMP_MS":     UnixMilliTime,
+	"DAY_OF_YEAR":           DayOfYear,
+	"WEEK_OF_YEAR":          WeekOfYear,
+	"ADD_YEAR":              AddYear,
+	"ADD_MONTH":             AddMonth,
+	"ADD_DAY":               AddDay,
+	"ADD_HOUR":              AddHour,
+	"ADD_MINUTE":            AddMinute,
+	"ADD_SECOND":            AddSecond,
+	"ADD_MILLI":             AddMilli,
+	"ADD_MICRO":             AddMicro,
+	"ADD_NANO":              AddNano,
+	"TRUNC_MONTH":           TruncMonth,
+	"TRUNC_DAY":             TruncDay,
+	"TRUNC_TIME":            TruncTime,
+	"TRUNC_HOUR":            TruncTime,
+	"TRUNC_MINUTE":          TruncMinute,
+	"TRUNC_SECOND":          TruncSecond,
+	"TRUNC_MILLI":           TruncMilli,
+	"TRUNC_MICRO":           TruncMicro,
+	"TRUNC_NANO":            TruncNano,
+	"DATE_DIFF":             DateDiff,
+	"TIME_DIFF":             TimeDiff,
+	"TIME_NANO_DIFF":        TimeNanoDiff,
+	"UTC":                   UTC,
+	"NANO_TO_DATETIME":      NanoToDatetime,
+	"FROM_UNIXTIME":         FromUnixTime,
+	"FROM_UNIXTIME_MS":      FromUnixMilliTime,
+	"STRING":                String,
+	"INTEGER":               Integer,
+	"FLOAT":                 Float,
+	"BOOLEAN":               Boolean,
+	"TERNARY":               Ternary,
+	"DATETIME":              Datetime,
+	"TYPEOF":                TypeOf,
+	"GETENV":                GetEnv,
+	"FILE_EXISTS":           FileExists,
+	"FILE_SIZE":             FileSize,
+	"FILE_MTIME":            FileMtime,
 }
 
 type Direction string
@@ -146,6 +200,13 @@ const (
 	LeftDirection            = "L"
 )
 
+type RoundingMode string
+
+const (
+	RoundHalfUp   RoundingMode = "HALF_UP"
+	RoundHalfEven RoundingMode = "HALF_EVEN"
+)
+
 type PaddingType string
 
 const (
@@ -189,6 +250,17 @@ func Ifnull(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Prima
 	return args[0], nil
 }
 
+func Nvl2(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	if len(args) != 3 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{3})
+	}
+
+	if value.IsNull(args[0]) {
+		return args[2], nil
+	}
+	return args[1], nil
+}
+
 func Nullif(fn parser.Function, args []value.Primary, flags *cmd.Flags) (value.Primary, error) {
 	if len(args) != 2 {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2})
@@ -200,6 +272,86 @@ func Nullif(fn parser.Function, args []value.Primary, flags *cmd.Flags) (value.P
 	return args[0], nil
 }
 
+func Decode(fn parser.Function, args []value.Primary, flags *cmd.Flags) (value.Primary, error) {
+	if len(args) < 3 {
+		return nil, NewFunctionArgumentLengthErrorWithCustomArgs(fn, fn.Name, "at least 3 arguments")
+	}
+
+	searchLen := len(args) - 1
+	if searchLen%2 == 1 {
+		searchLen--
+	}
+
+	for i := 1; i < searchLen; i = i + 2 {
+		if value.IsNull(args[0]) {
+			if value.IsNull(args[i]) {
+				return args[i+1], nil
+			}
+			continue
+		}
+
+		if value.Equal(args[0], args[i], flags.DatetimeFormat) == ternary.TRUE {
+			return args[i+1], nil
+		}
+	}
+
+	if searchLen < len(args)-1 {
+		return args[len(args)-1], nil
+	}
+	return value.NewNull(), nil
+}
+
+func Map(fn parser.Function, args []value.Primary, flags *cmd.Flags) (value.Primary, error) {
+	if len(args) < 2 {
+		return nil, NewFunctionArgumentLengthErrorWithCustomArgs(fn, fn.Name, "at least 2 arguments")
+	}
+
+	if len(args) <= 3 {
+		if s, ok := args[1].(*value.String); ok && strings.HasPrefix(strings.TrimSpace(s.Raw()), "{") {
+			keys, values, err := json.LoadObject(s.Raw())
+			if err != nil {
+				return nil, NewFunctionInvalidArgumentError(fn, fn.Name, fmt.Sprintf("the second argument must be a json object: %s", err.Error()))
+			}
+
+			if !value.IsNull(args[0]) {
+				for i, key := range keys {
+					if value.Equal(args[0], value.NewString(key), flags.DatetimeFormat) == ternary.TRUE {
+						return values[i], nil
+					}
+				}
+			}
+
+			if len(args) == 3 {
+				return args[2], nil
+			}
+			return args[0], nil
+		}
+	}
+
+	searchLen := len(args) - 1
+	if searchLen%2 == 1 {
+		searchLen--
+	}
+
+	for i := 1; i < searchLen; i = i + 2 {
+		if value.IsNull(args[0]) {
+			if value.IsNull(args[i]) {
+				return args[i+1], nil
+			}
+			continue
+		}
+
+		if value.Equal(args[0], args[i], flags.DatetimeFormat) == ternary.TRUE {
+			return args[i+1], nil
+		}
+	}
+
+	if searchLen < len(args)-1 {
+		return args[len(args)-1], nil
+	}
+	return args[0], nil
+}
+
 func roundParams(args []value.Primary) (number float64, place float64, isnull bool, argsErr bool) {
 	if len(args) < 1 || 2 < len(args) {
 		argsErr = true
@@ -254,18 +406,63 @@ func Floor(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primar
 	return value.ParseFloat64(r), nil
 }
 
-func round(f float64, place float64) float64 {
-	pow := math.Pow(10, place)
-	var r float64
+func roundHalfUp(f float64) float64 {
 	if f < 0 {
-		r = math.Ceil(pow*f-0.5) / pow
-	} else {
-		r = math.Floor(pow*f+0.5) / pow
+		return math.Ceil(f - 0.5)
 	}
-	return r
+	return math.Floor(f + 0.5)
+}
+
+func roundWithMode(f float64, place float64, mode RoundingMode) float64 {
+	roundf := roundHalfUp
+	if mode == RoundHalfEven {
+		roundf = math.RoundToEven
+	}
+
+	if place < 0 {
+		pow := math.Pow(10, -place)
+		return roundf(f/pow) * pow
+	}
+	pow := math.Pow(10, place)
+	return roundf(pow*f) / pow
+}
+
+func round(f float64, place float64) float64 {
+	return roundWithMode(f, place, RoundHalfUp)
 }
 
 func Round(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	if len(args) < 1 || 3 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1, 2, 3})
+	}
+
+	mode := RoundHalfUp
+	if len(args) == 3 {
+		s := value.ToString(args[2])
+		if value.IsNull(s) {
+			return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "rounding mode must be one of HALF_UP|HALF_EVEN")
+		}
+		switch RoundingMode(strings.ToUpper(s.(*value.String).Raw())) {
+		case RoundHalfUp:
+		case RoundHalfEven:
+			mode = RoundHalfEven
+		default:
+			value.Discard(s)
+			return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "rounding mode must be one of HALF_UP|HALF_EVEN")
+		}
+		value.Discard(s)
+		args = args[:2]
+	}
+
+	number, place, isnull, _ := roundParams(args)
+	if isnull {
+		return value.NewNull(), nil
+	}
+
+	return value.ParseFloat64(roundWithMode(number, place, mode)), nil
+}
+
+func Trunc(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
 	number, place, isnull, argsErr := roundParams(args)
 	if argsErr {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1, 2})
@@ -274,7 +471,15 @@ func Round(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primar
 		return value.NewNull(), nil
 	}
 
-	return value.ParseFloat64(round(number, place)), nil
+	var r float64
+	if place < 0 {
+		pow := math.Pow(10, -place)
+		r = math.Trunc(number/pow) * pow
+	} else {
+		pow := math.Pow(10, place)
+		r = math.Trunc(pow*number) / pow
+	}
+	return value.ParseFloat64(r), nil
 }
 
 func execMath1Arg(fn parser.Function, args []value.Primary, mathf func(float64) float64) (value.Primary, error) {
@@ -324,6 +529,27 @@ func Abs(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary,
 	return execMath1Arg(fn, args, math.Abs)
 }
 
+func Sign(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	if len(args) != 1 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
+	}
+
+	f := value.ToFloat(args[0])
+	if value.IsNull(f) {
+		return value.NewNull(), nil
+	}
+	number := f.(*value.Float).Raw()
+	value.Discard(f)
+
+	switch {
+	case number < 0:
+		return value.NewInteger(-1), nil
+	case 0 < number:
+		return value.NewInteger(1), nil
+	}
+	return value.NewInteger(0), nil
+}
+
 func Acos(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
 	return execMath1Arg(fn, args, math.Acos)
 }
@@ -365,6 +591,14 @@ func Expm1(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primar
 }
 
 func MathLog(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	if len(args) == 2 {
+		return execMath2Args(fn, args, func(base float64, x float64) float64 {
+			return math.Log(x) / math.Log(base)
+		})
+	}
+	if len(args) != 1 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1, 2})
+	}
 	return execMath1Arg(fn, args, math.Log)
 }
 
@@ -384,10 +618,130 @@ func Sqrt(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary
 	return execMath1Arg(fn, args, math.Sqrt)
 }
 
+func Cbrt(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	return execMath1Arg(fn, args, math.Cbrt)
+}
+
 func Pow(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
 	return execMath2Args(fn, args, math.Pow)
 }
 
+func SafeDivide(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	return execMath2Args(fn, args, func(dividend float64, divisor float64) float64 {
+		if divisor == 0 {
+			return math.NaN()
+		}
+		return dividend / divisor
+	})
+}
+
+func toBucketNumbers(args []value.Primary, flags *cmd.Flags) ([]float64, bool) {
+	numbers := make([]float64, len(args))
+
+	if f := value.ToFloat(args[0]); !value.IsNull(f) {
+		value.Discard(f)
+		for i := range args {
+			f := value.ToFloat(args[i])
+			if value.IsNull(f) {
+				return nil, false
+			}
+			numbers[i] = f.(*value.Float).Raw()
+			value.Discard(f)
+		}
+		return numbers, true
+	}
+
+	for i := range args {
+		dt := value.ToDatetime(args[i], flags.DatetimeFormat)
+		if value.IsNull(dt) {
+			return nil, false
+		}
+		t := dt.(*value.Datetime).Raw()
+		numbers[i] = float64(t.Unix()) + float64(t.Nanosecond())/1e9
+		value.Discard(dt)
+	}
+	return numbers, true
+}
+
+func WidthBucket(fn parser.Function, args []value.Primary, flags *cmd.Flags) (value.Primary, error) {
+	if len(args) != 4 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{4})
+	}
+
+	for _, arg := range args {
+		if value.IsNull(arg) {
+			return value.NewNull(), nil
+		}
+	}
+
+	p := value.ToInteger(args[3])
+	if value.IsNull(p) {
+		return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the fourth argument must be an integer greater than 0")
+	}
+	n := p.(*value.Integer).Raw()
+	value.Discard(p)
+	if n < 1 {
+		return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the fourth argument must be an integer greater than 0")
+	}
+
+	numbers, ok := toBucketNumbers(args[:3], flags)
+	if !ok {
+		return value.NewNull(), nil
+	}
+	x, low, high := numbers[0], numbers[1], numbers[2]
+
+	if high <= low {
+		return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the third argument must be greater than the second argument")
+	}
+
+	return value.NewInteger(widthBucket(x, low, high, n)), nil
+}
+
+func widthBucket(x float64, low float64, high float64, n int64) int64 {
+	var bucket int64
+	switch {
+	case x < low:
+		bucket = 0
+	case high <= x:
+		bucket = n + 1
+	default:
+		bucket = int64(math.Floor((x-low)/(high-low)*float64(n))) + 1
+		if n < bucket {
+			bucket = n
+		}
+	}
+	return bucket
+}
+
+func Clamp(fn parser.Function, args []value.Primary, flags *cmd.Flags) (value.Primary, error) {
+	if len(args) != 3 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{3})
+	}
+
+	for _, arg := range args {
+		if value.IsNull(arg) {
+			return value.NewNull(), nil
+		}
+	}
+
+	switch value.GreaterOrEqual(args[1], args[2], flags.DatetimeFormat) {
+	case ternary.TRUE:
+		return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the third argument must be greater than the second argument")
+	case ternary.UNKNOWN:
+		return value.NewNull(), nil
+	}
+
+	switch {
+	case value.Less(args[0], args[1], flags.DatetimeFormat) == ternary.TRUE:
+		return args[1], nil
+	case value.Greater(args[0], args[2], flags.DatetimeFormat) == ternary.TRUE:
+		return args[2], nil
+	case value.LessOrEqual(args[0], args[2], flags.DatetimeFormat) == ternary.TRUE:
+		return args[0], nil
+	}
+	return value.NewNull(), nil
+}
+
 func execParseInt(fn parser.Function, args []value.Primary, base int) (value.Primary, error) {
 	if len(args) != 1 {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
@@ -535,6 +889,267 @@ func NumberFormat(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value
 	return value.NewString(s), nil
 }
 
+func ToNumber(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	if len(args) < 1 || 2 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1, 2})
+	}
+
+	var decimalPoint, groupSeparator string
+	if 1 < len(args) {
+		format := value.ToString(args[1])
+		if value.IsNull(format) {
+			return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the second argument must be a string")
+		}
+		var ok bool
+		decimalPoint, groupSeparator, ok = parseNumberPattern(format.(*value.String).Raw())
+		value.Discard(format)
+		if !ok {
+			return nil, NewFunctionInvalidArgumentError(fn, fn.Name, fmt.Sprintf("%s is an invalid number format", args[1]))
+		}
+	}
+
+	s := value.ToString(args[0])
+	if value.IsNull(s) {
+		return value.NewNull(), nil
+	}
+	str := strings.TrimSpace(s.(*value.String).Raw())
+	value.Discard(s)
+	if len(str) < 1 {
+		return value.NewNull(), nil
+	}
+
+	if len(args) < 2 {
+		var ok bool
+		if decimalPoint, groupSeparator, ok = guessNumberSeparators(str); !ok {
+			return nil, NewFunctionInvalidArgumentError(fn, fn.Name, fmt.Sprintf("%s cannot be converted to a number", args[0]))
+		}
+	}
+
+	f, ok := parseNumber(str, decimalPoint, groupSeparator)
+	if !ok {
+		if len(args) < 2 {
+			return nil, NewFunctionInvalidArgumentError(fn, fn.Name, fmt.Sprintf("%s cannot be converted to a number", args[0]))
+		}
+		return nil, NewFunctionInvalidArgumentError(fn, fn.Name, fmt.Sprintf("%s cannot be converted to a number with the format %s", args[0], args[1]))
+	}
+	return value.NewFloat(f), nil
+}
+
+func FormatNumber(fn parser.Function, args []value.Primary, flags *cmd.Flags) (value.Primary, error) {
+	if len(args) < 2 || 4 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2, 3, 4})
+	}
+
+	if !value.IsNull(args[1]) {
+		i := value.ToInteger(args[1])
+		if value.IsNull(i) {
+			return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the second argument must be an integer")
+		}
+		value.Discard(i)
+	}
+
+	return NumberFormat(fn, args, flags)
+}
+
+func ParseNumber(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	if len(args) < 1 || 4 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1, 2, 3, 4})
+	}
+
+	decimalPoint := "."
+	groupSeparator := ","
+	lenient := false
+
+	if 1 < len(args) {
+		s := value.ToString(args[1])
+		if value.IsNull(s) {
+			return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the second argument must be a string")
+		}
+		decimalPoint = s.(*value.String).Raw()
+		value.Discard(s)
+	}
+	if 2 < len(args) {
+		s := value.ToString(args[2])
+		if value.IsNull(s) {
+			return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the third argument must be a string")
+		}
+		groupSeparator = s.(*value.String).Raw()
+		value.Discard(s)
+	}
+	if 3 < len(args) {
+		b := value.ToBoolean(args[3])
+		if value.IsNull(b) {
+			return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the fourth argument must be a boolean")
+		}
+		lenient = b.(*value.Boolean).Raw()
+		value.Discard(b)
+	}
+
+	if len(decimalPoint) < 1 || decimalPoint == groupSeparator {
+		return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the decimal point must be a non-empty string different from the group separator")
+	}
+
+	s := value.ToString(args[0])
+	if value.IsNull(s) {
+		return value.NewNull(), nil
+	}
+	str := s.(*value.String).Raw()
+	value.Discard(s)
+
+	if lenient {
+		str = trimCurrencySymbol(strings.TrimSpace(str))
+	}
+	if len(str) < 1 {
+		return value.NewNull(), nil
+	}
+
+	f, ok := parseNumber(str, decimalPoint, groupSeparator)
+	if !ok {
+		return value.NewNull(), nil
+	}
+	return value.NewFloat(f), nil
+}
+
+// trimCurrencySymbol removes a currency symbol that leads a number, or follows the sign of a number,
+// and spaces following the symbol.
+func trimCurrencySymbol(s string) string {
+	sign := ""
+	if 0 < len(s) && (s[0] == '-' || s[0] == '+') {
+		sign = s[:1]
+		s = s[1:]
+	}
+
+	r, size := utf8.DecodeRuneInString(s)
+	if 0 < size && unicode.Is(unicode.Sc, r) {
+		s = strings.TrimLeftFunc(s[size:], unicode.IsSpace)
+		if len(sign) < 1 && 0 < len(s) && (s[0] == '-' || s[0] == '+') {
+			sign = s[:1]
+			s = s[1:]
+		}
+	}
+	return sign + s
+}
+
+// parseNumberPattern determines the decimal point and the group separator from a pattern
+// such as "#,##0.00" or "#.##0,00".
+// If the pattern contains two kinds of separators, the last one is the decimal point.
+// If the pattern contains one kind of separator more than once, it is the group separator.
+// A separator that appears only once is the group separator when it follows "#" and is
+// followed by just three placeholders at the end of the pattern, such as "#,##0",
+// otherwise the decimal point.
+func parseNumberPattern(pattern string) (string, string, bool) {
+	return numberSeparators(pattern, false)
+}
+
+// guessNumberSeparators determines the decimal point and the group separator from a string
+// representing a number. A separator that appears only once is regarded as the decimal point.
+func guessNumberSeparators(s string) (string, string, bool) {
+	pattern := strings.Map(func(r rune) rune {
+		if '0' <= r && r <= '9' {
+			return '0'
+		}
+		return r
+	}, strings.TrimLeft(s, "+-"))
+	return numberSeparators(pattern, true)
+}
+
+func numberSeparators(pattern string, guess bool) (string, string, bool) {
+	separators := make([]rune, 0, 2)
+	counts := make(map[rune]int, 2)
+	hasPlaceholder := false
+	var last rune
+
+	for _, r := range pattern {
+		switch r {
+		case '#', '0', '9':
+			hasPlaceholder = true
+			continue
+		}
+		if _, ok := counts[r]; !ok {
+			separators = append(separators, r)
+		}
+		counts[r]++
+		last = r
+	}
+
+	if !hasPlaceholder {
+		return "", "", false
+	}
+
+	switch len(separators) {
+	case 0:
+		return "", "", true
+	case 1:
+		if counts[last] != 1 {
+			return "", string(last), true
+		}
+		if !guess {
+			idx := strings.IndexRune(pattern, last)
+			if strings.ContainsRune(pattern[:idx], '#') && len(pattern[idx+utf8.RuneLen(last):]) == 3 {
+				return "", string(last), true
+			}
+		}
+		return string(last), "", true
+	case 2:
+		if counts[last] != 1 {
+			return "", "", false
+		}
+		group := separators[0]
+		if group == last {
+			group = separators[1]
+		}
+		if strings.IndexRune(pattern, last) < strings.LastIndex(pattern, string(group)) {
+			return "", "", false
+		}
+		return string(last), string(group), true
+	}
+	return "", "", false
+}
+
+func parseNumber(s string, decimalPoint string, groupSeparator string) (float64, bool) {
+	sign := ""
+	if s[0] == '-' || s[0] == '+' {
+		sign = s[:1]
+		s = s[1:]
+	}
+
+	intPart := s
+	fracPart := ""
+	if 0 < len(decimalPoint) {
+		if idx := strings.Index(s, decimalPoint); -1 < idx {
+			intPart = s[:idx]
+			fracPart = s[idx+len(decimalPoint):]
+		}
+	}
+
+	if 0 < len(groupSeparator) && strings.Contains(intPart, groupSeparator) {
+		groups := strings.Split(intPart, groupSeparator)
+		for _, g := range groups {
+			if len(g) < 1 {
+				return 0, false
+			}
+		}
+		intPart = strings.Join(groups, "")
+	}
+
+	if len(intPart) < 1 && len(fracPart) < 1 {
+		return 0, false
+	}
+	for _, digits := range []string{intPart, fracPart} {
+		for i := 0; i < len(digits); i++ {
+			if digits[i] < '0' || '9' < digits[i] {
+				return 0, false
+			}
+		}
+	}
+
+	f, err := strconv.ParseFloat(sign+intPart+"."+fracPart, 64)
+	if err != nil {
+		return 0, false
+	}
+	return f, true
+}
+
 func Rand(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
 	if 0 < len(args) && len(args) != 2 {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{0, 2})
@@ -628,6 +1243,19 @@ func hexDecode(s string) string {
 	return string(bytes)
 }
 
+func urlDecode(s string) string {
+	decoded, _ := url.QueryUnescape(s)
+	return decoded
+}
+
+func reverse(s string) string {
+	runes := []rune(s)
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+	return string(runes)
+}
+
 func trim(s string, cutset string) string {
 	if len(cutset) < 1 {
 		return strings.TrimSpace(s)
@@ -716,7 +1344,7 @@ func execStringsPadding(fn parser.Function, args []value.Primary, direction Dire
 			value.Discard(encs)
 
 			if err != nil || e == text.AUTO {
-				return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "encoding must be one of UTF8|UTF16|SJIS")
+				return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "encoding must be one of UTF8|UTF16|SJIS|EUCJP")
 			}
 			enc = e
 		}
@@ -729,8 +1357,8 @@ func execStringsPadding(fn parser.Function, args []value.Primary, direction Dire
 		strLen = utf8.RuneCountInString(str)
 		padstrLen = utf8.RuneCountInString(padstr)
 	case PaddingByteCount:
-		strLen = text.ByteSize(str, enc)
-		padstrLen = text.ByteSize(padstr, enc)
+		strLen = cmd.ByteSize(str, enc)
+		padstrLen = cmd.ByteSize(padstr, enc)
 	case PaddingWidth:
 		strLen = cmd.TextWidth(str, flags)
 		padstrLen = cmd.TextWidth(padstr, flags)
@@ -753,7 +1381,7 @@ func execStringsPadding(fn parser.Function, args []value.Primary, direction Dire
 		for _, r := range padding {
 			switch padType {
 			case PaddingByteCount:
-				w = text.RuneByteSize(r, enc)
+				w = cmd.RuneByteSize(r, enc)
 			default:
 				w = cmd.RuneWidth(r, flags)
 			}
@@ -857,6 +1485,51 @@ func HexDecode(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Pr
 	return execStrings1Arg(fn, args, hexDecode)
 }
 
+func UrlEncode(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	return execStrings1Arg(fn, args, url.QueryEscape)
+}
+
+func UrlDecode(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	return execStrings1Arg(fn, args, urlDecode)
+}
+
+func HtmlEscape(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	return execStrings1Arg(fn, args, html.EscapeString)
+}
+
+func HtmlUnescape(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	return execStrings1Arg(fn, args, html.UnescapeString)
+}
+
+func Reverse(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	return execStrings1Arg(fn, args, reverse)
+}
+
+func Repeat(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	if len(args) != 2 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2})
+	}
+
+	s := value.ToString(args[0])
+	if value.IsNull(s) {
+		return value.NewNull(), nil
+	}
+	str := s.(*value.String).Raw()
+	value.Discard(s)
+
+	i := value.ToInteger(args[1])
+	if value.IsNull(i) {
+		return value.NewNull(), nil
+	}
+	count := i.(*value.Integer).Raw()
+	value.Discard(i)
+
+	if count < 1 {
+		return value.NewString(""), nil
+	}
+	return value.NewString(strings.Repeat(str, int(count))), nil
+}
+
 func Len(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
 	return execStringsLen(fn, args, utf8.RuneCountInString)
 }
@@ -880,13 +1553,13 @@ func ByteLen(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Prim
 
 			if err != nil || e == text.AUTO {
 				value.Discard(s)
-				return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "encoding must be one of UTF8|UTF16|SJIS")
+				return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "encoding must be one of UTF8|UTF16|SJIS|EUCJP")
 			}
 			enc = e
 		}
 	}
 
-	i := int64(text.ByteSize(s.(*value.String).Raw(), enc))
+	i := int64(cmd.ByteSize(s.(*value.String).Raw(), enc))
 	value.Discard(s)
 
 	return value.NewInteger(i), nil
@@ -907,6 +1580,234 @@ func Width(fn parser.Function, args []value.Primary, flags *cmd.Flags) (value.Pr
 	return value.NewInteger(int64(result)), nil
 }
 
+var normalizationForms = map[string]norm.Form{
+	"NFC":  norm.NFC,
+	"NFD":  norm.NFD,
+	"NFKC": norm.NFKC,
+	"NFKD": norm.NFKD,
+}
+
+func Normalize(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	if len(args) < 1 || 2 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1, 2})
+	}
+
+	form := norm.NFC
+	if 1 < len(args) {
+		f := value.ToString(args[1])
+		if value.IsNull(f) {
+			return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the second argument must be one of NFC, NFD, NFKC or NFKD")
+		}
+		var ok bool
+		form, ok = normalizationForms[strings.ToUpper(cmd.TrimSpace(f.(*value.String).Raw()))]
+		value.Discard(f)
+		if !ok {
+			return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the second argument must be one of NFC, NFD, NFKC or NFKD")
+		}
+	}
+
+	return execStrings1Arg(fn, args[:1], form.String)
+}
+
+// Voiced and semi-voiced katakana have no single halfwidth variants,
+// so they are mapped to pairs of halfwidth katakana and sound marks.
+var halfwidthVoicedKatakana, fullwidthVoicedKatakana = func() (map[rune]string, map[string]rune) {
+	toHalf := make(map[rune]string, 32)
+	toFull := make(map[string]rune, 32)
+	for r := rune(0x30A0); r <= 0x30FF; r++ {
+		d := []rune(norm.NFD.String(string(r)))
+		if len(d) != 2 || (d[1] != 0x3099 && d[1] != 0x309A) {
+			continue
+		}
+		if h := width.Narrow.String(string(d)); []rune(h)[0] != d[0] {
+			toHalf[r] = h
+			toFull[string(d)] = r
+		}
+	}
+	return toHalf, toFull
+}()
+
+func toHalfwidth(s string) string {
+	var buf strings.Builder
+	for _, r := range s {
+		if h, ok := halfwidthVoicedKatakana[r]; ok {
+			buf.WriteString(h)
+		} else {
+			buf.WriteRune(r)
+		}
+	}
+	return width.Narrow.String(buf.String())
+}
+
+func toFullwidth(s string) string {
+	runes := []rune(width.Widen.String(s))
+
+	var buf strings.Builder
+	for i := 0; i < len(runes); i++ {
+		if i+1 < len(runes) && (runes[i+1] == 0x3099 || runes[i+1] == 0x309A) {
+			if r, ok := fullwidthVoicedKatakana[string(runes[i:i+2])]; ok {
+				buf.WriteRune(r)
+				i++
+				continue
+			}
+		}
+		buf.WriteRune(runes[i])
+	}
+	return buf.String()
+}
+
+func ToHalfwidth(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	return execStrings1Arg(fn, args, toHalfwidth)
+}
+
+func ToFullwidth(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	return execStrings1Arg(fn, args, toFullwidth)
+}
+
+func stringPairArgs(fn parser.Function, args []value.Primary) ([]rune, []rune, bool, error) {
+	if len(args) != 2 {
+		return nil, nil, false, NewFunctionArgumentLengthError(fn, fn.Name, []int{2})
+	}
+
+	s1 := value.ToString(args[0])
+	if value.IsNull(s1) {
+		return nil, nil, true, nil
+	}
+	r1 := []rune(s1.(*value.String).Raw())
+	value.Discard(s1)
+
+	s2 := value.ToString(args[1])
+	if value.IsNull(s2) {
+		return nil, nil, true, nil
+	}
+	r2 := []rune(s2.(*value.String).Raw())
+	value.Discard(s2)
+
+	return r1, r2, false, nil
+}
+
+// levenshtein returns the number of single character insertions, deletions
+// and substitutions required to change r1 into r2.
+// The cost is proportional to the product of the lengths of r1 and r2.
+func levenshtein(r1 []rune, r2 []rune) int {
+	if len(r1) < len(r2) {
+		r1, r2 = r2, r1
+	}
+
+	row := make([]int, len(r2)+1)
+	for j := range row {
+		row[j] = j
+	}
+
+	for i := 1; i <= len(r1); i++ {
+		prev := row[0]
+		row[0] = i
+		for j := 1; j <= len(r2); j++ {
+			cost := 1
+			if r1[i-1] == r2[j-1] {
+				cost = 0
+			}
+
+			d := prev + cost
+			if row[j]+1 < d {
+				d = row[j] + 1
+			}
+			if row[j-1]+1 < d {
+				d = row[j-1] + 1
+			}
+
+			prev = row[j]
+			row[j] = d
+		}
+	}
+	return row[len(r2)]
+}
+
+func Levenshtein(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	r1, r2, isNull, err := stringPairArgs(fn, args)
+	if err != nil {
+		return nil, err
+	}
+	if isNull {
+		return value.NewNull(), nil
+	}
+
+	return value.NewInteger(int64(levenshtein(r1, r2))), nil
+}
+
+func Similarity(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	r1, r2, isNull, err := stringPairArgs(fn, args)
+	if err != nil {
+		return nil, err
+	}
+	if isNull {
+		return value.NewNull(), nil
+	}
+
+	maxLen := len(r1)
+	if maxLen < len(r2) {
+		maxLen = len(r2)
+	}
+	if maxLen < 1 {
+		return value.NewFloat(1), nil
+	}
+
+	return value.NewFloat(1 - float64(levenshtein(r1, r2))/float64(maxLen)), nil
+}
+
+var soundexCodes = map[rune]byte{
+	'B': '1', 'F': '1', 'P': '1', 'V': '1',
+	'C': '2', 'G': '2', 'J': '2', 'K': '2', 'Q': '2', 'S': '2', 'X': '2', 'Z': '2',
+	'D': '3', 'T': '3',
+	'L': '4',
+	'M': '5', 'N': '5',
+	'R': '6',
+}
+
+// soundex returns the American Soundex code of s.
+// Characters other than ASCII letters are ignored.
+func soundex(s string) string {
+	code := make([]byte, 0, 4)
+	var last byte
+	for _, r := range strings.ToUpper(s) {
+		if r < 'A' || 'Z' < r {
+			continue
+		}
+
+		c, ok := soundexCodes[r]
+		if len(code) < 1 {
+			code = append(code, byte(r))
+			last = c
+			continue
+		}
+
+		switch {
+		case ok:
+			if c != last {
+				code = append(code, c)
+				if len(code) == 4 {
+					return string(code)
+				}
+			}
+			last = c
+		case r != 'H' && r != 'W':
+			last = 0
+		}
+	}
+
+	if len(code) < 1 {
+		return ""
+	}
+	for len(code) < 4 {
+		code = append(code, '0')
+	}
+	return string(code)
+}
+
+func Soundex(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	return execStrings1Arg(fn, args, soundex)
+}
+
 func Lpad(fn parser.Function, args []value.Primary, flags *cmd.Flags) (value.Primary, error) {
 	return execStringsPadding(fn, args, LeftDirection, flags)
 }
@@ -977,29 +1878,86 @@ func Substr(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Prima
 }
 
 func Instr(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
-	if len(args) < 2 || 2 < len(args) {
-		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2})
+	if len(args) < 2 || 4 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2, 3, 4})
 	}
 
 	s := value.ToString(args[0])
 	if value.IsNull(s) {
 		return value.NewNull(), nil
 	}
+	str := []rune(s.(*value.String).Raw())
+	value.Discard(s)
 
 	substr := value.ToString(args[1])
 	if value.IsNull(substr) {
-		value.Discard(s)
 		return value.NewNull(), nil
 	}
-
-	index := strings.Index(s.(*value.String).Raw(), substr.(*value.String).Raw())
-	value.Discard(s)
+	sub := []rune(substr.(*value.String).Raw())
 	value.Discard(substr)
 
-	if index < 0 {
-		return value.NewNull(), nil
+	var start int64 = 1
+	if 2 < len(args) {
+		i := value.ToInteger(args[2])
+		if value.IsNull(i) {
+			return value.NewNull(), nil
+		}
+		start = i.(*value.Integer).Raw()
+		value.Discard(i)
+	}
+
+	var occurrence int64 = 1
+	if 3 < len(args) {
+		i := value.ToInteger(args[3])
+		if value.IsNull(i) {
+			return value.NewNull(), nil
+		}
+		occurrence = i.(*value.Integer).Raw()
+		value.Discard(i)
+
+		if occurrence < 1 {
+			return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the fourth argument must be an integer greater than 0")
+		}
+	}
+
+	return value.NewInteger(int64(instr(str, sub, start, occurrence))), nil
+}
+
+func instr(str []rune, substr []rune, start int64, occurrence int64) int {
+	matchAt := func(i int) bool {
+		if len(str) < i+len(substr) {
+			return false
+		}
+		for j := range substr {
+			if str[i+j] != substr[j] {
+				return false
+			}
+		}
+		return true
+	}
+
+	var count int64
+	switch {
+	case 0 < start:
+		for i := int(start - 1); i+len(substr) <= len(str); i++ {
+			if matchAt(i) {
+				count++
+				if count == occurrence {
+					return i + 1
+				}
+			}
+		}
+	case start < 0:
+		for i := len(str) + int(start); 0 <= i; i-- {
+			if matchAt(i) {
+				count++
+				if count == occurrence {
+					return i + 1
+				}
+			}
+		}
 	}
-	return value.NewInteger(int64(index)), nil
+	return 0
 }
 
 func ListElem(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
@@ -1037,7 +1995,31 @@ func ListElem(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Pri
 	if len(list) <= index {
 		return value.NewNull(), nil
 	}
-	return value.NewString(list[index]), nil
+	return value.NewString(list[index]), nil
+}
+
+func ConcatWs(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	if len(args) < 2 {
+		return nil, NewFunctionArgumentLengthErrorWithCustomArgs(fn, fn.Name, "at least 2 arguments")
+	}
+
+	sep := value.ToString(args[0])
+	if value.IsNull(sep) {
+		return value.NewNull(), nil
+	}
+	sepStr := sep.(*value.String).Raw()
+	value.Discard(sep)
+
+	list := make([]string, 0, len(args)-1)
+	for _, arg := range args[1:] {
+		s := value.ToString(arg)
+		if value.IsNull(s) {
+			continue
+		}
+		list = append(list, s.(*value.String).Raw())
+		value.Discard(s)
+	}
+	return value.NewString(strings.Join(list, sepStr)), nil
 }
 
 func ReplaceFn(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
@@ -1070,6 +2052,42 @@ func ReplaceFn(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Pr
 	return value.NewString(r), nil
 }
 
+func GlobFn(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	if len(args) < 2 || 3 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2, 3})
+	}
+
+	caseInsensitive := false
+	if len(args) == 3 {
+		p := value.ToBoolean(args[2])
+		if value.IsNull(p) {
+			return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the third argument must be a boolean")
+		}
+		caseInsensitive = p.(*value.Boolean).Raw()
+		value.Discard(p)
+	}
+
+	s := value.ToString(args[0])
+	if value.IsNull(s) {
+		return value.NewTernary(ternary.UNKNOWN), nil
+	}
+	str := s.(*value.String).Raw()
+	value.Discard(s)
+
+	p := value.ToString(args[1])
+	if value.IsNull(p) {
+		return value.NewTernary(ternary.UNKNOWN), nil
+	}
+	pattern := p.(*value.String).Raw()
+	value.Discard(p)
+
+	t, err := Glob(str, pattern, caseInsensitive)
+	if err != nil {
+		return nil, NewFunctionInvalidArgumentError(fn, fn.Name, err.Error())
+	}
+	return value.NewTernary(t), nil
+}
+
 func Format(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
 	if len(args) < 1 {
 		return nil, NewFunctionArgumentLengthErrorWithCustomArgs(fn, fn.Name, "at least 1 argument")
@@ -1146,6 +2164,85 @@ func Sha512Hmac(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.P
 	return execCryptoHMAC(fn, args, sha512.New)
 }
 
+func parseIP(p value.Primary) net.IP {
+	s := value.ToString(p)
+	if value.IsNull(s) {
+		return nil
+	}
+
+	ip := net.ParseIP(cmd.TrimSpace(s.(*value.String).Raw()))
+	value.Discard(s)
+	return ip
+}
+
+func InetAton(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	if len(args) != 1 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
+	}
+
+	ip := parseIP(args[0]).To4()
+	if ip == nil {
+		return value.NewNull(), nil
+	}
+
+	return value.NewInteger(int64(ip[0])<<24 | int64(ip[1])<<16 | int64(ip[2])<<8 | int64(ip[3])), nil
+}
+
+func InetNtoa(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	if len(args) != 1 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
+	}
+
+	p := value.ToInteger(args[0])
+	if value.IsNull(p) {
+		return value.NewNull(), nil
+	}
+
+	n := p.(*value.Integer).Raw()
+	value.Discard(p)
+	if n < 0 || math.MaxUint32 < n {
+		return value.NewNull(), nil
+	}
+
+	return value.NewString(net.IPv4(byte(n>>24), byte(n>>16), byte(n>>8), byte(n)).String()), nil
+}
+
+func IpInCidr(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	if len(args) != 2 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2})
+	}
+
+	ip := parseIP(args[0])
+	if ip == nil {
+		return value.NewTernary(ternary.UNKNOWN), nil
+	}
+
+	s := value.ToString(args[1])
+	if value.IsNull(s) {
+		return value.NewTernary(ternary.UNKNOWN), nil
+	}
+	_, network, err := net.ParseCIDR(cmd.TrimSpace(s.(*value.String).Raw()))
+	value.Discard(s)
+	if err != nil {
+		return value.NewTernary(ternary.UNKNOWN), nil
+	}
+
+	return value.NewTernary(ternary.ConvertFromBool(network.Contains(ip))), nil
+}
+
+func IpNormalize(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	if len(args) != 1 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
+	}
+
+	ip := parseIP(args[0])
+	if ip == nil {
+		return value.NewNull(), nil
+	}
+
+	return value.NewString(ip.String()), nil
+}
+
 func DatetimeFormat(fn parser.Function, args []value.Primary, flags *cmd.Flags) (value.Primary, error) {
 	if len(args) != 2 {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2})
@@ -1169,6 +2266,98 @@ func DatetimeFormat(fn parser.Function, args []value.Primary, flags *cmd.Flags)
 	return value.NewString(str), nil
 }
 
+// formatStrftime formats t according to the format written with strftime directives.
+// A format that has no directives is dealt with as a Go time layout.
+func formatStrftime(t time.Time, format string) string {
+	if f := value.StrftimeFormats.Get(format); f.HasDirectives() {
+		return f.Format(t)
+	}
+	return t.Format(format)
+}
+
+// textArgument converts the argument at the index to a string.
+// A string literal in the form of a datetime is read as a datetime value by the parser,
+// so the literal written in the query is used instead of the datetime value.
+func textArgument(fn parser.Function, args []value.Primary, idx int) value.Primary {
+	if _, ok := args[idx].(*value.Datetime); ok && idx < len(fn.Args) {
+		if lit, ok := fn.Args[idx].(parser.PrimitiveType); ok && 0 < len(lit.Literal) {
+			return value.NewString(lit.Literal)
+		}
+	}
+	return value.ToString(args[idx])
+}
+
+func parseDatetime(fn parser.Function, args []value.Primary) (value.Primary, string, error) {
+	if len(args) != 2 {
+		return nil, "", NewFunctionArgumentLengthError(fn, fn.Name, []int{2})
+	}
+
+	format := textArgument(fn, args, 1)
+	if value.IsNull(format) {
+		return value.NewNull(), "", nil
+	}
+	layout := format.(*value.String).Raw()
+	value.Discard(format)
+
+	s := textArgument(fn, args, 0)
+	if value.IsNull(s) {
+		if dt, ok := args[0].(*value.Datetime); ok {
+			return value.NewDatetime(dt.Raw()), "", nil
+		}
+		return value.NewNull(), "", nil
+	}
+	str := s.(*value.String).Raw()
+	value.Discard(s)
+
+	var t time.Time
+	var err error
+	if f := value.StrftimeFormats.Get(layout); f.HasDirectives() {
+		t, err = f.Parse(cmd.TrimSpace(str), cmd.GetLocation())
+	} else {
+		t, err = time.ParseInLocation(layout, cmd.TrimSpace(str), cmd.GetLocation())
+	}
+	if err != nil {
+		return value.NewNull(), fmt.Sprintf("%q does not match the format %q", str, layout), nil
+	}
+	return value.NewDatetime(t), "", nil
+}
+
+func ParseDatetime(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	p, _, err := parseDatetime(fn, args)
+	return p, err
+}
+
+func ParseDatetimeStrict(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	p, mismatch, err := parseDatetime(fn, args)
+	if err == nil && 0 < len(mismatch) {
+		err = NewFunctionInvalidArgumentError(fn, fn.Name, mismatch)
+	}
+	return p, err
+}
+
+func ToChar(fn parser.Function, args []value.Primary, flags *cmd.Flags) (value.Primary, error) {
+	if len(args) != 2 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2})
+	}
+
+	p := value.ToDatetime(args[0], flags.DatetimeFormat)
+	if value.IsNull(p) {
+		return value.NewNull(), nil
+	}
+
+	format := textArgument(fn, args, 1)
+	if value.IsNull(format) {
+		value.Discard(p)
+		return value.NewNull(), nil
+	}
+
+	str := formatStrftime(p.(*value.Datetime).Raw(), format.(*value.String).Raw())
+	value.Discard(p)
+	value.Discard(format)
+
+	return value.NewString(str), nil
+}
+
 func execDatetimeToInt(fn parser.Function, args []value.Primary, timef func(time.Time) int64, flags *cmd.Flags) (value.Primary, error) {
 	if len(args) != 1 {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
@@ -1251,6 +2440,10 @@ func unixTime(t time.Time) int64 {
 	return t.Unix()
 }
 
+func unixMilliTime(t time.Time) int64 {
+	return t.Unix()*1e3 + int64(t.Nanosecond())/1e6
+}
+
 func unixNanoTime(t time.Time) int64 {
 	return t.UnixNano()
 }
@@ -1350,6 +2543,10 @@ func UnixTime(fn parser.Function, args []value.Primary, flags *cmd.Flags) (value
 	return execDatetimeToInt(fn, args, unixTime, flags)
 }
 
+func UnixMilliTime(fn parser.Function, args []value.Primary, flags *cmd.Flags) (value.Primary, error) {
+	return execDatetimeToInt(fn, args, unixMilliTime, flags)
+}
+
 func UnixNanoTime(fn parser.Function, args []value.Primary, flags *cmd.Flags) (value.Primary, error) {
 	return execDatetimeToInt(fn, args, unixNanoTime, flags)
 }
@@ -1552,7 +2749,7 @@ func UTC(fn parser.Function, args []value.Primary, flags *cmd.Flags) (value.Prim
 	return value.NewDatetime(t), nil
 }
 
-func NanoToDatetime(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+func execIntToDatetime(fn parser.Function, args []value.Primary, timef func(int64) time.Time) (value.Primary, error) {
 	if len(args) != 1 {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
 	}
@@ -1564,7 +2761,25 @@ func NanoToDatetime(fn parser.Function, args []value.Primary, _ *cmd.Flags) (val
 	i := p.(*value.Integer).Raw()
 	value.Discard(p)
 
-	return value.NewDatetime(time.Unix(0, i).In(cmd.GetLocation())), nil
+	return value.NewDatetime(timef(i).In(cmd.GetLocation())), nil
+}
+
+func NanoToDatetime(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	return execIntToDatetime(fn, args, func(i int64) time.Time {
+		return time.Unix(0, i)
+	})
+}
+
+func FromUnixTime(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	return execIntToDatetime(fn, args, func(i int64) time.Time {
+		return time.Unix(i, 0)
+	})
+}
+
+func FromUnixMilliTime(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	return execIntToDatetime(fn, args, func(i int64) time.Time {
+		return time.Unix(i/1e3, (i%1e3)*1e6)
+	})
 }
 
 func String(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
@@ -1666,6 +2881,33 @@ func Datetime(fn parser.Function, args []value.Primary, flags *cmd.Flags) (value
 	}
 }
 
+func TypeOf(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	if len(args) != 1 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
+	}
+
+	return value.NewString(typeName(args[0])), nil
+}
+
+func typeName(p value.Primary) string {
+	switch p.(type) {
+	case *value.String:
+		return "string"
+	case *value.Integer:
+		return "integer"
+	case *value.Float:
+		return "float"
+	case *value.Boolean:
+		return "boolean"
+	case *value.Ternary:
+		return "ternary"
+	case *value.Datetime:
+		return "datetime"
+	default:
+		return "null"
+	}
+}
+
 func Call(ctx context.Context, fn parser.Function, args []value.Primary) (value.Primary, error) {
 	if len(args) < 1 {
 		return nil, NewFunctionArgumentLengthErrorWithCustomArgs(fn, fn.Name, "at least 1 argument")
@@ -1684,11 +2926,182 @@ func Call(ctx context.Context, fn parser.Function, args []value.Primary) (value.
 	return value.NewString(string(buf)), nil
 }
 
+func GetEnv(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	if len(args) != 1 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
+	}
+
+	s := value.ToString(args[0])
+	if value.IsNull(s) {
+		return value.NewNull(), nil
+	}
+	name := s.(*value.String).Raw()
+	value.Discard(s)
+
+	if v, ok := os.LookupEnv(name); ok {
+		return value.NewString(v), nil
+	}
+	return value.NewNull(), nil
+}
+
+func statFile(fn parser.Function, args []value.Primary, flags *cmd.Flags) (os.FileInfo, bool, error) {
+	if len(args) != 1 {
+		return nil, false, NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
+	}
+
+	s := value.ToString(args[0])
+	if value.IsNull(s) {
+		return nil, false, nil
+	}
+	fpath := s.(*value.String).Raw()
+	value.Discard(s)
+
+	if len(fpath) < 1 {
+		return nil, false, nil
+	}
+
+	if !filepath.IsAbs(fpath) {
+		repository := flags.Repository
+		if len(repository) < 1 {
+			repository, _ = os.Getwd()
+		}
+		fpath = filepath.Join(repository, fpath)
+	}
+
+	info, err := os.Stat(fpath)
+	if err != nil || info.IsDir() {
+		return nil, true, nil
+	}
+	return info, true, nil
+}
+
+func FileExists(fn parser.Function, args []value.Primary, flags *cmd.Flags) (value.Primary, error) {
+	info, ok, err := statFile(fn, args, flags)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return value.NewTernary(ternary.UNKNOWN), nil
+	}
+	return value.NewTernary(ternary.ConvertFromBool(info != nil)), nil
+}
+
+func FileSize(fn parser.Function, args []value.Primary, flags *cmd.Flags) (value.Primary, error) {
+	info, _, err := statFile(fn, args, flags)
+	if err != nil {
+		return nil, err
+	}
+	if info == nil {
+		return value.NewNull(), nil
+	}
+	return value.NewInteger(info.Size()), nil
+}
+
+func FileMtime(fn parser.Function, args []value.Primary, flags *cmd.Flags) (value.Primary, error) {
+	info, _, err := statFile(fn, args, flags)
+	if err != nil {
+		return nil, err
+	}
+	if info == nil {
+		return value.NewNull(), nil
+	}
+	return value.NewDatetime(info.ModTime().In(cmd.GetLocation())), nil
+}
+
+func timestampWithPrecision(fn parser.Function, args []value.Primary, t time.Time) (value.Primary, error) {
+	if 1 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{0, 1})
+	}
+
+	if len(args) == 1 {
+		p := value.ToInteger(args[0])
+		if value.IsNull(p) {
+			return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the first argument must be an integer from 0 to 9")
+		}
+		precision := p.(*value.Integer).Raw()
+		value.Discard(p)
+
+		if precision < 0 || 9 < precision {
+			return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the first argument must be an integer from 0 to 9")
+		}
+		t = t.Truncate(time.Duration(math.Pow10(9 - int(precision))))
+	}
+	return value.NewDatetime(t), nil
+}
+
 func Now(scope *ReferenceScope, fn parser.Function, args []value.Primary) (value.Primary, error) {
+	return timestampWithPrecision(fn, args, scope.Now())
+}
+
+func CurrentDate(scope *ReferenceScope, fn parser.Function, args []value.Primary) (value.Primary, error) {
+	if 0 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{0})
+	}
+
+	t := scope.Now().In(cmd.GetLocation())
+	return value.NewDatetime(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())), nil
+}
+
+// CurrentTime returns the time of day as a string formatted as "15:04:05".
+// A datetime value always has a date, so a time without a date is represented
+// by a string that can be compared with other time strings.
+func CurrentTime(scope *ReferenceScope, fn parser.Function, args []value.Primary) (value.Primary, error) {
+	p, err := timestampWithPrecision(fn, args, scope.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	layout := "15:04:05.999999999"
+	if len(args) == 1 {
+		i := value.ToInteger(args[0])
+		precision := int(i.(*value.Integer).Raw())
+		value.Discard(i)
+
+		layout = "15:04:05"
+		if 0 < precision {
+			layout = layout + "." + strings.Repeat("0", precision)
+		}
+	}
+	return value.NewString(p.(*value.Datetime).Raw().In(cmd.GetLocation()).Format(layout)), nil
+}
+
+func TransactionTimestamp(scope *ReferenceScope, fn parser.Function, args []value.Primary) (value.Primary, error) {
+	return timestampWithPrecision(fn, args, scope.Tx.Timestamp())
+}
+
+func FileName(scope *ReferenceScope, fn parser.Function, args []value.Primary) (value.Primary, error) {
+	if 0 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{0})
+	}
+
+	origin, ok := currentRecordOrigin(scope)
+	if !ok {
+		return value.NewNull(), nil
+	}
+	return value.NewString(origin.Path), nil
+}
+
+func FileLine(scope *ReferenceScope, fn parser.Function, args []value.Primary) (value.Primary, error) {
 	if 0 < len(args) {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{0})
 	}
-	return value.NewDatetime(scope.Now()), nil
+
+	origin, ok := currentRecordOrigin(scope)
+	if !ok || origin.Line < 1 {
+		return value.NewNull(), nil
+	}
+	return value.NewInteger(int64(origin.Line)), nil
+}
+
+func currentRecordOrigin(scope *ReferenceScope) (recordOrigin, bool) {
+	if len(scope.Records) < 1 || scope.Records[0].view == nil {
+		return recordOrigin{}, false
+	}
+	return scope.Records[0].view.origin(scope.Records[0].recordIndex)
+}
+
+func ClockTimestamp(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	return timestampWithPrecision(fn, args, cmd.Now())
 }
 
 func JsonObject(ctx context.Context, scope *ReferenceScope, fn parser.Function) (value.Primary, error) {
@@ -1725,6 +3138,9 @@ func JsonObject(ctx context.Context, scope *ReferenceScope, fn parser.Function)
 	for i := range view.RecordSet[0] {
 		record[i] = view.RecordSet[0][i][0]
 	}
-	structure, _ := json.ConvertRecordValueToJsonStructure(pathes, record)
+	structure, err := json.ConvertRecordValueToJsonStructure(pathes, record)
+	if err != nil {
+		return nil, NewFunctionInvalidArgumentError(fn, fn.Name, err.Error())
+	}
 	return value.NewString(structure.Encode()), nil
 }
@@ -2,6 +2,8 @@ package query
 
 import (
 	"context"
+	"io/ioutil"
+	"os"
 	"reflect"
 	"testing"
 	"time"
@@ -195,10 +197,252 @@ var nullifTests = []functionTest{
 	},
 }
 
+var nvl2Tests = []functionTest{
+	{
+		Name: "Nvl2",
+		Function: parser.Function{
+			Name: "nvl2",
+		},
+		Args: []value.Primary{
+			value.NewInteger(0),
+			value.NewString("not null"),
+			value.NewString("null"),
+		},
+		Result: value.NewString("not null"),
+	},
+	{
+		Name: "Nvl2 Null",
+		Function: parser.Function{
+			Name: "nvl2",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString("not null"),
+			value.NewString("null"),
+		},
+		Result: value.NewString("null"),
+	},
+	{
+		Name: "Nvl2 Arguments Error",
+		Function: parser.Function{
+			Name: "nvl2",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString("not null"),
+		},
+		Error: "function nvl2 takes exactly 3 arguments",
+	},
+}
+
+func TestNvl2(t *testing.T) {
+	testFunction(t, Nvl2, nvl2Tests)
+}
+
 func TestNullif(t *testing.T) {
 	testFunction(t, Nullif, nullifTests)
 }
 
+var decodeTests = []functionTest{
+	{
+		Name: "Decode",
+		Function: parser.Function{
+			Name: "decode",
+		},
+		Args: []value.Primary{
+			value.NewInteger(2),
+			value.NewInteger(1),
+			value.NewString("one"),
+			value.NewInteger(2),
+			value.NewString("two"),
+		},
+		Result: value.NewString("two"),
+	},
+	{
+		Name: "Decode Default",
+		Function: parser.Function{
+			Name: "decode",
+		},
+		Args: []value.Primary{
+			value.NewInteger(3),
+			value.NewInteger(1),
+			value.NewString("one"),
+			value.NewInteger(2),
+			value.NewString("two"),
+			value.NewString("other"),
+		},
+		Result: value.NewString("other"),
+	},
+	{
+		Name: "Decode No Match without Default",
+		Function: parser.Function{
+			Name: "decode",
+		},
+		Args: []value.Primary{
+			value.NewInteger(3),
+			value.NewInteger(1),
+			value.NewString("one"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Decode Null Matches Null",
+		Function: parser.Function{
+			Name: "decode",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewInteger(1),
+			value.NewString("one"),
+			value.NewNull(),
+			value.NewString("null"),
+			value.NewString("other"),
+		},
+		Result: value.NewString("null"),
+	},
+	{
+		Name: "Decode Null Search Does Not Match Value",
+		Function: parser.Function{
+			Name: "decode",
+		},
+		Args: []value.Primary{
+			value.NewInteger(1),
+			value.NewNull(),
+			value.NewString("null"),
+			value.NewString("other"),
+		},
+		Result: value.NewString("other"),
+	},
+	{
+		Name: "Decode Arguments Error",
+		Function: parser.Function{
+			Name: "decode",
+		},
+		Args: []value.Primary{
+			value.NewInteger(1),
+			value.NewInteger(1),
+		},
+		Error: "function decode takes at least 3 arguments",
+	},
+}
+
+func TestDecode(t *testing.T) {
+	testFunction(t, Decode, decodeTests)
+}
+
+var mapTests = []functionTest{
+	{
+		Name: "Map",
+		Function: parser.Function{
+			Name: "map",
+		},
+		Args: []value.Primary{
+			value.NewString("N"),
+			value.NewString("Y"),
+			value.NewString("yes"),
+			value.NewString("N"),
+			value.NewString("no"),
+		},
+		Result: value.NewString("no"),
+	},
+	{
+		Name: "Map Default",
+		Function: parser.Function{
+			Name: "map",
+		},
+		Args: []value.Primary{
+			value.NewString("X"),
+			value.NewString("Y"),
+			value.NewString("yes"),
+			value.NewString("unknown"),
+		},
+		Result: value.NewString("unknown"),
+	},
+	{
+		Name: "Map No Match without Default",
+		Function: parser.Function{
+			Name: "map",
+		},
+		Args: []value.Primary{
+			value.NewString("X"),
+			value.NewString("Y"),
+			value.NewString("yes"),
+		},
+		Result: value.NewString("X"),
+	},
+	{
+		Name: "Map Null Matches Null",
+		Function: parser.Function{
+			Name: "map",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewNull(),
+			value.NewString("none"),
+		},
+		Result: value.NewString("none"),
+	},
+	{
+		Name: "Map Json Object",
+		Function: parser.Function{
+			Name: "map",
+		},
+		Args: []value.Primary{
+			value.NewInteger(2),
+			value.NewString("{\"1\":\"one\", \"2\":\"two\"}"),
+		},
+		Result: value.NewString("two"),
+	},
+	{
+		Name: "Map Json Object No Match",
+		Function: parser.Function{
+			Name: "map",
+		},
+		Args: []value.Primary{
+			value.NewInteger(3),
+			value.NewString("{\"1\":\"one\", \"2\":\"two\"}"),
+		},
+		Result: value.NewInteger(3),
+	},
+	{
+		Name: "Map Json Object Default",
+		Function: parser.Function{
+			Name: "map",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString("{\"1\":\"one\"}"),
+			value.NewString("other"),
+		},
+		Result: value.NewString("other"),
+	},
+	{
+		Name: "Map Json Object Error",
+		Function: parser.Function{
+			Name: "map",
+		},
+		Args: []value.Primary{
+			value.NewInteger(1),
+			value.NewString("{\"1\"}"),
+		},
+		Error: "the second argument must be a json object: line 1, column 5: unexpected token \"}\" for function map",
+	},
+	{
+		Name: "Map Arguments Error",
+		Function: parser.Function{
+			Name: "map",
+		},
+		Args: []value.Primary{
+			value.NewInteger(1),
+		},
+		Error: "function map takes at least 2 arguments",
+	},
+}
+
+func TestMap(t *testing.T) {
+	testFunction(t, Map, mapTests)
+}
+
 var ceilTests = []functionTest{
 	{
 		Name: "Ceil",
@@ -305,1119 +549,1058 @@ var roundTests = []functionTest{
 		Result: value.NewFloat(-2.46),
 	},
 	{
-		Name: "Round Null",
+		Name: "Round Negative Place",
 		Function: parser.Function{
 			Name: "round",
 		},
 		Args: []value.Primary{
-			value.NewNull(),
+			value.NewFloat(1234.567),
+			value.NewInteger(-2),
 		},
-		Result: value.NewNull(),
+		Result: value.NewInteger(1200),
 	},
 	{
-		Name: "Round Arguments Error",
+		Name: "Round Half Up at Boundary",
 		Function: parser.Function{
 			Name: "round",
 		},
-		Args:  []value.Primary{},
-		Error: "function round takes 1 or 2 arguments",
+		Args: []value.Primary{
+			value.NewFloat(2.5),
+		},
+		Result: value.NewInteger(3),
 	},
-}
-
-func TestRound(t *testing.T) {
-	testFunction(t, Round, roundTests)
-}
-
-var absTests = []functionTest{
 	{
-		Name: "Abs",
+		Name: "Round Half Up at Boundary of Negative Number",
 		Function: parser.Function{
-			Name: "abs",
+			Name: "round",
 		},
 		Args: []value.Primary{
-			value.NewInteger(-2),
+			value.NewFloat(-2.5),
 		},
-		Result: value.NewInteger(2),
+		Result: value.NewInteger(-3),
 	},
 	{
-		Name: "Abs Null",
+		Name: "Round Half Up with Explicit Mode",
 		Function: parser.Function{
-			Name: "abs",
+			Name: "round",
 		},
 		Args: []value.Primary{
-			value.NewNull(),
+			value.NewFloat(2.5),
+			value.NewInteger(0),
+			value.NewString("half_up"),
 		},
-		Result: value.NewNull(),
+		Result: value.NewInteger(3),
 	},
 	{
-		Name: "Abs Arguments Error",
+		Name: "Round Half Even at Boundary",
 		Function: parser.Function{
-			Name: "abs",
+			Name: "round",
 		},
-		Args:  []value.Primary{},
-		Error: "function abs takes exactly 1 argument",
+		Args: []value.Primary{
+			value.NewFloat(2.5),
+			value.NewInteger(0),
+			value.NewString("HALF_EVEN"),
+		},
+		Result: value.NewInteger(2),
 	},
-}
-
-func TestAbs(t *testing.T) {
-	testFunction(t, Abs, absTests)
-}
-
-var acosTests = []functionTest{
 	{
-		Name: "Acos",
+		Name: "Round Half Even at Odd Boundary",
 		Function: parser.Function{
-			Name: "acos",
+			Name: "round",
 		},
 		Args: []value.Primary{
+			value.NewFloat(3.5),
 			value.NewInteger(0),
+			value.NewString("HALF_EVEN"),
 		},
-		Result: value.NewFloat(1.5707963267948966),
+		Result: value.NewInteger(4),
 	},
-}
-
-func TestAcos(t *testing.T) {
-	testFunction(t, Acos, acosTests)
-}
-
-var asinTests = []functionTest{
 	{
-		Name: "Asin",
+		Name: "Round Half Even at Boundary of Negative Number",
 		Function: parser.Function{
-			Name: "asin",
+			Name: "round",
 		},
 		Args: []value.Primary{
-			value.NewFloat(0.1),
+			value.NewFloat(-2.5),
+			value.NewInteger(0),
+			value.NewString("HALF_EVEN"),
 		},
-		Result: value.NewFloat(0.1001674211615598),
+		Result: value.NewInteger(-2),
 	},
-}
-
-func TestAsin(t *testing.T) {
-	testFunction(t, Asin, asinTests)
-}
-
-var atanTests = []functionTest{
 	{
-		Name: "Atan",
+		Name: "Round Half Even with Negative Place",
 		Function: parser.Function{
-			Name: "atan",
+			Name: "round",
 		},
 		Args: []value.Primary{
-			value.NewInteger(2),
+			value.NewInteger(1250),
+			value.NewInteger(-2),
+			value.NewString("HALF_EVEN"),
 		},
-		Result: value.NewFloat(1.1071487177940904),
+		Result: value.NewInteger(1200),
 	},
-}
-
-func TestAtan(t *testing.T) {
-	testFunction(t, Atan, atanTests)
-}
-
-var atan2Tests = []functionTest{
 	{
-		Name: "Atan2",
+		Name: "Round Half Even with Negative Place at Odd Boundary",
 		Function: parser.Function{
-			Name: "atan2",
+			Name: "round",
 		},
 		Args: []value.Primary{
-			value.NewInteger(2),
-			value.NewInteger(2),
+			value.NewInteger(1350),
+			value.NewInteger(-2),
+			value.NewString("HALF_EVEN"),
 		},
-		Result: value.NewFloat(0.7853981633974483),
+		Result: value.NewInteger(1400),
 	},
-}
-
-func TestAtan2(t *testing.T) {
-	testFunction(t, Atan2, atan2Tests)
-}
-
-var cosTests = []functionTest{
 	{
-		Name: "Cos",
+		Name: "Round Null",
 		Function: parser.Function{
-			Name: "cos",
+			Name: "round",
 		},
 		Args: []value.Primary{
-			value.NewInteger(2),
+			value.NewNull(),
 		},
-		Result: value.NewFloat(-0.4161468365471424),
+		Result: value.NewNull(),
 	},
-}
-
-func TestCos(t *testing.T) {
-	testFunction(t, Cos, cosTests)
-}
-
-var sinTests = []functionTest{
 	{
-		Name: "Sin",
+		Name: "Round Invalid Rounding Mode Error",
 		Function: parser.Function{
-			Name: "sin",
+			Name: "round",
 		},
 		Args: []value.Primary{
-			value.NewInteger(1),
+			value.NewFloat(2.5),
+			value.NewInteger(0),
+			value.NewString("HALF_DOWN"),
 		},
-		Result: value.NewFloat(0.8414709848078965),
+		Error: "rounding mode must be one of HALF_UP|HALF_EVEN for function round",
 	},
-}
-
-func TestSin(t *testing.T) {
-	testFunction(t, Sin, sinTests)
-}
-
-var tanTests = []functionTest{
 	{
-		Name: "Tan",
+		Name: "Round Rounding Mode is Null Error",
 		Function: parser.Function{
-			Name: "tan",
+			Name: "round",
 		},
 		Args: []value.Primary{
-			value.NewInteger(2),
+			value.NewFloat(2.5),
+			value.NewInteger(0),
+			value.NewNull(),
 		},
-		Result: value.NewFloat(-2.185039863261519),
+		Error: "rounding mode must be one of HALF_UP|HALF_EVEN for function round",
 	},
-}
-
-func TestTan(t *testing.T) {
-	testFunction(t, Tan, tanTests)
-}
-
-var expTests = []functionTest{
 	{
-		Name: "Exp",
+		Name: "Round Arguments Error",
 		Function: parser.Function{
-			Name: "exp",
-		},
-		Args: []value.Primary{
-			value.NewInteger(2),
+			Name: "round",
 		},
-		Result: value.NewFloat(7.38905609893065),
+		Args:  []value.Primary{},
+		Error: "function round takes 1 to 3 arguments",
 	},
 }
 
-func TestExp(t *testing.T) {
-	testFunction(t, Exp, expTests)
+func TestRound(t *testing.T) {
+	testFunction(t, Round, roundTests)
 }
 
-var exp2Tests = []functionTest{
+var truncTests = []functionTest{
 	{
-		Name: "Exp2",
+		Name: "Trunc",
 		Function: parser.Function{
-			Name: "exp2",
+			Name: "trunc",
 		},
 		Args: []value.Primary{
+			value.NewFloat(2.456),
 			value.NewInteger(2),
 		},
-		Result: value.NewInteger(4),
+		Result: value.NewFloat(2.45),
 	},
-}
-
-func TestExp2(t *testing.T) {
-	testFunction(t, Exp2, exp2Tests)
-}
-
-var expm1Tests = []functionTest{
 	{
-		Name: "Expm1",
+		Name: "Trunc Negative Number",
 		Function: parser.Function{
-			Name: "expm1",
+			Name: "trunc",
 		},
 		Args: []value.Primary{
+			value.NewFloat(-2.456),
 			value.NewInteger(2),
 		},
-		Result: value.NewFloat(6.38905609893065),
+		Result: value.NewFloat(-2.45),
 	},
-}
-
-func TestExpm1(t *testing.T) {
-	testFunction(t, Expm1, expm1Tests)
-}
-
-var mathLogTests = []functionTest{
 	{
-		Name: "MathLog",
+		Name: "Trunc without Place",
 		Function: parser.Function{
-			Name: "log",
+			Name: "trunc",
 		},
 		Args: []value.Primary{
-			value.NewFloat(2),
+			value.NewFloat(-2.9),
 		},
-		Result: value.NewFloat(0.6931471805599453),
+		Result: value.NewInteger(-2),
 	},
-}
-
-func TestMathLog(t *testing.T) {
-	testFunction(t, MathLog, mathLogTests)
-}
-
-var log10Tests = []functionTest{
 	{
-		Name: "Log10",
+		Name: "Trunc Negative Place",
 		Function: parser.Function{
-			Name: "log10",
+			Name: "trunc",
 		},
 		Args: []value.Primary{
-			value.NewFloat(100),
+			value.NewFloat(1299.99),
+			value.NewInteger(-2),
 		},
-		Result: value.NewInteger(2),
+		Result: value.NewInteger(1200),
 	},
-}
-
-func TestLog10(t *testing.T) {
-	testFunction(t, Log10, log10Tests)
-}
-
-var log2Tests = []functionTest{
 	{
-		Name: "Log2",
+		Name: "Trunc Negative Place of Negative Number",
 		Function: parser.Function{
-			Name: "log2",
+			Name: "trunc",
 		},
 		Args: []value.Primary{
-			value.NewFloat(16),
+			value.NewFloat(-1299.99),
+			value.NewInteger(-2),
 		},
-		Result: value.NewInteger(4),
+		Result: value.NewInteger(-1200),
 	},
-}
-
-func TestLog2(t *testing.T) {
-	testFunction(t, Log2, log2Tests)
-}
-
-var log1pTests = []functionTest{
 	{
-		Name: "Log1p",
+		Name: "Trunc Null",
 		Function: parser.Function{
-			Name: "log1p",
+			Name: "trunc",
 		},
 		Args: []value.Primary{
-			value.NewFloat(1),
+			value.NewNull(),
 		},
-		Result: value.NewFloat(0.6931471805599453),
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Trunc Arguments Error",
+		Function: parser.Function{
+			Name: "trunc",
+		},
+		Args:  []value.Primary{},
+		Error: "function trunc takes 1 or 2 arguments",
 	},
 }
 
-func TestLog1p(t *testing.T) {
-	testFunction(t, Log1p, log1pTests)
+func TestTrunc(t *testing.T) {
+	testFunction(t, Trunc, truncTests)
 }
 
-var sqrtTests = []functionTest{
+var absTests = []functionTest{
 	{
-		Name: "Sqrt",
+		Name: "Abs",
 		Function: parser.Function{
-			Name: "sqrt",
+			Name: "abs",
 		},
 		Args: []value.Primary{
-			value.NewFloat(4),
+			value.NewInteger(-2),
 		},
 		Result: value.NewInteger(2),
 	},
 	{
-		Name: "Sqrt Cannot Calculate",
+		Name: "Abs Null",
 		Function: parser.Function{
-			Name: "sqrt",
+			Name: "abs",
 		},
 		Args: []value.Primary{
-			value.NewFloat(-4),
+			value.NewNull(),
 		},
 		Result: value.NewNull(),
 	},
+	{
+		Name: "Abs Arguments Error",
+		Function: parser.Function{
+			Name: "abs",
+		},
+		Args:  []value.Primary{},
+		Error: "function abs takes exactly 1 argument",
+	},
 }
 
-func TestSqrt(t *testing.T) {
-	testFunction(t, Sqrt, sqrtTests)
+func TestAbs(t *testing.T) {
+	testFunction(t, Abs, absTests)
 }
 
-var powTests = []functionTest{
+var signTests = []functionTest{
 	{
-		Name: "Pow",
+		Name: "Sign Negative",
 		Function: parser.Function{
-			Name: "pow",
+			Name: "sign",
 		},
 		Args: []value.Primary{
-			value.NewFloat(2),
-			value.NewFloat(2),
+			value.NewFloat(-2.5),
 		},
-		Result: value.NewInteger(4),
+		Result: value.NewInteger(-1),
 	},
 	{
-		Name: "Pow First Argument is Null",
+		Name: "Sign Zero",
 		Function: parser.Function{
-			Name: "pow",
+			Name: "sign",
 		},
 		Args: []value.Primary{
-			value.NewNull(),
-			value.NewFloat(2),
+			value.NewInteger(0),
 		},
-		Result: value.NewNull(),
+		Result: value.NewInteger(0),
 	},
 	{
-		Name: "Pow Second Argument is Null",
+		Name: "Sign Positive",
 		Function: parser.Function{
-			Name: "pow",
+			Name: "sign",
 		},
 		Args: []value.Primary{
-			value.NewFloat(2),
-			value.NewNull(),
+			value.NewString("3"),
 		},
-		Result: value.NewNull(),
+		Result: value.NewInteger(1),
 	},
 	{
-		Name: "Pow Cannot Calculate",
+		Name: "Sign Null",
 		Function: parser.Function{
-			Name: "pow",
+			Name: "sign",
 		},
 		Args: []value.Primary{
-			value.NewFloat(-2),
-			value.NewFloat(2.4),
+			value.NewNull(),
 		},
 		Result: value.NewNull(),
 	},
 	{
-		Name: "Pow Arguments Error",
+		Name: "Sign Arguments Error",
 		Function: parser.Function{
-			Name: "pow",
+			Name: "sign",
 		},
 		Args:  []value.Primary{},
-		Error: "function pow takes exactly 2 arguments",
+		Error: "function sign takes exactly 1 argument",
 	},
 }
 
-func TestPow(t *testing.T) {
-	testFunction(t, Pow, powTests)
+func TestSign(t *testing.T) {
+	testFunction(t, Sign, signTests)
 }
 
-var binToDecTests = []functionTest{
+var acosTests = []functionTest{
 	{
-		Name: "BinToDec",
+		Name: "Acos",
 		Function: parser.Function{
-			Name: "bin_to_dec",
+			Name: "acos",
 		},
 		Args: []value.Primary{
-			value.NewString("1111011"),
+			value.NewInteger(0),
 		},
-		Result: value.NewInteger(123),
+		Result: value.NewFloat(1.5707963267948966),
 	},
+}
+
+func TestAcos(t *testing.T) {
+	testFunction(t, Acos, acosTests)
+}
+
+var asinTests = []functionTest{
 	{
-		Name: "BinToDec Null",
+		Name: "Asin",
 		Function: parser.Function{
-			Name: "bin_to_dec",
+			Name: "asin",
 		},
 		Args: []value.Primary{
-			value.NewNull(),
+			value.NewFloat(0.1),
 		},
-		Result: value.NewNull(),
+		Result: value.NewFloat(0.1001674211615598),
 	},
+}
+
+func TestAsin(t *testing.T) {
+	testFunction(t, Asin, asinTests)
+}
+
+var atanTests = []functionTest{
 	{
-		Name: "BinToDec Parse Error",
+		Name: "Atan",
 		Function: parser.Function{
-			Name: "bin_to_dec",
+			Name: "atan",
 		},
 		Args: []value.Primary{
-			value.NewString("string"),
-		},
-		Result: value.NewNull(),
-	},
-	{
-		Name: "BinToDec Arguments Error",
-		Function: parser.Function{
-			Name: "bin_to_dec",
+			value.NewInteger(2),
 		},
-		Args:  []value.Primary{},
-		Error: "function bin_to_dec takes exactly 1 argument",
+		Result: value.NewFloat(1.1071487177940904),
 	},
 }
 
-func TestBinToDec(t *testing.T) {
-	testFunction(t, BinToDec, binToDecTests)
+func TestAtan(t *testing.T) {
+	testFunction(t, Atan, atanTests)
 }
 
-var octToDecTests = []functionTest{
+var atan2Tests = []functionTest{
 	{
-		Name: "OctToDec",
+		Name: "Atan2",
 		Function: parser.Function{
-			Name: "oct_to_dec",
+			Name: "atan2",
 		},
 		Args: []value.Primary{
-			value.NewString("0173"),
+			value.NewInteger(2),
+			value.NewInteger(2),
 		},
-		Result: value.NewInteger(123),
+		Result: value.NewFloat(0.7853981633974483),
 	},
 }
 
-func TestOctToDec(t *testing.T) {
-	testFunction(t, OctToDec, octToDecTests)
+func TestAtan2(t *testing.T) {
+	testFunction(t, Atan2, atan2Tests)
 }
 
-var hexToDecTests = []functionTest{
+var cosTests = []functionTest{
 	{
-		Name: "HexToDec",
+		Name: "Cos",
 		Function: parser.Function{
-			Name: "hex_to_dec",
+			Name: "cos",
 		},
 		Args: []value.Primary{
-			value.NewString("0x7b"),
+			value.NewInteger(2),
 		},
-		Result: value.NewInteger(123),
+		Result: value.NewFloat(-0.4161468365471424),
 	},
 }
 
-func TestHexToDec(t *testing.T) {
-	testFunction(t, HexToDec, hexToDecTests)
+func TestCos(t *testing.T) {
+	testFunction(t, Cos, cosTests)
 }
 
-var enotationToDecTests = []functionTest{
+var sinTests = []functionTest{
 	{
-		Name: "EnotationToDec",
+		Name: "Sin",
 		Function: parser.Function{
-			Name: "enotation_to_dec",
+			Name: "sin",
 		},
 		Args: []value.Primary{
-			value.NewString("1.23e-11"),
+			value.NewInteger(1),
 		},
-		Result: value.NewFloat(0.0000000000123),
+		Result: value.NewFloat(0.8414709848078965),
 	},
+}
+
+func TestSin(t *testing.T) {
+	testFunction(t, Sin, sinTests)
+}
+
+var tanTests = []functionTest{
 	{
-		Name: "EnotationToDec To Integer",
+		Name: "Tan",
 		Function: parser.Function{
-			Name: "enotation_to_dec",
+			Name: "tan",
 		},
 		Args: []value.Primary{
-			value.NewString("1.23e+12"),
+			value.NewInteger(2),
 		},
-		Result: value.NewInteger(1230000000000),
+		Result: value.NewFloat(-2.185039863261519),
 	},
+}
+
+func TestTan(t *testing.T) {
+	testFunction(t, Tan, tanTests)
+}
+
+var expTests = []functionTest{
 	{
-		Name: "EnotationToDec Null",
+		Name: "Exp",
 		Function: parser.Function{
-			Name: "enotation_to_dec",
+			Name: "exp",
 		},
 		Args: []value.Primary{
-			value.NewNull(),
+			value.NewInteger(2),
 		},
-		Result: value.NewNull(),
+		Result: value.NewFloat(7.38905609893065),
 	},
+}
+
+func TestExp(t *testing.T) {
+	testFunction(t, Exp, expTests)
+}
+
+var exp2Tests = []functionTest{
 	{
-		Name: "EnotationToDec Parse Error",
+		Name: "Exp2",
 		Function: parser.Function{
-			Name: "enotation_to_dec",
+			Name: "exp2",
 		},
 		Args: []value.Primary{
-			value.NewString("string"),
+			value.NewInteger(2),
 		},
-		Result: value.NewNull(),
+		Result: value.NewInteger(4),
 	},
+}
+
+func TestExp2(t *testing.T) {
+	testFunction(t, Exp2, exp2Tests)
+}
+
+var expm1Tests = []functionTest{
 	{
-		Name: "EnotationToDec Arguments Error",
+		Name: "Expm1",
 		Function: parser.Function{
-			Name: "enotation_to_dec",
+			Name: "expm1",
 		},
-		Args:  []value.Primary{},
-		Error: "function enotation_to_dec takes exactly 1 argument",
+		Args: []value.Primary{
+			value.NewInteger(2),
+		},
+		Result: value.NewFloat(6.38905609893065),
 	},
 }
 
-func TestEnotationToDec(t *testing.T) {
-	testFunction(t, EnotationToDec, enotationToDecTests)
+func TestExpm1(t *testing.T) {
+	testFunction(t, Expm1, expm1Tests)
 }
 
-var binTests = []functionTest{
+var mathLogTests = []functionTest{
 	{
-		Name: "Bin",
+		Name: "MathLog",
 		Function: parser.Function{
-			Name: "bin",
+			Name: "log",
 		},
 		Args: []value.Primary{
-			value.NewInteger(123),
+			value.NewFloat(2),
 		},
-		Result: value.NewString("1111011"),
+		Result: value.NewFloat(0.6931471805599453),
 	},
 	{
-		Name: "Bin Null",
+		Name: "MathLog with Base",
 		Function: parser.Function{
-			Name: "bin",
+			Name: "log",
 		},
 		Args: []value.Primary{
-			value.NewNull(),
+			value.NewInteger(2),
+			value.NewInteger(8),
+		},
+		Result: value.NewInteger(3),
+	},
+	{
+		Name: "MathLog with Base of Non-Positive Value",
+		Function: parser.Function{
+			Name: "log",
+		},
+		Args: []value.Primary{
+			value.NewInteger(10),
+			value.NewInteger(0),
 		},
 		Result: value.NewNull(),
 	},
 	{
-		Name: "Bin Arguments Error",
+		Name: "MathLog with Invalid Base",
 		Function: parser.Function{
-			Name: "bin",
+			Name: "log",
 		},
-		Args:  []value.Primary{},
-		Error: "function bin takes exactly 1 argument",
+		Args: []value.Primary{
+			value.NewInteger(1),
+			value.NewInteger(8),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "MathLog Non-Positive Value",
+		Function: parser.Function{
+			Name: "log",
+		},
+		Args: []value.Primary{
+			value.NewInteger(-1),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "MathLog Arguments Error",
+		Function: parser.Function{
+			Name: "log",
+		},
+		Args: []value.Primary{
+			value.NewInteger(1),
+			value.NewInteger(2),
+			value.NewInteger(3),
+		},
+		Error: "function log takes 1 or 2 arguments",
 	},
 }
 
-func TestBin(t *testing.T) {
-	testFunction(t, Bin, binTests)
+func TestMathLog(t *testing.T) {
+	testFunction(t, MathLog, mathLogTests)
 }
 
-var octTests = []functionTest{
+var log10Tests = []functionTest{
 	{
-		Name: "Oct",
+		Name: "Log10",
 		Function: parser.Function{
-			Name: "oct",
+			Name: "log10",
 		},
 		Args: []value.Primary{
-			value.NewInteger(123),
+			value.NewFloat(100),
 		},
-		Result: value.NewString("173"),
+		Result: value.NewInteger(2),
 	},
 }
 
-func TestOct(t *testing.T) {
-	testFunction(t, Oct, octTests)
+func TestLog10(t *testing.T) {
+	testFunction(t, Log10, log10Tests)
 }
 
-var hexTests = []functionTest{
+var log2Tests = []functionTest{
 	{
-		Name: "Hex",
+		Name: "Log2",
 		Function: parser.Function{
-			Name: "hex",
+			Name: "log2",
 		},
 		Args: []value.Primary{
-			value.NewInteger(123),
+			value.NewFloat(16),
 		},
-		Result: value.NewString("7b"),
+		Result: value.NewInteger(4),
 	},
 }
 
-func TestHex(t *testing.T) {
-	testFunction(t, Hex, hexTests)
+func TestLog2(t *testing.T) {
+	testFunction(t, Log2, log2Tests)
 }
 
-var enotationTests = []functionTest{
+var log1pTests = []functionTest{
 	{
-		Name: "Enotation",
+		Name: "Log1p",
 		Function: parser.Function{
-			Name: "enotation",
+			Name: "log1p",
 		},
 		Args: []value.Primary{
-			value.NewFloat(0.0000000000123),
+			value.NewFloat(1),
 		},
-		Result: value.NewString("1.23e-11"),
+		Result: value.NewFloat(0.6931471805599453),
 	},
+}
+
+func TestLog1p(t *testing.T) {
+	testFunction(t, Log1p, log1pTests)
+}
+
+var sqrtTests = []functionTest{
 	{
-		Name: "Enotation From Integer",
+		Name: "Sqrt",
 		Function: parser.Function{
-			Name: "enotation",
+			Name: "sqrt",
 		},
 		Args: []value.Primary{
-			value.NewInteger(1230000000000),
+			value.NewFloat(4),
 		},
-		Result: value.NewString("1.23e+12"),
+		Result: value.NewInteger(2),
 	},
 	{
-		Name: "Enotation Null",
+		Name: "Sqrt Cannot Calculate",
 		Function: parser.Function{
-			Name: "enotation",
+			Name: "sqrt",
 		},
 		Args: []value.Primary{
-			value.NewNull(),
+			value.NewFloat(-4),
 		},
 		Result: value.NewNull(),
 	},
-	{
-		Name: "Enotation Arguments Error",
-		Function: parser.Function{
-			Name: "enotation",
-		},
-		Args:  []value.Primary{},
-		Error: "function enotation takes exactly 1 argument",
-	},
 }
 
-func TestEnotation(t *testing.T) {
-	testFunction(t, Enotation, enotationTests)
+func TestSqrt(t *testing.T) {
+	testFunction(t, Sqrt, sqrtTests)
 }
 
-var numberFormatTests = []functionTest{
+var cbrtTests = []functionTest{
 	{
-		Name: "NumberFormat",
+		Name: "Cbrt",
 		Function: parser.Function{
-			Name: "number_format",
+			Name: "cbrt",
 		},
 		Args: []value.Primary{
-			value.NewFloat(123456.789123),
-			value.NewInteger(4),
-			value.NewString(","),
-			value.NewString(" "),
-			value.NewString(" "),
+			value.NewInteger(-27),
 		},
-		Result: value.NewString("123 456,789 1"),
+		Result: value.NewInteger(-3),
 	},
 	{
-		Name: "NumberFormat Null",
+		Name: "Cbrt Null",
 		Function: parser.Function{
-			Name: "number_format",
+			Name: "cbrt",
 		},
 		Args: []value.Primary{
 			value.NewNull(),
 		},
 		Result: value.NewNull(),
 	},
-	{
-		Name: "NumberFormat Arguments Errlr",
-		Function: parser.Function{
-			Name: "number_format",
-		},
-		Args:  []value.Primary{},
-		Error: "function number_format takes 1 to 5 arguments",
-	},
 }
 
-func TestNumberFormat(t *testing.T) {
-	testFunction(t, NumberFormat, numberFormatTests)
+func TestCbrt(t *testing.T) {
+	testFunction(t, Cbrt, cbrtTests)
 }
 
-var randTests = []struct {
-	Name      string
-	Function  parser.Function
-	Args      []value.Primary
-	RangeLow  float64
-	RangeHigh float64
-	Error     string
-}{
+var powTests = []functionTest{
 	{
-		Name: "Rand",
+		Name: "Pow",
 		Function: parser.Function{
-			Name: "rand",
+			Name: "pow",
 		},
-		RangeLow:  0.0,
-		RangeHigh: 1.0,
+		Args: []value.Primary{
+			value.NewFloat(2),
+			value.NewFloat(2),
+		},
+		Result: value.NewInteger(4),
 	},
 	{
-		Name: "Rand Range Specified",
+		Name: "Pow First Argument is Null",
 		Function: parser.Function{
-			Name: "rand",
+			Name: "pow",
 		},
 		Args: []value.Primary{
-			value.NewInteger(7),
-			value.NewInteger(12),
+			value.NewNull(),
+			value.NewFloat(2),
 		},
-		RangeLow:  7.0,
-		RangeHigh: 12.0,
+		Result: value.NewNull(),
 	},
 	{
-		Name: "Range Arguments Error",
+		Name: "Pow Second Argument is Null",
 		Function: parser.Function{
-			Name: "rand",
+			Name: "pow",
 		},
 		Args: []value.Primary{
-			value.NewInteger(1),
-		},
-		Error: "function rand takes 0 or 2 arguments",
-	},
-	{
-		Name: "Range First Arguments Error",
-		Function: parser.Function{
-			Name: "rand",
-		},
-		Args: []value.Primary{
-			value.NewString("a"),
-			value.NewInteger(2),
+			value.NewFloat(2),
+			value.NewNull(),
 		},
-		Error: "the first argument must be an integer for function rand",
+		Result: value.NewNull(),
 	},
 	{
-		Name: "Range Second Arguments Error",
+		Name: "Pow Cannot Calculate",
 		Function: parser.Function{
-			Name: "rand",
+			Name: "pow",
 		},
 		Args: []value.Primary{
-			value.NewInteger(1),
-			value.NewString("a"),
+			value.NewFloat(-2),
+			value.NewFloat(2.4),
 		},
-		Error: "the second argument must be an integer for function rand",
+		Result: value.NewNull(),
 	},
 	{
-		Name: "Range Arguments Value Error",
+		Name: "Pow Arguments Error",
 		Function: parser.Function{
-			Name: "rand",
-		},
-		Args: []value.Primary{
-			value.NewInteger(1),
-			value.NewInteger(1),
+			Name: "pow",
 		},
-		Error: "the second argument must be greater than the first argument for function rand",
+		Args:  []value.Primary{},
+		Error: "function pow takes exactly 2 arguments",
 	},
 }
 
-func TestRand(t *testing.T) {
-	for _, v := range randTests {
-		result, err := Rand(v.Function, v.Args, TestTx.Flags)
-		if err != nil {
-			if len(v.Error) < 1 {
-				t.Errorf("%s: unexpected error %q", v.Name, err)
-			} else if err.Error() != v.Error {
-				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
-			}
-			continue
-		}
-		if 0 < len(v.Error) {
-			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
-			continue
-		}
-
-		var f float64
-		if len(v.Args) < 1 {
-			f = result.(*value.Float).Raw()
-		} else {
-			f = float64(result.(*value.Integer).Raw())
-		}
-
-		if f < v.RangeLow || v.RangeHigh < f {
-			t.Errorf("%s: result = %f, want in range from %f to %f", v.Name, f, v.RangeLow, v.RangeHigh)
-		}
-	}
+func TestPow(t *testing.T) {
+	testFunction(t, Pow, powTests)
 }
 
-var trimTests = []functionTest{
+var safeDivideTests = []functionTest{
 	{
-		Name: "Trim",
+		Name: "SafeDivide",
 		Function: parser.Function{
-			Name: "trim",
+			Name: "safe_divide",
 		},
 		Args: []value.Primary{
-			value.NewString("aabbfoo, baraabb"),
-			value.NewString("ab"),
+			value.NewInteger(7),
+			value.NewInteger(2),
 		},
-		Result: value.NewString("foo, bar"),
+		Result: value.NewFloat(3.5),
 	},
 	{
-		Name: "Trim Spaces",
+		Name: "SafeDivide Division by Zero",
 		Function: parser.Function{
-			Name: "trim",
+			Name: "safe_divide",
 		},
 		Args: []value.Primary{
-			value.NewString("  foo, bar \n"),
+			value.NewInteger(7),
+			value.NewInteger(0),
 		},
-		Result: value.NewString("foo, bar"),
+		Result: value.NewNull(),
 	},
 	{
-		Name: "Trim Null",
+		Name: "SafeDivide Zero by Zero",
 		Function: parser.Function{
-			Name: "trim",
+			Name: "safe_divide",
 		},
 		Args: []value.Primary{
-			value.NewNull(),
-			value.NewString("ab"),
+			value.NewInteger(0),
+			value.NewFloat(0),
 		},
 		Result: value.NewNull(),
 	},
 	{
-		Name: "Trim Cutset is Null",
+		Name: "SafeDivide Null",
 		Function: parser.Function{
-			Name: "trim",
+			Name: "safe_divide",
 		},
 		Args: []value.Primary{
-			value.NewString("aabbfoo, baraabb"),
+			value.NewInteger(7),
 			value.NewNull(),
 		},
 		Result: value.NewNull(),
 	},
 	{
-		Name: "Trim Arguments Error",
+		Name: "SafeDivide Arguments Error",
 		Function: parser.Function{
-			Name: "trim",
+			Name: "safe_divide",
 		},
-		Args:  []value.Primary{},
-		Error: "function trim takes 1 or 2 arguments",
+		Args: []value.Primary{
+			value.NewInteger(7),
+		},
+		Error: "function safe_divide takes exactly 2 arguments",
 	},
 }
 
-func TestTrim(t *testing.T) {
-	testFunction(t, Trim, trimTests)
+func TestSafeDivide(t *testing.T) {
+	testFunction(t, SafeDivide, safeDivideTests)
 }
 
-var ltrimTests = []functionTest{
+var widthBucketTests = []functionTest{
 	{
-		Name: "Ltrim",
+		Name: "WidthBucket",
 		Function: parser.Function{
-			Name: "ltrim",
+			Name: "width_bucket",
 		},
 		Args: []value.Primary{
-			value.NewString("aabbfoo, baraabb"),
-			value.NewString("ab"),
+			value.NewFloat(5.5),
+			value.NewInteger(0),
+			value.NewInteger(10),
+			value.NewInteger(4),
 		},
-		Result: value.NewString("foo, baraabb"),
+		Result: value.NewInteger(3),
 	},
 	{
-		Name: "Ltrim Spaces",
+		Name: "WidthBucket Lower Bound",
 		Function: parser.Function{
-			Name: "ltrim",
+			Name: "width_bucket",
 		},
 		Args: []value.Primary{
-			value.NewString("  foo, bar \n"),
+			value.NewInteger(0),
+			value.NewInteger(0),
+			value.NewInteger(10),
+			value.NewInteger(4),
 		},
-		Result: value.NewString("foo, bar \n"),
+		Result: value.NewInteger(1),
 	},
-}
-
-func TestLtrim(t *testing.T) {
-	testFunction(t, Ltrim, ltrimTests)
-}
-
-var rtrimTests = []functionTest{
 	{
-		Name: "Rtrim",
+		Name: "WidthBucket Below Range",
 		Function: parser.Function{
-			Name: "rtrim",
+			Name: "width_bucket",
 		},
 		Args: []value.Primary{
-			value.NewString("aabbfoo, baraabb"),
-			value.NewString("ab"),
+			value.NewInteger(-1),
+			value.NewInteger(0),
+			value.NewInteger(10),
+			value.NewInteger(4),
 		},
-		Result: value.NewString("aabbfoo, bar"),
+		Result: value.NewInteger(0),
 	},
 	{
-		Name: "Rtrim Spaces",
+		Name: "WidthBucket Above Range",
 		Function: parser.Function{
-			Name: "rtrim",
+			Name: "width_bucket",
 		},
 		Args: []value.Primary{
-			value.NewString("  foo, bar \n"),
+			value.NewInteger(10),
+			value.NewInteger(0),
+			value.NewInteger(10),
+			value.NewInteger(4),
 		},
-		Result: value.NewString("  foo, bar"),
+		Result: value.NewInteger(5),
 	},
-}
-
-func TestRtrim(t *testing.T) {
-	testFunction(t, Rtrim, rtrimTests)
-}
-
-var upperTests = []functionTest{
 	{
-		Name: "Upper",
+		Name: "WidthBucket Datetime",
 		Function: parser.Function{
-			Name: "upper",
+			Name: "width_bucket",
 		},
 		Args: []value.Primary{
-			value.NewString("Foo"),
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 0, 0, 0, GetTestLocation())),
+			value.NewDatetime(time.Date(2012, 2, 3, 0, 0, 0, 0, GetTestLocation())),
+			value.NewDatetime(time.Date(2012, 2, 3, 12, 0, 0, 0, GetTestLocation())),
+			value.NewInteger(4),
 		},
-		Result: value.NewString("FOO"),
+		Result: value.NewInteger(4),
 	},
 	{
-		Name: "Upper Null",
+		Name: "WidthBucket Null",
 		Function: parser.Function{
-			Name: "upper",
+			Name: "width_bucket",
 		},
 		Args: []value.Primary{
 			value.NewNull(),
+			value.NewInteger(0),
+			value.NewInteger(10),
+			value.NewInteger(4),
 		},
 		Result: value.NewNull(),
 	},
 	{
-		Name: "Upper Arguments Error",
+		Name: "WidthBucket Mismatched Types",
 		Function: parser.Function{
-			Name: "upper",
+			Name: "width_bucket",
 		},
-		Args:  []value.Primary{},
-		Error: "function upper takes exactly 1 argument",
+		Args: []value.Primary{
+			value.NewInteger(5),
+			value.NewDatetime(time.Date(2012, 2, 3, 0, 0, 0, 0, GetTestLocation())),
+			value.NewDatetime(time.Date(2012, 2, 3, 12, 0, 0, 0, GetTestLocation())),
+			value.NewInteger(4),
+		},
+		Result: value.NewNull(),
 	},
-}
-
-func TestUpper(t *testing.T) {
-	testFunction(t, Upper, upperTests)
-}
-
-var lowerTests = []functionTest{
 	{
-		Name: "Lower",
+		Name: "WidthBucket Arguments Error",
 		Function: parser.Function{
-			Name: "lower",
+			Name: "width_bucket",
 		},
 		Args: []value.Primary{
-			value.NewString("Foo"),
+			value.NewInteger(5),
+			value.NewInteger(0),
+			value.NewInteger(10),
 		},
-		Result: value.NewString("foo"),
+		Error: "function width_bucket takes exactly 4 arguments",
 	},
-}
-
-func TestLower(t *testing.T) {
-	testFunction(t, Lower, lowerTests)
-}
-
-var base64EncodeTests = []functionTest{
 	{
-		Name: "Base64Encode",
+		Name: "WidthBucket Invalid Range Error",
 		Function: parser.Function{
-			Name: "base64_encode",
+			Name: "width_bucket",
 		},
 		Args: []value.Primary{
-			value.NewString("Foo"),
+			value.NewInteger(5),
+			value.NewInteger(10),
+			value.NewInteger(10),
+			value.NewInteger(4),
 		},
-		Result: value.NewString("Rm9v"),
+		Error: "the third argument must be greater than the second argument for function width_bucket",
 	},
-}
-
-func TestBase64Encode(t *testing.T) {
-	testFunction(t, Base64Encode, base64EncodeTests)
-}
-
-var base64DecodeTests = []functionTest{
 	{
-		Name: "Base64Decode",
+		Name: "WidthBucket Invalid Bucket Count Error",
 		Function: parser.Function{
-			Name: "base64_decode",
+			Name: "width_bucket",
 		},
 		Args: []value.Primary{
-			value.NewString("Rm9v"),
+			value.NewInteger(5),
+			value.NewInteger(0),
+			value.NewInteger(10),
+			value.NewInteger(0),
 		},
-		Result: value.NewString("Foo"),
+		Error: "the fourth argument must be an integer greater than 0 for function width_bucket",
 	},
 }
 
-func TestBase64Decode(t *testing.T) {
-	testFunction(t, Base64Decode, base64DecodeTests)
+func TestWidthBucket(t *testing.T) {
+	testFunction(t, WidthBucket, widthBucketTests)
 }
 
-var hexEncodeTests = []functionTest{
+var clampTests = []functionTest{
 	{
-		Name: "HexEncode",
+		Name: "Clamp",
 		Function: parser.Function{
-			Name: "hex_encode",
+			Name: "clamp",
 		},
 		Args: []value.Primary{
-			value.NewString("Foo"),
+			value.NewInteger(5),
+			value.NewInteger(0),
+			value.NewInteger(10),
 		},
-		Result: value.NewString("466f6f"),
+		Result: value.NewInteger(5),
 	},
-}
-
-func TestHexEncode(t *testing.T) {
-	testFunction(t, HexEncode, hexEncodeTests)
-}
-
-var hexDecodeTests = []functionTest{
 	{
-		Name: "HexDecode",
+		Name: "Clamp Lower",
 		Function: parser.Function{
-			Name: "hex_decode",
+			Name: "clamp",
 		},
 		Args: []value.Primary{
-			value.NewString("466f6f"),
+			value.NewFloat(-1.5),
+			value.NewInteger(0),
+			value.NewInteger(10),
 		},
-		Result: value.NewString("Foo"),
+		Result: value.NewInteger(0),
 	},
-}
-
-func TestHexDecode(t *testing.T) {
-	testFunction(t, HexDecode, hexDecodeTests)
-}
-
-var lenTests = []functionTest{
 	{
-		Name: "Len",
+		Name: "Clamp Upper",
 		Function: parser.Function{
-			Name: "len",
+			Name: "clamp",
 		},
 		Args: []value.Primary{
-			value.NewString("日本語"),
+			value.NewFloat(10.5),
+			value.NewInteger(0),
+			value.NewInteger(10),
 		},
-		Result: value.NewInteger(3),
+		Result: value.NewInteger(10),
 	},
 	{
-		Name: "Len Null",
+		Name: "Clamp Datetime",
 		Function: parser.Function{
-			Name: "len",
+			Name: "clamp",
 		},
 		Args: []value.Primary{
-			value.NewNull(),
+			value.NewDatetime(time.Date(2012, 2, 3, 14, 0, 0, 0, GetTestLocation())),
+			value.NewDatetime(time.Date(2012, 2, 3, 0, 0, 0, 0, GetTestLocation())),
+			value.NewDatetime(time.Date(2012, 2, 3, 12, 0, 0, 0, GetTestLocation())),
 		},
-		Result: value.NewNull(),
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 12, 0, 0, 0, GetTestLocation())),
 	},
 	{
-		Name: "Len Arguments Error",
+		Name: "Clamp Null",
 		Function: parser.Function{
-			Name: "len",
-		},
-		Args:  []value.Primary{},
-		Error: "function len takes exactly 1 argument",
-	},
-}
-
-func TestLen(t *testing.T) {
-	testFunction(t, Len, lenTests)
-}
-
-var byteLenTests = []functionTest{
-	{
-		Name: "ByteLen",
-		Function: parser.Function{
-			Name: "byte_len",
+			Name: "clamp",
 		},
 		Args: []value.Primary{
-			value.NewString("abc日本語"),
+			value.NewInteger(5),
+			value.NewNull(),
+			value.NewInteger(10),
 		},
-		Result: value.NewInteger(12),
+		Result: value.NewNull(),
 	},
 	{
-		Name: "ByteLen SJIS",
+		Name: "Clamp Incomparable",
 		Function: parser.Function{
-			Name: "byte_len",
+			Name: "clamp",
 		},
 		Args: []value.Primary{
-			value.NewString("abc日本語"),
-			value.NewString("sjis"),
+			value.NewString("abc"),
+			value.NewInteger(0),
+			value.NewInteger(10),
 		},
-		Result: value.NewInteger(9),
+		Result: value.NewNull(),
 	},
 	{
-		Name: "ByteLen Null",
+		Name: "Clamp Arguments Error",
 		Function: parser.Function{
-			Name: "byte_len",
+			Name: "clamp",
 		},
 		Args: []value.Primary{
-			value.NewNull(),
+			value.NewInteger(5),
+			value.NewInteger(0),
 		},
-		Result: value.NewNull(),
+		Error: "function clamp takes exactly 3 arguments",
 	},
 	{
-		Name: "ByteLen Arguments Error",
+		Name: "Clamp Invalid Range Error",
 		Function: parser.Function{
-			Name: "byte_len",
+			Name: "clamp",
 		},
-		Args:  []value.Primary{},
-		Error: "function byte_len takes 1 or 2 arguments",
+		Args: []value.Primary{
+			value.NewInteger(5),
+			value.NewInteger(10),
+			value.NewInteger(0),
+		},
+		Error: "the third argument must be greater than the second argument for function clamp",
 	},
 	{
-		Name: "ByteLen Invalid Encoding Error",
+		Name: "Clamp Empty Range Error",
 		Function: parser.Function{
-			Name: "byte_len",
+			Name: "clamp",
 		},
 		Args: []value.Primary{
-			value.NewString("abc日本語"),
-			value.NewString("invalid"),
+			value.NewInteger(5),
+			value.NewInteger(5),
+			value.NewInteger(5),
 		},
-		Error: "encoding must be one of UTF8|UTF16|SJIS for function byte_len",
+		Error: "the third argument must be greater than the second argument for function clamp",
 	},
 }
 
-func TestByteLen(t *testing.T) {
-	testFunction(t, ByteLen, byteLenTests)
+func TestClamp(t *testing.T) {
+	testFunction(t, Clamp, clampTests)
 }
 
-var widthTests = []functionTest{
+var binToDecTests = []functionTest{
 	{
-		Name: "Width",
+		Name: "BinToDec",
 		Function: parser.Function{
-			Name: "width",
+			Name: "bin_to_dec",
 		},
 		Args: []value.Primary{
-			value.NewString("abc日本語"),
+			value.NewString("1111011"),
 		},
-		Result: value.NewInteger(9),
+		Result: value.NewInteger(123),
 	},
 	{
-		Name: "Width Arguments Is Null",
+		Name: "BinToDec Null",
 		Function: parser.Function{
-			Name: "width",
+			Name: "bin_to_dec",
 		},
 		Args: []value.Primary{
 			value.NewNull(),
@@ -1425,1886 +1608,4700 @@ var widthTests = []functionTest{
 		Result: value.NewNull(),
 	},
 	{
-		Name: "Width Arguments Error",
-		Function: parser.Function{
-			Name: "width",
-		},
-		Args:  []value.Primary{},
-		Error: "function width takes exactly 1 argument",
-	},
-}
-
-func TestWidth(t *testing.T) {
-	testFunction(t, Width, widthTests)
-}
-
-var lpadTests = []functionTest{
-	{
-		Name: "Lpad",
+		Name: "BinToDec Parse Error",
 		Function: parser.Function{
-			Name: "lpad",
+			Name: "bin_to_dec",
 		},
 		Args: []value.Primary{
-			value.NewString("aaaaa"),
-			value.NewInteger(10),
-			value.NewString("01"),
+			value.NewString("string"),
 		},
-		Result: value.NewString("01010aaaaa"),
+		Result: value.NewNull(),
 	},
 	{
-		Name: "Lpad by Byte Length",
+		Name: "BinToDec Arguments Error",
 		Function: parser.Function{
-			Name: "lpad",
-		},
-		Args: []value.Primary{
-			value.NewString("日本語"),
-			value.NewInteger(12),
-			value.NewString("空白"),
-			value.NewString("byte"),
-			value.NewString("sjis"),
+			Name: "bin_to_dec",
 		},
-		Result: value.NewString("空白空日本語"),
+		Args:  []value.Primary{},
+		Error: "function bin_to_dec takes exactly 1 argument",
 	},
+}
+
+func TestBinToDec(t *testing.T) {
+	testFunction(t, BinToDec, binToDecTests)
+}
+
+var octToDecTests = []functionTest{
 	{
-		Name: "Lpad by Byte Length Error",
+		Name: "OctToDec",
 		Function: parser.Function{
-			Name: "lpad",
+			Name: "oct_to_dec",
 		},
 		Args: []value.Primary{
-			value.NewString("日本語"),
-			value.NewInteger(11),
-			value.NewString("空白"),
-			value.NewString("byte"),
-			value.NewString("sjis"),
+			value.NewString("0173"),
 		},
-		Error: "cannot split pad string in a byte array of a character for function lpad",
+		Result: value.NewInteger(123),
 	},
+}
+
+func TestOctToDec(t *testing.T) {
+	testFunction(t, OctToDec, octToDecTests)
+}
+
+var hexToDecTests = []functionTest{
 	{
-		Name: "Lpad PadType Error",
+		Name: "HexToDec",
 		Function: parser.Function{
-			Name: "lpad",
+			Name: "hex_to_dec",
 		},
 		Args: []value.Primary{
-			value.NewString("日本語"),
-			value.NewInteger(11),
-			value.NewString("空白"),
-			value.NewString("invalid"),
+			value.NewString("0x7b"),
 		},
-		Error: "padding type must be one of LEN|BYTE|WIDTH for function lpad",
+		Result: value.NewInteger(123),
 	},
+}
+
+func TestHexToDec(t *testing.T) {
+	testFunction(t, HexToDec, hexToDecTests)
+}
+
+var enotationToDecTests = []functionTest{
 	{
-		Name: "Lpad Encoding Error",
+		Name: "EnotationToDec",
 		Function: parser.Function{
-			Name: "lpad",
+			Name: "enotation_to_dec",
 		},
 		Args: []value.Primary{
-			value.NewString("日本語"),
-			value.NewInteger(11),
-			value.NewString("空白"),
-			value.NewString("byte"),
-			value.NewString("invalid"),
+			value.NewString("1.23e-11"),
 		},
-		Error: "encoding must be one of UTF8|UTF16|SJIS for function lpad",
+		Result: value.NewFloat(0.0000000000123),
 	},
 	{
-		Name: "Lpad by Width",
+		Name: "EnotationToDec To Integer",
 		Function: parser.Function{
-			Name: "lpad",
+			Name: "enotation_to_dec",
 		},
 		Args: []value.Primary{
-			value.NewString("日本語"),
-			value.NewInteger(12),
-			value.NewString("空白"),
-			value.NewString("width"),
+			value.NewString("1.23e+12"),
 		},
-		Result: value.NewString("空白空日本語"),
+		Result: value.NewInteger(1230000000000),
 	},
 	{
-		Name: "Lpad by Width Length Error",
+		Name: "EnotationToDec Null",
 		Function: parser.Function{
-			Name: "lpad",
+			Name: "enotation_to_dec",
 		},
 		Args: []value.Primary{
-			value.NewString("日本語"),
-			value.NewInteger(11),
-			value.NewString("空白"),
-			value.NewString("width"),
+			value.NewNull(),
 		},
-		Error: "cannot split pad string in a byte array of a character for function lpad",
+		Result: value.NewNull(),
 	},
 	{
-		Name: "Lpad No Padding",
+		Name: "EnotationToDec Parse Error",
 		Function: parser.Function{
-			Name: "lpad",
+			Name: "enotation_to_dec",
 		},
 		Args: []value.Primary{
-			value.NewString("aaaaa"),
-			value.NewInteger(5),
-			value.NewString("01"),
+			value.NewString("string"),
 		},
-		Result: value.NewString("aaaaa"),
+		Result: value.NewNull(),
 	},
 	{
-		Name: "Lpad String is Null",
+		Name: "EnotationToDec Arguments Error",
 		Function: parser.Function{
-			Name: "lpad",
-		},
-		Args: []value.Primary{
-			value.NewNull(),
-			value.NewInteger(10),
-			value.NewString("01"),
+			Name: "enotation_to_dec",
 		},
-		Result: value.NewNull(),
+		Args:  []value.Primary{},
+		Error: "function enotation_to_dec takes exactly 1 argument",
 	},
+}
+
+func TestEnotationToDec(t *testing.T) {
+	testFunction(t, EnotationToDec, enotationToDecTests)
+}
+
+var binTests = []functionTest{
 	{
-		Name: "Lpad Length is Null",
+		Name: "Bin",
 		Function: parser.Function{
-			Name: "lpad",
+			Name: "bin",
 		},
 		Args: []value.Primary{
-			value.NewString("aaaaa"),
-			value.NewNull(),
-			value.NewString("01"),
+			value.NewInteger(123),
 		},
-		Result: value.NewNull(),
+		Result: value.NewString("1111011"),
 	},
 	{
-		Name: "Lpad Pad String is Null",
+		Name: "Bin Null",
 		Function: parser.Function{
-			Name: "lpad",
+			Name: "bin",
 		},
 		Args: []value.Primary{
-			value.NewString("aaaaa"),
-			value.NewInteger(10),
 			value.NewNull(),
 		},
 		Result: value.NewNull(),
 	},
 	{
-		Name: "Lpad Arguments Error",
+		Name: "Bin Arguments Error",
 		Function: parser.Function{
-			Name: "lpad",
+			Name: "bin",
 		},
 		Args:  []value.Primary{},
-		Error: "function lpad takes 3 to 5 arguments",
+		Error: "function bin takes exactly 1 argument",
 	},
 }
 
-func TestLpad(t *testing.T) {
-	testFunction(t, Lpad, lpadTests)
+func TestBin(t *testing.T) {
+	testFunction(t, Bin, binTests)
 }
 
-var rpadTests = []functionTest{
+var octTests = []functionTest{
 	{
-		Name: "Rpad",
+		Name: "Oct",
 		Function: parser.Function{
-			Name: "rpad",
+			Name: "oct",
 		},
 		Args: []value.Primary{
-			value.NewString("aaaaa"),
-			value.NewInteger(10),
-			value.NewString("01"),
+			value.NewInteger(123),
 		},
-		Result: value.NewString("aaaaa01010"),
+		Result: value.NewString("173"),
 	},
 }
 
-func TestRpad(t *testing.T) {
-	testFunction(t, Rpad, rpadTests)
+func TestOct(t *testing.T) {
+	testFunction(t, Oct, octTests)
 }
 
-var substringTests = []functionTest{
+var hexTests = []functionTest{
 	{
-		Name: "Substring with a positive argument",
+		Name: "Hex",
 		Function: parser.Function{
-			Name: "substring",
+			Name: "hex",
 		},
 		Args: []value.Primary{
-			value.NewString("abcdefghijklmn"),
-			value.NewInteger(5),
+			value.NewInteger(123),
 		},
-		Result: value.NewString("efghijklmn"),
+		Result: value.NewString("7b"),
 	},
+}
+
+func TestHex(t *testing.T) {
+	testFunction(t, Hex, hexTests)
+}
+
+var enotationTests = []functionTest{
 	{
-		Name: "Substring with a negative argument",
+		Name: "Enotation",
 		Function: parser.Function{
-			Name: "substring",
+			Name: "enotation",
 		},
 		Args: []value.Primary{
-			value.NewString("abcdefghijklmn"),
-			value.NewInteger(-5),
+			value.NewFloat(0.0000000000123),
 		},
-		Result: value.NewString("jklmn"),
+		Result: value.NewString("1.23e-11"),
 	},
 	{
-		Name: "Substring with two positive argument",
+		Name: "Enotation From Integer",
 		Function: parser.Function{
-			Name: "substring",
+			Name: "enotation",
 		},
 		Args: []value.Primary{
-			value.NewString("abcdefghijklmn"),
-			value.NewInteger(5),
-			value.NewInteger(3),
+			value.NewInteger(1230000000000),
 		},
-		Result: value.NewString("efg"),
+		Result: value.NewString("1.23e+12"),
 	},
 	{
-		Name: "Substring starting with zero",
+		Name: "Enotation Null",
 		Function: parser.Function{
-			Name: "substring",
+			Name: "enotation",
 		},
 		Args: []value.Primary{
-			value.NewString("abcdefghijklmn"),
-			value.NewInteger(0),
-			value.NewInteger(3),
+			value.NewNull(),
 		},
-		Result: value.NewString("abc"),
+		Result: value.NewNull(),
 	},
 	{
-		Name: "Substring",
+		Name: "Enotation Arguments Error",
 		Function: parser.Function{
-			Name: "substring",
-		},
-		Args: []value.Primary{
-			value.NewString("abcdefghijklmn"),
-			value.NewInteger(-5),
-			value.NewInteger(8),
+			Name: "enotation",
 		},
-		Result: value.NewString("jklmn"),
+		Args:  []value.Primary{},
+		Error: "function enotation takes exactly 1 argument",
 	},
+}
+
+func TestEnotation(t *testing.T) {
+	testFunction(t, Enotation, enotationTests)
+}
+
+var numberFormatTests = []functionTest{
 	{
-		Name: "Substring String is Null",
+		Name: "NumberFormat",
 		Function: parser.Function{
-			Name: "substring",
+			Name: "number_format",
 		},
 		Args: []value.Primary{
-			value.NewNull(),
-			value.NewInteger(-5),
-			value.NewInteger(8),
+			value.NewFloat(123456.789123),
+			value.NewInteger(4),
+			value.NewString(","),
+			value.NewString(" "),
+			value.NewString(" "),
 		},
-		Result: value.NewNull(),
+		Result: value.NewString("123 456,789 1"),
 	},
 	{
-		Name: "Substring StartIndex is Null",
+		Name: "NumberFormat Null",
 		Function: parser.Function{
-			Name: "substring",
+			Name: "number_format",
 		},
 		Args: []value.Primary{
-			value.NewString("abcdefghijklmn"),
 			value.NewNull(),
 		},
 		Result: value.NewNull(),
 	},
 	{
-		Name: "Substring Length is Null",
+		Name: "NumberFormat Arguments Errlr",
 		Function: parser.Function{
-			Name: "substring",
-		},
-		Args: []value.Primary{
-			value.NewString("abcdefghijklmn"),
-			value.NewInteger(-5),
-			value.NewNull(),
+			Name: "number_format",
 		},
-		Result: value.NewNull(),
+		Args:  []value.Primary{},
+		Error: "function number_format takes 1 to 5 arguments",
 	},
+}
+
+func TestNumberFormat(t *testing.T) {
+	testFunction(t, NumberFormat, numberFormatTests)
+}
+
+var toNumberTests = []functionTest{
 	{
-		Name: "Substring Length is Negative",
+		Name: "ToNumber",
 		Function: parser.Function{
-			Name: "substring",
+			Name: "to_number",
 		},
 		Args: []value.Primary{
-			value.NewString("abcdefghijklmn"),
-			value.NewInteger(-5),
-			value.NewInteger(-1),
+			value.NewString("1.234,56"),
+			value.NewString("#.##0,00"),
 		},
-		Result: value.NewNull(),
+		Result: value.NewFloat(1234.56),
 	},
 	{
-		Name: "Substring StartIndex is Out Of Index",
+		Name: "ToNumber Thousands Separator",
 		Function: parser.Function{
-			Name: "substring",
+			Name: "to_number",
 		},
 		Args: []value.Primary{
-			value.NewString("abcdefghijklmn"),
-			value.NewInteger(100),
-			value.NewInteger(8),
+			value.NewString("-1,234,567.8"),
+			value.NewString("#,##0.00"),
 		},
-		Result: value.NewNull(),
+		Result: value.NewFloat(-1234567.8),
 	},
 	{
-		Name: "Substring Arguments Error",
+		Name: "ToNumber Space Separator",
 		Function: parser.Function{
-			Name: "substring",
+			Name: "to_number",
 		},
-		Args:  []value.Primary{},
-		Error: "function substring takes 2 or 3 arguments",
+		Args: []value.Primary{
+			value.NewString(" 1 234,5 "),
+			value.NewString("# ###,##"),
+		},
+		Result: value.NewFloat(1234.5),
 	},
-}
-
-func TestSubstring(t *testing.T) {
-	testFunction(t, Substring, substringTests)
-}
-
-var substrTests = []functionTest{
 	{
-		Name: "Substr with a positive argument",
+		Name: "ToNumber Group Separator Only",
 		Function: parser.Function{
-			Name: "substr",
+			Name: "to_number",
 		},
 		Args: []value.Primary{
-			value.NewString("abcdefghijklmn"),
-			value.NewInteger(5),
+			value.NewString("1.234.567"),
+			value.NewString("#.###.###"),
 		},
-		Result: value.NewString("fghijklmn"),
+		Result: value.NewFloat(1234567),
 	},
 	{
-		Name: "Substr with a negative argument",
+		Name: "ToNumber Decimal Point Only",
 		Function: parser.Function{
-			Name: "substr",
+			Name: "to_number",
 		},
 		Args: []value.Primary{
-			value.NewString("abcdefghijklmn"),
-			value.NewInteger(-5),
+			value.NewString("+0,5"),
+			value.NewString("0,00"),
 		},
-		Result: value.NewString("jklmn"),
+		Result: value.NewFloat(0.5),
 	},
 	{
-		Name: "Substr with two positive argument",
+		Name: "ToNumber Single Group Separator",
 		Function: parser.Function{
-			Name: "substr",
+			Name: "to_number",
 		},
 		Args: []value.Primary{
-			value.NewString("abcdefghijklmn"),
-			value.NewInteger(5),
-			value.NewInteger(3),
+			value.NewString("1,234"),
+			value.NewString("#,##0"),
 		},
-		Result: value.NewString("fgh"),
+		Result: value.NewFloat(1234),
 	},
-}
-
-func TestSubstr(t *testing.T) {
-	testFunction(t, Substr, substrTests)
-}
-
-var instrTests = []functionTest{
 	{
-		Name: "Instr",
+		Name: "ToNumber Without Format",
 		Function: parser.Function{
-			Name: "instr",
+			Name: "to_number",
 		},
 		Args: []value.Primary{
-			value.NewString("abcdefghijklmn"),
-			value.NewString("def"),
+			value.NewString("1.234.567,8"),
 		},
-		Result: value.NewInteger(3),
+		Result: value.NewFloat(1234567.8),
 	},
 	{
-		Name: "Instr String is Null",
+		Name: "ToNumber Without Format Single Separator",
 		Function: parser.Function{
-			Name: "instr",
+			Name: "to_number",
 		},
 		Args: []value.Primary{
-			value.NewNull(),
-			value.NewString("def"),
+			value.NewString("-1,5"),
 		},
-		Result: value.NewNull(),
+		Result: value.NewFloat(-1.5),
 	},
 	{
-		Name: "Instr Substring is Null",
+		Name: "ToNumber Without Format Error",
 		Function: parser.Function{
-			Name: "instr",
+			Name: "to_number",
 		},
 		Args: []value.Primary{
-			value.NewString("abcdefghijklmn"),
-			value.NewNull(),
+			value.NewString("1,234.5,6"),
 		},
-		Result: value.NewNull(),
+		Error: "'1,234.5,6' cannot be converted to a number for function to_number",
 	},
 	{
-		Name: "Instr Substring does not exist",
+		Name: "ToNumber Null",
 		Function: parser.Function{
-			Name: "instr",
+			Name: "to_number",
 		},
 		Args: []value.Primary{
-			value.NewString("abcdefghijklmn"),
-			value.NewString("zzz"),
+			value.NewNull(),
+			value.NewString("#,##0.00"),
 		},
 		Result: value.NewNull(),
 	},
 	{
-		Name: "Instr Arguments Error",
-		Function: parser.Function{
-			Name: "instr",
-		},
-		Args:  []value.Primary{},
-		Error: "function instr takes exactly 2 arguments",
-	},
-}
-
-func TestInstr(t *testing.T) {
-	testFunction(t, Instr, instrTests)
-}
-
-var listElemTests = []functionTest{
-	{
-		Name: "ListElem",
+		Name: "ToNumber Empty String",
 		Function: parser.Function{
-			Name: "list_elem",
+			Name: "to_number",
 		},
 		Args: []value.Primary{
-			value.NewString("abc def ghi"),
-			value.NewString(" "),
-			value.NewInteger(1),
+			value.NewString(""),
+			value.NewString("#,##0.00"),
 		},
-		Result: value.NewString("def"),
+		Result: value.NewNull(),
 	},
 	{
-		Name: "ListElem String is Null",
+		Name: "ToNumber Arguments Error",
 		Function: parser.Function{
-			Name: "list_elem",
+			Name: "to_number",
 		},
 		Args: []value.Primary{
-			value.NewNull(),
-			value.NewString(" "),
-			value.NewInteger(1),
+			value.NewString("1.234,56"),
+			value.NewString("#.##0,00"),
+			value.NewString("#.##0,00"),
 		},
-		Result: value.NewNull(),
+		Error: "function to_number takes 1 or 2 arguments",
 	},
 	{
-		Name: "ListElem Separator is Null",
+		Name: "ToNumber Format Null Error",
 		Function: parser.Function{
-			Name: "list_elem",
+			Name: "to_number",
 		},
 		Args: []value.Primary{
-			value.NewString("abc def ghi"),
+			value.NewString("1.234,56"),
 			value.NewNull(),
-			value.NewInteger(1),
 		},
-		Result: value.NewNull(),
+		Error: "the second argument must be a string for function to_number",
 	},
 	{
-		Name: "ListElem Index is Null",
+		Name: "ToNumber Invalid Format Error",
 		Function: parser.Function{
-			Name: "list_elem",
+			Name: "to_number",
 		},
 		Args: []value.Primary{
-			value.NewString("abc def ghi"),
-			value.NewString(" "),
-			value.NewNull(),
+			value.NewString("1.234,56"),
+			value.NewString("#,##0.00,0"),
 		},
-		Result: value.NewNull(),
+		Error: "'#,##0.00,0' is an invalid number format for function to_number",
 	},
 	{
-		Name: "ListElem Index is negative value",
+		Name: "ToNumber Unparseable Error",
 		Function: parser.Function{
-			Name: "list_elem",
+			Name: "to_number",
 		},
 		Args: []value.Primary{
-			value.NewString("abc def ghi"),
-			value.NewString(" "),
-			value.NewInteger(-1),
+			value.NewString("1,234.56"),
+			value.NewString("#.##0,00"),
 		},
-		Result: value.NewNull(),
+		Error: "'1,234.56' cannot be converted to a number with the format '#.##0,00' for function to_number",
 	},
 	{
-		Name: "ListElem Index does not exist",
+		Name: "ToNumber Invalid Character Error",
 		Function: parser.Function{
-			Name: "list_elem",
+			Name: "to_number",
 		},
 		Args: []value.Primary{
-			value.NewString("abc def ghi"),
-			value.NewString(" "),
-			value.NewInteger(100),
+			value.NewString("12a"),
+			value.NewString("#,##0.00"),
 		},
-		Result: value.NewNull(),
+		Error: "'12a' cannot be converted to a number with the format '#,##0.00' for function to_number",
 	},
 	{
-		Name: "ListElem Arguments Error",
+		Name: "ToNumber Empty Group Error",
 		Function: parser.Function{
-			Name: "list_elem",
+			Name: "to_number",
 		},
-		Args:  []value.Primary{},
-		Error: "function list_elem takes exactly 3 arguments",
+		Args: []value.Primary{
+			value.NewString("1,,234"),
+			value.NewString("#,##0.00"),
+		},
+		Error: "'1,,234' cannot be converted to a number with the format '#,##0.00' for function to_number",
 	},
 }
 
-func TestListElem(t *testing.T) {
-	testFunction(t, ListElem, listElemTests)
+func TestToNumber(t *testing.T) {
+	testFunction(t, ToNumber, toNumberTests)
 }
 
-var replaceFnTests = []functionTest{
+var formatNumberTests = []functionTest{
 	{
-		Name: "Replace",
+		Name: "FormatNumber",
 		Function: parser.Function{
-			Name: "replace",
+			Name: "format_number",
 		},
 		Args: []value.Primary{
-			value.NewString("abcdefg abcdefg"),
-			value.NewString("cd"),
-			value.NewString("CD"),
+			value.NewFloat(1234567.891),
+			value.NewInteger(2),
+			value.NewString(","),
+			value.NewString("."),
 		},
-		Result: value.NewString("abCDefg abCDefg"),
+		Result: value.NewString("1.234.567,89"),
 	},
 	{
-		Name: "Replace String is Null",
+		Name: "FormatNumber Default Separators",
 		Function: parser.Function{
-			Name: "replace",
+			Name: "format_number",
 		},
 		Args: []value.Primary{
-			value.NewNull(),
-			value.NewString("cd"),
-			value.NewString("CD"),
+			value.NewInteger(1234),
+			value.NewInteger(1),
 		},
-		Result: value.NewNull(),
+		Result: value.NewString("1,234.0"),
 	},
 	{
-		Name: "Replace Old String is Null",
+		Name: "FormatNumber Null",
 		Function: parser.Function{
-			Name: "replace",
+			Name: "format_number",
 		},
 		Args: []value.Primary{
-			value.NewString("abcdefg abcdefg"),
 			value.NewNull(),
-			value.NewString("CD"),
+			value.NewInteger(2),
 		},
 		Result: value.NewNull(),
 	},
 	{
-		Name: "Replace New String is Null",
+		Name: "FormatNumber Arguments Error",
 		Function: parser.Function{
-			Name: "replace",
+			Name: "format_number",
 		},
 		Args: []value.Primary{
-			value.NewString("abcdefg abcdefg"),
-			value.NewString("cd"),
-			value.NewNull(),
+			value.NewFloat(1.5),
 		},
-		Result: value.NewNull(),
+		Error: "function format_number takes 2 to 4 arguments",
 	},
 	{
-		Name: "Replace Arguments Error",
+		Name: "FormatNumber Decimals Error",
 		Function: parser.Function{
-			Name: "replace",
+			Name: "format_number",
 		},
-		Args:  []value.Primary{},
-		Error: "function replace takes exactly 3 arguments",
+		Args: []value.Primary{
+			value.NewFloat(1.5),
+			value.NewString("a"),
+		},
+		Error: "the second argument must be an integer for function format_number",
 	},
 }
 
-func TestReplaceFn(t *testing.T) {
-	testFunction(t, ReplaceFn, replaceFnTests)
+func TestFormatNumber(t *testing.T) {
+	testFunction(t, FormatNumber, formatNumberTests)
 }
 
-var formatTests = []functionTest{
+var parseNumberTests = []functionTest{
 	{
-		Name: "Format",
+		Name: "ParseNumber",
 		Function: parser.Function{
-			Name: "format",
+			Name: "parse_number",
 		},
 		Args: []value.Primary{
-			value.NewString("string = %q, integer = %q"),
-			value.NewString("str"),
-			value.NewInteger(1),
+			value.NewString("1,234,567.89"),
 		},
-		Result: value.NewString("string = 'str', integer = '1'"),
+		Result: value.NewFloat(1234567.89),
 	},
 	{
-		Name: "Format Argument Length Error",
+		Name: "ParseNumber European",
 		Function: parser.Function{
-			Name: "format",
+			Name: "parse_number",
 		},
-		Args:  []value.Primary{},
-		Error: "function format takes at least 1 argument",
+		Args: []value.Primary{
+			value.NewString("-1.234,56"),
+			value.NewString(","),
+			value.NewString("."),
+		},
+		Result: value.NewFloat(-1234.56),
 	},
 	{
-		Name: "Format Argument Value Error",
+		Name: "ParseNumber Unparseable",
 		Function: parser.Function{
-			Name: "format",
+			Name: "parse_number",
 		},
 		Args: []value.Primary{
-			value.NewNull(),
-			value.NewString("str"),
-			value.NewInteger(1),
+			value.NewString("1.234,56"),
 		},
-		Error: "the first argument must be a string for function format",
+		Result: value.NewNull(),
 	},
 	{
-		Name: "Format Replace Holder Length Not Match Error",
+		Name: "ParseNumber Spaces Not Lenient",
 		Function: parser.Function{
-			Name: "format",
+			Name: "parse_number",
 		},
 		Args: []value.Primary{
-			value.NewString("string = %s, integer = %s"),
-			value.NewString("str"),
+			value.NewString(" 42 "),
 		},
-		Error: "number of replace values does not match for function format",
+		Result: value.NewNull(),
 	},
-}
-
-func TestFormat(t *testing.T) {
-	testFunction(t, Format, formatTests)
-}
-
-var jsonValueTests = []functionTest{
 	{
-		Name: "JsonValue",
+		Name: "ParseNumber Lenient",
 		Function: parser.Function{
-			Name: "json_value",
+			Name: "parse_number",
 		},
 		Args: []value.Primary{
-			value.NewString("key1.key2"),
-			value.NewString("{\"key1\":{\"key2\":\"value\"}}"),
+			value.NewString(" € 1.234,5 "),
+			value.NewString(","),
+			value.NewString("."),
+			value.NewTernary(ternary.TRUE),
 		},
-		Result: value.NewString("value"),
+		Result: value.NewFloat(1234.5),
 	},
 	{
-		Name: "JsonValue Query is Null",
+		Name: "ParseNumber Lenient Sign Before Symbol",
 		Function: parser.Function{
-			Name: "json_value",
+			Name: "parse_number",
 		},
 		Args: []value.Primary{
-			value.NewNull(),
-			value.NewString("{\"key1\":{\"key2\":\"value\"}}"),
+			value.NewString("-$12.5"),
+			value.NewString("."),
+			value.NewString(","),
+			value.NewTernary(ternary.TRUE),
 		},
-		Result: value.NewNull(),
+		Result: value.NewFloat(-12.5),
 	},
 	{
-		Name: "JsonValue Json-Text is Null",
+		Name: "ParseNumber Lenient Sign After Symbol",
 		Function: parser.Function{
-			Name: "json_value",
+			Name: "parse_number",
+		},
+		Args: []value.Primary{
+			value.NewString("$ -12.5"),
+			value.NewString("."),
+			value.NewString(","),
+			value.NewTernary(ternary.TRUE),
+		},
+		Result: value.NewFloat(-12.5),
+	},
+	{
+		Name: "ParseNumber Null",
+		Function: parser.Function{
+			Name: "parse_number",
 		},
 		Args: []value.Primary{
-			value.NewString("key1.key2"),
 			value.NewNull(),
 		},
 		Result: value.NewNull(),
 	},
 	{
-		Name: "JsonValue Arguments Error",
+		Name: "ParseNumber Arguments Error",
 		Function: parser.Function{
-			Name: "json_value",
+			Name: "parse_number",
+		},
+		Args:  []value.Primary{},
+		Error: "function parse_number takes 1 to 4 arguments",
+	},
+	{
+		Name: "ParseNumber Separator Error",
+		Function: parser.Function{
+			Name: "parse_number",
 		},
 		Args: []value.Primary{
-			value.NewString("key1.key2"),
+			value.NewString("1"),
+			value.NewString(","),
+			value.NewString(","),
 		},
-		Error: "function json_value takes exactly 2 arguments",
+		Error: "the decimal point must be a non-empty string different from the group separator for function parse_number",
 	},
 	{
-		Name: "JsonValue Json Loading Error",
+		Name: "ParseNumber Lenient Error",
 		Function: parser.Function{
-			Name: "json_value",
+			Name: "parse_number",
 		},
 		Args: []value.Primary{
-			value.NewString("key1.key2"),
-			value.NewString("{key1:{\"key2\":\"value\"}}"),
+			value.NewString("1"),
+			value.NewString("."),
+			value.NewString(","),
+			value.NewString("a"),
 		},
-		Error: "line 1, column 2: unexpected token \"key\" for function json_value",
+		Error: "the fourth argument must be a boolean for function parse_number",
 	},
 }
 
-func TestJsonValue(t *testing.T) {
-	testFunction(t, JsonValue, jsonValueTests)
+func TestParseNumber(t *testing.T) {
+	testFunction(t, ParseNumber, parseNumberTests)
 }
 
-var md5Tests = []functionTest{
+var randTests = []struct {
+	Name      string
+	Function  parser.Function
+	Args      []value.Primary
+	RangeLow  float64
+	RangeHigh float64
+	Error     string
+}{
 	{
-		Name: "Md5",
+		Name: "Rand",
 		Function: parser.Function{
-			Name: "md5",
+			Name: "rand",
+		},
+		RangeLow:  0.0,
+		RangeHigh: 1.0,
+	},
+	{
+		Name: "Rand Range Specified",
+		Function: parser.Function{
+			Name: "rand",
 		},
 		Args: []value.Primary{
-			value.NewString("foo"),
+			value.NewInteger(7),
+			value.NewInteger(12),
 		},
-		Result: value.NewString("acbd18db4cc2f85cedef654fccc4a4d8"),
+		RangeLow:  7.0,
+		RangeHigh: 12.0,
 	},
 	{
-		Name: "Md5 Null",
+		Name: "Range Arguments Error",
 		Function: parser.Function{
-			Name: "md5",
+			Name: "rand",
 		},
 		Args: []value.Primary{
-			value.NewNull(),
+			value.NewInteger(1),
 		},
-		Result: value.NewNull(),
+		Error: "function rand takes 0 or 2 arguments",
 	},
 	{
-		Name: "Md5 Arguments Error",
+		Name: "Range First Arguments Error",
 		Function: parser.Function{
-			Name: "md5",
+			Name: "rand",
 		},
-		Args:  []value.Primary{},
-		Error: "function md5 takes exactly 1 argument",
+		Args: []value.Primary{
+			value.NewString("a"),
+			value.NewInteger(2),
+		},
+		Error: "the first argument must be an integer for function rand",
 	},
-}
-
-func TestMd5(t *testing.T) {
-	testFunction(t, Md5, md5Tests)
-}
-
-var sha1Tests = []functionTest{
 	{
-		Name: "Sha1",
+		Name: "Range Second Arguments Error",
 		Function: parser.Function{
-			Name: "sha1",
+			Name: "rand",
 		},
 		Args: []value.Primary{
-			value.NewString("foo"),
+			value.NewInteger(1),
+			value.NewString("a"),
 		},
-		Result: value.NewString("0beec7b5ea3f0fdbc95d0dd47f3c5bc275da8a33"),
+		Error: "the second argument must be an integer for function rand",
 	},
-}
-
-func TestSha1(t *testing.T) {
-	testFunction(t, Sha1, sha1Tests)
-}
-
-var sha256Tests = []functionTest{
 	{
-		Name: "Sha256",
+		Name: "Range Arguments Value Error",
 		Function: parser.Function{
-			Name: "sha256",
+			Name: "rand",
 		},
 		Args: []value.Primary{
-			value.NewString("foo"),
+			value.NewInteger(1),
+			value.NewInteger(1),
 		},
-		Result: value.NewString("2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"),
+		Error: "the second argument must be greater than the first argument for function rand",
 	},
 }
 
-func TestSha256(t *testing.T) {
-	testFunction(t, Sha256, sha256Tests)
+func TestRand(t *testing.T) {
+	for _, v := range randTests {
+		result, err := Rand(v.Function, v.Args, TestTx.Flags)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		var f float64
+		if len(v.Args) < 1 {
+			f = result.(*value.Float).Raw()
+		} else {
+			f = float64(result.(*value.Integer).Raw())
+		}
+
+		if f < v.RangeLow || v.RangeHigh < f {
+			t.Errorf("%s: result = %f, want in range from %f to %f", v.Name, f, v.RangeLow, v.RangeHigh)
+		}
+	}
 }
 
-var sha512Tests = []functionTest{
+var trimTests = []functionTest{
 	{
-		Name: "Sha512",
+		Name: "Trim",
 		Function: parser.Function{
-			Name: "sha512",
+			Name: "trim",
 		},
 		Args: []value.Primary{
-			value.NewString("foo"),
+			value.NewString("aabbfoo, baraabb"),
+			value.NewString("ab"),
 		},
-		Result: value.NewString("f7fbba6e0636f890e56fbbf3283e524c6fa3204ae298382d624741d0dc6638326e282c41be5e4254d8820772c5518a2c5a8c0c7f7eda19594a7eb539453e1ed7"),
+		Result: value.NewString("foo, bar"),
 	},
-}
-
-func TestSha512(t *testing.T) {
-	testFunction(t, Sha512, sha512Tests)
-}
-
-var md5HmacTests = []functionTest{
 	{
-		Name: "Md5Hmac",
+		Name: "Trim Spaces",
 		Function: parser.Function{
-			Name: "md5_hmac",
+			Name: "trim",
 		},
 		Args: []value.Primary{
-			value.NewString("foo"),
-			value.NewString("bar"),
+			value.NewString("  foo, bar \n"),
 		},
-		Result: value.NewString("31b6db9e5eb4addb42f1a6ca07367adc"),
+		Result: value.NewString("foo, bar"),
 	},
 	{
-		Name: "Md5Hmac String is Null",
+		Name: "Trim Null",
 		Function: parser.Function{
-			Name: "md5_hmac",
+			Name: "trim",
 		},
 		Args: []value.Primary{
 			value.NewNull(),
-			value.NewString("bar"),
+			value.NewString("ab"),
 		},
 		Result: value.NewNull(),
 	},
 	{
-		Name: "Md5Hmac Key is Null",
+		Name: "Trim Cutset is Null",
 		Function: parser.Function{
-			Name: "md5_hmac",
+			Name: "trim",
 		},
 		Args: []value.Primary{
-			value.NewString("foo"),
+			value.NewString("aabbfoo, baraabb"),
 			value.NewNull(),
 		},
 		Result: value.NewNull(),
 	},
 	{
-		Name: "Md5Hmac Arguments Error",
+		Name: "Trim Arguments Error",
 		Function: parser.Function{
-			Name: "md5_hmac",
+			Name: "trim",
 		},
 		Args:  []value.Primary{},
-		Error: "function md5_hmac takes exactly 2 arguments",
+		Error: "function trim takes 1 or 2 arguments",
 	},
 }
 
-func TestMd5Hmac(t *testing.T) {
-	testFunction(t, Md5Hmac, md5HmacTests)
+func TestTrim(t *testing.T) {
+	testFunction(t, Trim, trimTests)
 }
 
-var sha1HmacTests = []functionTest{
+var ltrimTests = []functionTest{
 	{
-		Name: "Sha1Hmac",
+		Name: "Ltrim",
 		Function: parser.Function{
-			Name: "sha1_hmac",
+			Name: "ltrim",
 		},
 		Args: []value.Primary{
-			value.NewString("foo"),
-			value.NewString("bar"),
+			value.NewString("aabbfoo, baraabb"),
+			value.NewString("ab"),
 		},
-		Result: value.NewString("85d155c55ed286a300bd1cf124de08d87e914f3a"),
+		Result: value.NewString("foo, baraabb"),
 	},
-}
-
-func TestSha1Hmac(t *testing.T) {
-	testFunction(t, Sha1Hmac, sha1HmacTests)
-}
-
-var sha256HmacTests = []functionTest{
 	{
-		Name: "Sha256Hmac",
+		Name: "Ltrim Spaces",
 		Function: parser.Function{
-			Name: "sha256_hmac",
+			Name: "ltrim",
 		},
 		Args: []value.Primary{
-			value.NewString("foo"),
-			value.NewString("bar"),
+			value.NewString("  foo, bar \n"),
 		},
-		Result: value.NewString("147933218aaabc0b8b10a2b3a5c34684c8d94341bcf10a4736dc7270f7741851"),
+		Result: value.NewString("foo, bar \n"),
 	},
 }
 
-func TestSha256Hmac(t *testing.T) {
-	testFunction(t, Sha256Hmac, sha256HmacTests)
+func TestLtrim(t *testing.T) {
+	testFunction(t, Ltrim, ltrimTests)
 }
 
-var sha512HmacTests = []functionTest{
+var rtrimTests = []functionTest{
 	{
-		Name: "Sha512Hmac",
+		Name: "Rtrim",
 		Function: parser.Function{
-			Name: "sha512_hmac",
+			Name: "rtrim",
 		},
 		Args: []value.Primary{
-			value.NewString("foo"),
-			value.NewString("bar"),
+			value.NewString("aabbfoo, baraabb"),
+			value.NewString("ab"),
 		},
-		Result: value.NewString("24257d7210582a65c731ec55159c8184cc24c02489453e58587f71f44c23a2d61b4b72154a89d17b2d49448a8452ea066f4fc56a2bcead45c088572ffccdb3d8"),
+		Result: value.NewString("aabbfoo, bar"),
 	},
-}
-
-func TestSha512Hmac(t *testing.T) {
-	testFunction(t, Sha512Hmac, sha512HmacTests)
-}
-
-var datetimeFormatTests = []functionTest{
 	{
-		Name: "DatetimeFormat",
+		Name: "Rtrim Spaces",
 		Function: parser.Function{
-			Name: "datetime_format",
+			Name: "rtrim",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
-			value.NewString("%Y-%m-%d"),
+			value.NewString("  foo, bar \n"),
 		},
-		Result: value.NewString("2012-02-03"),
+		Result: value.NewString("  foo, bar"),
 	},
+}
+
+func TestRtrim(t *testing.T) {
+	testFunction(t, Rtrim, rtrimTests)
+}
+
+var upperTests = []functionTest{
 	{
-		Name: "DatetimeFormat Datetime is Null",
+		Name: "Upper",
 		Function: parser.Function{
-			Name: "datetime_format",
+			Name: "upper",
 		},
 		Args: []value.Primary{
-			value.NewNull(),
-			value.NewString("%Y-%m-%d"),
+			value.NewString("Foo"),
 		},
-		Result: value.NewNull(),
+		Result: value.NewString("FOO"),
 	},
 	{
-		Name: "DatetimeFormat Format is Null",
+		Name: "Upper Null",
 		Function: parser.Function{
-			Name: "datetime_format",
+			Name: "upper",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
 			value.NewNull(),
 		},
 		Result: value.NewNull(),
 	},
 	{
-		Name: "DatetimeFormat Arguments Error",
+		Name: "Upper Arguments Error",
 		Function: parser.Function{
-			Name: "datetime_format",
-		},
-		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+			Name: "upper",
 		},
-		Error: "function datetime_format takes exactly 2 arguments",
+		Args:  []value.Primary{},
+		Error: "function upper takes exactly 1 argument",
 	},
 }
 
-func TestDatetimeFormat(t *testing.T) {
-	testFunction(t, DatetimeFormat, datetimeFormatTests)
+func TestUpper(t *testing.T) {
+	testFunction(t, Upper, upperTests)
 }
 
-var yearTests = []functionTest{
+var lowerTests = []functionTest{
 	{
-		Name: "Year",
+		Name: "Lower",
 		Function: parser.Function{
-			Name: "year",
+			Name: "lower",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+			value.NewString("Foo"),
 		},
-		Result: value.NewInteger(2012),
+		Result: value.NewString("foo"),
 	},
+}
+
+func TestLower(t *testing.T) {
+	testFunction(t, Lower, lowerTests)
+}
+
+var base64EncodeTests = []functionTest{
 	{
-		Name: "Year Datetime is Null",
+		Name: "Base64Encode",
 		Function: parser.Function{
-			Name: "year",
+			Name: "base64_encode",
 		},
 		Args: []value.Primary{
-			value.NewNull(),
-		},
-		Result: value.NewNull(),
-	},
-	{
-		Name: "Year Arguments Error",
-		Function: parser.Function{
-			Name: "year",
+			value.NewString("Foo"),
 		},
-		Args:  []value.Primary{},
-		Error: "function year takes exactly 1 argument",
+		Result: value.NewString("Rm9v"),
 	},
 }
 
-func TestYear(t *testing.T) {
-	testFunction(t, Year, yearTests)
+func TestBase64Encode(t *testing.T) {
+	testFunction(t, Base64Encode, base64EncodeTests)
 }
 
-var monthTests = []functionTest{
+var base64DecodeTests = []functionTest{
 	{
-		Name: "Month",
+		Name: "Base64Decode",
 		Function: parser.Function{
-			Name: "month",
+			Name: "base64_decode",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+			value.NewString("Rm9v"),
 		},
-		Result: value.NewInteger(2),
+		Result: value.NewString("Foo"),
 	},
 }
 
-func TestMonth(t *testing.T) {
-	testFunction(t, Month, monthTests)
+func TestBase64Decode(t *testing.T) {
+	testFunction(t, Base64Decode, base64DecodeTests)
 }
 
-var dayTests = []functionTest{
+var hexEncodeTests = []functionTest{
 	{
-		Name: "Day",
+		Name: "HexEncode",
 		Function: parser.Function{
-			Name: "day",
+			Name: "hex_encode",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+			value.NewString("Foo"),
 		},
-		Result: value.NewInteger(3),
+		Result: value.NewString("466f6f"),
 	},
 }
 
-func TestDay(t *testing.T) {
-	testFunction(t, Day, dayTests)
+func TestHexEncode(t *testing.T) {
+	testFunction(t, HexEncode, hexEncodeTests)
 }
 
-var hourTests = []functionTest{
+var hexDecodeTests = []functionTest{
 	{
-		Name: "Hour",
+		Name: "HexDecode",
 		Function: parser.Function{
-			Name: "hour",
+			Name: "hex_decode",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+			value.NewString("466f6f"),
 		},
-		Result: value.NewInteger(9),
+		Result: value.NewString("Foo"),
 	},
 }
 
-func TestHour(t *testing.T) {
-	testFunction(t, Hour, hourTests)
+func TestHexDecode(t *testing.T) {
+	testFunction(t, HexDecode, hexDecodeTests)
 }
 
-var minuteTests = []functionTest{
+var urlEncodeTests = []functionTest{
 	{
-		Name: "Minute",
+		Name: "UrlEncode",
 		Function: parser.Function{
-			Name: "minute",
+			Name: "url_encode",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+			value.NewString("a b&c=d/é"),
 		},
-		Result: value.NewInteger(18),
+		Result: value.NewString("a+b%26c%3Dd%2F%C3%A9"),
 	},
-}
-
-func TestMinute(t *testing.T) {
-	testFunction(t, Minute, minuteTests)
-}
-
-var secondTests = []functionTest{
 	{
-		Name: "Second",
+		Name: "UrlEncode Null",
 		Function: parser.Function{
-			Name: "second",
+			Name: "url_encode",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+			value.NewNull(),
 		},
-		Result: value.NewInteger(15),
+		Result: value.NewNull(),
 	},
 }
 
-func TestSecond(t *testing.T) {
-	testFunction(t, Second, secondTests)
+func TestUrlEncode(t *testing.T) {
+	testFunction(t, UrlEncode, urlEncodeTests)
 }
 
-var millisecondTests = []functionTest{
+var urlDecodeTests = []functionTest{
 	{
-		Name: "Millisecond",
+		Name: "UrlDecode",
 		Function: parser.Function{
-			Name: "millisecond",
+			Name: "url_decode",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+			value.NewString("a+b%26c%3Dd%2F%C3%A9"),
 		},
-		Result: value.NewInteger(123),
+		Result: value.NewString("a b&c=d/é"),
 	},
-}
-
-func TestMillisecond(t *testing.T) {
-	testFunction(t, Millisecond, millisecondTests)
-}
-
-var microsecondTests = []functionTest{
 	{
-		Name: "Microsecond",
+		Name: "UrlDecode Invalid Escape",
 		Function: parser.Function{
-			Name: "microsecond",
+			Name: "url_decode",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+			value.NewString("%zz"),
 		},
-		Result: value.NewInteger(123457),
+		Result: value.NewString(""),
 	},
 }
 
-func TestMicrosecond(t *testing.T) {
-	testFunction(t, Microsecond, microsecondTests)
+func TestUrlDecode(t *testing.T) {
+	testFunction(t, UrlDecode, urlDecodeTests)
 }
 
-var nanosecondTests = []functionTest{
+var htmlEscapeTests = []functionTest{
 	{
-		Name: "Nanosecond",
+		Name: "HtmlEscape",
 		Function: parser.Function{
-			Name: "nanosecond",
+			Name: "html_escape",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+			value.NewString("<a href=\"x\">Tom & Jerry's</a>"),
 		},
-		Result: value.NewInteger(123456789),
+		Result: value.NewString("&lt;a href=&#34;x&#34;&gt;Tom &amp; Jerry&#39;s&lt;/a&gt;"),
 	},
-}
-
-func TestNanosecond(t *testing.T) {
-	testFunction(t, Nanosecond, nanosecondTests)
-}
-
-var weekdayTests = []functionTest{
 	{
-		Name: "Weekday",
+		Name: "HtmlEscape Null",
 		Function: parser.Function{
-			Name: "weekday",
+			Name: "html_escape",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+			value.NewNull(),
 		},
-		Result: value.NewInteger(5),
+		Result: value.NewNull(),
 	},
 }
 
-func TestWeekday(t *testing.T) {
-	testFunction(t, Weekday, weekdayTests)
+func TestHtmlEscape(t *testing.T) {
+	testFunction(t, HtmlEscape, htmlEscapeTests)
 }
 
-var unixTimeTests = []functionTest{
+var htmlUnescapeTests = []functionTest{
 	{
-		Name: "UnixTime",
+		Name: "HtmlUnescape",
 		Function: parser.Function{
-			Name: "unix_time",
+			Name: "html_unescape",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+			value.NewString("&lt;b&gt;caf&eacute; &amp; &#39;bar&#39;&lt;/b&gt;"),
 		},
-		Result: value.NewInteger(1328260695),
+		Result: value.NewString("<b>café & 'bar'</b>"),
 	},
 }
 
-func TestUnixTime(t *testing.T) {
-	testFunction(t, UnixTime, unixTimeTests)
+func TestHtmlUnescape(t *testing.T) {
+	testFunction(t, HtmlUnescape, htmlUnescapeTests)
 }
 
-var unixNanoTimeTests = []functionTest{
+var reverseTests = []functionTest{
 	{
-		Name: "UnixNanoTime",
+		Name: "Reverse",
 		Function: parser.Function{
-			Name: "unix_nano_time",
+			Name: "reverse",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+			value.NewString("abc"),
 		},
-		Result: value.NewInteger(1328260695123456789),
+		Result: value.NewString("cba"),
 	},
-}
-
-func TestUnixNanoTime(t *testing.T) {
-	testFunction(t, UnixNanoTime, unixNanoTimeTests)
-}
-
-var dayOfYearTests = []functionTest{
 	{
-		Name: "DayOfYear",
+		Name: "Reverse Multibyte",
 		Function: parser.Function{
-			Name: "day_of_year",
+			Name: "reverse",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+			value.NewString("日本語abc"),
 		},
-		Result: value.NewInteger(34),
+		Result: value.NewString("cba語本日"),
 	},
-}
-
-func TestDayOfYear(t *testing.T) {
-	testFunction(t, DayOfYear, dayOfYearTests)
-}
-
-var weekOfYearTests = []functionTest{
 	{
-		Name: "WeekOfYear",
+		Name: "Reverse Null",
 		Function: parser.Function{
-			Name: "week_of_year",
+			Name: "reverse",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+			value.NewNull(),
 		},
-		Result: value.NewInteger(5),
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Reverse Arguments Error",
+		Function: parser.Function{
+			Name: "reverse",
+		},
+		Args:  []value.Primary{},
+		Error: "function reverse takes exactly 1 argument",
 	},
 }
 
-func TestWeekOfYear(t *testing.T) {
-	testFunction(t, WeekOfYear, weekOfYearTests)
+func TestReverse(t *testing.T) {
+	testFunction(t, Reverse, reverseTests)
 }
 
-var addYearTests = []functionTest{
+var repeatTests = []functionTest{
 	{
-		Name: "AddYear",
+		Name: "Repeat",
 		Function: parser.Function{
-			Name: "add_year",
+			Name: "repeat",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
-			value.NewInteger(2),
+			value.NewString("ab"),
+			value.NewInteger(3),
 		},
-		Result: value.NewDatetime(time.Date(2014, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+		Result: value.NewString("ababab"),
 	},
 	{
-		Name: "AddYear Datetime is Null",
+		Name: "Repeat Negative Count",
 		Function: parser.Function{
-			Name: "add_year",
+			Name: "repeat",
+		},
+		Args: []value.Primary{
+			value.NewString("ab"),
+			value.NewInteger(-1),
+		},
+		Result: value.NewString(""),
+	},
+	{
+		Name: "Repeat Null",
+		Function: parser.Function{
+			Name: "repeat",
 		},
 		Args: []value.Primary{
 			value.NewNull(),
-			value.NewInteger(2),
+			value.NewInteger(3),
 		},
 		Result: value.NewNull(),
 	},
 	{
-		Name: "AddYear Duration is Null",
+		Name: "Repeat Count Null",
 		Function: parser.Function{
-			Name: "add_year",
+			Name: "repeat",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+			value.NewString("ab"),
 			value.NewNull(),
 		},
 		Result: value.NewNull(),
 	},
 	{
-		Name: "AddYear Arguments Error",
+		Name: "Repeat Arguments Error",
 		Function: parser.Function{
-			Name: "add_year",
+			Name: "repeat",
 		},
-		Args:  []value.Primary{},
-		Error: "function add_year takes exactly 2 arguments",
+		Args: []value.Primary{
+			value.NewString("ab"),
+		},
+		Error: "function repeat takes exactly 2 arguments",
 	},
 }
 
-func TestAddYear(t *testing.T) {
-	testFunction(t, AddYear, addYearTests)
+func TestRepeat(t *testing.T) {
+	testFunction(t, Repeat, repeatTests)
 }
 
-var addMonthTests = []functionTest{
+var lenTests = []functionTest{
 	{
-		Name: "AddMonth",
+		Name: "Len",
 		Function: parser.Function{
-			Name: "add_month",
+			Name: "len",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
-			value.NewInteger(2),
+			value.NewString("日本語"),
 		},
-		Result: value.NewDatetime(time.Date(2012, 4, 3, 9, 18, 15, 123456789, GetTestLocation())),
+		Result: value.NewInteger(3),
 	},
-}
-
-func TestAddMonth(t *testing.T) {
-	testFunction(t, AddMonth, addMonthTests)
-}
-
-var addDayTests = []functionTest{
 	{
-		Name: "AddDay",
+		Name: "Len Null",
 		Function: parser.Function{
-			Name: "add_day",
+			Name: "len",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
-			value.NewInteger(2),
+			value.NewNull(),
 		},
-		Result: value.NewDatetime(time.Date(2012, 2, 5, 9, 18, 15, 123456789, GetTestLocation())),
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Len Arguments Error",
+		Function: parser.Function{
+			Name: "len",
+		},
+		Args:  []value.Primary{},
+		Error: "function len takes exactly 1 argument",
 	},
 }
 
-func TestAddDay(t *testing.T) {
-	testFunction(t, AddDay, addDayTests)
+func TestLen(t *testing.T) {
+	testFunction(t, Len, lenTests)
 }
 
-var addHourTests = []functionTest{
+var byteLenTests = []functionTest{
 	{
-		Name: "AddHour",
+		Name: "ByteLen",
 		Function: parser.Function{
-			Name: "add_hour",
+			Name: "byte_len",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
-			value.NewInteger(2),
+			value.NewString("abc日本語"),
 		},
-		Result: value.NewDatetime(time.Date(2012, 2, 3, 11, 18, 15, 123456789, GetTestLocation())),
+		Result: value.NewInteger(12),
 	},
-}
-
-func TestAddHour(t *testing.T) {
-	testFunction(t, AddHour, addHourTests)
-}
-
-var addMinuteTests = []functionTest{
 	{
-		Name: "AddMinute",
+		Name: "ByteLen SJIS",
 		Function: parser.Function{
-			Name: "add_minute",
+			Name: "byte_len",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
-			value.NewInteger(2),
+			value.NewString("abc日本語"),
+			value.NewString("sjis"),
 		},
-		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 20, 15, 123456789, GetTestLocation())),
+		Result: value.NewInteger(9),
 	},
-}
-
-func TestAddMinute(t *testing.T) {
-	testFunction(t, AddMinute, addMinuteTests)
-}
-
-var addSecondTests = []functionTest{
 	{
-		Name: "AddSecond",
+		Name: "ByteLen EUC-JP",
 		Function: parser.Function{
-			Name: "add_second",
+			Name: "byte_len",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
-			value.NewInteger(2),
+			value.NewString("abc日本語ｱ"),
+			value.NewString("eucjp"),
 		},
-		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 17, 123456789, GetTestLocation())),
+		Result: value.NewInteger(11),
 	},
-}
-
-func TestAddSecond(t *testing.T) {
-	testFunction(t, AddSecond, addSecondTests)
-}
-
-var addMilliTests = []functionTest{
 	{
-		Name: "AddMilli",
+		Name: "ByteLen Null",
 		Function: parser.Function{
-			Name: "add_milli",
+			Name: "byte_len",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
-			value.NewInteger(2),
+			value.NewNull(),
 		},
-		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 125456789, GetTestLocation())),
+		Result: value.NewNull(),
 	},
-}
-
-func TestAddMilli(t *testing.T) {
-	testFunction(t, AddMilli, addMilliTests)
-}
-
-var addMicroTests = []functionTest{
 	{
-		Name: "AddMicro",
+		Name: "ByteLen Arguments Error",
 		Function: parser.Function{
-			Name: "add_micro",
+			Name: "byte_len",
+		},
+		Args:  []value.Primary{},
+		Error: "function byte_len takes 1 or 2 arguments",
+	},
+	{
+		Name: "ByteLen Invalid Encoding Error",
+		Function: parser.Function{
+			Name: "byte_len",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
-			value.NewInteger(2),
+			value.NewString("abc日本語"),
+			value.NewString("invalid"),
 		},
-		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123458789, GetTestLocation())),
+		Error: "encoding must be one of UTF8|UTF16|SJIS|EUCJP for function byte_len",
 	},
 }
 
-func TestAddMicro(t *testing.T) {
-	testFunction(t, AddMicro, addMicroTests)
+func TestByteLen(t *testing.T) {
+	testFunction(t, ByteLen, byteLenTests)
 }
 
-var addNanoTests = []functionTest{
+var widthTests = []functionTest{
 	{
-		Name: "AddNano",
+		Name: "Width",
 		Function: parser.Function{
-			Name: "add_nano",
+			Name: "width",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
-			value.NewInteger(2),
+			value.NewString("abc日本語"),
 		},
-		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456791, GetTestLocation())),
+		Result: value.NewInteger(9),
 	},
-}
-
-func TestAddNano(t *testing.T) {
-	testFunction(t, AddNano, addNanoTests)
-}
-
-var truncMonthTests = []functionTest{
 	{
-		Name: "TruncMonth",
+		Name: "Width Arguments Is Null",
 		Function: parser.Function{
-			Name: "trunc_month",
+			Name: "width",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+			value.NewNull(),
 		},
-		Result: value.NewDatetime(time.Date(2012, 1, 1, 0, 0, 0, 0, GetTestLocation())),
+		Result: value.NewNull(),
 	},
 	{
-		Name: "TruncMonth Argument Error",
+		Name: "Width Arguments Error",
 		Function: parser.Function{
-			Name: "trunc_month",
+			Name: "width",
 		},
 		Args:  []value.Primary{},
-		Error: "function trunc_month takes exactly 1 argument",
+		Error: "function width takes exactly 1 argument",
 	},
+}
+
+func TestWidth(t *testing.T) {
+	testFunction(t, Width, widthTests)
+}
+
+var normalizeTests = []functionTest{
 	{
-		Name: "TruncMonth Argument Is Null",
+		Name: "Normalize NFC",
 		Function: parser.Function{
-			Name: "trunc_month",
+			Name: "normalize",
 		},
 		Args: []value.Primary{
-			value.NewNull(),
+			value.NewString("e\u0301"),
+			value.NewString("NFC"),
 		},
-		Result: value.NewNull(),
+		Result: value.NewString("\u00e9"),
 	},
-}
-
-func TestTruncMonth(t *testing.T) {
-	testFunction(t, TruncMonth, truncMonthTests)
-}
-
-var truncDayTests = []functionTest{
 	{
-		Name: "TruncDay",
+		Name: "Normalize Default Form",
 		Function: parser.Function{
-			Name: "trunc_day",
+			Name: "normalize",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+			value.NewString("e\u0301"),
 		},
-		Result: value.NewDatetime(time.Date(2012, 2, 1, 0, 0, 0, 0, GetTestLocation())),
+		Result: value.NewString("\u00e9"),
 	},
-}
-
-func TestTruncDay(t *testing.T) {
-	testFunction(t, TruncDay, truncDayTests)
-}
-
-var truncTimeTests = []functionTest{
 	{
-		Name: "TruncTime",
+		Name: "Normalize NFD",
 		Function: parser.Function{
-			Name: "trunc_time",
+			Name: "normalize",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+			value.NewString("\u00e9"),
+			value.NewString("nfd"),
 		},
-		Result: value.NewDatetime(time.Date(2012, 2, 3, 0, 0, 0, 0, GetTestLocation())),
+		Result: value.NewString("e\u0301"),
 	},
-}
-
-func TestTruncTime(t *testing.T) {
-	testFunction(t, TruncTime, truncTimeTests)
-}
-
-var truncMinuteTests = []functionTest{
 	{
-		Name: "TruncMinute",
+		Name: "Normalize NFKC",
 		Function: parser.Function{
-			Name: "trunc_minute",
+			Name: "normalize",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+			value.NewString("ＡＢＣ１２３ｶﾞ"),
+			value.NewString("NFKC"),
 		},
-		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 0, 0, 0, GetTestLocation())),
+		Result: value.NewString("ABC123ガ"),
 	},
 	{
-		Name: "TruncMinute Argument Error",
+		Name: "Normalize NFKD",
 		Function: parser.Function{
-			Name: "trunc_minute",
+			Name: "normalize",
 		},
-		Args:  []value.Primary{},
-		Error: "function trunc_minute takes exactly 1 argument",
+		Args: []value.Primary{
+			value.NewString("ｶﾞ"),
+			value.NewString("NFKD"),
+		},
+		Result: value.NewString("カ\u3099"),
 	},
 	{
-		Name: "TruncMinute Argument Is Null",
+		Name: "Normalize Null",
 		Function: parser.Function{
-			Name: "trunc_minute",
+			Name: "normalize",
 		},
 		Args: []value.Primary{
 			value.NewNull(),
+			value.NewString("NFC"),
 		},
 		Result: value.NewNull(),
 	},
-}
-
-func TestTruncMinute(t *testing.T) {
-	testFunction(t, TruncMinute, truncMinuteTests)
-}
-
-var truncSecondTests = []functionTest{
 	{
-		Name: "TruncSecond",
+		Name: "Normalize Invalid Form Error",
 		Function: parser.Function{
-			Name: "trunc_second",
+			Name: "normalize",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+			value.NewString("a"),
+			value.NewString("NFX"),
 		},
-		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 0, 0, GetTestLocation())),
+		Error: "the second argument must be one of NFC, NFD, NFKC or NFKD for function normalize",
 	},
-}
-
-func TestTruncSecond(t *testing.T) {
-	testFunction(t, TruncSecond, truncSecondTests)
-}
-
-var truncMilliTests = []functionTest{
 	{
-		Name: "TruncMilli",
+		Name: "Normalize Null Form Error",
 		Function: parser.Function{
-			Name: "trunc_milli",
+			Name: "normalize",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+			value.NewString("a"),
+			value.NewNull(),
 		},
-		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+		Error: "the second argument must be one of NFC, NFD, NFKC or NFKD for function normalize",
+	},
+	{
+		Name: "Normalize Arguments Error",
+		Function: parser.Function{
+			Name: "normalize",
+		},
+		Args:  []value.Primary{},
+		Error: "function normalize takes 1 or 2 arguments",
 	},
 }
 
-func TestTruncMilli(t *testing.T) {
-	testFunction(t, TruncMilli, truncMilliTests)
+func TestNormalize(t *testing.T) {
+	testFunction(t, Normalize, normalizeTests)
 }
 
-var truncMicroTests = []functionTest{
+var toHalfwidthTests = []functionTest{
 	{
-		Name: "TruncMicro",
+		Name: "ToHalfwidth",
 		Function: parser.Function{
-			Name: "trunc_micro",
+			Name: "to_halfwidth",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+			value.NewString("ＡＢＣ　１２３"),
 		},
-		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123000000, GetTestLocation())),
+		Result: value.NewString("ABC 123"),
 	},
-}
-
-func TestTruncateMicro(t *testing.T) {
-	testFunction(t, TruncMicro, truncMicroTests)
-}
-
-var truncNanoTests = []functionTest{
 	{
-		Name: "TruncNano",
+		Name: "ToHalfwidth Katakana",
 		Function: parser.Function{
-			Name: "trunc_nano",
+			Name: "to_halfwidth",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+			value.NewString("アイウ"),
 		},
-		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456000, GetTestLocation())),
+		Result: value.NewString("ｱｲｳ"),
 	},
-}
-
-func TestTruncateNano(t *testing.T) {
-	testFunction(t, TruncNano, truncNanoTests)
-}
-
-var dateDiffTests = []functionTest{
 	{
-		Name: "DateDiff",
+		Name: "ToHalfwidth Voiced Katakana",
 		Function: parser.Function{
-			Name: "date_diff",
+			Name: "to_halfwidth",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
-			value.NewDatetime(time.Date(2012, 2, 5, 1, 18, 55, 123456789, GetTestLocation())),
+			value.NewString("ガパヴ"),
 		},
-		Result: value.NewInteger(-2),
+		Result: value.NewString("ｶﾞﾊﾟｳﾞ"),
 	},
 	{
-		Name: "DateDiff Datetime1 is Null",
+		Name: "ToHalfwidth Hiragana",
 		Function: parser.Function{
-			Name: "date_diff",
+			Name: "to_halfwidth",
 		},
 		Args: []value.Primary{
-			value.NewNull(),
-			value.NewDatetime(time.Date(2012, 2, 5, 1, 18, 55, 123456789, GetTestLocation())),
+			value.NewString("がぱ"),
 		},
-		Result: value.NewNull(),
+		Result: value.NewString("がぱ"),
 	},
 	{
-		Name: "DateDiff Datetime2 is Null",
+		Name: "ToHalfwidth Kanji",
 		Function: parser.Function{
-			Name: "date_diff",
+			Name: "to_halfwidth",
+		},
+		Args: []value.Primary{
+			value.NewString("漢字"),
+		},
+		Result: value.NewString("漢字"),
+	},
+	{
+		Name: "ToHalfwidth Null",
+		Function: parser.Function{
+			Name: "to_halfwidth",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
 			value.NewNull(),
 		},
 		Result: value.NewNull(),
 	},
 	{
-		Name: "DateDiff Arguments Error",
+		Name: "ToHalfwidth Arguments Error",
 		Function: parser.Function{
-			Name: "date_diff",
+			Name: "to_halfwidth",
 		},
 		Args:  []value.Primary{},
-		Error: "function date_diff takes exactly 2 arguments",
+		Error: "function to_halfwidth takes exactly 1 argument",
 	},
 }
 
-func TestDateDiff(t *testing.T) {
-	testFunction(t, DateDiff, dateDiffTests)
+func TestToHalfwidth(t *testing.T) {
+	testFunction(t, ToHalfwidth, toHalfwidthTests)
 }
 
-var timeDiffTests = []functionTest{
+var toFullwidthTests = []functionTest{
 	{
-		Name: "TimeDiff",
+		Name: "ToFullwidth",
 		Function: parser.Function{
-			Name: "time_diff",
+			Name: "to_fullwidth",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
-			value.NewDatetime(time.Date(2012, 2, 3, 1, 18, 55, 123000000, GetTestLocation())),
+			value.NewString("ABC 123"),
 		},
-		Result: value.NewFloat(28760.000456789),
+		Result: value.NewString("ＡＢＣ　１２３"),
 	},
 	{
-		Name: "TimeDiff Datetime1 is Null",
+		Name: "ToFullwidth Katakana",
 		Function: parser.Function{
-			Name: "time_diff",
+			Name: "to_fullwidth",
 		},
 		Args: []value.Primary{
-			value.NewNull(),
-			value.NewDatetime(time.Date(2012, 2, 5, 1, 18, 55, 123456789, GetTestLocation())),
+			value.NewString("ｱｲｳ"),
 		},
-		Result: value.NewNull(),
+		Result: value.NewString("アイウ"),
 	},
 	{
-		Name: "TimeDiff Datetime2 is Null",
+		Name: "ToFullwidth Voiced Katakana",
 		Function: parser.Function{
-			Name: "time_diff",
+			Name: "to_fullwidth",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
-			value.NewNull(),
+			value.NewString("ｶﾞﾊﾟｳﾞ"),
 		},
-		Result: value.NewNull(),
+		Result: value.NewString("ガパヴ"),
 	},
 	{
-		Name: "TimeDiff Arguments Error",
+		Name: "ToFullwidth Sound Mark Only",
 		Function: parser.Function{
-			Name: "time_diff",
+			Name: "to_fullwidth",
 		},
-		Args:  []value.Primary{},
-		Error: "function time_diff takes exactly 2 arguments",
+		Args: []value.Primary{
+			value.NewString("ﾞｱﾟ"),
+		},
+		Result: value.NewString("\u3099ア\u309a"),
 	},
-}
-
-func TestTimeDiff(t *testing.T) {
-	testFunction(t, TimeDiff, timeDiffTests)
-}
-
-var timeNanoDiffTests = []functionTest{
 	{
-		Name: "TimeNanoDiff",
+		Name: "ToFullwidth Kanji",
 		Function: parser.Function{
-			Name: "time_nano_diff",
+			Name: "to_fullwidth",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
-			value.NewDatetime(time.Date(2012, 2, 3, 1, 18, 55, 123000000, GetTestLocation())),
+			value.NewString("漢字"),
 		},
-		Result: value.NewInteger(28760000456789),
+		Result: value.NewString("漢字"),
 	},
-}
-
-func TestTimeNanoDiff(t *testing.T) {
-	testFunction(t, TimeNanoDiff, timeNanoDiffTests)
-}
-
-var utcTests = []functionTest{
 	{
-		Name: "UTC",
+		Name: "ToFullwidth Null",
 		Function: parser.Function{
-			Name: "utc",
+			Name: "to_fullwidth",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+			value.NewNull(),
 		},
-		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, time.UTC)),
+		Result: value.NewNull(),
 	},
 	{
-		Name: "UTC Argument Error",
+		Name: "ToFullwidth Arguments Error",
 		Function: parser.Function{
-			Name: "utc",
+			Name: "to_fullwidth",
 		},
 		Args:  []value.Primary{},
-		Error: "function utc takes exactly 1 argument",
+		Error: "function to_fullwidth takes exactly 1 argument",
 	},
+}
+
+func TestToFullwidth(t *testing.T) {
+	testFunction(t, ToFullwidth, toFullwidthTests)
+}
+
+var levenshteinTests = []functionTest{
 	{
-		Name: "UTC Argument Is Null",
+		Name: "Levenshtein",
 		Function: parser.Function{
-			Name: "utc",
+			Name: "levenshtein",
 		},
 		Args: []value.Primary{
-			value.NewNull(),
+			value.NewString("kitten"),
+			value.NewString("sitting"),
 		},
-		Result: value.NewNull(),
+		Result: value.NewInteger(3),
 	},
-}
-
-func TestUTC(t *testing.T) {
-	testFunction(t, UTC, utcTests)
-}
-
-var nanoToDatetimeTests = []functionTest{
 	{
-		Name: "NanoToDatetime",
+		Name: "Levenshtein Multibyte",
 		Function: parser.Function{
-			Name: "nano_to_datetime",
+			Name: "levenshtein",
 		},
 		Args: []value.Primary{
-			value.NewInteger(1328260695000000001),
+			value.NewString("日本語"),
+			value.NewString("日本"),
 		},
-		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 1, GetTestLocation())),
+		Result: value.NewInteger(1),
 	},
 	{
-		Name: "NanoToDatetime Invalid Argument",
+		Name: "Levenshtein Empty String",
 		Function: parser.Function{
-			Name: "nano_to_datetime",
+			Name: "levenshtein",
+		},
+		Args: []value.Primary{
+			value.NewString(""),
+			value.NewString("abc"),
+		},
+		Result: value.NewInteger(3),
+	},
+	{
+		Name: "Levenshtein Null",
+		Function: parser.Function{
+			Name: "levenshtein",
 		},
 		Args: []value.Primary{
 			value.NewString("abc"),
+			value.NewNull(),
 		},
 		Result: value.NewNull(),
 	},
 	{
-		Name: "NanoToDatetime Arguments Error",
+		Name: "Levenshtein Arguments Error",
 		Function: parser.Function{
-			Name: "nano_to_datetime",
+			Name: "levenshtein",
 		},
-		Args:  []value.Primary{},
-		Error: "function nano_to_datetime takes exactly 1 argument",
+		Args: []value.Primary{
+			value.NewString("abc"),
+		},
+		Error: "function levenshtein takes exactly 2 arguments",
 	},
 }
 
-func TestNanoToDatetime(t *testing.T) {
-	testFunction(t, NanoToDatetime, nanoToDatetimeTests)
+func TestLevenshtein(t *testing.T) {
+	testFunction(t, Levenshtein, levenshteinTests)
 }
 
-var stringTests = []functionTest{
+var similarityTests = []functionTest{
 	{
-		Name: "String from Integer",
+		Name: "Similarity",
 		Function: parser.Function{
-			Name: "string",
+			Name: "similarity",
 		},
 		Args: []value.Primary{
-			value.NewInteger(2),
+			value.NewString("abcd"),
+			value.NewString("abed"),
 		},
-		Result: value.NewString("2"),
+		Result: value.NewFloat(0.75),
 	},
 	{
-		Name: "String from Boolean",
+		Name: "Similarity Empty Strings",
 		Function: parser.Function{
-			Name: "string",
+			Name: "similarity",
 		},
 		Args: []value.Primary{
-			value.NewBoolean(true),
+			value.NewString(""),
+			value.NewString(""),
 		},
-		Result: value.NewString("true"),
+		Result: value.NewFloat(1),
 	},
 	{
-		Name: "String from Ternary",
+		Name: "Similarity Null",
 		Function: parser.Function{
-			Name: "string",
+			Name: "similarity",
 		},
 		Args: []value.Primary{
-			value.NewTernary(ternary.TRUE),
+			value.NewNull(),
+			value.NewString("abc"),
 		},
-		Result: value.NewString("TRUE"),
+		Result: value.NewNull(),
 	},
 	{
-		Name: "String from Datetime",
+		Name: "Similarity Arguments Error",
 		Function: parser.Function{
-			Name: "string",
+			Name: "similarity",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
-		},
-		Result: value.NewString("2012-02-03T09:18:15Z"),
-	},
-	{
-		Name: "String Arguments Error",
-		Function: parser.Function{
-			Name: "string",
+			value.NewString("abc"),
 		},
-		Args:  []value.Primary{},
-		Error: "function string takes exactly 1 argument",
+		Error: "function similarity takes exactly 2 arguments",
 	},
 }
 
-func TestString(t *testing.T) {
-	testFunction(t, String, stringTests)
+func TestSimilarity(t *testing.T) {
+	testFunction(t, Similarity, similarityTests)
 }
 
-var integerTests = []functionTest{
+var soundexTests = []functionTest{
 	{
-		Name: "Integer from Integer",
+		Name: "Soundex",
 		Function: parser.Function{
-			Name: "integer",
+			Name: "soundex",
 		},
 		Args: []value.Primary{
-			value.NewInteger(2),
+			value.NewString("Robert"),
 		},
-		Result: value.NewInteger(2),
+		Result: value.NewString("R163"),
 	},
 	{
-		Name: "Integer from String",
+		Name: "Soundex Separated by H",
 		Function: parser.Function{
-			Name: "integer",
+			Name: "soundex",
 		},
 		Args: []value.Primary{
-			value.NewString("2"),
+			value.NewString("Ashcraft"),
 		},
-		Result: value.NewInteger(2),
+		Result: value.NewString("A261"),
 	},
 	{
-		Name: "Integer from E-Notation",
+		Name: "Soundex Separated by Vowel",
 		Function: parser.Function{
-			Name: "integer",
+			Name: "soundex",
 		},
 		Args: []value.Primary{
-			value.NewString("2e+02"),
+			value.NewString("Tymczak"),
 		},
-		Result: value.NewInteger(200),
+		Result: value.NewString("T522"),
 	},
 	{
-		Name: "Integer from Float",
+		Name: "Soundex Padding",
 		Function: parser.Function{
-			Name: "integer",
+			Name: "soundex",
 		},
 		Args: []value.Primary{
-			value.NewFloat(1.7),
+			value.NewString("lee"),
 		},
-		Result: value.NewInteger(2),
+		Result: value.NewString("L000"),
 	},
 	{
-		Name: "Float Null",
+		Name: "Soundex Non-ASCII",
 		Function: parser.Function{
-			Name: "float",
+			Name: "soundex",
 		},
 		Args: []value.Primary{
-			value.NewNull(),
+			value.NewString("日本語"),
 		},
-		Result: value.NewNull(),
+		Result: value.NewString(""),
 	},
 	{
-		Name: "Integer from Datetime",
+		Name: "Soundex Null",
 		Function: parser.Function{
-			Name: "integer",
+			Name: "soundex",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+			value.NewNull(),
 		},
-		Result: value.NewInteger(1328260695),
+		Result: value.NewNull(),
 	},
 	{
-		Name: "Integer Arguments Error",
+		Name: "Soundex Arguments Error",
 		Function: parser.Function{
-			Name: "integer",
+			Name: "soundex",
 		},
 		Args:  []value.Primary{},
-		Error: "function integer takes exactly 1 argument",
+		Error: "function soundex takes exactly 1 argument",
 	},
 }
 
-func TestInteger(t *testing.T) {
-	testFunction(t, Integer, integerTests)
+func TestSoundex(t *testing.T) {
+	testFunction(t, Soundex, soundexTests)
 }
 
-var floatTests = []functionTest{
+var lpadTests = []functionTest{
 	{
-		Name: "Float from String",
+		Name: "Lpad",
 		Function: parser.Function{
-			Name: "float",
+			Name: "lpad",
 		},
 		Args: []value.Primary{
-			value.NewString("2"),
+			value.NewString("aaaaa"),
+			value.NewInteger(10),
+			value.NewString("01"),
 		},
-		Result: value.NewFloat(2),
+		Result: value.NewString("01010aaaaa"),
 	},
 	{
-		Name: "Float from Datetime",
+		Name: "Lpad by Byte Length",
 		Function: parser.Function{
-			Name: "float",
+			Name: "lpad",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123450000, GetTestLocation())),
+			value.NewString("日本語"),
+			value.NewInteger(12),
+			value.NewString("空白"),
+			value.NewString("byte"),
+			value.NewString("sjis"),
 		},
-		Result: value.NewFloat(1328260695.12345),
+		Result: value.NewString("空白空日本語"),
 	},
 	{
-		Name: "Float Arguments Error",
+		Name: "Lpad by Byte Length Error",
 		Function: parser.Function{
-			Name: "float",
+			Name: "lpad",
 		},
-		Args:  []value.Primary{},
+		Args: []value.Primary{
+			value.NewString("日本語"),
+			value.NewInteger(11),
+			value.NewString("空白"),
+			value.NewString("byte"),
+			value.NewString("sjis"),
+		},
+		Error: "cannot split pad string in a byte array of a character for function lpad",
+	},
+	{
+		Name: "Lpad PadType Error",
+		Function: parser.Function{
+			Name: "lpad",
+		},
+		Args: []value.Primary{
+			value.NewString("日本語"),
+			value.NewInteger(11),
+			value.NewString("空白"),
+			value.NewString("invalid"),
+		},
+		Error: "padding type must be one of LEN|BYTE|WIDTH for function lpad",
+	},
+	{
+		Name: "Lpad Encoding Error",
+		Function: parser.Function{
+			Name: "lpad",
+		},
+		Args: []value.Primary{
+			value.NewString("日本語"),
+			value.NewInteger(11),
+			value.NewString("空白"),
+			value.NewString("byte"),
+			value.NewString("invalid"),
+		},
+		Error: "encoding must be one of UTF8|UTF16|SJIS|EUCJP for function lpad",
+	},
+	{
+		Name: "Lpad by Width",
+		Function: parser.Function{
+			Name: "lpad",
+		},
+		Args: []value.Primary{
+			value.NewString("日本語"),
+			value.NewInteger(12),
+			value.NewString("空白"),
+			value.NewString("width"),
+		},
+		Result: value.NewString("空白空日本語"),
+	},
+	{
+		Name: "Lpad by Width Length Error",
+		Function: parser.Function{
+			Name: "lpad",
+		},
+		Args: []value.Primary{
+			value.NewString("日本語"),
+			value.NewInteger(11),
+			value.NewString("空白"),
+			value.NewString("width"),
+		},
+		Error: "cannot split pad string in a byte array of a character for function lpad",
+	},
+	{
+		Name: "Lpad No Padding",
+		Function: parser.Function{
+			Name: "lpad",
+		},
+		Args: []value.Primary{
+			value.NewString("aaaaa"),
+			value.NewInteger(5),
+			value.NewString("01"),
+		},
+		Result: value.NewString("aaaaa"),
+	},
+	{
+		Name: "Lpad String is Null",
+		Function: parser.Function{
+			Name: "lpad",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewInteger(10),
+			value.NewString("01"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Lpad Length is Null",
+		Function: parser.Function{
+			Name: "lpad",
+		},
+		Args: []value.Primary{
+			value.NewString("aaaaa"),
+			value.NewNull(),
+			value.NewString("01"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Lpad Pad String is Null",
+		Function: parser.Function{
+			Name: "lpad",
+		},
+		Args: []value.Primary{
+			value.NewString("aaaaa"),
+			value.NewInteger(10),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Lpad Arguments Error",
+		Function: parser.Function{
+			Name: "lpad",
+		},
+		Args:  []value.Primary{},
+		Error: "function lpad takes 3 to 5 arguments",
+	},
+}
+
+func TestLpad(t *testing.T) {
+	testFunction(t, Lpad, lpadTests)
+}
+
+var rpadTests = []functionTest{
+	{
+		Name: "Rpad",
+		Function: parser.Function{
+			Name: "rpad",
+		},
+		Args: []value.Primary{
+			value.NewString("aaaaa"),
+			value.NewInteger(10),
+			value.NewString("01"),
+		},
+		Result: value.NewString("aaaaa01010"),
+	},
+}
+
+func TestRpad(t *testing.T) {
+	testFunction(t, Rpad, rpadTests)
+}
+
+var substringTests = []functionTest{
+	{
+		Name: "Substring with a positive argument",
+		Function: parser.Function{
+			Name: "substring",
+		},
+		Args: []value.Primary{
+			value.NewString("abcdefghijklmn"),
+			value.NewInteger(5),
+		},
+		Result: value.NewString("efghijklmn"),
+	},
+	{
+		Name: "Substring with a negative argument",
+		Function: parser.Function{
+			Name: "substring",
+		},
+		Args: []value.Primary{
+			value.NewString("abcdefghijklmn"),
+			value.NewInteger(-5),
+		},
+		Result: value.NewString("jklmn"),
+	},
+	{
+		Name: "Substring with two positive argument",
+		Function: parser.Function{
+			Name: "substring",
+		},
+		Args: []value.Primary{
+			value.NewString("abcdefghijklmn"),
+			value.NewInteger(5),
+			value.NewInteger(3),
+		},
+		Result: value.NewString("efg"),
+	},
+	{
+		Name: "Substring starting with zero",
+		Function: parser.Function{
+			Name: "substring",
+		},
+		Args: []value.Primary{
+			value.NewString("abcdefghijklmn"),
+			value.NewInteger(0),
+			value.NewInteger(3),
+		},
+		Result: value.NewString("abc"),
+	},
+	{
+		Name: "Substring",
+		Function: parser.Function{
+			Name: "substring",
+		},
+		Args: []value.Primary{
+			value.NewString("abcdefghijklmn"),
+			value.NewInteger(-5),
+			value.NewInteger(8),
+		},
+		Result: value.NewString("jklmn"),
+	},
+	{
+		Name: "Substring String is Null",
+		Function: parser.Function{
+			Name: "substring",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewInteger(-5),
+			value.NewInteger(8),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Substring StartIndex is Null",
+		Function: parser.Function{
+			Name: "substring",
+		},
+		Args: []value.Primary{
+			value.NewString("abcdefghijklmn"),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Substring Length is Null",
+		Function: parser.Function{
+			Name: "substring",
+		},
+		Args: []value.Primary{
+			value.NewString("abcdefghijklmn"),
+			value.NewInteger(-5),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Substring Length is Negative",
+		Function: parser.Function{
+			Name: "substring",
+		},
+		Args: []value.Primary{
+			value.NewString("abcdefghijklmn"),
+			value.NewInteger(-5),
+			value.NewInteger(-1),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Substring StartIndex is Out Of Index",
+		Function: parser.Function{
+			Name: "substring",
+		},
+		Args: []value.Primary{
+			value.NewString("abcdefghijklmn"),
+			value.NewInteger(100),
+			value.NewInteger(8),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Substring Arguments Error",
+		Function: parser.Function{
+			Name: "substring",
+		},
+		Args:  []value.Primary{},
+		Error: "function substring takes 2 or 3 arguments",
+	},
+}
+
+func TestSubstring(t *testing.T) {
+	testFunction(t, Substring, substringTests)
+}
+
+var substrTests = []functionTest{
+	{
+		Name: "Substr with a positive argument",
+		Function: parser.Function{
+			Name: "substr",
+		},
+		Args: []value.Primary{
+			value.NewString("abcdefghijklmn"),
+			value.NewInteger(5),
+		},
+		Result: value.NewString("fghijklmn"),
+	},
+	{
+		Name: "Substr with a negative argument",
+		Function: parser.Function{
+			Name: "substr",
+		},
+		Args: []value.Primary{
+			value.NewString("abcdefghijklmn"),
+			value.NewInteger(-5),
+		},
+		Result: value.NewString("jklmn"),
+	},
+	{
+		Name: "Substr with two positive argument",
+		Function: parser.Function{
+			Name: "substr",
+		},
+		Args: []value.Primary{
+			value.NewString("abcdefghijklmn"),
+			value.NewInteger(5),
+			value.NewInteger(3),
+		},
+		Result: value.NewString("fgh"),
+	},
+}
+
+func TestSubstr(t *testing.T) {
+	testFunction(t, Substr, substrTests)
+}
+
+var instrTests = []functionTest{
+	{
+		Name: "Instr",
+		Function: parser.Function{
+			Name: "instr",
+		},
+		Args: []value.Primary{
+			value.NewString("abcdefghijklmn"),
+			value.NewString("def"),
+		},
+		Result: value.NewInteger(4),
+	},
+	{
+		Name: "Instr Multibyte",
+		Function: parser.Function{
+			Name: "instr",
+		},
+		Args: []value.Primary{
+			value.NewString("日本語の文字列の検索"),
+			value.NewString("の"),
+		},
+		Result: value.NewInteger(4),
+	},
+	{
+		Name: "Instr With Start",
+		Function: parser.Function{
+			Name: "instr",
+		},
+		Args: []value.Primary{
+			value.NewString("CORPORATE FLOOR"),
+			value.NewString("OR"),
+			value.NewInteger(3),
+		},
+		Result: value.NewInteger(5),
+	},
+	{
+		Name: "Instr With Occurrence",
+		Function: parser.Function{
+			Name: "instr",
+		},
+		Args: []value.Primary{
+			value.NewString("CORPORATE FLOOR"),
+			value.NewString("OR"),
+			value.NewInteger(3),
+			value.NewInteger(2),
+		},
+		Result: value.NewInteger(14),
+	},
+	{
+		Name: "Instr With Negative Start",
+		Function: parser.Function{
+			Name: "instr",
+		},
+		Args: []value.Primary{
+			value.NewString("CORPORATE FLOOR"),
+			value.NewString("OR"),
+			value.NewInteger(-3),
+			value.NewInteger(2),
+		},
+		Result: value.NewInteger(2),
+	},
+	{
+		Name: "Instr Start is Zero",
+		Function: parser.Function{
+			Name: "instr",
+		},
+		Args: []value.Primary{
+			value.NewString("CORPORATE FLOOR"),
+			value.NewString("OR"),
+			value.NewInteger(0),
+		},
+		Result: value.NewInteger(0),
+	},
+	{
+		Name: "Instr String is Null",
+		Function: parser.Function{
+			Name: "instr",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString("def"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Instr Substring is Null",
+		Function: parser.Function{
+			Name: "instr",
+		},
+		Args: []value.Primary{
+			value.NewString("abcdefghijklmn"),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Instr Start is Null",
+		Function: parser.Function{
+			Name: "instr",
+		},
+		Args: []value.Primary{
+			value.NewString("abcdefghijklmn"),
+			value.NewString("def"),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Instr Substring does not exist",
+		Function: parser.Function{
+			Name: "instr",
+		},
+		Args: []value.Primary{
+			value.NewString("abcdefghijklmn"),
+			value.NewString("zzz"),
+		},
+		Result: value.NewInteger(0),
+	},
+	{
+		Name: "Instr Occurrence does not exist",
+		Function: parser.Function{
+			Name: "instr",
+		},
+		Args: []value.Primary{
+			value.NewString("CORPORATE FLOOR"),
+			value.NewString("OR"),
+			value.NewInteger(1),
+			value.NewInteger(4),
+		},
+		Result: value.NewInteger(0),
+	},
+	{
+		Name: "Instr Arguments Error",
+		Function: parser.Function{
+			Name: "instr",
+		},
+		Args:  []value.Primary{},
+		Error: "function instr takes 2 to 4 arguments",
+	},
+	{
+		Name: "Instr Invalid Occurrence Error",
+		Function: parser.Function{
+			Name: "instr",
+		},
+		Args: []value.Primary{
+			value.NewString("CORPORATE FLOOR"),
+			value.NewString("OR"),
+			value.NewInteger(1),
+			value.NewInteger(0),
+		},
+		Error: "the fourth argument must be an integer greater than 0 for function instr",
+	},
+}
+
+func TestInstr(t *testing.T) {
+	testFunction(t, Instr, instrTests)
+}
+
+var listElemTests = []functionTest{
+	{
+		Name: "ListElem",
+		Function: parser.Function{
+			Name: "list_elem",
+		},
+		Args: []value.Primary{
+			value.NewString("abc def ghi"),
+			value.NewString(" "),
+			value.NewInteger(1),
+		},
+		Result: value.NewString("def"),
+	},
+	{
+		Name: "ListElem String is Null",
+		Function: parser.Function{
+			Name: "list_elem",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString(" "),
+			value.NewInteger(1),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "ListElem Separator is Null",
+		Function: parser.Function{
+			Name: "list_elem",
+		},
+		Args: []value.Primary{
+			value.NewString("abc def ghi"),
+			value.NewNull(),
+			value.NewInteger(1),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "ListElem Index is Null",
+		Function: parser.Function{
+			Name: "list_elem",
+		},
+		Args: []value.Primary{
+			value.NewString("abc def ghi"),
+			value.NewString(" "),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "ListElem Index is negative value",
+		Function: parser.Function{
+			Name: "list_elem",
+		},
+		Args: []value.Primary{
+			value.NewString("abc def ghi"),
+			value.NewString(" "),
+			value.NewInteger(-1),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "ListElem Index does not exist",
+		Function: parser.Function{
+			Name: "list_elem",
+		},
+		Args: []value.Primary{
+			value.NewString("abc def ghi"),
+			value.NewString(" "),
+			value.NewInteger(100),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "ListElem Arguments Error",
+		Function: parser.Function{
+			Name: "list_elem",
+		},
+		Args:  []value.Primary{},
+		Error: "function list_elem takes exactly 3 arguments",
+	},
+}
+
+func TestListElem(t *testing.T) {
+	testFunction(t, ListElem, listElemTests)
+}
+
+var replaceFnTests = []functionTest{
+	{
+		Name: "Replace",
+		Function: parser.Function{
+			Name: "replace",
+		},
+		Args: []value.Primary{
+			value.NewString("abcdefg abcdefg"),
+			value.NewString("cd"),
+			value.NewString("CD"),
+		},
+		Result: value.NewString("abCDefg abCDefg"),
+	},
+	{
+		Name: "Replace String is Null",
+		Function: parser.Function{
+			Name: "replace",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString("cd"),
+			value.NewString("CD"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Replace Old String is Null",
+		Function: parser.Function{
+			Name: "replace",
+		},
+		Args: []value.Primary{
+			value.NewString("abcdefg abcdefg"),
+			value.NewNull(),
+			value.NewString("CD"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Replace New String is Null",
+		Function: parser.Function{
+			Name: "replace",
+		},
+		Args: []value.Primary{
+			value.NewString("abcdefg abcdefg"),
+			value.NewString("cd"),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Replace Arguments Error",
+		Function: parser.Function{
+			Name: "replace",
+		},
+		Args:  []value.Primary{},
+		Error: "function replace takes exactly 3 arguments",
+	},
+}
+
+func TestReplaceFn(t *testing.T) {
+	testFunction(t, ReplaceFn, replaceFnTests)
+}
+
+var globFnTests = []functionTest{
+	{
+		Name: "Glob",
+		Function: parser.Function{
+			Name: "glob",
+		},
+		Args: []value.Primary{
+			value.NewString("report_2020.csv"),
+			value.NewString("report_*.csv"),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "Glob Case Insensitive",
+		Function: parser.Function{
+			Name: "glob",
+		},
+		Args: []value.Primary{
+			value.NewString("REPORT_2020.CSV"),
+			value.NewString("report_*.csv"),
+			value.NewBoolean(true),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "Glob Null",
+		Function: parser.Function{
+			Name: "glob",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString("*"),
+		},
+		Result: value.NewTernary(ternary.UNKNOWN),
+	},
+	{
+		Name: "Glob Arguments Error",
+		Function: parser.Function{
+			Name: "glob",
+		},
+		Args: []value.Primary{
+			value.NewString("str"),
+		},
+		Error: "function glob takes 2 or 3 arguments",
+	},
+	{
+		Name: "Glob Third Argument Error",
+		Function: parser.Function{
+			Name: "glob",
+		},
+		Args: []value.Primary{
+			value.NewString("str"),
+			value.NewString("*"),
+			value.NewNull(),
+		},
+		Error: "the third argument must be a boolean for function glob",
+	},
+	{
+		Name: "Glob Pattern Error",
+		Function: parser.Function{
+			Name: "glob",
+		},
+		Args: []value.Primary{
+			value.NewString("str"),
+			value.NewString("[a-"),
+		},
+		Error: "unterminated character class in the pattern for function glob",
+	},
+}
+
+func TestGlobFn(t *testing.T) {
+	testFunction(t, GlobFn, globFnTests)
+}
+
+var concatWsTests = []functionTest{
+	{
+		Name: "ConcatWs",
+		Function: parser.Function{
+			Name: "concat_ws",
+		},
+		Args: []value.Primary{
+			value.NewString("-"),
+			value.NewString("a"),
+			value.NewInteger(1),
+			value.NewString(""),
+			value.NewFloat(1.5),
+		},
+		Result: value.NewString("a-1--1.5"),
+	},
+	{
+		Name: "ConcatWs Skip Nulls",
+		Function: parser.Function{
+			Name: "concat_ws",
+		},
+		Args: []value.Primary{
+			value.NewString(","),
+			value.NewNull(),
+			value.NewString("a"),
+			value.NewNull(),
+			value.NewString("b"),
+		},
+		Result: value.NewString("a,b"),
+	},
+	{
+		Name: "ConcatWs All Values are Null",
+		Function: parser.Function{
+			Name: "concat_ws",
+		},
+		Args: []value.Primary{
+			value.NewString(","),
+			value.NewNull(),
+		},
+		Result: value.NewString(""),
+	},
+	{
+		Name: "ConcatWs Separator is Null",
+		Function: parser.Function{
+			Name: "concat_ws",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString("a"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "ConcatWs Arguments Error",
+		Function: parser.Function{
+			Name: "concat_ws",
+		},
+		Args: []value.Primary{
+			value.NewString(","),
+		},
+		Error: "function concat_ws takes at least 2 arguments",
+	},
+}
+
+func TestConcatWs(t *testing.T) {
+	testFunction(t, ConcatWs, concatWsTests)
+}
+
+var formatTests = []functionTest{
+	{
+		Name: "Format",
+		Function: parser.Function{
+			Name: "format",
+		},
+		Args: []value.Primary{
+			value.NewString("string = %q, integer = %q"),
+			value.NewString("str"),
+			value.NewInteger(1),
+		},
+		Result: value.NewString("string = 'str', integer = '1'"),
+	},
+	{
+		Name: "Format Argument Length Error",
+		Function: parser.Function{
+			Name: "format",
+		},
+		Args:  []value.Primary{},
+		Error: "function format takes at least 1 argument",
+	},
+	{
+		Name: "Format Argument Value Error",
+		Function: parser.Function{
+			Name: "format",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString("str"),
+			value.NewInteger(1),
+		},
+		Error: "the first argument must be a string for function format",
+	},
+	{
+		Name: "Format Replace Holder Length Not Match Error",
+		Function: parser.Function{
+			Name: "format",
+		},
+		Args: []value.Primary{
+			value.NewString("string = %s, integer = %s"),
+			value.NewString("str"),
+		},
+		Error: "number of replace values does not match for function format",
+	},
+}
+
+func TestFormat(t *testing.T) {
+	testFunction(t, Format, formatTests)
+}
+
+var jsonValueTests = []functionTest{
+	{
+		Name: "JsonValue",
+		Function: parser.Function{
+			Name: "json_value",
+		},
+		Args: []value.Primary{
+			value.NewString("key1.key2"),
+			value.NewString("{\"key1\":{\"key2\":\"value\"}}"),
+		},
+		Result: value.NewString("value"),
+	},
+	{
+		Name: "JsonValue Query is Null",
+		Function: parser.Function{
+			Name: "json_value",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString("{\"key1\":{\"key2\":\"value\"}}"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "JsonValue Json-Text is Null",
+		Function: parser.Function{
+			Name: "json_value",
+		},
+		Args: []value.Primary{
+			value.NewString("key1.key2"),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "JsonValue Arguments Error",
+		Function: parser.Function{
+			Name: "json_value",
+		},
+		Args: []value.Primary{
+			value.NewString("key1.key2"),
+		},
+		Error: "function json_value takes exactly 2 arguments",
+	},
+	{
+		Name: "JsonValue Json Loading Error",
+		Function: parser.Function{
+			Name: "json_value",
+		},
+		Args: []value.Primary{
+			value.NewString("key1.key2"),
+			value.NewString("{key1:{\"key2\":\"value\"}}"),
+		},
+		Error: "line 1, column 2: unexpected token \"key\" for function json_value",
+	},
+}
+
+func TestJsonValue(t *testing.T) {
+	testFunction(t, JsonValue, jsonValueTests)
+}
+
+var md5Tests = []functionTest{
+	{
+		Name: "Md5",
+		Function: parser.Function{
+			Name: "md5",
+		},
+		Args: []value.Primary{
+			value.NewString("foo"),
+		},
+		Result: value.NewString("acbd18db4cc2f85cedef654fccc4a4d8"),
+	},
+	{
+		Name: "Md5 Null",
+		Function: parser.Function{
+			Name: "md5",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Md5 Arguments Error",
+		Function: parser.Function{
+			Name: "md5",
+		},
+		Args:  []value.Primary{},
+		Error: "function md5 takes exactly 1 argument",
+	},
+}
+
+func TestMd5(t *testing.T) {
+	testFunction(t, Md5, md5Tests)
+}
+
+var sha1Tests = []functionTest{
+	{
+		Name: "Sha1",
+		Function: parser.Function{
+			Name: "sha1",
+		},
+		Args: []value.Primary{
+			value.NewString("foo"),
+		},
+		Result: value.NewString("0beec7b5ea3f0fdbc95d0dd47f3c5bc275da8a33"),
+	},
+}
+
+func TestSha1(t *testing.T) {
+	testFunction(t, Sha1, sha1Tests)
+}
+
+var sha256Tests = []functionTest{
+	{
+		Name: "Sha256",
+		Function: parser.Function{
+			Name: "sha256",
+		},
+		Args: []value.Primary{
+			value.NewString("foo"),
+		},
+		Result: value.NewString("2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"),
+	},
+}
+
+func TestSha256(t *testing.T) {
+	testFunction(t, Sha256, sha256Tests)
+}
+
+var sha512Tests = []functionTest{
+	{
+		Name: "Sha512",
+		Function: parser.Function{
+			Name: "sha512",
+		},
+		Args: []value.Primary{
+			value.NewString("foo"),
+		},
+		Result: value.NewString("f7fbba6e0636f890e56fbbf3283e524c6fa3204ae298382d624741d0dc6638326e282c41be5e4254d8820772c5518a2c5a8c0c7f7eda19594a7eb539453e1ed7"),
+	},
+}
+
+func TestSha512(t *testing.T) {
+	testFunction(t, Sha512, sha512Tests)
+}
+
+var md5HmacTests = []functionTest{
+	{
+		Name: "Md5Hmac",
+		Function: parser.Function{
+			Name: "md5_hmac",
+		},
+		Args: []value.Primary{
+			value.NewString("foo"),
+			value.NewString("bar"),
+		},
+		Result: value.NewString("31b6db9e5eb4addb42f1a6ca07367adc"),
+	},
+	{
+		Name: "Md5Hmac String is Null",
+		Function: parser.Function{
+			Name: "md5_hmac",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString("bar"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Md5Hmac Key is Null",
+		Function: parser.Function{
+			Name: "md5_hmac",
+		},
+		Args: []value.Primary{
+			value.NewString("foo"),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Md5Hmac Arguments Error",
+		Function: parser.Function{
+			Name: "md5_hmac",
+		},
+		Args:  []value.Primary{},
+		Error: "function md5_hmac takes exactly 2 arguments",
+	},
+}
+
+func TestMd5Hmac(t *testing.T) {
+	testFunction(t, Md5Hmac, md5HmacTests)
+}
+
+var sha1HmacTests = []functionTest{
+	{
+		Name: "Sha1Hmac",
+		Function: parser.Function{
+			Name: "sha1_hmac",
+		},
+		Args: []value.Primary{
+			value.NewString("foo"),
+			value.NewString("bar"),
+		},
+		Result: value.NewString("85d155c55ed286a300bd1cf124de08d87e914f3a"),
+	},
+}
+
+func TestSha1Hmac(t *testing.T) {
+	testFunction(t, Sha1Hmac, sha1HmacTests)
+}
+
+var sha256HmacTests = []functionTest{
+	{
+		Name: "Sha256Hmac",
+		Function: parser.Function{
+			Name: "sha256_hmac",
+		},
+		Args: []value.Primary{
+			value.NewString("foo"),
+			value.NewString("bar"),
+		},
+		Result: value.NewString("147933218aaabc0b8b10a2b3a5c34684c8d94341bcf10a4736dc7270f7741851"),
+	},
+}
+
+func TestSha256Hmac(t *testing.T) {
+	testFunction(t, Sha256Hmac, sha256HmacTests)
+}
+
+var sha512HmacTests = []functionTest{
+	{
+		Name: "Sha512Hmac",
+		Function: parser.Function{
+			Name: "sha512_hmac",
+		},
+		Args: []value.Primary{
+			value.NewString("foo"),
+			value.NewString("bar"),
+		},
+		Result: value.NewString("24257d7210582a65c731ec55159c8184cc24c02489453e58587f71f44c23a2d61b4b72154a89d17b2d49448a8452ea066f4fc56a2bcead45c088572ffccdb3d8"),
+	},
+}
+
+func TestSha512Hmac(t *testing.T) {
+	testFunction(t, Sha512Hmac, sha512HmacTests)
+}
+
+var inetAtonTests = []functionTest{
+	{
+		Name: "InetAton",
+		Function: parser.Function{
+			Name: "inet_aton",
+		},
+		Args: []value.Primary{
+			value.NewString("10.0.0.1"),
+		},
+		Result: value.NewInteger(167772161),
+	},
+	{
+		Name: "InetAton Zero Address",
+		Function: parser.Function{
+			Name: "inet_aton",
+		},
+		Args: []value.Primary{
+			value.NewString("0.0.0.0"),
+		},
+		Result: value.NewInteger(0),
+	},
+	{
+		Name: "InetAton Broadcast Address",
+		Function: parser.Function{
+			Name: "inet_aton",
+		},
+		Args: []value.Primary{
+			value.NewString("255.255.255.255"),
+		},
+		Result: value.NewInteger(4294967295),
+	},
+	{
+		Name: "InetAton IPv4-Mapped IPv6 Address",
+		Function: parser.Function{
+			Name: "inet_aton",
+		},
+		Args: []value.Primary{
+			value.NewString("::ffff:192.168.0.1"),
+		},
+		Result: value.NewInteger(3232235521),
+	},
+	{
+		Name: "InetAton IPv6 Address",
+		Function: parser.Function{
+			Name: "inet_aton",
+		},
+		Args: []value.Primary{
+			value.NewString("2001:db8::1"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "InetAton Malformed Address",
+		Function: parser.Function{
+			Name: "inet_aton",
+		},
+		Args: []value.Primary{
+			value.NewString("256.0.0.1"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "InetAton Leading Zeros",
+		Function: parser.Function{
+			Name: "inet_aton",
+		},
+		Args: []value.Primary{
+			value.NewString("010.0.0.1"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "InetAton Null",
+		Function: parser.Function{
+			Name: "inet_aton",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "InetAton Arguments Error",
+		Function: parser.Function{
+			Name: "inet_aton",
+		},
+		Args:  []value.Primary{},
+		Error: "function inet_aton takes exactly 1 argument",
+	},
+}
+
+func TestInetAton(t *testing.T) {
+	testFunction(t, InetAton, inetAtonTests)
+}
+
+var inetNtoaTests = []functionTest{
+	{
+		Name: "InetNtoa",
+		Function: parser.Function{
+			Name: "inet_ntoa",
+		},
+		Args: []value.Primary{
+			value.NewInteger(167772161),
+		},
+		Result: value.NewString("10.0.0.1"),
+	},
+	{
+		Name: "InetNtoa Zero",
+		Function: parser.Function{
+			Name: "inet_ntoa",
+		},
+		Args: []value.Primary{
+			value.NewInteger(0),
+		},
+		Result: value.NewString("0.0.0.0"),
+	},
+	{
+		Name: "InetNtoa Broadcast Address",
+		Function: parser.Function{
+			Name: "inet_ntoa",
+		},
+		Args: []value.Primary{
+			value.NewInteger(4294967295),
+		},
+		Result: value.NewString("255.255.255.255"),
+	},
+	{
+		Name: "InetNtoa String",
+		Function: parser.Function{
+			Name: "inet_ntoa",
+		},
+		Args: []value.Primary{
+			value.NewString("3232235521"),
+		},
+		Result: value.NewString("192.168.0.1"),
+	},
+	{
+		Name: "InetNtoa Negative Number",
+		Function: parser.Function{
+			Name: "inet_ntoa",
+		},
+		Args: []value.Primary{
+			value.NewInteger(-1),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "InetNtoa Out of Range",
+		Function: parser.Function{
+			Name: "inet_ntoa",
+		},
+		Args: []value.Primary{
+			value.NewInteger(4294967296),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "InetNtoa Not a Number",
+		Function: parser.Function{
+			Name: "inet_ntoa",
+		},
+		Args: []value.Primary{
+			value.NewString("abc"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "InetNtoa Null",
+		Function: parser.Function{
+			Name: "inet_ntoa",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "InetNtoa Arguments Error",
+		Function: parser.Function{
+			Name: "inet_ntoa",
+		},
+		Args:  []value.Primary{},
+		Error: "function inet_ntoa takes exactly 1 argument",
+	},
+}
+
+func TestInetNtoa(t *testing.T) {
+	testFunction(t, InetNtoa, inetNtoaTests)
+}
+
+var ipInCidrTests = []functionTest{
+	{
+		Name: "IpInCidr",
+		Function: parser.Function{
+			Name: "ip_in_cidr",
+		},
+		Args: []value.Primary{
+			value.NewString("10.1.2.3"),
+			value.NewString("10.0.0.0/8"),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "IpInCidr Not Contained",
+		Function: parser.Function{
+			Name: "ip_in_cidr",
+		},
+		Args: []value.Primary{
+			value.NewString("11.0.0.1"),
+			value.NewString("10.0.0.0/8"),
+		},
+		Result: value.NewTernary(ternary.FALSE),
+	},
+	{
+		Name: "IpInCidr Broadcast Address",
+		Function: parser.Function{
+			Name: "ip_in_cidr",
+		},
+		Args: []value.Primary{
+			value.NewString("192.168.1.255"),
+			value.NewString("192.168.1.0/24"),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "IpInCidr All IPv4 Addresses",
+		Function: parser.Function{
+			Name: "ip_in_cidr",
+		},
+		Args: []value.Primary{
+			value.NewString("255.255.255.255"),
+			value.NewString("0.0.0.0/0"),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "IpInCidr IPv4-Mapped IPv6 Address",
+		Function: parser.Function{
+			Name: "ip_in_cidr",
+		},
+		Args: []value.Primary{
+			value.NewString("::ffff:10.0.0.1"),
+			value.NewString("10.0.0.0/8"),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "IpInCidr IPv4-Mapped IPv6 Range",
+		Function: parser.Function{
+			Name: "ip_in_cidr",
+		},
+		Args: []value.Primary{
+			value.NewString("10.0.0.1"),
+			value.NewString("::ffff:10.0.0.0/104"),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "IpInCidr IPv6",
+		Function: parser.Function{
+			Name: "ip_in_cidr",
+		},
+		Args: []value.Primary{
+			value.NewString("2001:DB8::1"),
+			value.NewString("2001:db8::/32"),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "IpInCidr IPv6 Not Contained",
+		Function: parser.Function{
+			Name: "ip_in_cidr",
+		},
+		Args: []value.Primary{
+			value.NewString("2001:db9::1"),
+			value.NewString("2001:db8::/32"),
+		},
+		Result: value.NewTernary(ternary.FALSE),
+	},
+	{
+		Name: "IpInCidr Unspecified IPv6 Address",
+		Function: parser.Function{
+			Name: "ip_in_cidr",
+		},
+		Args: []value.Primary{
+			value.NewString("::"),
+			value.NewString("::/128"),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "IpInCidr IPv4 Address in IPv6 Range",
+		Function: parser.Function{
+			Name: "ip_in_cidr",
+		},
+		Args: []value.Primary{
+			value.NewString("10.0.0.1"),
+			value.NewString("::/0"),
+		},
+		Result: value.NewTernary(ternary.FALSE),
+	},
+	{
+		Name: "IpInCidr Malformed Address",
+		Function: parser.Function{
+			Name: "ip_in_cidr",
+		},
+		Args: []value.Primary{
+			value.NewString("10.0.0"),
+			value.NewString("10.0.0.0/8"),
+		},
+		Result: value.NewTernary(ternary.UNKNOWN),
+	},
+	{
+		Name: "IpInCidr Malformed Range",
+		Function: parser.Function{
+			Name: "ip_in_cidr",
+		},
+		Args: []value.Primary{
+			value.NewString("10.0.0.1"),
+			value.NewString("10.0.0.0/33"),
+		},
+		Result: value.NewTernary(ternary.UNKNOWN),
+	},
+	{
+		Name: "IpInCidr Null",
+		Function: parser.Function{
+			Name: "ip_in_cidr",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString("10.0.0.0/8"),
+		},
+		Result: value.NewTernary(ternary.UNKNOWN),
+	},
+	{
+		Name: "IpInCidr Arguments Error",
+		Function: parser.Function{
+			Name: "ip_in_cidr",
+		},
+		Args: []value.Primary{
+			value.NewString("10.0.0.1"),
+		},
+		Error: "function ip_in_cidr takes exactly 2 arguments",
+	},
+}
+
+func TestIpInCidr(t *testing.T) {
+	testFunction(t, IpInCidr, ipInCidrTests)
+}
+
+var ipNormalizeTests = []functionTest{
+	{
+		Name: "IpNormalize IPv6",
+		Function: parser.Function{
+			Name: "ip_normalize",
+		},
+		Args: []value.Primary{
+			value.NewString("2001:0DB8:0000:0000:0000:0000:0000:0001"),
+		},
+		Result: value.NewString("2001:db8::1"),
+	},
+	{
+		Name: "IpNormalize Unspecified IPv6 Address",
+		Function: parser.Function{
+			Name: "ip_normalize",
+		},
+		Args: []value.Primary{
+			value.NewString("0:0:0:0:0:0:0:0"),
+		},
+		Result: value.NewString("::"),
+	},
+	{
+		Name: "IpNormalize IPv4",
+		Function: parser.Function{
+			Name: "ip_normalize",
+		},
+		Args: []value.Primary{
+			value.NewString(" 192.168.0.1 "),
+		},
+		Result: value.NewString("192.168.0.1"),
+	},
+	{
+		Name: "IpNormalize IPv4-Mapped IPv6 Address",
+		Function: parser.Function{
+			Name: "ip_normalize",
+		},
+		Args: []value.Primary{
+			value.NewString("::FFFF:192.168.0.1"),
+		},
+		Result: value.NewString("192.168.0.1"),
+	},
+	{
+		Name: "IpNormalize Malformed Address",
+		Function: parser.Function{
+			Name: "ip_normalize",
+		},
+		Args: []value.Primary{
+			value.NewString("2001:db8:::1"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "IpNormalize Null",
+		Function: parser.Function{
+			Name: "ip_normalize",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "IpNormalize Arguments Error",
+		Function: parser.Function{
+			Name: "ip_normalize",
+		},
+		Args:  []value.Primary{},
+		Error: "function ip_normalize takes exactly 1 argument",
+	},
+}
+
+func TestIpNormalize(t *testing.T) {
+	testFunction(t, IpNormalize, ipNormalizeTests)
+}
+
+var datetimeFormatTests = []functionTest{
+	{
+		Name: "DatetimeFormat",
+		Function: parser.Function{
+			Name: "datetime_format",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+			value.NewString("%Y-%m-%d"),
+		},
+		Result: value.NewString("2012-02-03"),
+	},
+	{
+		Name: "DatetimeFormat Datetime is Null",
+		Function: parser.Function{
+			Name: "datetime_format",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString("%Y-%m-%d"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "DatetimeFormat Format is Null",
+		Function: parser.Function{
+			Name: "datetime_format",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "DatetimeFormat Arguments Error",
+		Function: parser.Function{
+			Name: "datetime_format",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+		},
+		Error: "function datetime_format takes exactly 2 arguments",
+	},
+}
+
+func TestDatetimeFormat(t *testing.T) {
+	testFunction(t, DatetimeFormat, datetimeFormatTests)
+}
+
+var parseDatetimeTests = []functionTest{
+	{
+		Name: "ParseDatetime",
+		Function: parser.Function{
+			Name: "parse_datetime",
+		},
+		Args: []value.Primary{
+			value.NewString("03/Feb/2012:09:18:15"),
+			value.NewString("%d/%b/%Y:%H:%M:%S"),
+		},
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+	},
+	{
+		Name: "ParseDatetime with Go Layout",
+		Function: parser.Function{
+			Name: "parse_datetime",
+		},
+		Args: []value.Primary{
+			value.NewString("03/Feb/2021:10:00:00 +0000"),
+			value.NewString("02/Jan/2006:15:04:05 -0700"),
+		},
+		Result: value.NewDatetime(time.Date(2021, 2, 3, 10, 0, 0, 0, GetTestLocation())),
+	},
+	{
+		Name: "ParseDatetime with Literal Format Not Matched",
+		Function: parser.Function{
+			Name: "parse_datetime",
+		},
+		Args: []value.Primary{
+			value.NewString("2021-02-03"),
+			value.NewString("literal"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "ParseDatetime with Literal Digits",
+		Function: parser.Function{
+			Name: "parse_datetime",
+		},
+		Args: []value.Primary{
+			value.NewString("Q1 01 Jan 03/Feb/2012:09:18:15"),
+			value.NewString("Q1 01 Jan %d/%b/%Y:%H:%M:%S"),
+		},
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+	},
+	{
+		Name: "ParseDatetime Mismatch",
+		Function: parser.Function{
+			Name: "parse_datetime",
+		},
+		Args: []value.Primary{
+			value.NewString("2012-02-03"),
+			value.NewString("%d/%b/%Y"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "ParseDatetime Datetime Literals",
+		Function: parser.Function{
+			Name: "parse_datetime",
+			Args: []parser.QueryExpression{
+				parser.NewDatetimeValueFromString("2020-01-02 10:11:12", nil),
+				parser.NewStringValue("%Y-%m-%d %H:%M:%S"),
+			},
+		},
+		Args: []value.Primary{
+			value.NewDatetimeFromString("2020-01-02 10:11:12", nil),
+			value.NewString("%Y-%m-%d %H:%M:%S"),
+		},
+		Result: value.NewDatetime(time.Date(2020, 1, 2, 10, 11, 12, 0, GetTestLocation())),
+	},
+	{
+		Name: "ParseDatetime Datetime Literal Layout",
+		Function: parser.Function{
+			Name: "parse_datetime",
+			Args: []parser.QueryExpression{
+				parser.NewDatetimeValueFromString("2020-01-02", nil),
+				parser.NewDatetimeValueFromString("2006-01-02", nil),
+			},
+		},
+		Args: []value.Primary{
+			value.NewDatetimeFromString("2020-01-02", nil),
+			value.NewDatetimeFromString("2006-01-02", nil),
+		},
+		Result: value.NewDatetime(time.Date(2020, 1, 2, 0, 0, 0, 0, GetTestLocation())),
+	},
+	{
+		Name: "ParseDatetime Datetime Value",
+		Function: parser.Function{
+			Name: "parse_datetime",
+			Args: []parser.QueryExpression{
+				parser.Function{Name: "now"},
+				parser.NewStringValue("%Y-%m-%d"),
+			},
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2020, 1, 2, 10, 11, 12, 0, GetTestLocation())),
+			value.NewString("%Y-%m-%d"),
+		},
+		Result: value.NewDatetime(time.Date(2020, 1, 2, 10, 11, 12, 0, GetTestLocation())),
+	},
+	{
+		Name: "ParseDatetime String is Null",
+		Function: parser.Function{
+			Name: "parse_datetime",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString("%Y-%m-%d"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "ParseDatetime Format is Null",
+		Function: parser.Function{
+			Name: "parse_datetime",
+		},
+		Args: []value.Primary{
+			value.NewString("2012-02-03"),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "ParseDatetime Arguments Error",
+		Function: parser.Function{
+			Name: "parse_datetime",
+		},
+		Args: []value.Primary{
+			value.NewString("2012-02-03"),
+		},
+		Error: "function parse_datetime takes exactly 2 arguments",
+	},
+}
+
+func TestParseDatetime(t *testing.T) {
+	testFunction(t, ParseDatetime, parseDatetimeTests)
+}
+
+var parseDatetimeStrictTests = []functionTest{
+	{
+		Name: "ParseDatetimeStrict",
+		Function: parser.Function{
+			Name: "parse_datetime_strict",
+		},
+		Args: []value.Primary{
+			value.NewString("2012-02-03 09:18:15"),
+			value.NewString("%Y-%m-%d %H:%M:%S"),
+		},
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+	},
+	{
+		Name: "ParseDatetimeStrict Datetime Literal Layout",
+		Function: parser.Function{
+			Name: "parse_datetime_strict",
+			Args: []parser.QueryExpression{
+				parser.NewDatetimeValueFromString("2020-01-02", nil),
+				parser.NewDatetimeValueFromString("2006-01-02", nil),
+			},
+		},
+		Args: []value.Primary{
+			value.NewDatetimeFromString("2020-01-02", nil),
+			value.NewDatetimeFromString("2006-01-02", nil),
+		},
+		Result: value.NewDatetime(time.Date(2020, 1, 2, 0, 0, 0, 0, GetTestLocation())),
+	},
+	{
+		Name: "ParseDatetimeStrict String is Null",
+		Function: parser.Function{
+			Name: "parse_datetime_strict",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString("%Y-%m-%d"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "ParseDatetimeStrict Datetime Literal Mismatch Error",
+		Function: parser.Function{
+			Name: "parse_datetime_strict",
+			Args: []parser.QueryExpression{
+				parser.NewDatetimeValueFromString("2020-01-02", nil),
+				parser.NewStringValue("%d/%m/%Y"),
+			},
+		},
+		Args: []value.Primary{
+			value.NewDatetimeFromString("2020-01-02", nil),
+			value.NewString("%d/%m/%Y"),
+		},
+		Error: "\"2020-01-02\" does not match the format \"%d/%m/%Y\" for function parse_datetime_strict",
+	},
+	{
+		Name: "ParseDatetimeStrict Mismatch Error",
+		Function: parser.Function{
+			Name: "parse_datetime_strict",
+		},
+		Args: []value.Primary{
+			value.NewString("2012-02-03"),
+			value.NewString("%d/%b/%Y"),
+		},
+		Error: "\"2012-02-03\" does not match the format \"%d/%b/%Y\" for function parse_datetime_strict",
+	},
+}
+
+func TestParseDatetimeStrict(t *testing.T) {
+	testFunction(t, ParseDatetimeStrict, parseDatetimeStrictTests)
+}
+
+var toCharTests = []functionTest{
+	{
+		Name: "ToChar",
+		Function: parser.Function{
+			Name: "to_char",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+			value.NewString("%d/%b/%Y:%H:%M:%S"),
+		},
+		Result: value.NewString("03/Feb/2012:09:18:15"),
+	},
+	{
+		Name: "ToChar with Go Layout",
+		Function: parser.Function{
+			Name: "to_char",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+			value.NewString("2006-01-02T15:04:05"),
+		},
+		Result: value.NewString("2012-02-03T09:18:15"),
+	},
+	{
+		Name: "ToChar with Literal Digits",
+		Function: parser.Function{
+			Name: "to_char",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+			value.NewString("2006-01-02 Jan PM: %Y-%m-%dT%H:%M:%S"),
+		},
+		Result: value.NewString("2006-01-02 Jan PM: 2012-02-03T09:18:15"),
+	},
+	{
+		Name: "ToChar with Datetime Literal Layout",
+		Function: parser.Function{
+			Name: "to_char",
+			Args: []parser.QueryExpression{
+				parser.Function{Name: "now"},
+				parser.NewDatetimeValueFromString("2006-01-02", nil),
+			},
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+			value.NewDatetimeFromString("2006-01-02", nil),
+		},
+		Result: value.NewString("2012-02-03"),
+	},
+	{
+		Name: "ToChar Datetime is Null",
+		Function: parser.Function{
+			Name: "to_char",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString("%Y-%m-%d"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "ToChar Format is Null",
+		Function: parser.Function{
+			Name: "to_char",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "ToChar Arguments Error",
+		Function: parser.Function{
+			Name: "to_char",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+		},
+		Error: "function to_char takes exactly 2 arguments",
+	},
+}
+
+func TestToChar(t *testing.T) {
+	testFunction(t, ToChar, toCharTests)
+}
+
+var yearTests = []functionTest{
+	{
+		Name: "Year",
+		Function: parser.Function{
+			Name: "year",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+		},
+		Result: value.NewInteger(2012),
+	},
+	{
+		Name: "Year Datetime is Null",
+		Function: parser.Function{
+			Name: "year",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Year Arguments Error",
+		Function: parser.Function{
+			Name: "year",
+		},
+		Args:  []value.Primary{},
+		Error: "function year takes exactly 1 argument",
+	},
+}
+
+func TestYear(t *testing.T) {
+	testFunction(t, Year, yearTests)
+}
+
+var monthTests = []functionTest{
+	{
+		Name: "Month",
+		Function: parser.Function{
+			Name: "month",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+		},
+		Result: value.NewInteger(2),
+	},
+}
+
+func TestMonth(t *testing.T) {
+	testFunction(t, Month, monthTests)
+}
+
+var dayTests = []functionTest{
+	{
+		Name: "Day",
+		Function: parser.Function{
+			Name: "day",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+		},
+		Result: value.NewInteger(3),
+	},
+}
+
+func TestDay(t *testing.T) {
+	testFunction(t, Day, dayTests)
+}
+
+var hourTests = []functionTest{
+	{
+		Name: "Hour",
+		Function: parser.Function{
+			Name: "hour",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+		},
+		Result: value.NewInteger(9),
+	},
+}
+
+func TestHour(t *testing.T) {
+	testFunction(t, Hour, hourTests)
+}
+
+var minuteTests = []functionTest{
+	{
+		Name: "Minute",
+		Function: parser.Function{
+			Name: "minute",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+		},
+		Result: value.NewInteger(18),
+	},
+}
+
+func TestMinute(t *testing.T) {
+	testFunction(t, Minute, minuteTests)
+}
+
+var secondTests = []functionTest{
+	{
+		Name: "Second",
+		Function: parser.Function{
+			Name: "second",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+		},
+		Result: value.NewInteger(15),
+	},
+}
+
+func TestSecond(t *testing.T) {
+	testFunction(t, Second, secondTests)
+}
+
+var millisecondTests = []functionTest{
+	{
+		Name: "Millisecond",
+		Function: parser.Function{
+			Name: "millisecond",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+		},
+		Result: value.NewInteger(123),
+	},
+}
+
+func TestMillisecond(t *testing.T) {
+	testFunction(t, Millisecond, millisecondTests)
+}
+
+var microsecondTests = []functionTest{
+	{
+		Name: "Microsecond",
+		Function: parser.Function{
+			Name: "microsecond",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+		},
+		Result: value.NewInteger(123457),
+	},
+}
+
+func TestMicrosecond(t *testing.T) {
+	testFunction(t, Microsecond, microsecondTests)
+}
+
+var nanosecondTests = []functionTest{
+	{
+		Name: "Nanosecond",
+		Function: parser.Function{
+			Name: "nanosecond",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+		},
+		Result: value.NewInteger(123456789),
+	},
+}
+
+func TestNanosecond(t *testing.T) {
+	testFunction(t, Nanosecond, nanosecondTests)
+}
+
+var weekdayTests = []functionTest{
+	{
+		Name: "Weekday",
+		Function: parser.Function{
+			Name: "weekday",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+		},
+		Result: value.NewInteger(5),
+	},
+}
+
+func TestWeekday(t *testing.T) {
+	testFunction(t, Weekday, weekdayTests)
+}
+
+var unixTimeTests = []functionTest{
+	{
+		Name: "UnixTime",
+		Function: parser.Function{
+			Name: "unix_time",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+		},
+		Result: value.NewInteger(1328260695),
+	},
+}
+
+func TestUnixTime(t *testing.T) {
+	testFunction(t, UnixTime, unixTimeTests)
+}
+
+var unixNanoTimeTests = []functionTest{
+	{
+		Name: "UnixNanoTime",
+		Function: parser.Function{
+			Name: "unix_nano_time",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+		},
+		Result: value.NewInteger(1328260695123456789),
+	},
+}
+
+func TestUnixNanoTime(t *testing.T) {
+	testFunction(t, UnixNanoTime, unixNanoTimeTests)
+}
+
+var unixMilliTimeTests = []functionTest{
+	{
+		Name: "UnixMilliTime",
+		Function: parser.Function{
+			Name: "unix_timestamp_ms",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+		},
+		Result: value.NewInteger(1328260695123),
+	},
+	{
+		Name: "UnixMilliTime Before Epoch",
+		Function: parser.Function{
+			Name: "unix_timestamp_ms",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(1969, 12, 31, 23, 59, 59, 500000000, time.UTC)),
+		},
+		Result: value.NewInteger(-500),
+	},
+	{
+		Name: "UnixMilliTime Null",
+		Function: parser.Function{
+			Name: "unix_timestamp_ms",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestUnixMilliTime(t *testing.T) {
+	testFunction(t, UnixMilliTime, unixMilliTimeTests)
+}
+
+var dayOfYearTests = []functionTest{
+	{
+		Name: "DayOfYear",
+		Function: parser.Function{
+			Name: "day_of_year",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+		},
+		Result: value.NewInteger(34),
+	},
+}
+
+func TestDayOfYear(t *testing.T) {
+	testFunction(t, DayOfYear, dayOfYearTests)
+}
+
+var weekOfYearTests = []functionTest{
+	{
+		Name: "WeekOfYear",
+		Function: parser.Function{
+			Name: "week_of_year",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+		},
+		Result: value.NewInteger(5),
+	},
+}
+
+func TestWeekOfYear(t *testing.T) {
+	testFunction(t, WeekOfYear, weekOfYearTests)
+}
+
+var addYearTests = []functionTest{
+	{
+		Name: "AddYear",
+		Function: parser.Function{
+			Name: "add_year",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+			value.NewInteger(2),
+		},
+		Result: value.NewDatetime(time.Date(2014, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+	},
+	{
+		Name: "AddYear Datetime is Null",
+		Function: parser.Function{
+			Name: "add_year",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewInteger(2),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "AddYear Duration is Null",
+		Function: parser.Function{
+			Name: "add_year",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "AddYear Arguments Error",
+		Function: parser.Function{
+			Name: "add_year",
+		},
+		Args:  []value.Primary{},
+		Error: "function add_year takes exactly 2 arguments",
+	},
+}
+
+func TestAddYear(t *testing.T) {
+	testFunction(t, AddYear, addYearTests)
+}
+
+var addMonthTests = []functionTest{
+	{
+		Name: "AddMonth",
+		Function: parser.Function{
+			Name: "add_month",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+			value.NewInteger(2),
+		},
+		Result: value.NewDatetime(time.Date(2012, 4, 3, 9, 18, 15, 123456789, GetTestLocation())),
+	},
+}
+
+func TestAddMonth(t *testing.T) {
+	testFunction(t, AddMonth, addMonthTests)
+}
+
+var addDayTests = []functionTest{
+	{
+		Name: "AddDay",
+		Function: parser.Function{
+			Name: "add_day",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+			value.NewInteger(2),
+		},
+		Result: value.NewDatetime(time.Date(2012, 2, 5, 9, 18, 15, 123456789, GetTestLocation())),
+	},
+}
+
+func TestAddDay(t *testing.T) {
+	testFunction(t, AddDay, addDayTests)
+}
+
+var addHourTests = []functionTest{
+	{
+		Name: "AddHour",
+		Function: parser.Function{
+			Name: "add_hour",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+			value.NewInteger(2),
+		},
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 11, 18, 15, 123456789, GetTestLocation())),
+	},
+}
+
+func TestAddHour(t *testing.T) {
+	testFunction(t, AddHour, addHourTests)
+}
+
+var addMinuteTests = []functionTest{
+	{
+		Name: "AddMinute",
+		Function: parser.Function{
+			Name: "add_minute",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+			value.NewInteger(2),
+		},
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 20, 15, 123456789, GetTestLocation())),
+	},
+}
+
+func TestAddMinute(t *testing.T) {
+	testFunction(t, AddMinute, addMinuteTests)
+}
+
+var addSecondTests = []functionTest{
+	{
+		Name: "AddSecond",
+		Function: parser.Function{
+			Name: "add_second",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+			value.NewInteger(2),
+		},
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 17, 123456789, GetTestLocation())),
+	},
+}
+
+func TestAddSecond(t *testing.T) {
+	testFunction(t, AddSecond, addSecondTests)
+}
+
+var addMilliTests = []functionTest{
+	{
+		Name: "AddMilli",
+		Function: parser.Function{
+			Name: "add_milli",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+			value.NewInteger(2),
+		},
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 125456789, GetTestLocation())),
+	},
+}
+
+func TestAddMilli(t *testing.T) {
+	testFunction(t, AddMilli, addMilliTests)
+}
+
+var addMicroTests = []functionTest{
+	{
+		Name: "AddMicro",
+		Function: parser.Function{
+			Name: "add_micro",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+			value.NewInteger(2),
+		},
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123458789, GetTestLocation())),
+	},
+}
+
+func TestAddMicro(t *testing.T) {
+	testFunction(t, AddMicro, addMicroTests)
+}
+
+var addNanoTests = []functionTest{
+	{
+		Name: "AddNano",
+		Function: parser.Function{
+			Name: "add_nano",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+			value.NewInteger(2),
+		},
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456791, GetTestLocation())),
+	},
+}
+
+func TestAddNano(t *testing.T) {
+	testFunction(t, AddNano, addNanoTests)
+}
+
+var truncMonthTests = []functionTest{
+	{
+		Name: "TruncMonth",
+		Function: parser.Function{
+			Name: "trunc_month",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+		},
+		Result: value.NewDatetime(time.Date(2012, 1, 1, 0, 0, 0, 0, GetTestLocation())),
+	},
+	{
+		Name: "TruncMonth Argument Error",
+		Function: parser.Function{
+			Name: "trunc_month",
+		},
+		Args:  []value.Primary{},
+		Error: "function trunc_month takes exactly 1 argument",
+	},
+	{
+		Name: "TruncMonth Argument Is Null",
+		Function: parser.Function{
+			Name: "trunc_month",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestTruncMonth(t *testing.T) {
+	testFunction(t, TruncMonth, truncMonthTests)
+}
+
+var truncDayTests = []functionTest{
+	{
+		Name: "TruncDay",
+		Function: parser.Function{
+			Name: "trunc_day",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+		},
+		Result: value.NewDatetime(time.Date(2012, 2, 1, 0, 0, 0, 0, GetTestLocation())),
+	},
+}
+
+func TestTruncDay(t *testing.T) {
+	testFunction(t, TruncDay, truncDayTests)
+}
+
+var truncTimeTests = []functionTest{
+	{
+		Name: "TruncTime",
+		Function: parser.Function{
+			Name: "trunc_time",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+		},
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 0, 0, 0, 0, GetTestLocation())),
+	},
+}
+
+func TestTruncTime(t *testing.T) {
+	testFunction(t, TruncTime, truncTimeTests)
+}
+
+var truncMinuteTests = []functionTest{
+	{
+		Name: "TruncMinute",
+		Function: parser.Function{
+			Name: "trunc_minute",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+		},
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 0, 0, 0, GetTestLocation())),
+	},
+	{
+		Name: "TruncMinute Argument Error",
+		Function: parser.Function{
+			Name: "trunc_minute",
+		},
+		Args:  []value.Primary{},
+		Error: "function trunc_minute takes exactly 1 argument",
+	},
+	{
+		Name: "TruncMinute Argument Is Null",
+		Function: parser.Function{
+			Name: "trunc_minute",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestTruncMinute(t *testing.T) {
+	testFunction(t, TruncMinute, truncMinuteTests)
+}
+
+var truncSecondTests = []functionTest{
+	{
+		Name: "TruncSecond",
+		Function: parser.Function{
+			Name: "trunc_second",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+		},
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 0, 0, GetTestLocation())),
+	},
+}
+
+func TestTruncSecond(t *testing.T) {
+	testFunction(t, TruncSecond, truncSecondTests)
+}
+
+var truncMilliTests = []functionTest{
+	{
+		Name: "TruncMilli",
+		Function: parser.Function{
+			Name: "trunc_milli",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+		},
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+	},
+}
+
+func TestTruncMilli(t *testing.T) {
+	testFunction(t, TruncMilli, truncMilliTests)
+}
+
+var truncMicroTests = []functionTest{
+	{
+		Name: "TruncMicro",
+		Function: parser.Function{
+			Name: "trunc_micro",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+		},
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123000000, GetTestLocation())),
+	},
+}
+
+func TestTruncateMicro(t *testing.T) {
+	testFunction(t, TruncMicro, truncMicroTests)
+}
+
+var truncNanoTests = []functionTest{
+	{
+		Name: "TruncNano",
+		Function: parser.Function{
+			Name: "trunc_nano",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+		},
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456000, GetTestLocation())),
+	},
+}
+
+func TestTruncateNano(t *testing.T) {
+	testFunction(t, TruncNano, truncNanoTests)
+}
+
+var dateDiffTests = []functionTest{
+	{
+		Name: "DateDiff",
+		Function: parser.Function{
+			Name: "date_diff",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+			value.NewDatetime(time.Date(2012, 2, 5, 1, 18, 55, 123456789, GetTestLocation())),
+		},
+		Result: value.NewInteger(-2),
+	},
+	{
+		Name: "DateDiff Datetime1 is Null",
+		Function: parser.Function{
+			Name: "date_diff",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewDatetime(time.Date(2012, 2, 5, 1, 18, 55, 123456789, GetTestLocation())),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "DateDiff Datetime2 is Null",
+		Function: parser.Function{
+			Name: "date_diff",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "DateDiff Arguments Error",
+		Function: parser.Function{
+			Name: "date_diff",
+		},
+		Args:  []value.Primary{},
+		Error: "function date_diff takes exactly 2 arguments",
+	},
+}
+
+func TestDateDiff(t *testing.T) {
+	testFunction(t, DateDiff, dateDiffTests)
+}
+
+var timeDiffTests = []functionTest{
+	{
+		Name: "TimeDiff",
+		Function: parser.Function{
+			Name: "time_diff",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+			value.NewDatetime(time.Date(2012, 2, 3, 1, 18, 55, 123000000, GetTestLocation())),
+		},
+		Result: value.NewFloat(28760.000456789),
+	},
+	{
+		Name: "TimeDiff Datetime1 is Null",
+		Function: parser.Function{
+			Name: "time_diff",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewDatetime(time.Date(2012, 2, 5, 1, 18, 55, 123456789, GetTestLocation())),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "TimeDiff Datetime2 is Null",
+		Function: parser.Function{
+			Name: "time_diff",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "TimeDiff Arguments Error",
+		Function: parser.Function{
+			Name: "time_diff",
+		},
+		Args:  []value.Primary{},
+		Error: "function time_diff takes exactly 2 arguments",
+	},
+}
+
+func TestTimeDiff(t *testing.T) {
+	testFunction(t, TimeDiff, timeDiffTests)
+}
+
+var timeNanoDiffTests = []functionTest{
+	{
+		Name: "TimeNanoDiff",
+		Function: parser.Function{
+			Name: "time_nano_diff",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+			value.NewDatetime(time.Date(2012, 2, 3, 1, 18, 55, 123000000, GetTestLocation())),
+		},
+		Result: value.NewInteger(28760000456789),
+	},
+}
+
+func TestTimeNanoDiff(t *testing.T) {
+	testFunction(t, TimeNanoDiff, timeNanoDiffTests)
+}
+
+var utcTests = []functionTest{
+	{
+		Name: "UTC",
+		Function: parser.Function{
+			Name: "utc",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+		},
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, time.UTC)),
+	},
+	{
+		Name: "UTC Argument Error",
+		Function: parser.Function{
+			Name: "utc",
+		},
+		Args:  []value.Primary{},
+		Error: "function utc takes exactly 1 argument",
+	},
+	{
+		Name: "UTC Argument Is Null",
+		Function: parser.Function{
+			Name: "utc",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestUTC(t *testing.T) {
+	testFunction(t, UTC, utcTests)
+}
+
+var nanoToDatetimeTests = []functionTest{
+	{
+		Name: "NanoToDatetime",
+		Function: parser.Function{
+			Name: "nano_to_datetime",
+		},
+		Args: []value.Primary{
+			value.NewInteger(1328260695000000001),
+		},
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 1, GetTestLocation())),
+	},
+	{
+		Name: "NanoToDatetime Invalid Argument",
+		Function: parser.Function{
+			Name: "nano_to_datetime",
+		},
+		Args: []value.Primary{
+			value.NewString("abc"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "NanoToDatetime Arguments Error",
+		Function: parser.Function{
+			Name: "nano_to_datetime",
+		},
+		Args:  []value.Primary{},
+		Error: "function nano_to_datetime takes exactly 1 argument",
+	},
+}
+
+func TestNanoToDatetime(t *testing.T) {
+	testFunction(t, NanoToDatetime, nanoToDatetimeTests)
+}
+
+var fromUnixTimeTests = []functionTest{
+	{
+		Name: "FromUnixTime",
+		Function: parser.Function{
+			Name: "from_unixtime",
+		},
+		Args: []value.Primary{
+			value.NewInteger(1328260695),
+		},
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+	},
+	{
+		Name: "FromUnixTime Null",
+		Function: parser.Function{
+			Name: "from_unixtime",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "FromUnixTime Arguments Error",
+		Function: parser.Function{
+			Name: "from_unixtime",
+		},
+		Args:  []value.Primary{},
+		Error: "function from_unixtime takes exactly 1 argument",
+	},
+}
+
+func TestFromUnixTime(t *testing.T) {
+	testFunction(t, FromUnixTime, fromUnixTimeTests)
+}
+
+var fromUnixMilliTimeTests = []functionTest{
+	{
+		Name: "FromUnixMilliTime",
+		Function: parser.Function{
+			Name: "from_unixtime_ms",
+		},
+		Args: []value.Primary{
+			value.NewInteger(1328260695123),
+		},
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123000000, GetTestLocation())),
+	},
+	{
+		Name: "FromUnixMilliTime Before Epoch",
+		Function: parser.Function{
+			Name: "from_unixtime_ms",
+		},
+		Args: []value.Primary{
+			value.NewInteger(-500),
+		},
+		Result: value.NewDatetime(time.Date(1969, 12, 31, 23, 59, 59, 500000000, GetTestLocation())),
+	},
+}
+
+func TestFromUnixMilliTime(t *testing.T) {
+	testFunction(t, FromUnixMilliTime, fromUnixMilliTimeTests)
+}
+
+var stringTests = []functionTest{
+	{
+		Name: "String from Integer",
+		Function: parser.Function{
+			Name: "string",
+		},
+		Args: []value.Primary{
+			value.NewInteger(2),
+		},
+		Result: value.NewString("2"),
+	},
+	{
+		Name: "String from Boolean",
+		Function: parser.Function{
+			Name: "string",
+		},
+		Args: []value.Primary{
+			value.NewBoolean(true),
+		},
+		Result: value.NewString("true"),
+	},
+	{
+		Name: "String from Ternary",
+		Function: parser.Function{
+			Name: "string",
+		},
+		Args: []value.Primary{
+			value.NewTernary(ternary.TRUE),
+		},
+		Result: value.NewString("TRUE"),
+	},
+	{
+		Name: "String from Datetime",
+		Function: parser.Function{
+			Name: "string",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+		},
+		Result: value.NewString("2012-02-03T09:18:15Z"),
+	},
+	{
+		Name: "String Arguments Error",
+		Function: parser.Function{
+			Name: "string",
+		},
+		Args:  []value.Primary{},
+		Error: "function string takes exactly 1 argument",
+	},
+}
+
+func TestString(t *testing.T) {
+	testFunction(t, String, stringTests)
+}
+
+var integerTests = []functionTest{
+	{
+		Name: "Integer from Integer",
+		Function: parser.Function{
+			Name: "integer",
+		},
+		Args: []value.Primary{
+			value.NewInteger(2),
+		},
+		Result: value.NewInteger(2),
+	},
+	{
+		Name: "Integer from String",
+		Function: parser.Function{
+			Name: "integer",
+		},
+		Args: []value.Primary{
+			value.NewString("2"),
+		},
+		Result: value.NewInteger(2),
+	},
+	{
+		Name: "Integer from E-Notation",
+		Function: parser.Function{
+			Name: "integer",
+		},
+		Args: []value.Primary{
+			value.NewString("2e+02"),
+		},
+		Result: value.NewInteger(200),
+	},
+	{
+		Name: "Integer from Float",
+		Function: parser.Function{
+			Name: "integer",
+		},
+		Args: []value.Primary{
+			value.NewFloat(1.7),
+		},
+		Result: value.NewInteger(2),
+	},
+	{
+		Name: "Float Null",
+		Function: parser.Function{
+			Name: "float",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Integer from Datetime",
+		Function: parser.Function{
+			Name: "integer",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+		},
+		Result: value.NewInteger(1328260695),
+	},
+	{
+		Name: "Integer Arguments Error",
+		Function: parser.Function{
+			Name: "integer",
+		},
+		Args:  []value.Primary{},
+		Error: "function integer takes exactly 1 argument",
+	},
+}
+
+func TestInteger(t *testing.T) {
+	testFunction(t, Integer, integerTests)
+}
+
+var floatTests = []functionTest{
+	{
+		Name: "Float from String",
+		Function: parser.Function{
+			Name: "float",
+		},
+		Args: []value.Primary{
+			value.NewString("2"),
+		},
+		Result: value.NewFloat(2),
+	},
+	{
+		Name: "Float from Datetime",
+		Function: parser.Function{
+			Name: "float",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123450000, GetTestLocation())),
+		},
+		Result: value.NewFloat(1328260695.12345),
+	},
+	{
+		Name: "Float Arguments Error",
+		Function: parser.Function{
+			Name: "float",
+		},
+		Args:  []value.Primary{},
 		Error: "function float takes exactly 1 argument",
 	},
 }
@@ -3417,35 +6414,366 @@ var datetimeTests = []functionTest{
 	{
 		Name: "Datetime from String representing Float",
 		Function: parser.Function{
-			Name: "datetime",
+			Name: "datetime",
+		},
+		Args: []value.Primary{
+			value.NewString("1136181845.123"),
+		},
+		Result: value.NewDatetime(time.Date(2006, 1, 2, 6, 4, 5, 123000000, GetTestLocation())),
+	},
+	{
+		Name: "Datetime Invalid String",
+		Function: parser.Function{
+			Name: "datetime",
+		},
+		Args: []value.Primary{
+			value.NewString("abcde"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Datetime Arguments Error",
+		Function: parser.Function{
+			Name: "datetime",
+		},
+		Args:  []value.Primary{},
+		Error: "function datetime takes exactly 1 argument",
+	},
+}
+
+func TestDatetime(t *testing.T) {
+	testFunction(t, Datetime, datetimeTests)
+}
+
+var typeOfTests = []functionTest{
+	{
+		Name: "TypeOf String",
+		Function: parser.Function{
+			Name: "typeof",
+		},
+		Args: []value.Primary{
+			value.NewString("1"),
+		},
+		Result: value.NewString("string"),
+	},
+	{
+		Name: "TypeOf Integer",
+		Function: parser.Function{
+			Name: "typeof",
+		},
+		Args: []value.Primary{
+			value.NewInteger(1),
+		},
+		Result: value.NewString("integer"),
+	},
+	{
+		Name: "TypeOf Float",
+		Function: parser.Function{
+			Name: "typeof",
+		},
+		Args: []value.Primary{
+			value.NewFloat(1.5),
+		},
+		Result: value.NewString("float"),
+	},
+	{
+		Name: "TypeOf Boolean",
+		Function: parser.Function{
+			Name: "typeof",
+		},
+		Args: []value.Primary{
+			value.NewBoolean(true),
+		},
+		Result: value.NewString("boolean"),
+	},
+	{
+		Name: "TypeOf Ternary",
+		Function: parser.Function{
+			Name: "typeof",
+		},
+		Args: []value.Primary{
+			value.NewTernary(ternary.UNKNOWN),
+		},
+		Result: value.NewString("ternary"),
+	},
+	{
+		Name: "TypeOf Datetime",
+		Function: parser.Function{
+			Name: "typeof",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+		},
+		Result: value.NewString("datetime"),
+	},
+	{
+		Name: "TypeOf Null",
+		Function: parser.Function{
+			Name: "typeof",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+		},
+		Result: value.NewString("null"),
+	},
+	{
+		Name: "TypeOf Arguments Error",
+		Function: parser.Function{
+			Name: "typeof",
+		},
+		Args:  []value.Primary{},
+		Error: "function typeof takes exactly 1 argument",
+	},
+}
+
+func TestTypeOf(t *testing.T) {
+	testFunction(t, TypeOf, typeOfTests)
+}
+
+var getEnvTests = []functionTest{
+	{
+		Name: "GetEnv",
+		Function: parser.Function{
+			Name: "getenv",
+		},
+		Args: []value.Primary{
+			value.NewString("CSVQ_TEST_GETENV"),
+		},
+		Result: value.NewString("foo"),
+	},
+	{
+		Name: "GetEnv Empty Value",
+		Function: parser.Function{
+			Name: "getenv",
+		},
+		Args: []value.Primary{
+			value.NewString("CSVQ_TEST_GETENV_EMPTY"),
+		},
+		Result: value.NewString(""),
+	},
+	{
+		Name: "GetEnv Not Set",
+		Function: parser.Function{
+			Name: "getenv",
+		},
+		Args: []value.Primary{
+			value.NewString("CSVQ_TEST_GETENV_NOT_SET"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "GetEnv Null",
+		Function: parser.Function{
+			Name: "getenv",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "GetEnv Arguments Error",
+		Function: parser.Function{
+			Name: "getenv",
+		},
+		Args:  []value.Primary{},
+		Error: "function getenv takes exactly 1 argument",
+	},
+}
+
+func TestGetEnv(t *testing.T) {
+	_ = os.Setenv("CSVQ_TEST_GETENV", "foo")
+	_ = os.Setenv("CSVQ_TEST_GETENV_EMPTY", "")
+	_ = os.Unsetenv("CSVQ_TEST_GETENV_NOT_SET")
+	defer func() {
+		_ = os.Unsetenv("CSVQ_TEST_GETENV")
+		_ = os.Unsetenv("CSVQ_TEST_GETENV_EMPTY")
+	}()
+
+	testFunction(t, GetEnv, getEnvTests)
+}
+
+var fileExistsTests = []functionTest{
+	{
+		Name: "FileExists",
+		Function: parser.Function{
+			Name: "file_exists",
+		},
+		Args: []value.Primary{
+			value.NewString("file_functions.txt"),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "FileExists Absolute Path",
+		Function: parser.Function{
+			Name: "file_exists",
+		},
+		Args: []value.Primary{
+			value.NewString(GetTestFilePath("file_functions.txt")),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "FileExists Symbolic Link",
+		Function: parser.Function{
+			Name: "file_exists",
+		},
+		Args: []value.Primary{
+			value.NewString("file_functions_link.txt"),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "FileExists Not Exist",
+		Function: parser.Function{
+			Name: "file_exists",
+		},
+		Args: []value.Primary{
+			value.NewString("notexist.txt"),
+		},
+		Result: value.NewTernary(ternary.FALSE),
+	},
+	{
+		Name: "FileExists Directory",
+		Function: parser.Function{
+			Name: "file_exists",
+		},
+		Args: []value.Primary{
+			value.NewString("completion"),
+		},
+		Result: value.NewTernary(ternary.FALSE),
+	},
+	{
+		Name: "FileExists Null",
+		Function: parser.Function{
+			Name: "file_exists",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+		},
+		Result: value.NewTernary(ternary.UNKNOWN),
+	},
+	{
+		Name: "FileExists Arguments Error",
+		Function: parser.Function{
+			Name: "file_exists",
+		},
+		Args:  []value.Primary{},
+		Error: "function file_exists takes exactly 1 argument",
+	},
+}
+
+var fileSizeTests = []functionTest{
+	{
+		Name: "FileSize",
+		Function: parser.Function{
+			Name: "file_size",
+		},
+		Args: []value.Primary{
+			value.NewString("file_functions.txt"),
+		},
+		Result: value.NewInteger(4),
+	},
+	{
+		Name: "FileSize Symbolic Link",
+		Function: parser.Function{
+			Name: "file_size",
+		},
+		Args: []value.Primary{
+			value.NewString("file_functions_link.txt"),
+		},
+		Result: value.NewInteger(4),
+	},
+	{
+		Name: "FileSize Not Exist",
+		Function: parser.Function{
+			Name: "file_size",
+		},
+		Args: []value.Primary{
+			value.NewString("notexist.txt"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "FileSize Arguments Error",
+		Function: parser.Function{
+			Name: "file_size",
+		},
+		Args:  []value.Primary{},
+		Error: "function file_size takes exactly 1 argument",
+	},
+}
+
+var fileMtimeTests = []functionTest{
+	{
+		Name: "FileMtime",
+		Function: parser.Function{
+			Name: "file_mtime",
 		},
 		Args: []value.Primary{
-			value.NewString("1136181845.123"),
+			value.NewString("file_functions.txt"),
 		},
-		Result: value.NewDatetime(time.Date(2006, 1, 2, 6, 4, 5, 123000000, GetTestLocation())),
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
 	},
 	{
-		Name: "Datetime Invalid String",
+		Name: "FileMtime Not Exist",
 		Function: parser.Function{
-			Name: "datetime",
+			Name: "file_mtime",
 		},
 		Args: []value.Primary{
-			value.NewString("abcde"),
+			value.NewString("notexist.txt"),
 		},
 		Result: value.NewNull(),
 	},
 	{
-		Name: "Datetime Arguments Error",
+		Name: "FileMtime Arguments Error",
 		Function: parser.Function{
-			Name: "datetime",
+			Name: "file_mtime",
 		},
 		Args:  []value.Primary{},
-		Error: "function datetime takes exactly 1 argument",
+		Error: "function file_mtime takes exactly 1 argument",
 	},
 }
 
-func TestDatetime(t *testing.T) {
-	testFunction(t, Datetime, datetimeTests)
+func setupFileFunctionTest() func() {
+	fpath := GetTestFilePath("file_functions.txt")
+	link := GetTestFilePath("file_functions_link.txt")
+	mtime := time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())
+
+	_ = ioutil.WriteFile(fpath, []byte("abc\n"), 0644)
+	_ = os.Chtimes(fpath, mtime, mtime)
+	_ = os.Symlink(fpath, link)
+
+	oldRepository := TestTx.Flags.Repository
+	TestTx.Flags.Repository = TestDir
+
+	return func() {
+		TestTx.Flags.Repository = oldRepository
+		_ = os.Remove(link)
+		_ = os.Remove(fpath)
+	}
+}
+
+func TestFileExists(t *testing.T) {
+	teardown := setupFileFunctionTest()
+	defer teardown()
+
+	testFunction(t, FileExists, fileExistsTests)
+}
+
+func TestFileSize(t *testing.T) {
+	teardown := setupFileFunctionTest()
+	defer teardown()
+
+	testFunction(t, FileSize, fileSizeTests)
+}
+
+func TestFileMtime(t *testing.T) {
+	teardown := setupFileFunctionTest()
+	defer teardown()
+
+	testFunction(t, FileMtime, fileMtimeTests)
 }
 
 var callTests = []functionTest{
@@ -3515,6 +6843,50 @@ var nowTests = []struct {
 		Scope:  GenerateReferenceScope(nil, nil, time.Date(2013, 2, 3, 0, 0, 0, 0, GetTestLocation()), nil),
 		Result: value.NewDatetime(time.Date(2013, 2, 3, 0, 0, 0, 0, GetTestLocation())),
 	},
+	{
+		Name: "Now with Precision",
+		Function: parser.Function{
+			Name: "now",
+		},
+		Args: []value.Primary{
+			value.NewInteger(3),
+		},
+		Scope:  GenerateReferenceScope(nil, nil, time.Date(2013, 2, 3, 0, 0, 0, 123456789, GetTestLocation()), nil),
+		Result: value.NewDatetime(time.Date(2013, 2, 3, 0, 0, 0, 123000000, GetTestLocation())),
+	},
+	{
+		Name: "Now with Zero Precision",
+		Function: parser.Function{
+			Name: "now",
+		},
+		Args: []value.Primary{
+			value.NewInteger(0),
+		},
+		Scope:  GenerateReferenceScope(nil, nil, time.Date(2013, 2, 3, 0, 0, 0, 123456789, GetTestLocation()), nil),
+		Result: value.NewDatetime(time.Date(2013, 2, 3, 0, 0, 0, 0, GetTestLocation())),
+	},
+	{
+		Name: "Now Invalid Precision Error",
+		Function: parser.Function{
+			Name: "now",
+		},
+		Args: []value.Primary{
+			value.NewInteger(10),
+		},
+		Scope: NewReferenceScope(TestTx),
+		Error: "the first argument must be an integer from 0 to 9 for function now",
+	},
+	{
+		Name: "Now Precision is Null Error",
+		Function: parser.Function{
+			Name: "now",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+		},
+		Scope: NewReferenceScope(TestTx),
+		Error: "the first argument must be an integer from 0 to 9 for function now",
+	},
 	{
 		Name: "Now Arguments Error",
 		Function: parser.Function{
@@ -3522,9 +6894,10 @@ var nowTests = []struct {
 		},
 		Args: []value.Primary{
 			value.NewInteger(1),
+			value.NewInteger(1),
 		},
 		Scope: NewReferenceScope(TestTx),
-		Error: "function now takes no argument",
+		Error: "function now takes 0 or 1 argument",
 	},
 }
 
@@ -3550,6 +6923,328 @@ func TestNow(t *testing.T) {
 	}
 }
 
+var currentDateTests = []struct {
+	Name     string
+	Function parser.Function
+	Args     []value.Primary
+	Scope    *ReferenceScope
+	Result   value.Primary
+	Error    string
+}{
+	{
+		Name: "CurrentDate",
+		Function: parser.Function{
+			Name: "current_date",
+		},
+		Scope:  GenerateReferenceScope(nil, nil, time.Date(2013, 2, 3, 12, 34, 56, 123456789, GetTestLocation()), nil),
+		Result: value.NewDatetime(time.Date(2013, 2, 3, 0, 0, 0, 0, GetTestLocation())),
+	},
+	{
+		Name: "CurrentDate Arguments Error",
+		Function: parser.Function{
+			Name: "current_date",
+		},
+		Args: []value.Primary{
+			value.NewInteger(1),
+		},
+		Scope: NewReferenceScope(TestTx),
+		Error: "function current_date takes no argument",
+	},
+}
+
+func TestCurrentDate(t *testing.T) {
+	defer initFlag(TestTx.Flags)
+	_ = TestTx.Flags.SetLocation(TestLocation)
+
+	for _, v := range currentDateTests {
+		result, err := CurrentDate(v.Scope, v.Function, v.Args)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %s, want %s", v.Name, result, v.Result)
+		}
+	}
+
+	TestTx.Flags.SetNow(time.Date(2020, 5, 6, 7, 8, 9, 0, GetTestLocation()))
+	result, _ := CurrentDate(NewReferenceScope(TestTx), parser.Function{Name: "current_date"}, nil)
+	expect := value.NewDatetime(time.Date(2020, 5, 6, 0, 0, 0, 0, GetTestLocation()))
+	if !reflect.DeepEqual(result, expect) {
+		t.Errorf("result = %s, want %s for the frozen time", result, expect)
+	}
+}
+
+var currentTimeTests = []struct {
+	Name     string
+	Function parser.Function
+	Args     []value.Primary
+	Scope    *ReferenceScope
+	Result   value.Primary
+	Error    string
+}{
+	{
+		Name: "CurrentTime",
+		Function: parser.Function{
+			Name: "current_time",
+		},
+		Scope:  GenerateReferenceScope(nil, nil, time.Date(2013, 2, 3, 12, 34, 56, 123456789, GetTestLocation()), nil),
+		Result: value.NewString("12:34:56.123456789"),
+	},
+	{
+		Name: "CurrentTime Trailing Zeros",
+		Function: parser.Function{
+			Name: "current_time",
+		},
+		Scope:  GenerateReferenceScope(nil, nil, time.Date(2013, 2, 3, 12, 34, 56, 120000000, GetTestLocation()), nil),
+		Result: value.NewString("12:34:56.12"),
+	},
+	{
+		Name: "CurrentTime with Precision",
+		Function: parser.Function{
+			Name: "current_time",
+		},
+		Args: []value.Primary{
+			value.NewInteger(0),
+		},
+		Scope:  GenerateReferenceScope(nil, nil, time.Date(2013, 2, 3, 12, 34, 56, 123456789, GetTestLocation()), nil),
+		Result: value.NewString("12:34:56"),
+	},
+	{
+		Name: "CurrentTime with Fractional Precision",
+		Function: parser.Function{
+			Name: "current_time",
+		},
+		Args: []value.Primary{
+			value.NewInteger(3),
+		},
+		Scope:  GenerateReferenceScope(nil, nil, time.Date(2013, 2, 3, 12, 34, 56, 100000000, GetTestLocation()), nil),
+		Result: value.NewString("12:34:56.100"),
+	},
+	{
+		Name: "CurrentTime Invalid Precision Error",
+		Function: parser.Function{
+			Name: "current_time",
+		},
+		Args: []value.Primary{
+			value.NewInteger(10),
+		},
+		Scope: NewReferenceScope(TestTx),
+		Error: "the first argument must be an integer from 0 to 9 for function current_time",
+	},
+}
+
+func TestCurrentTime(t *testing.T) {
+	defer initFlag(TestTx.Flags)
+	_ = TestTx.Flags.SetLocation(TestLocation)
+
+	for _, v := range currentTimeTests {
+		result, err := CurrentTime(v.Scope, v.Function, v.Args)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %s, want %s", v.Name, result, v.Result)
+		}
+	}
+}
+
+func TestTransactionTimestamp(t *testing.T) {
+	defer func() {
+		cmd.TestTime = NowForTest
+		TestTx.resetTimestamp()
+	}()
+
+	fn := parser.Function{Name: "transaction_timestamp"}
+	scope := NewReferenceScope(TestTx)
+
+	TestTx.resetTimestamp()
+	cmd.TestTime = time.Date(2012, 2, 4, 0, 0, 0, 0, GetTestLocation())
+	result, err := TransactionTimestamp(scope, fn, nil)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	expect := value.NewDatetime(NowForTest)
+	if !reflect.DeepEqual(result, expect) {
+		t.Errorf("result = %s, want %s for the time at which the transaction started", result, expect)
+	}
+
+	cmd.TestTime = time.Date(2013, 2, 3, 0, 0, 0, 0, GetTestLocation())
+	result, _ = TransactionTimestamp(scope, fn, nil)
+	if !reflect.DeepEqual(result, expect) {
+		t.Errorf("result = %s, want %s for the second call in the same transaction", result, expect)
+	}
+
+	TestTx.resetTimestamp()
+	result, _ = TransactionTimestamp(scope, fn, nil)
+	expect = value.NewDatetime(cmd.TestTime)
+	if !reflect.DeepEqual(result, expect) {
+		t.Errorf("result = %s, want %s for a new transaction", result, expect)
+	}
+
+	_, err = TransactionTimestamp(scope, fn, []value.Primary{value.NewInteger(1), value.NewInteger(1)})
+	if err == nil {
+		t.Error("no error, want error for arguments length")
+	} else if err.Error() != "function transaction_timestamp takes 0 or 1 argument" {
+		t.Errorf("error %q, want error %q", err.Error(), "function transaction_timestamp takes 0 or 1 argument")
+	}
+}
+
+var fileOriginTestView = &View{
+	Header: NewHeader("table1", []string{"column1"}),
+	RecordSet: RecordSet{
+		NewRecord([]value.Primary{value.NewString("1")}),
+		NewRecord([]value.Primary{value.NewString("2")}),
+		NewRecord([]value.Primary{value.NewString("3")}),
+	},
+	recordOrigins: []recordOrigin{
+		{Path: "/path/to/table1.csv", Line: 2},
+		{Path: "/path/to/table1.csv", Line: 0},
+	},
+}
+
+var fileOriginTests = []struct {
+	Name     string
+	Function parser.Function
+	Args     []value.Primary
+	Scope    *ReferenceScope
+	FileName value.Primary
+	FileLine value.Primary
+	Error    string
+}{
+	{
+		Name:     "Record Loaded from File",
+		Function: parser.Function{Name: "fileline"},
+		Scope:    GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{NewReferenceRecord(fileOriginTestView, 0, 1)}),
+		FileName: value.NewString("/path/to/table1.csv"),
+		FileLine: value.NewInteger(2),
+	},
+	{
+		Name:     "Unknown Line",
+		Function: parser.Function{Name: "fileline"},
+		Scope:    GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{NewReferenceRecord(fileOriginTestView, 1, 1)}),
+		FileName: value.NewString("/path/to/table1.csv"),
+		FileLine: value.NewNull(),
+	},
+	{
+		Name:     "Record Not Loaded from File",
+		Function: parser.Function{Name: "fileline"},
+		Scope:    GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{NewReferenceRecord(fileOriginTestView, 2, 1)}),
+		FileName: value.NewNull(),
+		FileLine: value.NewNull(),
+	},
+	{
+		Name:     "No Record",
+		Function: parser.Function{Name: "fileline"},
+		Scope:    NewReferenceScope(TestTx),
+		FileName: value.NewNull(),
+		FileLine: value.NewNull(),
+	},
+	{
+		Name:     "Arguments Error",
+		Function: parser.Function{Name: "fileline"},
+		Args: []value.Primary{
+			value.NewInteger(1),
+		},
+		Scope: NewReferenceScope(TestTx),
+		Error: "function fileline takes no argument",
+	},
+}
+
+func TestFileName(t *testing.T) {
+	for _, v := range fileOriginTests {
+		result, err := FileName(v.Scope, v.Function, v.Args)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.FileName) {
+			t.Errorf("%s: result = %s, want %s", v.Name, result, v.FileName)
+		}
+	}
+}
+
+func TestFileLine(t *testing.T) {
+	for _, v := range fileOriginTests {
+		result, err := FileLine(v.Scope, v.Function, v.Args)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.FileLine) {
+			t.Errorf("%s: result = %s, want %s", v.Name, result, v.FileLine)
+		}
+	}
+}
+
+var clockTimestampTests = []functionTest{
+	{
+		Name: "ClockTimestamp",
+		Function: parser.Function{
+			Name: "clock_timestamp",
+		},
+		Result: value.NewDatetime(NowForTest),
+	},
+	{
+		Name: "ClockTimestamp with Precision",
+		Function: parser.Function{
+			Name: "clock_timestamp",
+		},
+		Args: []value.Primary{
+			value.NewInteger(6),
+		},
+		Result: value.NewDatetime(NowForTest),
+	},
+	{
+		Name: "ClockTimestamp Arguments Error",
+		Function: parser.Function{
+			Name: "clock_timestamp",
+		},
+		Args: []value.Primary{
+			value.NewInteger(1),
+			value.NewInteger(1),
+		},
+		Error: "function clock_timestamp takes 0 or 1 argument",
+	},
+}
+
+func TestClockTimestamp(t *testing.T) {
+	testFunction(t, ClockTimestamp, clockTimestampTests)
+}
+
 var jsonObjectTests = []struct {
 	Name     string
 	Function parser.Function
@@ -2,6 +2,7 @@ package query
 
 import (
 	"errors"
+	"reflect"
 	"strconv"
 	"strings"
 
@@ -18,6 +19,14 @@ type HeaderField struct {
 	IsFromTable  bool
 	IsJoinColumn bool
 	IsGroupKey   bool
+
+	// Default is evaluated for the field omitted in insert queries.
+	Default parser.QueryExpression
+	NotNull bool
+	Unique  bool
+	// Checks are the check constraints declared on the field, and the table
+	// check constraints whose first field reference is the field.
+	Checks []parser.QueryExpression
 }
 
 var errFieldAmbiguous = errors.New("field ambiguous")
@@ -267,3 +276,144 @@ func (h Header) Copy() Header {
 	}
 	return header
 }
+
+// AddTableChecks adds each of the table check constraints to the first field that
+// the constraint refers to, or to the first field of the table if the constraint
+// refers to no field.
+func (h Header) AddTableChecks(checks []parser.QueryExpression) {
+	for _, check := range checks {
+		idx := -1
+		for _, ref := range fieldReferences(check) {
+			if i, err := h.FieldIndex(ref); err == nil {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			for i := range h {
+				if h[i].IsFromTable {
+					idx = i
+					break
+				}
+			}
+		}
+		if idx < 0 {
+			continue
+		}
+		h[idx].Checks = append(h[idx].Checks[:len(h[idx].Checks):len(h[idx].Checks)], check)
+	}
+}
+
+// RemoveChecks removes the check constraints that refer to any of the columns.
+// Column names must be in upper case.
+func (h Header) RemoveChecks(columns map[string]bool) {
+	for i := range h {
+		var checks []parser.QueryExpression
+		for _, check := range h[i].Checks {
+			refers := false
+			for _, ref := range fieldReferences(check) {
+				if columns[strings.ToUpper(ref.Column.Literal)] {
+					refers = true
+					break
+				}
+			}
+			if !refers {
+				checks = append(checks, check)
+			}
+		}
+		h[i].Checks = checks
+	}
+}
+
+// RenameChecks replaces the references to the column old in the check constraints
+// with the references to the column new.
+func (h Header) RenameChecks(old string, new parser.Identifier) {
+	for i := range h {
+		if len(h[i].Checks) < 1 {
+			continue
+		}
+		checks := make([]parser.QueryExpression, len(h[i].Checks))
+		for j, check := range h[i].Checks {
+			checks[j] = renameFieldReferences(check, old, new)
+		}
+		h[i].Checks = checks
+	}
+}
+
+// fieldReferences returns the field references in the expression.
+func fieldReferences(expr parser.QueryExpression) []parser.FieldReference {
+	var refs []parser.FieldReference
+
+	var walk func(v reflect.Value)
+	walk = func(v reflect.Value) {
+		switch v.Kind() {
+		case reflect.Interface:
+			if !v.IsNil() {
+				walk(v.Elem())
+			}
+		case reflect.Slice:
+			for i := 0; i < v.Len(); i++ {
+				walk(v.Index(i))
+			}
+		case reflect.Struct:
+			if v.CanInterface() {
+				if ref, ok := v.Interface().(parser.FieldReference); ok {
+					refs = append(refs, ref)
+					return
+				}
+			}
+			for i := 0; i < v.NumField(); i++ {
+				walk(v.Field(i))
+			}
+		}
+	}
+	walk(reflect.ValueOf(expr))
+
+	return refs
+}
+
+// renameFieldReferences returns a copy of the expression in which the references
+// to the column old are replaced with the references to the column new.
+func renameFieldReferences(expr parser.QueryExpression, old string, new parser.Identifier) parser.QueryExpression {
+	var rename func(v reflect.Value) reflect.Value
+	rename = func(v reflect.Value) reflect.Value {
+		switch v.Kind() {
+		case reflect.Interface:
+			if v.IsNil() {
+				return v
+			}
+			ret := reflect.New(v.Type()).Elem()
+			ret.Set(rename(v.Elem()))
+			return ret
+		case reflect.Slice:
+			if v.IsNil() {
+				return v
+			}
+			ret := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+			for i := 0; i < v.Len(); i++ {
+				ret.Index(i).Set(rename(v.Index(i)))
+			}
+			return ret
+		case reflect.Struct:
+			if !v.CanInterface() {
+				return v
+			}
+			if ref, ok := v.Interface().(parser.FieldReference); ok {
+				if strings.EqualFold(ref.Column.Literal, old) {
+					ref.Column = parser.Identifier{BaseExpr: ref.Column.BaseExpr, Literal: new.Literal, Quoted: new.Quoted}
+				}
+				return reflect.ValueOf(ref)
+			}
+			ret := reflect.New(v.Type()).Elem()
+			ret.Set(v)
+			for i := 0; i < v.NumField(); i++ {
+				if ret.Field(i).CanSet() {
+					ret.Field(i).Set(rename(v.Field(i)))
+				}
+			}
+			return ret
+		}
+		return v
+	}
+	return rename(reflect.ValueOf(&expr).Elem()).Interface().(parser.QueryExpression)
+}
@@ -574,3 +574,75 @@ func TestHeader_Update(t *testing.T) {
 		}
 	}
 }
+
+var headerChecksTestChecks = []parser.QueryExpression{
+	parser.Comparison{
+		LHS:      parser.NewIntegerValueFromString("1"),
+		RHS:      parser.Function{Name: "abs", Args: []parser.QueryExpression{parser.FieldReference{Column: parser.Identifier{Literal: "c2"}}}},
+		Operator: parser.Token{Token: '=', Literal: "="},
+	},
+	parser.Comparison{
+		LHS:      parser.NewIntegerValueFromString("1"),
+		RHS:      parser.NewIntegerValueFromString("1"),
+		Operator: parser.Token{Token: '=', Literal: "="},
+	},
+	parser.Comparison{
+		LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+		RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "C3"}},
+		Operator: parser.Token{Token: '=', Literal: "="},
+	},
+}
+
+func TestHeader_AddTableChecks(t *testing.T) {
+	h := NewHeader("t1", []string{"c1", "c2", "c3"})
+	h.AddTableChecks(headerChecksTestChecks)
+
+	expect := NewHeader("t1", []string{"c1", "c2", "c3"})
+	expect[0].Checks = []parser.QueryExpression{headerChecksTestChecks[1]}
+	expect[1].Checks = []parser.QueryExpression{headerChecksTestChecks[0]}
+	expect[2].Checks = []parser.QueryExpression{headerChecksTestChecks[2]}
+
+	if !reflect.DeepEqual(h, expect) {
+		t.Errorf("header = %v, want %v", h, expect)
+	}
+}
+
+func TestHeader_RemoveChecks(t *testing.T) {
+	h := NewHeader("t1", []string{"c1", "c3"})
+	h[0].Checks = headerChecksTestChecks[:2]
+	h[1].Checks = headerChecksTestChecks[2:]
+	h.RemoveChecks(map[string]bool{"C2": true})
+
+	expect := NewHeader("t1", []string{"c1", "c3"})
+	expect[0].Checks = []parser.QueryExpression{headerChecksTestChecks[1]}
+	expect[1].Checks = []parser.QueryExpression{headerChecksTestChecks[2]}
+
+	if !reflect.DeepEqual(h, expect) {
+		t.Errorf("header = %v, want %v", h, expect)
+	}
+}
+
+func TestHeader_RenameChecks(t *testing.T) {
+	h := NewHeader("t1", []string{"c1", "c2", "c3"})
+	h[0].Checks = headerChecksTestChecks[:2]
+	h[2].Checks = headerChecksTestChecks[2:]
+	h.RenameChecks("C2", parser.Identifier{Literal: "new name", Quoted: true})
+
+	expect := NewHeader("t1", []string{"c1", "c2", "c3"})
+	expect[0].Checks = []parser.QueryExpression{
+		parser.Comparison{
+			LHS:      parser.NewIntegerValueFromString("1"),
+			RHS:      parser.Function{Name: "abs", Args: []parser.QueryExpression{parser.FieldReference{Column: parser.Identifier{Literal: "new name", Quoted: true}}}},
+			Operator: parser.Token{Token: '=', Literal: "="},
+		},
+		headerChecksTestChecks[1],
+	}
+	expect[2].Checks = []parser.QueryExpression{headerChecksTestChecks[2]}
+
+	if !reflect.DeepEqual(h, expect) {
+		t.Errorf("header = %v, want %v", h, expect)
+	}
+	if s := headerChecksTestChecks[0].String(); s != "1 = ABS(c2)" {
+		t.Errorf("original check = %s, want the original check not to be changed", s)
+	}
+}
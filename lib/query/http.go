@@ -0,0 +1,133 @@
+package query
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+func isHttpUrl(s string) bool {
+	u, err := url.Parse(s)
+	if err != nil {
+		return false
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "http", "https":
+		return 0 < len(u.Host)
+	}
+	return false
+}
+
+// hasHttpScheme returns true if s begins with "http://" or "https://" regardless of case.
+func hasHttpScheme(s string) bool {
+	for _, scheme := range []string{"http://", "https://"} {
+		if len(scheme) <= len(s) && strings.EqualFold(s[:len(scheme)], scheme) {
+			return true
+		}
+	}
+	return false
+}
+
+// fetchUrl retrieves the resource of the url and returns its body and content type.
+// Redirects are followed, and responses other than 200 OK are returned as errors.
+func fetchUrl(ctx context.Context, flags *cmd.Flags, u parser.Url) ([]byte, string, error) {
+	if !isHttpUrl(u.Raw) {
+		return nil, "", NewHttpRequestError(u, "url must begin with http:// or https://")
+	}
+
+	headers, err := cmd.ParseHttpHeaders(flags.HttpHeaders)
+	if err != nil {
+		return nil, "", NewHttpRequestError(u, err.Error())
+	}
+
+	req, err := http.NewRequest(http.MethodGet, u.Raw, nil)
+	if err != nil {
+		return nil, "", NewHttpRequestError(u, err.Error())
+	}
+	req = req.WithContext(ctx)
+	for k, v := range headers {
+		if strings.EqualFold(k, "Host") {
+			req.Host = v
+		} else {
+			req.Header.Set(k, v)
+		}
+	}
+
+	client := &http.Client{
+		Timeout: time.Duration(flags.HttpTimeout * float64(time.Second)),
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, "", ConvertContextError(ctx.Err())
+		}
+		return nil, "", NewHttpRequestError(u, err.Error())
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, "", NewHttpStatusError(u, res.StatusCode)
+	}
+
+	var body io.Reader = res.Body
+	if 0 < flags.HttpMaxSize {
+		if flags.HttpMaxSize < res.ContentLength {
+			return nil, "", NewHttpSizeExceededError(u, flags.HttpMaxSize)
+		}
+		body = io.LimitReader(res.Body, flags.HttpMaxSize+1)
+	}
+
+	b, err := ioutil.ReadAll(body)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, "", ConvertContextError(ctx.Err())
+		}
+		return nil, "", NewHttpRequestError(u, err.Error())
+	}
+	if 0 < flags.HttpMaxSize && flags.HttpMaxSize < int64(len(b)) {
+		return nil, "", NewHttpSizeExceededError(u, flags.HttpMaxSize)
+	}
+
+	return b, res.Header.Get("Content-Type"), nil
+}
+
+// detectUrlFormat detects the format from the extension of the url path,
+// and then from the content type if the extension does not indicate any format.
+func detectUrlFormat(rawUrl string, contentType string, defaultFormat cmd.Format) cmd.Format {
+	if u, err := url.Parse(rawUrl); err == nil {
+		if format := detectFormat(path.Base(u.Path), cmd.AutoSelect); format != cmd.AutoSelect {
+			return format
+		}
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return defaultFormat
+	}
+
+	switch strings.ToLower(mediaType) {
+	case "text/csv":
+		return cmd.CSV
+	case "text/tab-separated-values":
+		return cmd.TSV
+	case "application/json", "text/json":
+		return cmd.JSON
+	case "application/x-ndjson", "application/ndjson", "application/jsonl", "application/x-jsonlines":
+		return cmd.NDJSON
+	case "text/x-ltsv":
+		return cmd.LTSV
+	}
+	return defaultFormat
+}
@@ -0,0 +1,214 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+func newTestHttpServer() *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/table.csv", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/csv")
+		_, _ = fmt.Fprint(w, "column1,column2\n1,str1\n2,str2\n")
+	})
+	mux.HandleFunc("/TABLE.csv", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/csv")
+		_, _ = fmt.Fprint(w, "column1\nupper\n")
+	})
+	mux.HandleFunc("/auth", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		_, _ = fmt.Fprint(w, "[{\"column1\":1}]")
+	})
+	mux.HandleFunc("/redirect", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/table.csv", http.StatusFound)
+	})
+	return httptest.NewServer(mux)
+}
+
+var fetchUrlTests = []struct {
+	Name        string
+	Path        string
+	Url         string
+	HttpMaxSize int64
+	HttpHeaders string
+	Result      string
+	ContentType string
+	Error       string
+}{
+	{
+		Name:        "Fetch Url",
+		Path:        "/table.csv",
+		Result:      "column1,column2\n1,str1\n2,str2\n",
+		ContentType: "text/csv",
+	},
+	{
+		Name:        "Fetch Url With Headers",
+		Path:        "/auth",
+		HttpHeaders: "{\"Authorization\": \"Bearer token\"}",
+		Result:      "[{\"column1\":1}]",
+		ContentType: "application/json; charset=utf-8",
+	},
+	{
+		Name:  "Fetch Url Status Error",
+		Path:  "/auth",
+		Error: "failed to fetch '%s/auth': status code 401",
+	},
+	{
+		Name:  "Fetch Url Not Found Error",
+		Path:  "/notexist",
+		Error: "failed to fetch '%s/notexist': status code 404",
+	},
+	{
+		Name:        "Fetch Url Following Redirect",
+		Path:        "/redirect",
+		Result:      "column1,column2\n1,str1\n2,str2\n",
+		ContentType: "text/csv",
+	},
+	{
+		Name:        "Fetch Url Size Exceeded Error",
+		Path:        "/table.csv",
+		HttpMaxSize: 10,
+		Error:       "size of '%s/table.csv' exceeds the limit of 10 bytes",
+	},
+	{
+		Name:  "Fetch Url Invalid Scheme Error",
+		Url:   "ftp://example.com/table.csv",
+		Error: "failed to fetch 'ftp://example.com/table.csv': url must begin with http:// or https://",
+	},
+}
+
+func TestFetchUrl(t *testing.T) {
+	server := newTestHttpServer()
+	defer server.Close()
+
+	flags := cmd.NewFlags(nil)
+	ctx := context.Background()
+
+	for _, v := range fetchUrlTests {
+		flags.SetHttpMaxSize(v.HttpMaxSize)
+		_ = flags.SetHttpHeaders(v.HttpHeaders)
+
+		u := v.Url
+		if len(u) < 1 {
+			u = server.URL + v.Path
+		}
+
+		b, contentType, err := fetchUrl(ctx, flags, parser.Url{Raw: u})
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if expect := strings.Replace(v.Error, "%s", server.URL, 1); err.Error() != expect {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), expect)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if string(b) != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, string(b), v.Result)
+		}
+		if contentType != v.ContentType {
+			t.Errorf("%s: content type = %q, want %q", v.Name, contentType, v.ContentType)
+		}
+	}
+}
+
+var detectUrlFormatTests = []struct {
+	Url         string
+	ContentType string
+	Result      cmd.Format
+}{
+	{
+		Url:         "https://example.com/table.tsv?key=value",
+		ContentType: "text/csv",
+		Result:      cmd.TSV,
+	},
+	{
+		Url:         "https://example.com/table",
+		ContentType: "application/json; charset=utf-8",
+		Result:      cmd.JSON,
+	},
+	{
+		Url:         "https://example.com/table",
+		ContentType: "application/x-ndjson",
+		Result:      cmd.NDJSON,
+	},
+	{
+		Url:         "https://example.com/table",
+		ContentType: "text/plain",
+		Result:      cmd.LTSV,
+	},
+}
+
+func TestDetectUrlFormat(t *testing.T) {
+	for _, v := range detectUrlFormatTests {
+		result := detectUrlFormat(v.Url, v.ContentType, cmd.LTSV)
+		if result != v.Result {
+			t.Errorf("format = %s, want %s for %q, %q", result, v.Result, v.Url, v.ContentType)
+		}
+	}
+}
+
+var urlTableNotUpdatableTests = []struct {
+	Name  string
+	Query string
+}{
+	{
+		Name:  "Update Url Table",
+		Query: "UPDATE u SET column2 = 'updated' FROM '%s/table.csv' u",
+	},
+	{
+		Name:  "Delete Url Table",
+		Query: "DELETE u FROM '%s/table.csv' u WHERE column1 = 1",
+	},
+	{
+		Name:  "Delete Url Table Without Table Names",
+		Query: "DELETE FROM '%s/table.csv' WHERE column1 = 1",
+	},
+}
+
+func TestUrlTableNotUpdatable(t *testing.T) {
+	server := newTestHttpServer()
+	defer func() {
+		server.Close()
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		initFlag(TestTx.Flags)
+	}()
+
+	ctx := context.Background()
+	for _, v := range urlTableNotUpdatableTests {
+		statements, _, err := parser.Parse(strings.Replace(v.Query, "%s", server.URL, 1), "", TestTx.Flags.DatetimeFormat, false, TestTx.Flags.AnsiQuotes)
+		if err != nil {
+			t.Fatalf("%s: unexpected error %q", v.Name, err)
+		}
+
+		scope := NewReferenceScope(TestTx)
+		switch query := statements[0].(type) {
+		case parser.UpdateQuery:
+			_, _, _, err = Update(ctx, scope, query)
+		case parser.DeleteQuery:
+			_, _, _, err = Delete(ctx, scope, query)
+		}
+
+		if err == nil {
+			t.Errorf("%s: no error, want error %q", v.Name, ErrMsgUrlNotUpdatable)
+			continue
+		}
+		if _, ok := err.(*FileNotUpdatableError); !ok || !strings.HasSuffix(err.Error(), ErrMsgUrlNotUpdatable) {
+			t.Errorf("%s: error %q, want error %q", v.Name, err, ErrMsgUrlNotUpdatable)
+		}
+	}
+}
@@ -10,6 +10,21 @@ import (
 type InlineTableMap map[string]*View
 
 func (it InlineTableMap) Set(ctx context.Context, scope *ReferenceScope, inlineTable parser.InlineTable) error {
+	if inlineTable.Search != nil {
+		search := inlineTable.Search.(parser.SearchClause)
+		if !search.IsDepthFirst() && !search.IsBreadthFirst() {
+			return NewInvalidSearchTypeError(search.Type)
+		}
+		if set, ok := inlineTable.Query.SelectEntity.(parser.SelectSet); !ok || !inlineTable.IsRecursive() || set.Operator.Token != parser.UNION {
+			return NewInvalidSearchClauseError(inlineTable.Search, inlineTable.Name)
+		}
+	}
+	if inlineTable.Cycle != nil {
+		if _, ok := inlineTable.Query.SelectEntity.(parser.SelectSet); !ok || !inlineTable.IsRecursive() {
+			return NewInvalidCycleClauseError(inlineTable.Cycle, inlineTable.Name)
+		}
+	}
+
 	scope = scope.CreateNode()
 	if inlineTable.IsRecursive() {
 		if scope.RecursiveTable != nil {
@@ -25,7 +40,15 @@ func (it InlineTableMap) Set(ctx context.Context, scope *ReferenceScope, inlineT
 		return err
 	}
 
-	err = view.Header.Update(inlineTable.Name.Literal, inlineTable.Fields)
+	fields := inlineTable.Fields
+	if fields != nil && inlineTable.Search != nil {
+		fields = append(fields[:len(fields):len(fields)], inlineTable.Search.(parser.SearchClause).SetColumn)
+	}
+	if fields != nil && inlineTable.Cycle != nil {
+		fields = append(fields[:len(fields):len(fields)], inlineTable.Cycle.(parser.CycleClause).SetColumn)
+	}
+
+	err = view.Header.Update(inlineTable.Name.Literal, fields)
 	if err != nil {
 		if _, ok := err.(*FieldLengthNotMatchError); ok {
 			return NewInlineTableFieldLengthError(inlineTable.Query, inlineTable.Name, len(inlineTable.Fields))
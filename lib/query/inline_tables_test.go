@@ -154,6 +154,476 @@ var inlineTableMapSetTests = []struct {
 			},
 		},
 	},
+	{
+		Name: "InlineTableMap Set Recursive Table With Cycle Clause",
+		Expr: parser.InlineTable{
+			Recursive: parser.Token{Token: parser.RECURSIVE, Literal: "recursive"},
+			Name:      parser.Identifier{Literal: "it_cycle"},
+			Fields: []parser.QueryExpression{
+				parser.Identifier{Literal: "n"},
+			},
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectSet{
+					LHS: parser.SelectEntity{
+						SelectClause: parser.SelectClause{
+							Fields: []parser.QueryExpression{
+								parser.Field{Object: parser.NewIntegerValueFromString("1")},
+							},
+						},
+					},
+					Operator: parser.Token{Token: parser.UNION, Literal: "union"},
+					All:      parser.Token{Token: parser.ALL, Literal: "all"},
+					RHS: parser.SelectEntity{
+						SelectClause: parser.SelectClause{
+							Fields: []parser.QueryExpression{
+								parser.Field{
+									Object: parser.Arithmetic{
+										LHS: parser.Arithmetic{
+											LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "n"}},
+											RHS:      parser.NewIntegerValueFromString("3"),
+											Operator: parser.Token{Token: '%', Literal: "%"},
+										},
+										RHS:      parser.NewIntegerValueFromString("1"),
+										Operator: parser.Token{Token: '+', Literal: "+"},
+									},
+								},
+							},
+						},
+						FromClause: parser.FromClause{
+							Tables: []parser.QueryExpression{
+								parser.Table{Object: parser.Identifier{Literal: "it_cycle"}},
+							},
+						},
+					},
+				},
+			},
+			Cycle: parser.CycleClause{
+				Columns: []parser.QueryExpression{
+					parser.Identifier{Literal: "n"},
+				},
+				SetColumn: parser.Identifier{Literal: "is_cycle"},
+			},
+		},
+		Result: InlineTableMap{
+			"IT": &View{
+				Header: NewHeader("it", []string{"c1", "c2", "num"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{
+						value.NewString("1"),
+						value.NewString("str1"),
+						value.NewInteger(1),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("2"),
+						value.NewString("str2"),
+						value.NewInteger(1),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("3"),
+						value.NewString("str3"),
+						value.NewInteger(1),
+					}),
+				},
+			},
+			"IT_RECURSIVE": &View{
+				Header: []HeaderField{
+					{
+						View:        "it_recursive",
+						Column:      "n",
+						Number:      1,
+						IsFromTable: true,
+					},
+				},
+				RecordSet: []Record{
+					NewRecord([]value.Primary{
+						value.NewInteger(1),
+					}),
+					NewRecord([]value.Primary{
+						value.NewInteger(2),
+					}),
+					NewRecord([]value.Primary{
+						value.NewInteger(3),
+					}),
+				},
+			},
+			"IT_CYCLE": &View{
+				Header: NewHeader("it_cycle", []string{"n", "is_cycle"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{
+						value.NewInteger(1),
+						value.NewBoolean(false),
+					}),
+					NewRecord([]value.Primary{
+						value.NewInteger(2),
+						value.NewBoolean(false),
+					}),
+					NewRecord([]value.Primary{
+						value.NewInteger(3),
+						value.NewBoolean(false),
+					}),
+					NewRecord([]value.Primary{
+						value.NewInteger(1),
+						value.NewBoolean(true),
+					}),
+				},
+			},
+		},
+	},
+	{
+		Name: "InlineTableMap Set Recursive Table With Cycle Clause Detecting Cycles in Each Path",
+		Expr: parser.InlineTable{
+			Recursive: parser.Token{Token: parser.RECURSIVE, Literal: "recursive"},
+			Name:      parser.Identifier{Literal: "it_diamond"},
+			Fields: []parser.QueryExpression{
+				parser.Identifier{Literal: "node"},
+			},
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectSet{
+					LHS: parser.SelectEntity{
+						SelectClause: parser.SelectClause{
+							Fields: []parser.QueryExpression{
+								parser.Field{Object: parser.NewStringValue("A")},
+							},
+						},
+					},
+					Operator: parser.Token{Token: parser.UNION, Literal: "union"},
+					All:      parser.Token{Token: parser.ALL, Literal: "all"},
+					RHS: parser.SelectEntity{
+						SelectClause: parser.SelectClause{
+							Fields: []parser.QueryExpression{
+								parser.Field{Object: parser.FieldReference{View: parser.Identifier{Literal: "e"}, Column: parser.Identifier{Literal: "dst"}}},
+							},
+						},
+						FromClause: parser.FromClause{
+							Tables: []parser.QueryExpression{
+								parser.Table{Object: parser.Join{
+									Table: parser.Table{
+										Object: parser.Identifier{Literal: "it_diamond"},
+									},
+									JoinTable: parser.Table{
+										Object: parser.Identifier{Literal: "table_diamond"},
+										Alias:  parser.Identifier{Literal: "e"},
+									},
+									Condition: parser.JoinCondition{
+										On: parser.Comparison{
+											LHS:      parser.FieldReference{View: parser.Identifier{Literal: "it_diamond"}, Column: parser.Identifier{Literal: "node"}},
+											RHS:      parser.FieldReference{View: parser.Identifier{Literal: "e"}, Column: parser.Identifier{Literal: "src"}},
+											Operator: parser.Token{Token: '=', Literal: "="},
+										},
+									},
+								}},
+							},
+						},
+					},
+				},
+			},
+			Cycle: parser.CycleClause{
+				Columns: []parser.QueryExpression{
+					parser.Identifier{Literal: "node"},
+				},
+				SetColumn: parser.Identifier{Literal: "is_cycle"},
+			},
+		},
+		Result: InlineTableMap{
+			"IT": &View{
+				Header: NewHeader("it", []string{"c1", "c2", "num"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{
+						value.NewString("1"),
+						value.NewString("str1"),
+						value.NewInteger(1),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("2"),
+						value.NewString("str2"),
+						value.NewInteger(1),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("3"),
+						value.NewString("str3"),
+						value.NewInteger(1),
+					}),
+				},
+			},
+			"IT_RECURSIVE": &View{
+				Header: []HeaderField{
+					{
+						View:        "it_recursive",
+						Column:      "n",
+						Number:      1,
+						IsFromTable: true,
+					},
+				},
+				RecordSet: []Record{
+					NewRecord([]value.Primary{
+						value.NewInteger(1),
+					}),
+					NewRecord([]value.Primary{
+						value.NewInteger(2),
+					}),
+					NewRecord([]value.Primary{
+						value.NewInteger(3),
+					}),
+				},
+			},
+			"IT_CYCLE": &View{
+				Header: NewHeader("it_cycle", []string{"n", "is_cycle"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{
+						value.NewInteger(1),
+						value.NewBoolean(false),
+					}),
+					NewRecord([]value.Primary{
+						value.NewInteger(2),
+						value.NewBoolean(false),
+					}),
+					NewRecord([]value.Primary{
+						value.NewInteger(3),
+						value.NewBoolean(false),
+					}),
+					NewRecord([]value.Primary{
+						value.NewInteger(1),
+						value.NewBoolean(true),
+					}),
+				},
+			},
+			"IT_DIAMOND": &View{
+				Header: NewHeader("it_diamond", []string{"node", "is_cycle"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{
+						value.NewString("A"),
+						value.NewBoolean(false),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("B"),
+						value.NewBoolean(false),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("C"),
+						value.NewBoolean(false),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("D"),
+						value.NewBoolean(false),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("D"),
+						value.NewBoolean(false),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("A"),
+						value.NewBoolean(true),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("A"),
+						value.NewBoolean(true),
+					}),
+				},
+			},
+		},
+	},
+	{
+		Name: "InlineTableMap Set Recursive Table With Search Clause",
+		Expr: parser.InlineTable{
+			Recursive: parser.Token{Token: parser.RECURSIVE, Literal: "recursive"},
+			Name:      parser.Identifier{Literal: "it_search"},
+			Fields: []parser.QueryExpression{
+				parser.Identifier{Literal: "n"},
+			},
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectSet{
+					LHS: parser.SelectEntity{
+						SelectClause: parser.SelectClause{
+							Fields: []parser.QueryExpression{
+								parser.Field{Object: parser.NewIntegerValueFromString("1")},
+							},
+						},
+					},
+					Operator: parser.Token{Token: parser.UNION, Literal: "union"},
+					All:      parser.Token{Token: parser.ALL, Literal: "all"},
+					RHS: parser.SelectEntity{
+						SelectClause: parser.SelectClause{
+							Fields: []parser.QueryExpression{
+								parser.Field{
+									Object: parser.Arithmetic{
+										LHS: parser.Arithmetic{
+											LHS: parser.Arithmetic{
+												LHS:      parser.FieldReference{View: parser.Identifier{Literal: "it_search"}, Column: parser.Identifier{Literal: "n"}},
+												RHS:      parser.NewIntegerValueFromString("2"),
+												Operator: parser.Token{Token: '*', Literal: "*"},
+											},
+											RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+											Operator: parser.Token{Token: '+', Literal: "+"},
+										},
+										RHS:      parser.NewIntegerValueFromString("1"),
+										Operator: parser.Token{Token: '-', Literal: "-"},
+									},
+								},
+							},
+						},
+						FromClause: parser.FromClause{
+							Tables: []parser.QueryExpression{
+								parser.Table{Object: parser.Identifier{Literal: "it_search"}},
+								parser.Table{Object: parser.Identifier{Literal: "table1"}},
+							},
+						},
+						WhereClause: parser.WhereClause{
+							Filter: parser.Logic{
+								LHS: parser.Comparison{
+									LHS:      parser.FieldReference{View: parser.Identifier{Literal: "it_search"}, Column: parser.Identifier{Literal: "n"}},
+									RHS:      parser.NewIntegerValueFromString("4"),
+									Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: "<"},
+								},
+								RHS: parser.Comparison{
+									LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+									RHS:      parser.NewIntegerValueFromString("3"),
+									Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: "<"},
+								},
+								Operator: parser.Token{Token: parser.AND, Literal: "and"},
+							},
+						},
+					},
+				},
+			},
+			Search: parser.SearchClause{
+				Type: parser.Identifier{Literal: "depth"},
+				Columns: []parser.QueryExpression{
+					parser.Identifier{Literal: "n"},
+				},
+				SetColumn: parser.Identifier{Literal: "seq"},
+			},
+		},
+		Result: InlineTableMap{
+			"IT": &View{
+				Header: NewHeader("it", []string{"c1", "c2", "num"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{
+						value.NewString("1"),
+						value.NewString("str1"),
+						value.NewInteger(1),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("2"),
+						value.NewString("str2"),
+						value.NewInteger(1),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("3"),
+						value.NewString("str3"),
+						value.NewInteger(1),
+					}),
+				},
+			},
+			"IT_RECURSIVE": &View{
+				Header: []HeaderField{
+					{
+						View:        "it_recursive",
+						Column:      "n",
+						Number:      1,
+						IsFromTable: true,
+					},
+				},
+				RecordSet: []Record{
+					NewRecord([]value.Primary{
+						value.NewInteger(1),
+					}),
+					NewRecord([]value.Primary{
+						value.NewInteger(2),
+					}),
+					NewRecord([]value.Primary{
+						value.NewInteger(3),
+					}),
+				},
+			},
+			"IT_CYCLE": &View{
+				Header: NewHeader("it_cycle", []string{"n", "is_cycle"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{
+						value.NewInteger(1),
+						value.NewBoolean(false),
+					}),
+					NewRecord([]value.Primary{
+						value.NewInteger(2),
+						value.NewBoolean(false),
+					}),
+					NewRecord([]value.Primary{
+						value.NewInteger(3),
+						value.NewBoolean(false),
+					}),
+					NewRecord([]value.Primary{
+						value.NewInteger(1),
+						value.NewBoolean(true),
+					}),
+				},
+			},
+			"IT_DIAMOND": &View{
+				Header: NewHeader("it_diamond", []string{"node", "is_cycle"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{
+						value.NewString("A"),
+						value.NewBoolean(false),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("B"),
+						value.NewBoolean(false),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("C"),
+						value.NewBoolean(false),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("D"),
+						value.NewBoolean(false),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("D"),
+						value.NewBoolean(false),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("A"),
+						value.NewBoolean(true),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("A"),
+						value.NewBoolean(true),
+					}),
+				},
+			},
+			"IT_SEARCH": &View{
+				Header: NewHeader("it_search", []string{"n", "seq"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{
+						value.NewInteger(1),
+						value.NewInteger(1),
+					}),
+					NewRecord([]value.Primary{
+						value.NewInteger(2),
+						value.NewInteger(2),
+					}),
+					NewRecord([]value.Primary{
+						value.NewInteger(4),
+						value.NewInteger(3),
+					}),
+					NewRecord([]value.Primary{
+						value.NewInteger(5),
+						value.NewInteger(4),
+					}),
+					NewRecord([]value.Primary{
+						value.NewInteger(3),
+						value.NewInteger(5),
+					}),
+					NewRecord([]value.Primary{
+						value.NewInteger(6),
+						value.NewInteger(6),
+					}),
+					NewRecord([]value.Primary{
+						value.NewInteger(7),
+						value.NewInteger(7),
+					}),
+				},
+			},
+		},
+	},
 	{
 		Name: "InlineTableMap Set Redefined Error",
 		Expr: parser.InlineTable{
@@ -257,6 +727,74 @@ var inlineTableMapSetTests = []struct {
 		},
 		Error: "field name c1 is a duplicate",
 	},
+	{
+		Name: "InlineTableMap Set Cycle Clause Error",
+		Expr: parser.InlineTable{
+			Name: parser.Identifier{Literal: "it2"},
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.NewIntegerValueFromString("1")},
+						},
+					},
+				},
+			},
+			Cycle: parser.CycleClause{
+				Columns: []parser.QueryExpression{
+					parser.Identifier{Literal: "n"},
+				},
+				SetColumn: parser.Identifier{Literal: "is_cycle"},
+			},
+		},
+		Error: "cycle clause of inline table it2 requires a recursive query combined with a set operator",
+	},
+	{
+		Name: "InlineTableMap Set Search Clause Error",
+		Expr: parser.InlineTable{
+			Name: parser.Identifier{Literal: "it2"},
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.NewIntegerValueFromString("1")},
+						},
+					},
+				},
+			},
+			Search: parser.SearchClause{
+				Type: parser.Identifier{Literal: "breadth"},
+				Columns: []parser.QueryExpression{
+					parser.Identifier{Literal: "n"},
+				},
+				SetColumn: parser.Identifier{Literal: "seq"},
+			},
+		},
+		Error: "search clause of inline table it2 requires a recursive query combined with UNION",
+	},
+	{
+		Name: "InlineTableMap Set Search Type Error",
+		Expr: parser.InlineTable{
+			Name: parser.Identifier{Literal: "it2"},
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.NewIntegerValueFromString("1")},
+						},
+					},
+				},
+			},
+			Search: parser.SearchClause{
+				Type: parser.Identifier{Literal: "invalid"},
+				Columns: []parser.QueryExpression{
+					parser.Identifier{Literal: "n"},
+				},
+				SetColumn: parser.Identifier{Literal: "seq"},
+			},
+		},
+		Error: "search type invalid is invalid",
+	},
 }
 
 func TestInlineTableMap_Set(t *testing.T) {
@@ -122,6 +122,7 @@ func CrossJoin(ctx context.Context, scope *ReferenceScope, view *View, joinView
 	view.Header = mergedHeader
 	view.RecordSet = records
 	view.FileInfo = nil
+	view.recordOrigins = nil
 	return nil
 }
 
@@ -209,6 +210,7 @@ func InnerJoin(ctx context.Context, scope *ReferenceScope, view *View, joinView
 	view.Header = mergedHeader
 	view.RecordSet = MergeRecordSetList(recordsList)
 	view.FileInfo = nil
+	view.recordOrigins = nil
 	return nil
 }
 
@@ -380,6 +382,7 @@ func OuterJoin(ctx context.Context, scope *ReferenceScope, view *View, joinView
 	view.Header = mergedHeader
 	view.RecordSet = MergeRecordSetList(recordsList)
 	view.FileInfo = nil
+	view.recordOrigins = nil
 	return nil
 }
 
@@ -0,0 +1,122 @@
+package query
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/json"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
+	txjson "github.com/mithrandie/go-text/json"
+)
+
+const NdjsonFlatteningDepth = 5
+
+// NdjsonReader reads records from a newline-delimited json text one line
+// at a time. Each line must be a json object, and the header grows as keys
+// that have not appeared in the preceding lines are found.
+type NdjsonReader struct {
+	MaxDepth int
+
+	reader *bufio.Reader
+	line   int
+
+	Header      []string
+	headerIndex map[string]int
+
+	EscapeType        txjson.EscapeType
+	DetectedLineBreak text.LineBreak
+}
+
+func NewNdjsonReader(r io.Reader) *NdjsonReader {
+	return &NdjsonReader{
+		MaxDepth:    NdjsonFlatteningDepth,
+		reader:      bufio.NewReader(r),
+		line:        0,
+		Header:      make([]string, 0, 10),
+		headerIndex: make(map[string]int, 10),
+		EscapeType:  txjson.Backslash,
+	}
+}
+
+func (r *NdjsonReader) newError(s string) error {
+	return errors.New(fmt.Sprintf("line %d: %s", r.line, s))
+}
+
+// Read returns the values of the next object ordered by the header.
+// The returned slice is shorter than the final header when keys are added
+// by later lines.
+func (r *NdjsonReader) Read() ([]value.Primary, error) {
+	line, err := r.readLine()
+	if err != nil {
+		return nil, err
+	}
+
+	structure, escapeType, err := txjson.ParseJson(line, true)
+	if err != nil {
+		if se, ok := err.(*txjson.SyntaxError); ok {
+			return nil, errors.New(fmt.Sprintf("line %d, column %d: %s", r.line, se.Column, se.Error()))
+		}
+		return nil, r.newError(err.Error())
+	}
+	obj, ok := structure.(txjson.Object)
+	if !ok {
+		return nil, r.newError("json value must be an object")
+	}
+	if r.EscapeType < escapeType {
+		r.EscapeType = escapeType
+	}
+
+	keys, values := json.FlattenObject(obj, r.MaxDepth)
+	for _, key := range keys {
+		if _, ok := r.headerIndex[key]; !ok {
+			r.headerIndex[key] = len(r.Header)
+			r.Header = append(r.Header, key)
+		}
+	}
+
+	record := make([]value.Primary, len(r.Header))
+	for i := range values {
+		record[r.headerIndex[keys[i]]] = values[i]
+	}
+	for i := range record {
+		if record[i] == nil {
+			record[i] = value.NewNull()
+		}
+	}
+	return record, nil
+}
+
+func (r *NdjsonReader) readLine() (string, error) {
+	for {
+		line, err := r.reader.ReadString('\n')
+		if err != nil && (err != io.EOF || len(line) < 1) {
+			return "", err
+		}
+		r.line++
+
+		if r.line == 1 {
+			line = strings.TrimPrefix(line, text.UTF8BOM)
+		}
+
+		if strings.HasSuffix(line, "\r\n") {
+			line = line[:len(line)-2]
+			if r.DetectedLineBreak == "" {
+				r.DetectedLineBreak = text.CRLF
+			}
+		} else if strings.HasSuffix(line, "\n") {
+			line = line[:len(line)-1]
+			if r.DetectedLineBreak == "" {
+				r.DetectedLineBreak = text.LF
+			}
+		}
+
+		if 0 < len(strings.TrimSpace(line)) {
+			return line, nil
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package query
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/go-text/json"
+)
+
+var ndjsonReaderReadTests = []struct {
+	Name       string
+	Input      string
+	MaxDepth   int
+	Result     [][]value.Primary
+	Header     []string
+	EscapeType json.EscapeType
+	LineBreak  text.LineBreak
+	Error      string
+}{
+	{
+		Name:  "Union of Keys",
+		Input: "{\"a\":1,\"b\":\"str\"}\n\n{\"b\":\"\\u005c\",\"c\":true}\n",
+		Result: [][]value.Primary{
+			{value.NewInteger(1), value.NewString("str")},
+			{value.NewNull(), value.NewString("\\"), value.NewBoolean(true)},
+		},
+		Header:     []string{"a", "b", "c"},
+		EscapeType: json.HexDigits,
+		LineBreak:  text.LF,
+	},
+	{
+		Name:     "Flatten Nested Objects",
+		Input:    "\xef\xbb\xbf{\"a\":{\"b\":{\"c\":1}},\"d\":[1,2]}\r\n{\"a\":{\"b\":null}}",
+		MaxDepth: 2,
+		Result: [][]value.Primary{
+			{value.NewString("{\"c\":1}"), value.NewString("[1,2]")},
+			{value.NewNull(), value.NewNull()},
+		},
+		Header:     []string{"a.b", "d"},
+		EscapeType: json.Backslash,
+		LineBreak:  text.CRLF,
+	},
+	{
+		Name:  "Syntax Error",
+		Input: "{\"a\":1}\n{\"a\":2\n",
+		Error: "line 2, column 6: unexpected termination",
+	},
+	{
+		Name:  "Not an Object",
+		Input: "{\"a\":1}\n\n[1]\n",
+		Error: "line 3: json value must be an object",
+	},
+}
+
+func TestNdjsonReader_Read(t *testing.T) {
+	for _, v := range ndjsonReaderReadTests {
+		r := NewNdjsonReader(strings.NewReader(v.Input))
+		if 0 < v.MaxDepth {
+			r.MaxDepth = v.MaxDepth
+		}
+
+		var records [][]value.Primary
+		var err error
+		for {
+			var record []value.Primary
+			record, err = r.Read()
+			if err != nil {
+				break
+			}
+			records = append(records, record)
+		}
+
+		if err != io.EOF {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(records, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, records, v.Result)
+		}
+		if !reflect.DeepEqual(r.Header, v.Header) {
+			t.Errorf("%s: header = %q, want %q", v.Name, r.Header, v.Header)
+		}
+		if r.EscapeType != v.EscapeType {
+			t.Errorf("%s: escape type = %d, want %d", v.Name, r.EscapeType, v.EscapeType)
+		}
+		if r.DetectedLineBreak != v.LineBreak {
+			t.Errorf("%s: line break = %q, want %q", v.Name, r.DetectedLineBreak, v.LineBreak)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package query
+
+import (
+	"context"
+	"io"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parquet"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+var parquetCodecs = map[cmd.ParquetCompression]parquet.Codec{
+	cmd.SnappyParquetCompression: parquet.Snappy,
+	cmd.ZstdParquetCompression:   parquet.Zstd,
+	cmd.NoParquetCompression:     parquet.Uncompressed,
+}
+
+// encodeParquet writes a result set in the Apache Parquet format.
+//
+// The type of each column is inferred from the values in the same way as
+// the typed header. Integers are written as int64, floats and mixtures of
+// integers and floats as double, and datetimes as timestamps in milliseconds.
+// Any other column is written as strings. If ParquetAllStrings is set, all
+// columns are written as strings.
+func encodeParquet(ctx context.Context, fp io.Writer, view *View, options cmd.ExportOptions) error {
+	columns := make([]parquet.Column, view.FieldLen())
+	for i := range view.Header {
+		if ctx.Err() != nil {
+			return ConvertContextError(ctx.Err())
+		}
+
+		t := parquet.String
+		if !options.ParquetAllStrings {
+			switch columnTypeName(view, i) {
+			case "integer":
+				t = parquet.Int64
+			case "float":
+				t = parquet.Double
+			case "datetime":
+				t = parquet.TimestampMillis
+			}
+		}
+
+		values := make([]interface{}, view.RecordLen())
+		for j := range view.RecordSet {
+			values[j] = parquetValue(view.RecordSet[j][i][0], t)
+		}
+
+		columns[i] = parquet.Column{
+			Name:   view.Header[i].Column,
+			Type:   t,
+			Values: values,
+		}
+	}
+
+	if err := parquet.Write(fp, columns, parquetCodecs[options.ParquetCompression]); err != nil {
+		return NewSystemError(err.Error())
+	}
+	return nil
+}
+
+func parquetValue(p value.Primary, t parquet.Type) interface{} {
+	if value.IsNull(p) {
+		return nil
+	}
+	if tr, ok := p.(*value.Ternary); ok && tr.Ternary() == ternary.UNKNOWN {
+		return nil
+	}
+
+	switch t {
+	case parquet.Int64:
+		return p.(*value.Integer).Raw()
+	case parquet.Double:
+		if i, ok := p.(*value.Integer); ok {
+			return float64(i.Raw())
+		}
+		return p.(*value.Float).Raw()
+	case parquet.TimestampMillis:
+		return p.(*value.Datetime).Raw()
+	}
+
+	s, _, _ := ConvertFieldContents(p, false)
+	return s
+}
@@ -0,0 +1,97 @@
+package query
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parquet"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+var encodeParquetTests = []struct {
+	Name               string
+	View               *View
+	ParquetCompression cmd.ParquetCompression
+	ParquetAllStrings  bool
+	Columns            []parquet.Column
+	Codec              parquet.Codec
+}{
+	{
+		Name: "Parquet",
+		View: &View{
+			Header: NewHeader("test", []string{"int", "num", "dt", "str", "mixed", "null"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewInteger(2), value.NewDatetimeFromString("2012-02-03T09:18:15.123Z", nil), value.NewString("a"), value.NewInteger(1), value.NewNull()}),
+				NewRecord([]value.Primary{value.NewNull(), value.NewFloat(2.5), value.NewNull(), value.NewNull(), value.NewString("b"), value.NewNull()}),
+				NewRecord([]value.Primary{value.NewInteger(3), value.NewNull(), value.NewDatetimeFromString("2012-02-04T00:00:00+09:00", nil), value.NewString(""), value.NewTernary(ternary.UNKNOWN), value.NewNull()}),
+			},
+		},
+		ParquetCompression: cmd.SnappyParquetCompression,
+		Columns: []parquet.Column{
+			{Name: "int", Type: parquet.Int64, Values: []interface{}{int64(1), nil, int64(3)}},
+			{Name: "num", Type: parquet.Double, Values: []interface{}{float64(2), 2.5, nil}},
+			{Name: "dt", Type: parquet.TimestampMillis, Values: []interface{}{time.Date(2012, 2, 3, 9, 18, 15, 123000000, time.UTC), nil, time.Date(2012, 2, 3, 15, 0, 0, 0, time.UTC)}},
+			{Name: "str", Type: parquet.String, Values: []interface{}{"a", nil, ""}},
+			{Name: "mixed", Type: parquet.String, Values: []interface{}{"1", "b", nil}},
+			{Name: "null", Type: parquet.String, Values: []interface{}{nil, nil, nil}},
+		},
+		Codec: parquet.Snappy,
+	},
+	{
+		Name: "Parquet All Strings",
+		View: &View{
+			Header: NewHeader("test", []string{"int", "bool"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewBoolean(true)}),
+				NewRecord([]value.Primary{value.NewNull(), value.NewTernary(ternary.FALSE)}),
+			},
+		},
+		ParquetCompression: cmd.ZstdParquetCompression,
+		ParquetAllStrings:  true,
+		Columns: []parquet.Column{
+			{Name: "int", Type: parquet.String, Values: []interface{}{"1", nil}},
+			{Name: "bool", Type: parquet.String, Values: []interface{}{"true", "false"}},
+		},
+		Codec: parquet.Zstd,
+	},
+	{
+		Name: "Parquet Empty",
+		View: &View{
+			Header:    NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{},
+		},
+		ParquetCompression: cmd.NoParquetCompression,
+		Columns: []parquet.Column{
+			{Name: "c1", Type: parquet.String, Values: []interface{}{}},
+		},
+		Codec: parquet.Uncompressed,
+	},
+}
+
+func TestEncodeParquet(t *testing.T) {
+	for _, v := range encodeParquetTests {
+		options := cmd.NewExportOptions()
+		options.Format = cmd.PARQUET
+		options.ParquetCompression = v.ParquetCompression
+		options.ParquetAllStrings = v.ParquetAllStrings
+
+		buf := new(bytes.Buffer)
+		if _, err := EncodeView(context.Background(), buf, v.View, options, nil); err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+
+		expect := new(bytes.Buffer)
+		if err := parquet.Write(expect, v.Columns, v.Codec); err != nil {
+			t.Fatalf("%s: unexpected error %q", v.Name, err)
+		}
+		if !bytes.Equal(buf.Bytes(), expect.Bytes()) {
+			t.Errorf("%s: result = %#v, want %#v", v.Name, buf.Bytes(), expect.Bytes())
+		}
+	}
+}
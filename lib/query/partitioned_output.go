@@ -0,0 +1,239 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+const DefaultMaxPartitions = 1000
+
+// PartitionedOutput writes records of a result set to a separate file for each
+// distinct combination of values in the partition columns.
+//
+// The values are substituted for the placeholders such as "{column}" in the path
+// template. Path separators and characters that cannot be used in file names are
+// replaced with underscores.
+type PartitionedOutput struct {
+	PathTemplate  string
+	Columns       []string
+	MaxPartitions int
+
+	segments []pathSegment
+}
+
+type pathSegment struct {
+	literal string
+	column  int
+}
+
+func NewPartitionedOutput(pathTemplate string, columns []string, maxPartitions int) (*PartitionedOutput, error) {
+	if len(columns) < 1 {
+		return nil, NewIncorrectCommandUsageError("partition columns are not specified")
+	}
+
+	segments, err := parsePartitionPathTemplate(pathTemplate, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PartitionedOutput{
+		PathTemplate:  pathTemplate,
+		Columns:       columns,
+		MaxPartitions: maxPartitions,
+		segments:      segments,
+	}, nil
+}
+
+func parsePartitionPathTemplate(pathTemplate string, columns []string) ([]pathSegment, error) {
+	segments := make([]pathSegment, 0, len(columns)*2+1)
+	used := make([]bool, len(columns))
+
+	s := pathTemplate
+	for 0 < len(s) {
+		start := strings.IndexByte(s, '{')
+		if start < 0 {
+			segments = append(segments, pathSegment{literal: s, column: -1})
+			break
+		}
+		end := strings.IndexByte(s[start:], '}')
+		if end < 0 {
+			return nil, NewIncorrectCommandUsageError(fmt.Sprintf("placeholder in the output path %s is not closed", pathTemplate))
+		}
+		end = start + end
+
+		if 0 < start {
+			segments = append(segments, pathSegment{literal: s[:start], column: -1})
+		}
+
+		name := s[start+1 : end]
+		idx := -1
+		for i := range columns {
+			if strings.EqualFold(columns[i], name) {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			return nil, NewIncorrectCommandUsageError(fmt.Sprintf("placeholder {%s} in the output path is not a partition column", name))
+		}
+		used[idx] = true
+		segments = append(segments, pathSegment{column: idx})
+
+		s = s[end+1:]
+	}
+
+	for i := range used {
+		if !used[i] {
+			return nil, NewIncorrectCommandUsageError(fmt.Sprintf("partition column %s is not used in the output path", columns[i]))
+		}
+	}
+	return segments, nil
+}
+
+func (p *PartitionedOutput) path(values []string) string {
+	var buf strings.Builder
+	for _, seg := range p.segments {
+		if seg.column < 0 {
+			buf.WriteString(seg.literal)
+		} else {
+			buf.WriteString(values[seg.column])
+		}
+	}
+	return buf.String()
+}
+
+// Write writes the records of the view to the files, and returns the paths of
+// the written files.
+// All the files are written only when the number of partitions does not exceed
+// the limit, and existing files are replaced after the new contents are written.
+func (p *PartitionedOutput) Write(ctx context.Context, tx *Transaction, view *View, options cmd.ExportOptions) ([]string, error) {
+	indices := make([]int, len(p.Columns))
+	for i := range p.Columns {
+		idx, err := view.FieldIndex(parser.FieldReference{Column: parser.Identifier{Literal: p.Columns[i]}})
+		if err != nil {
+			return nil, err
+		}
+		indices[i] = idx
+	}
+
+	paths := make([]string, 0, 10)
+	partitions := make(map[string]RecordSet)
+	values := make([]string, len(indices))
+
+	for i := range view.RecordSet {
+		for j, idx := range indices {
+			values[j] = partitionValue(view.RecordSet[i][idx][0])
+		}
+		path := p.path(values)
+
+		if _, ok := partitions[path]; !ok {
+			if 0 < p.MaxPartitions && p.MaxPartitions <= len(paths) {
+				return nil, NewPartitionLimitExceededError(p.MaxPartitions)
+			}
+			paths = append(paths, path)
+		}
+		partitions[path] = append(partitions[path], view.RecordSet[i])
+	}
+
+	for i, path := range paths {
+		if ctx.Err() != nil {
+			return paths[:i], ConvertContextError(ctx.Err())
+		}
+
+		partition := &View{
+			Header:    view.Header,
+			RecordSet: partitions[path],
+		}
+		if err := writePartition(ctx, tx, path, partition, options); err != nil {
+			return paths[:i], err
+		}
+	}
+	return paths, nil
+}
+
+func partitionValue(p value.Primary) string {
+	if value.IsNull(p) {
+		return "NULL"
+	}
+
+	s, _, _ := ConvertFieldContents(p, false)
+	s = strings.Map(func(r rune) rune {
+		if r < 32 || strings.ContainsRune("/\\:*?\"<>|", r) {
+			return '_'
+		}
+		return r
+	}, s)
+
+	if s == "." || s == ".." {
+		s = strings.Repeat("_", len(s))
+	}
+	return s
+}
+
+func writePartition(ctx context.Context, tx *Transaction, path string, view *View, options cmd.ExportOptions) error {
+	ident := parser.Identifier{Literal: path}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return NewIOError(ident, err.Error())
+	}
+
+	var h *file.Handler
+	var err error
+	if file.Exists(path) {
+		h, err = file.NewHandlerForUpdate(ctx, tx.FileContainer, path, tx.WaitTimeout, tx.RetryDelay)
+	} else {
+		h, err = file.NewHandlerForCreate(tx.FileContainer, path)
+	}
+	if err != nil {
+		return ConvertFileHandlerError(err, ident)
+	}
+
+	fp, _ := h.FileForUpdate()
+	if err = encodePartition(ctx, fp, path, view, options, tx); err != nil {
+		return appendCompositeError(err, tx.FileContainer.Close(h))
+	}
+
+	h.SetInPlace(!tx.Flags.AtomicWrite)
+	if err = tx.FileContainer.Commit(h); err != nil {
+		return NewIOError(ident, err.Error())
+	}
+	return nil
+}
+
+func encodePartition(ctx context.Context, fp io.Writer, path string, view *View, options cmd.ExportOptions, tx *Transaction) error {
+	compression := options.Compression
+	if compression == cmd.NoCompression {
+		compression = cmd.CompressionFromExt(path)
+	}
+
+	w, err := NewCompressWriter(fp, compression, options.CompressionLevel)
+	if err != nil {
+		return NewIOError(nil, err.Error())
+	}
+
+	if _, err = EncodeView(ctx, w, view, options, tx.Palette); err != nil {
+		_ = w.Close()
+		return err
+	}
+
+	if !options.StripEndingLineBreak && !(options.Format == cmd.FIXED && options.SingleLine) && options.Format != cmd.PARQUET {
+		if _, err = w.Write([]byte(options.LineBreak.Value())); err != nil {
+			_ = w.Close()
+			return NewIOError(nil, err.Error())
+		}
+	}
+
+	if err = w.Close(); err != nil {
+		return NewIOError(nil, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+package query
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var newPartitionedOutputTests = []struct {
+	Name         string
+	PathTemplate string
+	Columns      []string
+	Error        string
+}{
+	{
+		Name:         "NewPartitionedOutput",
+		PathTemplate: "out/{region}_{customer_id}.csv",
+		Columns:      []string{"customer_id", "region"},
+	},
+	{
+		Name:         "NewPartitionedOutput Columns Not Specified Error",
+		PathTemplate: "out/{region}.csv",
+		Columns:      []string{},
+		Error:        "incorrect usage: partition columns are not specified",
+	},
+	{
+		Name:         "NewPartitionedOutput Placeholder Not Closed Error",
+		PathTemplate: "out/{region.csv",
+		Columns:      []string{"region"},
+		Error:        "incorrect usage: placeholder in the output path out/{region.csv is not closed",
+	},
+	{
+		Name:         "NewPartitionedOutput Undefined Placeholder Error",
+		PathTemplate: "out/{region}_{city}.csv",
+		Columns:      []string{"region"},
+		Error:        "incorrect usage: placeholder {city} in the output path is not a partition column",
+	},
+	{
+		Name:         "NewPartitionedOutput Unused Column Error",
+		PathTemplate: "out/{region}.csv",
+		Columns:      []string{"region", "city"},
+		Error:        "incorrect usage: partition column city is not used in the output path",
+	},
+}
+
+func TestNewPartitionedOutput(t *testing.T) {
+	for _, v := range newPartitionedOutputTests {
+		_, err := NewPartitionedOutput(v.PathTemplate, v.Columns, DefaultMaxPartitions)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+	}
+}
+
+var partitionedOutputWriteTests = []struct {
+	Name          string
+	PathTemplate  string
+	Columns       []string
+	MaxPartitions int
+	Existing      map[string]string
+	Files         map[string]string
+	Error         string
+}{
+	{
+		Name:         "PartitionedOutput Write",
+		PathTemplate: "{region}/orders.csv",
+		Columns:      []string{"region"},
+		Files: map[string]string{
+			"east/orders.csv": "" +
+				"id,region\n" +
+				"1,east\n" +
+				"3,east\n",
+			"west/orders.csv": "" +
+				"id,region\n" +
+				"2,west\n",
+			"a_b/orders.csv": "" +
+				"id,region\n" +
+				"4,a/b\n",
+			"NULL/orders.csv": "" +
+				"id,region\n" +
+				"5,\n",
+		},
+	},
+	{
+		Name:         "PartitionedOutput Write Overwrite Existing Files",
+		PathTemplate: "overwrite/{region}.csv",
+		Columns:      []string{"region"},
+		Existing: map[string]string{
+			"overwrite/east.csv": "id,region\n9,east\n",
+		},
+		Files: map[string]string{
+			"overwrite/east.csv": "" +
+				"id,region\n" +
+				"1,east\n" +
+				"3,east\n",
+		},
+	},
+	{
+		Name:          "PartitionedOutput Write Partition Limit Exceeded Error",
+		PathTemplate:  "limit/{region}.csv",
+		Columns:       []string{"region"},
+		MaxPartitions: 3,
+		Error:         "number of output files exceeds the limit of 3",
+	},
+	{
+		Name:         "PartitionedOutput Write Field Not Exist Error",
+		PathTemplate: "{city}.csv",
+		Columns:      []string{"city"},
+		Error:        "field city does not exist",
+	},
+}
+
+func TestPartitionedOutput_Write(t *testing.T) {
+	dir := filepath.Join(TestDir, "partitioned_output")
+	view := &View{
+		Header: NewHeader("orders", []string{"id", "region"}),
+		RecordSet: []Record{
+			NewRecord([]value.Primary{value.NewInteger(1), value.NewString("east")}),
+			NewRecord([]value.Primary{value.NewInteger(2), value.NewString("west")}),
+			NewRecord([]value.Primary{value.NewInteger(3), value.NewString("east")}),
+			NewRecord([]value.Primary{value.NewInteger(4), value.NewString("a/b")}),
+			NewRecord([]value.Primary{value.NewInteger(5), value.NewNull()}),
+		},
+	}
+
+	options := cmd.NewExportOptions()
+	options.Format = cmd.CSV
+	ctx := context.Background()
+
+	for _, v := range partitionedOutputWriteTests {
+		for name, content := range v.Existing {
+			_ = os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0755)
+			_ = ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644)
+		}
+
+		p, err := NewPartitionedOutput(filepath.Join(dir, v.PathTemplate), v.Columns, v.MaxPartitions)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+
+		paths, err := p.Write(ctx, TestTx, view, options)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			if 0 < len(paths) {
+				t.Errorf("%s: written files = %v, want no file", v.Name, paths)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		for name, expect := range v.Files {
+			buf, err := ioutil.ReadFile(filepath.Join(dir, name))
+			if err != nil {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+				continue
+			}
+			if !reflect.DeepEqual(string(buf), expect) {
+				t.Errorf("%s: content of %s = %q, want %q", v.Name, name, string(buf), expect)
+			}
+		}
+	}
+}
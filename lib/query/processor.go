@@ -180,7 +180,9 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 		err = proc.Tx.PreparedStatements.Dispose(stmt.(parser.DisposeStatement))
 	case parser.SelectQuery:
 		if selectEntity, ok := stmt.(parser.SelectQuery).SelectEntity.(parser.SelectEntity); ok && selectEntity.IntoClause != nil {
-			_, err = Select(ctx, proc.ReferenceScope, stmt.(parser.SelectQuery))
+			if _, err = Select(ctx, proc.ReferenceScope, stmt.(parser.SelectQuery)); err == nil {
+				err = proc.Tx.checkColumnTypes(stmt.(parser.SelectQuery))
+			}
 		} else {
 			if proc.Tx.Flags.Stats {
 				proc.measurementStart = time.Now()
@@ -188,44 +190,10 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 
 			view, e := Select(ctx, proc.ReferenceScope, stmt.(parser.SelectQuery))
 			if e == nil {
-				var warnmsg string
-
-				proc.Tx.Session.mtx.Lock()
-
-				if proc.storeResults {
-					proc.Tx.SelectedViews = append(proc.Tx.SelectedViews, view)
-				}
-
-				if _, ok := proc.Tx.Session.Stdout().(*Discard); !ok || proc.Tx.Session.OutFile() != nil {
-					exportOptions := proc.Tx.Flags.ExportOptions.Copy()
-
-					var writer io.Writer
-					if proc.Tx.Session.OutFile() != nil {
-						writer = proc.Tx.Session.OutFile()
-					} else {
-						writer = proc.Tx.Session.Stdout()
-					}
-					warn, e := EncodeView(ctx, writer, view, exportOptions, proc.Tx.Palette)
-
-					if e != nil {
-						if e == EmptyResultSetError {
-							warnmsg = warn
-						} else if e == DataEmpty {
-							// Do Nothing
-						} else {
-							err = e
-						}
-					} else if !proc.Tx.Flags.ExportOptions.StripEndingLineBreak &&
-						!(proc.Tx.Session.OutFile() != nil && exportOptions.Format == cmd.FIXED && exportOptions.SingleLine) {
-						_, err = writer.Write([]byte(proc.Tx.Flags.ExportOptions.LineBreak.Value()))
-					}
-				}
-
-				proc.Tx.Session.mtx.Unlock()
-
-				if 0 < len(warnmsg) {
-					proc.LogWarn(warnmsg, proc.Tx.Flags.Quiet)
-				}
+				e = proc.Tx.checkColumnTypes(stmt.(parser.SelectQuery))
+			}
+			if e == nil {
+				err = proc.writeView(ctx, view)
 			} else {
 				err = e
 			}
@@ -239,15 +207,25 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 			proc.measurementStart = time.Now()
 		}
 
-		fileInfo, cnt, e := Insert(ctx, proc.ReferenceScope, stmt.(parser.InsertQuery))
+		fileInfo, cnt, returning, e := Insert(ctx, proc.ReferenceScope, stmt.(parser.InsertQuery))
+		if e == nil {
+			e = proc.Tx.checkColumnTypes(stmt.(parser.InsertQuery))
+		}
 		if e == nil {
 			if 0 < cnt {
-				proc.Tx.uncommittedViews.SetForUpdatedView(fileInfo)
+				if view, ok := proc.Tx.cachedViews.Load(fileInfo.Path); ok && fileInfo.IsFile() {
+					proc.Tx.uncommittedViews.SetForInsertedView(fileInfo, view.RecordLen()-cnt)
+				} else {
+					proc.Tx.uncommittedViews.SetForUpdatedView(fileInfo)
+				}
 			}
 			proc.Log(fmt.Sprintf("%s inserted on %q.", FormatCount(cnt, "record"), fileInfo.Path), proc.Tx.Flags.Quiet)
 			if proc.storeResults {
 				proc.Tx.AffectedRows = cnt
 			}
+			if returning != nil {
+				err = proc.writeView(ctx, returning)
+			}
 		} else {
 			err = e
 		}
@@ -260,7 +238,10 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 			proc.measurementStart = time.Now()
 		}
 
-		infos, cnts, e := Update(ctx, proc.ReferenceScope, stmt.(parser.UpdateQuery))
+		infos, cnts, returning, e := Update(ctx, proc.ReferenceScope, stmt.(parser.UpdateQuery))
+		if e == nil {
+			e = proc.Tx.checkColumnTypes(stmt.(parser.UpdateQuery))
+		}
 		if e == nil {
 			cntTotal := 0
 			for i, info := range infos {
@@ -273,6 +254,9 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 			if proc.storeResults {
 				proc.Tx.AffectedRows = cntTotal
 			}
+			if returning != nil {
+				err = proc.writeView(ctx, returning)
+			}
 		} else {
 			err = e
 		}
@@ -286,6 +270,9 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 		}
 
 		fileInfo, cnt, e := Replace(ctx, proc.ReferenceScope, stmt.(parser.ReplaceQuery))
+		if e == nil {
+			e = proc.Tx.checkColumnTypes(stmt.(parser.ReplaceQuery))
+		}
 		if e == nil {
 			if 0 < cnt {
 				proc.Tx.uncommittedViews.SetForUpdatedView(fileInfo)
@@ -306,7 +293,10 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 			proc.measurementStart = time.Now()
 		}
 
-		infos, cnts, e := Delete(ctx, proc.ReferenceScope, stmt.(parser.DeleteQuery))
+		infos, cnts, returning, e := Delete(ctx, proc.ReferenceScope, stmt.(parser.DeleteQuery))
+		if e == nil {
+			e = proc.Tx.checkColumnTypes(stmt.(parser.DeleteQuery))
+		}
 		if e == nil {
 			cntTotal := 0
 			for i, info := range infos {
@@ -319,6 +309,9 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 			if proc.storeResults {
 				proc.Tx.AffectedRows = cntTotal
 			}
+			if returning != nil {
+				err = proc.writeView(ctx, returning)
+			}
 		} else {
 			err = e
 		}
@@ -444,9 +437,30 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 	case parser.Reload:
 		err = Reload(ctx, proc.Tx, stmt.(parser.Reload))
 	case parser.ShowObjects:
-		if printstr, err = ShowObjects(proc.ReferenceScope, stmt.(parser.ShowObjects)); err == nil {
+		if IsShowObjectTable(stmt.(parser.ShowObjects)) {
+			view, e := ShowObjectTable(proc.ReferenceScope, stmt.(parser.ShowObjects))
+			if e == nil {
+				err = proc.writeView(ctx, view)
+			} else {
+				err = e
+			}
+		} else if printstr, err = ShowObjects(proc.ReferenceScope, stmt.(parser.ShowObjects)); err == nil {
 			proc.Log(printstr, false)
 		}
+	case parser.Describe:
+		view, e := Describe(ctx, proc.ReferenceScope, stmt.(parser.Describe))
+		if e == nil {
+			err = proc.writeView(ctx, view)
+		} else {
+			err = e
+		}
+	case parser.ExplainAnalyze:
+		view, e := ExplainAnalyze(ctx, proc.ReferenceScope, stmt.(parser.ExplainAnalyze))
+		if e == nil {
+			err = proc.writeView(ctx, view)
+		} else {
+			err = e
+		}
 	case parser.ShowFields:
 		if printstr, err = ShowFields(ctx, proc.ReferenceScope, stmt.(parser.ShowFields)); err == nil {
 			proc.Log(printstr, false)
@@ -674,6 +688,101 @@ func (proc *Processor) ExecExternalCommand(ctx context.Context, stmt parser.Exte
 	return err
 }
 
+func (proc *Processor) writeView(ctx context.Context, view *View) (err error) {
+	var warnmsg string
+
+	proc.Tx.Session.mtx.Lock()
+
+	if proc.storeResults {
+		proc.Tx.SelectedViews = append(proc.Tx.SelectedViews, view)
+	}
+
+	if partition := proc.Tx.Session.OutPartition(); partition != nil {
+		paths, e := partition.Write(ctx, proc.Tx, view, proc.Tx.Flags.ExportOptions.Copy())
+		proc.Tx.Session.mtx.Unlock()
+
+		for _, path := range paths {
+			proc.LogNotice(fmt.Sprintf("Output: file %q is written.", path), proc.Tx.Flags.Quiet)
+		}
+		return e
+	}
+
+	if _, ok := proc.Tx.Session.Stdout().(*Discard); !ok || proc.Tx.Session.OutFile() != nil {
+		exportOptions := proc.Tx.Flags.ExportOptions.Copy()
+
+		if exportOptions.Format == cmd.PARQUET {
+			if proc.Tx.Session.OutFile() == nil && proc.Tx.Session.StdoutIsTerminal() {
+				proc.Tx.Session.mtx.Unlock()
+				return NewIncorrectCommandUsageError("PARQUET output cannot be written to a terminal, use --out option or redirect the standard output")
+			}
+			if proc.Tx.Session.parquetWritten {
+				proc.Tx.Session.mtx.Unlock()
+				return NewIncorrectCommandUsageError("PARQUET output cannot contain more than one result set")
+			}
+		}
+
+		var writer io.Writer
+		var compressWriter io.WriteCloser
+		if proc.Tx.Session.OutFile() != nil {
+			writer = proc.Tx.Session.OutFile()
+			if f := proc.Tx.Session.AppendedOutFile(); f != nil {
+				if view, err = f.Align(view, exportOptions); err != nil {
+					proc.Tx.Session.mtx.Unlock()
+					return err
+				}
+			}
+		} else if exportOptions.Compression != cmd.NoCompression {
+			if proc.Tx.Session.StdoutIsTerminal() {
+				proc.Tx.Session.mtx.Unlock()
+				return NewIncorrectCommandUsageError("compressed output cannot be written to a terminal, use --out option or redirect the standard output")
+			}
+			if compressWriter, err = NewCompressWriter(proc.Tx.Session.Stdout(), exportOptions.Compression, exportOptions.CompressionLevel); err != nil {
+				proc.Tx.Session.mtx.Unlock()
+				return NewIOError(nil, err.Error())
+			}
+			writer = compressWriter
+		} else {
+			writer = proc.Tx.Session.Stdout()
+			if w, ok := proc.Tx.Session.StdoutWidth(); ok {
+				exportOptions.MaxWidth = w
+			}
+		}
+		warn, e := EncodeView(ctx, writer, view, exportOptions, proc.Tx.Palette)
+
+		if e != nil {
+			if e == EmptyResultSetError {
+				warnmsg = warn
+			} else if e == DataEmpty {
+				// Do Nothing
+			} else {
+				err = e
+			}
+		} else if exportOptions.Format == cmd.PARQUET {
+			proc.Tx.Session.parquetWritten = true
+		} else if !proc.Tx.Flags.ExportOptions.StripEndingLineBreak &&
+			!(proc.Tx.Session.OutFile() != nil && exportOptions.Format == cmd.FIXED && exportOptions.SingleLine) {
+			_, err = writer.Write([]byte(proc.Tx.Flags.ExportOptions.LineBreak.Value()))
+		}
+
+		if compressWriter != nil {
+			if e := compressWriter.Close(); e != nil && err == nil {
+				err = NewIOError(nil, e.Error())
+			}
+		}
+	}
+
+	if tee := proc.Tx.Session.Tee(); tee != nil && err == nil {
+		err = tee.Write(ctx, view, proc.Tx.Flags.ExportOptions.Copy())
+	}
+
+	proc.Tx.Session.mtx.Unlock()
+
+	if 0 < len(warnmsg) {
+		proc.LogWarn(warnmsg, proc.Tx.Flags.Quiet)
+	}
+	return
+}
+
 func (proc *Processor) showExecutionTime(ctx context.Context) {
 	if ctx.Err() != nil {
 		return
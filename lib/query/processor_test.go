@@ -1,8 +1,11 @@
 package query
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"fmt"
+	"io/ioutil"
 	"reflect"
 	"strings"
 	"sync"
@@ -10,6 +13,7 @@ import (
 	"time"
 
 	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parquet"
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
 
@@ -665,6 +669,9 @@ var processorExecuteStatementTests = []struct {
 					ForUpdate: true,
 				},
 			},
+			insertedOnly: map[string]int{
+				strings.ToUpper(GetTestFilePath("TABLE1.CSV")): 3,
+			},
 		},
 		Logs: fmt.Sprintf("2 records inserted on %q.\n", GetTestFilePath("table1.csv")),
 	},
@@ -1014,9 +1021,9 @@ var processorExecuteStatementTests = []struct {
 	},
 	{
 		Input: parser.ShowObjects{
-			Type: parser.Identifier{Literal: "cursors"},
+			Type: parser.Identifier{Literal: "statements"},
 		},
-		Logs: "No cursor is declared\n",
+		Logs: "No statement is prepared\n",
 	},
 	{
 		Input: parser.ShowFields{
@@ -1036,6 +1043,14 @@ var processorExecuteStatementTests = []struct {
 			"   2. column2\n" +
 			"\n",
 	},
+	{
+		Input: parser.Describe{
+			Table: parser.Identifier{Literal: "table1"},
+		},
+		Logs: "column_name,position,type,integer,float,datetime,boolean,string,empty,null,min,max,distinct,samples\n" +
+			"column1,1,integer,3,0,0,0,0,0,0,1,3,3,\"1, 2, 3\"\n" +
+			"column2,2,string,0,0,0,0,3,0,0,str1,str3,3,\"str1, str2, str3\"\n",
+	},
 }
 
 func TestProcessor_ExecuteStatement(t *testing.T) {
@@ -2019,3 +2034,72 @@ func TestProcessor_ExecExternalCommand(t *testing.T) {
 		}
 	}
 }
+
+func TestProcessor_WriteCompressedView(t *testing.T) {
+	defer func() {
+		TestTx.Session.SetStdout(NewDiscard())
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.ExportOptions.Format = cmd.CSV
+	TestTx.Flags.ExportOptions.Compression = cmd.GZIP
+
+	view := &View{
+		Header: NewHeader("t", []string{"c1"}),
+		RecordSet: []Record{
+			NewRecord([]value.Primary{value.NewString("1")}),
+		},
+	}
+
+	out := NewOutput()
+	TestTx.Session.SetStdout(out)
+	proc := NewProcessor(TestTx)
+	if err := proc.writeView(context.Background(), view); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	r, err := gzip.NewReader(bytes.NewReader([]byte(out.String())))
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	b, _ := ioutil.ReadAll(r)
+	if expect := "c1\n1\n"; string(b) != expect {
+		t.Errorf("result = %q, want %q", string(b), expect)
+	}
+}
+
+func TestProcessor_WriteParquetView(t *testing.T) {
+	defer func() {
+		TestTx.Session.SetStdout(NewDiscard())
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.ExportOptions.Format = cmd.PARQUET
+
+	view := &View{
+		Header: NewHeader("t", []string{"c1"}),
+		RecordSet: []Record{
+			NewRecord([]value.Primary{value.NewInteger(1)}),
+		},
+	}
+
+	out := NewOutput()
+	TestTx.Session.SetStdout(out)
+	proc := NewProcessor(TestTx)
+	if err := proc.writeView(context.Background(), view); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	expect := new(bytes.Buffer)
+	_ = parquet.Write(expect, []parquet.Column{{Name: "c1", Type: parquet.Int64, Values: []interface{}{int64(1)}}}, parquet.Snappy)
+	if out.String() != expect.String() {
+		t.Errorf("result = %q, want %q", out.String(), expect.String())
+	}
+
+	expectErr := "incorrect usage: PARQUET output cannot contain more than one result set"
+	if err := proc.writeView(context.Background(), view); err == nil {
+		t.Errorf("no error, want error %q", expectErr)
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q", err.Error(), expectErr)
+	}
+}
@@ -2,6 +2,7 @@ package query
 
 import (
 	"context"
+	"sort"
 	"strings"
 	"sync/atomic"
 
@@ -9,6 +10,8 @@ import (
 	"github.com/mithrandie/csvq/lib/file"
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
 )
 
 func FetchCursor(ctx context.Context, scope *ReferenceScope, name parser.Identifier, fetchPosition parser.FetchPosition, vars []parser.Variable) (bool, error) {
@@ -116,8 +119,12 @@ func Select(ctx context.Context, scope *ReferenceScope, query parser.SelectQuery
 
 	queryScope := scope.CreateNode()
 
+	var view *View
+
 	if query.WithClause != nil {
-		if err := queryScope.LoadInlineTable(ctx, query.WithClause.(parser.WithClause)); err != nil {
+		if err := queryScope.measureStage("WITH", &view, func() error {
+			return queryScope.LoadInlineTable(ctx, query.WithClause.(parser.WithClause))
+		}); err != nil {
 			queryScope.CloseCurrentNode()
 			return nil, err
 		}
@@ -135,7 +142,9 @@ func Select(ctx context.Context, scope *ReferenceScope, query parser.SelectQuery
 	}
 
 	if query.OrderByClause != nil {
-		if err := view.OrderBy(ctx, queryScope, query.OrderByClause.(parser.OrderByClause)); err != nil {
+		if err := queryScope.measureStage("ORDER BY", &view, func() error {
+			return view.OrderBy(ctx, queryScope, query.OrderByClause.(parser.OrderByClause))
+		}); err != nil {
 			queryScope.CloseCurrentNode()
 			return nil, err
 		}
@@ -144,14 +153,18 @@ func Select(ctx context.Context, scope *ReferenceScope, query parser.SelectQuery
 	if query.LimitClause != nil {
 		limitClause := query.LimitClause.(parser.LimitClause)
 		if limitClause.OffsetClause != nil {
-			if err := view.Offset(ctx, queryScope, limitClause.OffsetClause.(parser.OffsetClause)); err != nil {
+			if err := queryScope.measureStage("OFFSET", &view, func() error {
+				return view.Offset(ctx, queryScope, limitClause.OffsetClause.(parser.OffsetClause))
+			}); err != nil {
 				queryScope.CloseCurrentNode()
 				return nil, err
 			}
 		}
 
 		if !limitClause.Type.IsEmpty() {
-			if err := view.Limit(ctx, queryScope, limitClause); err != nil {
+			if err := queryScope.measureStage("LIMIT", &view, func() error {
+				return view.Limit(ctx, queryScope, limitClause)
+			}); err != nil {
 				queryScope.CloseCurrentNode()
 				return nil, err
 			}
@@ -187,38 +200,59 @@ func Select(ctx context.Context, scope *ReferenceScope, query parser.SelectQuery
 }
 
 func selectEntity(ctx context.Context, scope *ReferenceScope, expr parser.QueryExpression, forUpdate bool) (*View, error) {
+	var view *View
+
 	entity, ok := expr.(parser.SelectEntity)
 	if !ok {
-		return selectSet(ctx, scope, expr.(parser.SelectSet), forUpdate)
+		set := expr.(parser.SelectSet)
+		operator := strings.ToUpper(set.Operator.Literal)
+		if !set.All.IsEmpty() {
+			operator = operator + " ALL"
+		}
+		err := scope.measureStage(operator, &view, func() (e error) {
+			view, e = selectSet(ctx, scope, set, forUpdate)
+			return e
+		})
+		return view, err
 	}
 
 	if entity.FromClause == nil {
 		entity.FromClause = parser.FromClause{}
 	}
-	view, err := LoadView(ctx, scope, entity.FromClause.(parser.FromClause).Tables, forUpdate, false)
-	if err != nil {
+	if err := scope.measureStage("FROM", &view, func() (e error) {
+		view, e = LoadView(ctx, scope, entity.FromClause.(parser.FromClause).Tables, forUpdate, false)
+		return e
+	}); err != nil {
 		return nil, err
 	}
 
 	if entity.WhereClause != nil {
-		if err := view.Where(ctx, scope, entity.WhereClause.(parser.WhereClause)); err != nil {
+		if err := scope.measureStage("WHERE", &view, func() error {
+			return view.Where(ctx, scope, entity.WhereClause.(parser.WhereClause))
+		}); err != nil {
 			return nil, err
 		}
 	}
 
 	if entity.GroupByClause != nil {
-		if err := view.GroupBy(ctx, scope, entity.GroupByClause.(parser.GroupByClause)); err != nil {
+		if err := scope.measureStage("GROUP BY", &view, func() error {
+			return view.GroupBy(ctx, scope, entity.GroupByClause.(parser.GroupByClause))
+		}); err != nil {
 			return nil, err
 		}
 	}
 
 	if entity.HavingClause != nil {
-		if err := view.Having(ctx, scope, entity.HavingClause.(parser.HavingClause)); err != nil {
+		if err := scope.measureStage("HAVING", &view, func() error {
+			return view.Having(ctx, scope, entity.HavingClause.(parser.HavingClause))
+		}); err != nil {
 			return nil, err
 		}
 	}
 
-	if err := view.Select(ctx, scope, entity.SelectClause.(parser.SelectClause)); err != nil {
+	if err := scope.measureStage("SELECT", &view, func() error {
+		return view.Select(ctx, scope, entity.SelectClause.(parser.SelectClause))
+	}); err != nil {
 		return nil, err
 	}
 
@@ -246,10 +280,24 @@ func selectSet(ctx context.Context, scope *ReferenceScope, set parser.SelectSet,
 
 	if scope.RecursiveTable != nil {
 		scope.RecursiveTmpView = nil
-		err := selectSetForRecursion(ctx, scope, lview, set, forUpdate)
+
+		var search *recursiveSearch
+		if scope.RecursiveTable.Search != nil {
+			search = &recursiveSearch{clause: scope.RecursiveTable.Search.(parser.SearchClause)}
+		}
+		var cycle *recursiveCycle
+		if scope.RecursiveTable.Cycle != nil {
+			cycle = &recursiveCycle{clause: scope.RecursiveTable.Cycle.(parser.CycleClause)}
+		}
+
+		err := selectSetForRecursion(ctx, scope, lview, set, forUpdate, search, cycle)
 		if err != nil {
 			return nil, err
 		}
+
+		if search != nil {
+			search.sort(lview, scope.RecursiveTable.Cycle != nil)
+		}
 	} else {
 		rview, err := selectSetEntity(ctx, scope, set.RHS, forUpdate)
 		if err != nil {
@@ -280,7 +328,7 @@ func selectSet(ctx context.Context, scope *ReferenceScope, set parser.SelectSet,
 	return lview, err
 }
 
-func selectSetForRecursion(ctx context.Context, scope *ReferenceScope, view *View, set parser.SelectSet, forUpdate bool) error {
+func selectSetForRecursion(ctx context.Context, scope *ReferenceScope, view *View, set parser.SelectSet, forUpdate bool, search *recursiveSearch, cycle *recursiveCycle) error {
 	if ctx.Err() != nil {
 		return ConvertContextError(ctx.Err())
 	}
@@ -303,47 +351,399 @@ func selectSetForRecursion(ctx context.Context, scope *ReferenceScope, view *Vie
 			return err
 		}
 		scope.RecursiveTmpView = view
+
+		if search != nil {
+			if err = search.init(scope, view); err != nil {
+				return err
+			}
+		}
+
+		if cycle != nil {
+			if err = cycle.init(view); err != nil {
+				return err
+			}
+			var kept []int
+			scope.RecursiveTmpView, kept = cycle.mark(scope.Tx.Flags, view, nil)
+			if search != nil {
+				search.tmpPaths = search.tmpPaths.filter(kept)
+			}
+		}
 	}
 
-	queryScope := scope.CreateNode()
-	rview, err := selectSetEntity(ctx, queryScope, set.RHS, forUpdate)
-	queryScope.CloseCurrentNode()
-	queryScope = nil
+	var rview *View
+	var parents []int
+	var err error
+	if search != nil || cycle != nil {
+		rview, parents, err = selectChildren(ctx, scope, set.RHS, forUpdate)
+	} else {
+		queryScope := scope.CreateNode()
+		rview, err = selectSetEntity(ctx, queryScope, set.RHS, forUpdate)
+		queryScope.CloseCurrentNode()
+		queryScope = nil
+	}
 	if err != nil {
 		return err
 	}
-	if view.FieldLen() != rview.FieldLen() {
-		return NewCombinedSetFieldLengthError(set.RHS, view.FieldLen())
+
+	fieldLen := view.FieldLen()
+	if cycle != nil {
+		fieldLen--
+	}
+	if fieldLen != rview.FieldLen() {
+		return NewCombinedSetFieldLengthError(set.RHS, fieldLen)
 	}
 
 	if rview.RecordLen() < 1 {
 		return nil
 	}
 
-	switch set.Operator.Token {
-	case parser.UNION:
-		if err = view.Union(ctx, scope.Tx.Flags, rview, !set.All.IsEmpty()); err != nil {
+	if search != nil {
+		search.setChildPaths(scope.Tx.Flags, rview, parents)
+	}
+
+	nextView := rview
+	var kept []int
+	if cycle != nil {
+		nextView, kept = cycle.mark(scope.Tx.Flags, rview, parents)
+	}
+
+	if search != nil {
+		if err = search.union(ctx, scope.Tx.Flags, view, rview, !set.All.IsEmpty()); err != nil {
 			return err
 		}
-	case parser.EXCEPT:
-		if err = view.Except(ctx, scope.Tx.Flags, rview, !set.All.IsEmpty()); err != nil {
+		if kept != nil {
+			search.tmpPaths = search.childPaths.filter(kept)
+		} else {
+			search.tmpPaths = search.childPaths
+		}
+	} else {
+		switch set.Operator.Token {
+		case parser.UNION:
+			if err = view.Union(ctx, scope.Tx.Flags, rview, !set.All.IsEmpty()); err != nil {
+				return err
+			}
+		case parser.EXCEPT:
+			if err = view.Except(ctx, scope.Tx.Flags, rview, !set.All.IsEmpty()); err != nil {
+				return err
+			}
+		case parser.INTERSECT:
+			if err = view.Intersect(ctx, scope.Tx.Flags, rview, !set.All.IsEmpty()); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err = nextView.Header.Update(tmpViewName, scope.RecursiveTable.Fields); err != nil {
+		return err
+	}
+	scope.RecursiveTmpView = nextView
+
+	return selectSetForRecursion(ctx, scope, view, set, forUpdate, search, cycle)
+}
+
+// recursiveCycle holds the values of the cycle columns of the records
+// generated by a recursive query with a cycle clause and their ancestors.
+type recursiveCycle struct {
+	clause  parser.CycleClause
+	indices []int
+
+	paths [][]string
+}
+
+func (cycle *recursiveCycle) init(view *View) error {
+	cycle.indices = make([]int, len(cycle.clause.Columns))
+	for i, c := range cycle.clause.Columns {
+		col := c.(parser.Identifier)
+		idx, err := view.FieldIndex(parser.FieldReference{BaseExpr: col.BaseExpr, Column: col})
+		if err != nil {
 			return err
 		}
-	case parser.INTERSECT:
-		if err = view.Intersect(ctx, scope.Tx.Flags, rview, !set.All.IsEmpty()); err != nil {
+		cycle.indices[i] = idx
+	}
+	return nil
+}
+
+// mark appends the column specified in the cycle clause to the records of the
+// target view, and sets TRUE if the values of the cycle columns are the same as
+// those of any of the ancestors of the record.
+// The parents are the indices of the parent records in the previous iteration,
+// and nil for the records of the base query.
+// The records not in cycles are returned as the view for the next iteration
+// with their indices in the target view.
+func (cycle *recursiveCycle) mark(flags *cmd.Flags, target *View, parents []int) (*View, []int) {
+	fieldLen := target.FieldLen()
+
+	kept := make([]int, 0, target.RecordLen())
+	nextView := NewView()
+	nextView.Header = target.Header.Copy()
+	nextView.RecordSet = make(RecordSet, 0, target.RecordLen())
+	paths := make([][]string, 0, target.RecordLen())
+
+	values := make([]value.Primary, len(cycle.indices))
+	buf := GetComparisonKeysBuf()
+	for i, record := range target.RecordSet {
+		for j, idx := range cycle.indices {
+			values[j] = record[idx][0]
+		}
+		buf.Reset()
+		SerializeComparisonKeys(buf, values, flags)
+		key := buf.String()
+
+		var ancestors []string
+		if parents != nil {
+			ancestors = cycle.paths[parents[i]]
+		}
+
+		isCycle := false
+		for _, ancestor := range ancestors {
+			if ancestor == key {
+				isCycle = true
+				break
+			}
+		}
+
+		if !isCycle {
+			path := make([]string, len(ancestors), len(ancestors)+1)
+			copy(path, ancestors)
+			paths = append(paths, append(path, key))
+			nextView.RecordSet = append(nextView.RecordSet, record.Copy())
+			kept = append(kept, i)
+		}
+		target.RecordSet[i] = append(record[:fieldLen], NewCell(value.NewBoolean(isCycle)))
+	}
+	PutComparisonkeysBuf(buf)
+
+	cycle.paths = paths
+	target.Header = append(target.Header, HeaderField{
+		Column:      cycle.clause.SetColumn.Literal,
+		Number:      fieldLen + 1,
+		IsFromTable: true,
+	})
+	return nextView, kept
+}
+
+// searchPath is the list of the values of the search columns of a record and
+// its ancestors, in order from the root of the recursion.
+type searchPath []SortValues
+
+type searchPaths []searchPath
+
+func (list searchPaths) filter(indices []int) searchPaths {
+	filtered := make(searchPaths, len(indices))
+	for i, idx := range indices {
+		filtered[i] = list[idx]
+	}
+	return filtered
+}
+
+// recursiveSearch holds the paths of the records generated by a recursive query
+// with a search clause.
+// The records of each iteration are generated from each record of the previous
+// iteration in turn, so that the paths to the records can be traced.
+type recursiveSearch struct {
+	clause  parser.SearchClause
+	indices []int
+
+	paths      searchPaths
+	tmpPaths   searchPaths
+	childPaths searchPaths
+}
+
+func (search *recursiveSearch) init(scope *ReferenceScope, view *View) error {
+	search.indices = make([]int, len(search.clause.Columns))
+	for i, c := range search.clause.Columns {
+		col := c.(parser.Identifier)
+		idx, err := view.FieldIndex(parser.FieldReference{BaseExpr: col.BaseExpr, Column: col})
+		if err != nil {
 			return err
 		}
+		search.indices[i] = idx
 	}
 
-	if err = rview.Header.Update(tmpViewName, scope.RecursiveTable.Fields); err != nil {
-		return err
+	search.paths = make(searchPaths, view.RecordLen())
+	for i, record := range view.RecordSet {
+		search.paths[i] = searchPath{search.values(scope.Tx.Flags, record)}
+	}
+	search.tmpPaths = search.paths
+	return nil
+}
+
+func (search *recursiveSearch) values(flags *cmd.Flags, record Record) SortValues {
+	values := make(SortValues, len(search.indices))
+	for i, idx := range search.indices {
+		values[i] = NewSortValue(record[idx][0], flags)
+	}
+	return values
+}
+
+// selectChildren evaluates the recursive query for each record of the previous
+// iteration and returns all the generated records with the indices of their
+// parent records, so that the ancestors of the records can be traced.
+func selectChildren(ctx context.Context, scope *ReferenceScope, query parser.QueryExpression, forUpdate bool) (*View, []int, error) {
+	parents := scope.RecursiveTmpView
+	defer func() {
+		scope.RecursiveTmpView = parents
+	}()
+
+	var children *View
+	indices := make([]int, 0, parents.RecordLen())
+
+	for i, record := range parents.RecordSet {
+		scope.RecursiveTmpView = &View{
+			Header:    parents.Header,
+			RecordSet: RecordSet{record},
+		}
+
+		queryScope := scope.CreateNode()
+		rview, err := selectSetEntity(ctx, queryScope, query, forUpdate)
+		queryScope.CloseCurrentNode()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if children == nil {
+			children = rview
+		} else {
+			if children.FieldLen() != rview.FieldLen() {
+				return nil, nil, NewCombinedSetFieldLengthError(query, children.FieldLen())
+			}
+			children.RecordSet = children.RecordSet.Merge(rview.RecordSet)
+		}
+
+		for range rview.RecordSet {
+			indices = append(indices, i)
+		}
+	}
+
+	if children == nil {
+		queryScope := scope.CreateNode()
+		children, err := selectSetEntity(ctx, queryScope, query, forUpdate)
+		queryScope.CloseCurrentNode()
+		return children, indices, err
+	}
+	return children, indices, nil
+}
+
+// setChildPaths sets the paths of the records generated in an iteration from
+// the paths of their parent records.
+func (search *recursiveSearch) setChildPaths(flags *cmd.Flags, children *View, parents []int) {
+	search.childPaths = make(searchPaths, children.RecordLen())
+	for i, child := range children.RecordSet {
+		parentPath := search.tmpPaths[parents[i]]
+		path := make(searchPath, len(parentPath), len(parentPath)+1)
+		copy(path, parentPath)
+		search.childPaths[i] = append(path, search.values(flags, child))
+	}
+}
+
+// union merges the records generated in an iteration into the view and keeps
+// the paths aligned with the records.
+func (search *recursiveSearch) union(ctx context.Context, flags *cmd.Flags, view *View, calcView *View, all bool) error {
+	view.RecordSet = view.RecordSet.Merge(calcView.RecordSet)
+	view.FileInfo = nil
+	paths := append(search.paths[:len(search.paths):len(search.paths)], search.childPaths...)
+
+	if !all {
+		if err := view.GenerateComparisonKeys(ctx, flags); err != nil {
+			return err
+		}
+
+		records := make(RecordSet, 0, view.RecordLen())
+		distinctPaths := make(searchPaths, 0, view.RecordLen())
+		values := make(map[string]bool)
+
+		for i, key := range view.comparisonKeysInEachRecord {
+			if !values[key] {
+				values[key] = true
+				records = append(records, view.RecordSet[i])
+				distinctPaths = append(distinctPaths, paths[i])
+			}
+		}
+
+		view.RecordSet = records
+		view.comparisonKeysInEachRecord = nil
+		paths = distinctPaths
+	}
+
+	search.paths = paths
+	return nil
+}
+
+// sort sorts the records of the view in the order of the search, and inserts
+// the column specified in the search clause as the sequence number of the order.
+func (search *recursiveSearch) sort(view *View, hasCycleColumn bool) {
+	order := make([]int, view.RecordLen())
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		p1 := search.paths[order[i]]
+		p2 := search.paths[order[j]]
+		if search.clause.IsBreadthFirst() {
+			if len(p1) != len(p2) {
+				return len(p1) < len(p2)
+			}
+			return compareSearchValues(p1[len(p1)-1], p2[len(p2)-1]) < 0
+		}
+
+		for k := 0; k < len(p1) && k < len(p2); k++ {
+			if c := compareSearchValues(p1[k], p2[k]); c != 0 {
+				return c < 0
+			}
+		}
+		return len(p1) < len(p2)
+	})
+
+	fieldLen := view.FieldLen()
+	insertIdx := fieldLen
+	if hasCycleColumn {
+		insertIdx = fieldLen - 1
 	}
-	scope.RecursiveTmpView = rview
 
-	return selectSetForRecursion(ctx, scope, view, set, forUpdate)
+	records := make(RecordSet, len(order))
+	for i, idx := range order {
+		record := view.RecordSet[idx]
+		newRecord := make(Record, 0, len(record)+1)
+		newRecord = append(newRecord, record[:insertIdx]...)
+		newRecord = append(newRecord, NewCell(value.NewInteger(int64(i+1))))
+		newRecord = append(newRecord, record[insertIdx:]...)
+		records[i] = newRecord
+	}
+	view.RecordSet = records
+
+	header := make(Header, 0, fieldLen+1)
+	header = append(header, view.Header[:insertIdx]...)
+	header = append(header, HeaderField{
+		Column:      search.clause.SetColumn.Literal,
+		IsFromTable: true,
+	})
+	header = append(header, view.Header[insertIdx:]...)
+	for i := range header {
+		header[i].Number = i + 1
+	}
+	view.Header = header
 }
 
-func Insert(ctx context.Context, scope *ReferenceScope, query parser.InsertQuery) (*FileInfo, int, error) {
+func compareSearchValues(v1 SortValues, v2 SortValues) int {
+	for i := range v1 {
+		if v1[i].Less(v2[i]) == ternary.TRUE {
+			return -1
+		}
+		if v2[i].Less(v1[i]) == ternary.TRUE {
+			return 1
+		}
+		if v1[i].Type == NullType && v2[i].Type != NullType {
+			return -1
+		}
+		if v1[i].Type != NullType && v2[i].Type == NullType {
+			return 1
+		}
+	}
+	return 0
+}
+
+func Insert(ctx context.Context, scope *ReferenceScope, query parser.InsertQuery) (*FileInfo, int, *View, error) {
 	queryScope := scope.CreateNode()
 	defer queryScope.CloseCurrentNode()
 
@@ -351,7 +751,7 @@ func Insert(ctx context.Context, scope *ReferenceScope, query parser.InsertQuery
 
 	if query.WithClause != nil {
 		if err := queryScope.LoadInlineTable(ctx, query.WithClause.(parser.WithClause)); err != nil {
-			return nil, insertRecords, err
+			return nil, insertRecords, nil, err
 		}
 	}
 
@@ -364,7 +764,7 @@ func Insert(ctx context.Context, scope *ReferenceScope, query parser.InsertQuery
 
 	view, err := LoadView(ctx, queryScope, tables, true, false)
 	if err != nil {
-		return nil, insertRecords, err
+		return nil, insertRecords, nil, err
 	}
 
 	fields := query.Fields
@@ -372,18 +772,33 @@ func Insert(ctx context.Context, scope *ReferenceScope, query parser.InsertQuery
 		fields = view.Header.TableColumns()
 	}
 
-	if query.ValuesList != nil {
+	if query.DefaultValues {
+		if insertRecords, err = view.InsertDefaultValues(ctx, queryScope); err != nil {
+			return nil, insertRecords, nil, err
+		}
+	} else if query.ValuesList != nil {
 		if insertRecords, err = view.InsertValues(ctx, queryScope, fields, query.ValuesList); err != nil {
-			return nil, insertRecords, err
+			return nil, insertRecords, nil, err
 		}
 	} else {
 		if insertRecords, err = view.InsertFromQuery(ctx, queryScope, fields, query.Query.(parser.SelectQuery)); err != nil {
-			return nil, insertRecords, err
+			return nil, insertRecords, nil, err
+		}
+	}
+
+	if err = view.CheckConstraints(ctx, queryScope, query.Table); err != nil {
+		return nil, insertRecords, nil, err
+	}
+
+	var returning *View
+	if query.Returning != nil {
+		if returning, err = returningView(ctx, queryScope, view.Header, view.RecordSet[view.RecordLen()-insertRecords:], query.Returning); err != nil {
+			return nil, insertRecords, nil, err
 		}
 	}
 
 	if err = view.RestoreHeaderReferences(); err != nil {
-		return nil, insertRecords, err
+		return nil, insertRecords, nil, err
 	}
 
 	if !view.FileInfo.IsFile() {
@@ -392,58 +807,126 @@ func Insert(ctx context.Context, scope *ReferenceScope, query parser.InsertQuery
 		scope.Tx.cachedViews.Set(view)
 	}
 
-	return view.FileInfo, insertRecords, err
+	return view.FileInfo, insertRecords, returning, err
 }
 
-func Update(ctx context.Context, scope *ReferenceScope, query parser.UpdateQuery) ([]*FileInfo, []int, error) {
+// joinUpdateTables adds the tables to be updated that are not referred to
+// in the from clause to the beginning of the from clause, so that
+// "UPDATE t SET ... FROM s WHERE t.id = s.id" joins t with s.
+func joinUpdateTables(tables []parser.QueryExpression, fromClause parser.FromClause) parser.FromClause {
+	names := make(map[string]bool)
+	for _, t := range fromClause.Tables {
+		collectTableNames(t, names)
+	}
+
+	joinTables := make([]parser.QueryExpression, 0, len(tables)+len(fromClause.Tables))
+	for _, t := range tables {
+		if !names[strings.ToUpper(t.(parser.Table).Name().Literal)] {
+			joinTables = append(joinTables, t)
+		}
+	}
+	if len(joinTables) < 1 {
+		return fromClause
+	}
+
+	fromClause.Tables = append(joinTables, fromClause.Tables...)
+	return fromClause
+}
+
+func collectTableNames(expr parser.QueryExpression, names map[string]bool) {
+	switch expr.(type) {
+	case parser.Table:
+		table := expr.(parser.Table)
+		if join, ok := table.Object.(parser.Join); ok {
+			collectTableNames(join.Table, names)
+			collectTableNames(join.JoinTable, names)
+		} else {
+			names[strings.ToUpper(table.Name().Literal)] = true
+		}
+	case parser.Parentheses:
+		collectTableNames(expr.(parser.Parentheses).Expr, names)
+	}
+}
+
+func checkUrlNotUpdatable(scope *ReferenceScope, tableName parser.Identifier, fpath string) error {
+	if view, ok := scope.Tx.cachedViews.Load(fpath); ok && view.FileInfo.IsUrl() {
+		return NewFileNotUpdatableError(tableName, view.FileInfo.Path, ErrMsgUrlNotUpdatable)
+	}
+	return nil
+}
+
+func Update(ctx context.Context, scope *ReferenceScope, query parser.UpdateQuery) ([]*FileInfo, []int, *View, error) {
 	queryScope := scope.CreateNode()
 	defer queryScope.CloseCurrentNode()
 
 	if query.WithClause != nil {
 		if err := queryScope.LoadInlineTable(ctx, query.WithClause.(parser.WithClause)); err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 	}
 
 	if query.FromClause == nil {
 		query.FromClause = parser.FromClause{Tables: query.Tables}
+	} else {
+		query.FromClause = joinUpdateTables(query.Tables, query.FromClause.(parser.FromClause))
 	}
 
 	queryScope.Tx.operationMutex.Lock()
 	defer queryScope.Tx.operationMutex.Unlock()
 
-	view, err := LoadView(ctx, queryScope, query.FromClause.(parser.FromClause).Tables, true, true)
+	var view *View
+	err := queryScope.measureStage("FROM", &view, func() (e error) {
+		view, e = LoadView(ctx, queryScope, query.FromClause.(parser.FromClause).Tables, true, true)
+		return e
+	})
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	if query.WhereClause != nil {
-		if err := view.Where(ctx, queryScope, query.WhereClause.(parser.WhereClause)); err != nil {
-			return nil, nil, err
+		if err := queryScope.measureStage("WHERE", &view, func() error {
+			return view.Where(ctx, queryScope, query.WhereClause.(parser.WhereClause))
+		}); err != nil {
+			return nil, nil, nil, err
 		}
 	}
 
 	viewsToUpdate := make(map[string]*View)
 	updatedCount := make(map[string]int)
+	updateTables := make(map[string]parser.QueryExpression)
 	for _, v := range query.Tables {
 		table := v.(parser.Table)
 		tableName := table.Name()
 		fpath, err := queryScope.GetAlias(tableName)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
+		}
+		if err = checkUrlNotUpdatable(queryScope, tableName, fpath); err != nil {
+			return nil, nil, nil, err
 		}
 		viewKey := strings.ToUpper(tableName.Literal)
+		updateTables[viewKey] = v
 
 		if queryScope.TemporaryTableExists(fpath) {
-			viewsToUpdate[viewKey], _ = queryScope.GetTemporaryTable(parser.Identifier{Literal: fpath})
+			viewsToUpdate[viewKey], err = queryScope.GetTemporaryTable(parser.Identifier{Literal: fpath})
 		} else {
-			viewsToUpdate[viewKey], _ = queryScope.Tx.cachedViews.Get(parser.Identifier{Literal: fpath})
+			if viewsToUpdate[viewKey], err = queryScope.Tx.cachedViews.Get(parser.Identifier{Literal: fpath}); err != nil {
+				err = NewTableNotLoadedError(tableName)
+			}
+		}
+		if err != nil {
+			return nil, nil, nil, err
 		}
 		if err = viewsToUpdate[viewKey].Header.Update(tableName.Literal, nil); err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 	}
 
+	var returningRecords RecordSet
+	if query.Returning != nil {
+		returningRecords = view.RecordSet.Copy()
+	}
+
 	updatesList := make(map[string]map[int]*UintPool)
 	seqScope := queryScope.CreateScopeForSequentialEvaluation(view)
 	for i := range view.RecordSet {
@@ -454,17 +937,17 @@ func Update(ctx context.Context, scope *ReferenceScope, query parser.UpdateQuery
 		for _, uset := range query.SetList {
 			val, err := Evaluate(ctx, seqScope, uset.Value)
 			if err != nil {
-				return nil, nil, err
+				return nil, nil, nil, err
 			}
 
 			viewref, err := view.FieldViewName(uset.Field)
 			if err != nil {
-				return nil, nil, err
+				return nil, nil, nil, err
 			}
 			viewref = strings.ToUpper(viewref)
 
 			if _, ok := viewsToUpdate[viewref]; !ok {
-				return nil, nil, NewUpdateFieldNotExistError(uset.Field)
+				return nil, nil, nil, NewUpdateFieldNotExistError(uset.Field)
 			}
 
 			var internalId int
@@ -473,7 +956,7 @@ func Update(ctx context.Context, scope *ReferenceScope, query parser.UpdateQuery
 			} else {
 				id, err := view.InternalRecordId(viewref, i)
 				if err != nil {
-					return nil, nil, NewUpdateValueAmbiguousError(uset.Field, uset.Value)
+					return nil, nil, nil, NewUpdateValueAmbiguousError(uset.Field, uset.Value)
 				}
 
 				internalId = id
@@ -489,18 +972,34 @@ func Update(ctx context.Context, scope *ReferenceScope, query parser.UpdateQuery
 				updatedCount[viewref]++
 			}
 			if updatesList[viewref][internalId].Exists(uint(fieldIdx)) {
-				return nil, nil, NewUpdateValueAmbiguousError(uset.Field, uset.Value)
+				return nil, nil, nil, NewUpdateValueAmbiguousError(uset.Field, uset.Value)
 			}
 			updatesList[viewref][internalId].Add(uint(fieldIdx))
 			viewsToUpdate[viewref].RecordSet[internalId][fieldIdx] = NewCell(val)
+
+			if returningRecords != nil {
+				idx, _ := view.Header.SearchIndex(uset.Field)
+				returningRecords[i][idx] = NewCell(val)
+			}
+		}
+	}
+
+	var returning *View
+	if returningRecords != nil {
+		if returning, err = returningView(ctx, queryScope, view.Header, returningRecords, query.Returning); err != nil {
+			return nil, nil, nil, err
 		}
 	}
 
 	fileInfos := make([]*FileInfo, 0)
 	updateRecords := make([]int, 0)
 	for k, v := range viewsToUpdate {
+		if err = v.CheckConstraints(ctx, queryScope, updateTables[k]); err != nil {
+			return nil, nil, nil, err
+		}
+
 		if err = v.RestoreHeaderReferences(); err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 
 		if !v.FileInfo.IsFile() {
@@ -513,7 +1012,7 @@ func Update(ctx context.Context, scope *ReferenceScope, query parser.UpdateQuery
 		updateRecords = append(updateRecords, updatedCount[k])
 	}
 
-	return fileInfos, updateRecords, nil
+	return fileInfos, updateRecords, returning, nil
 }
 
 func Replace(ctx context.Context, scope *ReferenceScope, query parser.ReplaceQuery) (*FileInfo, int, error) {
@@ -555,6 +1054,10 @@ func Replace(ctx context.Context, scope *ReferenceScope, query parser.ReplaceQue
 		}
 	}
 
+	if err = view.CheckConstraints(ctx, queryScope, query.Table); err != nil {
+		return nil, replaceRecords, err
+	}
+
 	if err = view.RestoreHeaderReferences(); err != nil {
 		return nil, replaceRecords, err
 	}
@@ -568,41 +1071,49 @@ func Replace(ctx context.Context, scope *ReferenceScope, query parser.ReplaceQue
 	return view.FileInfo, replaceRecords, err
 }
 
-func Delete(ctx context.Context, scope *ReferenceScope, query parser.DeleteQuery) ([]*FileInfo, []int, error) {
+func Delete(ctx context.Context, scope *ReferenceScope, query parser.DeleteQuery) ([]*FileInfo, []int, *View, error) {
 	queryScope := scope.CreateNode()
 	defer queryScope.CloseCurrentNode()
 
 	if query.WithClause != nil {
 		if err := queryScope.LoadInlineTable(ctx, query.WithClause.(parser.WithClause)); err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 	}
 
 	tables := query.FromClause.Tables
 	if query.Tables == nil {
 		if 1 < len(tables) {
-			return nil, nil, NewDeleteTableNotSpecifiedError(query)
+			return nil, nil, nil, NewDeleteTableNotSpecifiedError(query)
 		}
 		table := tables[0].(parser.Table)
 		switch table.Object.(type) {
 		case parser.Identifier, parser.TableObject, parser.Stdin:
 			query.Tables = tables
+		case parser.Url:
+			return nil, nil, nil, NewFileNotUpdatableError(table.Name(), table.Object.(parser.Url).Raw, ErrMsgUrlNotUpdatable)
 		default:
-			return nil, nil, NewDeleteTableNotSpecifiedError(query)
+			return nil, nil, nil, NewDeleteTableNotSpecifiedError(query)
 		}
 	}
 
 	queryScope.Tx.operationMutex.Lock()
 	defer queryScope.Tx.operationMutex.Unlock()
 
-	view, err := LoadView(ctx, queryScope, tables, true, true)
+	var view *View
+	err := queryScope.measureStage("FROM", &view, func() (e error) {
+		view, e = LoadView(ctx, queryScope, tables, true, true)
+		return e
+	})
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	if query.WhereClause != nil {
-		if err := view.Where(ctx, queryScope, query.WhereClause.(parser.WhereClause)); err != nil {
-			return nil, nil, err
+		if err := queryScope.measureStage("WHERE", &view, func() error {
+			return view.Where(ctx, queryScope, query.WhereClause.(parser.WhereClause))
+		}); err != nil {
+			return nil, nil, nil, err
 		}
 	}
 
@@ -613,24 +1124,32 @@ func Delete(ctx context.Context, scope *ReferenceScope, query parser.DeleteQuery
 		tableName := table.Name()
 		fpath, err := queryScope.GetAlias(tableName)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
+		}
+		if err = checkUrlNotUpdatable(queryScope, tableName, fpath); err != nil {
+			return nil, nil, nil, err
 		}
 
 		viewKey := strings.ToUpper(tableName.Literal)
 		if queryScope.TemporaryTableExists(fpath) {
-			viewsToDelete[viewKey], _ = queryScope.GetTemporaryTable(parser.Identifier{Literal: fpath})
+			viewsToDelete[viewKey], err = queryScope.GetTemporaryTable(parser.Identifier{Literal: fpath})
 		} else {
-			viewsToDelete[viewKey], _ = queryScope.Tx.cachedViews.Get(parser.Identifier{Literal: fpath})
+			if viewsToDelete[viewKey], err = queryScope.Tx.cachedViews.Get(parser.Identifier{Literal: fpath}); err != nil {
+				err = NewTableNotLoadedError(tableName)
+			}
+		}
+		if err != nil {
+			return nil, nil, nil, err
 		}
 		if err = viewsToDelete[viewKey].Header.Update(tableName.Literal, nil); err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 		deletedIndices[viewKey] = make(map[int]bool)
 	}
 
 	for i := range view.RecordSet {
 		if ctx.Err() != nil {
-			return nil, nil, ConvertContextError(ctx.Err())
+			return nil, nil, nil, ConvertContextError(ctx.Err())
 		}
 
 		for viewref := range viewsToDelete {
@@ -644,23 +1163,38 @@ func Delete(ctx context.Context, scope *ReferenceScope, query parser.DeleteQuery
 		}
 	}
 
+	var returning *View
+	if query.Returning != nil {
+		if returning, err = returningView(ctx, queryScope, view.Header, view.RecordSet, query.Returning); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
 	fileInfos := make([]*FileInfo, 0)
 	deletedCounts := make([]int, 0)
 	for k, v := range viewsToDelete {
 		if ctx.Err() != nil {
-			return nil, nil, ConvertContextError(ctx.Err())
+			return nil, nil, nil, ConvertContextError(ctx.Err())
 		}
 
 		records := make(RecordSet, 0, v.RecordLen()-len(deletedIndices[k]))
+		var origins []recordOrigin
+		if v.recordOrigins != nil {
+			origins = make([]recordOrigin, 0, cap(records))
+		}
 		for i, record := range v.RecordSet {
 			if !deletedIndices[k][i] {
 				records = append(records, record)
+				if origins != nil && i < len(v.recordOrigins) {
+					origins = append(origins, v.recordOrigins[i])
+				}
 			}
 		}
 		v.RecordSet = records
+		v.recordOrigins = origins
 
 		if err = v.RestoreHeaderReferences(); err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 
 		if !v.FileInfo.IsFile() {
@@ -673,7 +1207,23 @@ func Delete(ctx context.Context, scope *ReferenceScope, query parser.DeleteQuery
 		deletedCounts = append(deletedCounts, len(deletedIndices[k]))
 	}
 
-	return fileInfos, deletedCounts, nil
+	return fileInfos, deletedCounts, returning, nil
+}
+
+// returningView evaluates the fields of a returning clause over the records
+// affected by a data manipulation query.
+func returningView(ctx context.Context, scope *ReferenceScope, header Header, records RecordSet, fields []parser.QueryExpression) (*View, error) {
+	view := NewView()
+	view.Header = header.Copy()
+	view.RecordSet = records.Copy()
+
+	if err := view.Select(ctx, scope, parser.SelectClause{Fields: fields}); err != nil {
+		return nil, err
+	}
+	if err := view.Fix(ctx, scope.Tx.Flags); err != nil {
+		return nil, err
+	}
+	return view, nil
 }
 
 func CreateTable(ctx context.Context, scope *ReferenceScope, query parser.CreateTable) (*FileInfo, error) {
@@ -698,6 +1248,9 @@ func CreateTable(ctx context.Context, scope *ReferenceScope, query parser.Create
 	fileInfo.EncloseAll = flags.ExportOptions.EncloseAll
 	fileInfo.NoHeader = flags.ExportOptions.WithoutHeader
 	fileInfo.PrettyPrint = flags.ExportOptions.PrettyPrint
+	if flags.ExportOptions.Compression != cmd.NoCompression {
+		fileInfo.Compression = flags.ExportOptions.Compression
+	}
 	fileInfo.ForUpdate = true
 
 	if query.Query != nil {
@@ -732,8 +1285,25 @@ func CreateTable(ctx context.Context, scope *ReferenceScope, query parser.Create
 		}
 	}
 
+	for i := range view.Header {
+		view.Header[i].Default = nil
+		view.Header[i].NotNull = false
+		view.Header[i].Unique = false
+		view.Header[i].Checks = nil
+	}
+	for i, c := range query.Columns {
+		view.Header[i].Default = c.Value
+		view.Header[i].NotNull = c.NotNull
+		view.Header[i].Unique = c.Unique
+		view.Header[i].Checks = c.Checks
+	}
+	view.Header.AddTableChecks(query.Checks)
 	view.FileInfo = fileInfo
 
+	if err = view.CheckConstraints(ctx, queryScope, query.Table); err != nil {
+		return nil, appendCompositeError(err, queryScope.Tx.FileContainer.Close(fileInfo.Handler))
+	}
+
 	scope.Tx.cachedViews.Set(view)
 
 	return view.FileInfo, nil
@@ -808,6 +1378,10 @@ func AddColumns(ctx context.Context, scope *ReferenceScope, query parser.AddColu
 		header[idx] = v
 	}
 	for i, v := range addHeader {
+		v.Default = query.Columns[i].Value
+		v.NotNull = query.Columns[i].NotNull
+		v.Unique = query.Columns[i].Unique
+		v.Checks = query.Columns[i].Checks
 		header[i+insertPos] = v
 	}
 	colNumber := 0
@@ -846,6 +1420,15 @@ func AddColumns(ctx context.Context, scope *ReferenceScope, query parser.AddColu
 		return nil, 0, err
 	}
 
+	addedView := &View{
+		Header:    header,
+		RecordSet: records,
+		FileInfo:  view.FileInfo,
+	}
+	if err = addedView.CheckConstraints(ctx, queryScope, query.Table); err != nil {
+		return nil, 0, err
+	}
+
 	view.Header = header
 	view.RecordSet = records
 
@@ -881,16 +1464,20 @@ func DropColumns(ctx context.Context, scope *ReferenceScope, query parser.DropCo
 		}
 	}
 
+	droppedColumns := make(map[string]bool, len(query.Columns))
 	view.selectFields = []int{}
 	for i := 0; i < view.FieldLen(); i++ {
 		if view.Header[i].IsFromTable && !dropIndices.Exists(uint(i)) {
 			view.selectFields = append(view.selectFields, i)
+		} else {
+			droppedColumns[strings.ToUpper(view.Header[i].Column)] = true
 		}
 	}
 
 	if err = view.Fix(ctx, scope.Tx.Flags); err != nil {
 		return nil, 0, err
 	}
+	view.Header.RemoveChecks(droppedColumns)
 
 	if !view.FileInfo.IsFile() {
 		scope.ReplaceTemporaryTable(view)
@@ -928,6 +1515,7 @@ func RenameColumn(ctx context.Context, scope *ReferenceScope, query parser.Renam
 		return nil, err
 	}
 
+	view.Header.RenameChecks(view.Header[idx].Column, query.New)
 	view.Header[idx].Column = query.New.Literal
 
 	if !view.FileInfo.IsFile() {
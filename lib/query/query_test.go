@@ -1376,6 +1376,7 @@ var insertTests = []struct {
 	UpdateCount  int
 	ViewCache    ViewMap
 	ResultScopes *ReferenceScope
+	Returning    *View
 	Error        string
 }{
 	{
@@ -1688,6 +1689,46 @@ var insertTests = []struct {
 		},
 		Error: "select query should return exactly 1 field",
 	},
+	{
+		Name: "Insert Query With Returning",
+		Query: parser.InsertQuery{
+			Table: parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			Fields: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+			ValuesList: []parser.QueryExpression{
+				parser.RowValue{
+					Value: parser.ValueList{
+						Values: []parser.QueryExpression{
+							parser.NewIntegerValueFromString("4"),
+						},
+					},
+				},
+			},
+			Returning: []parser.QueryExpression{
+				parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+				parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}, As: parser.Token{Token: parser.AS, Literal: "as"}, Alias: parser.Identifier{Literal: "c2"}},
+			},
+		},
+		ResultFile: &FileInfo{
+			Path:      GetTestFilePath("table1.csv"),
+			Delimiter: ',',
+			NoHeader:  false,
+			Encoding:  text.UTF8,
+			LineBreak: text.LF,
+			ForUpdate: true,
+		},
+		UpdateCount: 1,
+		Returning: &View{
+			Header: NewHeader("table1", []string{"column1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(4),
+					value.NewNull(),
+				}),
+			},
+		},
+	},
 }
 
 func TestInsert(t *testing.T) {
@@ -1728,7 +1769,7 @@ func TestInsert(t *testing.T) {
 	ctx := context.Background()
 	for _, v := range insertTests {
 		_ = TestTx.ReleaseResources()
-		result, cnt, err := Insert(ctx, scope, v.Query)
+		result, cnt, returning, err := Insert(ctx, scope, v.Query)
 		if err != nil {
 			if len(v.Error) < 1 {
 				t.Errorf("%s: unexpected error %q", v.Name, err)
@@ -1762,6 +1803,10 @@ func TestInsert(t *testing.T) {
 			t.Errorf("%s: update count = %d, want %d", v.Name, cnt, v.UpdateCount)
 		}
 
+		if !reflect.DeepEqual(returning, v.Returning) {
+			t.Errorf("%s: returning = %v, want %v", v.Name, returning, v.Returning)
+		}
+
 		if v.ViewCache.SyncMap != nil {
 			if !SyncMapEqual(TestTx.cachedViews, v.ViewCache) {
 				t.Errorf("%s: view cache = %v, want %v", v.Name, TestTx.cachedViews, v.ViewCache)
@@ -1782,6 +1827,7 @@ var updateTests = []struct {
 	UpdateCounts []int
 	ViewCache    ViewMap
 	ResultScopes *ReferenceScope
+	Returning    *View
 	Error        string
 }{
 	{
@@ -1973,6 +2019,46 @@ var updateTests = []struct {
 		},
 		UpdateCounts: []int{2},
 	},
+	{
+		Name: "Update Query Joining Update Table with From Clause",
+		Query: parser.UpdateQuery{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			},
+			SetList: []parser.UpdateSet{
+				{
+					Field: parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column2"}},
+					Value: parser.FieldReference{View: parser.Identifier{Literal: "t2"}, Column: parser.Identifier{Literal: "column4"}},
+				},
+			},
+			FromClause: parser.FromClause{
+				Tables: []parser.QueryExpression{
+					parser.Table{
+						Object: parser.Identifier{Literal: "table2"},
+						Alias:  parser.Identifier{Literal: "t2"},
+					},
+				},
+			},
+			WhereClause: parser.WhereClause{
+				Filter: parser.Comparison{
+					LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+					RHS:      parser.FieldReference{View: parser.Identifier{Literal: "t2"}, Column: parser.Identifier{Literal: "column3"}},
+					Operator: parser.Token{Token: '=', Literal: "="},
+				},
+			},
+		},
+		ResultFiles: []*FileInfo{
+			{
+				Path:      GetTestFilePath("table1.csv"),
+				Delimiter: ',',
+				NoHeader:  false,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				ForUpdate: true,
+			},
+		},
+		UpdateCounts: []int{2},
+	},
 	{
 		Name: "Update Query File Does Not Exist Error",
 		Query: parser.UpdateQuery{
@@ -2018,7 +2104,7 @@ var updateTests = []struct {
 		Error: "field notexist does not exist",
 	},
 	{
-		Name: "Update Query File Is Not Loaded Error",
+		Name: "Update Query Update Table Not Joined File Does Not Exist Error",
 		Query: parser.UpdateQuery{
 			Tables: []parser.QueryExpression{
 				parser.Table{Object: parser.Identifier{Literal: "notexist"}},
@@ -2051,7 +2137,7 @@ var updateTests = []struct {
 				},
 			},
 		},
-		Error: "table notexist is not loaded",
+		Error: "file notexist does not exist",
 	},
 	{
 		Name: "Update Query Update Table Is Not Specified Error",
@@ -2163,6 +2249,80 @@ var updateTests = []struct {
 		},
 		Error: "value column4 to set in the field column2 is ambiguous",
 	},
+	{
+		Name: "Update Query Multiple Source Records Match Error",
+		Query: parser.UpdateQuery{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			},
+			SetList: []parser.UpdateSet{
+				{
+					Field: parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column2"}},
+					Value: parser.FieldReference{View: parser.Identifier{Literal: "t2"}, Column: parser.Identifier{Literal: "column4"}},
+				},
+			},
+			FromClause: parser.FromClause{
+				Tables: []parser.QueryExpression{
+					parser.Table{
+						Object: parser.Identifier{Literal: "table2"},
+						Alias:  parser.Identifier{Literal: "t2"},
+					},
+				},
+			},
+			WhereClause: parser.WhereClause{
+				Filter: parser.Comparison{
+					LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+					RHS:      parser.NewIntegerValue(1),
+					Operator: parser.Token{Token: '=', Literal: "="},
+				},
+			},
+		},
+		Error: "value t2.column4 to set in the field table1.column2 is ambiguous",
+	},
+	{
+		Name: "Update Query With Returning",
+		Query: parser.UpdateQuery{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			},
+			SetList: []parser.UpdateSet{
+				{
+					Field: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					Value: parser.NewStringValue("update"),
+				},
+			},
+			WhereClause: parser.WhereClause{
+				Filter: parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					RHS:      parser.NewIntegerValueFromString("2"),
+					Operator: parser.Token{Token: '=', Literal: "="},
+				},
+			},
+			Returning: []parser.QueryExpression{
+				parser.Field{Object: parser.AllColumns{}},
+			},
+		},
+		ResultFiles: []*FileInfo{
+			{
+				Path:      GetTestFilePath("table1.csv"),
+				Delimiter: ',',
+				NoHeader:  false,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				ForUpdate: true,
+			},
+		},
+		UpdateCounts: []int{1},
+		Returning: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("update"),
+				}),
+			},
+		},
+	},
 }
 
 func TestUpdate(t *testing.T) {
@@ -2203,7 +2363,7 @@ func TestUpdate(t *testing.T) {
 	ctx := context.Background()
 	for _, v := range updateTests {
 		_ = TestTx.ReleaseResources()
-		files, cnt, err := Update(ctx, scope, v.Query)
+		files, cnt, returning, err := Update(ctx, scope, v.Query)
 		if err != nil {
 			if len(v.Error) < 1 {
 				t.Errorf("%s: unexpected error %q", v.Name, err)
@@ -2237,6 +2397,10 @@ func TestUpdate(t *testing.T) {
 			t.Errorf("%s: update count = %v, want %v", v.Name, cnt, v.UpdateCounts)
 		}
 
+		if !reflect.DeepEqual(returning, v.Returning) {
+			t.Errorf("%s: returning = %v, want %v", v.Name, returning, v.Returning)
+		}
+
 		if v.ViewCache.SyncMap != nil {
 			if !SyncMapEqual(TestTx.cachedViews, v.ViewCache) {
 				t.Errorf("%s: view cache = %v, want %v", v.Name, TestTx.cachedViews, v.ViewCache)
@@ -2677,6 +2841,7 @@ var deleteTests = []struct {
 	UpdateCounts []int
 	ViewCache    ViewMap
 	ResultScopes *ReferenceScope
+	Returning    *View
 	Error        string
 }{
 	{
@@ -2951,6 +3116,45 @@ var deleteTests = []struct {
 		},
 		Error: "table notexist is not loaded",
 	},
+	{
+		Name: "Delete Query With Returning",
+		Query: parser.DeleteQuery{
+			FromClause: parser.FromClause{
+				Tables: []parser.QueryExpression{
+					parser.Table{Object: parser.Identifier{Literal: "table1"}},
+				},
+			},
+			WhereClause: parser.WhereClause{
+				Filter: parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					RHS:      parser.NewIntegerValueFromString("2"),
+					Operator: parser.Token{Token: '=', Literal: "="},
+				},
+			},
+			Returning: []parser.QueryExpression{
+				parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+			},
+		},
+		ResultFiles: []*FileInfo{
+			{
+				Path:      GetTestFilePath("table1.csv"),
+				Delimiter: ',',
+				NoHeader:  false,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				ForUpdate: true,
+			},
+		},
+		UpdateCounts: []int{1},
+		Returning: &View{
+			Header: NewHeader("table1", []string{"column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("str2"),
+				}),
+			},
+		},
+	},
 }
 
 func TestDelete(t *testing.T) {
@@ -2991,7 +3195,7 @@ func TestDelete(t *testing.T) {
 	ctx := context.Background()
 	for _, v := range deleteTests {
 		_ = TestTx.ReleaseResources()
-		files, cnt, err := Delete(ctx, scope, v.Query)
+		files, cnt, returning, err := Delete(ctx, scope, v.Query)
 		if err != nil {
 			if len(v.Error) < 1 {
 				t.Errorf("%s: unexpected error %q", v.Name, err)
@@ -3025,6 +3229,10 @@ func TestDelete(t *testing.T) {
 			t.Errorf("%s: update count = %v, want %v", v.Name, cnt, v.UpdateCounts)
 		}
 
+		if !reflect.DeepEqual(returning, v.Returning) {
+			t.Errorf("%s: returning = %v, want %v", v.Name, returning, v.Returning)
+		}
+
 		if v.ViewCache.SyncMap != nil {
 			if !SyncMapEqual(TestTx.cachedViews, v.ViewCache) {
 				t.Errorf("%s: view cache = %v, want %v", v.Name, TestTx.cachedViews, v.ViewCache)
@@ -3077,6 +3285,58 @@ var createTableTests = []struct {
 			},
 		}),
 	},
+	{
+		Name: "Create Table With Default Values and Constraints",
+		Query: parser.CreateTable{
+			Table: parser.Identifier{Literal: "create_table_1.csv"},
+			Fields: []parser.QueryExpression{
+				parser.Identifier{Literal: "column1"},
+				parser.Identifier{Literal: "column2"},
+			},
+			Columns: []parser.ColumnDefault{
+				{Column: parser.Identifier{Literal: "column1"}, NotNull: true},
+				{Column: parser.Identifier{Literal: "column2"}, Value: parser.NewIntegerValueFromString("0"), Unique: true},
+			},
+			Checks: []parser.QueryExpression{
+				parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					RHS:      parser.NewIntegerValueFromString("0"),
+					Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: ">="},
+				},
+			},
+		},
+		ResultFile: &FileInfo{
+			Path:      GetTestFilePath("create_table_1.csv"),
+			Delimiter: ',',
+			NoHeader:  false,
+			Encoding:  text.UTF8,
+			LineBreak: text.LF,
+			ForUpdate: true,
+		},
+		ViewCache: GenerateViewMap([]*View{
+			{
+				FileInfo: &FileInfo{
+					Path:      GetTestFilePath("create_table_1.csv"),
+					Delimiter: ',',
+					NoHeader:  false,
+					Encoding:  text.UTF8,
+					LineBreak: text.LF,
+					ForUpdate: true,
+				},
+				Header: Header{
+					{View: "create_table_1", Column: "column1", Number: 1, IsFromTable: true, NotNull: true},
+					{View: "create_table_1", Column: "column2", Number: 2, IsFromTable: true, Default: parser.NewIntegerValueFromString("0"), Unique: true, Checks: []parser.QueryExpression{
+						parser.Comparison{
+							LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+							RHS:      parser.NewIntegerValueFromString("0"),
+							Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: ">="},
+						},
+					}},
+				},
+				RecordSet: RecordSet{},
+			},
+		}),
+	},
 	{
 		Name: "Create Table From Select Query",
 		Query: parser.CreateTable{
@@ -3146,6 +3406,59 @@ var createTableTests = []struct {
 		},
 		Error: "field name column1 is a duplicate",
 	},
+	{
+		Name: "Create Table From Select Query Not Null Constraint Error",
+		Query: parser.CreateTable{
+			Table: parser.Identifier{Literal: "create_table_1.csv"},
+			Fields: []parser.QueryExpression{
+				parser.Identifier{Literal: "column1"},
+				parser.Identifier{Literal: "column2"},
+			},
+			Columns: []parser.ColumnDefault{
+				{Column: parser.Identifier{Literal: "column1"}},
+				{Column: parser.Identifier{Literal: "column2"}, NotNull: true},
+			},
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.NewIntegerValueFromString("1")},
+							parser.Field{Object: parser.NewNullValue()},
+						},
+					},
+				},
+			},
+		},
+		Error: "field column2 in record 1 violates not null constraint",
+	},
+	{
+		Name: "Create Table From Select Query Check Constraint Error",
+		Query: parser.CreateTable{
+			Table: parser.Identifier{Literal: "create_table_1.csv"},
+			Fields: []parser.QueryExpression{
+				parser.Identifier{Literal: "column1"},
+				parser.Identifier{Literal: "column2"},
+			},
+			Checks: []parser.QueryExpression{
+				parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					Operator: parser.Token{Token: '=', Literal: "="},
+				},
+			},
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.NewIntegerValueFromString("1")},
+							parser.Field{Object: parser.NewIntegerValueFromString("2")},
+						},
+					},
+				},
+			},
+		},
+		Error: "record 1 violates check constraint column1 = column2",
+	},
 	{
 		Name: "Create Table Select Query Execution Error",
 		Query: parser.CreateTable{
@@ -3415,7 +3728,12 @@ var addColumnsTests = []struct {
 					LineBreak: text.LF,
 					ForUpdate: true,
 				},
-				Header: NewHeader("table1", []string{"column3", "column4", "column1", "column2"}),
+				Header: Header{
+					{View: "table1", Column: "column3", Number: 1, IsFromTable: true, Default: parser.NewIntegerValueFromString("2")},
+					{View: "table1", Column: "column4", Number: 2, IsFromTable: true, Default: parser.NewIntegerValueFromString("1")},
+					{View: "table1", Column: "column1", Number: 3, IsFromTable: true},
+					{View: "table1", Column: "column2", Number: 4, IsFromTable: true},
+				},
 				RecordSet: []Record{
 					NewRecord([]value.Primary{
 						value.NewInteger(2),
@@ -3476,7 +3794,12 @@ var addColumnsTests = []struct {
 					LineBreak: text.LF,
 					ForUpdate: true,
 				},
-				Header: NewHeader("table1", []string{"column1", "column3", "column4", "column2"}),
+				Header: Header{
+					{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
+					{View: "table1", Column: "column3", Number: 2, IsFromTable: true},
+					{View: "table1", Column: "column4", Number: 3, IsFromTable: true, Default: parser.NewIntegerValueFromString("1")},
+					{View: "table1", Column: "column2", Number: 4, IsFromTable: true},
+				},
 				RecordSet: []Record{
 					NewRecord([]value.Primary{
 						value.NewString("1"),
@@ -3537,7 +3860,12 @@ var addColumnsTests = []struct {
 					LineBreak: text.LF,
 					ForUpdate: true,
 				},
-				Header: NewHeader("table1", []string{"column1", "column3", "column4", "column2"}),
+				Header: Header{
+					{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
+					{View: "table1", Column: "column3", Number: 2, IsFromTable: true},
+					{View: "table1", Column: "column4", Number: 3, IsFromTable: true, Default: parser.NewIntegerValueFromString("1")},
+					{View: "table1", Column: "column2", Number: 4, IsFromTable: true},
+				},
 				RecordSet: []Record{
 					NewRecord([]value.Primary{
 						value.NewString("1"),
@@ -3612,6 +3940,20 @@ var addColumnsTests = []struct {
 		},
 		Error: "field name column1 is a duplicate",
 	},
+	{
+		Name: "Add Fields Unique Constraint Error",
+		Query: parser.AddColumns{
+			Table: parser.Identifier{Literal: "table1.csv"},
+			Columns: []parser.ColumnDefault{
+				{
+					Column: parser.Identifier{Literal: "column3"},
+					Value:  parser.NewIntegerValueFromString("1"),
+					Unique: true,
+				},
+			},
+		},
+		Error: "value 1 of field column3 in record 2 violates unique constraint, it is a duplicate of record 1",
+	},
 	{
 		Name: "Add Fields Default Value Error",
 		Query: parser.AddColumns{
@@ -4209,7 +4551,7 @@ var setTableAttributeTests = []struct {
 			Attribute: parser.Identifier{Literal: "delimiter_positions"},
 			Value:     parser.NewStringValue("invalid"),
 		},
-		Error: "delimiter positions must be \"SPACES\" or a JSON array of integers",
+		Error: "delimiter positions must be \"SPACES\", a JSON array of integers or field positions",
 	},
 	{
 		Name: "Set Format to Text",
@@ -4266,7 +4608,7 @@ var setTableAttributeTests = []struct {
 			Attribute: parser.Identifier{Literal: "format"},
 			Value:     parser.NewStringValue("invalid"),
 		},
-		Error: "format must be one of CSV|TSV|FIXED|JSON|LTSV|GFM|ORG|TEXT",
+		Error: "format must be one of CSV|TSV|FIXED|JSON|NDJSON|LTSV|GFM|ORG|TEXT|HTML|XML|VERTICAL|SQL_INSERT|TEMPLATE|PARQUET",
 	},
 	{
 		Name: "Set Encoding to SJIS",
@@ -4307,7 +4649,7 @@ var setTableAttributeTests = []struct {
 			Attribute: parser.Identifier{Literal: "encoding"},
 			Value:     parser.NewStringValue("invalid"),
 		},
-		Error: "encoding must be one of UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS",
+		Error: "encoding must be one of UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS|EUCJP",
 	},
 	{
 		Name: "Set Encoding Error in JSON Format",
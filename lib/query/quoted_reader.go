@@ -0,0 +1,317 @@
+package query
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+
+	"github.com/mithrandie/go-text"
+)
+
+// RaggedRows is the number of records that have a wrong number of fields
+// and are handled by the RaggedMode other than cmd.RaggedError.
+type RaggedRows struct {
+	Padded    int
+	Truncated int
+	Skipped   int
+}
+
+func (rr RaggedRows) IsEmpty() bool {
+	return rr.Padded == 0 && rr.Truncated == 0 && rr.Skipped == 0
+}
+
+func (rr RaggedRows) String() string {
+	return fmt.Sprintf("%d padded, %d truncated, %d skipped", rr.Padded, rr.Truncated, rr.Skipped)
+}
+
+// QuotedReader reads records from a CSV text in which fields are enclosed
+// by an arbitrary quotation character and quotation characters in fields
+// are escaped in the specified style.
+//
+// If QuoteChar is 0, fields are not enclosed. If EscapeStyle is
+// cmd.BackslashEscape, a backslash escapes the following character both in
+// enclosed and unenclosed fields, so delimiters and line breaks can be
+// included in unenclosed fields.
+//
+// Records that have a wrong number of fields are handled according to
+// Ragged, and the numbers of the handled records are counted in RaggedRows.
+type QuotedReader struct {
+	Delimiter   rune
+	QuoteChar   rune
+	EscapeStyle cmd.EscapeStyle
+	WithoutNull bool
+	Ragged      cmd.RaggedMode
+
+	reader *bufio.Reader
+	line   int
+	column int
+
+	fieldBuf bytes.Buffer
+
+	FieldsPerRecord int
+
+	DetectedLineBreak text.LineBreak
+	RaggedRows        RaggedRows
+
+	// recordLines holds the lines on which the records start when
+	// records are skipped, because the lines cannot be counted from the
+	// loaded records.
+	recordLines []int
+}
+
+func NewQuotedReader(r io.Reader, enc text.Encoding) (*QuotedReader, error) {
+	decoder, err := getTransformDecoder(r, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QuotedReader{
+		Delimiter:       ',',
+		QuoteChar:       '"',
+		EscapeStyle:     cmd.DoubleEscape,
+		Ragged:          cmd.RaggedError,
+		reader:          bufio.NewReader(decoder),
+		line:            1,
+		column:          0,
+		FieldsPerRecord: 0,
+	}, nil
+}
+
+func (r *QuotedReader) newError(s string) error {
+	return errors.New(fmt.Sprintf("line %d, column %d: %s", r.line, r.column, s))
+}
+
+func (r *QuotedReader) ReadHeader() ([]string, error) {
+	record, _, err := r.readRecord(true)
+	if err != nil {
+		return nil, err
+	}
+	r.FieldsPerRecord = len(record)
+
+	header := make([]string, len(record))
+	for i, v := range record {
+		header[i] = string(v)
+	}
+	return header, nil
+}
+
+func (r *QuotedReader) Read() ([]text.RawText, error) {
+	for {
+		record, line, err := r.readRecord(r.WithoutNull)
+		if err != nil {
+			return nil, err
+		}
+
+		if r.FieldsPerRecord < 1 {
+			r.FieldsPerRecord = len(record)
+		}
+
+		if len(record) != r.FieldsPerRecord {
+			switch {
+			case r.Ragged == cmd.RaggedPad && len(record) < r.FieldsPerRecord:
+				for len(record) < r.FieldsPerRecord {
+					if r.WithoutNull {
+						record = append(record, text.RawText{})
+					} else {
+						record = append(record, nil)
+					}
+				}
+				r.RaggedRows.Padded++
+			case r.Ragged == cmd.RaggedTruncate && r.FieldsPerRecord < len(record):
+				record = record[:r.FieldsPerRecord]
+				r.RaggedRows.Truncated++
+			case r.Ragged == cmd.RaggedSkip:
+				r.RaggedRows.Skipped++
+				continue
+			default:
+				return nil, errors.New(fmt.Sprintf("line %d: wrong number of fields, %d expected but %d found", line, r.FieldsPerRecord, len(record)))
+			}
+		}
+
+		if r.Ragged == cmd.RaggedSkip {
+			r.recordLines = append(r.recordLines, line)
+		}
+		return record, nil
+	}
+}
+
+// readRecord reads all fields of a record and returns them with the line
+// on which the record starts.
+func (r *QuotedReader) readRecord(withoutNull bool) ([]text.RawText, int, error) {
+	record := make([]text.RawText, 0, r.FieldsPerRecord)
+	line := r.line
+
+	for {
+		if len(record) < 1 {
+			line = r.line
+		}
+
+		field, quoted, eol, err := r.parseField()
+		if err != nil {
+			if err != io.EOF {
+				return nil, line, err
+			}
+			if len(record) < 1 && len(field) < 1 && !quoted {
+				return nil, line, io.EOF
+			}
+		}
+
+		if eol && len(record) < 1 && len(field) < 1 && !quoted {
+			continue
+		}
+
+		if len(field) < 1 && !quoted && !withoutNull {
+			record = append(record, nil)
+		} else {
+			record = append(record, field)
+		}
+
+		if eol {
+			break
+		}
+	}
+
+	return record, line, nil
+}
+
+// readRune reads a rune and converts CR and CRLF to LF.
+// The line break read is returned as the second value if the rune is LF.
+func (r *QuotedReader) readRune() (rune, text.LineBreak, error) {
+	ch, _, err := r.reader.ReadRune()
+	if err != nil {
+		return ch, "", err
+	}
+	r.column++
+
+	var lineBreak text.LineBreak
+	switch ch {
+	case '\r':
+		if nxt, _, err := r.reader.ReadRune(); err == nil {
+			if nxt == '\n' {
+				lineBreak = text.CRLF
+			} else {
+				if err = r.reader.UnreadRune(); err != nil {
+					return ch, "", err
+				}
+				lineBreak = text.CR
+			}
+		} else {
+			lineBreak = text.CR
+		}
+		ch = '\n'
+	case '\n':
+		lineBreak = text.LF
+	}
+	if ch == '\n' {
+		r.line++
+		r.column = 0
+	}
+	return ch, lineBreak, nil
+}
+
+func (r *QuotedReader) detectLineBreak(lineBreak text.LineBreak) {
+	if r.DetectedLineBreak == "" {
+		r.DetectedLineBreak = lineBreak
+	}
+}
+
+func (r *QuotedReader) parseField() (text.RawText, bool, bool, error) {
+	r.fieldBuf.Reset()
+
+	quoted := false
+	closed := false
+	eol := false
+	var err error
+
+Read:
+	for {
+		var ch rune
+		var lineBreak text.LineBreak
+
+		ch, lineBreak, err = r.readRune()
+		if err != nil {
+			if err == io.EOF {
+				if quoted && !closed {
+					err = r.newError(fmt.Sprintf("extraneous %c in field", r.QuoteChar))
+					break Read
+				}
+				eol = true
+			}
+			break Read
+		}
+
+		if closed {
+			switch ch {
+			case r.Delimiter:
+				break Read
+			case '\n':
+				r.detectLineBreak(lineBreak)
+				eol = true
+				break Read
+			case r.QuoteChar:
+				if r.EscapeStyle == cmd.DoubleEscape {
+					closed = false
+					r.fieldBuf.WriteRune(ch)
+					continue
+				}
+			}
+			err = r.newError(fmt.Sprintf("unexpected %c in field", r.QuoteChar))
+			break Read
+		}
+
+		if ch == '\\' && r.EscapeStyle == cmd.BackslashEscape {
+			var lb text.LineBreak
+			ch, lb, err = r.readRune()
+			if err != nil {
+				if err != io.EOF {
+					break Read
+				}
+				err = nil
+				ch = '\\'
+			}
+			if ch == '\n' {
+				r.fieldBuf.WriteString(lb.Value())
+			} else {
+				r.fieldBuf.WriteRune(ch)
+			}
+			continue
+		}
+
+		if quoted {
+			switch ch {
+			case r.QuoteChar:
+				closed = true
+			case '\n':
+				r.fieldBuf.WriteString(lineBreak.Value())
+			default:
+				r.fieldBuf.WriteRune(ch)
+			}
+			continue
+		}
+
+		switch ch {
+		case r.Delimiter:
+			break Read
+		case '\n':
+			r.detectLineBreak(lineBreak)
+			eol = true
+			break Read
+		case r.QuoteChar:
+			if r.QuoteChar != 0 && r.fieldBuf.Len() < 1 {
+				quoted = true
+			} else {
+				r.fieldBuf.WriteRune(ch)
+			}
+		default:
+			r.fieldBuf.WriteRune(ch)
+		}
+	}
+
+	field := make(text.RawText, r.fieldBuf.Len())
+	copy(field, r.fieldBuf.Bytes())
+	return field, quoted, eol, err
+}
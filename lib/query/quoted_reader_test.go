@@ -0,0 +1,202 @@
+package query
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+
+	"github.com/mithrandie/go-text"
+)
+
+var quotedReaderReadTests = []struct {
+	Name        string
+	Input       string
+	QuoteChar   rune
+	EscapeStyle cmd.EscapeStyle
+	WithoutNull bool
+	Ragged      cmd.RaggedMode
+	Result      [][]text.RawText
+	LineBreak   text.LineBreak
+	RaggedRows  RaggedRows
+	Error       string
+}{
+	{
+		Name:        "Double Escape",
+		Input:       "a,\"b\"\"c\"\n\n1,\"\"\n2,\n",
+		QuoteChar:   '"',
+		EscapeStyle: cmd.DoubleEscape,
+		Result: [][]text.RawText{
+			{text.RawText("a"), text.RawText("b\"c")},
+			{text.RawText("1"), text.RawText("")},
+			{text.RawText("2"), nil},
+		},
+		LineBreak: text.LF,
+	},
+	{
+		Name:        "Backslash Escape",
+		Input:       "a,\"b\\\"c\\\\\"\r\n1,2\\,3\r\n\"x\ny\",z\\\nw",
+		QuoteChar:   '"',
+		EscapeStyle: cmd.BackslashEscape,
+		Result: [][]text.RawText{
+			{text.RawText("a"), text.RawText("b\"c\\")},
+			{text.RawText("1"), text.RawText("2,3")},
+			{text.RawText("x\ny"), text.RawText("z\nw")},
+		},
+		LineBreak: text.CRLF,
+	},
+	{
+		Name:        "Single Quotation Mark",
+		Input:       "'a,b','c''d'\n\"e\",'f'",
+		QuoteChar:   '\'',
+		EscapeStyle: cmd.DoubleEscape,
+		Result: [][]text.RawText{
+			{text.RawText("a,b"), text.RawText("c'd")},
+			{text.RawText("\"e\""), text.RawText("f")},
+		},
+		LineBreak: text.LF,
+	},
+	{
+		Name:        "No Escape",
+		Input:       "\"a\\\",\"b\"",
+		QuoteChar:   '"',
+		EscapeStyle: cmd.NoEscape,
+		Result: [][]text.RawText{
+			{text.RawText("a\\"), text.RawText("b")},
+		},
+	},
+	{
+		Name:        "No Quotation",
+		Input:       "\"a\",b\"c\n,d",
+		QuoteChar:   0,
+		EscapeStyle: cmd.DoubleEscape,
+		WithoutNull: true,
+		Result: [][]text.RawText{
+			{text.RawText("\"a\""), text.RawText("b\"c")},
+			{text.RawText(""), text.RawText("d")},
+		},
+		LineBreak: text.LF,
+	},
+	{
+		Name:        "Quotation Mark After Closing Quotation Mark Error",
+		Input:       "a,\"b\"\"c\"",
+		QuoteChar:   '"',
+		EscapeStyle: cmd.NoEscape,
+		Error:       "line 1, column 6: unexpected \" in field",
+	},
+	{
+		Name:        "Unclosed Quotation Mark Error",
+		Input:       "a,'b\\'",
+		QuoteChar:   '\'',
+		EscapeStyle: cmd.BackslashEscape,
+		Error:       "line 1, column 6: extraneous ' in field",
+	},
+	{
+		Name:        "Wrong Number of Fields",
+		Input:       "a,b\n1,2,3",
+		QuoteChar:   '"',
+		EscapeStyle: cmd.BackslashEscape,
+		Error:       "line 2: wrong number of fields, 2 expected but 3 found",
+	},
+	{
+		Name:        "Short Record Error",
+		Input:       "a,b\n\n1,\"x\ny\"\n2",
+		QuoteChar:   '"',
+		EscapeStyle: cmd.DoubleEscape,
+		Error:       "line 5: wrong number of fields, 2 expected but 1 found",
+	},
+	{
+		Name:        "Ragged Pad",
+		Input:       "a,b,c\n1\n2,3,4",
+		QuoteChar:   '"',
+		EscapeStyle: cmd.DoubleEscape,
+		Ragged:      cmd.RaggedPad,
+		Result: [][]text.RawText{
+			{text.RawText("a"), text.RawText("b"), text.RawText("c")},
+			{text.RawText("1"), nil, nil},
+			{text.RawText("2"), text.RawText("3"), text.RawText("4")},
+		},
+		LineBreak:  text.LF,
+		RaggedRows: RaggedRows{Padded: 1},
+	},
+	{
+		Name:        "Ragged Pad with Long Record Error",
+		Input:       "a,b\n1,2,3",
+		QuoteChar:   '"',
+		EscapeStyle: cmd.DoubleEscape,
+		Ragged:      cmd.RaggedPad,
+		Error:       "line 2: wrong number of fields, 2 expected but 3 found",
+	},
+	{
+		Name:        "Ragged Truncate",
+		Input:       "a,b\n1,2,3\n4,5",
+		QuoteChar:   '"',
+		EscapeStyle: cmd.DoubleEscape,
+		Ragged:      cmd.RaggedTruncate,
+		Result: [][]text.RawText{
+			{text.RawText("a"), text.RawText("b")},
+			{text.RawText("1"), text.RawText("2")},
+			{text.RawText("4"), text.RawText("5")},
+		},
+		LineBreak:  text.LF,
+		RaggedRows: RaggedRows{Truncated: 1},
+	},
+	{
+		Name:        "Ragged Skip",
+		Input:       "a,b\n1\n2,3,4\n5,6",
+		QuoteChar:   '"',
+		EscapeStyle: cmd.DoubleEscape,
+		Ragged:      cmd.RaggedSkip,
+		Result: [][]text.RawText{
+			{text.RawText("a"), text.RawText("b")},
+			{text.RawText("5"), text.RawText("6")},
+		},
+		LineBreak:  text.LF,
+		RaggedRows: RaggedRows{Skipped: 2},
+	},
+}
+
+func TestQuotedReader_Read(t *testing.T) {
+	for _, v := range quotedReaderReadTests {
+		r, _ := NewQuotedReader(strings.NewReader(v.Input), text.UTF8)
+		r.QuoteChar = v.QuoteChar
+		r.EscapeStyle = v.EscapeStyle
+		r.WithoutNull = v.WithoutNull
+		r.Ragged = v.Ragged
+
+		var records [][]text.RawText
+		var err error
+		for {
+			var record []text.RawText
+			record, err = r.Read()
+			if err != nil {
+				break
+			}
+			records = append(records, record)
+		}
+
+		if err != io.EOF {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(records, v.Result) {
+			t.Errorf("%s: result = %q, want %q", v.Name, records, v.Result)
+		}
+		if r.DetectedLineBreak != v.LineBreak {
+			t.Errorf("%s: line break = %q, want %q", v.Name, r.DetectedLineBreak, v.LineBreak)
+		}
+		if r.RaggedRows != v.RaggedRows {
+			t.Errorf("%s: ragged rows = %v, want %v", v.Name, r.RaggedRows, v.RaggedRows)
+		}
+	}
+}
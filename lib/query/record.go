@@ -15,6 +15,26 @@ func NewCell(val value.Primary) Cell {
 	return []value.Primary{val}
 }
 
+// NewCellWithText returns a cell of a value converted from a text on loading.
+// The text is held as the second element of the cell, so it is discarded
+// together with the cell when the value is updated.
+func NewCellWithText(val value.Primary, text string) Cell {
+	return []value.Primary{val, value.NewString(text)}
+}
+
+// Text returns the text held by a cell created by NewCellWithText.
+// It must not be called for cells of grouped records.
+func (c Cell) Text() (string, bool) {
+	if len(c) != 2 {
+		return "", false
+	}
+	s, ok := c[1].(*value.String)
+	if !ok {
+		return "", false
+	}
+	return s.Raw(), true
+}
+
 func NewGroupCell(values []value.Primary) Cell {
 	return values
 }
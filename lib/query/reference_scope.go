@@ -168,6 +168,8 @@ type ReferenceScope struct {
 	RecursiveTable   *parser.InlineTable
 	RecursiveTmpView *View
 	RecursiveCount   *int64
+
+	profile *queryProfile
 }
 
 func NewReferenceScope(tx *Transaction) *ReferenceScope {
@@ -254,6 +256,7 @@ func (rs *ReferenceScope) CreateNode() *ReferenceScope {
 		RecursiveTable:   rs.RecursiveTable,
 		RecursiveTmpView: rs.RecursiveTmpView,
 		RecursiveCount:   rs.RecursiveCount,
+		profile:          rs.profile,
 	}
 
 	if node.cachedFilePath == nil {
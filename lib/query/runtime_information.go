@@ -16,6 +16,7 @@ const (
 	LoadedTablesInformation = "LOADED_TABLES"
 	WorkingDirectory        = "WORKING_DIRECTORY"
 	VersionInformation      = "VERSION"
+	LastLoadWarnings        = "LAST_LOAD_WARNINGS"
 )
 
 var RuntimeInformatinList = []string{
@@ -26,6 +27,7 @@ var RuntimeInformatinList = []string{
 	LoadedTablesInformation,
 	WorkingDirectory,
 	VersionInformation,
+	LastLoadWarnings,
 }
 
 func GetRuntimeInformation(tx *Transaction, expr parser.RuntimeInformation) (value.Primary, error) {
@@ -50,6 +52,12 @@ func GetRuntimeInformation(tx *Transaction, expr parser.RuntimeInformation) (val
 		p = value.NewString(wd)
 	case VersionInformation:
 		p = value.NewString(Version)
+	case LastLoadWarnings:
+		if len(tx.lastLoadWarnings) < 1 {
+			p = value.NewNull()
+		} else {
+			p = value.NewString(tx.lastLoadWarnings)
+		}
 	default:
 		return p, NewInvalidRuntimeInformationError(expr)
 	}
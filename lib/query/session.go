@@ -13,6 +13,8 @@ import (
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/file"
 	"github.com/mithrandie/csvq/lib/parser"
+
+	"golang.org/x/crypto/ssh/terminal"
 )
 
 var (
@@ -177,6 +179,12 @@ type Session struct {
 	outFile  io.Writer
 	terminal VirtualTerminal
 
+	appendedOutFile *AppendedOutFile
+	parquetWritten  bool
+
+	outPartition *PartitionedOutput
+	tee          *TeeOutput
+
 	CanReadStdin bool
 	stdinViewMap ViewMap
 	stdinLocker  *StdinLocker
@@ -223,10 +231,51 @@ func (sess *Session) OutFile() io.Writer {
 	return sess.outFile
 }
 
+func (sess *Session) AppendedOutFile() *AppendedOutFile {
+	return sess.appendedOutFile
+}
+
+func (sess *Session) OutPartition() *PartitionedOutput {
+	return sess.outPartition
+}
+
+func (sess *Session) Tee() *TeeOutput {
+	return sess.tee
+}
+
 func (sess *Session) Terminal() VirtualTerminal {
 	return sess.terminal
 }
 
+// StdoutWidth returns the width of the terminal on which the standard output
+// is displayed. If the standard output is not a terminal, then returns false.
+func (sess *Session) StdoutWidth() (int, bool) {
+	if sess.terminal != nil {
+		if w, _, err := sess.terminal.GetSize(); err == nil {
+			return w, true
+		}
+		return 0, false
+	}
+
+	if fp, ok := sess.stdout.(*os.File); ok {
+		if w, _, err := terminal.GetSize(int(fp.Fd())); err == nil {
+			return w, true
+		}
+	}
+	return 0, false
+}
+
+// StdoutIsTerminal returns true if the standard output is displayed on a terminal.
+func (sess *Session) StdoutIsTerminal() bool {
+	if sess.terminal != nil {
+		return true
+	}
+	if fp, ok := sess.stdout.(*os.File); ok {
+		return terminal.IsTerminal(int(fp.Fd()))
+	}
+	return false
+}
+
 func (sess *Session) SetStdin(r io.ReadCloser) error {
 	return sess.SetStdinContext(context.Background(), r)
 }
@@ -251,6 +300,7 @@ func (sess *Session) SetStdinContext(ctx context.Context, r io.ReadCloser) error
 func (sess *Session) SetStdout(w io.WriteCloser) {
 	sess.mtx.Lock()
 	sess.stdout = w
+	sess.parquetWritten = false
 	sess.mtx.Unlock()
 }
 
@@ -263,6 +313,25 @@ func (sess *Session) SetStderr(w io.WriteCloser) {
 func (sess *Session) SetOutFile(w io.Writer) {
 	sess.mtx.Lock()
 	sess.outFile = w
+	sess.parquetWritten = false
+	sess.mtx.Unlock()
+}
+
+func (sess *Session) SetAppendedOutFile(f *AppendedOutFile) {
+	sess.mtx.Lock()
+	sess.appendedOutFile = f
+	sess.mtx.Unlock()
+}
+
+func (sess *Session) SetOutPartition(p *PartitionedOutput) {
+	sess.mtx.Lock()
+	sess.outPartition = p
+	sess.mtx.Unlock()
+}
+
+func (sess *Session) SetTee(t *TeeOutput) {
+	sess.mtx.Lock()
+	sess.tee = t
 	sess.mtx.Unlock()
 }
 
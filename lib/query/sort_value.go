@@ -10,6 +10,7 @@ import (
 	"github.com/mithrandie/csvq/lib/value"
 
 	"github.com/mithrandie/ternary"
+	"golang.org/x/text/collate"
 )
 
 type SortValueType int
@@ -25,9 +26,14 @@ const (
 
 type SortValues []*SortValue
 
-func (values SortValues) Less(compareValues SortValues, directions []int, nullPositions []int) bool {
+func (values SortValues) Less(compareValues SortValues, directions []int, nullPositions []int, collators []*collate.Collator) bool {
 	for i, val := range values {
-		t := val.Less(compareValues[i])
+		var t ternary.Value
+		if collators != nil && collators[i] != nil {
+			t = val.LessWithCollator(compareValues[i], collators[i])
+		} else {
+			t = val.Less(compareValues[i])
+		}
 		if t != ternary.UNKNOWN {
 			if directions[i] == parser.ASC {
 				return t == ternary.TRUE
@@ -184,6 +190,20 @@ func (v *SortValue) Less(compareValue *SortValue) ternary.Value {
 	return ternary.UNKNOWN
 }
 
+// LessWithCollator compares strings using the collator.
+// Any other combination of types is compared in the same way as Less.
+func (v *SortValue) LessWithCollator(compareValue *SortValue, collator *collate.Collator) ternary.Value {
+	if v.Type != StringType || compareValue.Type != StringType {
+		return v.Less(compareValue)
+	}
+
+	c := collator.CompareString(v.String, compareValue.String)
+	if c == 0 {
+		return ternary.UNKNOWN
+	}
+	return ternary.ConvertFromBool(c < 0)
+}
+
 func (v *SortValue) EquivalentTo(compareValue *SortValue) bool {
 	switch v.Type {
 	case IntegerType:
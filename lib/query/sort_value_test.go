@@ -8,6 +8,8 @@ import (
 	"github.com/mithrandie/csvq/lib/value"
 
 	"github.com/mithrandie/ternary"
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
 )
 
 func TestSortValues_Serialize(t *testing.T) {
@@ -138,6 +140,52 @@ func TestSortValue_Less(t *testing.T) {
 	}
 }
 
+var sortValueLessWithCollatorTests = []struct {
+	Name         string
+	SortValue    *SortValue
+	CompareValue *SortValue
+	Collation    string
+	Result       ternary.Value
+}{
+	{
+		Name:         "SortValue LessWithCollator String",
+		SortValue:    NewSortValue(value.NewString("\u00c4pfel"), TestTx.Flags),
+		CompareValue: NewSortValue(value.NewString("Zebra"), TestTx.Flags),
+		Collation:    "de",
+		Result:       ternary.TRUE,
+	},
+	{
+		Name:         "SortValue LessWithCollator String in Another Language",
+		SortValue:    NewSortValue(value.NewString("\u00c4pfel"), TestTx.Flags),
+		CompareValue: NewSortValue(value.NewString("Zebra"), TestTx.Flags),
+		Collation:    "sv",
+		Result:       ternary.FALSE,
+	},
+	{
+		Name:         "SortValue LessWithCollator String Equal",
+		SortValue:    NewSortValue(value.NewString(" aaa "), TestTx.Flags),
+		CompareValue: NewSortValue(value.NewString("AAA"), TestTx.Flags),
+		Collation:    "de",
+		Result:       ternary.UNKNOWN,
+	},
+	{
+		Name:         "SortValue LessWithCollator Integer",
+		SortValue:    NewSortValue(value.NewInteger(10), TestTx.Flags),
+		CompareValue: NewSortValue(value.NewInteger(9), TestTx.Flags),
+		Collation:    "de",
+		Result:       ternary.FALSE,
+	},
+}
+
+func TestSortValue_LessWithCollator(t *testing.T) {
+	for _, v := range sortValueLessWithCollatorTests {
+		result := v.SortValue.LessWithCollator(v.CompareValue, collate.New(language.MustParse(v.Collation)))
+		if result != v.Result {
+			t.Errorf("%s: result = %s, want %s", v.Name, result, v.Result)
+		}
+	}
+}
+
 var sortValueEquivalentToTests = []struct {
 	Name         string
 	SortValue    *SortValue
@@ -0,0 +1,112 @@
+package query
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/mithrandie/go-text"
+)
+
+// SplitReader reads records from a text in which fields are separated by
+// a multi-character string or by a regular expression.
+// Quoting is not interpreted, so each line is split as is.
+type SplitReader struct {
+	Delimiter      string
+	DelimiterRegex *regexp.Regexp
+	WithoutNull    bool
+
+	reader *bufio.Reader
+	line   int
+
+	FieldsPerRecord int
+
+	DetectedLineBreak text.LineBreak
+}
+
+func NewSplitReader(r io.Reader, enc text.Encoding) (*SplitReader, error) {
+	decoder, err := getTransformDecoder(r, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SplitReader{
+		reader:          bufio.NewReader(decoder),
+		line:            0,
+		FieldsPerRecord: 0,
+	}, nil
+}
+
+func (r *SplitReader) newError(s string) error {
+	return errors.New(fmt.Sprintf("line %d: %s", r.line, s))
+}
+
+func (r *SplitReader) ReadHeader() ([]string, error) {
+	return r.readFields()
+}
+
+func (r *SplitReader) Read() ([]text.RawText, error) {
+	fields, err := r.readFields()
+	if err != nil {
+		return nil, err
+	}
+
+	record := make([]text.RawText, len(fields))
+	for i, f := range fields {
+		if len(f) < 1 && !r.WithoutNull {
+			continue
+		}
+		record[i] = text.RawText(f)
+	}
+	return record, nil
+}
+
+func (r *SplitReader) readFields() ([]string, error) {
+	line, err := r.readLine()
+	if err != nil {
+		return nil, err
+	}
+
+	var fields []string
+	if r.DelimiterRegex != nil {
+		fields = r.DelimiterRegex.Split(line, -1)
+	} else {
+		fields = strings.Split(line, r.Delimiter)
+	}
+
+	if r.FieldsPerRecord < 1 {
+		r.FieldsPerRecord = len(fields)
+	} else if len(fields) != r.FieldsPerRecord {
+		return nil, r.newError("wrong number of fields in line")
+	}
+	return fields, nil
+}
+
+func (r *SplitReader) readLine() (string, error) {
+	for {
+		line, err := r.reader.ReadString('\n')
+		if err != nil && (err != io.EOF || len(line) < 1) {
+			return "", err
+		}
+		r.line++
+
+		if strings.HasSuffix(line, "\r\n") {
+			line = line[:len(line)-2]
+			if r.DetectedLineBreak == "" {
+				r.DetectedLineBreak = text.CRLF
+			}
+		} else if strings.HasSuffix(line, "\n") {
+			line = line[:len(line)-1]
+			if r.DetectedLineBreak == "" {
+				r.DetectedLineBreak = text.LF
+			}
+		}
+
+		if 0 < len(line) {
+			return line, nil
+		}
+	}
+}
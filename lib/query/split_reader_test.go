@@ -0,0 +1,101 @@
+package query
+
+import (
+	"io"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/mithrandie/go-text"
+)
+
+var splitReaderReadTests = []struct {
+	Name           string
+	Input          string
+	Delimiter      string
+	DelimiterRegex string
+	WithoutNull    bool
+	Result         [][]text.RawText
+	LineBreak      text.LineBreak
+	Error          string
+}{
+	{
+		Name:      "Multi-Character Delimiter",
+		Input:     "a||b\n\n1||\n",
+		Delimiter: "||",
+		Result: [][]text.RawText{
+			{text.RawText("a"), text.RawText("b")},
+			{text.RawText("1"), nil},
+		},
+		LineBreak: text.LF,
+	},
+	{
+		Name:        "Multi-Character Delimiter Without Null",
+		Input:       "a||b\r\n1||",
+		Delimiter:   "||",
+		WithoutNull: true,
+		Result: [][]text.RawText{
+			{text.RawText("a"), text.RawText("b")},
+			{text.RawText("1"), text.RawText("")},
+		},
+		LineBreak: text.CRLF,
+	},
+	{
+		Name:           "Delimiter Regex",
+		Input:          "a \t b\n\"1\"  2",
+		DelimiterRegex: "\\s+",
+		Result: [][]text.RawText{
+			{text.RawText("a"), text.RawText("b")},
+			{text.RawText("\"1\""), text.RawText("2")},
+		},
+		LineBreak: text.LF,
+	},
+	{
+		Name:      "Wrong Number of Fields",
+		Input:     "a::b\n1::2::3",
+		Delimiter: "::",
+		Error:     "line 2: wrong number of fields in line",
+	},
+}
+
+func TestSplitReader_Read(t *testing.T) {
+	for _, v := range splitReaderReadTests {
+		r, _ := NewSplitReader(strings.NewReader(v.Input), text.UTF8)
+		r.Delimiter = v.Delimiter
+		if 0 < len(v.DelimiterRegex) {
+			r.DelimiterRegex = regexp.MustCompile(v.DelimiterRegex)
+		}
+		r.WithoutNull = v.WithoutNull
+
+		var records [][]text.RawText
+		var err error
+		for {
+			var record []text.RawText
+			record, err = r.Read()
+			if err != nil {
+				break
+			}
+			records = append(records, record)
+		}
+
+		if err != io.EOF {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(records, v.Result) {
+			t.Errorf("%s: result = %q, want %q", v.Name, records, v.Result)
+		}
+		if r.DetectedLineBreak != v.LineBreak {
+			t.Errorf("%s: line break = %q, want %q", v.Name, r.DetectedLineBreak, v.LineBreak)
+		}
+	}
+}
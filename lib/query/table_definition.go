@@ -0,0 +1,190 @@
+package query
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+// TableDefinitionFileSuffix is appended to the path of a table file to get the path
+// of the table definition file, in which the column definitions are stored as
+// a CREATE TABLE statement.
+const TableDefinitionFileSuffix = ".def.sql"
+
+func TableDefinitionFilePath(fpath string) string {
+	return fpath + TableDefinitionFileSuffix
+}
+
+func hasColumnDefinition(field HeaderField) bool {
+	return field.Default != nil || field.NotNull || field.Unique || 0 < len(field.Checks)
+}
+
+// TableDefinition returns a CREATE TABLE statement that defines the columns of the view.
+// If no column has a definition, then returns an empty string.
+func TableDefinition(view *View) string {
+	defined := false
+	for _, field := range view.Header {
+		if field.IsFromTable && hasColumnDefinition(field) {
+			defined = true
+			break
+		}
+	}
+	if !defined {
+		return ""
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("CREATE TABLE ")
+	buf.WriteString(cmd.QuoteIdentifier(filepath.Base(view.FileInfo.Path)))
+	buf.WriteString(" (")
+	columns := 0
+	for _, field := range view.Header {
+		if !field.IsFromTable {
+			continue
+		}
+		if 0 < columns {
+			buf.WriteByte(',')
+		}
+		buf.WriteString("\n  ")
+		buf.WriteString(cmd.QuoteIdentifier(field.Column))
+		if field.NotNull {
+			buf.WriteString(" NOT NULL")
+		}
+		if field.Unique {
+			buf.WriteString(" UNIQUE")
+		}
+		if field.Default != nil {
+			buf.WriteString(" DEFAULT ")
+			buf.WriteString(field.Default.String())
+		}
+		for _, check := range field.Checks {
+			buf.WriteString(" CHECK (")
+			buf.WriteString(check.String())
+			buf.WriteByte(')')
+		}
+		columns++
+	}
+	buf.WriteString("\n);\n")
+	return buf.String()
+}
+
+// WriteTableDefinition writes the column definitions of the view to the temporary file
+// of the table definition file, and sets the handler to the file info of the view.
+// The table definition file is replaced when the handler is committed with the table.
+// If no column has a definition, then the table definition file is removed on commit.
+func WriteTableDefinition(ctx context.Context, tx *Transaction, view *View) error {
+	fpath := TableDefinitionFilePath(view.FileInfo.Path)
+	def := TableDefinition(view)
+
+	h := view.FileInfo.DefinitionHandler
+	if h == nil {
+		var err error
+		if file.Exists(fpath) {
+			h, err = file.NewHandlerForUpdate(ctx, tx.FileContainer, fpath, tx.WaitTimeout, tx.RetryDelay)
+		} else if 0 < len(def) {
+			h, err = file.NewHandlerForCreate(tx.FileContainer, fpath)
+		}
+		if err != nil {
+			return err
+		}
+		if h == nil {
+			return nil
+		}
+		view.FileInfo.DefinitionHandler = h
+	}
+
+	if len(def) < 1 {
+		h.SetRemoval(true)
+		return nil
+	}
+	h.SetRemoval(false)
+
+	fp, err := h.FileForUpdate()
+	if err != nil {
+		return err
+	}
+	if err = fp.Truncate(0); err != nil {
+		return err
+	}
+	if _, err = fp.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	_, err = fp.WriteString(def)
+	return err
+}
+
+// LoadTableDefinition sets the column definitions written in the table definition file
+// to the header of the view. Columns that are not written in the file have no definition.
+//
+// If the table is loaded for update, the table definition file is kept locked
+// until the transaction ends, and the handler is set to the file info of the view.
+func LoadTableDefinition(ctx context.Context, tx *Transaction, view *View, expr parser.QueryExpression) (err error) {
+	fpath := TableDefinitionFilePath(view.FileInfo.Path)
+	if !file.Exists(fpath) {
+		return nil
+	}
+
+	var h *file.Handler
+	if view.FileInfo.ForUpdate {
+		if h, err = file.NewHandlerForUpdate(ctx, tx.FileContainer, fpath, tx.WaitTimeout, tx.RetryDelay); err != nil {
+			return ConvertFileHandlerError(err, parser.Identifier{BaseExpr: expr.GetBaseExpr(), Literal: fpath})
+		}
+		view.FileInfo.DefinitionHandler = h
+	} else {
+		if h, err = file.NewHandlerForRead(ctx, tx.FileContainer, fpath, tx.WaitTimeout, tx.RetryDelay); err != nil {
+			return ConvertFileHandlerError(err, parser.Identifier{BaseExpr: expr.GetBaseExpr(), Literal: fpath})
+		}
+		defer func() {
+			err = appendCompositeError(err, tx.FileContainer.Close(h))
+		}()
+	}
+
+	buf, err := ioutil.ReadAll(h.File())
+	if err != nil {
+		return NewIOError(expr, err.Error())
+	}
+
+	statements, _, err := parser.Parse(string(buf), fpath, tx.Flags.DatetimeFormat, false, tx.Flags.AnsiQuotes)
+	if err != nil {
+		return NewSyntaxError(err.(*parser.SyntaxError))
+	}
+
+	var def parser.CreateTable
+	if len(statements) == 1 {
+		def, _ = statements[0].(parser.CreateTable)
+	}
+	if def.Fields == nil || def.Query != nil {
+		return NewInvalidTableDefinitionError(expr, fpath, "file must contain only a create table statement with column definitions")
+	}
+	if !isDefinedTableName(def.Table.Literal, view.FileInfo.Path) {
+		return NewInvalidTableDefinitionError(expr, fpath, fmt.Sprintf("table name %s does not match the file name %s", cmd.QuoteIdentifier(def.Table.Literal), cmd.QuoteIdentifier(filepath.Base(view.FileInfo.Path))))
+	}
+
+	for _, c := range def.Columns {
+		idx, err := view.Header.FieldIndex(parser.FieldReference{Column: c.Column})
+		if err != nil {
+			return NewInvalidTableDefinitionError(expr, fpath, fmt.Sprintf("field %s does not exist", c.Column))
+		}
+		view.Header[idx].Default = c.Value
+		view.Header[idx].NotNull = c.NotNull
+		view.Header[idx].Unique = c.Unique
+		view.Header[idx].Checks = c.Checks
+	}
+	view.Header.AddTableChecks(def.Checks)
+	return nil
+}
+
+// isDefinedTableName returns whether the table name written in a table definition file
+// refers to the file. The name is the file name with or without the extension.
+func isDefinedTableName(name string, fpath string) bool {
+	base := filepath.Base(fpath)
+	return strings.EqualFold(name, base) || strings.EqualFold(name, strings.TrimSuffix(base, filepath.Ext(base)))
+}
@@ -0,0 +1,171 @@
+package query
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+var loadTableDefinitionTests = []struct {
+	Name       string
+	Definition string
+	Result     string
+	Error      string
+}{
+	{
+		Name:   "No Definition File",
+		Result: "",
+	},
+	{
+		Name:       "Column Definitions",
+		Definition: "CREATE TABLE table_definition (column2 DEFAULT 1 NOT NULL, column1 CHECK (column1 <> '') UNIQUE, check (column2 <> column1));",
+		Result:     "CREATE TABLE `table_definition.csv` (\n  `column1` UNIQUE CHECK (column1 <> ''),\n  `column2` NOT NULL DEFAULT 1 CHECK (column2 <> column1)\n);\n",
+	},
+	{
+		Name:       "Field Not Exist",
+		Definition: "CREATE TABLE `table_definition.csv` (`column3` DEFAULT 1);",
+		Error:      "invalid table definition file " + TableDefinitionFilePath(GetTestFilePath("table_definition.csv")) + ": field `column3` does not exist",
+	},
+	{
+		Name:       "Table Name Not Match",
+		Definition: "CREATE TABLE `table2.csv` (`column1` DEFAULT 1);",
+		Error:      "invalid table definition file " + TableDefinitionFilePath(GetTestFilePath("table_definition.csv")) + ": table name `table2.csv` does not match the file name `table_definition.csv`",
+	},
+	{
+		Name:       "Not Create Table Statement",
+		Definition: "SELECT 1;",
+		Error:      "invalid table definition file " + TableDefinitionFilePath(GetTestFilePath("table_definition.csv")) + ": file must contain only a create table statement with column definitions",
+	},
+}
+
+func TestLoadTableDefinition(t *testing.T) {
+	fpath := TableDefinitionFilePath(GetTestFilePath("table_definition.csv"))
+	defer func() {
+		_ = os.Remove(fpath)
+	}()
+
+	for _, v := range loadTableDefinitionTests {
+		_ = os.Remove(fpath)
+		if 0 < len(v.Definition) {
+			_ = ioutil.WriteFile(fpath, []byte(v.Definition), 0664)
+		}
+
+		view := &View{
+			Header:   NewHeader("table_definition", []string{"column1", "column2"}),
+			FileInfo: &FileInfo{Path: GetTestFilePath("table_definition.csv")},
+		}
+
+		err := LoadTableDefinition(context.Background(), TestTx, view, parser.Identifier{Literal: "table_definition"})
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if def := TableDefinition(view); def != v.Result {
+			t.Errorf("%s: definition = %q, want %q", v.Name, def, v.Result)
+		}
+	}
+}
+
+var tableDefinitionLifecycleTests = []struct {
+	Name       string
+	Query      string
+	Definition string
+}{
+	{
+		Name:       "Create Table Replaces Stale File",
+		Query:      "CREATE TABLE `table_definition_lifecycle.csv` (id NOT NULL, name UNIQUE); COMMIT;",
+		Definition: "CREATE TABLE `table_definition_lifecycle.csv` (\n  `id` NOT NULL,\n  `name` UNIQUE\n);\n",
+	},
+	{
+		Name:       "Rollback Keeps File",
+		Query:      "ALTER TABLE `table_definition_lifecycle.csv` RENAME name TO title; ROLLBACK;",
+		Definition: "CREATE TABLE `table_definition_lifecycle.csv` (\n  `id` NOT NULL,\n  `name` UNIQUE\n);\n",
+	},
+	{
+		Name:       "Rename Column",
+		Query:      "ALTER TABLE `table_definition_lifecycle.csv` RENAME name TO title; COMMIT;",
+		Definition: "CREATE TABLE `table_definition_lifecycle.csv` (\n  `id` NOT NULL,\n  `title` UNIQUE\n);\n",
+	},
+	{
+		Name:       "Add Column with Check Constraint",
+		Query:      "ALTER TABLE `table_definition_lifecycle.csv` ADD score CHECK (score >= 0) DEFAULT 0; COMMIT;",
+		Definition: "CREATE TABLE `table_definition_lifecycle.csv` (\n  `id` NOT NULL,\n  `title` UNIQUE,\n  `score` DEFAULT 0 CHECK (score >= 0)\n);\n",
+	},
+	{
+		Name:       "Drop Column",
+		Query:      "ALTER TABLE `table_definition_lifecycle.csv` DROP title; COMMIT;",
+		Definition: "CREATE TABLE `table_definition_lifecycle.csv` (\n  `id` NOT NULL,\n  `score` DEFAULT 0 CHECK (score >= 0)\n);\n",
+	},
+	{
+		Name:       "Rename Column Referred by Check Constraint",
+		Query:      "ALTER TABLE `table_definition_lifecycle.csv` RENAME score TO points; INSERT INTO `table_definition_lifecycle.csv` (id, points) VALUES (1, 2); COMMIT;",
+		Definition: "CREATE TABLE `table_definition_lifecycle.csv` (\n  `id` NOT NULL,\n  `points` DEFAULT 0 CHECK (points >= 0)\n);\n",
+	},
+	{
+		Name:       "Drop Column Referred by Check Constraint",
+		Query:      "ALTER TABLE `table_definition_lifecycle.csv` ADD bonus CHECK (bonus <= points); ALTER TABLE `table_definition_lifecycle.csv` DROP points; UPDATE `table_definition_lifecycle.csv` SET bonus = 3; COMMIT;",
+		Definition: "CREATE TABLE `table_definition_lifecycle.csv` (\n  `id` NOT NULL,\n  `bonus`\n);\n",
+	},
+	{
+		Name:       "Remove File When No Definition",
+		Query:      "ALTER TABLE `table_definition_lifecycle.csv` ADD note; ALTER TABLE `table_definition_lifecycle.csv` DROP (id, bonus); COMMIT;",
+		Definition: "",
+	},
+}
+
+func TestTableDefinitionFileLifecycle(t *testing.T) {
+	fpath := GetTestFilePath("table_definition_lifecycle.csv")
+	defpath := TableDefinitionFilePath(fpath)
+	defer func() {
+		_ = TestTx.ReleaseResources()
+		TestTx.Session.SetStdout(NewDiscard())
+		initFlag(TestTx.Flags)
+		_ = os.Remove(fpath)
+		_ = os.Remove(defpath)
+	}()
+
+	TestTx.Flags.Repository = TestDir
+	_ = ioutil.WriteFile(defpath, []byte("CREATE TABLE `table_definition_lifecycle.csv` (stale NOT NULL);"), 0664)
+
+	for _, v := range tableDefinitionLifecycleTests {
+		statements, _, err := parser.Parse(v.Query, "", TestTx.Flags.DatetimeFormat, false, TestTx.Flags.AnsiQuotes)
+		if err != nil {
+			t.Fatalf("%s: unexpected error %q", v.Name, err)
+		}
+		if _, err = NewProcessor(TestTx).Execute(context.Background(), statements); err != nil {
+			t.Fatalf("%s: unexpected error %q", v.Name, err)
+		}
+
+		if file.Exists(file.TempFilePath(defpath)) || file.LockExists(defpath) {
+			t.Errorf("%s: temporary or lock file of the table definition file is left", v.Name)
+		}
+
+		if len(v.Definition) < 1 {
+			if file.Exists(defpath) {
+				t.Errorf("%s: table definition file exists, want no file", v.Name)
+			}
+			continue
+		}
+
+		def, err := ioutil.ReadFile(defpath)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		if string(def) != v.Definition {
+			t.Errorf("%s: definition = %q, want %q", v.Name, string(def), v.Definition)
+		}
+	}
+}
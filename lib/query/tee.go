@@ -0,0 +1,152 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+
+	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/go-text/color"
+)
+
+// TeeOutput writes copies of result sets to a file while they are written to
+// the standard output.
+//
+// The format of the file is determined by its extension, or is the export format
+// at the time the file is opened. Result sets are separated by blank lines in text
+// formats and are enclosed in a JSON array in JSON, and the file is kept complete
+// after each result set is written.
+type TeeOutput struct {
+	Path   string
+	Format cmd.Format
+
+	fp       *os.File
+	palette  *color.Palette
+	count    int
+	closePos int64
+}
+
+func OpenTeeOutput(path string, defaultFormat cmd.Format) (*TeeOutput, error) {
+	format, ok := cmd.FormatFromExt(path)
+	if !ok {
+		format = defaultFormat
+	}
+	if format == cmd.PARQUET {
+		return nil, errors.New("PARQUET format cannot be used for tee output")
+	}
+
+	fp, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0666)
+	if err != nil {
+		return nil, NewIOError(nil, err.Error())
+	}
+
+	return &TeeOutput{
+		Path:    path,
+		Format:  format,
+		fp:      fp,
+		palette: color.NewPalette(),
+	}, nil
+}
+
+func (t *TeeOutput) Write(ctx context.Context, view *View, options cmd.ExportOptions) error {
+	options.Format = t.Format
+	options.Compression = cmd.NoCompression
+	options.Color = false
+
+	enc := options.Encoding
+	if options.WriteBOM && t.count < 1 {
+		enc = encodingWithBOM(enc)
+	}
+	options.Encoding = encodingWithoutBOM(options.Encoding)
+	options.WriteBOM = false
+	lineBreak := options.LineBreak.Value()
+
+	var prefix string
+	switch options.Format {
+	case cmd.JSON:
+		if 0 < t.count {
+			if _, err := t.fp.Seek(t.closePos, io.SeekStart); err != nil {
+				return NewIOError(nil, err.Error())
+			}
+			prefix = "," + lineBreak
+		} else {
+			prefix = "[" + lineBreak
+		}
+	case cmd.NDJSON:
+	default:
+		if 0 < t.count {
+			prefix = lineBreak
+		}
+	}
+
+	b, err := encodeBytes([]byte(prefix), enc)
+	if err != nil {
+		return NewIOError(nil, err.Error())
+	}
+	w := &prefixWriter{w: t.fp, prefix: b}
+
+	if _, err = EncodeView(ctx, w, view, options, t.palette); err != nil {
+		if err == EmptyResultSetError || err == DataEmpty {
+			return nil
+		}
+		return err
+	}
+	if !w.written {
+		return nil
+	}
+
+	if options.Format == cmd.JSON {
+		if t.closePos, err = t.fp.Seek(0, io.SeekCurrent); err != nil {
+			return NewIOError(nil, err.Error())
+		}
+		if err = t.writeString(lineBreak+"]", options.Encoding); err != nil {
+			return err
+		}
+	}
+	if !(options.Format == cmd.FIXED && options.SingleLine) {
+		if err = t.writeString(lineBreak, options.Encoding); err != nil {
+			return err
+		}
+	}
+
+	t.count++
+	return nil
+}
+
+func (t *TeeOutput) writeString(s string, enc text.Encoding) error {
+	b, err := encodeBytes([]byte(s), enc)
+	if err != nil {
+		return NewIOError(nil, err.Error())
+	}
+	if _, err = t.fp.Write(b); err != nil {
+		return NewIOError(nil, err.Error())
+	}
+	return nil
+}
+
+func (t *TeeOutput) Close() error {
+	if err := t.fp.Close(); err != nil {
+		return NewIOError(nil, err.Error())
+	}
+	return nil
+}
+
+// prefixWriter writes the prefix before the first data written to w.
+type prefixWriter struct {
+	w       io.Writer
+	prefix  []byte
+	written bool
+}
+
+func (pw *prefixWriter) Write(p []byte) (int, error) {
+	if !pw.written {
+		pw.written = true
+		if _, err := pw.w.Write(pw.prefix); err != nil {
+			return 0, err
+		}
+	}
+	return pw.w.Write(p)
+}
@@ -0,0 +1,140 @@
+package query
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var teeOutputWriteTests = []struct {
+	Name          string
+	Path          string
+	DefaultFormat cmd.Format
+	Views         []*View
+	Expect        string
+}{
+	{
+		Name:          "TeeOutput Write CSV",
+		Path:          "tee.csv",
+		DefaultFormat: cmd.TEXT,
+		Views: []*View{
+			{
+				Header: NewHeader("t", []string{"c1", "c2"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a")}),
+				},
+			},
+			{
+				Header: NewHeader("t", []string{"c3"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{value.NewInteger(2)}),
+				},
+			},
+		},
+		Expect: "" +
+			"c1,c2\n" +
+			"1,a\n" +
+			"\n" +
+			"c3\n" +
+			"2\n",
+	},
+	{
+		Name:          "TeeOutput Write JSON",
+		Path:          "tee.json",
+		DefaultFormat: cmd.TEXT,
+		Views: []*View{
+			{
+				Header: NewHeader("t", []string{"c1"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{value.NewInteger(1)}),
+				},
+			},
+			{
+				Header: NewHeader("t", []string{"c2"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{value.NewString("a")}),
+				},
+			},
+		},
+		Expect: "" +
+			"[\n" +
+			"[{\"c1\":1}],\n" +
+			"[{\"c2\":\"a\"}]\n" +
+			"]\n",
+	},
+	{
+		Name:          "TeeOutput Write Text Skipping Empty Result Set",
+		Path:          "tee.txt",
+		DefaultFormat: cmd.TEXT,
+		Views: []*View{
+			{
+				Header:    NewHeader("t", []string{"c1"}),
+				RecordSet: []Record{},
+			},
+			{
+				Header: NewHeader("t", []string{"c1"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{value.NewInteger(1)}),
+				},
+			},
+		},
+		Expect: "" +
+			"+----+\n" +
+			"| c1 |\n" +
+			"+----+\n" +
+			"|  1 |\n" +
+			"+----+\n",
+	},
+}
+
+func TestTeeOutput_Write(t *testing.T) {
+	ctx := context.Background()
+	options := cmd.NewExportOptions()
+
+	for _, v := range teeOutputWriteTests {
+		path := GetTestFilePath(v.Path)
+
+		tee, err := OpenTeeOutput(path, v.DefaultFormat)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+
+		for _, view := range v.Views {
+			if err = tee.Write(ctx, view, options); err != nil {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			}
+		}
+		if err = tee.Close(); err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+		}
+
+		buf, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		if string(buf) != v.Expect {
+			t.Errorf("%s: content = %q, want %q", v.Name, string(buf), v.Expect)
+		}
+	}
+}
+
+func TestOpenTeeOutput(t *testing.T) {
+	expectErr := "PARQUET format cannot be used for tee output"
+
+	if _, err := OpenTeeOutput(GetTestFilePath("tee.parquet"), cmd.TEXT); err == nil {
+		t.Errorf("no error, want error %q", expectErr)
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q", err.Error(), expectErr)
+	}
+
+	if _, err := OpenTeeOutput(GetTestFilePath("tee.out"), cmd.PARQUET); err == nil {
+		t.Errorf("no error, want error %q", expectErr)
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q", err.Error(), expectErr)
+	}
+}
@@ -0,0 +1,202 @@
+package query
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+const (
+	RowTemplateName    = "row"
+	HeaderTemplateName = "header"
+	FooterTemplateName = "footer"
+)
+
+// TemplateData is passed to the template in TEMPLATE format.
+type TemplateData struct {
+	Fields  []string
+	Records []map[string]interface{}
+}
+
+// TemplateRowData is passed to the row template for each record.
+type TemplateRowData struct {
+	Fields []string
+	Number int
+	Record map[string]interface{}
+}
+
+var templateFuncs = template.FuncMap{
+	"number":   templateNumber,
+	"datetime": templateDatetime,
+}
+
+// templateNumber formats a number with thousands separators.
+// If precision is omitted, then the number is written with the minimum digits necessary.
+func templateNumber(v interface{}, precision ...int) string {
+	var f float64
+	switch n := v.(type) {
+	case nil:
+		return ""
+	case int64:
+		f = float64(n)
+	case float64:
+		f = n
+	case string:
+		var err error
+		if f, err = strconv.ParseFloat(cmd.TrimSpace(n), 64); err != nil {
+			return n
+		}
+	default:
+		return fmt.Sprint(v)
+	}
+
+	p := -1
+	if 0 < len(precision) {
+		p = precision[0]
+	}
+	return cmd.FormatNumber(f, p, ".", ",", "")
+}
+
+// templateDatetime formats a datetime in the same way as DATETIME_FORMAT function.
+func templateDatetime(v interface{}, format string) string {
+	var t time.Time
+	switch d := v.(type) {
+	case nil:
+		return ""
+	case time.Time:
+		t = d
+	case string:
+		var ok bool
+		if t, ok = value.StrToTime(d, nil); !ok {
+			return d
+		}
+	default:
+		return fmt.Sprint(v)
+	}
+	return t.Format(value.DatetimeFormats.Get(format))
+}
+
+func templateValue(val value.Primary) interface{} {
+	switch v := val.(type) {
+	case *value.String:
+		return v.Raw()
+	case *value.Integer:
+		return v.Raw()
+	case *value.Float:
+		return v.Raw()
+	case *value.Boolean:
+		return v.Raw()
+	case *value.Ternary:
+		if v.Ternary() == ternary.UNKNOWN {
+			return nil
+		}
+		return v.Ternary().ParseBool()
+	case *value.Datetime:
+		return v.Raw()
+	}
+	return nil
+}
+
+func templateRecord(view *View, record Record) map[string]interface{} {
+	m := make(map[string]interface{}, len(record))
+	for i := range record {
+		m[view.Header[i].Column] = templateValue(record[i][0])
+	}
+	return m
+}
+
+func loadTemplate(path string) (*template.Template, error) {
+	if len(path) < 1 {
+		return nil, NewDataEncodingError("template file is not specified for TEMPLATE")
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, NewIOError(nil, err.Error())
+	}
+	src := strings.TrimSuffix(strings.TrimSuffix(string(b), "\n"), "\r")
+
+	t, err := template.New(filepath.Base(path)).Funcs(templateFuncs).Parse(src)
+	if err != nil {
+		return nil, NewDataEncodingError(err.Error())
+	}
+	return t, nil
+}
+
+// encodeTemplate writes the result set with the template file.
+//
+// If the template defines a "row" template, then the "row" template is executed
+// for each record, and the "header" and "footer" templates are executed before
+// and after the records if they are defined. Otherwise, the template is executed
+// once with all the records.
+func encodeTemplate(ctx context.Context, fp io.Writer, view *View, options cmd.ExportOptions) error {
+	t, err := loadTemplate(options.Template)
+	if err != nil {
+		return err
+	}
+
+	tw, err := getTransformWriter(fp, options.Encoding)
+	if err != nil {
+		return NewDataEncodingError(err.Error())
+	}
+	w := bufio.NewWriter(tw)
+
+	fields := make([]string, view.FieldLen())
+	for i := range view.Header {
+		fields[i] = view.Header[i].Column
+	}
+
+	if t.Lookup(RowTemplateName) == nil {
+		records := make([]map[string]interface{}, view.RecordLen())
+		for i := range view.RecordSet {
+			if i&15 == 0 && ctx.Err() != nil {
+				return ConvertContextError(ctx.Err())
+			}
+			records[i] = templateRecord(view, view.RecordSet[i])
+		}
+
+		if err = t.Execute(w, TemplateData{Fields: fields, Records: records}); err != nil {
+			return NewDataEncodingError(err.Error())
+		}
+	} else {
+		if t.Lookup(HeaderTemplateName) != nil {
+			if err = t.ExecuteTemplate(w, HeaderTemplateName, TemplateData{Fields: fields}); err != nil {
+				return NewDataEncodingError(err.Error())
+			}
+		}
+
+		for i := range view.RecordSet {
+			if i&15 == 0 && ctx.Err() != nil {
+				return ConvertContextError(ctx.Err())
+			}
+
+			data := TemplateRowData{Fields: fields, Number: i + 1, Record: templateRecord(view, view.RecordSet[i])}
+			if err = t.ExecuteTemplate(w, RowTemplateName, data); err != nil {
+				return NewDataEncodingError(err.Error())
+			}
+		}
+
+		if t.Lookup(FooterTemplateName) != nil {
+			if err = t.ExecuteTemplate(w, FooterTemplateName, TemplateData{Fields: fields}); err != nil {
+				return NewDataEncodingError(err.Error())
+			}
+		}
+	}
+
+	if err = w.Flush(); err != nil {
+		return NewSystemError(err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package query
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var encodeTemplateTests = []struct {
+	Name     string
+	Template string
+	View     *View
+	Result   string
+	Error    string
+}{
+	{
+		Name: "Template",
+		Template: "{{len .Records}} records\n" +
+			"{{range .Records}}{{.c1}}: {{number .c2 2}} {{datetime .c3 \"%Y/%m/%d\"}}\n{{end}}\n",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2", "c3"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("a"), value.NewInteger(1234), value.NewDatetimeFromString("2012-02-03T09:18:15Z", nil)}),
+				NewRecord([]value.Primary{value.NewString("b"), value.NewString("5.5"), value.NewString("2012-02-04")}),
+				NewRecord([]value.Primary{value.NewString("c"), value.NewNull(), value.NewNull()}),
+			},
+		},
+		Result: "3 records\n" +
+			"a: 1,234.00 2012/02/03\n" +
+			"b: 5.50 2012/02/04\n" +
+			"c:  \n",
+	},
+	{
+		Name:     "Template Fields",
+		Template: "{{range $i, $f := .Fields}}{{if $i}},{{end}}{{$f}}{{end}}",
+		View: &View{
+			Header:    NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{},
+		},
+		Result: "c1,c2",
+	},
+	{
+		Name: "Template Row",
+		Template: "{{define \"header\"}}[{{end}}" +
+			"{{define \"row\"}}{{if gt .Number 1}},{{end}}{{.Record.c1}}{{end}}" +
+			"{{define \"footer\"}}]{{end}}" +
+			"ignored",
+		View: &View{
+			Header: NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1)}),
+				NewRecord([]value.Primary{value.NewFloat(2.5)}),
+				NewRecord([]value.Primary{value.NewBoolean(true)}),
+			},
+		},
+		Result: "[1,2.5,true]",
+	},
+	{
+		Name:     "Template Parse Error",
+		Template: "line1\n{{.c1}\n",
+		View: &View{
+			Header:    NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{},
+		},
+		Error: "data encode error: template: template_test.tmpl:2: bad character U+007D '}'",
+	},
+	{
+		Name:     "Template Execution Error",
+		Template: "line1\n{{.Records.c1}}",
+		View: &View{
+			Header:    NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{},
+		},
+		Error: "data encode error: template: template_test.tmpl:2:10: executing \"template_test.tmpl\" at <.Records.c1>: can't evaluate field c1 in type []map[string]interface {}",
+	},
+	{
+		Name: "Template Not Specified Error",
+		View: &View{
+			Header:    NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{},
+		},
+		Error: "data encode error: template file is not specified for TEMPLATE",
+	},
+}
+
+func TestEncodeTemplate(t *testing.T) {
+	ctx := context.Background()
+	path := GetTestFilePath("template_test.tmpl")
+
+	for _, v := range encodeTemplateTests {
+		options := cmd.NewExportOptions()
+		options.Format = cmd.TEMPLATE
+		if 0 < len(v.Template) {
+			if err := ioutil.WriteFile(path, []byte(v.Template), 0666); err != nil {
+				t.Fatalf("%s: unexpected error %q", v.Name, err)
+			}
+			options.Template = path
+		}
+
+		buf := new(bytes.Buffer)
+		_, err := EncodeView(ctx, buf, v.View, options, TestTx.Palette)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if buf.String() != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, buf.String(), v.Result)
+		}
+	}
+}
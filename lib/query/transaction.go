@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"os"
 	"strconv"
 	"strings"
 	"sync"
@@ -15,6 +16,7 @@ import (
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
 
+	"github.com/mithrandie/go-text"
 	"github.com/mithrandie/go-text/color"
 	"github.com/mithrandie/go-text/fixedlen"
 )
@@ -39,11 +41,17 @@ type Transaction struct {
 
 	flagMutex *sync.RWMutex
 
+	timestamp      time.Time
+	timestampMutex *sync.Mutex
+
 	PreparedStatements PreparedStatementMap
 
 	SelectedViews []*View
 	AffectedRows  int
 
+	// lastLoadWarnings is the summary of the ragged rows in the last loaded file.
+	lastLoadWarnings string
+
 	AutoCommit bool
 }
 
@@ -60,7 +68,7 @@ func NewTransaction(ctx context.Context, defaultWaitTimeout time.Duration, retry
 	}
 	palette.Disable()
 
-	return &Transaction{
+	tx := &Transaction{
 		Session:            session,
 		Environment:        environment,
 		Palette:            palette,
@@ -74,11 +82,14 @@ func NewTransaction(ctx context.Context, defaultWaitTimeout time.Duration, retry
 		viewLoadingMutex:   &sync.Mutex{},
 		stdinIsLocked:      false,
 		flagMutex:          &sync.RWMutex{},
+		timestampMutex:     &sync.Mutex{},
 		PreparedStatements: NewPreparedStatementMap(),
 		SelectedViews:      nil,
 		AffectedRows:       0,
 		AutoCommit:         false,
-	}, nil
+	}
+	tx.resetTimestamp()
+	return tx, nil
 }
 
 func (tx *Transaction) UpdateWaitTimeout(waitTimeout float64, retryDelay time.Duration) {
@@ -101,14 +112,171 @@ func (tx *Transaction) UseColor(useColor bool) {
 	tx.Flags.SetColor(useColor)
 }
 
+// setTee closes the file written by the tee output, and opens the file at the path
+// to write copies of the following result sets unless the path is empty.
+func (tx *Transaction) setTee(path string) error {
+	if err := tx.CloseTee(); err != nil {
+		return err
+	}
+
+	tx.Flags.SetTee(path)
+	if len(tx.Flags.Tee) < 1 {
+		return nil
+	}
+
+	t, err := OpenTeeOutput(tx.Flags.Tee, tx.Flags.ExportOptions.Format)
+	if err != nil {
+		tx.Flags.SetTee("")
+		return err
+	}
+	tx.Session.SetTee(t)
+	return nil
+}
+
+// CloseTee closes the file written by the tee output.
+func (tx *Transaction) CloseTee() error {
+	t := tx.Session.Tee()
+	if t == nil {
+		return nil
+	}
+
+	tx.Session.SetTee(nil)
+	tx.Flags.SetTee("")
+	return t.Close()
+}
+
+// Timestamp returns the time at which the current transaction started.
+// The time is captured when the transaction starts, and again when it ends by commit or rollback.
+func (tx *Transaction) Timestamp() time.Time {
+	tx.timestampMutex.Lock()
+	defer tx.timestampMutex.Unlock()
+
+	return tx.timestamp
+}
+
+func (tx *Transaction) writeFile(ctx context.Context, fp io.Writer, view *View, fileinfo *FileInfo) error {
+	options := fileinfo.ExportOptions(tx)
+	if tx.Flags.ExportOptions.Compression != cmd.NoCompression {
+		options.Compression = tx.Flags.ExportOptions.Compression
+	}
+	w, err := NewCompressWriter(fp, options.Compression, options.CompressionLevel)
+	if err != nil {
+		return err
+	}
+
+	if _, err = EncodeView(ctx, w, viewForWriting(view), options, tx.Palette); err != nil {
+		return err
+	}
+
+	if !tx.Flags.ExportOptions.StripEndingLineBreak && !(fileinfo.Format == cmd.FIXED && fileinfo.SingleLine) && fileinfo.Format != cmd.PARQUET {
+		if _, err = w.Write([]byte(tx.Flags.ExportOptions.LineBreak.Value())); err != nil {
+			return err
+		}
+	}
+	return w.Close()
+}
+
+// appendableRecordLen returns the number of records that are already written in the file
+// if the inserted records can be appended to the file without rewriting it.
+func (tx *Transaction) appendableRecordLen(fileinfo *FileInfo) (int, bool) {
+	if !tx.Flags.AppendCommit {
+		return 0, false
+	}
+
+	n, ok := tx.uncommittedViews.InsertedOnly(fileinfo)
+	if !ok {
+		return 0, false
+	}
+
+	switch fileinfo.Format {
+	case cmd.CSV, cmd.TSV, cmd.LTSV, cmd.NDJSON:
+	default:
+		return 0, false
+	}
+	switch fileinfo.Encoding {
+	case text.UTF8, text.UTF8M, text.SJIS, cmd.EUCJP:
+	default:
+		return 0, false
+	}
+	if fileinfo.Compression != cmd.NoCompression || tx.Flags.ExportOptions.Compression != cmd.NoCompression || fileinfo.Handler == nil {
+		return 0, false
+	}
+
+	if fi, err := fileinfo.Handler.File().Stat(); err != nil || fi.Size() < 1 {
+		return 0, false
+	}
+	return n, true
+}
+
+// appendFile writes the records of the view to the end of the original file.
+// The contents of the file before appending are never rewritten, and the file is
+// truncated to its original size if writing fails.
+func (tx *Transaction) appendFile(ctx context.Context, view *View) error {
+	fp, err := view.FileInfo.Handler.FileForAppend()
+	if err != nil {
+		return err
+	}
+
+	size, err := fp.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+
+	if err = tx.writeAppendedRecords(ctx, fp, size, view); err != nil {
+		return appendCompositeError(err, fp.Truncate(size))
+	}
+	return nil
+}
+
+func (tx *Transaction) writeAppendedRecords(ctx context.Context, fp *os.File, size int64, view *View) error {
+	options := view.FileInfo.ExportOptions(tx)
+	options.WithoutHeader = true
+	options.WriteBOM = false
+	if options.Encoding == text.UTF8M {
+		options.Encoding = text.UTF8
+	}
+
+	last := make([]byte, 1)
+	if _, err := fp.ReadAt(last, size-1); err != nil {
+		return err
+	}
+	if last[0] != '\n' && last[0] != '\r' {
+		if _, err := fp.Write([]byte(options.LineBreak.Value())); err != nil {
+			return err
+		}
+	}
+
+	if _, err := EncodeView(ctx, fp, viewForWriting(view), options, tx.Palette); err != nil {
+		return err
+	}
+
+	if !tx.Flags.ExportOptions.StripEndingLineBreak {
+		if _, err := fp.Write([]byte(options.LineBreak.Value())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (tx *Transaction) resetTimestamp() {
+	tx.timestampMutex.Lock()
+	tx.timestamp = cmd.Now()
+	tx.timestampMutex.Unlock()
+}
+
 func (tx *Transaction) Commit(ctx context.Context, scope *ReferenceScope, expr parser.Expression) error {
 	tx.operationMutex.Lock()
 	defer tx.operationMutex.Unlock()
 
 	createdFiles, updatedFiles := tx.uncommittedViews.UncommittedFiles()
 
+	if tx.Flags.DryRun {
+		return tx.discardDryRun(scope, expr, createdFiles, updatedFiles)
+	}
+
 	createFileInfo := make([]*FileInfo, 0, len(createdFiles))
 	updateFileInfo := make([]*FileInfo, 0, len(updatedFiles))
+	appendViews := make([]*View, 0, len(updatedFiles))
 
 	if 0 < len(createdFiles) {
 		for _, fileinfo := range createdFiles {
@@ -122,14 +290,11 @@ func (tx *Transaction) Commit(ctx context.Context, scope *ReferenceScope, expr p
 				return NewSystemError(err.Error())
 			}
 
-			if _, err := EncodeView(ctx, fp, view, fileinfo.ExportOptions(tx), tx.Palette); err != nil {
+			if err := tx.writeFile(ctx, fp, view, fileinfo); err != nil {
 				return NewCommitError(expr, err.Error())
 			}
-
-			if !tx.Flags.ExportOptions.StripEndingLineBreak && !(fileinfo.Format == cmd.FIXED && fileinfo.SingleLine) {
-				if _, err := fp.Write([]byte(tx.Flags.ExportOptions.LineBreak.Value())); err != nil {
-					return NewCommitError(expr, err.Error())
-				}
+			if err := WriteTableDefinition(ctx, tx, view); err != nil {
+				return NewCommitError(expr, err.Error())
 			}
 
 			createFileInfo = append(createFileInfo, view.FileInfo)
@@ -140,6 +305,15 @@ func (tx *Transaction) Commit(ctx context.Context, scope *ReferenceScope, expr p
 		for _, fileinfo := range updatedFiles {
 			view, _ := tx.cachedViews.Get(parser.Identifier{Literal: fileinfo.Path})
 
+			if n, ok := tx.appendableRecordLen(fileinfo); ok {
+				appendViews = append(appendViews, &View{
+					Header:    view.Header,
+					RecordSet: view.RecordSet[n:],
+					FileInfo:  view.FileInfo,
+				})
+				continue
+			}
+
 			fp, _ := view.FileInfo.Handler.FileForUpdate()
 			if err := fp.Truncate(0); err != nil {
 				return NewSystemError(err.Error())
@@ -148,14 +322,11 @@ func (tx *Transaction) Commit(ctx context.Context, scope *ReferenceScope, expr p
 				return NewSystemError(err.Error())
 			}
 
-			if _, err := EncodeView(ctx, fp, view, fileinfo.ExportOptions(tx), tx.Palette); err != nil {
+			if err := tx.writeFile(ctx, fp, view, fileinfo); err != nil {
 				return NewCommitError(expr, err.Error())
 			}
-
-			if !tx.Flags.ExportOptions.StripEndingLineBreak && !(fileinfo.Format == cmd.FIXED && fileinfo.SingleLine) {
-				if _, err := fp.Write([]byte(tx.Flags.ExportOptions.LineBreak.Value())); err != nil {
-					return NewCommitError(expr, err.Error())
-				}
+			if err := WriteTableDefinition(ctx, tx, view); err != nil {
+				return NewCommitError(expr, err.Error())
 			}
 
 			updateFileInfo = append(updateFileInfo, view.FileInfo)
@@ -166,22 +337,79 @@ func (tx *Transaction) Commit(ctx context.Context, scope *ReferenceScope, expr p
 		if err := tx.FileContainer.Commit(f.Handler); err != nil {
 			return NewCommitError(expr, err.Error())
 		}
+		if err := tx.commitTableDefinition(f); err != nil {
+			return NewCommitError(expr, err.Error())
+		}
 		tx.uncommittedViews.Unset(f)
 		tx.LogNotice(fmt.Sprintf("Commit: file %q is created.", f.Path), tx.Flags.Quiet)
 	}
 	for _, f := range updateFileInfo {
+		f.Handler.SetInPlace(!tx.Flags.AtomicWrite)
 		if err := tx.FileContainer.Commit(f.Handler); err != nil {
 			return NewCommitError(expr, err.Error())
 		}
+		if err := tx.commitTableDefinition(f); err != nil {
+			return NewCommitError(expr, err.Error())
+		}
 		tx.uncommittedViews.Unset(f)
 		tx.LogNotice(fmt.Sprintf("Commit: file %q is updated.", f.Path), tx.Flags.Quiet)
 	}
+	for _, view := range appendViews {
+		if err := tx.appendFile(ctx, view); err != nil {
+			return NewCommitError(expr, err.Error())
+		}
+		if err := tx.FileContainer.Commit(view.FileInfo.Handler); err != nil {
+			return NewCommitError(expr, err.Error())
+		}
+		tx.uncommittedViews.Unset(view.FileInfo)
+		tx.LogNotice(fmt.Sprintf("Commit: %s appended to file %q.", FormatCount(view.RecordLen(), "record"), view.FileInfo.Path), tx.Flags.Quiet)
+	}
 
 	msglist := scope.StoreTemporaryTable(tx.Session, tx.uncommittedViews.UncommittedTempViews())
 	if 0 < len(msglist) {
 		tx.LogNotice(strings.Join(msglist, "\n"), tx.quietForTemporaryViews(expr))
 	}
 	tx.uncommittedViews.Clean()
+	tx.resetTimestamp()
+	tx.UnlockStdin()
+	if err := tx.ReleaseResources(); err != nil {
+		return NewCommitError(expr, err.Error())
+	}
+	return nil
+}
+
+// commitTableDefinition replaces the table definition file of the committed table
+// with the temporary file written by WriteTableDefinition.
+func (tx *Transaction) commitTableDefinition(fileinfo *FileInfo) error {
+	if fileinfo.DefinitionHandler == nil {
+		return nil
+	}
+	fileinfo.DefinitionHandler.SetInPlace(!tx.Flags.AtomicWrite)
+	if err := tx.FileContainer.Commit(fileinfo.DefinitionHandler); err != nil {
+		return err
+	}
+	fileinfo.DefinitionHandler = nil
+	return nil
+}
+
+// discardDryRun reports the changes that would be committed and discards them
+// without writing any files.
+func (tx *Transaction) discardDryRun(scope *ReferenceScope, expr parser.Expression, createdFiles map[string]*FileInfo, updatedFiles map[string]*FileInfo) error {
+	for _, fileinfo := range createdFiles {
+		tx.LogNotice(fmt.Sprintf("Dry run: file %q would be created.", fileinfo.Path), tx.Flags.Quiet)
+	}
+	for _, fileinfo := range updatedFiles {
+		tx.LogNotice(fmt.Sprintf("Dry run: file %q would be updated.", fileinfo.Path), tx.Flags.Quiet)
+	}
+
+	if scope != nil {
+		msglist := scope.RestoreTemporaryTable(tx.uncommittedViews.UncommittedTempViews())
+		if 0 < len(msglist) {
+			tx.LogNotice(strings.Join(msglist, "\n"), tx.quietForTemporaryViews(expr))
+		}
+	}
+	tx.uncommittedViews.Clean()
+	tx.resetTimestamp()
 	tx.UnlockStdin()
 	if err := tx.ReleaseResources(); err != nil {
 		return NewCommitError(expr, err.Error())
@@ -214,6 +442,7 @@ func (tx *Transaction) Rollback(scope *ReferenceScope, expr parser.Expression) e
 		}
 	}
 	tx.uncommittedViews.Clean()
+	tx.resetTimestamp()
 	tx.UnlockStdin()
 	if err := tx.ReleaseResources(); err != nil {
 		return NewRollbackError(expr, err.Error())
@@ -298,6 +527,55 @@ func (tx *Transaction) Notice(s string) string {
 	return s
 }
 
+// setLastLoadWarnings sets the summary of the ragged rows handled on loading
+// the file, and logs it if @@STATS is enabled.
+func (tx *Transaction) setLastLoadWarnings(fileInfo *FileInfo) {
+	tx.lastLoadWarnings = ""
+	if fileInfo.raggedRows.IsEmpty() {
+		return
+	}
+
+	tx.lastLoadWarnings = fmt.Sprintf("%s: ragged rows %s", fileInfo.Path, fileInfo.raggedRows)
+	if tx.Flags.Stats {
+		tx.LogNotice(tx.lastLoadWarnings, tx.Flags.Quiet)
+	}
+}
+
+// checkColumnTypes returns an error if a column specified in the column types
+// does not exist in any loaded table. The column types are applied only to the
+// tables that have the columns, so the check is done after a query loads its
+// tables. Queries that load no tables are not checked.
+func (tx *Transaction) checkColumnTypes(expr parser.Expression) error {
+	if len(tx.Flags.ImportOptions.ColumnTypes) < 1 {
+		return nil
+	}
+
+	var headers []Header
+	collect := func(_, v interface{}) bool {
+		headers = append(headers, v.(*View).Header)
+		return true
+	}
+	tx.cachedViews.Range(collect)
+	tx.Session.stdinViewMap.Range(collect)
+	if len(headers) < 1 {
+		return nil
+	}
+
+	for _, ct := range tx.Flags.ImportOptions.ColumnTypes {
+		exists := false
+		for _, h := range headers {
+			if -1 < columnTypeIndex(h, ct) {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			return NewColumnTypeFieldNotExistError(expr, ct.Column)
+		}
+	}
+	return nil
+}
+
 func (tx *Transaction) Log(log string, quiet bool) {
 	if !quiet {
 		if err := tx.Session.WriteToStdoutWithLineBreak(log); err != nil {
@@ -370,12 +648,72 @@ func (tx *Transaction) setFlag(key string, value interface{}, outFile string) er
 		} else {
 			err = errNotAllowdFlagFormat
 		}
+	case cmd.NullsOrderFlag:
+		if s, ok := value.(string); ok {
+			err = tx.Flags.SetNullsOrder(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.StableSortFlag:
+		if b, ok := value.(bool); ok {
+			tx.Flags.SetStableSort(b)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.ConcatNullAsEmptyFlag:
+		if b, ok := value.(bool); ok {
+			tx.Flags.SetConcatNullAsEmpty(b)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.AppendCommitFlag:
+		if b, ok := value.(bool); ok {
+			tx.Flags.SetAppendCommit(b)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.AtomicWriteFlag:
+		if b, ok := value.(bool); ok {
+			tx.Flags.SetAtomicWrite(b)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.DryRunFlag:
+		if b, ok := value.(bool); ok {
+			tx.Flags.SetDryRun(b)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.IgnoreConstraintsFlag:
+		if b, ok := value.(bool); ok {
+			tx.Flags.SetIgnoreConstraints(b)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
 	case cmd.WaitTimeoutFlag:
 		if f, ok := value.(float64); ok {
 			tx.UpdateWaitTimeout(f, file.DefaultRetryDelay)
 		} else {
 			err = errNotAllowdFlagFormat
 		}
+	case cmd.HttpTimeoutFlag:
+		if f, ok := value.(float64); ok {
+			tx.Flags.SetHttpTimeout(f)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.HttpMaxSizeFlag:
+		if i, ok := value.(int64); ok {
+			tx.Flags.SetHttpMaxSize(i)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.HttpHeadersFlag:
+		if s, ok := value.(string); ok {
+			err = tx.Flags.SetHttpHeaders(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
 	case cmd.ImportFormatFlag:
 		if s, ok := value.(string); ok {
 			err = tx.Flags.SetImportFormat(s)
@@ -388,6 +726,24 @@ func (tx *Transaction) setFlag(key string, value interface{}, outFile string) er
 		} else {
 			err = errNotAllowdFlagFormat
 		}
+	case cmd.DelimiterRegexFlag:
+		if s, ok := value.(string); ok {
+			err = tx.Flags.SetDelimiterRegex(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.QuoteCharFlag:
+		if s, ok := value.(string); ok {
+			err = tx.Flags.SetQuoteChar(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.EscapeStyleFlag:
+		if s, ok := value.(string); ok {
+			err = tx.Flags.SetEscapeStyle(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
 	case cmd.DelimiterPositionsFlag:
 		if s, ok := value.(string); ok {
 			err = tx.Flags.SetDelimiterPositions(s)
@@ -418,6 +774,72 @@ func (tx *Transaction) setFlag(key string, value interface{}, outFile string) er
 		} else {
 			err = errNotAllowdFlagFormat
 		}
+	case cmd.ColumnTypesFlag:
+		if s, ok := value.(string); ok {
+			err = tx.Flags.SetColumnTypes(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.StrictColumnTypesFlag:
+		if b, ok := value.(bool); ok {
+			tx.Flags.SetStrictColumnTypes(b)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.StrictGlobHeaderFlag:
+		if b, ok := value.(bool); ok {
+			tx.Flags.SetStrictGlobHeader(b)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.NoInferFlag:
+		if b, ok := value.(bool); ok {
+			tx.Flags.SetNoInfer(b)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.SkipLinesFlag:
+		if i, ok := value.(int64); ok {
+			tx.Flags.SetSkipLines(i)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.CommentPrefixFlag:
+		if s, ok := value.(string); ok {
+			tx.Flags.SetCommentPrefix(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.SkipAllCommentsFlag:
+		if b, ok := value.(bool); ok {
+			tx.Flags.SetSkipAllComments(b)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.TrimFlag:
+		if b, ok := value.(bool); ok {
+			tx.Flags.SetTrim(b)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.DetectFlag:
+		if b, ok := value.(bool); ok {
+			tx.Flags.SetDetect(b)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.RaggedFlag:
+		if s, ok := value.(string); ok {
+			err = tx.Flags.SetRagged(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.TeeFlag:
+		if s, ok := value.(string); ok {
+			err = tx.setTee(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
 	case cmd.FormatFlag:
 		if s, ok := value.(string); ok {
 			err = tx.Flags.SetFormat(s, outFile)
@@ -448,6 +870,24 @@ func (tx *Transaction) setFlag(key string, value interface{}, outFile string) er
 		} else {
 			err = errNotAllowdFlagFormat
 		}
+	case cmd.WriteBOMFlag:
+		if b, ok := value.(bool); ok {
+			tx.Flags.SetWriteBOM(b)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.ReplaceUnencodableFlag:
+		if b, ok := value.(bool); ok {
+			tx.Flags.SetReplaceUnencodable(b)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.TypedHeaderFlag:
+		if b, ok := value.(bool); ok {
+			tx.Flags.SetTypedHeader(b)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
 	case cmd.LineBreakFlag:
 		if s, ok := value.(string); ok {
 			err = tx.Flags.SetLineBreak(s)
@@ -460,6 +900,24 @@ func (tx *Transaction) setFlag(key string, value interface{}, outFile string) er
 		} else {
 			err = errNotAllowdFlagFormat
 		}
+	case cmd.QuoteModeFlag:
+		if s, ok := value.(string); ok {
+			err = tx.Flags.SetQuoteMode(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.ExcelSafeFlag:
+		if b, ok := value.(bool); ok {
+			tx.Flags.SetExcelSafe(b)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.ExcelSafePrefixFlag:
+		if s, ok := value.(string); ok {
+			err = tx.Flags.SetExcelSafePrefix(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
 	case cmd.JsonEscapeFlag:
 		if s, ok := value.(string); ok {
 			err = tx.Flags.SetJsonEscape(s)
@@ -472,6 +930,108 @@ func (tx *Transaction) setFlag(key string, value interface{}, outFile string) er
 		} else {
 			err = errNotAllowdFlagFormat
 		}
+	case cmd.JsonKeyFlag:
+		if s, ok := value.(string); ok {
+			tx.Flags.SetJsonKey(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.JsonKeyLastFlag:
+		if b, ok := value.(bool); ok {
+			tx.Flags.SetJsonKeyLast(b)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.JsonFlatFlag:
+		if b, ok := value.(bool); ok {
+			tx.Flags.SetJsonFlat(b)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.NullTextFlag:
+		if s, ok := value.(string); ok {
+			tx.Flags.SetNullText(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.HtmlClassFlag:
+		if s, ok := value.(string); ok {
+			tx.Flags.SetHtmlClass(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.XmlAttributesFlag:
+		if b, ok := value.(bool); ok {
+			tx.Flags.SetXmlAttributes(b)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.MaxColumnWidthFlag:
+		if i, ok := value.(int64); ok {
+			tx.Flags.SetMaxColumnWidth(i)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.WrapTextFlag:
+		if b, ok := value.(bool); ok {
+			tx.Flags.SetWrapText(b)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.BoxDrawingFlag:
+		if b, ok := value.(bool); ok {
+			tx.Flags.SetBoxDrawing(b)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.TableNameFlag:
+		if s, ok := value.(string); ok {
+			tx.Flags.SetTableName(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.InsertBatchSizeFlag:
+		if i, ok := value.(int64); ok {
+			tx.Flags.SetInsertBatchSize(i)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.IdentifierQuoteFlag:
+		if s, ok := value.(string); ok {
+			err = tx.Flags.SetIdentifierQuote(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.TemplateFlag:
+		if s, ok := value.(string); ok {
+			tx.Flags.SetTemplate(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.ParquetCompressionFlag:
+		if s, ok := value.(string); ok {
+			err = tx.Flags.SetParquetCompression(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.ParquetAllStringsFlag:
+		if b, ok := value.(bool); ok {
+			tx.Flags.SetParquetAllStrings(b)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.CompressionFlag:
+		if s, ok := value.(string); ok {
+			err = tx.Flags.SetCompression(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.CompressionLevelFlag:
+		if i, ok := value.(int64); ok {
+			tx.Flags.SetCompressionLevel(i)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
 	case cmd.StripEndingLineBreakFlag:
 		if b, ok := value.(bool); ok {
 			tx.Flags.SetStripEndingLineBreak(b)
@@ -526,6 +1086,13 @@ func (tx *Transaction) setFlag(key string, value interface{}, outFile string) er
 		} else {
 			err = errNotAllowdFlagFormat
 		}
+	case cmd.NowFlag:
+		if t, ok := value.(time.Time); ok {
+			tx.Flags.SetNow(t)
+			tx.resetTimestamp()
+		} else {
+			err = errNotAllowdFlagFormat
+		}
 	default:
 		err = errInvalidFlagName
 	}
@@ -557,12 +1124,46 @@ func (tx *Transaction) GetFlag(key string) (value.Primary, bool) {
 		val = value.NewString(s)
 	case cmd.AnsiQuotesFlag:
 		val = value.NewBoolean(tx.Flags.AnsiQuotes)
+	case cmd.NullsOrderFlag:
+		val = value.NewString(tx.Flags.NullsOrder.String())
+	case cmd.StableSortFlag:
+		val = value.NewBoolean(tx.Flags.StableSort)
+	case cmd.ConcatNullAsEmptyFlag:
+		val = value.NewBoolean(tx.Flags.ConcatNullAsEmpty)
+	case cmd.AppendCommitFlag:
+		val = value.NewBoolean(tx.Flags.AppendCommit)
+	case cmd.AtomicWriteFlag:
+		val = value.NewBoolean(tx.Flags.AtomicWrite)
+	case cmd.DryRunFlag:
+		val = value.NewBoolean(tx.Flags.DryRun)
+	case cmd.IgnoreConstraintsFlag:
+		val = value.NewBoolean(tx.Flags.IgnoreConstraints)
 	case cmd.WaitTimeoutFlag:
 		val = value.NewFloat(tx.Flags.WaitTimeout)
+	case cmd.HttpTimeoutFlag:
+		val = value.NewFloat(tx.Flags.HttpTimeout)
+	case cmd.HttpMaxSizeFlag:
+		val = value.NewInteger(tx.Flags.HttpMaxSize)
+	case cmd.HttpHeadersFlag:
+		val = value.NewString(tx.Flags.HttpHeaders)
 	case cmd.ImportFormatFlag:
 		val = value.NewString(tx.Flags.ImportOptions.Format.String())
 	case cmd.DelimiterFlag:
-		val = value.NewString(string(tx.Flags.ImportOptions.Delimiter))
+		if 0 < len(tx.Flags.ImportOptions.MultiCharDelimiter) {
+			val = value.NewString(tx.Flags.ImportOptions.MultiCharDelimiter)
+		} else {
+			val = value.NewString(string(tx.Flags.ImportOptions.Delimiter))
+		}
+	case cmd.DelimiterRegexFlag:
+		val = value.NewString(tx.Flags.ImportOptions.DelimiterRegex)
+	case cmd.QuoteCharFlag:
+		if tx.Flags.ImportOptions.QuoteChar == 0 {
+			val = value.NewString("")
+		} else {
+			val = value.NewString(string(tx.Flags.ImportOptions.QuoteChar))
+		}
+	case cmd.EscapeStyleFlag:
+		val = value.NewString(tx.Flags.ImportOptions.EscapeStyle.String())
 	case cmd.DelimiterPositionsFlag:
 		s := fixedlen.DelimiterPositions(tx.Flags.ImportOptions.DelimiterPositions).String()
 		if tx.Flags.ImportOptions.SingleLine {
@@ -577,10 +1178,36 @@ func (tx *Transaction) GetFlag(key string) (value.Primary, bool) {
 		val = value.NewBoolean(tx.Flags.ImportOptions.NoHeader)
 	case cmd.WithoutNullFlag:
 		val = value.NewBoolean(tx.Flags.ImportOptions.WithoutNull)
+	case cmd.ColumnTypesFlag:
+		val = value.NewString(tx.Flags.ImportOptions.ColumnTypes.String())
+	case cmd.StrictColumnTypesFlag:
+		val = value.NewBoolean(tx.Flags.ImportOptions.StrictColumnTypes)
+	case cmd.StrictGlobHeaderFlag:
+		val = value.NewBoolean(tx.Flags.ImportOptions.StrictGlobHeader)
+	case cmd.NoInferFlag:
+		val = value.NewBoolean(tx.Flags.ImportOptions.NoInfer)
+	case cmd.SkipLinesFlag:
+		val = value.NewInteger(int64(tx.Flags.ImportOptions.SkipLines))
+	case cmd.CommentPrefixFlag:
+		val = value.NewString(tx.Flags.ImportOptions.CommentPrefix)
+	case cmd.SkipAllCommentsFlag:
+		val = value.NewBoolean(tx.Flags.ImportOptions.SkipAllComments)
+	case cmd.TrimFlag:
+		val = value.NewBoolean(tx.Flags.ImportOptions.Trim)
+	case cmd.DetectFlag:
+		val = value.NewBoolean(tx.Flags.ImportOptions.Detect)
+	case cmd.RaggedFlag:
+		val = value.NewString(tx.Flags.ImportOptions.Ragged.String())
+	case cmd.TeeFlag:
+		val = value.NewString(tx.Flags.Tee)
 	case cmd.FormatFlag:
 		val = value.NewString(tx.Flags.ExportOptions.Format.String())
 	case cmd.ExportEncodingFlag:
 		val = value.NewString(tx.Flags.ExportOptions.Encoding.String())
+	case cmd.WriteBOMFlag:
+		val = value.NewBoolean(tx.Flags.ExportOptions.WriteBOM)
+	case cmd.ReplaceUnencodableFlag:
+		val = value.NewBoolean(tx.Flags.ExportOptions.ReplaceUnencodable)
 	case cmd.ExportDelimiterFlag:
 		val = value.NewString(string(tx.Flags.ExportOptions.Delimiter))
 	case cmd.ExportDelimiterPositionsFlag:
@@ -591,14 +1218,56 @@ func (tx *Transaction) GetFlag(key string) (value.Primary, bool) {
 		val = value.NewString(s)
 	case cmd.WithoutHeaderFlag:
 		val = value.NewBoolean(tx.Flags.ExportOptions.WithoutHeader)
+	case cmd.TypedHeaderFlag:
+		val = value.NewBoolean(tx.Flags.ExportOptions.TypedHeader)
 	case cmd.LineBreakFlag:
 		val = value.NewString(tx.Flags.ExportOptions.LineBreak.String())
 	case cmd.EncloseAllFlag:
 		val = value.NewBoolean(tx.Flags.ExportOptions.EncloseAll)
+	case cmd.QuoteModeFlag:
+		val = value.NewString(tx.Flags.ExportOptions.QuoteMode.String())
+	case cmd.ExcelSafeFlag:
+		val = value.NewBoolean(tx.Flags.ExportOptions.ExcelSafe)
+	case cmd.ExcelSafePrefixFlag:
+		val = value.NewString(tx.Flags.ExportOptions.ExcelSafePrefix.String())
 	case cmd.JsonEscapeFlag:
 		val = value.NewString(cmd.JsonEscapeTypeToString(tx.Flags.ExportOptions.JsonEscape))
 	case cmd.PrettyPrintFlag:
 		val = value.NewBoolean(tx.Flags.ExportOptions.PrettyPrint)
+	case cmd.JsonKeyFlag:
+		val = value.NewString(tx.Flags.ExportOptions.JsonKey)
+	case cmd.JsonKeyLastFlag:
+		val = value.NewBoolean(tx.Flags.ExportOptions.JsonKeyLast)
+	case cmd.JsonFlatFlag:
+		val = value.NewBoolean(tx.Flags.ExportOptions.JsonFlat)
+	case cmd.NullTextFlag:
+		val = value.NewString(tx.Flags.ExportOptions.NullText)
+	case cmd.HtmlClassFlag:
+		val = value.NewString(tx.Flags.ExportOptions.HtmlClass)
+	case cmd.XmlAttributesFlag:
+		val = value.NewBoolean(tx.Flags.ExportOptions.XmlAttributes)
+	case cmd.MaxColumnWidthFlag:
+		val = value.NewInteger(int64(tx.Flags.ExportOptions.MaxColumnWidth))
+	case cmd.WrapTextFlag:
+		val = value.NewBoolean(tx.Flags.ExportOptions.WrapText)
+	case cmd.BoxDrawingFlag:
+		val = value.NewBoolean(tx.Flags.ExportOptions.BoxDrawing)
+	case cmd.TableNameFlag:
+		val = value.NewString(tx.Flags.ExportOptions.TableName)
+	case cmd.InsertBatchSizeFlag:
+		val = value.NewInteger(int64(tx.Flags.ExportOptions.InsertBatchSize))
+	case cmd.IdentifierQuoteFlag:
+		val = value.NewString(tx.Flags.ExportOptions.IdentifierQuote.String())
+	case cmd.TemplateFlag:
+		val = value.NewString(tx.Flags.ExportOptions.Template)
+	case cmd.ParquetCompressionFlag:
+		val = value.NewString(tx.Flags.ExportOptions.ParquetCompression.String())
+	case cmd.ParquetAllStringsFlag:
+		val = value.NewBoolean(tx.Flags.ExportOptions.ParquetAllStrings)
+	case cmd.CompressionFlag:
+		val = value.NewString(tx.Flags.ExportOptions.Compression.String())
+	case cmd.CompressionLevelFlag:
+		val = value.NewInteger(int64(tx.Flags.ExportOptions.CompressionLevel))
 	case cmd.StripEndingLineBreakFlag:
 		val = value.NewBoolean(tx.Flags.ExportOptions.StripEndingLineBreak)
 	case cmd.EastAsianEncodingFlag:
@@ -617,6 +1286,12 @@ func (tx *Transaction) GetFlag(key string) (value.Primary, bool) {
 		val = value.NewInteger(int64(tx.Flags.CPU))
 	case cmd.StatsFlag:
 		val = value.NewBoolean(tx.Flags.Stats)
+	case cmd.NowFlag:
+		if tx.Flags.Now.IsZero() {
+			val = value.NewNull()
+		} else {
+			val = value.NewDatetime(tx.Flags.Now)
+		}
 	default:
 		ok = false
 	}
@@ -30,9 +30,14 @@ func TestTransaction_Commit(t *testing.T) {
 	ch, _ := file.NewHandlerForCreate(TestTx.FileContainer, GetTestFilePath("created_file.csv"))
 	uh, _ := file.NewHandlerForUpdate(context.Background(), TestTx.FileContainer, GetTestFilePath("updated_file_1.csv"), TestTx.WaitTimeout, TestTx.RetryDelay)
 
+	createdHeader := NewHeader("created_file", []string{"column1", "column2"})
+	createdHeader[0].Unique = true
+	createdHeader[1].Default = parser.NewIntegerValueFromString("1")
+	createdHeader[1].NotNull = true
+
 	TestTx.cachedViews = GenerateViewMap([]*View{
 		{
-			Header:    NewHeader("created_file", []string{"column1", "column2"}),
+			Header:    createdHeader,
 			RecordSet: RecordSet{},
 			FileInfo: &FileInfo{
 				Path:      GetTestFilePath("created_file.csv"),
@@ -132,6 +137,19 @@ func TestTransaction_Commit(t *testing.T) {
 		t.Errorf("updated contents = %q, want %q", string(updatedContents), expectedUpdatedContents)
 	}
 
+	expectedDefinition := "CREATE TABLE `created_file.csv` (\n  `column1` UNIQUE,\n  `column2` NOT NULL DEFAULT 1\n);\n"
+	definition, err := ioutil.ReadFile(TableDefinitionFilePath(GetTestFilePath("created_file.csv")))
+	if err != nil {
+		t.Fatalf("unexpected error %q", err.Error())
+	}
+
+	if expectedDefinition != string(definition) {
+		t.Errorf("table definition = %q, want %q", string(definition), expectedDefinition)
+	}
+	if file.Exists(TableDefinitionFilePath(GetTestFilePath("updated_file_1.csv"))) {
+		t.Errorf("table definition file of %q is created, want no file", GetTestFilePath("updated_file_1.csv"))
+	}
+
 	// Flags.StripEndingLineBreak = true
 	TestTx.Flags.ExportOptions.StripEndingLineBreak = true
 	ch, _ = file.NewHandlerForCreate(TestTx.FileContainer, GetTestFilePath("created_file_1.csv"))
@@ -224,6 +242,140 @@ func TestTransaction_Commit(t *testing.T) {
 	if expectedUpdatedContents != string(updatedContents) {
 		t.Errorf("updated contents = %q, want %q", string(updatedContents), expectedUpdatedContents)
 	}
+
+	// Flags.AppendCommit = true
+	TestTx.Flags.ExportOptions.StripEndingLineBreak = false
+	TestTx.Flags.SetAppendCommit(true)
+	uh, _ = file.NewHandlerForUpdate(context.Background(), TestTx.FileContainer, GetTestFilePath("updated_file_1.csv"), TestTx.WaitTimeout, TestTx.RetryDelay)
+	updatedFileInfo := &FileInfo{
+		Path:      GetTestFilePath("updated_file_1.csv"),
+		Handler:   uh,
+		Encoding:  text.UTF8,
+		Format:    cmd.CSV,
+		Delimiter: ',',
+		LineBreak: text.LF,
+	}
+	TestTx.cachedViews = GenerateViewMap([]*View{
+		{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("update1"),
+					value.NewString("update2"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("4"),
+					value.NewString("str,4"),
+				}),
+			},
+			FileInfo: updatedFileInfo,
+		},
+	})
+
+	TestTx.uncommittedViews = NewUncommittedViews()
+	TestTx.uncommittedViews.SetForInsertedView(updatedFileInfo, 3)
+
+	out = NewOutput()
+	tx.Session.SetStdout(out)
+
+	err = TestTx.Commit(context.Background(), NewReferenceScope(tx), parser.TransactionControl{Token: parser.COMMIT})
+	if err != nil {
+		t.Fatalf("unexpected error %q", err.Error())
+	}
+
+	expect = fmt.Sprintf("Commit: 1 record appended to file %q.\n", GetTestFilePath("updated_file_1.csv"))
+	log = out.String()
+	if log != expect {
+		t.Errorf("Commit: log = %q, want %q", log, expect)
+	}
+
+	expectedUpdatedContents = "column1,column2\n1,str1\nupdate1,update2\n3,str3\n4,\"str,4\"\n"
+	updatedContents, err = ioutil.ReadFile(GetTestFilePath("updated_file_1.csv"))
+	if err != nil {
+		t.Fatalf("unexpected error %q", err.Error())
+	}
+
+	if expectedUpdatedContents != string(updatedContents) {
+		t.Errorf("updated contents = %q, want %q", string(updatedContents), expectedUpdatedContents)
+	}
+
+	// Flags.DryRun = true
+	TestTx.Flags.SetAppendCommit(false)
+	TestTx.Flags.SetDryRun(true)
+	ch, _ = file.NewHandlerForCreate(TestTx.FileContainer, GetTestFilePath("created_file_2.csv"))
+	uh, _ = file.NewHandlerForUpdate(context.Background(), TestTx.FileContainer, GetTestFilePath("updated_file_1.csv"), TestTx.WaitTimeout, TestTx.RetryDelay)
+	createdFileInfo := &FileInfo{
+		Path:      GetTestFilePath("created_file_2.csv"),
+		Handler:   ch,
+		Encoding:  text.UTF8,
+		Format:    cmd.CSV,
+		Delimiter: ',',
+		LineBreak: text.LF,
+	}
+	updatedFileInfo = &FileInfo{
+		Path:      GetTestFilePath("updated_file_1.csv"),
+		Handler:   uh,
+		Encoding:  text.UTF8,
+		Format:    cmd.CSV,
+		Delimiter: ',',
+		LineBreak: text.LF,
+	}
+	TestTx.cachedViews = GenerateViewMap([]*View{
+		{
+			Header:    NewHeader("created_file_2", []string{"column1", "column2"}),
+			RecordSet: RecordSet{},
+			FileInfo:  createdFileInfo,
+		},
+		{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("dryrun"),
+				}),
+			},
+			FileInfo: updatedFileInfo,
+		},
+	})
+
+	TestTx.uncommittedViews = NewUncommittedViews()
+	TestTx.uncommittedViews.SetForCreatedView(createdFileInfo)
+	TestTx.uncommittedViews.SetForUpdatedView(updatedFileInfo)
+
+	out = NewOutput()
+	tx.Session.SetStdout(out)
+
+	err = TestTx.Commit(context.Background(), NewReferenceScope(tx), parser.TransactionControl{Token: parser.COMMIT})
+	if err != nil {
+		t.Fatalf("unexpected error %q", err.Error())
+	}
+
+	expect = fmt.Sprintf("Dry run: file %q would be created.\nDry run: file %q would be updated.\n", GetTestFilePath("created_file_2.csv"), GetTestFilePath("updated_file_1.csv"))
+	log = out.String()
+	if log != expect {
+		t.Errorf("Commit: log = %q, want %q", log, expect)
+	}
+
+	if file.Exists(GetTestFilePath("created_file_2.csv")) {
+		t.Errorf("file %q is created, want not to be created in dry run", GetTestFilePath("created_file_2.csv"))
+	}
+
+	updatedContents, err = ioutil.ReadFile(GetTestFilePath("updated_file_1.csv"))
+	if err != nil {
+		t.Fatalf("unexpected error %q", err.Error())
+	}
+
+	if expectedUpdatedContents != string(updatedContents) {
+		t.Errorf("updated contents = %q, want %q", string(updatedContents), expectedUpdatedContents)
+	}
 }
 
 func TestTransaction_Rollback(t *testing.T) {
@@ -265,3 +417,73 @@ func TestTransaction_Rollback(t *testing.T) {
 		t.Errorf("Rollback: log = %q, want %q", log, expect)
 	}
 }
+
+var transactionCheckColumnTypesTests = []struct {
+	Name        string
+	ColumnTypes cmd.ColumnTypes
+	Views       []*View
+	Error       string
+}{
+	{
+		Name: "CheckColumnTypes Columns in Different Tables",
+		ColumnTypes: cmd.ColumnTypes{
+			{Column: "column1", Type: "STRING"},
+			{Column: "COLUMN4", Type: "INTEGER"},
+		},
+		Views: []*View{
+			{
+				Header:   NewHeader("table1", []string{"column1", "column2"}),
+				FileInfo: &FileInfo{Path: GetTestFilePath("table1.csv")},
+			},
+			{
+				Header:   NewHeader("table2", []string{"column3", "column4"}),
+				FileInfo: &FileInfo{Path: GetTestFilePath("table2.csv")},
+			},
+		},
+	},
+	{
+		Name: "CheckColumnTypes No Loaded Tables",
+		ColumnTypes: cmd.ColumnTypes{
+			{Column: "notexist", Type: "STRING"},
+		},
+	},
+	{
+		Name: "CheckColumnTypes Column Not Exist Error",
+		ColumnTypes: cmd.ColumnTypes{
+			{Column: "column1", Type: "STRING"},
+			{Column: "notexist", Type: "STRING"},
+		},
+		Views: []*View{
+			{
+				Header:   NewHeader("table1", []string{"column1", "column2"}),
+				FileInfo: &FileInfo{Path: GetTestFilePath("table1.csv")},
+			},
+		},
+		Error: "column notexist specified in the column types does not exist in any loaded table",
+	},
+}
+
+func TestTransaction_checkColumnTypes(t *testing.T) {
+	defer func() {
+		TestTx.cachedViews = NewViewMap()
+		initFlag(TestTx.Flags)
+	}()
+
+	for _, v := range transactionCheckColumnTypesTests {
+		TestTx.Flags.ImportOptions.ColumnTypes = v.ColumnTypes
+		TestTx.cachedViews = GenerateViewMap(v.Views)
+
+		err := TestTx.checkColumnTypes(parser.SelectQuery{})
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+		}
+	}
+}
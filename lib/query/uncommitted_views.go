@@ -9,6 +9,10 @@ type UncommittedViews struct {
 	mtx     *sync.RWMutex
 	Created map[string]*FileInfo
 	Updated map[string]*FileInfo
+
+	// insertedOnly holds the numbers of records before insertion of the views
+	// that have only been inserted into since they were loaded.
+	insertedOnly map[string]int
 }
 
 func NewUncommittedViews() UncommittedViews {
@@ -43,6 +47,39 @@ func (m *UncommittedViews) SetForUpdatedView(fileInfo *FileInfo) {
 			m.Updated[ufpath] = fileInfo
 		}
 	}
+	delete(m.insertedOnly, ufpath)
+}
+
+// SetForInsertedView sets the view as updated only by insertion, unless the view
+// has already been created or updated in another way.
+func (m *UncommittedViews) SetForInsertedView(fileInfo *FileInfo, recordLenBeforeInsertion int) {
+	ufpath := strings.ToUpper(fileInfo.Path)
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if _, ok := m.Created[ufpath]; ok {
+		return
+	}
+	if _, ok := m.Updated[ufpath]; ok {
+		return
+	}
+
+	m.Updated[ufpath] = fileInfo
+	if m.insertedOnly == nil {
+		m.insertedOnly = make(map[string]int)
+	}
+	m.insertedOnly[ufpath] = recordLenBeforeInsertion
+}
+
+// InsertedOnly returns the number of records before insertion if the view has
+// only been inserted into.
+func (m *UncommittedViews) InsertedOnly(fileInfo *FileInfo) (int, bool) {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	n, ok := m.insertedOnly[strings.ToUpper(fileInfo.Path)]
+	return n, ok
 }
 
 func (m *UncommittedViews) Unset(fileInfo *FileInfo) {
@@ -53,6 +90,7 @@ func (m *UncommittedViews) Unset(fileInfo *FileInfo) {
 
 	if _, ok := m.Updated[ufpath]; ok {
 		delete(m.Updated, ufpath)
+		delete(m.insertedOnly, ufpath)
 		return
 	}
 
@@ -71,6 +109,7 @@ func (m *UncommittedViews) Clean() {
 	for k := range m.Created {
 		delete(m.Created, k)
 	}
+	m.insertedOnly = nil
 }
 
 func (m *UncommittedViews) UncommittedFiles() (map[string]*FileInfo, map[string]*FileInfo) {
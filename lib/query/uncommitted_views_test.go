@@ -89,6 +89,58 @@ func TestUncommittedViewMap_SetForUpdatedView(t *testing.T) {
 	}
 }
 
+func TestUncommittedViewMap_SetForInsertedView(t *testing.T) {
+	m := &UncommittedViews{
+		mtx: &sync.RWMutex{},
+		Created: map[string]*FileInfo{
+			"PRE_CREATED.TXT": {Path: "pre_created.txt"},
+		},
+		Updated: map[string]*FileInfo{
+			"PRE_UPDATED.TXT": {Path: "pre_updated.txt"},
+		},
+	}
+
+	info := &FileInfo{
+		Path: "insert.txt",
+	}
+	expect := &UncommittedViews{
+		mtx: &sync.RWMutex{},
+		Created: map[string]*FileInfo{
+			"PRE_CREATED.TXT": {Path: "pre_created.txt"},
+		},
+		Updated: map[string]*FileInfo{
+			"PRE_UPDATED.TXT": {Path: "pre_updated.txt"},
+			"INSERT.TXT":      {Path: "insert.txt"},
+		},
+		insertedOnly: map[string]int{
+			"INSERT.TXT": 3,
+		},
+	}
+	m.SetForInsertedView(info, 3)
+	if !reflect.DeepEqual(m, expect) {
+		t.Errorf("map = %v, want %v", m, expect)
+	}
+
+	m.SetForInsertedView(info, 5)
+	if !reflect.DeepEqual(m, expect) {
+		t.Errorf("map = %v, want %v", m, expect)
+	}
+	if n, ok := m.InsertedOnly(info); !ok || n != 3 {
+		t.Errorf("inserted only = %d, %t, want %d, %t", n, ok, 3, true)
+	}
+
+	m.SetForInsertedView(preCreatedFileInfo, 1)
+	m.SetForInsertedView(preUpdatedFileInfo, 1)
+	if !reflect.DeepEqual(m, expect) {
+		t.Errorf("map = %v, want %v", m, expect)
+	}
+
+	m.SetForUpdatedView(info)
+	if _, ok := m.InsertedOnly(info); ok {
+		t.Errorf("inserted only = %t, want %t", ok, false)
+	}
+}
+
 func TestUncommittedViewMap_Unset(t *testing.T) {
 	m := &UncommittedViews{
 		mtx: &sync.RWMutex{},
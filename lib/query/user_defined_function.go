@@ -120,12 +120,18 @@ func (m UserDefinedFunctionMap) parseParameters(parameters []parser.VariableAssi
 func (m UserDefinedFunctionMap) CheckDuplicate(name parser.Identifier) error {
 	uname := strings.ToUpper(name.Literal)
 
-	if _, ok := Functions[uname]; ok || uname == "CALL" || uname == "NOW" || uname == "JSON_OBJECT" {
+	if _, ok := Functions[uname]; ok || uname == "CALL" || uname == "NOW" || uname == "TRANSACTION_TIMESTAMP" || uname == "JSON_OBJECT" || uname == "FILENAME" || uname == "FILELINE" {
 		return NewBuiltInFunctionDeclaredError(name)
 	}
 	if _, ok := AggregateFunctions[uname]; ok {
 		return NewBuiltInFunctionDeclaredError(name)
 	}
+	if _, ok := BivariateAggregateFunctions[uname]; ok {
+		return NewBuiltInFunctionDeclaredError(name)
+	}
+	if _, ok := ConditionalAggregateFunctions[uname]; ok {
+		return NewBuiltInFunctionDeclaredError(name)
+	}
 	if _, ok := AnalyticFunctions[uname]; ok {
 		return NewBuiltInFunctionDeclaredError(name)
 	}
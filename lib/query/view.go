@@ -1,14 +1,16 @@
 package query
 
 import (
+	"bufio"
 	"bytes"
 	"context"
-	gojson "encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"math"
 	"os"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -25,6 +27,8 @@ import (
 	"github.com/mithrandie/go-text/fixedlen"
 	"github.com/mithrandie/go-text/ltsv"
 	"github.com/mithrandie/ternary"
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
 )
 
 const fileLoadingPreparedRecordSetCap = 300
@@ -39,11 +43,22 @@ type RecordReader interface {
 	Read() ([]text.RawText, error)
 }
 
+// recordOrigin is the file and the line from which a record is loaded.
+// Line is 0 if the line of the record cannot be determined.
+type recordOrigin struct {
+	Path string
+	Line int
+}
+
 type View struct {
 	Header    Header
 	RecordSet RecordSet
 	FileInfo  *FileInfo
 
+	// recordOrigins holds the origins of the records loaded directly from files.
+	// It is nil for views whose records are derived from other records.
+	recordOrigins []recordOrigin
+
 	selectFields []int
 	selectLabels []string
 	isGrouped    bool
@@ -53,6 +68,7 @@ type View struct {
 	sortValuesInEachRecord     []SortValues
 	sortDirections             []int
 	sortNullPositions          []int
+	sortCollators              []*collate.Collator
 
 	tempRecord Record
 
@@ -98,6 +114,44 @@ func LoadViewFromTableIdentifier(ctx context.Context, scope *ReferenceScope, tab
 	return LoadView(ctx, scope, tables, forUpdate, useInternalId)
 }
 
+// LoadViewSample loads the header and the first n records of the table.
+// If the table is a file that has not been loaded in the transaction, then only
+// the header and the n records are read from the file, and the view is not cached.
+// Otherwise, or if n is less than 1, the table is loaded in the same way as LoadViewFromTableIdentifier.
+func LoadViewSample(ctx context.Context, scope *ReferenceScope, table parser.QueryExpression, n int) (*View, error) {
+	tableIdentifier, ok := table.(parser.Identifier)
+	if !ok || n < 1 || scope.InlineTableExists(tableIdentifier) || scope.TemporaryTableExists(tableIdentifier.Literal) {
+		return LoadViewFromTableIdentifier(ctx, scope, table, false, false)
+	}
+
+	filePath, ok := scope.LoadFilePath(tableIdentifier.Literal)
+	if !ok {
+		p, err := CreateFilePath(tableIdentifier, scope.Tx.Flags.Repository)
+		if err != nil {
+			return nil, NewIOError(tableIdentifier, err.Error())
+		}
+		filePath = p
+	}
+	if scope.Tx.cachedViews.Exists(filePath) {
+		return LoadViewFromTableIdentifier(ctx, scope, table, false, false)
+	}
+
+	options := scope.Tx.Flags.ImportOptions.Copy()
+	options.Format = cmd.AutoSelect
+
+	fileInfo, err := NewFileInfo(tableIdentifier, scope.Tx.Flags.Repository, options, scope.Tx.Flags.ImportOptions.Format)
+	if err != nil {
+		return nil, err
+	}
+	if scope.Tx.cachedViews.Exists(fileInfo.Path) {
+		return LoadViewFromTableIdentifier(ctx, scope, table, false, false)
+	}
+
+	setLoadingOptions(fileInfo, scope.Tx.Flags, options)
+	fileInfo.recordLimit = n
+	return readViewFromFile(ctx, scope, tableIdentifier, fileInfo, false, options)
+}
+
 func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.QueryExpression, forUpdate bool, useInternalId bool) (view *View, err error) {
 	if parentheses, ok := tableExpr.(parser.Parentheses); ok {
 		return loadView(ctx, scope, parentheses.Expr, forUpdate, useInternalId)
@@ -126,6 +180,8 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 		encodingIdx := 0
 		noHeaderIdx := 1
 		withoutNullIdx := 2
+		quoteCharIdx := -1
+		escapeStyleIdx := -1
 
 		switch tableObject.Type.Token {
 		case parser.CSV:
@@ -137,14 +193,22 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 			}
 			s := felem.(*value.String).Raw()
 			d := []rune(s)
-			if 1 != len(d) {
+			if len(d) < 1 {
 				return nil, NewTableObjectInvalidDelimiterError(tableObject, tableObject.FormatElement.String())
 			}
-			if 3 < len(tableObject.Args) {
-				return nil, NewTableObjectArgumentsLengthError(tableObject, 5)
+			if 5 < len(tableObject.Args) {
+				return nil, NewTableObjectArgumentsLengthError(tableObject, 7)
 			}
-			options.Delimiter = d[0]
-			if options.Delimiter == '\t' {
+			quoteCharIdx = 3
+			escapeStyleIdx = 4
+			options.DelimiterRegex = ""
+			if 1 < len(d) {
+				options.MultiCharDelimiter = s
+			} else {
+				options.Delimiter = d[0]
+				options.MultiCharDelimiter = ""
+			}
+			if len(options.MultiCharDelimiter) < 1 && options.Delimiter == '\t' {
 				options.Format = cmd.TSV
 			} else {
 				options.Format = cmd.CSV
@@ -158,21 +222,16 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 			}
 			s := felem.(*value.String).Raw()
 
-			var positions []int
-			if !strings.EqualFold("SPACES", s) {
-				if strings.HasPrefix(s, "s[") || strings.HasPrefix(s, "S[") {
-					options.SingleLine = true
-					s = s[1:]
-				}
-				err = gojson.Unmarshal([]byte(s), &positions)
-				if err != nil {
-					return nil, NewTableObjectInvalidDelimiterPositionsError(tableObject, tableObject.FormatElement.String())
-				}
+			positions, names, singleLine, err := cmd.ParseDelimiterPositionsWithNames(s)
+			if err != nil {
+				return nil, NewTableObjectInvalidDelimiterPositionsError(tableObject, tableObject.FormatElement.String())
 			}
 			if 3 < len(tableObject.Args) {
 				return nil, NewTableObjectArgumentsLengthError(tableObject, 5)
 			}
 			options.DelimiterPositions = positions
+			options.FieldNames = names
+			options.SingleLine = singleLine
 			options.Format = cmd.FIXED
 		case parser.JSON:
 			if felem == nil {
@@ -197,7 +256,7 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 			return nil, NewInvalidTableObjectError(tableObject, tableObject.Type.Literal)
 		}
 
-		args := make([]value.Primary, 3)
+		args := make([]value.Primary, 5)
 		defer func() {
 			for i := range args {
 				if args[i] != nil {
@@ -241,6 +300,20 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 				} else {
 					return nil, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a without-null value: %s", tableObject.Args[withoutNullIdx].String()))
 				}
+			case quoteCharIdx:
+				v := value.ToString(p)
+				if !value.IsNull(v) {
+					args[i] = v
+				} else {
+					return nil, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a quote-char value: %s", tableObject.Args[quoteCharIdx].String()))
+				}
+			case escapeStyleIdx:
+				v := value.ToString(p)
+				if !value.IsNull(v) {
+					args[i] = v
+				} else {
+					return nil, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a escape-style value: %s", tableObject.Args[escapeStyleIdx].String()))
+				}
 			}
 		}
 
@@ -251,10 +324,21 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 		}
 		if args[noHeaderIdx] != nil {
 			options.NoHeader = args[noHeaderIdx].(*value.Boolean).Raw()
+			options.Detect = false
 		}
 		if args[withoutNullIdx] != nil {
 			options.WithoutNull = args[withoutNullIdx].(*value.Boolean).Raw()
 		}
+		if 0 <= quoteCharIdx && args[quoteCharIdx] != nil {
+			if options.QuoteChar, err = cmd.ParseQuoteChar(args[quoteCharIdx].(*value.String).Raw()); err != nil {
+				return nil, NewTableObjectInvalidArgumentError(tableObject, err.Error())
+			}
+		}
+		if 0 <= escapeStyleIdx && args[escapeStyleIdx] != nil {
+			if options.EscapeStyle, err = cmd.ParseEscapeStyle(args[escapeStyleIdx].(*value.String).Raw()); err != nil {
+				return nil, NewTableObjectInvalidArgumentError(tableObject, err.Error())
+			}
+		}
 
 		view, err = loadObject(
 			ctx,
@@ -285,6 +369,16 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 		if err != nil {
 			return nil, err
 		}
+	case parser.Url:
+		view, err = loadUrl(ctx, scope, table.Object.(parser.Url), tableName, useInternalId)
+		if err != nil {
+			return nil, err
+		}
+	case parser.FileGlob:
+		view, err = loadFileGlob(ctx, scope, table.Object.(parser.FileGlob), tableName)
+		if err != nil {
+			return nil, err
+		}
 	case parser.Join:
 		join := table.Object.(parser.Join)
 		view, err = loadView(ctx, scope, join.Table, forUpdate, useInternalId)
@@ -547,6 +641,7 @@ func loadStdin(ctx context.Context, scope *ReferenceScope, fileInfo *FileInfo, s
 			return nil, err
 		}
 		scope.Global().temporaryTables.Set(view)
+		scope.Tx.setLastLoadWarnings(view.FileInfo)
 	}
 
 	pathIdent := parser.Identifier{Literal: stdin.String()}
@@ -579,6 +674,206 @@ func loadStdin(ctx context.Context, scope *ReferenceScope, fileInfo *FileInfo, s
 	return view, nil
 }
 
+func loadUrl(ctx context.Context, scope *ReferenceScope, u parser.Url, tableName parser.Identifier, useInternalId bool) (*View, error) {
+	if err := cacheViewFromUrl(ctx, scope, u); err != nil {
+		return nil, err
+	}
+
+	var view *View
+	var err error
+	pathIdent := parser.Identifier{Literal: u.Raw}
+	if useInternalId {
+		if view, err = scope.Tx.cachedViews.GetWithInternalId(ctx, pathIdent, scope.Tx.Flags); err != nil {
+			if err == errTableNotLoaded {
+				err = NewTableNotLoadedError(pathIdent)
+			}
+			return nil, err
+		}
+	} else {
+		if view, err = scope.Tx.cachedViews.Get(pathIdent); err != nil {
+			return nil, NewTableNotLoadedError(pathIdent)
+		}
+	}
+
+	if err = scope.AddAlias(tableName, u.Raw); err != nil {
+		return nil, err
+	}
+
+	if !strings.EqualFold(parser.FormatTableName(u.Raw), tableName.Literal) {
+		if err = view.Header.Update(tableName.Literal, nil); err != nil {
+			return nil, err
+		}
+	}
+	return view, nil
+}
+
+// cacheViewFromUrl fetches the resource of the url and caches the view until the transaction ends.
+// The resource is fetched without holding the lock for loading views so that
+// a slow download does not block loading other tables.
+func cacheViewFromUrl(ctx context.Context, scope *ReferenceScope, u parser.Url) error {
+	scope.Tx.viewLoadingMutex.Lock()
+	exists := scope.Tx.cachedViews.Exists(u.Raw)
+	scope.Tx.viewLoadingMutex.Unlock()
+	if exists {
+		return nil
+	}
+
+	b, contentType, err := fetchUrl(ctx, scope.Tx.Flags, u)
+	if err != nil {
+		return err
+	}
+
+	options := scope.Tx.Flags.ImportOptions
+	format := detectUrlFormat(u.Raw, contentType, options.Format)
+
+	delimiter := options.Delimiter
+	multiCharDelimiter := ""
+	delimiterRegex := ""
+	encoding := options.Encoding
+	switch format {
+	case cmd.CSV:
+		multiCharDelimiter = options.MultiCharDelimiter
+		delimiterRegex = options.DelimiterRegex
+	case cmd.TSV:
+		delimiter = '\t'
+	case cmd.JSON, cmd.NDJSON:
+		encoding = text.UTF8
+	}
+
+	fileInfo := &FileInfo{
+		Path:               u.Raw,
+		Format:             format,
+		Delimiter:          delimiter,
+		MultiCharDelimiter: multiCharDelimiter,
+		DelimiterRegex:     delimiterRegex,
+		DelimiterPositions: options.DelimiterPositions,
+		FieldNames:         options.FieldNames,
+		SingleLine:         options.SingleLine,
+		JsonQuery:          cmd.TrimSpace(options.JsonQuery),
+		Encoding:           encoding,
+		LineBreak:          scope.Tx.Flags.ExportOptions.LineBreak,
+		NoHeader:           options.NoHeader,
+		ViewType:           ViewTypeUrl,
+	}
+	fileInfo.SetQuoteStyle(options)
+
+	view, err := loadViewFromFile(ctx, scope.Tx.Flags, bytes.NewReader(b), fileInfo, options.WithoutNull, u)
+	if err != nil {
+		if _, ok := err.(Error); !ok {
+			err = NewDataParsingError(u, fileInfo.Path, err.Error())
+		}
+		return err
+	}
+
+	scope.Tx.viewLoadingMutex.Lock()
+	defer scope.Tx.viewLoadingMutex.Unlock()
+	if !scope.Tx.cachedViews.Exists(u.Raw) {
+		scope.Tx.cachedViews.Set(view)
+		scope.Tx.setLastLoadWarnings(view.FileInfo)
+	}
+	return nil
+}
+
+// loadFileGlob loads all the files matched by the glob pattern with the same options
+// and concatenates them into one view.
+func loadFileGlob(ctx context.Context, scope *ReferenceScope, glob parser.FileGlob, tableName parser.Identifier) (*View, error) {
+	pathes, err := SearchFilePathsByGlob(glob, scope.Tx.Flags.Repository)
+	if err != nil {
+		return nil, err
+	}
+
+	options := scope.Tx.Flags.ImportOptions.Copy()
+	options.Format = cmd.AutoSelect
+
+	var view *View
+	var basePath string
+	for _, fpath := range pathes {
+		filePath, err := cacheViewFromFile(ctx, scope, parser.Identifier{BaseExpr: glob.BaseExpr, Literal: fpath}, false, options)
+		if err != nil {
+			return nil, err
+		}
+
+		fileView, err := scope.Tx.cachedViews.Get(parser.Identifier{Literal: filePath})
+		if err != nil {
+			return nil, NewTableNotLoadedError(parser.Identifier{BaseExpr: glob.BaseExpr, Literal: filePath})
+		}
+
+		if view == nil {
+			view = fileView
+			view.recordOrigins = append([]recordOrigin(nil), view.recordOrigins...)
+			basePath = filePath
+			continue
+		}
+
+		indices, ok := matchGlobHeader(view.Header, fileView.Header, scope.Tx.Flags.ImportOptions.StrictGlobHeader)
+		if !ok {
+			return nil, NewFileGlobHeaderMismatchError(glob, filePath, basePath)
+		}
+
+		for _, record := range fileView.RecordSet {
+			r := make(Record, len(indices))
+			for i, idx := range indices {
+				r[i] = record[idx]
+			}
+			view.RecordSet = append(view.RecordSet, r)
+		}
+		view.recordOrigins = append(view.recordOrigins, fileView.recordOrigins...)
+	}
+
+	view.FileInfo = &FileInfo{
+		Path:      glob.Pattern,
+		Format:    view.FileInfo.Format,
+		Delimiter: view.FileInfo.Delimiter,
+		Encoding:  view.FileInfo.Encoding,
+		LineBreak: view.FileInfo.LineBreak,
+		ViewType:  ViewTypeTemporaryTable,
+	}
+
+	if err = scope.AddAlias(tableName, ""); err != nil {
+		return nil, err
+	}
+	if err = view.Header.Update(tableName.Literal, nil); err != nil {
+		return nil, err
+	}
+	return view, nil
+}
+
+// matchGlobHeader returns the field indices of the header to be concatenated in the order of the base header.
+// The columns are matched by their names regardless of the order unless strict is true.
+func matchGlobHeader(base Header, header Header, strict bool) ([]int, bool) {
+	if len(base) != len(header) {
+		return nil, false
+	}
+
+	indices := make([]int, len(base))
+	if strict {
+		for i := range base {
+			if !strings.EqualFold(base[i].Column, header[i].Column) {
+				return nil, false
+			}
+			indices[i] = i
+		}
+		return indices, true
+	}
+
+	used := make([]bool, len(header))
+	for i := range base {
+		idx := -1
+		for j := range header {
+			if !used[j] && strings.EqualFold(base[i].Column, header[j].Column) {
+				idx = j
+				break
+			}
+		}
+		if idx < 0 {
+			return nil, false
+		}
+		used[idx] = true
+		indices[i] = idx
+	}
+	return indices, true
+}
+
 func loadObject(
 	ctx context.Context,
 	scope *ReferenceScope,
@@ -597,7 +892,10 @@ func loadObject(
 			Path:               stdin.String(),
 			Format:             options.Format,
 			Delimiter:          options.Delimiter,
+			MultiCharDelimiter: options.MultiCharDelimiter,
+			DelimiterRegex:     options.DelimiterRegex,
 			DelimiterPositions: options.DelimiterPositions,
+			FieldNames:         options.FieldNames,
 			SingleLine:         options.SingleLine,
 			JsonQuery:          options.JsonQuery,
 			Encoding:           options.Encoding,
@@ -605,6 +903,7 @@ func loadObject(
 			NoHeader:           options.NoHeader,
 			ViewType:           ViewTypeStdin,
 		}
+		fileInfo.SetQuoteStyle(options)
 		return loadStdin(ctx, scope, fileInfo, stdin, tableName, forUpdate, useInternalId)
 	}
 
@@ -719,88 +1018,646 @@ func cacheViewFromFile(
 		filePath = p
 	}
 
-	view, ok := scope.Tx.cachedViews.Load(filePath)
-	if !ok || (forUpdate && !view.FileInfo.ForUpdate) {
-		fileInfo, err := NewFileInfo(tableIdentifier, scope.Tx.Flags.Repository, options, scope.Tx.Flags.ImportOptions.Format)
-		if err != nil {
-			return filePath, err
+	view, ok := scope.Tx.cachedViews.Load(filePath)
+	if !ok || (forUpdate && !view.FileInfo.ForUpdate) {
+		fileInfo, err := NewFileInfo(tableIdentifier, scope.Tx.Flags.Repository, options, scope.Tx.Flags.ImportOptions.Format)
+		if err != nil {
+			return filePath, err
+		}
+		filePath = fileInfo.Path
+
+		view, ok = scope.Tx.cachedViews.Load(filePath)
+		if !ok || (forUpdate && !view.FileInfo.ForUpdate) {
+			setLoadingOptions(fileInfo, scope.Tx.Flags, options)
+
+			if ok {
+				fileInfo = view.FileInfo
+			}
+
+			if err = scope.Tx.cachedViews.Dispose(scope.Tx.FileContainer, fileInfo.Path); err != nil {
+				return filePath, err
+			}
+
+			loadView, err := readViewFromFile(ctx, scope, tableIdentifier, fileInfo, forUpdate, options)
+			if err != nil {
+				return filePath, err
+			}
+			scope.Tx.cachedViews.Set(loadView)
+			scope.Tx.setLastLoadWarnings(loadView.FileInfo)
+		}
+	}
+	if !cacheExists {
+		scope.StoreFilePath(tableIdentifier.Literal, filePath)
+	}
+	return filePath, nil
+}
+
+// setLoadingOptions sets the options to load the file to the file info.
+func setLoadingOptions(fileInfo *FileInfo, flags *cmd.Flags, options cmd.ImportOptions) {
+	fileInfo.DelimiterPositions = options.DelimiterPositions
+	fileInfo.FieldNames = options.FieldNames
+	fileInfo.SingleLine = options.SingleLine
+	fileInfo.JsonQuery = cmd.TrimSpace(options.JsonQuery)
+	fileInfo.LineBreak = flags.ExportOptions.LineBreak
+	fileInfo.NoHeader = options.NoHeader
+	fileInfo.EncloseAll = flags.ExportOptions.EncloseAll
+	fileInfo.JsonEscape = flags.ExportOptions.JsonEscape
+}
+
+// readViewFromFile opens the file and loads the view from it.
+// If forUpdate is true, then the file is kept open and the handler is set to the file info.
+func readViewFromFile(
+	ctx context.Context,
+	scope *ReferenceScope,
+	tableIdentifier parser.Identifier,
+	fileInfo *FileInfo,
+	forUpdate bool,
+	options cmd.ImportOptions,
+) (*View, error) {
+	var err error
+
+	if forUpdate {
+		if fileInfo.SplitsFields() {
+			return nil, NewFileNotUpdatableError(tableIdentifier, fileInfo.Path, "fields are split by a multi-character or regular expression delimiter")
+		}
+		if fileInfo.CustomizesQuotes() {
+			return nil, NewFileNotUpdatableError(tableIdentifier, fileInfo.Path, "fields are quoted or escaped in a custom style")
+		}
+		if skipsLines(options, fileInfo.Format) {
+			return nil, NewFileNotUpdatableError(tableIdentifier, fileInfo.Path, "lines are skipped on loading")
+		}
+		if handlesRaggedRows(options, fileInfo) {
+			return nil, NewFileNotUpdatableError(tableIdentifier, fileInfo.Path, "records with a wrong number of fields are not kept as they are")
+		}
+	}
+
+	var fp io.ReadSeeker
+	if forUpdate {
+		h, err := file.NewHandlerForUpdate(ctx, scope.Tx.FileContainer, fileInfo.Path, scope.Tx.WaitTimeout, scope.Tx.RetryDelay)
+		if err != nil {
+			tableIdentifier.Literal = fileInfo.Path
+			return nil, ConvertFileHandlerError(err, tableIdentifier)
+		}
+		fileInfo.Handler = h
+		fp = h.File()
+	} else {
+		h, err := file.NewHandlerForRead(ctx, scope.Tx.FileContainer, fileInfo.Path, scope.Tx.WaitTimeout, scope.Tx.RetryDelay)
+		if err != nil {
+			tableIdentifier.Literal = fileInfo.Path
+			return nil, ConvertFileHandlerError(err, tableIdentifier)
+		}
+		defer func() {
+			err = appendCompositeError(err, scope.Tx.FileContainer.Close(h))
+		}()
+		fp = h.File()
+	}
+
+	if fp, fileInfo.Compression, err = decompressFile(fp); err != nil {
+		return nil, appendCompositeError(NewIOError(tableIdentifier, err.Error()), scope.Tx.FileContainer.Close(fileInfo.Handler))
+	}
+
+	if options.Detect && (fileInfo.Format == cmd.CSV || fileInfo.Format == cmd.TSV) && !fileInfo.SplitsFields() {
+		detectsDelimiter := options.Format == cmd.AutoSelect && fileInfo.Format == cmd.CSV && options.Delimiter == ','
+		detectsHeader := !options.NoHeader
+		fileInfo.Delimiter, fileInfo.NoHeader, err = detectCSVSettings(fp, fileInfo.Encoding, fileInfo.Delimiter, fileInfo.NoHeader, options, detectsDelimiter, detectsHeader)
+		if err != nil {
+			return nil, appendCompositeError(NewIOError(tableIdentifier, err.Error()), scope.Tx.FileContainer.Close(fileInfo.Handler))
+		}
+		if scope.Tx.Flags.Stats {
+			header := "with header"
+			if fileInfo.NoHeader {
+				header = "without header"
+			}
+			scope.Tx.LogNotice(fmt.Sprintf("%s: delimiter %s, %s", fileInfo.Path, cmd.QuoteString(cmd.EscapeString(string(fileInfo.Delimiter))), header), scope.Tx.Flags.Quiet)
+		}
+	}
+
+	loadView, err := loadViewFromFile(ctx, scope.Tx.Flags, fp, fileInfo, options.WithoutNull, tableIdentifier)
+	if err != nil {
+		if _, ok := err.(Error); !ok {
+			err = NewDataParsingError(tableIdentifier, fileInfo.Path, err.Error())
+		}
+		return nil, appendCompositeError(err, scope.Tx.FileContainer.Close(fileInfo.Handler))
+	}
+	loadView.FileInfo.ForUpdate = forUpdate
+	if err = LoadTableDefinition(ctx, scope.Tx, loadView, tableIdentifier); err != nil {
+		err = appendCompositeError(err, scope.Tx.FileContainer.Close(fileInfo.DefinitionHandler))
+		return nil, appendCompositeError(err, scope.Tx.FileContainer.Close(fileInfo.Handler))
+	}
+	return loadView, nil
+}
+
+func loadViewFromFile(ctx context.Context, flags *cmd.Flags, fp io.ReadSeeker, fileInfo *FileInfo, withoutNull bool, expr parser.QueryExpression) (*View, error) {
+	var view *View
+	var err error
+
+	if flags.ImportOptions.NoInfer {
+		withoutNull = true
+	}
+
+	var lineNumbers []int
+	if skipsLines(flags.ImportOptions, fileInfo.Format) {
+		if fp, lineNumbers, err = skipLines(fp, fileInfo, flags.ImportOptions, expr); err != nil {
+			return nil, err
+		}
+	}
+
+	var recordLines []int
+
+	switch fileInfo.Format {
+	case cmd.FIXED:
+		view, err = loadViewFromFixedLengthTextFile(ctx, fp, fileInfo, withoutNull, expr)
+	case cmd.LTSV:
+		view, err = loadViewFromLTSVFile(ctx, flags, fp, fileInfo, withoutNull, expr)
+	case cmd.JSON:
+		view, err = loadViewFromJsonFile(fp, fileInfo, expr)
+	case cmd.NDJSON:
+		view, err = loadViewFromNdjsonFile(ctx, flags, fp, fileInfo)
+	default:
+		if fileInfo.SplitsFields() {
+			view, err = loadViewFromSplitTextFile(ctx, fp, fileInfo, withoutNull, expr)
+		} else if fileInfo.CustomizesQuotes() || handlesRaggedRows(flags.ImportOptions, fileInfo) {
+			view, recordLines, err = loadViewFromQuotedCSVFile(ctx, fp, fileInfo, withoutNull, flags.ImportOptions.Ragged, expr)
+		} else {
+			view, err = loadViewFromCSVFile(ctx, fp, fileInfo, withoutNull, expr)
+		}
+	}
+	if err != nil {
+		return nil, physicalLineError(err, lineNumbers)
+	}
+
+	setRecordOrigins(view, fileInfo, 1)
+	if recordLines != nil {
+		for i := range view.recordOrigins {
+			view.recordOrigins[i].Line = recordLines[i]
+		}
+	}
+	if lineNumbers != nil {
+		for i := range view.recordOrigins {
+			if 0 < view.recordOrigins[i].Line && view.recordOrigins[i].Line <= len(lineNumbers) {
+				view.recordOrigins[i].Line = lineNumbers[view.recordOrigins[i].Line-1]
+			}
+		}
+	}
+
+	if flags.ImportOptions.NoInfer && (fileInfo.Format == cmd.JSON || fileInfo.Format == cmd.NDJSON) {
+		if err = convertToStrings(ctx, flags, view); err != nil {
+			return nil, err
+		}
+	}
+	if flags.ImportOptions.Trim {
+		if err = trimFields(ctx, flags, view); err != nil {
+			return nil, err
+		}
+	}
+	if err = convertColumnTypes(ctx, flags, view, fileInfo); err != nil {
+		return nil, err
+	}
+	return view, nil
+}
+
+// handlesRaggedRows returns true if records with a wrong number of fields in
+// CSV are padded, truncated or skipped on loading.
+func handlesRaggedRows(options cmd.ImportOptions, fileInfo *FileInfo) bool {
+	return options.Ragged != cmd.RaggedError && (fileInfo.Format == cmd.CSV || fileInfo.Format == cmd.TSV) && !fileInfo.SplitsFields()
+}
+
+// setRecordOrigins sets the path of the file and the line numbers to the records.
+// Line numbers are counted from the firstLine with the line breaks in the header and
+// the fields, so empty lines that are skipped on loading are not counted.
+// The lines are not set if firstLine is 0 or the format does not have lines for records.
+func setRecordOrigins(view *View, fileInfo *FileInfo, firstLine int) {
+	view.recordOrigins = make([]recordOrigin, view.RecordLen())
+	for i := range view.recordOrigins {
+		view.recordOrigins[i].Path = fileInfo.Path
+	}
+
+	if firstLine < 1 {
+		return
+	}
+
+	countsLineBreaks := false
+	switch fileInfo.Format {
+	case cmd.JSON:
+		return
+	case cmd.FIXED:
+		if fileInfo.SingleLine {
+			return
+		}
+	case cmd.CSV, cmd.TSV:
+		countsLineBreaks = true
+	}
+
+	line := firstLine
+	if countsLineBreaks && !fileInfo.NoHeader {
+		line++
+		for _, f := range view.Header {
+			line += countLineBreaks(f.Column)
+		}
+	} else if fileInfo.Format == cmd.FIXED && !fileInfo.NoHeader {
+		line++
+	}
+
+	for i, record := range view.RecordSet {
+		view.recordOrigins[i].Line = line
+		line++
+		if countsLineBreaks {
+			for _, cell := range record {
+				if s, ok := cell[0].(*value.String); ok {
+					line += countLineBreaks(s.Raw())
+				}
+			}
+		}
+	}
+}
+
+func countLineBreaks(s string) int {
+	cnt := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\n':
+			cnt++
+		case '\r':
+			if i+1 == len(s) || s[i+1] != '\n' {
+				cnt++
+			}
+		}
+	}
+	return cnt
+}
+
+// detectEncoding returns the encoding of the file.
+// If UTF16BE or UTF16LE is specified and the file starts with the byte order mark
+// of the same byte order, the encoding with BOM is returned so that the mark is
+// not read as a part of the first field. A byte order mark in the middle of the
+// file is not removed.
+func detectEncoding(fp io.ReadSeeker, specified text.Encoding) (text.Encoding, error) {
+	if specified == cmd.EUCJP {
+		return specified, nil
+	}
+
+	enc, err := text.DetectInSpecifiedEncoding(fp, specified)
+	if err != nil {
+		return enc, err
+	}
+
+	var bom string
+	switch enc {
+	case text.UTF16BE:
+		bom = text.UTF16BEBOM
+	case text.UTF16LE:
+		bom = text.UTF16LEBOM
+	default:
+		return enc, nil
+	}
+
+	if _, err = fp.Seek(0, io.SeekStart); err != nil {
+		return enc, err
+	}
+	lead := make([]byte, len(bom))
+	n, _ := io.ReadFull(fp, lead)
+	if _, err = fp.Seek(0, io.SeekStart); err != nil {
+		return enc, err
+	}
+
+	if n == len(bom) && string(lead) == bom {
+		if enc == text.UTF16BE {
+			enc = text.UTF16BEM
+		} else {
+			enc = text.UTF16LEM
+		}
+	}
+	return enc, nil
+}
+
+func skipsLines(options cmd.ImportOptions, format cmd.Format) bool {
+	return format != cmd.JSON && (0 < options.SkipLines || 0 < len(options.CommentPrefix))
+}
+
+// skipLines returns the reader without the lines to be skipped and the line
+// numbers in the file of the lines that are not skipped.
+// Lines in multi-line fields enclosed in quotation marks are never skipped as
+// comments, because comments are recognized only at the beginning of records.
+func skipLines(fp io.ReadSeeker, fileInfo *FileInfo, options cmd.ImportOptions, expr parser.QueryExpression) (io.ReadSeeker, []int, error) {
+	enc, err := detectEncoding(fp, fileInfo.Encoding)
+	if err != nil {
+		return nil, nil, NewCannotDetectFileEncodingError(expr)
+	}
+	switch enc {
+	case text.UTF16, text.UTF16BE, text.UTF16LE, text.UTF16BEM, text.UTF16LEM:
+		return nil, nil, errors.New(fmt.Sprintf("lines cannot be skipped in %s", enc))
+	}
+	fileInfo.Encoding = enc
+
+	var quotes *recordBoundaryScanner
+	if (fileInfo.Format == cmd.CSV || fileInfo.Format == cmd.TSV) && !fileInfo.SplitsFields() {
+		quotes = newRecordBoundaryScanner(fileInfo)
+	}
+
+	prefix := []byte(options.CommentPrefix)
+	reader := bufio.NewReader(fp)
+	buf := &bytes.Buffer{}
+	lineNumbers := make([]int, 0, 100)
+	inBody := false
+	for i := 0; ; i++ {
+		line, err := reader.ReadBytes('\n')
+		if err != nil && (err != io.EOF || len(line) < 1) {
+			if err != io.EOF {
+				return nil, nil, NewIOError(expr, err.Error())
+			}
+			break
+		}
+
+		if i < options.SkipLines {
+			continue
+		}
+
+		atBoundary := quotes == nil || quotes.AtBoundary()
+		if atBoundary && 0 < len(prefix) && (!inBody || options.SkipAllComments) {
+			l := line
+			if i == 0 {
+				l = bytes.TrimPrefix(l, []byte(text.UTF8BOM))
+			}
+			if bytes.HasPrefix(l, prefix) {
+				continue
+			}
+		}
+		if quotes != nil {
+			quotes.Scan(line)
+		}
+		inBody = true
+		buf.Write(line)
+		lineNumbers = append(lineNumbers, i+1)
+	}
+	return bytes.NewReader(buf.Bytes()), lineNumbers, nil
+}
+
+var lineErrorPrefix = regexp.MustCompile("^line ([0-9]+)")
+
+// physicalLineError replaces the line number in the error returned by readers
+// with the line number in the file before lines are skipped.
+func physicalLineError(err error, lineNumbers []int) error {
+	if lineNumbers == nil {
+		return err
+	}
+	if _, ok := err.(Error); ok {
+		return err
+	}
+
+	msg := err.Error()
+	m := lineErrorPrefix.FindStringSubmatch(msg)
+	if m == nil {
+		return err
+	}
+	line, e := strconv.Atoi(m[1])
+	if e != nil || line < 1 || len(lineNumbers) < line {
+		return err
+	}
+	return errors.New("line " + strconv.Itoa(lineNumbers[line-1]) + msg[len(m[0]):])
+}
+
+// recordBoundaryScanner tracks whether the next line starts a new record or
+// continues a field enclosed in quotation marks.
+type recordBoundaryScanner struct {
+	delimiter       rune
+	quoteChar       rune
+	backslashEscape bool
+
+	inQuotes    bool
+	quoteClosed bool
+	escaped     bool
+	fieldStart  bool
+}
+
+func newRecordBoundaryScanner(fileInfo *FileInfo) *recordBoundaryScanner {
+	s := &recordBoundaryScanner{
+		delimiter:  fileInfo.Delimiter,
+		quoteChar:  '"',
+		fieldStart: true,
+	}
+	if fileInfo.CustomizesQuotes() {
+		s.quoteChar = fileInfo.QuoteChar
+		s.backslashEscape = fileInfo.EscapeStyle == cmd.BackslashEscape
+	}
+	return s
+}
+
+func (s *recordBoundaryScanner) AtBoundary() bool {
+	return !s.inQuotes && !s.escaped
+}
+
+func (s *recordBoundaryScanner) Scan(line []byte) {
+	for _, r := range string(line) {
+		quoteClosed := s.quoteClosed
+		s.quoteClosed = false
+
+		if s.escaped {
+			s.escaped = false
+			s.fieldStart = false
+			continue
+		}
+
+		switch {
+		case s.backslashEscape && r == '\\':
+			s.escaped = true
+		case s.inQuotes:
+			if r == s.quoteChar {
+				s.inQuotes = false
+				s.quoteClosed = true
+			}
+		case r == s.quoteChar && s.quoteChar != 0 && (s.fieldStart || quoteClosed):
+			s.inQuotes = true
+		case r == s.delimiter || r == '\n':
+			s.fieldStart = true
+			continue
+		}
+		s.fieldStart = false
+	}
+}
+
+func trimFields(ctx context.Context, flags *cmd.Flags, view *View) error {
+	for i := range view.Header {
+		view.Header[i].Column = cmd.TrimSpace(view.Header[i].Column)
+	}
+
+	return NewGoroutineTaskManager(view.RecordLen(), -1, flags.CPU).Run(ctx, func(index int) error {
+		for i := range view.RecordSet[index] {
+			s, ok := view.RecordSet[index][i][0].(*value.String)
+			if !ok {
+				continue
+			}
+
+			if trimmed := cmd.TrimSpace(s.Raw()); len(trimmed) != len(s.Raw()) {
+				view.RecordSet[index][i][0] = value.NewString(trimmed)
+			}
+		}
+		return nil
+	})
+}
+
+func convertToStrings(ctx context.Context, flags *cmd.Flags, view *View) error {
+	fn := parser.Function{Name: "STRING"}
+
+	return NewGoroutineTaskManager(view.RecordLen(), -1, flags.CPU).Run(ctx, func(index int) error {
+		for i := range view.RecordSet[index] {
+			p := view.RecordSet[index][i][0]
+			if _, ok := p.(*value.String); ok || value.IsNull(p) {
+				continue
+			}
+
+			converted, err := String(fn, []value.Primary{p}, flags)
+			if err != nil {
+				return err
+			}
+			view.RecordSet[index][i][0] = converted
+		}
+		return nil
+	})
+}
+
+// convertColumnTypes converts the values in the columns specified by the column
+// types. The column types are shared by all the loaded tables, so columns that
+// do not exist in the table are ignored.
+// Cells whose texts are not restored from the converted values hold the original
+// texts, which are written back to the file unless the cells are updated.
+func convertColumnTypes(ctx context.Context, flags *cmd.Flags, view *View, fileInfo *FileInfo) error {
+	if len(flags.ImportOptions.ColumnTypes) < 1 {
+		return nil
+	}
+
+	indices := make([]int, 0, len(flags.ImportOptions.ColumnTypes))
+	types := make([]cmd.ColumnType, 0, len(flags.ImportOptions.ColumnTypes))
+	for _, ct := range flags.ImportOptions.ColumnTypes {
+		idx := columnTypeIndex(view.Header, ct)
+		if idx < 0 {
+			continue
 		}
-		filePath = fileInfo.Path
+		indices = append(indices, idx)
+		types = append(types, ct)
 
-		view, ok = scope.Tx.cachedViews.Load(filePath)
-		if !ok || (forUpdate && !view.FileInfo.ForUpdate) {
-			fileInfo.DelimiterPositions = options.DelimiterPositions
-			fileInfo.SingleLine = options.SingleLine
-			fileInfo.JsonQuery = cmd.TrimSpace(options.JsonQuery)
-			fileInfo.LineBreak = scope.Tx.Flags.ExportOptions.LineBreak
-			fileInfo.NoHeader = options.NoHeader
-			fileInfo.EncloseAll = scope.Tx.Flags.ExportOptions.EncloseAll
-			fileInfo.JsonEscape = scope.Tx.Flags.ExportOptions.JsonEscape
-
-			if ok {
-				fileInfo = view.FileInfo
+		if 0 < len(ct.Format) {
+			if fileInfo.datetimeFormats == nil {
+				fileInfo.datetimeFormats = make(map[string]string)
 			}
+			fileInfo.datetimeFormats[strings.ToUpper(view.Header[idx].Column)] = ct.Format
+		}
+	}
+	if len(indices) < 1 {
+		return nil
+	}
 
-			if err = scope.Tx.cachedViews.Dispose(scope.Tx.FileContainer, fileInfo.Path); err != nil {
-				return filePath, err
+	return NewGoroutineTaskManager(view.RecordLen(), -1, flags.CPU).Run(ctx, func(index int) error {
+		for i, idx := range indices {
+			p := view.RecordSet[index][idx][0]
+			if value.IsNull(p) {
+				continue
 			}
 
-			var fp *os.File
-			if forUpdate {
-				h, err := file.NewHandlerForUpdate(ctx, scope.Tx.FileContainer, fileInfo.Path, scope.Tx.WaitTimeout, scope.Tx.RetryDelay)
-				if err != nil {
-					tableIdentifier.Literal = fileInfo.Path
-					return filePath, ConvertFileHandlerError(err, tableIdentifier)
-				}
-				fileInfo.Handler = h
-				fp = h.File()
+			var converted value.Primary
+			var err error
+			if 0 < len(types[i].Format) {
+				converted, err = ParseDatetime(parser.Function{Name: "PARSE_DATETIME"}, []value.Primary{p, value.NewString(types[i].Format)}, flags)
 			} else {
-				h, err := file.NewHandlerForRead(ctx, scope.Tx.FileContainer, fileInfo.Path, scope.Tx.WaitTimeout, scope.Tx.RetryDelay)
-				if err != nil {
-					tableIdentifier.Literal = fileInfo.Path
-					return filePath, ConvertFileHandlerError(err, tableIdentifier)
-				}
-				defer func() {
-					err = appendCompositeError(err, scope.Tx.FileContainer.Close(h))
-				}()
-				fp = h.File()
+				converted, err = Functions[types[i].Type](parser.Function{Name: types[i].Type}, []value.Primary{p}, flags)
 			}
-
-			loadView, err := loadViewFromFile(ctx, scope.Tx.Flags, fp, fileInfo, options.WithoutNull, tableIdentifier)
 			if err != nil {
-				if _, ok := err.(Error); !ok {
-					err = NewDataParsingError(tableIdentifier, fileInfo.Path, err.Error())
+				return err
+			}
+			if value.IsNull(converted) && flags.ImportOptions.StrictColumnTypes {
+				if index < len(view.recordOrigins) && 0 < view.recordOrigins[index].Line {
+					return errors.New(fmt.Sprintf("value %s in line %d, column %s cannot be converted to %s", p.String(), view.recordOrigins[index].Line, view.Header[idx].Column, types[i].TypeString()))
 				}
-				return filePath, appendCompositeError(err, scope.Tx.FileContainer.Close(fileInfo.Handler))
+				return errors.New(fmt.Sprintf("value %s in column %s cannot be converted to %s", p.String(), view.Header[idx].Column, types[i].TypeString()))
+			}
+
+			if s, ok := p.(*value.String); ok && s.Raw() != writtenText(converted, types[i].Format) {
+				view.RecordSet[index][idx] = NewCellWithText(converted, s.Raw())
+			} else {
+				view.RecordSet[index][idx] = NewCell(converted)
 			}
-			loadView.FileInfo.ForUpdate = forUpdate
-			scope.Tx.cachedViews.Set(loadView)
+		}
+		return nil
+	})
+}
+
+func columnTypeIndex(header Header, ct cmd.ColumnType) int {
+	for i := range header {
+		if !header[i].IsFromTable {
+			continue
+		}
+		if strings.EqualFold(header[i].Column, ct.Column) {
+			return i
 		}
 	}
-	if !cacheExists {
-		scope.StoreFilePath(tableIdentifier.Literal, filePath)
+	return -1
+}
+
+// writtenText returns the text of a value written to a file.
+func writtenText(p value.Primary, datetimeFormat string) string {
+	if dt, ok := p.(*value.Datetime); ok && 0 < len(datetimeFormat) {
+		return formatStrftime(dt.Raw(), datetimeFormat)
 	}
-	return filePath, nil
+	s, _, _ := ConvertFieldContents(p, false)
+	return s
 }
 
-func loadViewFromFile(ctx context.Context, flags *cmd.Flags, fp io.ReadSeeker, fileInfo *FileInfo, withoutNull bool, expr parser.QueryExpression) (*View, error) {
-	switch fileInfo.Format {
-	case cmd.FIXED:
-		return loadViewFromFixedLengthTextFile(ctx, fp, fileInfo, withoutNull, expr)
-	case cmd.LTSV:
-		return loadViewFromLTSVFile(ctx, flags, fp, fileInfo, withoutNull, expr)
-	case cmd.JSON:
-		return loadViewFromJsonFile(fp, fileInfo, expr)
+// viewForWriting returns a view whose records are written to the file of the view.
+// Cells converted by the column types on loading are written back as the original
+// texts unless they are updated, and datetime values in the columns declared with
+// formats are written in the formats.
+func viewForWriting(view *View) *View {
+	fileInfo := view.FileInfo
+	if fileInfo == nil || (len(fileInfo.datetimeFormats) < 1 && !hasCellTexts(view)) {
+		return view
+	}
+
+	formats := make([]string, view.FieldLen())
+	for i := range view.Header {
+		formats[i] = fileInfo.datetimeFormats[strings.ToUpper(view.Header[i].Column)]
+	}
+
+	records := make(RecordSet, view.RecordLen())
+	for i, record := range view.RecordSet {
+		r := make(Record, len(record))
+		for j, cell := range record {
+			if s, ok := cell.Text(); ok {
+				r[j] = NewCell(value.NewString(s))
+			} else if dt, ok := cell[0].(*value.Datetime); ok && 0 < len(formats[j]) {
+				r[j] = NewCell(value.NewString(formatStrftime(dt.Raw(), formats[j])))
+			} else {
+				r[j] = cell
+			}
+		}
+		records[i] = r
+	}
+
+	return &View{
+		Header:    view.Header,
+		RecordSet: records,
+		FileInfo:  fileInfo,
+	}
+}
+
+func hasCellTexts(view *View) bool {
+	for _, record := range view.RecordSet {
+		for _, cell := range record {
+			if _, ok := cell.Text(); ok {
+				return true
+			}
+		}
 	}
-	return loadViewFromCSVFile(ctx, fp, fileInfo, withoutNull, expr)
+	return false
 }
 
 func loadViewFromFixedLengthTextFile(ctx context.Context, fp io.ReadSeeker, fileInfo *FileInfo, withoutNull bool, expr parser.QueryExpression) (*View, error) {
-	enc, err := text.DetectInSpecifiedEncoding(fp, fileInfo.Encoding)
+	enc, err := detectEncoding(fp, fileInfo.Encoding)
 	if err != nil {
 		return nil, NewCannotDetectFileEncodingError(expr)
 	}
 	fileInfo.Encoding = enc
+	if fileInfo.Encoding == cmd.EUCJP {
+		return nil, errors.New("fixed-length format does not support EUCJP")
+	}
 
 	var r io.Reader
 
@@ -839,14 +1696,17 @@ func loadViewFromFixedLengthTextFile(ctx context.Context, fp io.ReadSeeker, file
 	reader.SingleLine = fileInfo.SingleLine
 
 	var header []string
-	if !fileInfo.NoHeader && !fileInfo.SingleLine {
+	if fileInfo.FieldNames != nil {
+		fileInfo.NoHeader = true
+		header = fileInfo.FieldNames
+	} else if !fileInfo.NoHeader && !fileInfo.SingleLine {
 		header, err = reader.ReadHeader()
 		if err != nil && err != io.EOF {
 			return nil, err
 		}
 	}
 
-	records, err := readRecordSet(ctx, reader, fileSize(fp))
+	records, err := readRecordSet(ctx, reader, fileSize(fp), fileInfo.recordLimit)
 	if err != nil {
 		return nil, err
 	}
@@ -870,13 +1730,13 @@ func loadViewFromFixedLengthTextFile(ctx context.Context, fp io.ReadSeeker, file
 }
 
 func loadViewFromCSVFile(ctx context.Context, fp io.ReadSeeker, fileInfo *FileInfo, withoutNull bool, expr parser.QueryExpression) (*View, error) {
-	enc, err := text.DetectInSpecifiedEncoding(fp, fileInfo.Encoding)
+	enc, err := detectEncoding(fp, fileInfo.Encoding)
 	if err != nil {
 		return nil, NewCannotDetectFileEncodingError(expr)
 	}
 	fileInfo.Encoding = enc
 
-	reader, err := csv.NewReader(fp, fileInfo.Encoding)
+	reader, err := csv.NewReader(readerForTextLibrary(fp, fileInfo.Encoding))
 	if err != nil {
 		return nil, err
 	}
@@ -891,8 +1751,17 @@ func loadViewFromCSVFile(ctx context.Context, fp io.ReadSeeker, fileInfo *FileIn
 		}
 	}
 
-	records, err := readRecordSet(ctx, reader, fileSize(fp))
+	records, err := readRecordSet(ctx, reader, fileSize(fp), fileInfo.recordLimit)
 	if err != nil {
+		if strings.HasSuffix(err.Error(), "wrong number of fields in line") {
+			// The error of the csv package does not tell the numbers of fields,
+			// so the file is read again to report them.
+			if _, e := fp.Seek(0, io.SeekStart); e == nil {
+				if _, _, e = loadViewFromQuotedCSVFile(ctx, fp, fileInfo, withoutNull, cmd.RaggedError, expr); e != nil {
+					return nil, e
+				}
+			}
+		}
 		return nil, err
 	}
 
@@ -915,20 +1784,129 @@ func loadViewFromCSVFile(ctx context.Context, fp io.ReadSeeker, fileInfo *FileIn
 	return view, nil
 }
 
+// loadViewFromQuotedCSVFile loads a CSV file with QuotedReader. The lines on which
+// the records start are also returned if any records are skipped.
+func loadViewFromQuotedCSVFile(ctx context.Context, fp io.ReadSeeker, fileInfo *FileInfo, withoutNull bool, ragged cmd.RaggedMode, expr parser.QueryExpression) (*View, []int, error) {
+	enc, err := detectEncoding(fp, fileInfo.Encoding)
+	if err != nil {
+		return nil, nil, NewCannotDetectFileEncodingError(expr)
+	}
+	fileInfo.Encoding = enc
+
+	reader, err := NewQuotedReader(fp, fileInfo.Encoding)
+	if err != nil {
+		return nil, nil, err
+	}
+	reader.Delimiter = fileInfo.Delimiter
+	if fileInfo.customQuotes {
+		reader.QuoteChar = fileInfo.QuoteChar
+		reader.EscapeStyle = fileInfo.EscapeStyle
+	}
+	reader.WithoutNull = withoutNull
+	reader.Ragged = ragged
+
+	var header []string
+	if !fileInfo.NoHeader {
+		header, err = reader.ReadHeader()
+		if err != nil && err != io.EOF {
+			return nil, nil, err
+		}
+	}
+
+	records, err := readRecordSet(ctx, reader, fileSize(fp), fileInfo.recordLimit)
+	if err != nil {
+		return nil, nil, err
+	}
+	fileInfo.raggedRows = reader.RaggedRows
+
+	var recordLines []int
+	if 0 < reader.RaggedRows.Skipped {
+		recordLines = reader.recordLines
+	}
+
+	if header == nil {
+		header = make([]string, reader.FieldsPerRecord)
+		for i := 0; i < reader.FieldsPerRecord; i++ {
+			header[i] = "c" + strconv.Itoa(i+1)
+		}
+	}
+
+	if reader.DetectedLineBreak != "" {
+		fileInfo.LineBreak = reader.DetectedLineBreak
+	}
+
+	view := NewView()
+	view.Header = NewHeader(parser.FormatTableName(fileInfo.Path), header)
+	view.RecordSet = records
+	view.FileInfo = fileInfo
+	return view, recordLines, nil
+}
+
+func loadViewFromSplitTextFile(ctx context.Context, fp io.ReadSeeker, fileInfo *FileInfo, withoutNull bool, expr parser.QueryExpression) (*View, error) {
+	enc, err := detectEncoding(fp, fileInfo.Encoding)
+	if err != nil {
+		return nil, NewCannotDetectFileEncodingError(expr)
+	}
+	fileInfo.Encoding = enc
+
+	reader, err := NewSplitReader(fp, fileInfo.Encoding)
+	if err != nil {
+		return nil, err
+	}
+	if 0 < len(fileInfo.DelimiterRegex) {
+		if reader.DelimiterRegex, err = regexp.Compile(fileInfo.DelimiterRegex); err != nil {
+			return nil, err
+		}
+	} else {
+		reader.Delimiter = fileInfo.MultiCharDelimiter
+	}
+	reader.WithoutNull = withoutNull
+
+	var header []string
+	if !fileInfo.NoHeader {
+		header, err = reader.ReadHeader()
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+	}
+
+	records, err := readRecordSet(ctx, reader, fileSize(fp), fileInfo.recordLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	if header == nil {
+		header = make([]string, reader.FieldsPerRecord)
+		for i := 0; i < reader.FieldsPerRecord; i++ {
+			header[i] = "c" + strconv.Itoa(i+1)
+		}
+	}
+
+	if reader.DetectedLineBreak != "" {
+		fileInfo.LineBreak = reader.DetectedLineBreak
+	}
+
+	view := NewView()
+	view.Header = NewHeader(parser.FormatTableName(fileInfo.Path), header)
+	view.RecordSet = records
+	view.FileInfo = fileInfo
+	return view, nil
+}
+
 func loadViewFromLTSVFile(ctx context.Context, flags *cmd.Flags, fp io.ReadSeeker, fileInfo *FileInfo, withoutNull bool, expr parser.QueryExpression) (*View, error) {
-	enc, err := text.DetectInSpecifiedEncoding(fp, fileInfo.Encoding)
+	enc, err := detectEncoding(fp, fileInfo.Encoding)
 	if err != nil {
 		return nil, NewCannotDetectFileEncodingError(expr)
 	}
 	fileInfo.Encoding = enc
 
-	reader, err := ltsv.NewReader(fp, fileInfo.Encoding)
+	reader, err := ltsv.NewReader(readerForTextLibrary(fp, fileInfo.Encoding))
 	if err != nil {
 		return nil, NewIOError(expr, err.Error())
 	}
 	reader.WithoutNull = withoutNull
 
-	records, err := readRecordSet(ctx, reader, fileSize(fp))
+	records, err := readRecordSet(ctx, reader, fileSize(fp), fileInfo.recordLimit)
 	if err != nil {
 		return nil, err
 	}
@@ -967,7 +1945,9 @@ func fileSize(fp io.ReadSeeker) int64 {
 	return 0
 }
 
-func readRecordSet(ctx context.Context, reader RecordReader, fileSize int64) (RecordSet, error) {
+// readRecordSet reads the records from the reader. If limit is greater than 0,
+// then at most limit records are read.
+func readRecordSet(ctx context.Context, reader RecordReader, fileSize int64, limit int) (RecordSet, error) {
 	var err error
 	recordSet := make(RecordSet, 0, fileLoadingPreparedRecordSetCap)
 	rowch := make(chan []text.RawText, fileLoadingBuffer)
@@ -993,6 +1973,9 @@ func readRecordSet(ctx context.Context, reader RecordReader, fileSize int64) (Re
 
 			if 0 < fileSize && len(recordSet) == fileLoadingPreparedRecordSetCap && int64(pos) < fileSize {
 				l := int((float64(fileSize) / float64(pos)) * fileLoadingPreparedRecordSetCap * 1.2)
+				if 0 < limit && limit < l {
+					l = limit
+				}
 				newSet := make(RecordSet, fileLoadingPreparedRecordSetCap, l)
 				copy(newSet, recordSet)
 				recordSet = newSet
@@ -1007,6 +1990,9 @@ func readRecordSet(ctx context.Context, reader RecordReader, fileSize int64) (Re
 	go func() {
 		i := 0
 		for {
+			if 0 < limit && limit <= i {
+				break
+			}
 			if i&15 == 0 && ctx.Err() != nil {
 				err = ConvertContextError(ctx.Err())
 				break
@@ -1050,6 +2036,10 @@ func loadViewFromJsonFile(fp io.Reader, fileInfo *FileInfo, expr parser.QueryExp
 		return nil, NewLoadJsonError(expr, err.Error())
 	}
 
+	if 0 < fileInfo.recordLimit && fileInfo.recordLimit < len(rows) {
+		rows = rows[:fileInfo.recordLimit]
+	}
+
 	records := make(RecordSet, len(rows))
 	for i := range rows {
 		records[i] = NewRecord(rows[i])
@@ -1065,6 +2055,48 @@ func loadViewFromJsonFile(fp io.Reader, fileInfo *FileInfo, expr parser.QueryExp
 	return view, nil
 }
 
+func loadViewFromNdjsonFile(ctx context.Context, flags *cmd.Flags, fp io.Reader, fileInfo *FileInfo) (*View, error) {
+	reader := NewNdjsonReader(fp)
+
+	records := make(RecordSet, 0, fileLoadingPreparedRecordSetCap)
+	for i := 0; fileInfo.recordLimit < 1 || i < fileInfo.recordLimit; i++ {
+		if i&15 == 0 && ctx.Err() != nil {
+			return nil, ConvertContextError(ctx.Err())
+		}
+
+		row, err := reader.Read()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		records = append(records, NewRecord(row))
+	}
+
+	header := reader.Header
+	if err := NewGoroutineTaskManager(len(records), -1, flags.CPU).Run(ctx, func(index int) error {
+		for j := len(records[index]); j < len(header); j++ {
+			records[index] = append(records[index], NewCell(value.NewNull()))
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	fileInfo.Encoding = text.UTF8
+	fileInfo.JsonEscape = reader.EscapeType
+	if reader.DetectedLineBreak != "" {
+		fileInfo.LineBreak = reader.DetectedLineBreak
+	}
+
+	view := NewView()
+	view.Header = NewHeader(parser.FormatTableName(fileInfo.Path), header)
+	view.RecordSet = records
+	view.FileInfo = fileInfo
+	return view, nil
+}
+
 func loadDualView() *View {
 	return &View{
 		Header:    NewEmptyHeader(1),
@@ -1116,17 +2148,26 @@ func (view *View) filter(ctx context.Context, scope *ReferenceScope, condition p
 		return err
 	}
 
+	var origins []recordOrigin
+	if view.recordOrigins != nil {
+		origins = make([]recordOrigin, 0, view.RecordLen())
+	}
+
 	newIdx := 0
 	for i, ok := range results {
 		if ok {
 			if i != newIdx {
 				view.RecordSet[newIdx] = view.RecordSet[i]
 			}
+			if origins != nil && i < len(view.recordOrigins) {
+				origins = append(origins, view.recordOrigins[i])
+			}
 			newIdx++
 		}
 	}
 
 	view.RecordSet = view.RecordSet[:newIdx]
+	view.recordOrigins = origins
 	return nil
 }
 
@@ -1249,6 +2290,7 @@ func (view *View) group(ctx context.Context, scope *ReferenceScope, items []pars
 	}
 
 	view.RecordSet = records
+	view.recordOrigins = nil
 	view.isGrouped = true
 	for _, item := range items {
 		switch item.(type) {
@@ -1284,6 +2326,7 @@ func (view *View) groupAll(ctx context.Context, flags *cmd.Flags) error {
 		view.RecordSet[0] = record
 	}
 
+	view.recordOrigins = nil
 	view.isGrouped = true
 	return nil
 }
@@ -1430,6 +2473,7 @@ func (view *View) Select(ctx context.Context, scope *ReferenceScope, clause pars
 
 		view.Header = hfields
 		view.RecordSet = records
+		view.recordOrigins = nil
 		view.comparisonKeysInEachRecord = nil
 		view.sortValuesInEachCell = nil
 	}
@@ -1489,9 +2533,21 @@ func (view *View) OrderBy(ctx context.Context, scope *ReferenceScope, clause par
 	view.sortValuesInEachRecord = make([]SortValues, view.RecordLen())
 	view.sortDirections = make([]int, len(clause.Items))
 	view.sortNullPositions = make([]int, len(clause.Items))
+	view.sortCollators = nil
 
 	for i, v := range clause.Items {
 		oi := v.(parser.OrderItem)
+		if !oi.Collation.IsEmpty() {
+			tag, err := language.Parse(oi.Collation.Literal)
+			if err != nil {
+				return NewInvalidCollationError(oi.Value, oi.Collation.Literal)
+			}
+			if view.sortCollators == nil {
+				view.sortCollators = make([]*collate.Collator, len(clause.Items))
+			}
+			view.sortCollators[i] = collate.New(tag)
+		}
+
 		if oi.Direction.IsEmpty() {
 			view.sortDirections[i] = parser.ASC
 		} else {
@@ -1499,11 +2555,18 @@ func (view *View) OrderBy(ctx context.Context, scope *ReferenceScope, clause par
 		}
 
 		if oi.NullsPosition.IsEmpty() {
-			switch view.sortDirections[i] {
-			case parser.ASC:
+			switch scope.Tx.Flags.NullsOrder {
+			case cmd.NullsFirst:
 				view.sortNullPositions[i] = parser.FIRST
-			default: //parser.DESC
+			case cmd.NullsLast:
 				view.sortNullPositions[i] = parser.LAST
+			default:
+				switch view.sortDirections[i] {
+				case parser.ASC:
+					view.sortNullPositions[i] = parser.FIRST
+				default: //parser.DESC
+					view.sortNullPositions[i] = parser.LAST
+				}
 			}
 		} else {
 			view.sortNullPositions[i] = oi.NullsPosition.Token
@@ -1532,7 +2595,12 @@ func (view *View) OrderBy(ctx context.Context, scope *ReferenceScope, clause par
 		return err
 	}
 
-	sort.Sort(view)
+	if scope.Tx.Flags.StableSort {
+		sort.Stable(view)
+	} else {
+		sort.Sort(view)
+	}
+	view.recordOrigins = nil
 	return nil
 }
 
@@ -1685,9 +2753,11 @@ func (view *View) evalColumn(ctx context.Context, scope *ReferenceScope, obj par
 func (view *View) evalAnalyticFunction(ctx context.Context, scope *ReferenceScope, expr parser.AnalyticFunction) error {
 	name := strings.ToUpper(expr.Name)
 	if _, ok := AggregateFunctions[name]; !ok {
-		if _, ok := AnalyticFunctions[name]; !ok {
-			if udfn, err := scope.GetFunction(expr, expr.Name); err != nil || !udfn.IsAggregate {
-				return NewFunctionNotExistError(expr, expr.Name)
+		if _, ok := ConditionalAggregateFunctions[name]; !ok {
+			if _, ok := AnalyticFunctions[name]; !ok {
+				if udfn, err := scope.GetFunction(expr, expr.Name); err != nil || !udfn.IsAggregate {
+					return NewFunctionNotExistError(expr, expr.Name)
+				}
 			}
 		}
 	}
@@ -1722,6 +2792,7 @@ func (view *View) evalAnalyticFunction(ctx context.Context, scope *ReferenceScop
 	view.sortValuesInEachRecord = nil
 	view.sortDirections = nil
 	view.sortNullPositions = nil
+	view.sortCollators = nil
 
 	return err
 }
@@ -1742,6 +2813,7 @@ func (view *View) Offset(ctx context.Context, scope *ReferenceScope, clause pars
 		view.offset = 0
 	}
 
+	view.recordOrigins = nil
 	if view.RecordLen() <= view.offset {
 		view.RecordSet = RecordSet{}
 	} else {
@@ -1812,7 +2884,7 @@ func (view *View) InsertValues(ctx context.Context, scope *ReferenceScope, field
 	if err != nil {
 		return 0, err
 	}
-	return view.insert(ctx, fields, recordValues)
+	return view.insert(ctx, scope, fields, recordValues)
 }
 
 func (view *View) InsertFromQuery(ctx context.Context, scope *ReferenceScope, fields []parser.QueryExpression, query parser.SelectQuery) (int, error) {
@@ -1820,7 +2892,13 @@ func (view *View) InsertFromQuery(ctx context.Context, scope *ReferenceScope, fi
 	if err != nil {
 		return 0, err
 	}
-	return view.insert(ctx, fields, recordValues)
+	return view.insert(ctx, scope, fields, recordValues)
+}
+
+// InsertDefaultValues inserts a record in which every field takes its
+// default value, or null if the field has no default value.
+func (view *View) InsertDefaultValues(ctx context.Context, scope *ReferenceScope) (int, error) {
+	return view.insert(ctx, scope, []parser.QueryExpression{}, [][]value.Primary{{}})
 }
 
 func (view *View) ReplaceValues(ctx context.Context, scope *ReferenceScope, fields []parser.QueryExpression, list []parser.QueryExpression, keys []parser.QueryExpression) (int, error) {
@@ -1828,7 +2906,7 @@ func (view *View) ReplaceValues(ctx context.Context, scope *ReferenceScope, fiel
 	if err != nil {
 		return 0, err
 	}
-	return view.replace(ctx, scope.Tx.Flags, fields, recordValues, keys)
+	return view.replace(ctx, scope, fields, recordValues, keys)
 }
 
 func (view *View) ReplaceFromQuery(ctx context.Context, scope *ReferenceScope, fields []parser.QueryExpression, query parser.SelectQuery, keys []parser.QueryExpression) (int, error) {
@@ -1836,7 +2914,7 @@ func (view *View) ReplaceFromQuery(ctx context.Context, scope *ReferenceScope, f
 	if err != nil {
 		return 0, err
 	}
-	return view.replace(ctx, scope.Tx.Flags, fields, recordValues, keys)
+	return view.replace(ctx, scope, fields, recordValues, keys)
 }
 
 func (view *View) convertListToRecordValues(ctx context.Context, scope *ReferenceScope, fields []parser.QueryExpression, list []parser.QueryExpression) ([][]value.Primary, error) {
@@ -1884,7 +2962,7 @@ func (view *View) convertResultSetToRecordValues(ctx context.Context, scope *Ref
 	return recordValues, nil
 }
 
-func (view *View) convertRecordValuesToRecordSet(ctx context.Context, fields []parser.QueryExpression, recordValues [][]value.Primary) (RecordSet, error) {
+func (view *View) convertRecordValuesToRecordSet(ctx context.Context, scope *ReferenceScope, fields []parser.QueryExpression, recordValues [][]value.Primary) (RecordSet, error) {
 	var valueIndex = func(i int, list []int) int {
 		for j, v := range list {
 			if i == v {
@@ -1918,13 +2996,119 @@ func (view *View) convertRecordValuesToRecordSet(ctx context.Context, fields []p
 				record[j] = NewCell(values[recordIndices[j]])
 			}
 		}
+		if err := view.setDefaultValues(ctx, scope, record, recordIndices); err != nil {
+			return nil, err
+		}
 		records[i] = record
 	}
 	return records, nil
 }
 
-func (view *View) insert(ctx context.Context, fields []parser.QueryExpression, recordValues [][]value.Primary) (int, error) {
-	records, err := view.convertRecordValuesToRecordSet(ctx, fields, recordValues)
+// CheckConstraints returns an error if any field violates the not null or
+// unique constraint, or if any record does not satisfy the check constraints
+// of the table. Null values are not compared in unique constraints, and
+// check constraints are violated only when they are evaluated as FALSE.
+// No constraint is checked if @@IGNORE_CONSTRAINTS is true.
+func (view *View) CheckConstraints(ctx context.Context, scope *ReferenceScope, expr parser.QueryExpression) error {
+	flags := scope.Tx.Flags
+	if flags.IgnoreConstraints {
+		return nil
+	}
+
+	buf := GetComparisonKeysBuf()
+	defer PutComparisonkeysBuf(buf)
+
+	for i := range view.Header {
+		if !view.Header[i].NotNull && !view.Header[i].Unique {
+			continue
+		}
+
+		var keys map[string]int
+		if view.Header[i].Unique {
+			keys = make(map[string]int, view.RecordLen())
+		}
+
+		for j := range view.RecordSet {
+			if j&15 == 0 && ctx.Err() != nil {
+				return ConvertContextError(ctx.Err())
+			}
+
+			p := view.RecordSet[j][i][0]
+			if value.IsNull(p) {
+				if view.Header[i].NotNull {
+					return NewNotNullConstraintViolationError(expr, view.Header[i].Column, j+1)
+				}
+				continue
+			}
+
+			if keys != nil {
+				buf.Reset()
+				SerializeKey(buf, p, flags)
+				key := buf.String()
+				if n, ok := keys[key]; ok {
+					return NewUniqueConstraintViolationError(expr, view.Header[i].Column, p, j+1, n)
+				}
+				keys[key] = j + 1
+			}
+		}
+	}
+
+	var checks []parser.QueryExpression
+	for i := range view.Header {
+		checks = append(checks, view.Header[i].Checks...)
+	}
+	if len(checks) < 1 {
+		return nil
+	}
+
+	seqScope := scope.CreateScopeForSequentialEvaluation(view)
+	for j := range view.RecordSet {
+		if j&15 == 0 && ctx.Err() != nil {
+			return ConvertContextError(ctx.Err())
+		}
+
+		seqScope.Records[0].recordIndex = j
+		for _, check := range checks {
+			p, err := Evaluate(ctx, seqScope, check)
+			if err != nil {
+				return err
+			}
+			if p.Ternary() == ternary.FALSE {
+				return NewCheckConstraintViolationError(expr, check, j+1)
+			}
+		}
+	}
+	return nil
+}
+
+// setDefaultValues evaluates the default values of the fields omitted in
+// the record. Default values can refer to the other fields in the record.
+func (view *View) setDefaultValues(ctx context.Context, scope *ReferenceScope, record Record, recordIndices []int) error {
+	var recordScope *ReferenceScope
+	for i := range view.Header {
+		if -1 < recordIndices[i] || view.Header[i].Default == nil {
+			continue
+		}
+
+		if recordScope == nil {
+			recordView := &View{
+				Header:    view.Header,
+				RecordSet: RecordSet{record},
+			}
+			recordScope = scope.CreateScopeForRecordEvaluation(recordView, 0)
+		}
+
+		val, err := Evaluate(ctx, recordScope, view.Header[i].Default)
+		if err != nil {
+			return err
+		}
+		record[i] = NewCell(val)
+	}
+	return nil
+}
+
+func (view *View) insert(ctx context.Context, scope *ReferenceScope, fields []parser.QueryExpression, recordValues [][]value.Primary) (int, error) {
+	records, err := view.convertRecordValuesToRecordSet(ctx, scope, fields, recordValues)
 	if err != nil {
 		return 0, err
 	}
@@ -1933,7 +3117,9 @@ func (view *View) insert(ctx context.Context, fields []parser.QueryExpression, r
 	return len(recordValues), nil
 }
 
-func (view *View) replace(ctx context.Context, flags *cmd.Flags, fields []parser.QueryExpression, recordValues [][]value.Primary, keys []parser.QueryExpression) (int, error) {
+func (view *View) replace(ctx context.Context, scope *ReferenceScope, fields []parser.QueryExpression, recordValues [][]value.Primary, keys []parser.QueryExpression) (int, error) {
+	flags := scope.Tx.Flags
+
 	fieldIndices, err := view.FieldIndices(fields)
 	if err != nil {
 		return 0, err
@@ -1964,7 +3150,7 @@ func (view *View) replace(ctx context.Context, flags *cmd.Flags, fields []parser
 		}
 	}
 
-	records, err := view.convertRecordValuesToRecordSet(ctx, fields, recordValues)
+	records, err := view.convertRecordValuesToRecordSet(ctx, scope, fields, recordValues)
 	if err != nil {
 		return 0, err
 	}
@@ -2084,6 +3270,7 @@ func (view *View) Fix(ctx context.Context, flags *cmd.Flags) error {
 	}
 
 	view.Header = hfields
+	view.recordOrigins = nil
 	view.selectFields = nil
 	view.selectLabels = nil
 	view.isGrouped = false
@@ -2092,6 +3279,7 @@ func (view *View) Fix(ctx context.Context, flags *cmd.Flags) error {
 	view.sortValuesInEachRecord = nil
 	view.sortDirections = nil
 	view.sortNullPositions = nil
+	view.sortCollators = nil
 	view.offset = 0
 	view.tempRecord = nil
 	return nil
@@ -2293,7 +3481,7 @@ func (view *View) Swap(i, j int) {
 }
 
 func (view *View) Less(i, j int) bool {
-	return view.sortValuesInEachRecord[i].Less(view.sortValuesInEachRecord[j], view.sortDirections, view.sortNullPositions)
+	return view.sortValuesInEachRecord[i].Less(view.sortValuesInEachRecord[j], view.sortDirections, view.sortNullPositions, view.sortCollators)
 }
 
 func (view *View) Copy() *View {
@@ -2301,8 +3489,16 @@ func (view *View) Copy() *View {
 	records := view.RecordSet.Copy()
 
 	return &View{
-		Header:    header,
-		RecordSet: records,
-		FileInfo:  view.FileInfo,
+		Header:        header,
+		RecordSet:     records,
+		FileInfo:      view.FileInfo,
+		recordOrigins: view.recordOrigins,
+	}
+}
+
+func (view *View) origin(recordIndex int) (recordOrigin, bool) {
+	if recordIndex < 0 || len(view.recordOrigins) <= recordIndex {
+		return recordOrigin{}, false
 	}
+	return view.recordOrigins[recordIndex], true
 }
@@ -29,27 +29,36 @@ func (m ViewMap) IsEmpty() bool {
 	return m.SyncMap == nil
 }
 
+// viewKey returns the key of the view in the map.
+// Urls are used as they are because paths and query strings are case-sensitive.
+func viewKey(fpath string) string {
+	if hasHttpScheme(fpath) {
+		return fpath
+	}
+	return strings.ToUpper(fpath)
+}
+
 func (m ViewMap) Store(fpath string, view *View) {
-	m.store(strings.ToUpper(fpath), view)
+	m.store(viewKey(fpath), view)
 }
 
 func (m ViewMap) LoadDirect(name string) (interface{}, bool) {
-	return m.load(strings.ToUpper(name))
+	return m.load(viewKey(name))
 }
 
 func (m ViewMap) Load(fpath string) (*View, bool) {
-	if v, ok := m.load(strings.ToUpper(fpath)); ok {
+	if v, ok := m.load(viewKey(fpath)); ok {
 		return v.(*View), true
 	}
 	return nil, false
 }
 
 func (m ViewMap) Delete(fpath string) {
-	m.delete(strings.ToUpper(fpath))
+	m.delete(viewKey(fpath))
 }
 
 func (m ViewMap) Exists(fpath string) bool {
-	return m.exists(strings.ToUpper(fpath))
+	return m.exists(viewKey(fpath))
 }
 
 func (m ViewMap) Get(fpath parser.Identifier) (*View, error) {
@@ -110,6 +119,12 @@ func (m ViewMap) Dispose(container *file.Container, name string) error {
 				return err
 			}
 		}
+		if view.FileInfo.DefinitionHandler != nil {
+			if err := container.Close(view.FileInfo.DefinitionHandler); err != nil {
+				return err
+			}
+			view.FileInfo.DefinitionHandler = nil
+		}
 		m.Delete(name)
 	}
 	return nil
@@ -133,6 +148,10 @@ func (m ViewMap) CleanWithErrors(container *file.Container) error {
 			if err := container.CloseWithErrors(view.FileInfo.Handler); err != nil {
 				errs = append(errs, err.(*file.ForcedUnlockError).Errors...)
 			}
+			if err := container.CloseWithErrors(view.FileInfo.DefinitionHandler); err != nil {
+				errs = append(errs, err.(*file.ForcedUnlockError).Errors...)
+			}
+			view.FileInfo.DefinitionHandler = nil
 			m.Delete(k)
 		}
 	}
@@ -28,9 +28,20 @@ var viewLoadTests = []struct {
 	Stdin              string
 	ImportFormat       cmd.Format
 	Delimiter          rune
+	MultiCharDelimiter string
+	DelimiterRegex     string
 	DelimiterPositions []int
 	SingleLine         bool
 	JsonQuery          string
+	ColumnTypes        cmd.ColumnTypes
+	StrictColumnTypes  bool
+	StrictGlobHeader   bool
+	NoInfer            bool
+	SkipLines          int
+	CommentPrefix      string
+	SkipAllComments    bool
+	Trim               bool
+	Ragged             cmd.RaggedMode
 	Scope              *ReferenceScope
 	Result             *View
 	ResultScope        *ReferenceScope
@@ -102,6 +113,152 @@ var viewLoadTests = []struct {
 			}},
 		}, time.Time{}, nil),
 	},
+	{
+		Name: "LoadView File With Column Types",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table1.csv"},
+				},
+			},
+		},
+		ColumnTypes: cmd.ColumnTypes{
+			{Column: "COLUMN1", Type: "FLOAT"},
+			{Column: "column2", Type: "INTEGER"},
+		},
+		Result: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				{NewCell(value.NewFloat(1)), NewCellWithText(value.NewNull(), "str1")},
+				{NewCell(value.NewFloat(2)), NewCellWithText(value.NewNull(), "str2")},
+				{NewCell(value.NewFloat(3)), NewCellWithText(value.NewNull(), "str3")},
+			},
+			FileInfo: &FileInfo{
+				Path:      "table1.csv",
+				Delimiter: ',',
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"TABLE1": strings.ToUpper(GetTestFilePath("table1.csv")),
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView File With Column Types with Datetime Format",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table_orders.csv"},
+				},
+			},
+		},
+		ColumnTypes: cmd.ColumnTypes{
+			{Column: "zip", Type: "STRING"},
+			{Column: "order_date", Type: "DATETIME", Format: "%m/%d/%Y"},
+			{Column: "qty", Type: "INTEGER"},
+		},
+		Result: &View{
+			Header: NewHeader("table_orders", []string{"zip", "order_date", "qty"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("01234"),
+					value.NewDatetime(time.Date(2020, 3, 15, 0, 0, 0, 0, GetTestLocation())),
+					value.NewInteger(1),
+				}),
+				{
+					NewCell(value.NewString("90210")),
+					NewCell(value.NewDatetime(time.Date(2020, 12, 1, 0, 0, 0, 0, GetTestLocation()))),
+					NewCellWithText(value.NewNull(), "x"),
+				},
+			},
+			FileInfo: &FileInfo{
+				Path:      "table_orders.csv",
+				Delimiter: ',',
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"TABLE_ORDERS": strings.ToUpper(GetTestFilePath("table_orders.csv")),
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView File With Strict Column Types and Datetime Format Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table_orders.csv"},
+				},
+			},
+		},
+		ColumnTypes: cmd.ColumnTypes{
+			{Column: "order_date", Type: "DATETIME", Format: "%d/%m/%Y"},
+		},
+		StrictColumnTypes: true,
+		Error:             fmt.Sprintf("data parse error in file %s: value '03/15/2020' in line 2, column order_date cannot be converted to DATETIME(%%d/%%m/%%Y)", GetTestFilePath("table_orders.csv")),
+	},
+	{
+		Name: "LoadView File With Column Types Not In Table",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table1.csv"},
+				},
+			},
+		},
+		ColumnTypes: cmd.ColumnTypes{
+			{Column: "column1", Type: "INTEGER"},
+			{Column: "notexist", Type: "BOOLEAN"},
+		},
+		Result: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewString("str2"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(3),
+					value.NewString("str3"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table1.csv",
+				Delimiter: ',',
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"TABLE1": strings.ToUpper(GetTestFilePath("table1.csv")),
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView File With Strict Column Types Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table1.csv"},
+				},
+			},
+		},
+		ColumnTypes: cmd.ColumnTypes{
+			{Column: "column2", Type: "INTEGER"},
+		},
+		StrictColumnTypes: true,
+		Error:             fmt.Sprintf("data parse error in file %s: value 'str1' in line 2, column column2 cannot be converted to INTEGER", GetTestFilePath("table1.csv")),
+	},
 	{
 		Name: "LoadView File ForUpdate",
 		From: parser.FromClause{
@@ -123,117 +280,654 @@ var viewLoadTests = []struct {
 					value.NewString("2"),
 					value.NewString("str2"),
 				}),
-				NewRecord([]value.Primary{
-					value.NewString("3"),
-					value.NewString("str3"),
-				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table1.csv",
+				Delimiter: ',',
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				ForUpdate: true,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{{
+			scopeNameAliases: {
+				"TABLE1": strings.ToUpper(GetTestFilePath("table1.csv")),
+			},
+		}}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView File with UTF-8 BOM",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table1_bom.csv"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("table1_bom", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table1_bom.csv",
+				Delimiter: ',',
+				Encoding:  text.UTF8M,
+				LineBreak: text.LF,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"TABLE1_BOM": strings.ToUpper(GetTestFilePath("table1_bom.csv")),
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView with Parentheses",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Parentheses{
+					Expr: parser.Table{
+						Object: parser.Identifier{Literal: "table1.csv"},
+					},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table1.csv",
+				Delimiter: ',',
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"TABLE1": strings.ToUpper(GetTestFilePath("table1.csv")),
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView From Stdin",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
+			},
+		},
+		Stdin: "column1,column2\n1,\"str1\"",
+		Result: &View{
+			Header: NewHeader("t", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "STDIN",
+				Delimiter: ',',
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				ViewType:  ViewTypeStdin,
+			},
+		},
+		ResultScope: GenerateReferenceScope([]map[string]map[string]interface{}{
+			{
+				scopeNameTempTables: {
+					"STDIN": &View{
+						FileInfo: &FileInfo{Path: "STDIN"},
+					},
+				},
+			},
+		}, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"T": "STDIN",
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView From Stdin ForUpdate",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
+			},
+		},
+		Stdin:     "column1,column2\n1,\"str1\"",
+		ForUpdate: true,
+		Result: &View{
+			Header: NewHeader("t", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "STDIN",
+				Delimiter: ',',
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				ViewType:  ViewTypeStdin,
+			},
+		},
+		ResultScope: GenerateReferenceScope([]map[string]map[string]interface{}{
+			{
+				scopeNameTempTables: {
+					"STDIN": &View{
+						FileInfo: &FileInfo{Path: "STDIN"},
+					},
+				},
+			},
+		}, []map[string]map[string]interface{}{
+			{
+				scopeNameAliases: {
+					"T": "STDIN",
+				},
+			},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView From Stdin With Internal Id",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
+			},
+		},
+		UseInternalId: true,
+		Stdin:         "column1,column2\n1,\"str1\"",
+		Result: &View{
+			Header: NewHeaderWithId("t", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecordWithId(0, []value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "STDIN",
+				Delimiter: ',',
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				ViewType:  ViewTypeStdin,
+			},
+		},
+		ResultScope: GenerateReferenceScope([]map[string]map[string]interface{}{
+			{
+				scopeNameTempTables: {
+					"STDIN": &View{
+						FileInfo: &FileInfo{Path: "STDIN"},
+					},
+				},
+			},
+		}, []map[string]map[string]interface{}{
+			{
+				scopeNameAliases: {
+					"T": "STDIN",
+				},
+			},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView Json From Stdin",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
+			},
+		},
+		Stdin:        "{\"key\":[{\"column1\": 1, \"column2\": \"str1\"}]}",
+		ImportFormat: cmd.JSON,
+		JsonQuery:    "key{}",
+		Result: &View{
+			Header: NewHeader("t", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("str1"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "STDIN",
+				Delimiter: ',',
+				JsonQuery: "key{}",
+				Format:    cmd.JSON,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				ViewType:  ViewTypeStdin,
+			},
+		},
+		ResultScope: GenerateReferenceScope([]map[string]map[string]interface{}{
+			{
+				scopeNameTempTables: {
+					"STDIN": &View{
+						FileInfo: &FileInfo{Path: "STDIN"},
+					},
+				},
+			},
+		}, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"T": "STDIN",
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView NDJSON From Stdin",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
+			},
+		},
+		Stdin:        "{\"column1\": 1, \"column2\": {\"key\": \"str1\"}}\n{\"column1\": 2, \"column3\": true}\n",
+		ImportFormat: cmd.NDJSON,
+		Result: &View{
+			Header: NewHeader("t", []string{"column1", "column2.key", "column3"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("str1"),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewNull(),
+					value.NewBoolean(true),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "STDIN",
+				Delimiter: ',',
+				Format:    cmd.NDJSON,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				ViewType:  ViewTypeStdin,
+			},
+		},
+		ResultScope: GenerateReferenceScope([]map[string]map[string]interface{}{
+			{
+				scopeNameTempTables: {
+					"STDIN": &View{
+						FileInfo: &FileInfo{Path: "STDIN"},
+					},
+				},
+			},
+		}, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"T": "STDIN",
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView NDJSON From Stdin Invalid Line Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
+			},
+		},
+		Stdin:        "{\"column1\": 1}\n[1, 2]\n",
+		ImportFormat: cmd.NDJSON,
+		Error:        "data parse error in file STDIN: line 2: json value must be an object",
+	},
+	{
+		Name: "LoadView From Stdin With Multi-Character Delimiter",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
+			},
+		},
+		Stdin:              "column1||column2\n1||\n2||\"str2\"\n",
+		MultiCharDelimiter: "||",
+		Result: &View{
+			Header: NewHeader("t", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("\"str2\""),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:               "STDIN",
+				Delimiter:          ',',
+				MultiCharDelimiter: "||",
+				Encoding:           text.UTF8,
+				LineBreak:          text.LF,
+				ViewType:           ViewTypeStdin,
+			},
+		},
+		ResultScope: GenerateReferenceScope([]map[string]map[string]interface{}{
+			{
+				scopeNameTempTables: {
+					"STDIN": &View{
+						FileInfo: &FileInfo{Path: "STDIN"},
+					},
+				},
+			},
+		}, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"T": "STDIN",
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView From Stdin With Delimiter Regex",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
+			},
+		},
+		Stdin:          "column1 ; column2\r\n1;str1\r\n",
+		DelimiterRegex: "\\s*;\\s*",
+		Result: &View{
+			Header: NewHeader("t", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:           "STDIN",
+				Delimiter:      ',',
+				DelimiterRegex: "\\s*;\\s*",
+				Encoding:       text.UTF8,
+				LineBreak:      text.CRLF,
+				ViewType:       ViewTypeStdin,
+			},
+		},
+		ResultScope: GenerateReferenceScope([]map[string]map[string]interface{}{
+			{
+				scopeNameTempTables: {
+					"STDIN": &View{
+						FileInfo: &FileInfo{Path: "STDIN"},
+					},
+				},
+			},
+		}, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"T": "STDIN",
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView From Stdin With Multi-Character Delimiter Field Length Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
+			},
+		},
+		Stdin:              "column1||column2\n1||2||3\n",
+		MultiCharDelimiter: "||",
+		Error:              "data parse error in file STDIN: line 2: wrong number of fields in line",
+	},
+	{
+		Name: "LoadView From Stdin With SkipLines",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
+			},
+		},
+		Stdin:     "title\ngenerated at 2006-01-02\ncolumn1,column2\n1,str1\n",
+		SkipLines: 2,
+		Result: &View{
+			Header: NewHeader("t", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "STDIN",
+				Delimiter: ',',
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				ViewType:  ViewTypeStdin,
+			},
+		},
+		ResultScope: GenerateReferenceScope([]map[string]map[string]interface{}{
+			{
+				scopeNameTempTables: {
+					"STDIN": &View{
+						FileInfo: &FileInfo{Path: "STDIN"},
+					},
+				},
+			},
+		}, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"T": "STDIN",
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView From Stdin With CommentPrefix",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
+			},
+		},
+		Stdin:         "# comment1\n# comment2\ncolumn1,column2\n1,str1\n#2,str2\n",
+		CommentPrefix: "#",
+		Result: &View{
+			Header: NewHeader("t", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("#2"),
+					value.NewString("str2"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "STDIN",
+				Delimiter: ',',
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				ViewType:  ViewTypeStdin,
+			},
+		},
+		ResultScope: GenerateReferenceScope([]map[string]map[string]interface{}{
+			{
+				scopeNameTempTables: {
+					"STDIN": &View{
+						FileInfo: &FileInfo{Path: "STDIN"},
+					},
+				},
+			},
+		}, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"T": "STDIN",
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView From Stdin With SkipAllComments",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
+			},
+		},
+		Stdin:           "title\n# comment\ncolumn1,column2\n1,str1\n# comment\n2,str2\n",
+		SkipLines:       1,
+		CommentPrefix:   "#",
+		SkipAllComments: true,
+		Result: &View{
+			Header: NewHeader("t", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+				}),
 			},
 			FileInfo: &FileInfo{
-				Path:      "table1.csv",
+				Path:      "STDIN",
 				Delimiter: ',',
 				Encoding:  text.UTF8,
 				LineBreak: text.LF,
-				ForUpdate: true,
+				ViewType:  ViewTypeStdin,
 			},
 		},
-		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{{
-			scopeNameAliases: {
-				"TABLE1": strings.ToUpper(GetTestFilePath("table1.csv")),
+		ResultScope: GenerateReferenceScope([]map[string]map[string]interface{}{
+			{
+				scopeNameTempTables: {
+					"STDIN": &View{
+						FileInfo: &FileInfo{Path: "STDIN"},
+					},
+				},
 			},
-		}}, time.Time{}, nil),
+		}, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"T": "STDIN",
+			}},
+		}, time.Time{}, nil),
 	},
 	{
-		Name: "LoadView File with UTF-8 BOM",
+		Name: "LoadView From Stdin With SkipAllComments In Multi-Line Field",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
-				parser.Table{
-					Object: parser.Identifier{Literal: "table1_bom.csv"},
-				},
+				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
 			},
 		},
+		Stdin:           "column1,column2\n1,\"str1\n# not a comment\"\n# comment\n2,\"\"\"str2\"\"\n#3\"\n",
+		CommentPrefix:   "#",
+		SkipAllComments: true,
 		Result: &View{
-			Header: NewHeader("table1_bom", []string{"column1", "column2"}),
+			Header: NewHeader("t", []string{"column1", "column2"}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{
 					value.NewString("1"),
-					value.NewString("str1"),
+					value.NewString("str1\n# not a comment"),
 				}),
 				NewRecord([]value.Primary{
 					value.NewString("2"),
-					value.NewString("str2"),
-				}),
-				NewRecord([]value.Primary{
-					value.NewString("3"),
-					value.NewString("str3"),
+					value.NewString("\"str2\"\n#3"),
 				}),
 			},
 			FileInfo: &FileInfo{
-				Path:      "table1_bom.csv",
+				Path:      "STDIN",
 				Delimiter: ',',
-				Encoding:  text.UTF8M,
+				Encoding:  text.UTF8,
 				LineBreak: text.LF,
+				ViewType:  ViewTypeStdin,
 			},
 		},
-		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+		ResultScope: GenerateReferenceScope([]map[string]map[string]interface{}{
+			{
+				scopeNameTempTables: {
+					"STDIN": &View{
+						FileInfo: &FileInfo{Path: "STDIN"},
+					},
+				},
+			},
+		}, []map[string]map[string]interface{}{
 			{scopeNameAliases: {
-				"TABLE1_BOM": strings.ToUpper(GetTestFilePath("table1_bom.csv")),
+				"T": "STDIN",
 			}},
 		}, time.Time{}, nil),
 	},
 	{
-		Name: "LoadView with Parentheses",
+		Name: "LoadView From Stdin With SkipAllComments Data Parsing Error",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
-				parser.Parentheses{
-					Expr: parser.Table{
-						Object: parser.Identifier{Literal: "table1.csv"},
-					},
-				},
+				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
+			},
+		},
+		Stdin:           "# comment\ncolumn1,column2\n# comment\n1,str1,3\n",
+		CommentPrefix:   "#",
+		SkipAllComments: true,
+		Error:           "data parse error in file STDIN: line 4: wrong number of fields, 2 expected but 3 found",
+	},
+	{
+		Name: "LoadView From Stdin With Trim",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
 			},
 		},
+		Stdin: "column1 , column2\n 42 ,\"  str1\"\n",
+		Trim:  true,
 		Result: &View{
-			Header: NewHeader("table1", []string{"column1", "column2"}),
+			Header: NewHeader("t", []string{"column1", "column2"}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{
-					value.NewString("1"),
+					value.NewString("42"),
 					value.NewString("str1"),
 				}),
-				NewRecord([]value.Primary{
-					value.NewString("2"),
-					value.NewString("str2"),
-				}),
-				NewRecord([]value.Primary{
-					value.NewString("3"),
-					value.NewString("str3"),
-				}),
 			},
 			FileInfo: &FileInfo{
-				Path:      "table1.csv",
+				Path:      "STDIN",
 				Delimiter: ',',
 				Encoding:  text.UTF8,
 				LineBreak: text.LF,
+				ViewType:  ViewTypeStdin,
 			},
 		},
-		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+		ResultScope: GenerateReferenceScope([]map[string]map[string]interface{}{
+			{
+				scopeNameTempTables: {
+					"STDIN": &View{
+						FileInfo: &FileInfo{Path: "STDIN"},
+					},
+				},
+			},
+		}, []map[string]map[string]interface{}{
 			{scopeNameAliases: {
-				"TABLE1": strings.ToUpper(GetTestFilePath("table1.csv")),
+				"T": "STDIN",
 			}},
 		}, time.Time{}, nil),
 	},
 	{
-		Name: "LoadView From Stdin",
+		Name: "LoadView From Stdin With Ragged Skip",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
 			},
 		},
-		Stdin: "column1,column2\n1,\"str1\"",
+		Stdin:  "column1,column2\n1\n2,str2,extra\n3,str3\n",
+		Ragged: cmd.RaggedSkip,
 		Result: &View{
 			Header: NewHeader("t", []string{"column1", "column2"}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{
-					value.NewString("1"),
-					value.NewString("str1"),
+					value.NewString("3"),
+					value.NewString("str3"),
 				}),
 			},
 			FileInfo: &FileInfo{
@@ -259,20 +953,24 @@ var viewLoadTests = []struct {
 		}, time.Time{}, nil),
 	},
 	{
-		Name: "LoadView From Stdin ForUpdate",
+		Name: "LoadView From Stdin With Ragged Pad",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
 			},
 		},
-		Stdin:     "column1,column2\n1,\"str1\"",
-		ForUpdate: true,
+		Stdin:  "column1,column2\n1\n3,str3\n",
+		Ragged: cmd.RaggedPad,
 		Result: &View{
 			Header: NewHeader("t", []string{"column1", "column2"}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{
 					value.NewString("1"),
-					value.NewString("str1"),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
 				}),
 			},
 			FileInfo: &FileInfo{
@@ -292,28 +990,26 @@ var viewLoadTests = []struct {
 				},
 			},
 		}, []map[string]map[string]interface{}{
-			{
-				scopeNameAliases: {
-					"T": "STDIN",
-				},
-			},
+			{scopeNameAliases: {
+				"T": "STDIN",
+			}},
 		}, time.Time{}, nil),
 	},
 	{
-		Name: "LoadView From Stdin With Internal Id",
+		Name: "LoadView From Stdin With NoInfer",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
 			},
 		},
-		UseInternalId: true,
-		Stdin:         "column1,column2\n1,\"str1\"",
+		Stdin:   "column1,column2\n01,",
+		NoInfer: true,
 		Result: &View{
-			Header: NewHeaderWithId("t", []string{"column1", "column2"}),
+			Header: NewHeader("t", []string{"column1", "column2"}),
 			RecordSet: []Record{
-				NewRecordWithId(0, []value.Primary{
-					value.NewString("1"),
-					value.NewString("str1"),
+				NewRecord([]value.Primary{
+					value.NewString("01"),
+					value.NewString(""),
 				}),
 			},
 			FileInfo: &FileInfo{
@@ -333,29 +1029,29 @@ var viewLoadTests = []struct {
 				},
 			},
 		}, []map[string]map[string]interface{}{
-			{
-				scopeNameAliases: {
-					"T": "STDIN",
-				},
-			},
+			{scopeNameAliases: {
+				"T": "STDIN",
+			}},
 		}, time.Time{}, nil),
 	},
 	{
-		Name: "LoadView Json From Stdin",
+		Name: "LoadView Json From Stdin With NoInfer",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
 			},
 		},
-		Stdin:        "{\"key\":[{\"column1\": 1, \"column2\": \"str1\"}]}",
+		Stdin:        "{\"key\":[{\"column1\": 1, \"column2\": true, \"column3\": null}]}",
 		ImportFormat: cmd.JSON,
 		JsonQuery:    "key{}",
+		NoInfer:      true,
 		Result: &View{
-			Header: NewHeader("t", []string{"column1", "column2"}),
+			Header: NewHeader("t", []string{"column1", "column2", "column3"}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{
-					value.NewInteger(1),
-					value.NewString("str1"),
+					value.NewString("1"),
+					value.NewString("true"),
+					value.NewNull(),
 				}),
 			},
 			FileInfo: &FileInfo{
@@ -638,7 +1334,7 @@ var viewLoadTests = []struct {
 			},
 		},
 		Stdin: "column1,column2\n1\"str1\"",
-		Error: "data parse error in file STDIN: line 1, column 8: wrong number of fields in line",
+		Error: "data parse error in file STDIN: line 2: wrong number of fields, 2 expected but 1 found",
 	},
 	{
 		Name: "LoadView From Stdin Duplicate Table Name Error",
@@ -821,14 +1517,44 @@ var viewLoadTests = []struct {
 				parser.Table{
 					Object: parser.TableObject{
 						Type:          parser.Token{Token: parser.CSV, Literal: "csv"},
-						FormatElement: parser.NewStringValue("invalid"),
+						FormatElement: parser.NewStringValue(""),
+						Path:          parser.Identifier{Literal: "table1"},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "invalid delimiter: ''",
+	},
+	{
+		Name: "LoadView TableObject From CSV File With Multi-Character Delimiter For Update Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Token{Token: parser.CSV, Literal: "csv"},
+						FormatElement: parser.NewStringValue("||"),
 						Path:          parser.Identifier{Literal: "table1"},
 					},
 					Alias: parser.Identifier{Literal: "t"},
 				},
 			},
 		},
-		Error: "invalid delimiter: 'invalid'",
+		ForUpdate: true,
+		Error:     fmt.Sprintf("file %s cannot be updated: fields are split by a multi-character or regular expression delimiter", GetTestFilePath("table1.csv")),
+	},
+	{
+		Name: "LoadView From File With SkipLines For Update Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table1.csv"},
+				},
+			},
+		},
+		SkipLines: 1,
+		ForUpdate: true,
+		Error:     fmt.Sprintf("file %s cannot be updated: lines are skipped on loading", GetTestFilePath("table1.csv")),
 	},
 	{
 		Name: "LoadView TableObject From CSV File Arguments Length Error",
@@ -843,6 +1569,8 @@ var viewLoadTests = []struct {
 							parser.NewStringValue("SJIS"),
 							parser.NewTernaryValueFromString("true"),
 							parser.NewTernaryValueFromString("true"),
+							parser.NewStringValue("'"),
+							parser.NewStringValue("backslash"),
 							parser.NewStringValue("extra"),
 						},
 					},
@@ -850,7 +1578,104 @@ var viewLoadTests = []struct {
 				},
 			},
 		},
-		Error: "table object csv takes at most 5 arguments",
+		Error: "table object csv takes at most 7 arguments",
+	},
+	{
+		Name: "LoadView TableObject From CSV File With Quote Style",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Token{Token: parser.CSV, Literal: "csv"},
+						FormatElement: parser.NewStringValue(","),
+						Path:          parser.Identifier{Literal: "table_backslash"},
+						Args: []parser.QueryExpression{
+							parser.NewNullValue(),
+							parser.NewNullValue(),
+							parser.NewNullValue(),
+							parser.NewStringValue("'"),
+							parser.NewStringValue("backslash"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("t", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str'1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("a,b"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:         "table_backslash.csv",
+				Delimiter:    ',',
+				Format:       cmd.CSV,
+				Encoding:     text.UTF8,
+				LineBreak:    text.LF,
+				QuoteChar:    '\'',
+				EscapeStyle:  cmd.BackslashEscape,
+				customQuotes: true,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"T": strings.ToUpper(GetTestFilePath("table_backslash.csv")),
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView TableObject From CSV File With Quote Style For Update Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Token{Token: parser.CSV, Literal: "csv"},
+						FormatElement: parser.NewStringValue(","),
+						Path:          parser.Identifier{Literal: "table_backslash"},
+						Args: []parser.QueryExpression{
+							parser.NewNullValue(),
+							parser.NewNullValue(),
+							parser.NewNullValue(),
+							parser.NewNullValue(),
+							parser.NewStringValue("backslash"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		ForUpdate: true,
+		Error:     fmt.Sprintf("file %s cannot be updated: fields are quoted or escaped in a custom style", GetTestFilePath("table_backslash.csv")),
+	},
+	{
+		Name: "LoadView TableObject From CSV File Invalid Escape Style",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Token{Token: parser.CSV, Literal: "csv"},
+						FormatElement: parser.NewStringValue(","),
+						Path:          parser.Identifier{Literal: "table_backslash"},
+						Args: []parser.QueryExpression{
+							parser.NewNullValue(),
+							parser.NewNullValue(),
+							parser.NewNullValue(),
+							parser.NewNullValue(),
+							parser.NewStringValue("slash"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "invalid argument for csv: escape style must be one of DOUBLE|BACKSLASH|NONE",
 	},
 	{
 		Name: "LoadView TableObject From CSV File 3rd Argument Error",
@@ -929,16 +1754,61 @@ var viewLoadTests = []struct {
 				},
 			},
 		},
-		Error: "invalid argument for csv: encoding must be one of AUTO|UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS",
+		Error: "invalid argument for csv: encoding must be one of AUTO|UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS|EUCJP",
+	},
+	{
+		Name: "LoadView TableObject From Fixed-Length File",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Token{Token: parser.FIXED, Literal: "fixed"},
+						FormatElement: parser.NewStringValue("spaces"),
+						Path:          parser.Identifier{Literal: "fixed_length.txt", Quoted: true},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("t", []string{"column1", "__@2__"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:               "fixed_length.txt",
+				Delimiter:          ',',
+				DelimiterPositions: []int{7, 12},
+				Format:             cmd.FIXED,
+				Encoding:           text.UTF8,
+				LineBreak:          text.LF,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"T": strings.ToUpper(GetTestFilePath("fixed_length.txt")),
+			}},
+		}, time.Time{}, nil),
 	},
 	{
-		Name: "LoadView TableObject From Fixed-Length File",
+		Name: "LoadView TableObject From Fixed-Length File with Field Positions",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
 					Object: parser.TableObject{
 						Type:          parser.Token{Token: parser.FIXED, Literal: "fixed"},
-						FormatElement: parser.NewStringValue("spaces"),
+						FormatElement: parser.NewStringValue("id 1 7, name 8 5"),
 						Path:          parser.Identifier{Literal: "fixed_length.txt", Quoted: true},
 					},
 					Alias: parser.Identifier{Literal: "t"},
@@ -946,8 +1816,12 @@ var viewLoadTests = []struct {
 			},
 		},
 		Result: &View{
-			Header: NewHeader("t", []string{"column1", "__@2__"}),
+			Header: NewHeader("t", []string{"id", "name"}),
 			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("column1"),
+					value.NewNull(),
+				}),
 				NewRecord([]value.Primary{
 					value.NewString("1"),
 					value.NewString("str1"),
@@ -965,7 +1839,9 @@ var viewLoadTests = []struct {
 				Path:               "fixed_length.txt",
 				Delimiter:          ',',
 				DelimiterPositions: []int{7, 12},
+				FieldNames:         []string{"id", "name"},
 				Format:             cmd.FIXED,
+				NoHeader:           true,
 				Encoding:           text.UTF8,
 				LineBreak:          text.LF,
 			},
@@ -1678,6 +2554,64 @@ var viewLoadTests = []struct {
 			}},
 		}, time.Time{}, nil),
 	},
+	{
+		Name:     "LoadView EUC-JP File",
+		Encoding: cmd.EUCJP,
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table_eucjp"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("table_eucjp", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("日本語"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str"),
+				}),
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"TABLE_EUCJP": strings.ToUpper(GetTestFilePath("table_eucjp.csv")),
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name:     "LoadView UTF16LE File with BOM",
+		Encoding: text.UTF16LE,
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table_utf16le_bom"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("table_utf16le_bom", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("日本語"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str"),
+				}),
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"TABLE_UTF16LE_BOM": strings.ToUpper(GetTestFilePath("table_utf16le_bom.csv")),
+			}},
+		}, time.Time{}, nil),
+	},
 	{
 		Name:     "LoadView No Header File",
 		NoHeader: true,
@@ -2293,6 +3227,83 @@ var viewLoadTests = []struct {
 		},
 		Error: "file notexist does not exist",
 	},
+	{
+		Name: "LoadView File Glob",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.FileGlob{Pattern: "glob_a*.csv"},
+					Alias:  parser.Identifier{Literal: "g"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("g", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "glob_a*.csv",
+				Format:    cmd.CSV,
+				Delimiter: ',',
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				ViewType:  ViewTypeTemporaryTable,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{
+				scopeNameAliases: {
+					"G": "",
+				},
+			},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView File Glob Strict Header Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.FileGlob{Pattern: "glob_a*.csv"},
+				},
+			},
+		},
+		StrictGlobHeader: true,
+		Error:            "columns of file " + filepath.Join(TestDir, "glob_a2.csv") + " do not match columns of file " + filepath.Join(TestDir, "glob_a1.csv") + " for the pattern 'glob_a*.csv'",
+	},
+	{
+		Name: "LoadView File Glob Header Mismatch Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.FileGlob{Pattern: "glob_*.csv"},
+				},
+			},
+		},
+		Error: "columns of file " + filepath.Join(TestDir, "glob_b.csv") + " do not match columns of file " + filepath.Join(TestDir, "glob_a1.csv") + " for the pattern 'glob_*.csv'",
+	},
+	{
+		Name: "LoadView File Glob No Match Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.FileGlob{Pattern: "notexist_*.csv"},
+				},
+			},
+		},
+		Error: "no file matches the pattern 'notexist_*.csv'",
+	},
 	{
 		Name: "LoadView Json Table",
 		From: parser.FromClause{
@@ -2635,7 +3646,7 @@ var viewLoadTests = []struct {
 				},
 			},
 		},
-		Error: fmt.Sprintf("data parse error in file %s: line 3, column 7: wrong number of fields in line", GetTestFilePath("table_broken.csv")),
+		Error: fmt.Sprintf("data parse error in file %s: line 3: wrong number of fields, 2 expected but 3 found", GetTestFilePath("table_broken.csv")),
 	},
 	{
 		Name: "Inner Join Join Error",
@@ -2722,6 +3733,7 @@ func TestView_Load(t *testing.T) {
 	}()
 
 	TestTx.Flags.Repository = TestDir
+	_ = TestTx.Flags.SetLocation(TestLocation)
 	ctx := context.Background()
 
 	for _, v := range viewLoadTests {
@@ -2734,10 +3746,21 @@ func TestView_Load(t *testing.T) {
 		if v.Delimiter != 0 {
 			TestTx.Flags.ImportOptions.Delimiter = v.Delimiter
 		}
+		TestTx.Flags.ImportOptions.MultiCharDelimiter = v.MultiCharDelimiter
+		TestTx.Flags.ImportOptions.DelimiterRegex = v.DelimiterRegex
 		TestTx.Flags.ImportOptions.DelimiterPositions = v.DelimiterPositions
 		TestTx.Flags.ImportOptions.SingleLine = v.SingleLine
 		TestTx.Flags.ImportOptions.JsonQuery = v.JsonQuery
 		TestTx.Flags.ImportOptions.NoHeader = v.NoHeader
+		TestTx.Flags.ImportOptions.ColumnTypes = v.ColumnTypes
+		TestTx.Flags.ImportOptions.StrictColumnTypes = v.StrictColumnTypes
+		TestTx.Flags.ImportOptions.StrictGlobHeader = v.StrictGlobHeader
+		TestTx.Flags.ImportOptions.NoInfer = v.NoInfer
+		TestTx.Flags.ImportOptions.SkipLines = v.SkipLines
+		TestTx.Flags.ImportOptions.CommentPrefix = v.CommentPrefix
+		TestTx.Flags.ImportOptions.SkipAllComments = v.SkipAllComments
+		TestTx.Flags.ImportOptions.Trim = v.Trim
+		TestTx.Flags.ImportOptions.Ragged = v.Ragged
 		if v.Encoding != text.AUTO {
 			TestTx.Flags.ImportOptions.Encoding = v.Encoding
 		} else {
@@ -2810,6 +3833,7 @@ func TestView_Load(t *testing.T) {
 			view.FileInfo = nil
 		}
 		v.Result.FileInfo = nil
+		view.recordOrigins = nil
 
 		if v.ResultScope == nil {
 			v.ResultScope = NewReferenceScope(TestTx).CreateNode()
@@ -2823,9 +3847,232 @@ func TestView_Load(t *testing.T) {
 				t.Errorf("%s: temp view list = %v, want %v", v.Name, queryScope.blocks[i].temporaryTables.Keys(), v.ResultScope.blocks[i].temporaryTables.Keys())
 			}
 		}
-
-		if !reflect.DeepEqual(view, v.Result) {
-			t.Errorf("%s: \n result = %v,\n expect = %v", v.Name, view, v.Result)
+
+		if !reflect.DeepEqual(view, v.Result) {
+			t.Errorf("%s: \n result = %v,\n expect = %v", v.Name, view, v.Result)
+		}
+	}
+}
+
+func TestView_LoadUrl(t *testing.T) {
+	server := newTestHttpServer()
+	defer func() {
+		server.Close()
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		initFlag(TestTx.Flags)
+	}()
+
+	ctx := context.Background()
+	u := server.URL + "/table.csv"
+	from := parser.FromClause{
+		Tables: []parser.QueryExpression{
+			parser.Table{
+				Object: parser.Url{Raw: u},
+				Alias:  parser.Identifier{Literal: "t"},
+			},
+		},
+	}
+
+	expect := &View{
+		Header: NewHeader("t", []string{"column1", "column2"}),
+		RecordSet: []Record{
+			NewRecord([]value.Primary{
+				value.NewString("1"),
+				value.NewString("str1"),
+			}),
+			NewRecord([]value.Primary{
+				value.NewString("2"),
+				value.NewString("str2"),
+			}),
+		},
+	}
+
+	view, err := LoadView(ctx, NewReferenceScope(TestTx).CreateNode(), from.Tables, false, false)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if view.FileInfo.Format != cmd.CSV {
+		t.Errorf("FileInfo.Format = %s, want %s", view.FileInfo.Format, cmd.CSV)
+	}
+	if !view.FileInfo.IsUrl() {
+		t.Errorf("FileInfo.ViewType = %d, want %d", view.FileInfo.ViewType, ViewTypeUrl)
+	}
+	view.FileInfo = nil
+	view.recordOrigins = nil
+	if !reflect.DeepEqual(view, expect) {
+		t.Errorf("result = %v, want %v", view, expect)
+	}
+
+	upperFrom := parser.FromClause{
+		Tables: []parser.QueryExpression{
+			parser.Table{
+				Object: parser.Url{Raw: server.URL + "/TABLE.csv"},
+				Alias:  parser.Identifier{Literal: "u"},
+			},
+		},
+	}
+	view, err = LoadView(ctx, NewReferenceScope(TestTx).CreateNode(), upperFrom.Tables, false, false)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if view.RecordLen() != 1 || view.RecordSet[0][0][0].(*value.String).Raw() != "upper" {
+		t.Errorf("result = %v, want the view of the url differing only in case", view)
+	}
+
+	server.Close()
+	if _, err = LoadView(ctx, NewReferenceScope(TestTx).CreateNode(), from.Tables, false, false); err != nil {
+		t.Errorf("unexpected error %q, want the cached view to be loaded", err)
+	}
+}
+
+var loadViewSampleTests = []struct {
+	Name       string
+	Table      string
+	Sample     int
+	LoadBefore bool
+	Header     []string
+	RecordLen  int
+	Cached     bool
+	Error      string
+}{
+	{
+		Name:      "Load Sample Records",
+		Table:     "table_broken",
+		Sample:    1,
+		Header:    []string{"column1", "column2"},
+		RecordLen: 1,
+	},
+	{
+		Name:      "Load Sample Records from LTSV",
+		Table:     "table6.ltsv",
+		Sample:    1,
+		Header:    []string{"f1", "f2", "f3"},
+		RecordLen: 1,
+	},
+	{
+		Name:   "Load All Records",
+		Table:  "table_broken",
+		Sample: 0,
+		Error:  fmt.Sprintf("data parse error in file %s: line 3: wrong number of fields, 2 expected but 3 found", GetTestFilePath("table_broken.csv")),
+	},
+	{
+		Name:       "Load Cached View",
+		Table:      "table1",
+		Sample:     1,
+		LoadBefore: true,
+		Header:     []string{"column1", "column2"},
+		RecordLen:  3,
+		Cached:     true,
+	},
+}
+
+func TestLoadViewSample(t *testing.T) {
+	defer func() {
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.Repository = TestDir
+	ctx := context.Background()
+
+	for _, v := range loadViewSampleTests {
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		table := parser.Identifier{Literal: v.Table}
+
+		if v.LoadBefore {
+			if _, err := LoadViewFromTableIdentifier(ctx, NewReferenceScope(TestTx).CreateNode(), table, false, false); err != nil {
+				t.Fatalf("%s: unexpected error %q", v.Name, err)
+			}
+		}
+
+		view, err := LoadViewSample(ctx, NewReferenceScope(TestTx).CreateNode(), table, v.Sample)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		if !reflect.DeepEqual(view.Header.TableColumnNames(), v.Header) {
+			t.Errorf("%s: header = %v, want %v", v.Name, view.Header.TableColumnNames(), v.Header)
+		}
+		if view.RecordLen() != v.RecordLen {
+			t.Errorf("%s: record length = %d, want %d", v.Name, view.RecordLen(), v.RecordLen)
+		}
+		if cached := TestTx.cachedViews.Exists(view.FileInfo.Path); cached != v.Cached {
+			t.Errorf("%s: cached = %t, want %t", v.Name, cached, v.Cached)
+		}
+	}
+}
+
+var viewLoadRecordOriginsTests = []struct {
+	Name     string
+	Table    string
+	NoHeader bool
+	Format   cmd.Format
+	Lines    []int
+}{
+	{
+		Name:  "Multi-line Fields",
+		Table: "table_multiline.csv",
+		Lines: []int{2, 4, 5, 8},
+	},
+	{
+		Name:     "No Header",
+		Table:    "table_noheader.csv",
+		NoHeader: true,
+		Lines:    []int{1, 2},
+	},
+	{
+		Name:   "JSON",
+		Table:  "table.json",
+		Format: cmd.JSON,
+		Lines:  []int{0, 0},
+	},
+}
+
+func TestView_LoadRecordOrigins(t *testing.T) {
+	defer func() {
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		initFlag(TestTx.Flags)
+	}()
+
+	ctx := context.Background()
+	for _, v := range viewLoadRecordOriginsTests {
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		initFlag(TestTx.Flags)
+		TestTx.Flags.Repository = TestDir
+		TestTx.Flags.ImportOptions.NoHeader = v.NoHeader
+		if v.Format != cmd.AutoSelect {
+			TestTx.Flags.ImportOptions.Format = v.Format
+		}
+
+		view, err := LoadViewFromTableIdentifier(ctx, NewReferenceScope(TestTx).CreateNode(), parser.Identifier{Literal: v.Table}, false, false)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+
+		lines := make([]int, view.RecordLen())
+		for i := range lines {
+			origin, ok := view.origin(i)
+			if !ok {
+				t.Errorf("%s: origin of record %d is not set", v.Name, i)
+				continue
+			}
+			if filepath.Base(origin.Path) != v.Table {
+				t.Errorf("%s: path = %q, want %q", v.Name, filepath.Base(origin.Path), v.Table)
+			}
+			lines[i] = origin.Line
+		}
+		if !reflect.DeepEqual(lines, v.Lines) {
+			t.Errorf("%s: lines = %v, want %v", v.Name, lines, v.Lines)
 		}
 	}
 }
@@ -4344,11 +5591,12 @@ func TestView_Select(t *testing.T) {
 }
 
 var viewOrderByTests = []struct {
-	Name    string
-	View    *View
-	OrderBy parser.OrderByClause
-	Result  *View
-	Error   string
+	Name       string
+	NullsOrder cmd.NullsOrder
+	View       *View
+	OrderBy    parser.OrderByClause
+	Result     *View
+	Error      string
 }{
 	{
 		Name: "Order By",
@@ -4457,119 +5705,335 @@ var viewOrderByTests = []struct {
 					value.NewString("2"),
 				}),
 				NewRecordWithId(2, []value.Primary{
-					value.NewString("1"),
-					value.NewString("4"),
-					value.NewString("3"),
-				}),
-				NewRecordWithId(3, []value.Primary{
-					value.NewString("1"),
-					value.NewString("4"),
-					value.NewString("3"),
-				}),
-				NewRecordWithId(4, []value.Primary{
-					value.NewString("1"),
-					value.NewString("3"),
+					value.NewString("1"),
+					value.NewString("4"),
+					value.NewString("3"),
+				}),
+				NewRecordWithId(3, []value.Primary{
+					value.NewString("1"),
+					value.NewString("4"),
+					value.NewString("3"),
+				}),
+				NewRecordWithId(4, []value.Primary{
+					value.NewString("1"),
+					value.NewString("3"),
+					value.NewNull(),
+				}),
+				NewRecordWithId(5, []value.Primary{
+					value.NewNull(),
+					value.NewString("2"),
+					value.NewString("4"),
+				}),
+			},
+			sortValuesInEachCell: [][]*SortValue{
+				{nil, nil, NewSortValue(value.NewString("3"), TestTx.Flags), nil},
+				{nil, nil, NewSortValue(value.NewString("4"), TestTx.Flags), nil},
+				{nil, nil, NewSortValue(value.NewString("4"), TestTx.Flags), nil},
+				{nil, nil, NewSortValue(value.NewString("3"), TestTx.Flags), nil},
+				{nil, nil, NewSortValue(value.NewString("2"), TestTx.Flags), nil},
+			},
+		},
+		OrderBy: parser.OrderByClause{
+			Items: []parser.QueryExpression{
+				parser.OrderItem{
+					Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeaderWithId("table1", []string{"column1", "column2", "column3"}),
+			RecordSet: []Record{
+				NewRecordWithId(5, []value.Primary{
+					value.NewNull(),
+					value.NewString("2"),
+					value.NewString("4"),
+				}),
+				NewRecordWithId(1, []value.Primary{
+					value.NewString("1"),
+					value.NewString("3"),
+					value.NewString("2"),
+				}),
+				NewRecordWithId(4, []value.Primary{
+					value.NewString("1"),
+					value.NewString("3"),
+					value.NewNull(),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewString("1"),
+					value.NewString("4"),
+					value.NewString("3"),
+				}),
+				NewRecordWithId(3, []value.Primary{
+					value.NewString("1"),
+					value.NewString("4"),
+					value.NewString("3"),
+				}),
+			},
+			sortValuesInEachCell: [][]*SortValue{
+				{nil, nil, NewSortValue(value.NewString("2"), TestTx.Flags), nil},
+				{nil, nil, NewSortValue(value.NewString("3"), TestTx.Flags), nil},
+				{nil, nil, NewSortValue(value.NewString("3"), TestTx.Flags), nil},
+				{nil, nil, NewSortValue(value.NewString("4"), TestTx.Flags), nil},
+				{nil, nil, NewSortValue(value.NewString("4"), TestTx.Flags), nil},
+			},
+		},
+	},
+	{
+		Name: "Order By With Null Positions",
+		View: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: InternalIdColumn},
+				{View: "table1", Column: "column1", IsFromTable: true},
+				{View: "table1", Column: "column2", IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewString("1"),
+					value.NewNull(),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewString("1"),
+					value.NewString("2"),
+				}),
+				NewRecordWithId(3, []value.Primary{
+					value.NewNull(),
+					value.NewString("2"),
+				}),
+				NewRecordWithId(4, []value.Primary{
+					value.NewString("1"),
+					value.NewNull(),
+				}),
+				NewRecordWithId(5, []value.Primary{
+					value.NewString("1"),
+					value.NewString("2"),
+				}),
+			},
+		},
+		OrderBy: parser.OrderByClause{
+			Items: []parser.QueryExpression{
+				parser.OrderItem{
+					Value:         parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					NullsPosition: parser.Token{Token: parser.LAST, Literal: "last"},
+				},
+				parser.OrderItem{
+					Value:         parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					NullsPosition: parser.Token{Token: parser.FIRST, Literal: "first"},
+				},
+			},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: InternalIdColumn},
+				{View: "table1", Column: "column1", IsFromTable: true},
+				{View: "table1", Column: "column2", IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewString("1"),
+					value.NewNull(),
+				}),
+				NewRecordWithId(4, []value.Primary{
+					value.NewString("1"),
+					value.NewNull(),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewString("1"),
+					value.NewString("2"),
+				}),
+				NewRecordWithId(5, []value.Primary{
+					value.NewString("1"),
+					value.NewString("2"),
+				}),
+				NewRecordWithId(3, []value.Primary{
+					value.NewNull(),
+					value.NewString("2"),
+				}),
+			},
+		},
+	},
+	{
+		Name: "Order By Record Extend Error",
+		View: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: InternalIdColumn},
+				{View: "table1", Column: "column1", IsFromTable: true},
+				{View: "table1", Column: "column2", IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewString("1"),
+					value.NewNull(),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewString("1"),
+					value.NewString("2"),
+				}),
+			},
+		},
+		OrderBy: parser.OrderByClause{
+			Items: []parser.QueryExpression{
+				parser.OrderItem{
+					Value: parser.AggregateFunction{
+						Name:     "sum",
+						Distinct: parser.Token{},
+						Args: []parser.QueryExpression{
+							parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+						},
+					},
+				},
+			},
+		},
+		Error: "function sum cannot aggregate not grouping records",
+	},
+	{
+		Name: "Order By With Collation",
+		View: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: InternalIdColumn},
+				{View: "table1", Column: "column1", IsFromTable: true},
+				{View: "table1", Column: "column2", IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewString("Zebra"),
+					value.NewString("Zebra"),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewString("\u00c4pfel"),
+					value.NewString("\u00c4pfel"),
+				}),
+				NewRecordWithId(3, []value.Primary{
+					value.NewString("Apple"),
+					value.NewString("Apple"),
+				}),
+			},
+		},
+		OrderBy: parser.OrderByClause{
+			Items: []parser.QueryExpression{
+				parser.OrderItem{
+					Value:     parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					Collation: parser.Token{Token: parser.STRING, Literal: "sv"},
+				},
+				parser.OrderItem{
+					Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				},
+			},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: InternalIdColumn},
+				{View: "table1", Column: "column1", IsFromTable: true},
+				{View: "table1", Column: "column2", IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecordWithId(3, []value.Primary{
+					value.NewString("Apple"),
+					value.NewString("Apple"),
+				}),
+				NewRecordWithId(1, []value.Primary{
+					value.NewString("Zebra"),
+					value.NewString("Zebra"),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewString("\u00c4pfel"),
+					value.NewString("\u00c4pfel"),
+				}),
+			},
+		},
+	},
+	{
+		Name: "Order By Invalid Collation Error",
+		View: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: InternalIdColumn},
+				{View: "table1", Column: "column1", IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewString("a"),
+				}),
+			},
+		},
+		OrderBy: parser.OrderByClause{
+			Items: []parser.QueryExpression{
+				parser.OrderItem{
+					Value:     parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					Collation: parser.Token{Token: parser.STRING, Literal: "x--y"},
+				},
+			},
+		},
+		Error: "\"x--y\" is an invalid collation",
+	},
+	{
+		Name:       "Order By with Nulls Order Last",
+		NullsOrder: cmd.NullsLast,
+		View: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: InternalIdColumn},
+				{View: "table1", Column: "column1", IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewInteger(2),
+				}),
+				NewRecordWithId(2, []value.Primary{
 					value.NewNull(),
 				}),
-				NewRecordWithId(5, []value.Primary{
-					value.NewNull(),
-					value.NewString("2"),
-					value.NewString("4"),
+				NewRecordWithId(3, []value.Primary{
+					value.NewInteger(1),
 				}),
 			},
-			sortValuesInEachCell: [][]*SortValue{
-				{nil, nil, NewSortValue(value.NewString("3"), TestTx.Flags), nil},
-				{nil, nil, NewSortValue(value.NewString("4"), TestTx.Flags), nil},
-				{nil, nil, NewSortValue(value.NewString("4"), TestTx.Flags), nil},
-				{nil, nil, NewSortValue(value.NewString("3"), TestTx.Flags), nil},
-				{nil, nil, NewSortValue(value.NewString("2"), TestTx.Flags), nil},
-			},
 		},
 		OrderBy: parser.OrderByClause{
 			Items: []parser.QueryExpression{
 				parser.OrderItem{
-					Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					Value: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
 				},
 			},
 		},
 		Result: &View{
-			Header: NewHeaderWithId("table1", []string{"column1", "column2", "column3"}),
+			Header: []HeaderField{
+				{View: "table1", Column: InternalIdColumn},
+				{View: "table1", Column: "column1", IsFromTable: true},
+			},
 			RecordSet: []Record{
-				NewRecordWithId(5, []value.Primary{
-					value.NewNull(),
-					value.NewString("2"),
-					value.NewString("4"),
+				NewRecordWithId(3, []value.Primary{
+					value.NewInteger(1),
 				}),
 				NewRecordWithId(1, []value.Primary{
-					value.NewString("1"),
-					value.NewString("3"),
-					value.NewString("2"),
-				}),
-				NewRecordWithId(4, []value.Primary{
-					value.NewString("1"),
-					value.NewString("3"),
-					value.NewNull(),
+					value.NewInteger(2),
 				}),
 				NewRecordWithId(2, []value.Primary{
-					value.NewString("1"),
-					value.NewString("4"),
-					value.NewString("3"),
-				}),
-				NewRecordWithId(3, []value.Primary{
-					value.NewString("1"),
-					value.NewString("4"),
-					value.NewString("3"),
+					value.NewNull(),
 				}),
 			},
-			sortValuesInEachCell: [][]*SortValue{
-				{nil, nil, NewSortValue(value.NewString("2"), TestTx.Flags), nil},
-				{nil, nil, NewSortValue(value.NewString("3"), TestTx.Flags), nil},
-				{nil, nil, NewSortValue(value.NewString("3"), TestTx.Flags), nil},
-				{nil, nil, NewSortValue(value.NewString("4"), TestTx.Flags), nil},
-				{nil, nil, NewSortValue(value.NewString("4"), TestTx.Flags), nil},
-			},
 		},
 	},
 	{
-		Name: "Order By With Null Positions",
+		Name:       "Order By with Nulls Order First",
+		NullsOrder: cmd.NullsFirst,
 		View: &View{
 			Header: []HeaderField{
 				{View: "table1", Column: InternalIdColumn},
 				{View: "table1", Column: "column1", IsFromTable: true},
-				{View: "table1", Column: "column2", IsFromTable: true},
 			},
 			RecordSet: []Record{
 				NewRecordWithId(1, []value.Primary{
-					value.NewString("1"),
-					value.NewNull(),
+					value.NewInteger(2),
 				}),
 				NewRecordWithId(2, []value.Primary{
-					value.NewString("1"),
-					value.NewString("2"),
-				}),
-				NewRecordWithId(3, []value.Primary{
-					value.NewNull(),
-					value.NewString("2"),
-				}),
-				NewRecordWithId(4, []value.Primary{
-					value.NewString("1"),
 					value.NewNull(),
 				}),
-				NewRecordWithId(5, []value.Primary{
-					value.NewString("1"),
-					value.NewString("2"),
+				NewRecordWithId(3, []value.Primary{
+					value.NewInteger(1),
 				}),
 			},
 		},
 		OrderBy: parser.OrderByClause{
 			Items: []parser.QueryExpression{
 				parser.OrderItem{
-					Value:         parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
-					NullsPosition: parser.Token{Token: parser.LAST, Literal: "last"},
-				},
-				parser.OrderItem{
-					Value:         parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
-					NullsPosition: parser.Token{Token: parser.FIRST, Literal: "first"},
+					Value:     parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					Direction: parser.Token{Token: parser.DESC, Literal: "desc"},
 				},
 			},
 		},
@@ -4577,34 +6041,22 @@ var viewOrderByTests = []struct {
 			Header: []HeaderField{
 				{View: "table1", Column: InternalIdColumn},
 				{View: "table1", Column: "column1", IsFromTable: true},
-				{View: "table1", Column: "column2", IsFromTable: true},
 			},
 			RecordSet: []Record{
-				NewRecordWithId(1, []value.Primary{
-					value.NewString("1"),
-					value.NewNull(),
-				}),
-				NewRecordWithId(4, []value.Primary{
-					value.NewString("1"),
-					value.NewNull(),
-				}),
 				NewRecordWithId(2, []value.Primary{
-					value.NewString("1"),
-					value.NewString("2"),
+					value.NewNull(),
 				}),
-				NewRecordWithId(5, []value.Primary{
-					value.NewString("1"),
-					value.NewString("2"),
+				NewRecordWithId(1, []value.Primary{
+					value.NewInteger(2),
 				}),
 				NewRecordWithId(3, []value.Primary{
-					value.NewNull(),
-					value.NewString("2"),
+					value.NewInteger(1),
 				}),
 			},
 		},
 	},
 	{
-		Name: "Order By Record Extend Error",
+		Name: "Order By with Stable Sort",
 		View: &View{
 			Header: []HeaderField{
 				{View: "table1", Column: InternalIdColumn},
@@ -4612,37 +6064,67 @@ var viewOrderByTests = []struct {
 				{View: "table1", Column: "column2", IsFromTable: true},
 			},
 			RecordSet: []Record{
-				NewRecordWithId(1, []value.Primary{
-					value.NewString("1"),
-					value.NewNull(),
-				}),
-				NewRecordWithId(2, []value.Primary{
-					value.NewString("1"),
-					value.NewString("2"),
-				}),
+				NewRecordWithId(1, []value.Primary{value.NewString("b"), value.NewInteger(1)}),
+				NewRecordWithId(2, []value.Primary{value.NewString("a"), value.NewInteger(2)}),
+				NewRecordWithId(3, []value.Primary{value.NewString("b"), value.NewInteger(3)}),
+				NewRecordWithId(4, []value.Primary{value.NewString("a"), value.NewInteger(4)}),
+				NewRecordWithId(5, []value.Primary{value.NewString("b"), value.NewInteger(5)}),
+				NewRecordWithId(6, []value.Primary{value.NewString("a"), value.NewInteger(6)}),
+				NewRecordWithId(7, []value.Primary{value.NewString("b"), value.NewInteger(7)}),
+				NewRecordWithId(8, []value.Primary{value.NewString("a"), value.NewInteger(8)}),
+				NewRecordWithId(9, []value.Primary{value.NewString("b"), value.NewInteger(9)}),
+				NewRecordWithId(10, []value.Primary{value.NewString("a"), value.NewInteger(10)}),
+				NewRecordWithId(11, []value.Primary{value.NewString("b"), value.NewInteger(11)}),
+				NewRecordWithId(12, []value.Primary{value.NewString("a"), value.NewInteger(12)}),
+				NewRecordWithId(13, []value.Primary{value.NewString("b"), value.NewInteger(13)}),
+				NewRecordWithId(14, []value.Primary{value.NewString("a"), value.NewInteger(14)}),
+				NewRecordWithId(15, []value.Primary{value.NewString("b"), value.NewInteger(15)}),
+				NewRecordWithId(16, []value.Primary{value.NewString("a"), value.NewInteger(16)}),
 			},
 		},
 		OrderBy: parser.OrderByClause{
 			Items: []parser.QueryExpression{
 				parser.OrderItem{
-					Value: parser.AggregateFunction{
-						Name:     "sum",
-						Distinct: parser.Token{},
-						Args: []parser.QueryExpression{
-							parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
-						},
-					},
+					Value: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
 				},
 			},
 		},
-		Error: "function sum cannot aggregate not grouping records",
+		Result: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: InternalIdColumn},
+				{View: "table1", Column: "column1", IsFromTable: true},
+				{View: "table1", Column: "column2", IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecordWithId(2, []value.Primary{value.NewString("a"), value.NewInteger(2)}),
+				NewRecordWithId(4, []value.Primary{value.NewString("a"), value.NewInteger(4)}),
+				NewRecordWithId(6, []value.Primary{value.NewString("a"), value.NewInteger(6)}),
+				NewRecordWithId(8, []value.Primary{value.NewString("a"), value.NewInteger(8)}),
+				NewRecordWithId(10, []value.Primary{value.NewString("a"), value.NewInteger(10)}),
+				NewRecordWithId(12, []value.Primary{value.NewString("a"), value.NewInteger(12)}),
+				NewRecordWithId(14, []value.Primary{value.NewString("a"), value.NewInteger(14)}),
+				NewRecordWithId(16, []value.Primary{value.NewString("a"), value.NewInteger(16)}),
+				NewRecordWithId(1, []value.Primary{value.NewString("b"), value.NewInteger(1)}),
+				NewRecordWithId(3, []value.Primary{value.NewString("b"), value.NewInteger(3)}),
+				NewRecordWithId(5, []value.Primary{value.NewString("b"), value.NewInteger(5)}),
+				NewRecordWithId(7, []value.Primary{value.NewString("b"), value.NewInteger(7)}),
+				NewRecordWithId(9, []value.Primary{value.NewString("b"), value.NewInteger(9)}),
+				NewRecordWithId(11, []value.Primary{value.NewString("b"), value.NewInteger(11)}),
+				NewRecordWithId(13, []value.Primary{value.NewString("b"), value.NewInteger(13)}),
+				NewRecordWithId(15, []value.Primary{value.NewString("b"), value.NewInteger(15)}),
+			},
+		},
 	},
 }
 
 func TestView_OrderBy(t *testing.T) {
+	defer initFlag(TestTx.Flags)
+
 	scope := NewReferenceScope(TestTx)
 	ctx := context.Background()
 	for _, v := range viewOrderByTests {
+		TestTx.Flags.NullsOrder = v.NullsOrder
+
 		err := v.View.OrderBy(ctx, scope, v.OrderBy)
 		if err != nil {
 			if len(v.Error) < 1 {
@@ -5793,6 +7275,225 @@ func TestView_InsertValues(t *testing.T) {
 	}
 }
 
+func TestView_InsertValuesWithDefaults(t *testing.T) {
+	header := NewHeaderWithId("table1", []string{"column1", "column2", "column3"})
+	header[2].Default = parser.NewIntegerValueFromString("0")
+	header[3].Default = parser.Arithmetic{
+		LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+		RHS:      parser.NewIntegerValueFromString("10"),
+		Operator: parser.Token{Token: '*', Literal: "*"},
+	}
+
+	view := &View{
+		Header:    header,
+		RecordSet: []Record{},
+	}
+
+	fields := []parser.QueryExpression{
+		parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+	}
+	list := []parser.QueryExpression{
+		parser.RowValue{
+			Value: parser.ValueList{
+				Values: []parser.QueryExpression{
+					parser.NewIntegerValueFromString("1"),
+				},
+			},
+		},
+	}
+	expect := RecordSet{
+		NewRecord([]value.Primary{
+			value.NewNull(),
+			value.NewInteger(1),
+			value.NewInteger(0),
+			value.NewInteger(0),
+		}),
+	}
+
+	_, err := view.InsertValues(context.Background(), NewReferenceScope(TestTx), fields, list)
+	if err != nil {
+		t.Errorf("unexpected error %q", err)
+		return
+	}
+	if !reflect.DeepEqual(view.RecordSet, expect) {
+		t.Errorf("result = %v, want %v", view.RecordSet, expect)
+	}
+}
+
+func TestView_InsertDefaultValues(t *testing.T) {
+	header := NewHeaderWithId("table1", []string{"column1", "column2"})
+	header[2].Default = parser.NewStringValue("str")
+
+	view := &View{
+		Header:    header,
+		RecordSet: []Record{},
+	}
+
+	expect := RecordSet{
+		NewRecord([]value.Primary{
+			value.NewNull(),
+			value.NewNull(),
+			value.NewString("str"),
+		}),
+	}
+
+	cnt, err := view.InsertDefaultValues(context.Background(), NewReferenceScope(TestTx))
+	if err != nil {
+		t.Errorf("unexpected error %q", err)
+		return
+	}
+	if cnt != 1 {
+		t.Errorf("count = %d, want %d", cnt, 1)
+	}
+	if !reflect.DeepEqual(view.RecordSet, expect) {
+		t.Errorf("result = %v, want %v", view.RecordSet, expect)
+	}
+
+	view = &View{
+		Header:    NewHeaderWithId("table1", []string{"column1", "column2"}),
+		RecordSet: []Record{},
+	}
+	expect = RecordSet{
+		NewRecord([]value.Primary{
+			value.NewNull(),
+			value.NewNull(),
+			value.NewNull(),
+		}),
+	}
+
+	cnt, err = view.InsertDefaultValues(context.Background(), NewReferenceScope(TestTx))
+	if err != nil {
+		t.Errorf("unexpected error %q", err)
+		return
+	}
+	if cnt != 1 {
+		t.Errorf("count = %d, want %d", cnt, 1)
+	}
+	if !reflect.DeepEqual(view.RecordSet, expect) {
+		t.Errorf("result = %v, want %v", view.RecordSet, expect)
+	}
+}
+
+var viewCheckConstraintsTests = []struct {
+	Name              string
+	NotNull           bool
+	Unique            bool
+	Checks            []parser.QueryExpression
+	IgnoreConstraints bool
+	Values            []value.Primary
+	Error             string
+}{
+	{
+		Name:    "CheckConstraints",
+		NotNull: true,
+		Unique:  true,
+		Values:  []value.Primary{value.NewInteger(1), value.NewInteger(2)},
+	},
+	{
+		Name:   "CheckConstraints Unique Ignores Nulls",
+		Unique: true,
+		Values: []value.Primary{value.NewNull(), value.NewInteger(1), value.NewNull()},
+	},
+	{
+		Name:    "CheckConstraints Not Null Error",
+		NotNull: true,
+		Values:  []value.Primary{value.NewInteger(1), value.NewNull()},
+		Error:   "field column1 in record 2 violates not null constraint",
+	},
+	{
+		Name:   "CheckConstraints Unique Error",
+		Unique: true,
+		Values: []value.Primary{value.NewInteger(1), value.NewInteger(2), value.NewString("1")},
+		Error:  "value '1' of field column1 in record 3 violates unique constraint, it is a duplicate of record 1",
+	},
+	{
+		Name:              "CheckConstraints Ignore Not Null and Unique",
+		NotNull:           true,
+		Unique:            true,
+		IgnoreConstraints: true,
+		Values:            []value.Primary{value.NewInteger(1), value.NewNull(), value.NewInteger(1)},
+	},
+	{
+		Name: "CheckConstraints Check Passes Unknown",
+		Checks: []parser.QueryExpression{
+			parser.Comparison{
+				LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.NewIntegerValueFromString("0"),
+				Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: ">="},
+			},
+		},
+		Values: []value.Primary{value.NewInteger(1), value.NewNull()},
+	},
+	{
+		Name: "CheckConstraints Check Error",
+		Checks: []parser.QueryExpression{
+			parser.Comparison{
+				LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.NewIntegerValueFromString("0"),
+				Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: ">="},
+			},
+		},
+		Values: []value.Primary{value.NewInteger(1), value.NewInteger(-1)},
+		Error:  "record 2 violates check constraint column1 >= 0",
+	},
+	{
+		Name: "CheckConstraints Ignore Check",
+		Checks: []parser.QueryExpression{
+			parser.Comparison{
+				LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.NewIntegerValueFromString("0"),
+				Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: ">="},
+			},
+		},
+		IgnoreConstraints: true,
+		Values:            []value.Primary{value.NewInteger(1), value.NewInteger(-1)},
+	},
+	{
+		Name: "CheckConstraints Check Evaluation Error",
+		Checks: []parser.QueryExpression{
+			parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+		},
+		Values: []value.Primary{value.NewInteger(1)},
+		Error:  "field notexist does not exist",
+	},
+}
+
+func TestView_CheckConstraints(t *testing.T) {
+	defer initFlag(TestTx.Flags)
+
+	for _, v := range viewCheckConstraintsTests {
+		TestTx.Flags.IgnoreConstraints = v.IgnoreConstraints
+
+		header := NewHeader("table1", []string{"column1"})
+		header[0].NotNull = v.NotNull
+		header[0].Unique = v.Unique
+		header[0].Checks = v.Checks
+
+		records := make(RecordSet, len(v.Values))
+		for i := range v.Values {
+			records[i] = NewRecord([]value.Primary{v.Values[i]})
+		}
+		view := &View{
+			Header:    header,
+			RecordSet: records,
+			FileInfo:  &FileInfo{},
+		}
+
+		err := view.CheckConstraints(context.Background(), NewReferenceScope(TestTx), parser.Identifier{Literal: "table1"})
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+		}
+	}
+}
+
 var viewInsertFromQueryTests = []struct {
 	Name        string
 	Fields      []parser.QueryExpression
@@ -6856,3 +8557,52 @@ func BenchmarkView_SelectDistinct(b *testing.B) {
 		_ = v.Select(ctx, scope, clause)
 	}
 }
+
+func TestViewForWriting(t *testing.T) {
+	view := &View{
+		Header: NewHeader("table1", []string{"amount", "order_date"}),
+		RecordSet: RecordSet{
+			{
+				NewCellWithText(value.NewFloat(1.5), "1.50"),
+				NewCellWithText(value.NewDatetime(time.Date(2020, 3, 15, 0, 0, 0, 0, GetTestLocation())), "3/15/2020"),
+			},
+			{
+				NewCellWithText(value.NewNull(), "x"),
+				NewCellWithText(value.NewNull(), "bad"),
+			},
+			{
+				NewCell(value.NewInteger(3)),
+				NewCell(value.NewDatetime(time.Date(2020, 4, 1, 0, 0, 0, 0, GetTestLocation()))),
+			},
+		},
+		FileInfo: &FileInfo{
+			datetimeFormats: map[string]string{"ORDER_DATE": "%m/%d/%Y"},
+		},
+	}
+	view.RecordSet[0][1] = NewCell(value.NewDatetime(time.Date(2020, 12, 1, 0, 0, 0, 0, GetTestLocation())))
+	view.RecordSet[1][0] = NewCell(value.NewFloat(2))
+
+	copied := view.Copy()
+	copied.RecordSet = append(RecordSet{}, copied.RecordSet[1:]...)
+	copied.RecordSet = append(copied.RecordSet, view.RecordSet[0])
+
+	expect := RecordSet{
+		NewRecord([]value.Primary{
+			value.NewFloat(2),
+			value.NewString("bad"),
+		}),
+		NewRecord([]value.Primary{
+			value.NewInteger(3),
+			value.NewString("04/01/2020"),
+		}),
+		NewRecord([]value.Primary{
+			value.NewString("1.50"),
+			value.NewString("12/01/2020"),
+		}),
+	}
+
+	result := viewForWriting(copied)
+	if !reflect.DeepEqual(result.RecordSet, expect) {
+		t.Errorf("records = %s, want %s", result.RecordSet, expect)
+	}
+}
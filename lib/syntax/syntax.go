@@ -23,8 +23,13 @@ var CsvqSyntax = []Expression{
 				Name: "select_entity",
 				Group: []Grammar{
 					{Link("select_clause"), Option{Link("from_clause")}, Option{Link("where_clause")}, Option{Link("group_by_clause")}},
+					{Keyword("TABLE"), Link("table_entity")},
 					{Link("select_set_entity"), Link("Set Operators"), Option{Keyword("ALL")}, Link("select_set_entity")},
 				},
+				Description: Description{
+					Template: "%s is a shorthand for %s.",
+					Values:   []Element{PlainGroup{Keyword("TABLE"), Link("table_entity")}, PlainGroup{Keyword("SELECT"), Keyword("*"), Keyword("FROM"), Link("table_entity")}},
+				},
 			},
 			{
 				Name: "select_set_entity",
@@ -47,7 +52,29 @@ var CsvqSyntax = []Expression{
 					{
 						Name: "common_table_expression",
 						Group: []Grammar{
-							{Option{Keyword("RECURSIVE")}, Identifier("table_name"), Option{Parentheses{ContinuousOption{Identifier("column_name")}}}, Keyword("AS"), Parentheses{Link("select_query")}},
+							{Option{Keyword("RECURSIVE")}, Identifier("table_name"), Option{Parentheses{ContinuousOption{Identifier("column_name")}}}, Keyword("AS"), Parentheses{Link("select_query")}, Option{Link("search_clause")}, Option{Link("cycle_clause")}},
+						},
+					},
+					{
+						Name: "search_clause",
+						Group: []Grammar{
+							{Keyword("SEARCH"), Keyword("DEPTH"), Keyword("FIRST"), Keyword("BY"), ContinuousOption{Identifier("column_name")}, Keyword("SET"), Identifier("sequence_column")},
+							{Keyword("SEARCH"), Keyword("BREADTH"), Keyword("FIRST"), Keyword("BY"), ContinuousOption{Identifier("column_name")}, Keyword("SET"), Identifier("sequence_column")},
+						},
+						Description: Description{
+							Template: "In a recursive query, records are sorted in depth-first or breadth-first order of the recursion, and siblings are sorted by the %s. " +
+								"The position of each record in the order is set to the %s.",
+							Values: []Element{Identifier("column_name"), Identifier("sequence_column")},
+						},
+					},
+					{
+						Name: "cycle_clause",
+						Group: []Grammar{
+							{Keyword("CYCLE"), ContinuousOption{Identifier("column_name")}, Keyword("SET"), Identifier("cycle_mark_column")},
+						},
+						Description: Description{
+							Template: "In a recursive query, records whose values of the %s are the same as those of any of their ancestors are marked TRUE in the %s and are not used for further recursion.",
+							Values:   []Element{Identifier("column_name"), Identifier("cycle_mark_column")},
 						},
 					},
 				},
@@ -97,6 +124,8 @@ var CsvqSyntax = []Expression{
 							{Link("table_identifier")},
 							{Link("table_object")},
 							{Link("json_inline_table")},
+							{String("url")},
+							{String("file_glob")},
 						},
 					},
 					{
@@ -145,7 +174,7 @@ var CsvqSyntax = []Expression{
 					{
 						Name: "table_object",
 						Group: []Grammar{
-							{Function{Name: "CSV", Args: []Element{String("delimiter"), Link("table_identifier"), Option{String("encoding"), Boolean("no_header"), Boolean("without_null")}}}},
+							{Function{Name: "CSV", Args: []Element{String("delimiter"), Link("table_identifier"), Option{String("encoding"), Boolean("no_header"), Boolean("without_null"), String("quote_char"), String("escape_style")}}}},
 							{Function{Name: "FIXED", Args: []Element{String("delimiter_positions"), Link("table_identifier"), Option{String("encoding"), Boolean("no_header"), Boolean("without_null")}}}},
 							{Function{Name: "JSON", Args: []Element{String("json_query"), Link("table_identifier")}}},
 							{Function{Name: "LTSV", Args: []Element{Link("table_identifier"), Option{String("encoding"), Boolean("without_null")}}}},
@@ -211,13 +240,24 @@ var CsvqSyntax = []Expression{
 					{
 						Name: "order_item",
 						Group: []Grammar{
-							{Link("field"), Option{Link("order_direction")}, Option{Link("null_position")}},
+							{Link("field"), Option{Link("collation")}, Option{Link("order_direction")}, Option{Link("null_position")}},
 						},
 						Description: Description{
 							Template: "If %s keyword is specified in the %s, you can use only enumerated fields in the %s as %s.",
 							Values:   []Element{Keyword("DISTINCT"), Link("select_clause"), Link("select_clause"), Link("field")},
 						},
 					},
+					{
+						Name: "collation",
+						Group: []Grammar{
+							{Keyword("COLLATE"), String("language_tag")},
+						},
+						Description: Description{
+							Template: "Strings in the %s are sorted by the collation rules of the language specified by %s, such as 'de-DE' or 'sv'. " +
+								"Values of other types and the other %s are sorted in the default order.",
+							Values: []Element{Link("field"), String("language_tag"), Link("order_item")},
+						},
+					},
 					{
 						Name: "order_direction",
 						Group: []Grammar{
@@ -234,8 +274,8 @@ var CsvqSyntax = []Expression{
 							{Keyword("NULLS"), AnyOne{Keyword("FIRST"), Keyword("LAST")}},
 						},
 						Description: Description{
-							Template: "If %s is specified as %s then %s is the default. Otherwise %s is the default.",
-							Values:   []Element{Link("order_direction"), Keyword("ASC"), Keyword("FIRST"), Keyword("LAST")},
+							Template: "If %s is specified as %s then %s is the default. Otherwise %s is the default. The default can be changed by %s.",
+							Values:   []Element{Link("order_direction"), Keyword("ASC"), Keyword("FIRST"), Keyword("LAST"), Flag("@@NULLS_ORDER")},
 						},
 					},
 				},
@@ -275,8 +315,23 @@ var CsvqSyntax = []Expression{
 			{
 				Name: "insert_query",
 				Group: []Grammar{
-					{Keyword("INSERT"), Keyword("INTO"), Identifier("table_name"), Option{Parentheses{ContinuousOption{Identifier("column_name")}}}, Keyword("VALUES"), ContinuousOption{Link("row_value")}},
-					{Keyword("INSERT"), Keyword("INTO"), Identifier("table_name"), Option{Parentheses{ContinuousOption{Identifier("column_name")}}}, Link("select_query")},
+					{Keyword("INSERT"), Keyword("INTO"), Identifier("table_name"), Option{Parentheses{ContinuousOption{Identifier("column_name")}}}, Keyword("VALUES"), ContinuousOption{Link("row_value")}, Option{Link("returning_clause")}},
+					{Keyword("INSERT"), Keyword("INTO"), Identifier("table_name"), Option{Parentheses{ContinuousOption{Identifier("column_name")}}}, Link("select_query"), Option{Link("returning_clause")}},
+					{Keyword("INSERT"), Keyword("INTO"), Identifier("table_name"), Keyword("DEFAULT"), Keyword("VALUES"), Option{Link("returning_clause")}},
+				},
+				Description: Description{
+					Template: "%s inserts a record in which every column takes its default value, or %s if the column has no default value.",
+					Values:   []Element{PlainGroup{Keyword("DEFAULT"), Keyword("VALUES")}, Null("NULL")},
+				},
+			},
+			{
+				Name: "returning_clause",
+				Group: []Grammar{
+					{Keyword("RETURNING"), ContinuousOption{Link("field")}},
+				},
+				Description: Description{
+					Template: "%s returns a result set of the fields evaluated over the affected records. Inserted and updated records are returned with their new values, and deleted records with the values before deletion.",
+					Values:   []Element{Keyword("RETURNING")},
 				},
 			},
 		},
@@ -293,8 +348,12 @@ var CsvqSyntax = []Expression{
 			{
 				Name: "update_query",
 				Group: []Grammar{
-					{Keyword("UPDATE"), Identifier("table_name"), Keyword("SET"), ContinuousOption{Link("set_value")}, Option{Link("where_clause")}},
-					{Keyword("UPDATE"), ContinuousOption{Identifier("table_alias")}, Keyword("SET"), ContinuousOption{Link("set_value")}, Link("from_clause"), Option{Link("where_clause")}},
+					{Keyword("UPDATE"), Identifier("table_name"), Keyword("SET"), ContinuousOption{Link("set_value")}, Option{Link("where_clause")}, Option{Link("returning_clause")}},
+					{Keyword("UPDATE"), ContinuousOption{Identifier("table_alias")}, Keyword("SET"), ContinuousOption{Link("set_value")}, Link("from_clause"), Option{Link("where_clause")}, Option{Link("returning_clause")}},
+				},
+				Description: Description{
+					Template: "If %s is not specified in the %s, the table is joined with the tables in the %s. An error is returned if multiple records match a record to be updated.",
+					Values:   []Element{Identifier("table_alias"), Link("from_clause"), Link("from_clause")},
 				},
 			},
 			{
@@ -335,8 +394,8 @@ var CsvqSyntax = []Expression{
 			{
 				Name: "delete_query",
 				Group: []Grammar{
-					{Keyword("DELETE"), Keyword("FROM"), Identifier("table_name"), Option{Link("where_clause")}},
-					{Keyword("DELETE"), ContinuousOption{Identifier("table_alias")}, Link("from_clause"), Option{Link("where_clause")}},
+					{Keyword("DELETE"), Keyword("FROM"), Identifier("table_name"), Option{Link("where_clause")}, Option{Link("returning_clause")}},
+					{Keyword("DELETE"), ContinuousOption{Identifier("table_alias")}, Link("from_clause"), Option{Link("where_clause")}, Option{Link("returning_clause")}},
 				},
 			},
 		},
@@ -347,8 +406,21 @@ var CsvqSyntax = []Expression{
 			{
 				Name: "create_table_statement",
 				Group: []Grammar{
-					{Keyword("CREATE"), Keyword("TABLE"), Identifier("file_path"), Parentheses{ContinuousOption{Identifier("column_name")}}},
-					{Keyword("CREATE"), Keyword("TABLE"), Identifier("file_path"), Option{Parentheses{ContinuousOption{Identifier("column_name")}}}, Option{Keyword("AS")}, Link("select_query")},
+					{Keyword("CREATE"), Keyword("TABLE"), Identifier("file_path"), Parentheses{ContinuousOption{Link("create_column_definition")}, FollowingContinuousOption{Keyword("CHECK"), Parentheses{Link("condition")}}}},
+					{Keyword("CREATE"), Keyword("TABLE"), Identifier("file_path"), Option{Parentheses{ContinuousOption{Link("create_column_definition")}, FollowingContinuousOption{Keyword("CHECK"), Parentheses{Link("condition")}}}}, Option{Keyword("AS")}, Link("select_query")},
+				},
+			},
+			{
+				Name: "create_column_definition",
+				Group: []Grammar{
+					{Identifier("column_name"), Option{Keyword("NOT"), Keyword("NULL")}, Option{Keyword("UNIQUE")}, Option{Keyword("CHECK"), Parentheses{Link("condition")}}, Option{Keyword("DEFAULT"), Link("value")}},
+				},
+				Description: Description{
+					Template: "%s is set to the column omitted in insert queries. The value is evaluated for each inserted record, and can refer to the other columns in the record. " +
+						"%s rejects null values and %s rejects duplicate values in the column when records are inserted or updated. " +
+						"%s rejects records for which %s is FALSE. Check constraints can also be written after the column definitions as table constraints. " +
+						"Constraints and the default value can be written in any order, and are stored in the table definition file \"FILE_PATH.def.sql\" when the table is committed.",
+					Values: []Element{Link("value"), PlainGroup{Keyword("NOT"), Keyword("NULL")}, Keyword("UNIQUE"), Keyword("CHECK"), Link("condition")},
 				},
 			},
 		},
@@ -366,11 +438,12 @@ var CsvqSyntax = []Expression{
 			{
 				Name: "column_definition",
 				Group: []Grammar{
-					{Identifier("column_name"), Option{Keyword("DEFAULT"), Link("value")}},
+					{Identifier("column_name"), Option{Keyword("NOT"), Keyword("NULL")}, Option{Keyword("UNIQUE")}, Option{Keyword("CHECK"), Parentheses{Link("condition")}}, Option{Keyword("DEFAULT"), Link("value")}},
 				},
 				Description: Description{
-					Template: "%s is the default value.",
-					Values:   []Element{Null("NULL")},
+					Template: "%s is the default value. The default value is set to the existing records, and to the column omitted in insert queries. " +
+						"%s, %s and %s are checked against the values of the existing records. Constraints and the default value can be written in any order.",
+					Values: []Element{Null("NULL"), PlainGroup{Keyword("NOT"), Keyword("NULL")}, Keyword("UNIQUE"), Keyword("CHECK")},
 				},
 			},
 			{
@@ -756,10 +829,14 @@ var CsvqSyntax = []Expression{
 			{
 				Name: "show",
 				Group: []Grammar{
-					{Keyword("SHOW"), AnyOne{Keyword("TABLES"), Keyword("VIEWS"), Keyword("CURSORS"), Keyword("FUNCTIONS"), Keyword("FLAGS"), Keyword("ENV"), Keyword("RUNINFO")}},
+					{Keyword("SHOW"), AnyOne{Keyword("TABLES"), Keyword("VIEWS"), Keyword("CURSORS"), Keyword("FUNCTIONS"), Keyword("VARIABLES"), Keyword("STATEMENTS"), Keyword("FLAGS"), Keyword("ENV"), Keyword("RUNINFO"), Keyword("SETTINGS")}},
 				},
 				Description: Description{
-					Template: "Show objects.",
+					Template: "Show objects. " +
+						"%s shows loaded tables and data files in the repository directory. " +
+						"%s shows the current values of flags. " +
+						"%s, %s, %s, %s, %s and %s are shown as result sets.",
+					Values: []Element{Keyword("TABLES"), Keyword("SETTINGS"), Keyword("TABLES"), Keyword("VIEWS"), Keyword("CURSORS"), Keyword("FUNCTIONS"), Keyword("VARIABLES"), Keyword("SETTINGS")},
 				},
 			},
 			{
@@ -771,6 +848,31 @@ var CsvqSyntax = []Expression{
 					Template: "Show fields in a table or a view.",
 				},
 			},
+			{
+				Name: "describe",
+				Group: []Grammar{
+					{Keyword("DESCRIBE"), Identifier("table_name")},
+					{Keyword("DESCRIBE"), Identifier("table_name"), Keyword("SAMPLE"), Integer("number_of_records")},
+					{Keyword("SHOW"), Keyword("COLUMNS"), Keyword("FROM"), Identifier("table_name")},
+				},
+				Description: Description{
+					Template: "Show fields, their inferred types, the numbers of values by type, the minimum and maximum values, the number of distinct values and some sample values in a table or a view. All the records are analyzed unless %s is specified, in which case the first %s records are analyzed. The number of distinct values is an estimate when it exceeds 10000.",
+					Values:   []Element{Keyword("SAMPLE"), Integer("number_of_records")},
+				},
+			},
+			{
+				Name: "explain_analyze",
+				Group: []Grammar{
+					{Keyword("EXPLAIN"), Keyword("ANALYZE"), Link("select_query")},
+					{Keyword("EXPLAIN"), Keyword("ANALYZE"), Option{Keyword("APPLY")}, Link("update_query")},
+					{Keyword("EXPLAIN"), Keyword("ANALYZE"), Option{Keyword("APPLY")}, Link("delete_query")},
+				},
+				Description: Description{
+					Template: "Execute a query and show the number of rows, the elapsed time in milliseconds and the peak memory in bytes of the materialized views of each stage of the query. Stages executed in another stage are indented. " +
+						"Changes made by an update or delete query are discarded unless %s is specified.",
+					Values: []Element{Keyword("APPLY")},
+				},
+			},
 			{
 				Name: "chdir",
 				Group: []Grammar{
@@ -878,6 +980,13 @@ var CsvqSyntax = []Expression{
 					Values:   []Element{Link("String Operators")},
 				},
 			},
+			{
+				Name: "bitwise_operation",
+				Description: Description{
+					Template: "ref. %s",
+					Values:   []Element{Link("Bitwise Operators")},
+				},
+			},
 			{
 				Name: "function",
 				Description: Description{
@@ -892,6 +1001,7 @@ var CsvqSyntax = []Expression{
 						"   > %s\n" +
 						"   > %s\n" +
 						"   > %s\n" +
+						"   > %s\n" +
 						"",
 					Values: []Element{
 						Link("Logical Functions"),
@@ -899,6 +1009,7 @@ var CsvqSyntax = []Expression{
 						Link("DateTime Functions"),
 						Link("String Functions"),
 						Link("Cryptographic Hash Functions"),
+						Link("Network Functions"),
 						Link("Cast Functions"),
 						Link("System Functions"),
 						Link("Aggregate Functions"),
@@ -961,6 +1072,18 @@ var CsvqSyntax = []Expression{
 					{Keyword("CASE"), Link("value"), Keyword("WHEN"), Link("comparison_value"), Keyword("THEN"), Link("result_value"), Option{Keyword("WHEN"), Link("comparison_value"), Keyword("THEN"), Link("result_value"), Token("...")}, Option{Keyword("ELSE"), Link("result_value")}, Keyword("END")},
 				},
 			},
+			{
+				Name: "comparison_value",
+				Group: []Grammar{
+					{Link("value")},
+					{Link("comparison_operator"), Link("value")},
+					{Option{Keyword("NOT")}, Keyword("BETWEEN"), Link("low"), Keyword("AND"), Link("high")},
+				},
+				Description: Description{
+					Template: "If %s has a %s or BETWEEN, then the operation is applied to the value of the case expression. Otherwise %s is compared with the value for equality.",
+					Values:   []Element{Link("comparison_value"), Link("comparison_operator"), Link("comparison_value")},
+				},
+			},
 			{
 				Name: "comparison_operation",
 				Description: Description{
@@ -1003,7 +1126,7 @@ var CsvqSyntax = []Expression{
 		Description: Description{
 			Template: "" +
 				"%s  <type::%s>\n" +
-				"  > Deirectory path where files are located.\n" +
+				"  > Directory path where files are located.\n" +
 				"%s  <type::%s>\n" +
 				"  > Default %s.\n" +
 				"%s  <type::%s>\n" +
@@ -1011,14 +1134,34 @@ var CsvqSyntax = []Expression{
 				"%s  <type::%s>\n" +
 				"  > Use double quotation mark(U+0022 \") as identifier enclosure.\n" +
 				"%s  <type::%s>\n" +
+				"  > Default position of nulls in sorting. One of DEFAULT, FIRST and LAST.\n" +
+				"%s  <type::%s>\n" +
+				"  > Keep the input order of records with equal sort keys.\n" +
+				"%s  <type::%s>\n" +
+				"  > Treat nulls as empty strings in string concatenation.\n" +
+				"%s  <type::%s>\n" +
+				"  > Append inserted records to files on commit instead of rewriting them.\n" +
+				"%s  <type::%s>\n" +
 				"  > Limit of the waiting time in seconds to wait for locked files to be released.\n" +
 				"%s  <type::%s>\n" +
+				"  > Limit of the time in seconds to fetch tables from HTTP(S) URLs. 0 means no limit.\n" +
+				"%s  <type::%s>\n" +
+				"  > Maximum size in bytes of tables fetched from HTTP(S) URLs. 0 means no limit.\n" +
+				"%s  <type::%s>\n" +
+				"  > Request headers as a JSON object to fetch tables from HTTP(S) URLs.\n" +
+				"%s  <type::%s>\n" +
 				"  > Default format to load files.\n" +
 				"%s  <type::%s>\n" +
 				"  > Field delimiter for CSV.\n" +
 				"%s  <type::%s>\n" +
+				"  > Regular expression to split fields for CSV.\n" +
+				"%s  <type::%s>\n" +
 				"  > Delimiter positions for Fixed-Length Format.\n" +
 				"%s  <type::%s>\n" +
+				"  > Quotation character to enclose fields in CSV. An empty string means no quotation.\n" +
+				"%s  <type::%s>\n" +
+				"  > Escape style of quotation characters in CSV. One of DOUBLE, BACKSLASH and NONE.\n" +
+				"%s  <type::%s>\n" +
 				"  > Query for JSON data.\n" +
 				"%s  <type::%s>\n" +
 				"  > Character %s.\n" +
@@ -1027,26 +1170,66 @@ var CsvqSyntax = []Expression{
 				"%s  <type::%s>\n" +
 				"  > Parse empty fields as empty strings.\n" +
 				"%s  <type::%s>\n" +
+				"  > Types to which values of the named columns are converted.\n" +
+				"%s  <type::%s>\n" +
+				"  > Raise an error for values that cannot be converted to the types in @@COLUMN_TYPES.\n" +
+				"%s  <type::%s>\n" +
+				"  > Require the same order of columns in files matched by a glob pattern.\n" +
+				"%s  <type::%s>\n" +
+				"  > Import all fields as strings.\n" +
+				"%s  <type::%s>\n" +
+				"  > Number of leading lines to be skipped.\n" +
+				"%s  <type::%s>\n" +
+				"  > Prefix of leading comment lines to be skipped.\n" +
+				"%s  <type::%s>\n" +
+				"  > Skip comment lines after the header as well.\n" +
+				"%s  <type::%s>\n" +
+				"  > Trim leading and trailing white spaces from fields.\n" +
+				"%s  <type::%s>\n" +
+				"  > Detect the delimiter and the header of CSV files.\n" +
+				"%s  <type::%s>\n" +
+				"  > Handling of records with a wrong number of fields.\n" +
+				"%s  <type::%s>\n" +
+				"  > File to which copies of result sets are written while they are printed.\n" +
+				"%s  <type::%s>\n" +
 				"  > Strip line break from the end of files and query results.\n" +
 				"%s  <type::%s>\n" +
 				"  > %s of query results.\n" +
 				"%s  <type::%s>\n" +
 				"  > Character %s of query results.\n" +
 				"%s  <type::%s>\n" +
+				"  > Write a byte order mark at the beginning of query results.\n" +
+				"%s  <type::%s>\n" +
+				"  > Replace characters that cannot be encoded in the write-encoding with \"?\".\n" +
+				"%s  <type::%s>\n" +
 				"  > Field delimiter for query results in CSV.\n" +
 				"%s  <type::%s>\n" +
 				"  > Delimiter positions for query results in Fixed-Length Format.\n" +
 				"%s  <type::%s>\n" +
 				"  > Write without the header line in query results.\n" +
 				"%s  <type::%s>\n" +
+				"  > Write column types in the header line of query results.\n" +
+				"%s  <type::%s>\n" +
 				"  > %s in query results.\n" +
 				"%s  <type::%s>\n" +
 				"  > Enclose all string values in CSV.\n" +
 				"%s  <type::%s>\n" +
+				"  > Fields to be enclosed in CSV. One of MINIMAL, ALL, NON_NUMERIC and NONE.\n" +
+				"%s  <type::%s>\n" +
 				"  > %s of query results.\n" +
 				"%s  <type::%s>\n" +
 				"  > Make JSON output easier to read in query results.\n" +
 				"%s  <type::%s>\n" +
+				"  > String to represent nulls in GFM and ORG tables.\n" +
+				"%s  <type::%s>\n" +
+				"  > Class attribute of tables in HTML.\n" +
+				"%s  <type::%s>\n" +
+				"  > Write fields as attributes of row elements in XML.\n" +
+				"%s  <type::%s>\n" +
+				"  > Compression algorithm of query results and files written at commit. One of NONE|GZIP.\n" +
+				"%s  <type::%s>\n" +
+				"  > Compression level from 1 to 9.\n" +
+				"%s  <type::%s>\n" +
 				"  > Count ambiguous characters as fullwidth.\n" +
 				"%s  <type::%s>\n" +
 				"  > Count diacritical signs as halfwidth.\n" +
@@ -1057,40 +1240,93 @@ var CsvqSyntax = []Expression{
 				"%s  <type::%s>\n" +
 				"  > Suppress operation log output.\n" +
 				"%s  <type::%s>\n" +
+				"  > Maximum number of iterations for recursive queries.\n" +
+				"%s  <type::%s>\n" +
 				"  > Hint for the number of cpu cores to be used.\n" +
 				"%s  <type::%s>\n" +
 				"  > Show execution time.\n" +
+				"%s  <type::%s>\n" +
+				"  > Fixed time returned as the current time. NULL means the real clock.\n" +
 				"",
 			Values: []Element{
 				Flag("@@REPOSITORY"), String("string"),
 				Flag("@@TIMEZONE"), String("string"), Link("Timezone"),
 				Flag("@@DATETIME_FORMAT"), String("string"),
 				Flag("@@ANSI_QUOTES"), String("boolean"),
+				Flag("@@NULLS_ORDER"), String("string"),
+				Flag("@@STABLE_SORT"), Boolean("boolean"),
+				Flag("@@CONCAT_NULL_AS_EMPTY"), Boolean("boolean"),
+				Flag("@@APPEND_COMMIT"), Boolean("boolean"),
+				Flag("@@ATOMIC_WRITE"), Boolean("boolean"),
+				Flag("@@DRY_RUN"), Boolean("boolean"),
+				Flag("@@IGNORE_CONSTRAINTS"), Boolean("boolean"),
 				Flag("@@WAIT_TIMEOUT"), Float("float"),
+				Flag("@@HTTP_TIMEOUT"), Float("float"),
+				Flag("@@HTTP_MAX_SIZE"), Integer("integer"),
+				Flag("@@HTTP_HEADERS"), String("string"),
 				Flag("@@IMPORT_FORMAT"), String("string"),
 				Flag("@@DELIMITER"), String("string"),
+				Flag("@@DELIMITER_REGEX"), String("string"),
 				Flag("@@DELIMITER_POSITIONS"), String("string"),
+				Flag("@@QUOTE_CHAR"), String("string"),
+				Flag("@@ESCAPE_STYLE"), String("string"),
 				Flag("@@JSON_QUERY"), String("string"),
 				Flag("@@ENCODING"), String("string"), Link("Encoding"),
 				Flag("@@NO_HEADER"), Boolean("boolean"),
 				Flag("@@WITHOUT_NULL"), Boolean("boolean"),
+				Flag("@@COLUMN_TYPES"), String("string"),
+				Flag("@@STRICT_COLUMN_TYPES"), Boolean("boolean"),
+				Flag("@@STRICT_GLOB_HEADER"), Boolean("boolean"),
+				Flag("@@NO_INFER"), Boolean("boolean"),
+				Flag("@@SKIP_LINES"), Integer("integer"),
+				Flag("@@COMMENT_PREFIX"), String("string"),
+				Flag("@@SKIP_ALL_COMMENTS"), Boolean("boolean"),
+				Flag("@@TRIM"), Boolean("boolean"),
+				Flag("@@DETECT"), Boolean("boolean"),
+				Flag("@@RAGGED"), String("string"),
+				Flag("@@TEE"), String("string"),
 				Flag("@@STRIP_ENDING_LINE_BREAK"), Boolean("boolean"),
 				Flag("@@FORMAT"), String("string"), Link("Format"),
 				Flag("@@WRITE_ENCODING"), String("string"), Link("Encoding"),
+				Flag("@@WRITE_BOM"), Boolean("boolean"),
+				Flag("@@REPLACE_UNENCODABLE"), Boolean("boolean"),
 				Flag("@@WRITE_DELIMITER"), String("string"),
 				Flag("@@WRITE_DELIMITER_POSITIONS"), String("string"),
 				Flag("@@WITHOUT_HEADER"), Boolean("boolean"),
+				Flag("@@TYPED_HEADER"), Boolean("boolean"),
 				Flag("@@LINE_BREAK"), String("string"), Link("Line Break"),
 				Flag("@@ENCLOSE_ALL"), Boolean("boolean"),
+				Flag("@@QUOTE_MODE"), String("string"),
+				Flag("@@EXCEL_SAFE"), Boolean("boolean"),
+				Flag("@@EXCEL_SAFE_PREFIX"), String("string"),
 				Flag("@@JSON_ESCAPE"), String("string"), Link("Json Escape Type"),
 				Flag("@@PRETTY_PRINT"), Boolean("boolean"),
+				Flag("@@JSON_KEY"), String("string"),
+				Flag("@@JSON_KEY_LAST"), Boolean("boolean"),
+				Flag("@@JSON_FLAT"), Boolean("boolean"),
+				Flag("@@NULL_TEXT"), String("string"),
+				Flag("@@HTML_CLASS"), String("string"),
+				Flag("@@XML_ATTRIBUTES"), Boolean("boolean"),
+				Flag("@@MAX_COLUMN_WIDTH"), Integer("integer"),
+				Flag("@@WRAP_TEXT"), Boolean("boolean"),
+				Flag("@@BOX_DRAWING"), Boolean("boolean"),
+				Flag("@@TABLE_NAME"), String("string"),
+				Flag("@@INSERT_BATCH_SIZE"), Integer("integer"),
+				Flag("@@IDENTIFIER_QUOTE"), String("string"),
+				Flag("@@TEMPLATE"), String("string"),
+				Flag("@@PARQUET_COMPRESSION"), String("string"),
+				Flag("@@PARQUET_ALL_STRINGS"), Boolean("boolean"),
+				Flag("@@COMPRESSION"), String("string"),
+				Flag("@@COMPRESSION_LEVEL"), Integer("integer"),
 				Flag("@@EAST_ASIAN_ENCODING"), Boolean("boolean"),
 				Flag("@@COUNT_DIACRITICAL_SIGN"), Boolean("boolean"),
 				Flag("@@COUNT_FORMAT_CODE"), Boolean("boolean"),
 				Flag("@@COLOR"), Boolean("boolean"),
 				Flag("@@QUIET"), Boolean("boolean"),
+				Flag("@@LIMIT_RECURSION"), Integer("integer"),
 				Flag("@@CPU"), Integer("integer"),
 				Flag("@@STATS"), Boolean("boolean"),
+				Flag("@@NOW"), Datetime("datetime"),
 			},
 		},
 		Grammar: []Definition{
@@ -1158,6 +1394,8 @@ var CsvqSyntax = []Expression{
 				"  > Current working directory.\n" +
 				"%s  <type::%s>\n" +
 				"  > Version of csvq.\n" +
+				"%s  <type::%s>\n" +
+				"  > Warnings about the last loaded file.\n" +
 				"",
 			Values: []Element{
 				Variable("@#UNCOMMITTED"), Boolean("boolean"),
@@ -1167,6 +1405,7 @@ var CsvqSyntax = []Expression{
 				Variable("@#LOADED_TABLES"), Integer("integer"),
 				Variable("@#WORKING_DIRECTORY"), String("string"),
 				Variable("@#VERSION"), String("string"),
+				Variable("@#LAST_LOAD_WARNINGS"), String("string"),
 			},
 		},
 	},
@@ -1253,13 +1492,19 @@ var CsvqSyntax = []Expression{
 						"  |          1 | +  (Unary Plus)     | Right-to-Left |\n" +
 						"  |            | -  (Unary Minus)    | Right-to-Left |\n" +
 						"  |            | !  (Logical Not)    | Right-to-Left |\n" +
+						"  |            | ~  (Bitwise Not)    | Right-to-Left |\n" +
 						"  |          2 | *  (Multiplication) | Left-to-Right |\n" +
 						"  |            | /  (Division)       | Left-to-Right |\n" +
 						"  |            | %s  (Modulo)         | Left-to-Right |\n" +
 						"  |          3 | +  (Addition)       | Left-to-Right |\n" +
 						"  |            | -  (Subtraction)    | Left-to-Right |\n" +
-						"  |          4 | || (Concatenation)  | Left-to-Right |\n" +
-						"  |          5 | =                   | n/a           |\n" +
+						"  |          4 | << (Left Shift)     | Left-to-Right |\n" +
+						"  |            | >> (Right Shift)    | Left-to-Right |\n" +
+						"  |          5 | &  (Bitwise AND)    | Left-to-Right |\n" +
+						"  |          6 | ^  (Bitwise XOR)    | Left-to-Right |\n" +
+						"  |          7 | |  (Bitwise OR)     | Left-to-Right |\n" +
+						"  |          8 | || (Concatenation)  | Left-to-Right |\n" +
+						"  |          9 | =                   | n/a           |\n" +
 						"  |            | ==                  | n/a           |\n" +
 						"  |            | <                   | n/a           |\n" +
 						"  |            | <=                  | n/a           |\n" +
@@ -1271,13 +1516,13 @@ var CsvqSyntax = []Expression{
 						"  |            | BETWEEN             | n/a           |\n" +
 						"  |            | IN                  | n/a           |\n" +
 						"  |            | LIKE                | n/a           |\n" +
-						"  |          6 | NOT                 | Right-to-Left |\n" +
-						"  |          7 | AND                 | Left-to-Right |\n" +
-						"  |          8 | OR                  | Left-to-Right |\n" +
-						"  |          9 | INTERSECT           | Left-to-Right |\n" +
-						"  |         10 | UNION               | Left-to-Right |\n" +
+						"  |         10 | NOT                 | Right-to-Left |\n" +
+						"  |         11 | AND                 | Left-to-Right |\n" +
+						"  |         12 | OR                  | Left-to-Right |\n" +
+						"  |         13 | INTERSECT           | Left-to-Right |\n" +
+						"  |         14 | UNION               | Left-to-Right |\n" +
 						"  |            | EXCEPT              | Left-to-Right |\n" +
-						"  |         11 | :=                  | Right-to-Left |\n" +
+						"  |         15 | :=                  | Right-to-Left |\n" +
 						"  +------------+---------------------+---------------+\n" +
 						"```",
 					Values: []Element{Token("%")},
@@ -1458,6 +1703,54 @@ var CsvqSyntax = []Expression{
 						Group: []Grammar{
 							{Link("value"), Keyword("||"), Link("value")},
 						},
+						Description: Description{
+							Template: "Returns null if either of operands is null. If %s is true, nulls are treated as empty strings.",
+							Values:   []Element{Flag("@@CONCAT_NULL_AS_EMPTY")},
+						},
+					},
+				},
+			},
+			{
+				Label: "Bitwise Operators",
+				Grammar: []Definition{
+					{
+						Name: "bitwise_binary_operator",
+						Group: []Grammar{
+							{Integer("integer"), Link("bitwise_binary_operator"), Integer("integer")},
+						},
+						Description: Description{
+							Template: "" +
+								"```\n" +
+								"  +----------+-------------+\n" +
+								"  | Operator | Description |\n" +
+								"  +----------+-------------+\n" +
+								"  | &        | Bitwise AND |\n" +
+								"  | |        | Bitwise OR  |\n" +
+								"  | ^        | Bitwise XOR |\n" +
+								"  | <<       | Left Shift  |\n" +
+								"  | >>       | Right Shift |\n" +
+								"  +----------+-------------+\n" +
+								"```\n" +
+								"\n" +
+								"If either of operands is not an integer, an error is occurred. " +
+								"Shift operators return 0 if the shift count is less than 0 or greater than 63.",
+						},
+					},
+					{
+						Name: "bitwise_unary_operator",
+						Group: []Grammar{
+							{Keyword("~"), Integer("integer")},
+						},
+						Description: Description{
+							Template: "" +
+								"```\n" +
+								"  +----------+-------------+\n" +
+								"  | Operator | Description |\n" +
+								"  +----------+-------------+\n" +
+								"  | ~        | Bitwise NOT |\n" +
+								"  +----------+-------------+\n" +
+								"```",
+						},
 					},
 				},
 			},
@@ -1513,6 +1806,21 @@ var CsvqSyntax = []Expression{
 						},
 						Description: Description{Template: "If %s is %s, then returns %s. Otherwise returns %s.", Values: []Element{Link("value1"), Null("NULL"), Link("value2"), Link("value1")}},
 					},
+					{
+						Name: "nvl",
+						Group: []Grammar{
+							{Function{Name: "NVL", Args: []Element{Link("value1"), Link("value2")}, Return: Return("primitive type")}},
+							{Function{Name: "ISNULL", Args: []Element{Link("value1"), Link("value2")}, Return: Return("primitive type")}},
+						},
+						Description: Description{Template: "Alias for %s.", Values: []Element{Link("ifnull")}},
+					},
+					{
+						Name: "nvl2",
+						Group: []Grammar{
+							{Function{Name: "NVL2", Args: []Element{Link("value1"), Link("value2"), Link("value3")}, Return: Return("primitive type")}},
+						},
+						Description: Description{Template: "If %s is not %s, then returns %s. Otherwise returns %s.", Values: []Element{Link("value1"), Null("NULL"), Link("value2"), Link("value3")}},
+					},
 					{
 						Name: "nullif",
 						Group: []Grammar{
@@ -1520,6 +1828,21 @@ var CsvqSyntax = []Expression{
 						},
 						Description: Description{Template: "If %s is equal to %s, then returns %s. Otherwise returns %s.", Values: []Element{Link("value1"), Link("value2"), Null("NULL"), Link("value1")}},
 					},
+					{
+						Name: "decode",
+						Group: []Grammar{
+							{Function{Name: "DECODE", Args: []Element{Link("value"), Link("search"), Link("result"), Option{Link("search"), Link("result"), Token("...")}, Option{Link("default")}}, Return: Return("primitive type")}},
+						},
+						Description: Description{Template: "Compares %s to each %s in order, and returns the %s of the first matching %s. If no %s matches, then returns %s, or %s if %s is not specified. A null is regarded as equal to a null.", Values: []Element{Link("value"), Link("search"), Link("result"), Link("search"), Link("search"), Link("default"), Null("NULL"), Link("default")}},
+					},
+					{
+						Name: "map",
+						Group: []Grammar{
+							{Function{Name: "MAP", Args: []Element{Link("value"), Link("search"), Link("result"), Option{Link("search"), Link("result"), Token("...")}, Option{Link("default")}}, Return: Return("primitive type")}},
+							{Function{Name: "MAP", Args: []Element{Link("value"), String("json_object"), Option{Link("default")}}, Return: Return("primitive type")}},
+						},
+						Description: Description{Template: "Compares %s to each %s in order, and returns the %s of the first matching %s. If %s is specified, its keys are used as searches and its members as results. If no %s matches, then returns %s, or %s itself if %s is not specified. A null is regarded as equal to a null.", Values: []Element{Link("value"), Link("search"), Link("result"), Link("search"), String("json_object"), Link("search"), Link("default"), Link("value"), Link("default")}},
+					},
 				},
 			},
 			{
@@ -1542,9 +1865,16 @@ var CsvqSyntax = []Expression{
 					{
 						Name: "round",
 						Group: []Grammar{
-							{Function{Name: "ROUND", Args: []Element{Float("number"), ArgWithDefValue{Arg: Integer("place"), Default: Integer("0")}}, Return: Return("float or integer")}},
+							{Function{Name: "ROUND", Args: []Element{Float("number"), ArgWithDefValue{Arg: Integer("place"), Default: Integer("0")}, ArgWithDefValue{Arg: String("rounding_mode"), Default: String("'HALF_UP'")}}, Return: Return("float or integer")}},
+						},
+						Description: Description{Template: "Rounds %s to %s decimal place. If %s is a negative number, then %s represents the place in the integer part. %s is one of HALF_UP or HALF_EVEN.", Values: []Element{Float("number"), Integer("place"), Integer("place"), Integer("place"), String("rounding_mode")}},
+					},
+					{
+						Name: "trunc",
+						Group: []Grammar{
+							{Function{Name: "TRUNC", Args: []Element{Float("number"), ArgWithDefValue{Arg: Integer("place"), Default: Integer("0")}}, Return: Return("float or integer")}},
 						},
-						Description: Description{Template: "Rounds %s to %s decimal place. If %s is a negative number, then %s represents the place in the integer part.", Values: []Element{Float("number"), Integer("place"), Integer("place"), Integer("place")}},
+						Description: Description{Template: "Truncates %s toward zero to %s decimal place. If %s is a negative number, then %s represents the place in the integer part.", Values: []Element{Float("number"), Integer("place"), Integer("place"), Integer("place")}},
 					},
 					{
 						Name: "abs",
@@ -1553,6 +1883,13 @@ var CsvqSyntax = []Expression{
 						},
 						Description: Description{Template: "Returns the absolute value of %s.", Values: []Element{Float("number")}},
 					},
+					{
+						Name: "sign",
+						Group: []Grammar{
+							{Function{Name: "SIGN", Args: []Element{Float("number")}, Return: Return("integer")}},
+						},
+						Description: Description{Template: "Returns -1 if %s is negative, 0 if %s is zero, and 1 if %s is positive.", Values: []Element{Float("number"), Float("number"), Float("number")}},
+					},
 					{
 						Name: "acos",
 						Group: []Grammar{
@@ -1627,8 +1964,9 @@ var CsvqSyntax = []Expression{
 						Name: "log",
 						Group: []Grammar{
 							{Function{Name: "LOG", Args: []Element{Float("number")}, Return: Return("float or integer")}},
+							{Function{Name: "LOG", Args: []Element{Float("base"), Float("number")}, Return: Return("float or integer")}},
 						},
-						Description: Description{Template: "Returns the natural logarithm of %s.", Values: []Element{Float("number")}},
+						Description: Description{Template: "Returns the natural logarithm of %s. If %s is specified, then returns the logarithm of %s to %s.", Values: []Element{Float("number"), Float("base"), Float("number"), Float("base")}},
 					},
 					{
 						Name: "log10",
@@ -1658,6 +1996,13 @@ var CsvqSyntax = []Expression{
 						},
 						Description: Description{Template: "Returns the square root of %s.", Values: []Element{Float("number")}},
 					},
+					{
+						Name: "cbrt",
+						Group: []Grammar{
+							{Function{Name: "CBRT", Args: []Element{Float("number")}, Return: Return("float or integer")}},
+						},
+						Description: Description{Template: "Returns the cube root of %s.", Values: []Element{Float("number")}},
+					},
 					{
 						Name: "pow",
 						Group: []Grammar{
@@ -1665,6 +2010,30 @@ var CsvqSyntax = []Expression{
 						},
 						Description: Description{Template: "Returns the value of %s raised to the power of %s.", Values: []Element{Float("base"), Float("exponent")}},
 					},
+					{
+						Name: "safe_divide",
+						Group: []Grammar{
+							{Function{Name: "SAFE_DIVIDE", Args: []Element{Float("dividend"), Float("divisor")}, Return: Return("float or integer")}},
+						},
+						Description: Description{Template: "Returns the result of %s divided by %s. If %s is zero, then returns %s.", Values: []Element{Float("dividend"), Float("divisor"), Float("divisor"), Null("NULL")}},
+					},
+					{
+						Name: "width_bucket",
+						Group: []Grammar{
+							{Function{Name: "WIDTH_BUCKET", Args: []Element{Float("value"), Float("low"), Float("high"), Integer("buckets")}, Return: Return("integer")}},
+						},
+						Description: Description{Template: "Divides the range from %s to %s into %s equal-width buckets, and returns the bucket number from 1 to %s to which %s belongs. " +
+							"If %s is less than %s, then returns 0, and if %s is greater than or equal to %s, then returns %s + 1. " +
+							"Datetime values are also accepted for %s, %s and %s.",
+							Values: []Element{Float("low"), Float("high"), Integer("buckets"), Integer("buckets"), Float("value"), Float("value"), Float("low"), Float("value"), Float("high"), Integer("buckets"), Float("value"), Float("low"), Float("high")}},
+					},
+					{
+						Name: "clamp",
+						Group: []Grammar{
+							{Function{Name: "CLAMP", Args: []Element{Link("value"), Link("low"), Link("high")}, Return: Return("primitive type")}},
+						},
+						Description: Description{Template: "Returns %s if %s is less than %s, returns %s if %s is greater than %s, otherwise returns %s.", Values: []Element{Link("low"), Link("value"), Link("low"), Link("high"), Link("value"), Link("high"), Link("value")}},
+					},
 					{
 						Name: "bin_to_dec",
 						Group: []Grammar{
@@ -1728,6 +2097,44 @@ var CsvqSyntax = []Expression{
 						},
 						Description: Description{Template: "Formats %s to a string with separators.", Values: []Element{Integer("number")}},
 					},
+					{
+						Name: "to_number",
+						Group: []Grammar{
+							{Function{Name: "TO_NUMBER", Args: []Element{String("str"), Option{String("format")}}, Return: Return("float")}},
+						},
+						Description: Description{
+							Template: "Converts %s representing a number with separators to a float. " +
+								"The decimal point and the group separator are determined by %s such as %s or %s. " +
+								"If %s contains two kinds of separators, then the last one is the decimal point. " +
+								"If %s contains one kind of separator more than once, then it is the group separator. " +
+								"A separator that appears only once is the group separator when it is preceded by a placeholder \"#\" and followed by just three placeholders such as %s, otherwise the decimal point. " +
+								"If %s is omitted, then the separators are guessed from %s, and a separator that appears only once is regarded as the decimal point.\n" +
+								"\n" +
+								"If %s is null or an empty string, then returns %s. " +
+								"If %s cannot be converted, then an error occurs.",
+							Values: []Element{String("str"), String("format"), String("'#,##0.00'"), String("'#.##0,00'"), String("format"), String("format"), String("'#,##0'"), String("format"), String("str"), String("str"), Null("NULL"), String("str")},
+						},
+					},
+					{
+						Name: "format_number",
+						Group: []Grammar{
+							{Function{Name: "FORMAT_NUMBER", Args: []Element{Float("number"), Integer("decimals"), ArgWithDefValue{Arg: String("decimalPoint"), Default: String("'.'")}, ArgWithDefValue{Arg: String("groupSeparator"), Default: String("','")}}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Formats %s to a string with %s digits after the decimal point and separators.", Values: []Element{Float("number"), Integer("decimals")}},
+					},
+					{
+						Name: "parse_number",
+						Group: []Grammar{
+							{Function{Name: "PARSE_NUMBER", Args: []Element{String("str"), ArgWithDefValue{Arg: String("decimalPoint"), Default: String("'.'")}, ArgWithDefValue{Arg: String("groupSeparator"), Default: String("','")}, ArgWithDefValue{Arg: Boolean("lenient"), Default: Boolean("false")}}, Return: Return("float")}},
+						},
+						Description: Description{
+							Template: "Converts %s representing a number with separators to a float. " +
+								"If %s is null or cannot be converted, then returns %s.\n" +
+								"\n" +
+								"If %s is true, then leading and trailing white spaces and a leading currency symbol are ignored.",
+							Values: []Element{String("str"), String("str"), Null("NULL"), Boolean("lenient")},
+						},
+					},
 					{
 						Name: "rand",
 						Group: []Grammar{
@@ -1745,8 +2152,48 @@ var CsvqSyntax = []Expression{
 						Name: "now",
 						Group: []Grammar{
 							{Function{Name: "NOW", Return: Return("datetime")}},
+							{Function{Name: "NOW", Args: []Element{Integer("precision")}, Return: Return("datetime")}},
+						},
+						Description: Description{Template: "Returns a datetime value of current date and time. In a single query, every this function returns the same value. If %s is specified, then fractional seconds are truncated to %s digits.", Values: []Element{Integer("precision"), Integer("precision")}},
+					},
+					{
+						Name: "current_date",
+						Group: []Grammar{
+							{Keyword("CURRENT_DATE")},
+						},
+						Description: Description{Template: "Returns a datetime value of the current date with the time set to 00:00:00 in the current timezone. In a single query, every this expression returns the same value."},
+					},
+					{
+						Name: "current_time",
+						Group: []Grammar{
+							{Keyword("CURRENT_TIME")},
+							{Keyword("CURRENT_TIME"), Token("("), Integer("precision"), Token(")")},
+						},
+						Description: Description{Template: "Returns a string of the current time formatted as \"15:04:05\" in the current timezone. If %s is specified, then fractional seconds are truncated to and written with %s digits.", Values: []Element{Integer("precision"), Integer("precision")}},
+					},
+					{
+						Name: "current_timestamp",
+						Group: []Grammar{
+							{Keyword("CURRENT_TIMESTAMP")},
+							{Keyword("CURRENT_TIMESTAMP"), Token("("), Integer("precision"), Token(")")},
+						},
+						Description: Description{Template: "Same as NOW."},
+					},
+					{
+						Name: "transaction_timestamp",
+						Group: []Grammar{
+							{Function{Name: "TRANSACTION_TIMESTAMP", Return: Return("datetime")}},
+							{Function{Name: "TRANSACTION_TIMESTAMP", Args: []Element{Integer("precision")}, Return: Return("datetime")}},
+						},
+						Description: Description{Template: "Returns a datetime value of the time at which the current transaction started. In a single transaction, every this function returns the same value."},
+					},
+					{
+						Name: "clock_timestamp",
+						Group: []Grammar{
+							{Function{Name: "CLOCK_TIMESTAMP", Return: Return("datetime")}},
+							{Function{Name: "CLOCK_TIMESTAMP", Args: []Element{Integer("precision")}, Return: Return("datetime")}},
 						},
-						Description: Description{Template: "Returns a datetime value of current date and time. In a single query, every this function returns the same value."},
+						Description: Description{Template: "Returns a datetime value of current date and time. Unlike NOW, this function returns the actual time at every call."},
 					},
 					{
 						Name: "datetime_format",
@@ -1755,6 +2202,27 @@ var CsvqSyntax = []Expression{
 						},
 						Description: Description{Template: "Formats %s according to %s.", Values: []Element{Datetime("datetime"), String("format")}},
 					},
+					{
+						Name: "parse_datetime",
+						Group: []Grammar{
+							{Function{Name: "PARSE_DATETIME", Args: []Element{String("str"), String("format")}, Return: Return("datetime")}},
+						},
+						Description: Description{Template: "Parses %s according to %s written with strftime directives or a Go time layout. Characters other than directives must match as they are. If %s does not match %s, then returns a null.", Values: []Element{String("str"), String("format"), String("str"), String("format")}},
+					},
+					{
+						Name: "parse_datetime_strict",
+						Group: []Grammar{
+							{Function{Name: "PARSE_DATETIME_STRICT", Args: []Element{String("str"), String("format")}, Return: Return("datetime")}},
+						},
+						Description: Description{Template: "Parses %s according to %s in the same way as PARSE_DATETIME, but raises an error if %s does not match %s.", Values: []Element{String("str"), String("format"), String("str"), String("format")}},
+					},
+					{
+						Name: "to_char",
+						Group: []Grammar{
+							{Function{Name: "TO_CHAR", Args: []Element{Datetime("datetime"), String("format")}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Formats %s according to %s written with strftime directives or a Go time layout. Characters other than directives are written as they are.", Values: []Element{Datetime("datetime"), String("format")}},
+					},
 					{
 						Name: "year",
 						Group: []Grammar{
@@ -1839,6 +2307,20 @@ var CsvqSyntax = []Expression{
 						},
 						Description: Description{Template: "Returns the number of nanoseconds elapsed since January 1, 1970 UTC of %s as an integer.", Values: []Element{Datetime("datetime")}},
 					},
+					{
+						Name: "unix_timestamp",
+						Group: []Grammar{
+							{Function{Name: "UNIX_TIMESTAMP", Args: []Element{Datetime("datetime")}, Return: Return("integer")}},
+						},
+						Description: Description{Template: "Same as UNIX_TIME."},
+					},
+					{
+						Name: "unix_timestamp_ms",
+						Group: []Grammar{
+							{Function{Name: "UNIX_TIMESTAMP_MS", Args: []Element{Datetime("datetime")}, Return: Return("integer")}},
+						},
+						Description: Description{Template: "Returns the number of milliseconds elapsed since January 1, 1970 UTC of %s as an integer.", Values: []Element{Datetime("datetime")}},
+					},
 					{
 						Name: "day_of_year",
 						Group: []Grammar{
@@ -2000,6 +2482,27 @@ var CsvqSyntax = []Expression{
 						},
 						Description: Description{Template: "Returns the datetime value of %s in UTC.", Values: []Element{Datetime("datetime")}},
 					},
+					{
+						Name: "nano_to_datetime",
+						Group: []Grammar{
+							{Function{Name: "NANO_TO_DATETIME", Args: []Element{Integer("unix_nano_time")}, Return: Return("datetime")}},
+						},
+						Description: Description{Template: "Converts %s to a datetime in the current timezone.", Values: []Element{Integer("unix_nano_time")}},
+					},
+					{
+						Name: "from_unixtime",
+						Group: []Grammar{
+							{Function{Name: "FROM_UNIXTIME", Args: []Element{Integer("unix_time")}, Return: Return("datetime")}},
+						},
+						Description: Description{Template: "Converts %s representing the number of seconds elapsed since January 1, 1970 UTC to a datetime in the current timezone.", Values: []Element{Integer("unix_time")}},
+					},
+					{
+						Name: "from_unixtime_ms",
+						Group: []Grammar{
+							{Function{Name: "FROM_UNIXTIME_MS", Args: []Element{Integer("unix_milli_time")}, Return: Return("datetime")}},
+						},
+						Description: Description{Template: "Converts %s representing the number of milliseconds elapsed since January 1, 1970 UTC to a datetime in the current timezone.", Values: []Element{Integer("unix_milli_time")}},
+					},
 				},
 			},
 			{
@@ -2084,34 +2587,140 @@ var CsvqSyntax = []Expression{
 						Description: Description{Template: "Returns the string value represented by %s that is encoded with hexadecimal.", Values: []Element{String("str")}},
 					},
 					{
-						Name: "len",
+						Name: "url_encode",
 						Group: []Grammar{
-							{Function{Name: "LEN", Args: []Element{String("str")}, Return: Return("integer")}},
+							{Function{Name: "URL_ENCODE", Args: []Element{String("str")}, Return: Return("string")}},
 						},
-						Description: Description{Template: "Returns the number of characters of %s.", Values: []Element{String("str")}},
+						Description: Description{Template: "Returns the URL encoding of %s. Spaces are encoded as \"+\".", Values: []Element{String("str")}},
 					},
 					{
-						Name: "byte_len",
+						Name: "url_decode",
 						Group: []Grammar{
-							{Function{Name: "BYTE_LEN", Args: []Element{String("str"), ArgWithDefValue{Arg: String("encoding"), Default: String("'UTF8'")}}, Return: Return("integer")}},
+							{Function{Name: "URL_DECODE", Args: []Element{String("str")}, Return: Return("string")}},
 						},
-						Description: Description{Template: "Returns the byte length of %s.", Values: []Element{String("str")}},
+						Description: Description{Template: "Returns the string value represented by %s that is encoded with URL encoding.", Values: []Element{String("str")}},
 					},
 					{
-						Name: "width",
+						Name: "html_escape",
 						Group: []Grammar{
-							{Function{Name: "WIDTH", Args: []Element{String("str")}, Return: Return("integer")}},
+							{Function{Name: "HTML_ESCAPE", Args: []Element{String("str")}, Return: Return("string")}},
 						},
-						Description: Description{
-							Template: "Returns the string width of %s.\n" +
-								"Half-width characters are counted as 1, and full-width characters are counted as 2.",
-							Values: []Element{String("str")},
+						Description: Description{Template: "Returns the string with the special characters of HTML in %s escaped.", Values: []Element{String("str")}},
+					},
+					{
+						Name: "html_unescape",
+						Group: []Grammar{
+							{Function{Name: "HTML_UNESCAPE", Args: []Element{String("str")}, Return: Return("string")}},
 						},
+						Description: Description{Template: "Returns the string with the character references of HTML in %s unescaped.", Values: []Element{String("str")}},
 					},
 					{
-						Name: "lpad",
+						Name: "reverse",
 						Group: []Grammar{
-							{Function{Name: "LPAD", Args: []Element{String("str"), Integer("len"), String("padstr"), ArgWithDefValue{Arg: String("pad_type"), Default: String("'LEN'")}, ArgWithDefValue{Arg: String("encoding"), Default: String("'UTF8'")}}, Return: Return("string")}},
+							{Function{Name: "REVERSE", Args: []Element{String("str")}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Returns the string with the characters of %s in reverse order.", Values: []Element{String("str")}},
+					},
+					{
+						Name: "repeat",
+						Group: []Grammar{
+							{Function{Name: "REPEAT", Args: []Element{String("str"), Integer("n")}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Returns the string that concatenates %s copies of %s. If %s is less than 1, then returns an empty string.", Values: []Element{Integer("n"), String("str"), Integer("n")}},
+					},
+					{
+						Name: "len",
+						Group: []Grammar{
+							{Function{Name: "LEN", Args: []Element{String("str")}, Return: Return("integer")}},
+						},
+						Description: Description{Template: "Returns the number of characters of %s.", Values: []Element{String("str")}},
+					},
+					{
+						Name: "byte_len",
+						Group: []Grammar{
+							{Function{Name: "BYTE_LEN", Args: []Element{String("str"), ArgWithDefValue{Arg: String("encoding"), Default: String("'UTF8'")}}, Return: Return("integer")}},
+						},
+						Description: Description{Template: "Returns the byte length of %s.", Values: []Element{String("str")}},
+					},
+					{
+						Name: "width",
+						Group: []Grammar{
+							{Function{Name: "WIDTH", Args: []Element{String("str")}, Return: Return("integer")}},
+						},
+						Description: Description{
+							Template: "Returns the string width of %s.\n" +
+								"Half-width characters are counted as 1, and full-width characters are counted as 2.",
+							Values: []Element{String("str")},
+						},
+					},
+					{
+						Name: "normalize",
+						Group: []Grammar{
+							{Function{Name: "NORMALIZE", Args: []Element{String("str"), ArgWithDefValue{Arg: String("form"), Default: String("'NFC'")}}, Return: Return("string")}},
+						},
+						Description: Description{
+							Template: "Returns %s normalized with the Unicode normalization %s. %s is one of %s, %s, %s or %s.",
+							Values:   []Element{String("str"), String("form"), String("form"), String("'NFC'"), String("'NFD'"), String("'NFKC'"), String("'NFKD'")},
+						},
+					},
+					{
+						Name: "to_halfwidth",
+						Group: []Grammar{
+							{Function{Name: "TO_HALFWIDTH", Args: []Element{String("str")}, Return: Return("string")}},
+						},
+						Description: Description{
+							Template: "Converts full-width characters in %s to half-width characters. " +
+								"Voiced katakana are converted to pairs of half-width katakana and sound marks.",
+							Values: []Element{String("str")},
+						},
+					},
+					{
+						Name: "to_fullwidth",
+						Group: []Grammar{
+							{Function{Name: "TO_FULLWIDTH", Args: []Element{String("str")}, Return: Return("string")}},
+						},
+						Description: Description{
+							Template: "Converts half-width characters in %s to full-width characters. " +
+								"Pairs of half-width katakana and sound marks are converted to voiced katakana.",
+							Values: []Element{String("str")},
+						},
+					},
+					{
+						Name: "levenshtein",
+						Group: []Grammar{
+							{Function{Name: "LEVENSHTEIN", Args: []Element{String("str1"), String("str2")}, Return: Return("integer")}},
+						},
+						Description: Description{
+							Template: "Returns the minimum number of single character insertions, deletions and substitutions required to change %s into %s. " +
+								"The cost of the calculation is proportional to the product of the lengths of the strings.",
+							Values: []Element{String("str1"), String("str2")},
+						},
+					},
+					{
+						Name: "similarity",
+						Group: []Grammar{
+							{Function{Name: "SIMILARITY", Args: []Element{String("str1"), String("str2")}, Return: Return("float")}},
+						},
+						Description: Description{
+							Template: "Returns the similarity of %s and %s from 0 to 1, that is calculated by the LEVENSHTEIN distance divided by the length of the longer string and subtracted from 1.",
+							Values:   []Element{String("str1"), String("str2")},
+						},
+					},
+					{
+						Name: "soundex",
+						Group: []Grammar{
+							{Function{Name: "SOUNDEX", Args: []Element{String("str")}, Return: Return("string")}},
+						},
+						Description: Description{
+							Template: "Returns the four-character Soundex code of %s. " +
+								"Characters other than ASCII letters are ignored.",
+							Values: []Element{String("str")},
+						},
+					},
+					{
+						Name: "lpad",
+						Group: []Grammar{
+							{Function{Name: "LPAD", Args: []Element{String("str"), Integer("len"), String("padstr"), ArgWithDefValue{Arg: String("pad_type"), Default: String("'LEN'")}, ArgWithDefValue{Arg: String("encoding"), Default: String("'UTF8'")}}, Return: Return("string")}},
 						},
 						Description: Description{
 							Template: "Returns the string value of %s padded with leading %s to the length specified by %s. %s is any one of %s.",
@@ -2160,11 +2769,15 @@ var CsvqSyntax = []Expression{
 					{
 						Name: "instr",
 						Group: []Grammar{
-							{Function{Name: "INSTR", Args: []Element{String("str"), Integer("substr")}, Return: Return("integer")}},
+							{Function{Name: "INSTR", Args: []Element{String("str"), String("substr"), Option{Integer("position"), Option{Integer("occurrence")}}}, Return: Return("integer")}},
+							{Function{Name: "POSITION", Args: []Element{String("str"), String("substr"), Option{Integer("position"), Option{Integer("occurrence")}}}, Return: Return("integer")}},
 						},
 						Description: Description{
-							Template: "Returns the index of the first occurrence of %s in %s, or null if %s is not present in %s.",
-							Values:   []Element{String("substr"), String("str"), String("substr"), String("str")},
+							Template: "Returns the position of the %s-th occurrence of %s in %s, searching from %s. " +
+								"Positions are counted in characters, starting with 1. " +
+								"If %s is negative, then counts from the end of %s and searches backward. " +
+								"If %s is not found, then returns 0.",
+							Values: []Element{Integer("occurrence"), String("substr"), String("str"), Integer("position"), Integer("position"), String("str"), String("substr")},
 						},
 					},
 					{
@@ -2174,6 +2787,13 @@ var CsvqSyntax = []Expression{
 						},
 						Description: Description{Template: "Returns the string at %s in the list generated by splitting with %s from %s.", Values: []Element{Integer("index"), String("sep"), String("str")}},
 					},
+					{
+						Name: "concat_ws",
+						Group: []Grammar{
+							{Function{Name: "CONCAT_WS", Args: []Element{String("sep"), String("str"), Option{ContinuousOption{String("str")}}}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Returns the string concatenated %s with %s. Null values are skipped. If %s is null, returns null.", Values: []Element{String("str"), String("sep"), String("sep")}},
+					},
 					{
 						Name: "replace",
 						Group: []Grammar{
@@ -2181,6 +2801,18 @@ var CsvqSyntax = []Expression{
 						},
 						Description: Description{Template: "Returns the string that is replaced all occurrences of %s with %s in %s.", Values: []Element{String("old"), String("new"), String("str")}},
 					},
+					{
+						Name: "glob",
+						Group: []Grammar{
+							{Function{Name: "GLOB", Args: []Element{String("str"), String("pattern"), Option{Boolean("case_insensitive")}}, Return: Return("ternary")}},
+						},
+						Description: Description{
+							Template: "Returns TRUE if %s matches the shell-style wildcard %s, otherwise returns FALSE. " +
+								"Wildcards are \"*\", \"?\" and character classes such as \"[a-z]\" and \"[!a-z]\". " +
+								"If %s is true, the match is case-insensitive.",
+							Values: []Element{String("str"), String("pattern"), Boolean("case_insensitive")},
+						},
+					},
 					{
 						Name: "format",
 						Group: []Grammar{
@@ -2265,6 +2897,55 @@ var CsvqSyntax = []Expression{
 					},
 				},
 			},
+			{
+				Label: "Network Functions",
+				Grammar: []Definition{
+					{
+						Name: "inet_aton",
+						Group: []Grammar{
+							{Function{Name: "INET_ATON", Args: []Element{String("address")}, Return: Return("integer")}},
+						},
+						Description: Description{
+							Template: "Converts an IPv4 %s to an integer in network byte order. " +
+								"If %s is not a valid IPv4 address, then returns %s.",
+							Values: []Element{String("address"), String("address"), Null("NULL")},
+						},
+					},
+					{
+						Name: "inet_ntoa",
+						Group: []Grammar{
+							{Function{Name: "INET_NTOA", Args: []Element{Integer("number")}, Return: Return("string")}},
+						},
+						Description: Description{
+							Template: "Converts %s in network byte order to an IPv4 address. " +
+								"If %s is not in the range from 0 to 4294967295, then returns %s.",
+							Values: []Element{Integer("number"), Integer("number"), Null("NULL")},
+						},
+					},
+					{
+						Name: "ip_in_cidr",
+						Group: []Grammar{
+							{Function{Name: "IP_IN_CIDR", Args: []Element{String("address"), String("cidr")}, Return: Return("ternary")}},
+						},
+						Description: Description{
+							Template: "Returns whether %s is in the range represented by %s. " +
+								"If %s or %s is malformed, then returns %s.",
+							Values: []Element{String("address"), String("cidr"), String("address"), String("cidr"), Ternary("UNKNOWN")},
+						},
+					},
+					{
+						Name: "ip_normalize",
+						Group: []Grammar{
+							{Function{Name: "IP_NORMALIZE", Args: []Element{String("address")}, Return: Return("string")}},
+						},
+						Description: Description{
+							Template: "Converts %s to the canonical representation. " +
+								"If %s is malformed, then returns %s.",
+							Values: []Element{String("address"), String("address"), Null("NULL")},
+						},
+					},
+				},
+			},
 			{
 				Label: "Cast Functions",
 				Grammar: []Definition{
@@ -2310,6 +2991,16 @@ var CsvqSyntax = []Expression{
 						},
 						Description: Description{Template: "Converts %s to a ternary.", Values: []Element{Link("value")}},
 					},
+					{
+						Name: "typeof",
+						Group: []Grammar{
+							{Function{Name: "TYPEOF", Args: []Element{Link("value")}, Return: Return("string")}},
+						},
+						Description: Description{
+							Template: "Returns the type name of %s. The return value is one of %s, %s, %s, %s, %s, %s and %s.",
+							Values:   []Element{Link("value"), String("'string'"), String("'integer'"), String("'float'"), String("'datetime'"), String("'boolean'"), String("'ternary'"), String("'null'")},
+						},
+					},
 				},
 			},
 			{
@@ -2326,6 +3017,69 @@ var CsvqSyntax = []Expression{
 							Values: []Element{String("command"), String("command")},
 						},
 					},
+					{
+						Name: "getenv",
+						Group: []Grammar{
+							{Function{Name: "GETENV", Args: []Element{String("name")}, Return: Return("string")}},
+						},
+						Description: Description{
+							Template: "Returns the value of the environment variable named %s. " +
+								"If the environment variable is not set, then returns a null.",
+							Values: []Element{String("name")},
+						},
+					},
+					{
+						Name: "file_exists",
+						Group: []Grammar{
+							{Function{Name: "FILE_EXISTS", Args: []Element{String("path")}, Return: Return("ternary")}},
+						},
+						Description: Description{
+							Template: "Returns TRUE if a file exists at %s, otherwise returns FALSE. " +
+								"A relative path is resolved against the repository directory, and symbolic links are followed.",
+							Values: []Element{String("path")},
+						},
+					},
+					{
+						Name: "file_size",
+						Group: []Grammar{
+							{Function{Name: "FILE_SIZE", Args: []Element{String("path")}, Return: Return("integer")}},
+						},
+						Description: Description{
+							Template: "Returns the size of the file at %s in bytes. If the file does not exist, then returns a null.",
+							Values:   []Element{String("path")},
+						},
+					},
+					{
+						Name: "file_mtime",
+						Group: []Grammar{
+							{Function{Name: "FILE_MTIME", Args: []Element{String("path")}, Return: Return("datetime")}},
+						},
+						Description: Description{
+							Template: "Returns the modification time of the file at %s. If the file does not exist, then returns a null.",
+							Values:   []Element{String("path")},
+						},
+					},
+					{
+						Name: "filename",
+						Group: []Grammar{
+							{Function{Name: "FILENAME", Return: Return("string")}},
+						},
+						Description: Description{
+							Template: "Returns the path of the file from which the current record is loaded. " +
+								"If the record is not loaded directly from a file, such as a joined or grouped record, then returns a null.",
+						},
+					},
+					{
+						Name: "fileline",
+						Group: []Grammar{
+							{Function{Name: "FILELINE", Return: Return("integer")}},
+						},
+						Description: Description{
+							Template: "Returns the line number at which the current record begins in the file. " +
+								"Line breaks in quoted fields are counted. " +
+								"If the record is not loaded directly from a file or the line number cannot be determined, then returns a null.",
+						},
+					},
 				},
 			},
 			{
@@ -2399,18 +3153,20 @@ var CsvqSyntax = []Expression{
 					{
 						Name: "stdev",
 						Group: []Grammar{
-							{Function{Name: "STDEV", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("float or integer")}},
+							{Function{Name: "STDEV", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("float")}},
+							{Function{Name: "STDDEV_SAMP", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("float")}},
 						},
 						Description: Description{
 							Template: "Returns the sample standard deviation of float values of %s. " +
-								"If all values are null, then returns %s.",
+								"If the number of non-null values is less than 2, then returns %s.",
 							Values: []Element{Link("value"), Null("NULL")},
 						},
 					},
 					{
 						Name: "stdevp",
 						Group: []Grammar{
-							{Function{Name: "STDEVP", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("float or integer")}},
+							{Function{Name: "STDEVP", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("float")}},
+							{Function{Name: "STDDEV_POP", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("float")}},
 						},
 						Description: Description{
 							Template: "Returns the population standard deviation of float values of %s. " +
@@ -2421,18 +3177,20 @@ var CsvqSyntax = []Expression{
 					{
 						Name: "var",
 						Group: []Grammar{
-							{Function{Name: "VAR", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("float or integer")}},
+							{Function{Name: "VAR", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("float")}},
+							{Function{Name: "VAR_SAMP", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("float")}},
 						},
 						Description: Description{
 							Template: "Returns the sample variance of float values of %s. " +
-								"If all values are null, then returns %s.",
+								"If the number of non-null values is less than 2, then returns %s.",
 							Values: []Element{Link("value"), Null("NULL")},
 						},
 					},
 					{
 						Name: "varp",
 						Group: []Grammar{
-							{Function{Name: "VARP", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("float or integer")}},
+							{Function{Name: "VARP", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("float")}},
+							{Function{Name: "VAR_POP", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("float")}},
 						},
 						Description: Description{
 							Template: "Returns the population variance of float values of %s. " +
@@ -2454,6 +3212,162 @@ var CsvqSyntax = []Expression{
 							Values: []Element{Link("value"), Null("NULL"), Link("value"), Keyword("DATETIME")},
 						},
 					},
+					{
+						Name: "mode",
+						Group: []Grammar{
+							{Function{Name: "MODE", AfterArgs: []Element{Keyword("WITHIN"), Keyword("GROUP"), Parentheses{Keyword("ORDER"), Keyword("BY"), Link("value")}}, Return: Return("primitive type")}},
+						},
+						Description: Description{
+							Template: "Returns the most frequent non-null value of %s. " +
+								"If there are multiple values that appear most frequently, then the first one in the sort order is returned. " +
+								"If all values are null, then returns %s.",
+							Values: []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "corr",
+						Group: []Grammar{
+							{Function{Name: "CORR", Args: []Element{Link("value"), Link("value")}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the Pearson correlation coefficient of pairs of float values. " +
+								"Pairs in which either value is null are ignored. " +
+								"If the number of pairs is less than 2, or either set of values has no variance, then returns %s.",
+							Values: []Element{Null("NULL")},
+						},
+					},
+					{
+						Name: "covar_samp",
+						Group: []Grammar{
+							{Function{Name: "COVAR_SAMP", Args: []Element{Link("value"), Link("value")}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the sample covariance of pairs of float values. " +
+								"Pairs in which either value is null are ignored. " +
+								"If the number of pairs is less than 2, then returns %s.",
+							Values: []Element{Null("NULL")},
+						},
+					},
+					{
+						Name: "covar_pop",
+						Group: []Grammar{
+							{Function{Name: "COVAR_POP", Args: []Element{Link("value"), Link("value")}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the population covariance of pairs of float values. " +
+								"Pairs in which either value is null are ignored. " +
+								"If there are no pairs, then returns %s.",
+							Values: []Element{Null("NULL")},
+						},
+					},
+					{
+						Name: "bit_and",
+						Group: []Grammar{
+							{Function{Name: "BIT_AND", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("integer")}},
+						},
+						Description: Description{
+							Template: "Returns the bitwise AND of integer values of %s. If all values are null, then returns %s.",
+							Values:   []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "bit_or",
+						Group: []Grammar{
+							{Function{Name: "BIT_OR", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("integer")}},
+						},
+						Description: Description{
+							Template: "Returns the bitwise OR of integer values of %s. If all values are null, then returns %s.",
+							Values:   []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "bool_and",
+						Group: []Grammar{
+							{Function{Name: "BOOL_AND", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("ternary")}},
+						},
+						Description: Description{
+							Template: "Returns the logical conjunction of ternary values of %s. Null values and %s values are ignored. " +
+								"If there is any %s, then returns %s, otherwise returns %s. " +
+								"If there is no %s or %s value, then returns %s.",
+							Values: []Element{Link("value"), Ternary("UNKNOWN"), Ternary("FALSE"), Ternary("FALSE"), Ternary("TRUE"), Ternary("TRUE"), Ternary("FALSE"), Null("NULL")},
+						},
+					},
+					{
+						Name: "bool_or",
+						Group: []Grammar{
+							{Function{Name: "BOOL_OR", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("ternary")}},
+						},
+						Description: Description{
+							Template: "Returns the logical disjunction of ternary values of %s. Null values and %s values are ignored. " +
+								"If there is any %s, then returns %s, otherwise returns %s. " +
+								"If there is no %s or %s value, then returns %s.",
+							Values: []Element{Link("value"), Ternary("UNKNOWN"), Ternary("TRUE"), Ternary("TRUE"), Ternary("FALSE"), Ternary("TRUE"), Ternary("FALSE"), Null("NULL")},
+						},
+					},
+					{
+						Name: "any_value",
+						Group: []Grammar{
+							{Function{Name: "ANY_VALUE", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("primitive type")}},
+						},
+						Description: Description{
+							Template: "Returns the first non-null value of %s in the order in which records are read. If all values are null, then returns %s.",
+							Values:   []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "first",
+						Group: []Grammar{
+							{Function{Name: "FIRST", CustomArgs: []Element{Link("value"), Keyword("ORDER"), Keyword("BY"), ContinuousOption{Link("order_item")}}, Return: Return("primitive type")}},
+						},
+						Description: Description{
+							Template: "Returns %s in the first record sorted by %s. Records in which any of the sort keys is %s are ignored. If there is no such record, then returns %s.",
+							Values:   []Element{Link("value"), Link("order_item"), Null("NULL"), Null("NULL")},
+						},
+					},
+					{
+						Name: "last",
+						Group: []Grammar{
+							{Function{Name: "LAST", CustomArgs: []Element{Link("value"), Keyword("ORDER"), Keyword("BY"), ContinuousOption{Link("order_item")}}, Return: Return("primitive type")}},
+						},
+						Description: Description{
+							Template: "Returns %s in the last record sorted by %s. Records in which any of the sort keys is %s are ignored. If there is no such record, then returns %s.",
+							Values:   []Element{Link("value"), Link("order_item"), Null("NULL"), Null("NULL")},
+						},
+					},
+					{
+						Name: "count_if",
+						Group: []Grammar{
+							{Function{Name: "COUNT_IF", Args: []Element{Link("condition")}, Return: Return("integer")}},
+						},
+						Description: Description{
+							Template: "Returns the number of records in which %s is %s.",
+							Values:   []Element{Link("condition"), Ternary("TRUE")},
+						},
+					},
+					{
+						Name: "sum_if",
+						Group: []Grammar{
+							{Function{Name: "SUM_IF", Args: []Element{Link("condition"), Link("value")}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the sum of float values of %s in records in which %s is %s. " +
+								"%s is not evaluated for the other records. " +
+								"If no value is summed, then returns %s.",
+							Values: []Element{Link("value"), Link("condition"), Ternary("TRUE"), Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "avg_if",
+						Group: []Grammar{
+							{Function{Name: "AVG_IF", Args: []Element{Link("condition"), Link("value")}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the average of float values of %s in records in which %s is %s. " +
+								"%s is not evaluated for the other records. " +
+								"If no value is averaged, then returns %s.",
+							Values: []Element{Link("value"), Link("condition"), Ternary("TRUE"), Link("value"), Null("NULL")},
+						},
+					},
 					{
 						Name: "listagg",
 						Group: []Grammar{
@@ -2468,6 +3382,21 @@ var CsvqSyntax = []Expression{
 							Values: []Element{Link("value"), Null("NULL"), String("sep"), Link("order_by_clause")},
 						},
 					},
+					{
+						Name: "string_agg",
+						Group: []Grammar{
+							{Function{Name: "STRING_AGG", Args: []Element{Option{Keyword("DISTINCT")}, Link("value"), String("sep"), Option{Link("order_by_clause")}}, Return: Return("string")}},
+							{Function{Name: "STRING_AGG", Args: []Element{Option{Keyword("DISTINCT")}, Link("value"), String("sep")}, AfterArgs: []Element{Option{Keyword("WITHIN"), Keyword("GROUP"), Parentheses{Link("order_by_clause")}}}, Return: Return("string")}},
+						},
+						Description: Description{
+							Template: "Returns the string result with the concatenated non-null values of %s. " +
+								"If all values are null, then returns %s.\n" +
+								"\n" +
+								"%s is placed between values. " +
+								"By using %s, you can sort values.",
+							Values: []Element{Link("value"), Null("NULL"), String("sep"), Link("order_by_clause")},
+						},
+					},
 					{
 						Name: "json_agg",
 						Group: []Grammar{
@@ -2479,6 +3408,28 @@ var CsvqSyntax = []Expression{
 							Values: []Element{Link("value"), Link("order_by_clause")},
 						},
 					},
+					{
+						Name: "top_k",
+						Group: []Grammar{
+							{Function{Name: "TOP_K", Args: []Element{Link("value"), Integer("k")}, Return: Return("string")}},
+						},
+						Description: Description{
+							Template: "Returns the string formatted in JSON array of objects that have the %s most frequent values of %s and their counts. " +
+								"Ties are broken by the order of first occurrence, and nulls are counted as %s.",
+							Values: []Element{Integer("k"), Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "histogram",
+						Group: []Grammar{
+							{Function{Name: "HISTOGRAM", Args: []Element{Link("value"), Float("low"), Float("high"), Integer("n")}, Return: Return("string")}},
+						},
+						Description: Description{
+							Template: "Returns the string formatted in JSON object that has the counts of values of %s in %s buckets of equal width between %s and %s, " +
+								"the counts of values less than %s and not less than %s, and the count of %s and non-numeric values.",
+							Values: []Element{Link("value"), Integer("n"), Float("low"), Float("high"), Float("low"), Float("high"), Null("NULL")},
+						},
+					},
 				},
 			},
 			{
@@ -2598,6 +3549,18 @@ var CsvqSyntax = []Expression{
 							Values: []Element{Link("value"), Keyword("IGNORE"), Keyword("NULLS")},
 						},
 					},
+					{
+						Name: "ratio_to_report",
+						Group: []Grammar{
+							{Function{Name: "RATIO_TO_REPORT", Args: []Element{Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float")}},
+						},
+						Description: Description{
+							Template: "Returns the ratio of %s to the sum of %s in a group. " +
+								"Null values are ignored when calculating the sum. " +
+								"If the sum is zero or null, then returns a null.",
+							Values: []Element{Link("value"), Link("value")},
+						},
+					},
 					{
 						Name: "count",
 						Group: []Grammar{
@@ -2652,17 +3615,19 @@ var CsvqSyntax = []Expression{
 					{
 						Name: "stdev",
 						Group: []Grammar{
-							{Function{Name: "STDEV", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+							{Function{Name: "STDEV", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float")}},
+							{Function{Name: "STDDEV_SAMP", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float")}},
 						},
 						Description: Description{
-							Template: "Returns the sample standard deviation of float values of %s. If all values are null, then returns %s.",
+							Template: "Returns the sample standard deviation of float values of %s. If the number of non-null values is less than 2, then returns %s.",
 							Values:   []Element{Link("value"), Null("NULL")},
 						},
 					},
 					{
 						Name: "stdevp",
 						Group: []Grammar{
-							{Function{Name: "STDEVP", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+							{Function{Name: "STDEVP", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float")}},
+							{Function{Name: "STDDEV_POP", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float")}},
 						},
 						Description: Description{
 							Template: "Returns the population standard deviation of float values of %s. If all values are null, then returns %s.",
@@ -2672,17 +3637,19 @@ var CsvqSyntax = []Expression{
 					{
 						Name: "var",
 						Group: []Grammar{
-							{Function{Name: "VAR", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+							{Function{Name: "VAR", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float")}},
+							{Function{Name: "VAR_SAMP", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float")}},
 						},
 						Description: Description{
-							Template: "Returns the sample variance of float values of %s. If all values are null, then returns %s.",
+							Template: "Returns the sample variance of float values of %s. If the number of non-null values is less than 2, then returns %s.",
 							Values:   []Element{Link("value"), Null("NULL")},
 						},
 					},
 					{
 						Name: "varp",
 						Group: []Grammar{
-							{Function{Name: "VARP", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+							{Function{Name: "VARP", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float")}},
+							{Function{Name: "VAR_POP", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float")}},
 						},
 						Description: Description{
 							Template: "Returns the population variance of float values of %s. If all values are null, then returns %s.",
@@ -2702,6 +3669,162 @@ var CsvqSyntax = []Expression{
 							Values: []Element{Link("value"), Null("NULL"), Link("value"), Keyword("DATETIME")},
 						},
 					},
+					{
+						Name: "mode",
+						Group: []Grammar{
+							{Function{Name: "MODE", AfterArgs: []Element{Keyword("WITHIN"), Keyword("GROUP"), Parentheses{Keyword("ORDER"), Keyword("BY"), Link("value")}, Keyword("OVER"), Parentheses{Option{Link("partition_clause")}}}, Return: Return("primitive type")}},
+						},
+						Description: Description{
+							Template: "Returns the most frequent non-null value of %s. " +
+								"If there are multiple values that appear most frequently, then the first one in the sort order is returned. " +
+								"If all values are null, then returns %s.",
+							Values: []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "corr",
+						Group: []Grammar{
+							{Function{Name: "CORR", Args: []Element{Link("value"), Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the Pearson correlation coefficient of pairs of float values. " +
+								"Pairs in which either value is null are ignored. " +
+								"If the number of pairs is less than 2, or either set of values has no variance, then returns %s.",
+							Values: []Element{Null("NULL")},
+						},
+					},
+					{
+						Name: "covar_samp",
+						Group: []Grammar{
+							{Function{Name: "COVAR_SAMP", Args: []Element{Link("value"), Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the sample covariance of pairs of float values. " +
+								"Pairs in which either value is null are ignored. " +
+								"If the number of pairs is less than 2, then returns %s.",
+							Values: []Element{Null("NULL")},
+						},
+					},
+					{
+						Name: "covar_pop",
+						Group: []Grammar{
+							{Function{Name: "COVAR_POP", Args: []Element{Link("value"), Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the population covariance of pairs of float values. " +
+								"Pairs in which either value is null are ignored. " +
+								"If there are no pairs, then returns %s.",
+							Values: []Element{Null("NULL")},
+						},
+					},
+					{
+						Name: "bit_and",
+						Group: []Grammar{
+							{Function{Name: "BIT_AND", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("integer")}},
+						},
+						Description: Description{
+							Template: "Returns the bitwise AND of integer values of %s. If all values are null, then returns %s.",
+							Values:   []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "bit_or",
+						Group: []Grammar{
+							{Function{Name: "BIT_OR", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("integer")}},
+						},
+						Description: Description{
+							Template: "Returns the bitwise OR of integer values of %s. If all values are null, then returns %s.",
+							Values:   []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "bool_and",
+						Group: []Grammar{
+							{Function{Name: "BOOL_AND", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("ternary")}},
+						},
+						Description: Description{
+							Template: "Returns the logical conjunction of ternary values of %s. Null values and %s values are ignored. " +
+								"If there is any %s, then returns %s, otherwise returns %s. " +
+								"If there is no %s or %s value, then returns %s.",
+							Values: []Element{Link("value"), Ternary("UNKNOWN"), Ternary("FALSE"), Ternary("FALSE"), Ternary("TRUE"), Ternary("TRUE"), Ternary("FALSE"), Null("NULL")},
+						},
+					},
+					{
+						Name: "bool_or",
+						Group: []Grammar{
+							{Function{Name: "BOOL_OR", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("ternary")}},
+						},
+						Description: Description{
+							Template: "Returns the logical disjunction of ternary values of %s. Null values and %s values are ignored. " +
+								"If there is any %s, then returns %s, otherwise returns %s. " +
+								"If there is no %s or %s value, then returns %s.",
+							Values: []Element{Link("value"), Ternary("UNKNOWN"), Ternary("TRUE"), Ternary("TRUE"), Ternary("FALSE"), Ternary("TRUE"), Ternary("FALSE"), Null("NULL")},
+						},
+					},
+					{
+						Name: "any_value",
+						Group: []Grammar{
+							{Function{Name: "ANY_VALUE", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("primitive type")}},
+						},
+						Description: Description{
+							Template: "Returns the first non-null value of %s in the order in which records are read. If all values are null, then returns %s.",
+							Values:   []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "first",
+						Group: []Grammar{
+							{Function{Name: "FIRST", CustomArgs: []Element{Link("value"), Keyword("ORDER"), Keyword("BY"), ContinuousOption{Link("order_item")}}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}}}, Return: Return("primitive type")}},
+						},
+						Description: Description{
+							Template: "Returns %s in the first record in a group sorted by %s. Records in which any of the sort keys is %s are ignored. If there is no such record, then returns %s.",
+							Values:   []Element{Link("value"), Link("order_item"), Null("NULL"), Null("NULL")},
+						},
+					},
+					{
+						Name: "last",
+						Group: []Grammar{
+							{Function{Name: "LAST", CustomArgs: []Element{Link("value"), Keyword("ORDER"), Keyword("BY"), ContinuousOption{Link("order_item")}}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}}}, Return: Return("primitive type")}},
+						},
+						Description: Description{
+							Template: "Returns %s in the last record in a group sorted by %s. Records in which any of the sort keys is %s are ignored. If there is no such record, then returns %s.",
+							Values:   []Element{Link("value"), Link("order_item"), Null("NULL"), Null("NULL")},
+						},
+					},
+					{
+						Name: "count_if",
+						Group: []Grammar{
+							{Function{Name: "COUNT_IF", Args: []Element{Link("condition")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("integer")}},
+						},
+						Description: Description{
+							Template: "Returns the number of records in which %s is %s.",
+							Values:   []Element{Link("condition"), Ternary("TRUE")},
+						},
+					},
+					{
+						Name: "sum_if",
+						Group: []Grammar{
+							{Function{Name: "SUM_IF", Args: []Element{Link("condition"), Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the sum of float values of %s in records in which %s is %s. " +
+								"%s is not evaluated for the other records. " +
+								"If no value is summed, then returns %s.",
+							Values: []Element{Link("value"), Link("condition"), Ternary("TRUE"), Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "avg_if",
+						Group: []Grammar{
+							{Function{Name: "AVG_IF", Args: []Element{Link("condition"), Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the average of float values of %s in records in which %s is %s. " +
+								"%s is not evaluated for the other records. " +
+								"If no value is averaged, then returns %s.",
+							Values: []Element{Link("value"), Link("condition"), Ternary("TRUE"), Link("value"), Null("NULL")},
+						},
+					},
 					{
 						Name: "listagg",
 						Group: []Grammar{
@@ -2714,6 +3837,18 @@ var CsvqSyntax = []Expression{
 							Values: []Element{Link("value"), Null("NULL"), String("sep")},
 						},
 					},
+					{
+						Name: "string_agg",
+						Group: []Grammar{
+							{Function{Name: "STRING_AGG", Args: []Element{Option{Keyword("DISTINCT")}, Link("value"), String("sep")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("string")}},
+						},
+						Description: Description{
+							Template: "Returns the string result with the concatenated non-null values of %s. If all values are null, then returns %s.\n" +
+								"\n" +
+								"%s is placed between values.",
+							Values: []Element{Link("value"), Null("NULL"), String("sep")},
+						},
+					},
 					{
 						Name: "json_agg",
 						Group: []Grammar{
@@ -2724,6 +3859,28 @@ var CsvqSyntax = []Expression{
 							Values:   []Element{Link("value")},
 						},
 					},
+					{
+						Name: "top_k",
+						Group: []Grammar{
+							{Function{Name: "TOP_K", Args: []Element{Link("value"), Integer("k")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}}}, Return: Return("string")}},
+						},
+						Description: Description{
+							Template: "Returns the string formatted in JSON array of objects that have the %s most frequent values of %s and their counts. " +
+								"Ties are broken by the order of first occurrence, and nulls are counted as %s.",
+							Values: []Element{Integer("k"), Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "histogram",
+						Group: []Grammar{
+							{Function{Name: "HISTOGRAM", Args: []Element{Link("value"), Float("low"), Float("high"), Integer("n")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}}}, Return: Return("string")}},
+						},
+						Description: Description{
+							Template: "Returns the string formatted in JSON object that has the counts of values of %s in %s buckets of equal width between %s and %s, " +
+								"the counts of values less than %s and not less than %s, and the count of %s and non-numeric values.",
+							Values: []Element{Link("value"), Integer("n"), Float("low"), Float("high"), Float("low"), Float("high"), Null("NULL")},
+						},
+					},
 				},
 				Children: []Expression{
 					{
@@ -2901,20 +4058,20 @@ var CsvqSyntax = []Expression{
 				Name: "Reserved Words",
 				Description: Description{
 					Template: "" +
-						"ABSOLUTE ADD AFTER AGGREGATE ALTER ALL AND ANY AS ASC AVG BEFORE BEGIN " +
-						"BETWEEN BREAK BY CASE CHDIR CLOSE COMMIT CONTINUE COUNT CREATE CROSS " +
-						"CUME_DIST CURRENT CURSOR DECLARE DEFAULT DELETE DENSE_RANK DESC DISPOSE " +
+						"ABSOLUTE ADD AFTER AGGREGATE ALTER ALL AND ANY ANY_VALUE AS ASC AVG AVG_IF BEFORE BEGIN " +
+						"BETWEEN BIT_AND BIT_OR BOOL_AND BOOL_OR BREAK BY CASE CHDIR CHECK CLOSE COLLATE COMMIT CONTINUE CORR COUNT COUNT_IF COVAR_POP COVAR_SAMP CREATE CROSS " +
+						"CUME_DIST CURRENT CURRENT_DATE CURRENT_TIME CURRENT_TIMESTAMP CURSOR CYCLE DECLARE DEFAULT DELETE DENSE_RANK DESC DISPOSE " +
 						"DISTINCT DO DROP DUAL ECHO ELSE ELSEIF END EXCEPT EXECUTE EXISTS " +
 						"EXIT FALSE FETCH FIRST FIRST_VALUE FOLLOWING FOR FROM FULL FUNCTION " +
-						"GROUP HAVING IF IGNORE IN INNER INSERT INTERSECT INTO IS JOIN " +
+						"GROUP HAVING HISTOGRAM IF IGNORE IN INNER INSERT INTERSECT INTO IS JOIN " +
 						"JSON_AGG JSON_OBJECT JSON_ROW JSON_TABLE LAG LAST LAST_VALUE LATERAL LEAD " +
-						"LEFT LIKE LIMIT LISTAGG MAX MEDIAN MIN NATURAL NEXT NOT NTH_VALUE " +
+						"LEFT LIKE LIMIT LISTAGG MAX MEDIAN MIN MODE NATURAL NEXT NOT NTH_VALUE " +
 						"NTILE NULL OFFSET ON ONLY OPEN OR ORDER OUTER OVER PARTITION PERCENT " +
 						"PERCENT_RANK PRECEDING PREPARE PRINT PRINTF PRIOR PWD RANGE RANK RECURSIVE " +
-						"RELATIVE RELOAD REMOVE RENAME REPLACE RETURN RIGHT ROLLBACK ROW ROW_NUMBER " +
-						"SELECT SEPARATOR SET SHOW SOURCE STDEV STDEVP STDIN SUBSTRING SUM SYNTAX TABLE " +
-						"THEN TO TRIGGER TRUE " +
-						"UNBOUNDED UNION UNKNOWN UNSET UPDATE USING VALUES VAR VARP VIEW WHEN WHERE " +
+						"RELATIVE RELOAD REMOVE RENAME REPLACE RETURN RETURNING RIGHT ROLLBACK ROW ROW_NUMBER " +
+						"SEARCH SELECT SEPARATOR SET SHOW SOURCE STDDEV_POP STDDEV_SAMP STDEV STDEVP STDIN STRING_AGG SUBSTRING SUM SUM_IF SYNTAX TABLE " +
+						"THEN TO TOP_K TRIGGER TRUE " +
+						"UNBOUNDED UNION UNIQUE UNKNOWN UNSET UPDATE USING VALUES VAR VAR_POP VAR_SAMP VARP VIEW WHEN WHERE " +
 						"WHILE WITH WITHIN",
 				},
 			},
@@ -3059,19 +4216,20 @@ var CsvqSyntax = []Expression{
 				Description: Description{
 					Template: "" +
 						"```\n" +
-						"+----------+---------------------------------------------+\n" +
-						"| Value    |     Character Encoding                      |\n" +
-						"+----------+---------------------------------------------+\n" +
-						"| AUTO     | Detect encoding automatically               |\n" +
-						"| UTF8     | UTF-8. Detect BOM automatically             |\n" +
-						"| UTF8M    | UTF-8 with BOM                              |\n" +
-						"| UTF16    | UTF-16. Detect BOM and Endian automatically |\n" +
-						"| UTF16BE  | UTF-16 Big-Endian                           |\n" +
-						"| UTF16LE  | UTF-16 Little-Endian                        |\n" +
-						"| UTF16BEM | UTF-16 Big-Endian with BOM                  |\n" +
-						"| UTF16LEM | UTF-16 Little-Endian with BOM               |\n" +
-						"| SJIS     | Shift_JIS                                   |\n" +
-						"+----------+---------------------------------------------+\n" +
+						"+----------+------------------------------------------------+\n" +
+						"| Value    |     Character Encoding                         |\n" +
+						"+----------+------------------------------------------------+\n" +
+						"| AUTO     | Detect encoding automatically                  |\n" +
+						"| UTF8     | UTF-8. Detect BOM automatically                |\n" +
+						"| UTF8M    | UTF-8 with BOM                                 |\n" +
+						"| UTF16    | UTF-16. Detect BOM and Endian automatically    |\n" +
+						"| UTF16BE  | UTF-16 Big-Endian. Detect BOM automatically    |\n" +
+						"| UTF16LE  | UTF-16 Little-Endian. Detect BOM automatically |\n" +
+						"| UTF16BEM | UTF-16 Big-Endian with BOM                     |\n" +
+						"| UTF16LEM | UTF-16 Little-Endian with BOM                  |\n" +
+						"| SJIS     | Shift_JIS                                      |\n" +
+						"| EUCJP    | EUC-JP                                         |\n" +
+						"+----------+------------------------------------------------+\n" +
 						"```",
 				},
 			},
@@ -3091,6 +4249,7 @@ var CsvqSyntax = []Expression{
 						"| UTF16BEM | UTF-16 Big-Endian with BOM       |\n" +
 						"| UTF16LEM | UTF-16 Little-Endian with BOM    |\n" +
 						"| SJIS     | Shift_JIS                        |\n" +
+						"| EUCJP    | EUC-JP                           |\n" +
 						"+----------+----------------------------------+\n" +
 						"```",
 				},
@@ -3115,18 +4274,25 @@ var CsvqSyntax = []Expression{
 				Description: Description{
 					Template: "" +
 						"```\n" +
-						"+-------+------------------------------------------+\n" +
-						"| Value |                  Format                  |\n" +
-						"+-------+------------------------------------------+\n" +
-						"| CSV   | Character separated values               |\n" +
-						"| TSV   | Tab separated values                     |\n" +
-						"| FIXED | Fixed-Length Format                      |\n" +
-						"| JSON  | JSON Format                              |\n" +
-						"| LTSV  | Labeled Tab-separated Values             |\n" +
-						"| GFM   | Text Table for GitHub Flavored Markdown  |\n" +
-						"| ORG   | Text Table for Emacs Org-mode            |\n" +
-						"| TEXT  | Text Table for console                   |\n" +
-						"+-------+------------------------------------------+\n" +
+						"+------------+----------------------------------------------+\n" +
+						"| Value      |                    Format                    |\n" +
+						"+------------+----------------------------------------------+\n" +
+						"| CSV        | Character separated values                   |\n" +
+						"| TSV        | Tab separated values                         |\n" +
+						"| FIXED      | Fixed-Length Format                          |\n" +
+						"| JSON       | JSON Format                                  |\n" +
+						"| NDJSON     | Newline Delimited JSON (JSON Lines)          |\n" +
+						"| LTSV       | Labeled Tab-separated Values                 |\n" +
+						"| GFM        | Text Table for GitHub Flavored Markdown      |\n" +
+						"| ORG        | Text Table for Emacs Org-mode                |\n" +
+						"| TEXT       | Text Table for console                       |\n" +
+						"| HTML       | HTML Table                                   |\n" +
+						"| XML        | XML Format                                   |\n" +
+						"| VERTICAL   | Records as lines of column names and values  |\n" +
+						"| SQL_INSERT | SQL INSERT statements                        |\n" +
+						"| TEMPLATE   | Text generated by a Go template              |\n" +
+						"| PARQUET    | Apache Parquet                               |\n" +
+						"+------------+----------------------------------------------+\n" +
 						"```",
 				},
 			},
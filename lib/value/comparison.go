@@ -223,6 +223,29 @@ func Compare(p1 Primary, p2 Primary, operator string, datetimeFormats []string)
 	}
 }
 
+// CompareStrings compares two strings without converting them to other types.
+func CompareStrings(s1 *String, s2 *String, operator string) ternary.Value {
+	v1 := strings.ToUpper(cmd.TrimSpace(s1.Raw()))
+	v2 := strings.ToUpper(cmd.TrimSpace(s2.Raw()))
+
+	switch operator {
+	case "=":
+		return ternary.ConvertFromBool(v1 == v2)
+	case "==":
+		return ternary.ConvertFromBool(s1.Raw() == s2.Raw())
+	case ">":
+		return ternary.ConvertFromBool(v1 > v2)
+	case "<":
+		return ternary.ConvertFromBool(v1 < v2)
+	case ">=":
+		return ternary.ConvertFromBool(v1 >= v2)
+	case "<=":
+		return ternary.ConvertFromBool(v1 <= v2)
+	default: //case "<>", "!=":
+		return ternary.ConvertFromBool(v1 != v2)
+	}
+}
+
 func CompareRowValues(rowValue1 RowValue, rowValue2 RowValue, operator string, datetimeFormats []string) (ternary.Value, error) {
 	if rowValue1 == nil || rowValue2 == nil {
 		return ternary.UNKNOWN, nil
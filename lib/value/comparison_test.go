@@ -331,6 +331,29 @@ func TestCompare(t *testing.T) {
 	}
 }
 
+var compareStringsTests = []struct {
+	LHS    *String
+	RHS    *String
+	Op     string
+	Result ternary.Value
+}{
+	{LHS: NewString("01234"), RHS: NewString("1234"), Op: "=", Result: ternary.FALSE},
+	{LHS: NewString(" abc"), RHS: NewString("ABC"), Op: "=", Result: ternary.TRUE},
+	{LHS: NewString(" abc"), RHS: NewString("ABC"), Op: "==", Result: ternary.FALSE},
+	{LHS: NewString("10"), RHS: NewString("9"), Op: "<", Result: ternary.TRUE},
+	{LHS: NewString("10"), RHS: NewString("9"), Op: ">=", Result: ternary.FALSE},
+	{LHS: NewString("a"), RHS: NewString("b"), Op: "<>", Result: ternary.TRUE},
+}
+
+func TestCompareStrings(t *testing.T) {
+	for _, v := range compareStringsTests {
+		r := CompareStrings(v.LHS, v.RHS, v.Op)
+		if r != v.Result {
+			t.Errorf("result = %s, want %s for (%s %s %s)", r, v.Result, v.LHS, v.Op, v.RHS)
+		}
+	}
+}
+
 var compareRowValuesTests = []struct {
 	LHS    RowValue
 	RHS    RowValue
@@ -0,0 +1,181 @@
+package value
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+var StrftimeFormats = NewStrftimeFormatMap()
+
+type StrftimeFormatMap struct {
+	m *sync.Map
+}
+
+func NewStrftimeFormatMap() *StrftimeFormatMap {
+	return &StrftimeFormatMap{
+		m: &sync.Map{},
+	}
+}
+
+func (fmap StrftimeFormatMap) Get(s string) StrftimeFormat {
+	if f, ok := fmap.m.Load(s); ok {
+		return f.(StrftimeFormat)
+	}
+	f := ParseStrftimeFormat(s)
+	fmap.m.Store(s, f)
+	return f
+}
+
+type strftimeDirective struct {
+	layout  string
+	pattern *regexp.Regexp
+
+	// upperCase is true if the matched part must be upper-cased to be parsed
+	// with the layout.
+	upperCase bool
+}
+
+var strftimeDirectives = map[rune]strftimeDirective{
+	'a': {layout: "Mon", pattern: regexp.MustCompile(`^[A-Za-z]{3}`)},
+	'A': {layout: "Monday", pattern: regexp.MustCompile(`^[A-Za-z]+`)},
+	'b': {layout: "Jan", pattern: regexp.MustCompile(`^[A-Za-z]{3}`)},
+	'B': {layout: "January", pattern: regexp.MustCompile(`^[A-Za-z]+`)},
+	'd': {layout: "02", pattern: regexp.MustCompile(`^[0-9]{2}`)},
+	'e': {layout: "_2", pattern: regexp.MustCompile(`^( [0-9]|[0-9]{1,2})`)},
+	'F': {layout: "2006-01-02", pattern: regexp.MustCompile(`^[0-9]{4}-[0-9]{2}-[0-9]{2}`)},
+	'H': {layout: "15", pattern: regexp.MustCompile(`^[0-9]{2}`)},
+	'h': {layout: "Jan", pattern: regexp.MustCompile(`^[A-Za-z]{3}`)},
+	'I': {layout: "03", pattern: regexp.MustCompile(`^[0-9]{2}`)},
+	'j': {layout: "002", pattern: regexp.MustCompile(`^[0-9]{3}`)},
+	'm': {layout: "01", pattern: regexp.MustCompile(`^[0-9]{2}`)},
+	'M': {layout: "04", pattern: regexp.MustCompile(`^[0-9]{2}`)},
+	'p': {layout: "PM", pattern: regexp.MustCompile(`^[AaPp][Mm]`), upperCase: true},
+	'S': {layout: "05", pattern: regexp.MustCompile(`^[0-9]{2}`)},
+	'T': {layout: "15:04:05", pattern: regexp.MustCompile(`^[0-9]{2}:[0-9]{2}:[0-9]{2}`)},
+	'y': {layout: "06", pattern: regexp.MustCompile(`^[0-9]{2}`)},
+	'Y': {layout: "2006", pattern: regexp.MustCompile(`^[0-9]{4}`)},
+	'z': {layout: "-0700", pattern: regexp.MustCompile(`^[+-][0-9]{4}`)},
+	'Z': {layout: "MST", pattern: regexp.MustCompile(`^[A-Za-z]{3,5}`)},
+}
+
+// strftimeSeparator joins the parts matched by directives to be parsed with
+// Go layouts. It is never interpreted as a part of a Go layout.
+const strftimeSeparator = "\x00"
+
+var errStrftimeMismatch = errors.New("string does not match the format")
+var errStrftimeNoDirectives = errors.New("format has no directives")
+
+type strftimeElement struct {
+	literal   string
+	directive *strftimeDirective
+}
+
+// StrftimeFormat is a format described with C strftime directives.
+// Characters other than directives are always treated as literal text,
+// so they are never interpreted as Go layouts.
+type StrftimeFormat []strftimeElement
+
+// ParseStrftimeFormat splits a format into directives and literal text.
+// Unknown directives are treated as literal text.
+func ParseStrftimeFormat(format string) StrftimeFormat {
+	var elements StrftimeFormat
+	var literal strings.Builder
+
+	var flushLiteral = func() {
+		if 0 < literal.Len() {
+			elements = append(elements, strftimeElement{literal: literal.String()})
+			literal.Reset()
+		}
+	}
+
+	runes := []rune(format)
+	for i := 0; i < len(runes); i++ {
+		if runes[i] != '%' || i+1 == len(runes) {
+			literal.WriteRune(runes[i])
+			continue
+		}
+
+		i++
+		if runes[i] == '%' {
+			literal.WriteRune('%')
+			continue
+		}
+
+		d, ok := strftimeDirectives[runes[i]]
+		if !ok {
+			literal.WriteRune('%')
+			literal.WriteRune(runes[i])
+			continue
+		}
+
+		flushLiteral()
+		elements = append(elements, strftimeElement{directive: &d})
+	}
+	flushLiteral()
+
+	return elements
+}
+
+// HasDirectives returns true if the format includes any directive.
+func (f StrftimeFormat) HasDirectives() bool {
+	for _, e := range f {
+		if e.directive != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (f StrftimeFormat) Format(t time.Time) string {
+	var buf strings.Builder
+	for _, e := range f {
+		if e.directive == nil {
+			buf.WriteString(e.literal)
+		} else {
+			buf.WriteString(t.Format(e.directive.layout))
+		}
+	}
+	return buf.String()
+}
+
+// Parse parses s as a datetime. Literal text in the format must match s
+// exactly, and the parts matched by directives are parsed with Go layouts.
+// A format that has no directives cannot determine a datetime, so it always
+// results in an error.
+func (f StrftimeFormat) Parse(s string, loc *time.Location) (time.Time, error) {
+	if !f.HasDirectives() {
+		return time.Time{}, errStrftimeNoDirectives
+	}
+
+	layouts := make([]string, 0, len(f))
+	values := make([]string, 0, len(f))
+
+	for _, e := range f {
+		if e.directive == nil {
+			if !strings.HasPrefix(s, e.literal) {
+				return time.Time{}, errStrftimeMismatch
+			}
+			s = s[len(e.literal):]
+			continue
+		}
+
+		v := e.directive.pattern.FindString(s)
+		if len(v) < 1 {
+			return time.Time{}, errStrftimeMismatch
+		}
+		s = s[len(v):]
+		if e.directive.upperCase {
+			v = strings.ToUpper(v)
+		}
+		layouts = append(layouts, e.directive.layout)
+		values = append(values, v)
+	}
+	if 0 < len(s) {
+		return time.Time{}, errStrftimeMismatch
+	}
+
+	return time.ParseInLocation(strings.Join(layouts, strftimeSeparator), strings.Join(values, strftimeSeparator), loc)
+}
@@ -0,0 +1,134 @@
+package value
+
+import (
+	"testing"
+	"time"
+)
+
+var strftimeFormatFormatTests = []struct {
+	Format string
+	Time   time.Time
+	Result string
+}{
+	{
+		Format: "%a %A %b %B %d %e %F %H %I %j %m %M %p %S %T %y %Y %z %Z %% %h",
+		Time:   time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC),
+		Result: "Fri Friday Feb February 03  3 2012-02-03 09 09 034 02 18 AM 15 09:18:15 12 2012 +0000 UTC % Feb",
+	},
+	{
+		Format: "%d/%b/%Y:%H:%M:%S %z",
+		Time:   time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC),
+		Result: "03/Feb/2012:09:18:15 +0000",
+	},
+	{
+		Format: "Q1 2006-01-02 Jan Mon PM %Y",
+		Time:   time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC),
+		Result: "Q1 2006-01-02 Jan Mon PM 2012",
+	},
+	{
+		Format: "%c %Q 100%",
+		Time:   time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC),
+		Result: "%c %Q 100%",
+	},
+}
+
+func TestStrftimeFormat_Format(t *testing.T) {
+	for _, v := range strftimeFormatFormatTests {
+		result := ParseStrftimeFormat(v.Format).Format(v.Time)
+		if result != v.Result {
+			t.Errorf("result = %q, want %q for %q", result, v.Result, v.Format)
+		}
+	}
+}
+
+var strftimeFormatParseTests = []struct {
+	Format string
+	Value  string
+	Result time.Time
+	Error  bool
+}{
+	{
+		Format: "%d/%b/%Y:%H:%M:%S %z",
+		Value:  "03/Feb/2012:09:18:15 +0900",
+		Result: time.Date(2012, 2, 3, 9, 18, 15, 0, time.FixedZone("", 9*60*60)),
+	},
+	{
+		Format: "%F %T",
+		Value:  "2012-02-03 09:18:15",
+		Result: time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC),
+	},
+	{
+		Format: "%e %B %Y %I:%M %p",
+		Value:  " 3 February 2012 09:18 PM",
+		Result: time.Date(2012, 2, 3, 21, 18, 0, 0, time.UTC),
+	},
+	{
+		Format: "%F %I:%M %p",
+		Value:  "2012-02-03 03:04 pm",
+		Result: time.Date(2012, 2, 3, 15, 4, 0, 0, time.UTC),
+	},
+	{
+		Format: "%F %I:%M %p",
+		Value:  "2012-02-03 03:04 Am",
+		Result: time.Date(2012, 2, 3, 3, 4, 0, 0, time.UTC),
+	},
+	{
+		Format: "Q1 01 Jan %Y-%m-%d",
+		Value:  "Q1 01 Jan 2012-02-03",
+		Result: time.Date(2012, 2, 3, 0, 0, 0, 0, time.UTC),
+	},
+	{
+		Format: "%Y/%m/%d 100%%",
+		Value:  "2012/02/03 100%",
+		Result: time.Date(2012, 2, 3, 0, 0, 0, 0, time.UTC),
+	},
+	{
+		Format: "Q1 %Y",
+		Value:  "Q2 2012",
+		Error:  true,
+	},
+	{
+		Format: "%Y-%m-%d",
+		Value:  "2012-02-03 09:18:15",
+		Error:  true,
+	},
+	{
+		Format: "%Y-%m-%d",
+		Value:  "2012-02-30",
+		Error:  true,
+	},
+	{
+		Format: "02/Jan/2006",
+		Value:  "03/Feb/2012",
+		Error:  true,
+	},
+	{
+		Format: "literal",
+		Value:  "literal",
+		Error:  true,
+	},
+	{
+		Format: "",
+		Value:  "",
+		Error:  true,
+	},
+}
+
+func TestStrftimeFormat_Parse(t *testing.T) {
+	for _, v := range strftimeFormatParseTests {
+		result, err := ParseStrftimeFormat(v.Format).Parse(v.Value, time.UTC)
+		if err != nil {
+			if !v.Error {
+				t.Errorf("unexpected error %q for %q, %q", err, v.Format, v.Value)
+			}
+			continue
+		}
+		if v.Error {
+			t.Errorf("no error, want error for %q, %q", v.Format, v.Value)
+			continue
+		}
+		if !result.Equal(v.Result) {
+			t.Errorf("result = %s, want %s for %q, %q", result, v.Result, v.Format, v.Value)
+		}
+	}
+}
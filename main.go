@@ -2,9 +2,12 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"strings"
 
 	"github.com/mithrandie/csvq/lib/action"
 	"github.com/mithrandie/csvq/lib/cmd"
@@ -49,11 +52,54 @@ func main() {
 			Name:  "ansi-quotes, k",
 			Usage: "use double quotation mark as identifier enclosure",
 		},
+		cli.StringFlag{
+			Name:  "nulls-order",
+			Value: "DEFAULT",
+			Usage: "default position of nulls in sorting. one of: DEFAULT|FIRST|LAST",
+		},
+		cli.BoolTFlag{
+			Name:  "stable-sort",
+			Usage: "keep the input order of records with equal sort keys. enabled by default; use --stable-sort=false to disable it",
+		},
+		cli.BoolFlag{
+			Name:  "concat-null-as-empty",
+			Usage: "treat nulls as empty strings in string concatenation",
+		},
+		cli.BoolFlag{
+			Name:  "append-commit",
+			Usage: "append inserted records to files instead of rewriting them when tables are only inserted into",
+		},
+		cli.BoolTFlag{
+			Name:  "atomic-write",
+			Usage: "write updated files to temporary files and rename them over the original files on commit. enabled by default; use --atomic-write=false to overwrite the original files in place",
+		},
+		cli.BoolFlag{
+			Name:  "dry-run",
+			Usage: "execute data manipulation statements and report the changes without writing files on commit",
+		},
+		cli.BoolFlag{
+			Name:  "ignore-constraints",
+			Usage: "do not check not null, unique and check constraints of tables",
+		},
 		cli.Float64Flag{
 			Name:  "wait-timeout, w",
 			Value: 10,
 			Usage: "limit of the waiting time in seconds to wait for locked files to be released",
 		},
+		cli.Float64Flag{
+			Name:  "http-timeout",
+			Value: 30,
+			Usage: "limit of the time in seconds to fetch tables from HTTP(S) URLs",
+		},
+		cli.Int64Flag{
+			Name:  "http-max-size",
+			Value: 0,
+			Usage: "maximum size in bytes of tables fetched from HTTP(S) URLs. 0 means no limit",
+		},
+		cli.StringFlag{
+			Name:  "http-headers",
+			Usage: "request headers as a JSON object to fetch tables from HTTP(S) URLs",
+		},
 		cli.StringFlag{
 			Name:  "source, s",
 			Usage: "load query or statements from `FILE`",
@@ -68,10 +114,24 @@ func main() {
 			Value: ",",
 			Usage: "field delimiter for CSV",
 		},
+		cli.StringFlag{
+			Name:  "delimiter-regex",
+			Usage: "regular expression `PATTERN` to split fields for CSV",
+		},
 		cli.StringFlag{
 			Name:  "delimiter-positions, m",
 			Usage: "delimiter positions for FIXED",
 		},
+		cli.StringFlag{
+			Name:  "quote-char",
+			Value: "\"",
+			Usage: "quotation character to enclose fields in CSV. an empty string means no quotation",
+		},
+		cli.StringFlag{
+			Name:  "escape-style",
+			Value: "DOUBLE",
+			Usage: "escape style of quotation characters in CSV. one of: DOUBLE|BACKSLASH|NONE",
+		},
 		cli.StringFlag{
 			Name:  "json-query, j",
 			Usage: "`QUERY` for JSON",
@@ -89,10 +149,72 @@ func main() {
 			Name:  "without-null, a",
 			Usage: "parse empty fields as empty strings",
 		},
+		cli.StringFlag{
+			Name:  "column-types",
+			Usage: "force values of the named columns to be parsed as the specified types",
+		},
+		cli.StringFlag{
+			Name:  "schema",
+			Usage: "load the types of columns from a JSON file instead of --column-types",
+		},
+		cli.BoolFlag{
+			Name:  "strict-column-types",
+			Usage: "raise an error when a value cannot be parsed as the type specified by --column-types",
+		},
+		cli.BoolFlag{
+			Name:  "strict-glob-header",
+			Usage: "require the same order of columns in files matched by a glob pattern",
+		},
+		cli.BoolFlag{
+			Name:  "no-infer",
+			Usage: "import all fields as strings",
+		},
+		cli.IntFlag{
+			Name:  "skip-lines",
+			Usage: "number of leading lines to be skipped before loading",
+		},
+		cli.StringFlag{
+			Name:  "comment-prefix",
+			Usage: "skip leading lines starting with `PREFIX`",
+		},
+		cli.BoolFlag{
+			Name:  "skip-all-comments",
+			Usage: "skip lines starting with the comment prefix after the header as well",
+		},
+		cli.BoolFlag{
+			Name:  "trim",
+			Usage: "trim leading and trailing white spaces from fields",
+		},
+		cli.BoolFlag{
+			Name:  "detect",
+			Usage: "detect the delimiter and the header of CSV files from the first 64KB",
+		},
+		cli.StringFlag{
+			Name:  "ragged",
+			Value: "ERROR",
+			Usage: "handling of records with a wrong number of fields. one of: ERROR|PAD|TRUNCATE|SKIP",
+		},
 		cli.StringFlag{
 			Name:  "out, o",
 			Usage: "export result sets of select queries to `FILE`",
 		},
+		cli.BoolFlag{
+			Name:  "append",
+			Usage: "append result sets to the file specified by --out if it exists. fields are arranged in the order of the header of the file",
+		},
+		cli.StringFlag{
+			Name:  "partition-by",
+			Usage: "export records to a file for each distinct combination of values in comma-separated `COLUMNS`. the values are substituted for {column} in the path specified by --out",
+		},
+		cli.IntFlag{
+			Name:  "max-partitions",
+			Value: query.DefaultMaxPartitions,
+			Usage: "maximum number of files exported with --partition-by. 0 means no limit",
+		},
+		cli.StringFlag{
+			Name:  "tee",
+			Usage: "write copies of result sets of select queries to `FILE` while printing them",
+		},
 		cli.BoolFlag{
 			Name:  "strip-ending-line-break, T",
 			Usage: "strip line break from the end of files and query results",
@@ -112,6 +234,14 @@ func main() {
 			Value: ",",
 			Usage: "field delimiter for CSV in query results",
 		},
+		cli.BoolFlag{
+			Name:  "write-bom",
+			Usage: "write a byte order mark in query results encoded in UTF8, UTF16, UTF16BE or UTF16LE",
+		},
+		cli.BoolFlag{
+			Name:  "replace-unencodable",
+			Usage: "replace characters that cannot be encoded in the write-encoding with \"?\" instead of an error",
+		},
 		cli.StringFlag{
 			Name:  "write-delimiter-positions, M",
 			Usage: "delimiter positions for FIXED in query results",
@@ -120,6 +250,10 @@ func main() {
 			Name:  "without-header, N",
 			Usage: "export result sets of select queries without the header line",
 		},
+		cli.BoolFlag{
+			Name:  "typed-header",
+			Usage: "write the type of each column in the header line as \"name:type\"",
+		},
 		cli.StringFlag{
 			Name:  "line-break, l",
 			Value: "LF",
@@ -129,15 +263,103 @@ func main() {
 			Name:  "enclose-all, Q",
 			Usage: "enclose all string values in CSV and TSV",
 		},
+		cli.StringFlag{
+			Name:  "quote-mode",
+			Value: "MINIMAL",
+			Usage: "fields to be enclosed in CSV and TSV. one of: MINIMAL|ALL|NON_NUMERIC|NONE",
+		},
+		cli.BoolFlag{
+			Name:  "excel-safe",
+			Usage: "prefix strings that begin with formula characters in CSV and TSV",
+		},
+		cli.StringFlag{
+			Name:  "excel-safe-prefix",
+			Value: "QUOTE",
+			Usage: "prefix of strings in CSV and TSV with --excel-safe. one of: QUOTE|TAB",
+		},
 		cli.StringFlag{
 			Name:  "json-escape, J",
 			Value: "BACKSLASH",
 			Usage: "JSON escape type",
 		},
 		cli.BoolFlag{
-			Name:  "pretty-print, P",
+			Name:  "pretty-print, pretty, P",
 			Usage: "make JSON output easier to read in query results",
 		},
+		cli.StringFlag{
+			Name:  "json-key",
+			Usage: "column to be used as the member names of a JSON object",
+		},
+		cli.BoolFlag{
+			Name:  "json-key-last",
+			Usage: "use the last record for duplicate keys in --json-key",
+		},
+		cli.BoolFlag{
+			Name:  "json-flat",
+			Usage: "do not interpret periods in field names as nesting in JSON output",
+		},
+		cli.StringFlag{
+			Name:  "null-text",
+			Usage: "string to represent nulls in GFM and ORG tables",
+		},
+		cli.StringFlag{
+			Name:  "html-class",
+			Usage: "class attribute of tables in HTML",
+		},
+		cli.BoolFlag{
+			Name:  "xml-attributes",
+			Usage: "write fields as attributes of row elements in XML",
+		},
+		cli.Int64Flag{
+			Name:  "max-column-width",
+			Value: 0,
+			Usage: "maximum width of columns in TEXT. 0 means no limit",
+		},
+		cli.BoolFlag{
+			Name:  "wrap-text",
+			Usage: "wrap values longer than --max-column-width instead of truncating them",
+		},
+		cli.BoolFlag{
+			Name:  "box-drawing",
+			Usage: "draw borders of tables in TEXT with box-drawing characters",
+		},
+		cli.StringFlag{
+			Name:  "table-name",
+			Usage: "table name of INSERT statements in SQL_INSERT",
+		},
+		cli.Int64Flag{
+			Name:  "insert-batch-size",
+			Value: 1,
+			Usage: "number of rows in an INSERT statement in SQL_INSERT",
+		},
+		cli.StringFlag{
+			Name:  "identifier-quote",
+			Value: "DOUBLE",
+			Usage: "quotation marks of identifiers in SQL_INSERT. one of: DOUBLE|BACKTICK",
+		},
+		cli.StringFlag{
+			Name:  "template",
+			Usage: "template file of query results in TEMPLATE",
+		},
+		cli.StringFlag{
+			Name:  "parquet-compression",
+			Value: "SNAPPY",
+			Usage: "compression codec of data pages in PARQUET. one of: SNAPPY|ZSTD|NONE",
+		},
+		cli.BoolFlag{
+			Name:  "parquet-all-strings",
+			Usage: "write all columns as strings in PARQUET",
+		},
+		cli.StringFlag{
+			Name:  "compression",
+			Value: "NONE",
+			Usage: "compression algorithm of query results and files written at commit. one of: NONE|GZIP",
+		},
+		cli.Int64Flag{
+			Name:  "compression-level",
+			Value: 6,
+			Usage: "compression level from 1 to 9",
+		},
 		cli.BoolFlag{
 			Name:  "east-asian-encoding, W",
 			Usage: "count ambiguous characters as fullwidth",
@@ -172,6 +394,10 @@ func main() {
 			Name:  "stats, x",
 			Usage: "show execution time and memory statistics",
 		},
+		cli.StringSliceFlag{
+			Name:  "var, V",
+			Usage: "declare a variable in the form of \"name=value\" or \"type:name=value\"",
+		},
 	}
 
 	app.Commands = []cli.Command{
@@ -248,17 +474,62 @@ func main() {
 		if len(queryString) < 1 {
 			err = action.LaunchInteractiveShell(ctx, proc)
 		} else {
-			err = action.Run(ctx, proc, queryString, path, c.GlobalString("out"))
+			outfile := c.GlobalString("out")
+			appendOut := c.GlobalBool("append")
+			if appendOut && len(outfile) < 1 {
+				return query.NewIncorrectCommandUsageError("--append option requires --out option")
+			}
+			if appendOut && !c.GlobalIsSet("format") {
+				// The format of the existing file is determined by its extension.
+				if err = proc.Tx.SetFormatFlag("", outfile); err != nil {
+					return query.NewIncorrectCommandUsageError(err.Error())
+				}
+			}
+			if appendOut && !query.IsAppendableFormat(proc.Tx.Flags.ExportOptions.Format) {
+				return query.NewIncorrectCommandUsageError(fmt.Sprintf("--append option cannot be used with %s format", proc.Tx.Flags.ExportOptions.Format))
+			}
+			if c.GlobalIsSet("partition-by") {
+				if appendOut {
+					return query.NewIncorrectCommandUsageError("--append option cannot be used with --partition-by option")
+				}
+				if err = setOutPartition(c, proc); err != nil {
+					return err
+				}
+				outfile = ""
+			}
+			err = action.Run(ctx, proc, queryString, path, outfile, appendOut)
 		}
 
 		return err
 	})
 
-	if err := app.Run(os.Args); err != nil {
+	if err := app.Run(normalizeVarOptions(os.Args)); err != nil {
 		log.Fatalln(err.Error())
 	}
 }
 
+func setOutPartition(c *cli.Context, proc *query.Processor) error {
+	outfile := c.GlobalString("out")
+	if len(outfile) < 1 {
+		return query.NewIncorrectCommandUsageError("--partition-by option requires --out option")
+	}
+	if abs, err := filepath.Abs(outfile); err == nil {
+		outfile = abs
+	}
+
+	columns := strings.Split(c.GlobalString("partition-by"), ",")
+	for i := range columns {
+		columns[i] = strings.TrimSpace(columns[i])
+	}
+
+	p, err := query.NewPartitionedOutput(outfile, columns, c.GlobalInt("max-partitions"))
+	if err != nil {
+		return err
+	}
+	proc.Tx.Session.SetOutPartition(p)
+	return nil
+}
+
 func onUsageError(c *cli.Context, err error, isSubcommand bool) error {
 	if isSubcommand {
 		if e := cli.ShowCommandHelp(c, c.Command.Name); e != nil {
@@ -290,6 +561,9 @@ func commandAction(fn func(ctx context.Context, c *cli.Context, proc *query.Proc
 			if e := proc.ReleaseResourcesWithErrors(); e != nil {
 				proc.LogError(e.Error())
 			}
+			if e := proc.Tx.CloseTee(); e != nil {
+				proc.LogError(e.Error())
+			}
 
 			if err != nil {
 				if _, ok := err.(*query.IncorrectCommandUsageError); ok {
@@ -321,6 +595,11 @@ func commandAction(fn func(ctx context.Context, c *cli.Context, proc *query.Proc
 			return
 		}
 
+		// Declare variables passed by the --var option
+		if err = action.DeclareVariables(proc, c.GlobalStringSlice("var")); err != nil {
+			return
+		}
+
 		err = fn(ctx, c, proc)
 		if signalReceived != nil {
 			err = signalReceived
@@ -329,6 +608,42 @@ func commandAction(fn func(ctx context.Context, c *cli.Context, proc *query.Proc
 	}
 }
 
+// normalizeVarOptions rewrites "-V:type name=value" into "--var type:name=value"
+// so that the type prefix can be written next to the option name.
+// The short form is also rewritten into the long form, because the cli package
+// does not allow both forms of the same option to be used together.
+func normalizeVarOptions(args []string) []string {
+	normalized := make([]string, 0, len(args))
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			normalized = append(normalized, args[i:]...)
+			break
+		}
+
+		name := arg
+		typeName := ""
+		if idx := strings.Index(arg, ":"); 0 < idx {
+			name = arg[:idx]
+			typeName = arg[idx+1:]
+		}
+		if name != "-V" && name != "--var" {
+			normalized = append(normalized, arg)
+			continue
+		}
+
+		if 0 < len(typeName) && i+1 < len(args) {
+			normalized = append(normalized, "--var", typeName+":"+args[i+1])
+			i++
+		} else if len(typeName) < 1 {
+			normalized = append(normalized, "--var")
+		} else {
+			normalized = append(normalized, arg)
+		}
+	}
+	return normalized
+}
+
 func overwriteFlags(c *cli.Context, tx *query.Transaction) error {
 	if c.GlobalIsSet("repository") {
 		if err := tx.SetFlag(cmd.RepositoryFlag, c.GlobalString("repository")); err != nil {
@@ -346,10 +661,44 @@ func overwriteFlags(c *cli.Context, tx *query.Transaction) error {
 	if c.GlobalIsSet("ansi-quotes") {
 		_ = tx.SetFlag(cmd.AnsiQuotesFlag, c.GlobalBool("ansi-quotes"))
 	}
+	if c.GlobalIsSet("nulls-order") {
+		if err := tx.SetFlag(cmd.NullsOrderFlag, c.GlobalString("nulls-order")); err != nil {
+			return query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
+	if c.GlobalIsSet("stable-sort") {
+		_ = tx.SetFlag(cmd.StableSortFlag, c.GlobalBoolT("stable-sort"))
+	}
+	if c.GlobalIsSet("concat-null-as-empty") {
+		_ = tx.SetFlag(cmd.ConcatNullAsEmptyFlag, c.GlobalBool("concat-null-as-empty"))
+	}
+	if c.GlobalIsSet("append-commit") {
+		_ = tx.SetFlag(cmd.AppendCommitFlag, c.GlobalBool("append-commit"))
+	}
+	if c.GlobalIsSet("atomic-write") {
+		_ = tx.SetFlag(cmd.AtomicWriteFlag, c.GlobalBoolT("atomic-write"))
+	}
+	if c.GlobalIsSet("dry-run") {
+		_ = tx.SetFlag(cmd.DryRunFlag, c.GlobalBool("dry-run"))
+	}
+	if c.GlobalIsSet("ignore-constraints") {
+		_ = tx.SetFlag(cmd.IgnoreConstraintsFlag, c.GlobalBool("ignore-constraints"))
+	}
 
 	if c.GlobalIsSet("wait-timeout") {
 		_ = tx.SetFlag(cmd.WaitTimeoutFlag, c.GlobalFloat64("wait-timeout"))
 	}
+	if c.GlobalIsSet("http-timeout") {
+		_ = tx.SetFlag(cmd.HttpTimeoutFlag, c.GlobalFloat64("http-timeout"))
+	}
+	if c.GlobalIsSet("http-max-size") {
+		_ = tx.SetFlag(cmd.HttpMaxSizeFlag, c.GlobalInt64("http-max-size"))
+	}
+	if c.GlobalIsSet("http-headers") {
+		if err := tx.SetFlag(cmd.HttpHeadersFlag, c.GlobalString("http-headers")); err != nil {
+			return query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
 	if c.GlobalIsSet("color") {
 		_ = tx.SetFlag(cmd.ColorFlag, c.GlobalBool("color"))
 	}
@@ -364,11 +713,26 @@ func overwriteFlags(c *cli.Context, tx *query.Transaction) error {
 			return query.NewIncorrectCommandUsageError(err.Error())
 		}
 	}
+	if c.GlobalIsSet("delimiter-regex") {
+		if err := tx.SetFlag(cmd.DelimiterRegexFlag, c.GlobalString("delimiter-regex")); err != nil {
+			return query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
 	if c.GlobalIsSet("delimiter-positions") {
 		if err := tx.SetFlag(cmd.DelimiterPositionsFlag, c.GlobalString("delimiter-positions")); err != nil {
 			return query.NewIncorrectCommandUsageError(err.Error())
 		}
 	}
+	if c.GlobalIsSet("quote-char") {
+		if err := tx.SetFlag(cmd.QuoteCharFlag, c.GlobalString("quote-char")); err != nil {
+			return query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
+	if c.GlobalIsSet("escape-style") {
+		if err := tx.SetFlag(cmd.EscapeStyleFlag, c.GlobalString("escape-style")); err != nil {
+			return query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
 	if c.GlobalIsSet("json-query") {
 		_ = tx.SetFlag(cmd.JsonQueryFlag, c.GlobalString("json-query"))
 	}
@@ -383,6 +747,48 @@ func overwriteFlags(c *cli.Context, tx *query.Transaction) error {
 	if c.GlobalIsSet("without-null") {
 		_ = tx.SetFlag(cmd.WithoutNullFlag, c.GlobalBool("without-null"))
 	}
+	if c.GlobalIsSet("column-types") {
+		if err := tx.SetFlag(cmd.ColumnTypesFlag, c.GlobalString("column-types")); err != nil {
+			return query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
+	if c.GlobalIsSet("schema") {
+		if c.GlobalIsSet("column-types") {
+			return query.NewIncorrectCommandUsageError("--schema and --column-types cannot be used together")
+		}
+		if err := tx.Flags.SetSchema(c.GlobalString("schema")); err != nil {
+			return query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
+	if c.GlobalIsSet("strict-column-types") {
+		_ = tx.SetFlag(cmd.StrictColumnTypesFlag, c.GlobalBool("strict-column-types"))
+	}
+	if c.GlobalIsSet("strict-glob-header") {
+		_ = tx.SetFlag(cmd.StrictGlobHeaderFlag, c.GlobalBool("strict-glob-header"))
+	}
+	if c.GlobalIsSet("no-infer") {
+		_ = tx.SetFlag(cmd.NoInferFlag, c.GlobalBool("no-infer"))
+	}
+	if c.GlobalIsSet("skip-lines") {
+		_ = tx.SetFlag(cmd.SkipLinesFlag, c.GlobalInt64("skip-lines"))
+	}
+	if c.GlobalIsSet("comment-prefix") {
+		_ = tx.SetFlag(cmd.CommentPrefixFlag, c.GlobalString("comment-prefix"))
+	}
+	if c.GlobalIsSet("skip-all-comments") {
+		_ = tx.SetFlag(cmd.SkipAllCommentsFlag, c.GlobalBool("skip-all-comments"))
+	}
+	if c.GlobalIsSet("trim") {
+		_ = tx.SetFlag(cmd.TrimFlag, c.GlobalBool("trim"))
+	}
+	if c.GlobalIsSet("detect") {
+		_ = tx.SetFlag(cmd.DetectFlag, c.GlobalBool("detect"))
+	}
+	if c.GlobalIsSet("ragged") {
+		if err := tx.SetFlag(cmd.RaggedFlag, c.GlobalString("ragged")); err != nil {
+			return query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
 
 	if c.GlobalIsSet("strip-ending-line-break") {
 		_ = tx.SetFlag(cmd.StripEndingLineBreakFlag, c.GlobalBool("strip-ending-line-break"))
@@ -407,9 +813,18 @@ func overwriteFlags(c *cli.Context, tx *query.Transaction) error {
 			return query.NewIncorrectCommandUsageError(err.Error())
 		}
 	}
+	if c.GlobalIsSet("write-bom") {
+		_ = tx.SetFlag(cmd.WriteBOMFlag, c.GlobalBool("write-bom"))
+	}
+	if c.GlobalIsSet("replace-unencodable") {
+		_ = tx.SetFlag(cmd.ReplaceUnencodableFlag, c.GlobalBool("replace-unencodable"))
+	}
 	if c.GlobalIsSet("without-header") {
 		_ = tx.SetFlag(cmd.WithoutHeaderFlag, c.GlobalBool("without-header"))
 	}
+	if c.GlobalIsSet("typed-header") {
+		_ = tx.SetFlag(cmd.TypedHeaderFlag, c.GlobalBool("typed-header"))
+	}
 	if c.GlobalIsSet("line-break") {
 		if err := tx.SetFlag(cmd.LineBreakFlag, c.GlobalString("line-break")); err != nil {
 			return query.NewIncorrectCommandUsageError(err.Error())
@@ -418,6 +833,19 @@ func overwriteFlags(c *cli.Context, tx *query.Transaction) error {
 	if c.GlobalIsSet("enclose-all") {
 		_ = tx.SetFlag(cmd.EncloseAllFlag, c.GlobalBool("enclose-all"))
 	}
+	if c.GlobalIsSet("quote-mode") {
+		if err := tx.SetFlag(cmd.QuoteModeFlag, c.GlobalString("quote-mode")); err != nil {
+			return query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
+	if c.GlobalIsSet("excel-safe") {
+		_ = tx.SetFlag(cmd.ExcelSafeFlag, c.GlobalBool("excel-safe"))
+	}
+	if c.GlobalIsSet("excel-safe-prefix") {
+		if err := tx.SetFlag(cmd.ExcelSafePrefixFlag, c.GlobalString("excel-safe-prefix")); err != nil {
+			return query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
 	if c.GlobalIsSet("json-escape") {
 		if err := tx.SetFlag(cmd.JsonEscapeFlag, c.GlobalString("json-escape")); err != nil {
 			return query.NewIncorrectCommandUsageError(err.Error())
@@ -426,6 +854,68 @@ func overwriteFlags(c *cli.Context, tx *query.Transaction) error {
 	if c.GlobalIsSet("pretty-print") {
 		_ = tx.SetFlag(cmd.PrettyPrintFlag, c.GlobalBool("pretty-print"))
 	}
+	if c.GlobalIsSet("json-key") {
+		_ = tx.SetFlag(cmd.JsonKeyFlag, c.GlobalString("json-key"))
+	}
+	if c.GlobalIsSet("json-key-last") {
+		_ = tx.SetFlag(cmd.JsonKeyLastFlag, c.GlobalBool("json-key-last"))
+	}
+	if c.GlobalIsSet("json-flat") {
+		_ = tx.SetFlag(cmd.JsonFlatFlag, c.GlobalBool("json-flat"))
+	}
+	if c.GlobalIsSet("null-text") {
+		_ = tx.SetFlag(cmd.NullTextFlag, c.GlobalString("null-text"))
+	}
+	if c.GlobalIsSet("html-class") {
+		_ = tx.SetFlag(cmd.HtmlClassFlag, c.GlobalString("html-class"))
+	}
+	if c.GlobalIsSet("xml-attributes") {
+		_ = tx.SetFlag(cmd.XmlAttributesFlag, c.GlobalBool("xml-attributes"))
+	}
+	if c.GlobalIsSet("max-column-width") {
+		_ = tx.SetFlag(cmd.MaxColumnWidthFlag, c.GlobalInt64("max-column-width"))
+	}
+	if c.GlobalIsSet("wrap-text") {
+		_ = tx.SetFlag(cmd.WrapTextFlag, c.GlobalBool("wrap-text"))
+	}
+	if c.GlobalIsSet("box-drawing") {
+		_ = tx.SetFlag(cmd.BoxDrawingFlag, c.GlobalBool("box-drawing"))
+	}
+	if c.GlobalIsSet("table-name") {
+		_ = tx.SetFlag(cmd.TableNameFlag, c.GlobalString("table-name"))
+	}
+	if c.GlobalIsSet("insert-batch-size") {
+		_ = tx.SetFlag(cmd.InsertBatchSizeFlag, c.GlobalInt64("insert-batch-size"))
+	}
+	if c.GlobalIsSet("identifier-quote") {
+		if err := tx.SetFlag(cmd.IdentifierQuoteFlag, c.GlobalString("identifier-quote")); err != nil {
+			return query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
+	if c.GlobalIsSet("template") {
+		_ = tx.SetFlag(cmd.TemplateFlag, c.GlobalString("template"))
+	}
+	if c.GlobalIsSet("parquet-compression") {
+		if err := tx.SetFlag(cmd.ParquetCompressionFlag, c.GlobalString("parquet-compression")); err != nil {
+			return query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
+	if c.GlobalIsSet("parquet-all-strings") {
+		_ = tx.SetFlag(cmd.ParquetAllStringsFlag, c.GlobalBool("parquet-all-strings"))
+	}
+	if c.GlobalIsSet("compression") {
+		if err := tx.SetFlag(cmd.CompressionFlag, c.GlobalString("compression")); err != nil {
+			return query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
+	if c.GlobalIsSet("compression-level") {
+		_ = tx.SetFlag(cmd.CompressionLevelFlag, c.GlobalInt64("compression-level"))
+	}
+	if c.GlobalIsSet("tee") {
+		if err := tx.SetFlag(cmd.TeeFlag, c.GlobalString("tee")); err != nil {
+			return query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
 
 	if c.GlobalIsSet("east-asian-encoding") {
 		_ = tx.SetFlag(cmd.EastAsianEncodingFlag, c.GlobalBool("east-asian-encoding"))